@@ -78,3 +78,41 @@ func EEATransactionPayload(tx *types.Transaction, privateArgs *entities.PrivateE
 
 	return hash.Bytes(), nil
 }
+
+func EncodeSignedEEATransaction(tx *types.Transaction, privateArgs *entities.PrivateETHTransactionParams, signature []byte, chainID *big.Int) ([]byte, error) {
+	signedTx, err := tx.WithSignature(types.NewEIP155Signer(chainID), signature)
+	if err != nil {
+		return nil, errors.InvalidParameterError("failed to set eea transaction signature").AppendReason(err.Error())
+	}
+	v, r, s := signedTx.RawSignatureValues()
+
+	privateFromEncoded, err := GetEncodedPrivateFrom(privateArgs.PrivateFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	privateRecipientEncoded, err := GetEncodedPrivateRecipient(privateArgs.PrivacyGroupID, privateArgs.PrivateFor)
+	if err != nil {
+		return nil, err
+	}
+
+	signedRaw, err := rlp.Encode([]interface{}{
+		tx.Nonce(),
+		tx.GasPrice(),
+		tx.Gas(),
+		tx.To(),
+		tx.Value(),
+		tx.Data(),
+		v,
+		r,
+		s,
+		privateFromEncoded,
+		privateRecipientEncoded,
+		privateArgs.PrivateTxType,
+	})
+	if err != nil {
+		return nil, errors.CryptoOperationError("failed to RLP encode signed eea transaction").AppendReason(err.Error())
+	}
+
+	return signedRaw, nil
+}
@@ -54,6 +54,7 @@ const (
 	Ethereum        uint64 = 11<<16 + 14<<12
 	NonceTooLow            = Ethereum + 1
 	InvalidNonceErr        = Ethereum + 2
+	ChainReorg             = Ethereum + 3 // Transaction removed from the canonical chain (code BE003)
 
 	// Cryptographic operation error (class C0XXX)
 	CryptoOperation               uint64 = 12 << 16
@@ -330,6 +331,16 @@ func NonceTooLowError(format string, a ...interface{}) *ierror.Error {
 	return Errorf(NonceTooLow, format, a...)
 }
 
+// ChainReorgError is raised when a mined transaction is removed from the canonical chain by a reorganisation
+func ChainReorgError(format string, a ...interface{}) *ierror.Error {
+	return Errorf(ChainReorg, format, a...)
+}
+
+// IsChainReorgError indicate whether an error is a chain reorganisation error
+func IsChainReorgError(err error) bool {
+	return isErrorClass(FromError(err).GetCode(), ChainReorg)
+}
+
 // CryptoOperationError is raised when failing a cryptographic operation
 func CryptoOperationError(format string, a ...interface{}) *ierror.Error {
 	return Errorf(CryptoOperation, format, a...)
@@ -0,0 +1,178 @@
+package abi
+
+import (
+	"bytes"
+	encoding "encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	revertErrorID = crypto.Keccak256([]byte("Error(string)"))[:4]
+	revertPanicID = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// ContractError is a custom Solidity error declared in a contract ABI
+type ContractError struct {
+	Name   string
+	Inputs abi.Arguments
+}
+
+// Sig returns the error signature, e.g. InsufficientBalance(uint256,uint256)
+func (e *ContractError) Sig() string {
+	types := make([]string, len(e.Inputs))
+	for i := range e.Inputs {
+		types[i] = e.Inputs[i].Type.String()
+	}
+	return fmt.Sprintf("%v(%v)", e.Name, strings.Join(types, ","))
+}
+
+// ID returns the 4 bytes selector of the error
+func (e *ContractError) ID() []byte {
+	return crypto.Keccak256([]byte(e.Sig()))[:4]
+}
+
+// ParseABI parses a JSON ABI, skipping the custom errors which go-ethereum does not support (see ParseErrors)
+func ParseABI(rawABI string) (abi.ABI, error) {
+	var fields []encoding.RawMessage
+	if err := encoding.Unmarshal([]byte(rawABI), &fields); err != nil {
+		return abi.ABI{}, err
+	}
+
+	var supported []encoding.RawMessage
+	for _, field := range fields {
+		var f struct{ Type string }
+		if err := encoding.Unmarshal(field, &f); err == nil && f.Type == "error" {
+			continue
+		}
+		supported = append(supported, field)
+	}
+
+	supportedABI, err := encoding.Marshal(supported)
+	if err != nil {
+		return abi.ABI{}, err
+	}
+
+	return abi.JSON(bytes.NewReader(supportedABI))
+}
+
+// ParseErrors extracts the custom errors declared in a JSON ABI
+func ParseErrors(rawABI string) ([]*ContractError, error) {
+	var fields []struct {
+		Type   string
+		Name   string
+		Inputs []abi.ArgumentMarshaling
+	}
+	if err := encoding.Unmarshal([]byte(rawABI), &fields); err != nil {
+		return nil, errors.InvalidFormatError("invalid ABI %v", err)
+	}
+
+	var contractErrors []*ContractError
+	for _, field := range fields {
+		if field.Type != "error" {
+			continue
+		}
+
+		inputs := make(abi.Arguments, len(field.Inputs))
+		for i, input := range field.Inputs {
+			t, err := abi.NewType(input.Type, input.InternalType, input.Components)
+			if err != nil {
+				return nil, errors.InvalidFormatError("invalid type of error %v: %v", field.Name, err)
+			}
+			inputs[i] = abi.Argument{Name: input.Name, Type: t}
+		}
+
+		contractErrors = append(contractErrors, &ContractError{Name: field.Name, Inputs: inputs})
+	}
+
+	return contractErrors, nil
+}
+
+// DecodeInput decodes the arguments of a method call, data being the call data without the method selector
+func DecodeInput(method *abi.Method, data []byte) (map[string]string, error) {
+	mapping, err := decodeArguments(method.Inputs, data)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid input of method %v", method.Sig)
+	}
+
+	return mapping, nil
+}
+
+// DecodeOutput decodes the values returned by a method call, unnamed values being keyed by their position
+func DecodeOutput(method *abi.Method, data []byte) (map[string]string, error) {
+	mapping, err := decodeArguments(method.Outputs, data)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid output of method %v", method.Sig)
+	}
+
+	return mapping, nil
+}
+
+// DecodeRevertReason decodes the data of a transaction reverted by a require, a revert with a message or a panic
+func DecodeRevertReason(data []byte) (string, error) {
+	switch {
+	case len(data) >= 4 && bytes.Equal(data[:4], revertErrorID):
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			return "", errors.InvalidFormatError("invalid revert reason %v", err)
+		}
+		return reason, nil
+	case len(data) >= 4 && bytes.Equal(data[:4], revertPanicID):
+		if len(data) != 36 {
+			return "", errors.InvalidFormatError("invalid panic code")
+		}
+		return fmt.Sprintf("panic code 0x%x", new(big.Int).SetBytes(data[4:])), nil
+	default:
+		return "", errors.NotFoundError("revert data is not of type Error(string) or Panic(uint256)")
+	}
+}
+
+// DecodeError decodes the data of a transaction reverted by one of the given custom errors
+func DecodeError(contractErrors []*ContractError, data []byte) (*ContractError, map[string]string, error) {
+	if len(data) < 4 {
+		return nil, nil, errors.InvalidFormatError("invalid revert data")
+	}
+
+	for _, contractError := range contractErrors {
+		if !bytes.Equal(data[:4], contractError.ID()) {
+			continue
+		}
+
+		mapping, err := decodeArguments(contractError.Inputs, data[4:])
+		if err != nil {
+			return nil, nil, errors.InvalidFormatError("invalid arguments of error %v", contractError.Sig())
+		}
+		return contractError, mapping, nil
+	}
+
+	return nil, nil, errors.NotFoundError("no error of the ABI matches the revert data")
+}
+
+func decodeArguments(args abi.Arguments, data []byte) (map[string]string, error) {
+	values, err := args.UnpackValues(data)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := make(map[string]string, len(args))
+	for i := range args {
+		decoded, err := FormatNonIndexedArg(&args[i].Type, values[i])
+		if err != nil {
+			return nil, err
+		}
+
+		name := args[i].Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		mapping[name] = decoded
+	}
+
+	return mapping, nil
+}
@@ -0,0 +1,132 @@
+// +build unit
+
+package abi
+
+import (
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tokenABI = `[
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]},
+	{"type":"error","name":"Unauthorized","inputs":[]}
+]`
+
+func TestParseABI(t *testing.T) {
+	t.Run("should parse ABI with custom errors successfully", func(t *testing.T) {
+		contractABI, err := ParseABI(tokenABI)
+
+		assert.NoError(t, err)
+		assert.Contains(t, contractABI.Methods, "transfer")
+	})
+
+	t.Run("should fail if ABI is invalid", func(t *testing.T) {
+		_, err := ParseABI(`{"type":"function"}`)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestDecodeInput(t *testing.T) {
+	contractABI, err := ParseABI(tokenABI)
+	require.NoError(t, err)
+
+	data := hexutil.MustDecode("0xa9059cbb000000000000000000000000ff778b716fc07d98839f48ddb88d8be583beb684000000000000000000000000000000000000000000000000002386f26fc10000")
+	method, err := contractABI.MethodById(data[:4])
+	require.NoError(t, err)
+
+	t.Run("should decode method call successfully", func(t *testing.T) {
+		mapping, err := DecodeInput(method, data[4:])
+
+		assert.NoError(t, err)
+		assert.Equal(t, "transfer(address,uint256)", method.Sig)
+		assert.Equal(t, map[string]string{
+			"to":     "0xfF778b716FC07D98839f48DdB88D8bE583BEB684",
+			"amount": "10000000000000000",
+		}, mapping)
+	})
+
+	t.Run("should fail with InvalidFormatError if data is truncated", func(t *testing.T) {
+		_, err := DecodeInput(method, data[4:20])
+
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+}
+
+func TestDecodeOutput(t *testing.T) {
+	contractABI, err := ParseABI(tokenABI)
+	require.NoError(t, err)
+
+	method := contractABI.Methods["transfer"]
+
+	t.Run("should decode returned values successfully", func(t *testing.T) {
+		mapping, err := DecodeOutput(&method, hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000001"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"0": "true"}, mapping)
+	})
+
+	t.Run("should fail with InvalidFormatError if data is empty", func(t *testing.T) {
+		_, err := DecodeOutput(&method, []byte{})
+
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+}
+
+func TestDecodeRevertReason(t *testing.T) {
+	t.Run("should decode Error(string) successfully", func(t *testing.T) {
+		data := hexutil.MustDecode("0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000001a4e6f7420656e6f7567682045746865722070726f76696465642e000000000000")
+		reason, err := DecodeRevertReason(data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Not enough Ether provided.", reason)
+	})
+
+	t.Run("should decode Panic(uint256) successfully", func(t *testing.T) {
+		data := hexutil.MustDecode("0x4e487b710000000000000000000000000000000000000000000000000000000000000011")
+		reason, err := DecodeRevertReason(data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "panic code 0x11", reason)
+	})
+
+	t.Run("should fail with NotFoundError for custom errors", func(t *testing.T) {
+		_, err := DecodeRevertReason(hexutil.MustDecode("0x82b42900"))
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
+func TestDecodeError(t *testing.T) {
+	contractErrors, err := ParseErrors(tokenABI)
+	require.NoError(t, err)
+	require.Len(t, contractErrors, 2)
+
+	t.Run("should decode custom error successfully", func(t *testing.T) {
+		data := hexutil.MustDecode("0xcf47918100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002")
+		contractError, mapping, err := DecodeError(contractErrors, data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "InsufficientBalance(uint256,uint256)", contractError.Sig())
+		assert.Equal(t, map[string]string{"available": "1", "required": "2"}, mapping)
+	})
+
+	t.Run("should decode custom error without arguments successfully", func(t *testing.T) {
+		contractError, mapping, err := DecodeError(contractErrors, hexutil.MustDecode("0x82b42900"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Unauthorized()", contractError.Sig())
+		assert.Empty(t, mapping)
+	})
+
+	t.Run("should fail with NotFoundError if no error matches", func(t *testing.T) {
+		_, _, err := DecodeError(contractErrors, hexutil.MustDecode("0x12345678"))
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
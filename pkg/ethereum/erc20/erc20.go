@@ -0,0 +1,59 @@
+package erc20
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	TransferMethodSignature  = "transfer(address,uint256)"
+	BalanceOfMethodSignature = "balanceOf(address)"
+)
+
+var (
+	transferMethodID  = crypto.Keccak256([]byte(TransferMethodSignature))[:4]
+	balanceOfMethodID = crypto.Keccak256([]byte(BalanceOfMethodSignature))[:4]
+
+	abiAddress, _ = abi.NewType("address", "", nil)
+	abiUint256, _ = abi.NewType("uint256", "", nil)
+)
+
+// EncodeTransfer returns the data of a call to transfer(address,uint256)
+func EncodeTransfer(to ethcommon.Address, amount *big.Int) ([]byte, error) {
+	args, err := abi.Arguments{{Type: abiAddress}, {Type: abiUint256}}.Pack(to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, transferMethodID...), args...), nil
+}
+
+// IsTransfer indicates whether the data is a call to transfer(address,uint256)
+func IsTransfer(data []byte) bool {
+	return len(data) >= len(transferMethodID) && bytes.Equal(data[:len(transferMethodID)], transferMethodID)
+}
+
+// EncodeBalanceOf returns the data of a call to balanceOf(address)
+func EncodeBalanceOf(owner ethcommon.Address) ([]byte, error) {
+	args, err := abi.Arguments{{Type: abiAddress}}.Pack(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]byte{}, balanceOfMethodID...), args...), nil
+}
+
+// DecodeBalance decodes the uint256 returned by a call to balanceOf(address)
+func DecodeBalance(result []byte) (*big.Int, error) {
+	vs, err := abi.Arguments{{Type: abiUint256}}.UnpackValues(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode token balance: %v", err)
+	}
+
+	return vs[0].(*big.Int), nil
+}
@@ -0,0 +1,51 @@
+// +build unit
+
+package erc20
+
+import (
+	"math/big"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeTransfer(t *testing.T) {
+	data, err := EncodeTransfer(ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"), big.NewInt(1000))
+	require.NoError(t, err)
+
+	assert.Equal(t, "0xa9059cbb"+
+		"000000000000000000000000905b88eff8bda1543d4d6f4aa05afef143d27e18"+
+		"00000000000000000000000000000000000000000000000000000000000003e8", hexutil.Encode(data))
+}
+
+func TestIsTransfer(t *testing.T) {
+	data, _ := EncodeTransfer(ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"), big.NewInt(1))
+
+	assert.True(t, IsTransfer(data))
+	assert.False(t, IsTransfer(hexutil.MustDecode("0xa9059c")))
+	assert.False(t, IsTransfer(hexutil.MustDecode("0x70a08231")))
+}
+
+func TestEncodeBalanceOf(t *testing.T) {
+	data, err := EncodeBalanceOf(ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "0x70a08231"+
+		"000000000000000000000000905b88eff8bda1543d4d6f4aa05afef143d27e18", hexutil.Encode(data))
+}
+
+func TestDecodeBalance(t *testing.T) {
+	t.Run("should decode the balance successfully", func(t *testing.T) {
+		balance, err := DecodeBalance(ethcommon.LeftPadBytes(big.NewInt(1000).Bytes(), 32))
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1000), balance)
+	})
+
+	t.Run("should fail if the result is not an uint256", func(t *testing.T) {
+		_, err := DecodeBalance([]byte{0x01})
+		assert.Error(t, err)
+	})
+}
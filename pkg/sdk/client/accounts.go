@@ -84,6 +84,40 @@ func (c *HTTPClient) UpdateAccount(ctx context.Context, address ethcommon.Addres
 	return resp, nil
 }
 
+func (c *HTTPClient) DisableAccount(ctx context.Context, address ethcommon.Address) (*api.AccountResponse, error) {
+	reqURL := fmt.Sprintf("%v/accounts/%s/disable", c.config.URL, address)
+	resp := &api.AccountResponse{}
+
+	response, err := clientutils.PutRequest(ctx, c.client, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer clientutils.CloseResponse(response)
+	if err := httputil.ParseResponse(ctx, response, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c *HTTPClient) RotateAccount(ctx context.Context, address ethcommon.Address, req *api.RotateAccountRequest) (*api.AccountResponse, error) {
+	reqURL := fmt.Sprintf("%v/accounts/%s/rotate", c.config.URL, address)
+	resp := &api.AccountResponse{}
+
+	response, err := clientutils.PostRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer clientutils.CloseResponse(response)
+	if err := httputil.ParseResponse(ctx, response, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 func (c *HTTPClient) SearchAccounts(ctx context.Context, filters *entities.AccountFilters) ([]*api.AccountResponse, error) {
 	reqURL := fmt.Sprintf("%v/accounts", c.config.URL)
 	var resp []*api.AccountResponse
@@ -93,17 +127,22 @@ func (c *HTTPClient) SearchAccounts(ctx context.Context, filters *entities.Accou
 		qParams = append(qParams, "aliases="+strings.Join(filters.Aliases, ","))
 	}
 
-	if len(qParams) > 0 {
-		reqURL = reqURL + "?" + strings.Join(qParams, "&")
-	}
+	err := searchPages(filters.Pagination, func(pageParams []string) (string, error) {
+		var page []*api.AccountResponse
+		response, err := clientutils.GetRequest(ctx, c.client, withQueryParams(reqURL, qParams, pageParams))
+		if err != nil {
+			return "", err
+		}
 
-	response, err := clientutils.GetRequest(ctx, c.client, reqURL)
-	if err != nil {
-		return nil, err
-	}
+		defer clientutils.CloseResponse(response)
+		if err := httputil.ParseResponse(ctx, response, &page); err != nil {
+			return "", err
+		}
 
-	defer clientutils.CloseResponse(response)
-	if err := httputil.ParseResponse(ctx, response, &resp); err != nil {
+		resp = append(resp, page...)
+		return nextPageCursor(response), nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
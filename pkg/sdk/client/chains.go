@@ -37,17 +37,25 @@ func (c *HTTPClient) SearchChains(ctx context.Context, filters *entities.ChainFi
 		qParams = append(qParams, "names="+strings.Join(filters.Names, ","))
 	}
 
-	if len(qParams) > 0 {
-		reqURL = reqURL + "?" + strings.Join(qParams, "&")
-	}
-
-	err := callWithBackOff(ctx, c.config.backOff, func() error {
-		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
-		if err != nil {
-			return err
-		}
-		defer clientutils.CloseResponse(response)
-		return httputil.ParseResponse(ctx, response, &resp)
+	err := searchPages(filters.Pagination, func(pageParams []string) (string, error) {
+		var page []*types.ChainResponse
+		next := ""
+		err := callWithBackOff(ctx, c.config.backOff, func() error {
+			response, err := clientutils.GetRequest(ctx, c.client, withQueryParams(reqURL, qParams, pageParams))
+			if err != nil {
+				return err
+			}
+			defer clientutils.CloseResponse(response)
+			if err := httputil.ParseResponse(ctx, response, &page); err != nil {
+				return err
+			}
+
+			next = nextPageCursor(response)
+			return nil
+		})
+
+		resp = append(resp, page...)
+		return next, err
 	})
 
 	return resp, err
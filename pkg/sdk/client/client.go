@@ -32,6 +32,8 @@ type TransactionClient interface {
 	SendDeployTransaction(ctx context.Context, request *types.DeployContractRequest) (*types.TransactionResponse, error)
 	SendRawTransaction(ctx context.Context, request *types.RawTransactionRequest) (*types.TransactionResponse, error)
 	SendTransferTransaction(ctx context.Context, request *types.TransferRequest) (*types.TransactionResponse, error)
+	SendTransactionBatch(ctx context.Context, request *types.SendTransactionBatchRequest) ([]*types.TransactionResponse, error)
+	SimulateTransaction(ctx context.Context, request *types.SendTransactionRequest) (*types.SimulateTransactionResponse, error)
 	GetTxRequest(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error)
 	SendCallOffTransaction(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error)
 	SendSpeedUpTransaction(ctx context.Context, txRequestUUID string, increment *float64) (*types.TransactionResponse, error)
@@ -50,7 +52,14 @@ type JobClient interface {
 	UpdateJob(ctx context.Context, jobUUID string, request *types.UpdateJobRequest) (*types.JobResponse, error)
 	StartJob(ctx context.Context, jobUUID string) error
 	ResendJobTx(ctx context.Context, jobUUID string) error
+	ApproveJob(ctx context.Context, jobUUID string, request *types.JobApprovalRequest) (*types.JobResponse, error)
+	RejectJob(ctx context.Context, jobUUID string, request *types.JobApprovalRequest) (*types.JobResponse, error)
+	GetJobApprovals(ctx context.Context, jobUUID string) ([]*types.JobApprovalResponse, error)
+	SearchFailedJobs(ctx context.Context, filters *entities.FailedJobFilters) ([]*types.FailedJobResponse, error)
+	ReplayJob(ctx context.Context, jobUUID string) error
+	ReplayFailedJobs(ctx context.Context, request *types.ReplayFailedJobsRequest) ([]*types.FailedJobResponse, error)
 	SearchJob(ctx context.Context, filters *entities.JobFilters) ([]*types.JobResponse, error)
+	SubscribeJobEvents(ctx context.Context, filters *entities.JobEventFilters) (<-chan *types.JobEventResponse, error)
 }
 
 type MetricClient interface {
@@ -64,6 +73,8 @@ type AccountClient interface {
 	GetAccount(ctx context.Context, address ethcommon.Address) (*types.AccountResponse, error)
 	ImportAccount(ctx context.Context, request *types.ImportAccountRequest) (*types.AccountResponse, error)
 	UpdateAccount(ctx context.Context, address ethcommon.Address, request *types.UpdateAccountRequest) (*types.AccountResponse, error)
+	DisableAccount(ctx context.Context, address ethcommon.Address) (*types.AccountResponse, error)
+	RotateAccount(ctx context.Context, address ethcommon.Address, request *types.RotateAccountRequest) (*types.AccountResponse, error)
 	SignMessage(ctx context.Context, address ethcommon.Address, request *qkmtypes.SignMessageRequest) (string, error)
 	SignTypedData(ctx context.Context, address ethcommon.Address, request *qkmtypes.SignTypedDataRequest) (string, error)
 	VerifyMessageSignature(ctx context.Context, request *utilstypes.VerifyRequest) error
@@ -89,6 +100,8 @@ type ChainClient interface {
 type ContractClient interface {
 	RegisterContract(ctx context.Context, req *types.RegisterContractRequest) (*types.ContractResponse, error)
 	DeregisterContract(ctx context.Context, name, tag string) error
+	DeleteContract(ctx context.Context, name string) error
+	SetContractTag(ctx context.Context, name, tag string, req *types.SetContractTagRequest) (*types.ContractResponse, error)
 	GetContract(ctx context.Context, name, tag string) (*types.ContractResponse, error)
 	SearchContract(ctx context.Context, req *types.SearchContractRequest) (*types.ContractResponse, error)
 	GetContractsCatalog(ctx context.Context) ([]string, error)
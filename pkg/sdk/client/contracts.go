@@ -101,8 +101,45 @@ func (c *HTTPClient) SearchContract(ctx context.Context, req *types.SearchContra
 	return resp, err
 }
 
-func (c *HTTPClient) DeregisterContract(_ context.Context, _, _ string) error {
-	panic("method DeregisterContract is not implemented")
+func (c *HTTPClient) DeregisterContract(ctx context.Context, name, tag string) error {
+	reqURL := fmt.Sprintf("%v/contracts/%s/%s", c.config.URL, name, tag)
+
+	response, err := clientutils.DeleteRequest(ctx, c.client, reqURL)
+	if err != nil {
+		return err
+	}
+
+	defer clientutils.CloseResponse(response)
+	return httputil.ParseEmptyBodyResponse(ctx, response)
+}
+
+func (c *HTTPClient) DeleteContract(ctx context.Context, name string) error {
+	reqURL := fmt.Sprintf("%v/contracts/%s", c.config.URL, name)
+
+	response, err := clientutils.DeleteRequest(ctx, c.client, reqURL)
+	if err != nil {
+		return err
+	}
+
+	defer clientutils.CloseResponse(response)
+	return httputil.ParseEmptyBodyResponse(ctx, response)
+}
+
+func (c *HTTPClient) SetContractTag(ctx context.Context, name, tag string, req *types.SetContractTagRequest) (*types.ContractResponse, error) {
+	reqURL := fmt.Sprintf("%v/contracts/%s/%s", c.config.URL, name, tag)
+	resp := &types.ContractResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PutRequest(ctx, c.client, reqURL, req)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
 }
 
 func (c *HTTPClient) SetContractAddressCodeHash(ctx context.Context, address, chainID string, req *types.SetContractCodeHashRequest) error {
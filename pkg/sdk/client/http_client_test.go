@@ -7,12 +7,14 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	backoffmock "github.com/consensys/orchestrate/pkg/backoff/mock"
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
 	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -121,3 +123,50 @@ func TestClientUpdate_NotRetryOnNotInvalidStateError(t *testing.T) {
 	assert.True(t, errors.IsInvalidParameterError(err))
 	assert.False(t, bckoff.HasRetried())
 }
+
+func TestClientSearchJob_Pagination(t *testing.T) {
+	ctx := context.Background()
+
+	var queries []url.Values
+	server := httptest.NewServer(
+		http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			query := req.URL.Query()
+			queries = append(queries, query)
+			if query.Get("next") == "" {
+				rw.Header().Set("Link", `</jobs?limit=1&next=cursor&sort=-created_at>; rel="next"`)
+			}
+			r, _ := json.Marshal([]*types.JobResponse{{UUID: jobUUID}})
+			_, _ = rw.Write(r)
+		}),
+	)
+	defer server.Close()
+	client = NewHTTPClient(
+		server.Client(),
+		NewConfig(server.URL, nil),
+	)
+
+	t.Run("should search a single page without modifying filters if a limit is set", func(t *testing.T) {
+		queries = nil
+		filters := &entities.JobFilters{Pagination: entities.Pagination{Limit: 1, Sort: "-created_at"}}
+
+		res, err := client.SearchJob(ctx, filters)
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Len(t, queries, 1)
+		assert.Equal(t, "1", queries[0].Get("limit"))
+		assert.Equal(t, "-created_at", queries[0].Get("sort"))
+		assert.Equal(t, &entities.JobFilters{Pagination: entities.Pagination{Limit: 1, Sort: "-created_at"}}, filters)
+	})
+
+	t.Run("should follow next pages if no limit is set", func(t *testing.T) {
+		queries = nil
+		filters := &entities.JobFilters{}
+
+		res, err := client.SearchJob(ctx, filters)
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Len(t, queries, 2)
+		assert.Equal(t, "cursor", queries[1].Get("next"))
+		assert.Empty(t, filters.Next)
+	})
+}
@@ -1,8 +1,12 @@
 package client
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
 
@@ -85,18 +89,26 @@ func (c *HTTPClient) SearchJob(ctx context.Context, filters *entities.JobFilters
 		qParams = append(qParams, "with_logs=true")
 	}
 
-	if len(qParams) > 0 {
-		reqURL = reqURL + "?" + strings.Join(qParams, "&")
-	}
-
-	err := callWithBackOff(ctx, c.config.backOff, func() error {
-		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
-		if err != nil {
-			errMessage := "error while searching jobs"
-			return errors.FromError(err).SetMessage(errMessage).AppendReason(err.Error()).ExtendComponent(component)
-		}
-		defer clientutils.CloseResponse(response)
-		return httputil.ParseResponse(ctx, response, &resp)
+	err := searchPages(filters.Pagination, func(pageParams []string) (string, error) {
+		var page []*types.JobResponse
+		next := ""
+		err := callWithBackOff(ctx, c.config.backOff, func() error {
+			response, err := clientutils.GetRequest(ctx, c.client, withQueryParams(reqURL, qParams, pageParams))
+			if err != nil {
+				errMessage := "error while searching jobs"
+				return errors.FromError(err).SetMessage(errMessage).AppendReason(err.Error()).ExtendComponent(component)
+			}
+			defer clientutils.CloseResponse(response)
+			if err := httputil.ParseResponse(ctx, response, &page); err != nil {
+				return err
+			}
+
+			next = nextPageCursor(response)
+			return nil
+		})
+
+		resp = append(resp, page...)
+		return next, err
 	})
 
 	return resp, err
@@ -163,3 +175,191 @@ func (c *HTTPClient) ResendJobTx(ctx context.Context, jobUUID string) error {
 		return httputil.ParseEmptyBodyResponse(ctx, response)
 	})
 }
+
+func (c *HTTPClient) ApproveJob(ctx context.Context, jobUUID string, request *types.JobApprovalRequest) (*types.JobResponse, error) {
+	return c.decideJob(ctx, fmt.Sprintf("%v/jobs/%s/approve", c.config.URL, jobUUID), request)
+}
+
+func (c *HTTPClient) RejectJob(ctx context.Context, jobUUID string, request *types.JobApprovalRequest) (*types.JobResponse, error) {
+	return c.decideJob(ctx, fmt.Sprintf("%v/jobs/%s/reject", c.config.URL, jobUUID), request)
+}
+
+func (c *HTTPClient) decideJob(ctx context.Context, reqURL string, request *types.JobApprovalRequest) (*types.JobResponse, error) {
+	resp := &types.JobResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PutRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) GetJobApprovals(ctx context.Context, jobUUID string) ([]*types.JobApprovalResponse, error) {
+	reqURL := fmt.Sprintf("%v/jobs/%s/approvals", c.config.URL, jobUUID)
+	var resp []*types.JobApprovalResponse
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, &resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) SearchFailedJobs(ctx context.Context, filters *entities.FailedJobFilters) ([]*types.FailedJobResponse, error) {
+	reqURL := fmt.Sprintf("%v/jobs/failed", c.config.URL)
+	var resp []*types.FailedJobResponse
+
+	var qParams []string
+	if len(filters.JobUUIDs) > 0 {
+		qParams = append(qParams, "job_uuids="+strings.Join(filters.JobUUIDs, ","))
+	}
+	if filters.ChainUUID != "" {
+		qParams = append(qParams, "chain_uuid="+filters.ChainUUID)
+	}
+	if filters.ErrorCode != "" {
+		qParams = append(qParams, "error_code="+filters.ErrorCode)
+	}
+	if filters.ErrorClass != "" {
+		qParams = append(qParams, "error_class="+string(filters.ErrorClass))
+	}
+	if !filters.CreatedAfter.IsZero() {
+		qParams = append(qParams, "created_after="+url.QueryEscape(filters.CreatedAfter.Format(time.RFC3339)))
+	}
+	if !filters.CreatedBefore.IsZero() {
+		qParams = append(qParams, "created_before="+url.QueryEscape(filters.CreatedBefore.Format(time.RFC3339)))
+	}
+	if filters.OnlyPending {
+		qParams = append(qParams, "pending=true")
+	}
+
+	err := searchPages(filters.Pagination, func(pageParams []string) (string, error) {
+		var page []*types.FailedJobResponse
+		next := ""
+		err := callWithBackOff(ctx, c.config.backOff, func() error {
+			response, err := clientutils.GetRequest(ctx, c.client, withQueryParams(reqURL, qParams, pageParams))
+			if err != nil {
+				errMessage := "error while searching failed jobs"
+				return errors.FromError(err).SetMessage(errMessage).AppendReason(err.Error()).ExtendComponent(component)
+			}
+			defer clientutils.CloseResponse(response)
+			if err := httputil.ParseResponse(ctx, response, &page); err != nil {
+				return err
+			}
+
+			next = nextPageCursor(response)
+			return nil
+		})
+
+		resp = append(resp, page...)
+		return next, err
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) ReplayJob(ctx context.Context, jobUUID string) error {
+	reqURL := fmt.Sprintf("%v/jobs/%s/replay", c.config.URL, jobUUID)
+
+	return callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PutRequest(ctx, c.client, reqURL, nil)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseEmptyBodyResponse(ctx, response)
+	})
+}
+
+func (c *HTTPClient) ReplayFailedJobs(ctx context.Context, request *types.ReplayFailedJobsRequest) ([]*types.FailedJobResponse, error) {
+	reqURL := fmt.Sprintf("%v/jobs/failed/replay", c.config.URL)
+	var resp []*types.FailedJobResponse
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			errMessage := "error while replaying failed jobs"
+			return errors.FromError(err).SetMessage(errMessage).AppendReason(err.Error()).ExtendComponent(component)
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, &resp)
+	})
+
+	return resp, err
+}
+
+// SubscribeJobEvents streams the job status changes using Server-Sent Events
+// The returned channel is closed when the context is cancelled or the connection is lost
+func (c *HTTPClient) SubscribeJobEvents(ctx context.Context, filters *entities.JobEventFilters) (<-chan *types.JobEventResponse, error) {
+	reqURL := fmt.Sprintf("%v/jobs/stream", c.config.URL)
+
+	var qParams []string
+	if filters.ChainUUID != "" {
+		qParams = append(qParams, "chain_uuid="+filters.ChainUUID)
+	}
+
+	if len(filters.Labels) > 0 {
+		var labels []string
+		for key, value := range filters.Labels {
+			labels = append(labels, key+":"+value)
+		}
+		sort.Strings(labels)
+		qParams = append(qParams, "labels="+url.QueryEscape(strings.Join(labels, ",")))
+	}
+
+	if len(qParams) > 0 {
+		reqURL = reqURL + "?" + strings.Join(qParams, "&")
+	}
+
+	response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+	if err != nil {
+		errMessage := "error while subscribing to job events"
+		return nil, errors.FromError(err).SetMessage(errMessage).AppendReason(err.Error()).ExtendComponent(component)
+	}
+
+	if err = httputil.ParseResponse(ctx, response, nil); err != nil {
+		clientutils.CloseResponse(response)
+		return nil, err
+	}
+
+	events := make(chan *types.JobEventResponse)
+	go func() {
+		defer close(events)
+		defer clientutils.CloseResponse(response)
+
+		scanner := bufio.NewScanner(response.Body)
+		for scanner.Scan() {
+			data := strings.TrimPrefix(scanner.Text(), "data: ")
+			if data == scanner.Text() {
+				// Event names, heartbeats and separators
+				continue
+			}
+
+			event := &types.JobEventResponse{}
+			if err := json.Unmarshal([]byte(data), event); err != nil {
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
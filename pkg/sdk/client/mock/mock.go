@@ -100,6 +100,36 @@ func (mr *MockOrchestrateClientMockRecorder) SendTransferTransaction(ctx, reques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransferTransaction", reflect.TypeOf((*MockOrchestrateClient)(nil).SendTransferTransaction), ctx, request)
 }
 
+// SendTransactionBatch mocks base method
+func (m *MockOrchestrateClient) SendTransactionBatch(ctx context.Context, request *api.SendTransactionBatchRequest) ([]*api.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTransactionBatch", ctx, request)
+	ret0, _ := ret[0].([]*api.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTransactionBatch indicates an expected call of SendTransactionBatch
+func (mr *MockOrchestrateClientMockRecorder) SendTransactionBatch(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransactionBatch", reflect.TypeOf((*MockOrchestrateClient)(nil).SendTransactionBatch), ctx, request)
+}
+
+// SimulateTransaction mocks base method
+func (m *MockOrchestrateClient) SimulateTransaction(ctx context.Context, request *api.SendTransactionRequest) (*api.SimulateTransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateTransaction", ctx, request)
+	ret0, _ := ret[0].(*api.SimulateTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateTransaction indicates an expected call of SimulateTransaction
+func (mr *MockOrchestrateClientMockRecorder) SimulateTransaction(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateTransaction", reflect.TypeOf((*MockOrchestrateClient)(nil).SimulateTransaction), ctx, request)
+}
+
 // GetTxRequest mocks base method
 func (m *MockOrchestrateClient) GetTxRequest(ctx context.Context, txRequestUUID string) (*api.TransactionResponse, error) {
 	m.ctrl.T.Helper()
@@ -278,6 +308,95 @@ func (mr *MockOrchestrateClientMockRecorder) ResendJobTx(ctx, jobUUID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendJobTx", reflect.TypeOf((*MockOrchestrateClient)(nil).ResendJobTx), ctx, jobUUID)
 }
 
+// ApproveJob mocks base method
+func (m *MockOrchestrateClient) ApproveJob(ctx context.Context, jobUUID string, request *api.JobApprovalRequest) (*api.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveJob", ctx, jobUUID, request)
+	ret0, _ := ret[0].(*api.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveJob indicates an expected call of ApproveJob
+func (mr *MockOrchestrateClientMockRecorder) ApproveJob(ctx, jobUUID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveJob", reflect.TypeOf((*MockOrchestrateClient)(nil).ApproveJob), ctx, jobUUID, request)
+}
+
+// RejectJob mocks base method
+func (m *MockOrchestrateClient) RejectJob(ctx context.Context, jobUUID string, request *api.JobApprovalRequest) (*api.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectJob", ctx, jobUUID, request)
+	ret0, _ := ret[0].(*api.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectJob indicates an expected call of RejectJob
+func (mr *MockOrchestrateClientMockRecorder) RejectJob(ctx, jobUUID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectJob", reflect.TypeOf((*MockOrchestrateClient)(nil).RejectJob), ctx, jobUUID, request)
+}
+
+// GetJobApprovals mocks base method
+func (m *MockOrchestrateClient) GetJobApprovals(ctx context.Context, jobUUID string) ([]*api.JobApprovalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobApprovals", ctx, jobUUID)
+	ret0, _ := ret[0].([]*api.JobApprovalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobApprovals indicates an expected call of GetJobApprovals
+func (mr *MockOrchestrateClientMockRecorder) GetJobApprovals(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobApprovals", reflect.TypeOf((*MockOrchestrateClient)(nil).GetJobApprovals), ctx, jobUUID)
+}
+
+// SearchFailedJobs mocks base method
+func (m *MockOrchestrateClient) SearchFailedJobs(ctx context.Context, filters *entities.FailedJobFilters) ([]*api.FailedJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFailedJobs", ctx, filters)
+	ret0, _ := ret[0].([]*api.FailedJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFailedJobs indicates an expected call of SearchFailedJobs
+func (mr *MockOrchestrateClientMockRecorder) SearchFailedJobs(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFailedJobs", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchFailedJobs), ctx, filters)
+}
+
+// ReplayJob mocks base method
+func (m *MockOrchestrateClient) ReplayJob(ctx context.Context, jobUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayJob", ctx, jobUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplayJob indicates an expected call of ReplayJob
+func (mr *MockOrchestrateClientMockRecorder) ReplayJob(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayJob", reflect.TypeOf((*MockOrchestrateClient)(nil).ReplayJob), ctx, jobUUID)
+}
+
+// ReplayFailedJobs mocks base method
+func (m *MockOrchestrateClient) ReplayFailedJobs(ctx context.Context, request *api.ReplayFailedJobsRequest) ([]*api.FailedJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayFailedJobs", ctx, request)
+	ret0, _ := ret[0].([]*api.FailedJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayFailedJobs indicates an expected call of ReplayFailedJobs
+func (mr *MockOrchestrateClientMockRecorder) ReplayFailedJobs(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayFailedJobs", reflect.TypeOf((*MockOrchestrateClient)(nil).ReplayFailedJobs), ctx, request)
+}
+
 // SearchJob mocks base method
 func (m *MockOrchestrateClient) SearchJob(ctx context.Context, filters *entities.JobFilters) ([]*api.JobResponse, error) {
 	m.ctrl.T.Helper()
@@ -293,6 +412,21 @@ func (mr *MockOrchestrateClientMockRecorder) SearchJob(ctx, filters interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJob", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchJob), ctx, filters)
 }
 
+// SubscribeJobEvents mocks base method
+func (m *MockOrchestrateClient) SubscribeJobEvents(ctx context.Context, filters *entities.JobEventFilters) (<-chan *api.JobEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeJobEvents", ctx, filters)
+	ret0, _ := ret[0].(<-chan *api.JobEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeJobEvents indicates an expected call of SubscribeJobEvents
+func (mr *MockOrchestrateClientMockRecorder) SubscribeJobEvents(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeJobEvents", reflect.TypeOf((*MockOrchestrateClient)(nil).SubscribeJobEvents), ctx, filters)
+}
+
 // Checker mocks base method
 func (m *MockOrchestrateClient) Checker() healthcheck.Check {
 	m.ctrl.T.Helper()
@@ -397,6 +531,36 @@ func (mr *MockOrchestrateClientMockRecorder) UpdateAccount(ctx, address, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockOrchestrateClient)(nil).UpdateAccount), ctx, address, request)
 }
 
+// DisableAccount mocks base method
+func (m *MockOrchestrateClient) DisableAccount(ctx context.Context, address common.Address) (*api.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableAccount", ctx, address)
+	ret0, _ := ret[0].(*api.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableAccount indicates an expected call of DisableAccount
+func (mr *MockOrchestrateClientMockRecorder) DisableAccount(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAccount", reflect.TypeOf((*MockOrchestrateClient)(nil).DisableAccount), ctx, address)
+}
+
+// RotateAccount mocks base method
+func (m *MockOrchestrateClient) RotateAccount(ctx context.Context, address common.Address, request *api.RotateAccountRequest) (*api.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateAccount", ctx, address, request)
+	ret0, _ := ret[0].(*api.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateAccount indicates an expected call of RotateAccount
+func (mr *MockOrchestrateClientMockRecorder) RotateAccount(ctx, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAccount", reflect.TypeOf((*MockOrchestrateClient)(nil).RotateAccount), ctx, address, request)
+}
+
 // SignMessage mocks base method
 func (m *MockOrchestrateClient) SignMessage(ctx context.Context, address common.Address, request *types.SignMessageRequest) (string, error) {
 	m.ctrl.T.Helper()
@@ -632,6 +796,35 @@ func (mr *MockOrchestrateClientMockRecorder) DeregisterContract(ctx, name, tag i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterContract", reflect.TypeOf((*MockOrchestrateClient)(nil).DeregisterContract), ctx, name, tag)
 }
 
+// DeleteContract mocks base method
+func (m *MockOrchestrateClient) DeleteContract(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContract", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContract indicates an expected call of DeleteContract
+func (mr *MockOrchestrateClientMockRecorder) DeleteContract(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContract", reflect.TypeOf((*MockOrchestrateClient)(nil).DeleteContract), ctx, name)
+}
+
+// SetContractTag mocks base method
+func (m *MockOrchestrateClient) SetContractTag(ctx context.Context, name, tag string, req *api.SetContractTagRequest) (*api.ContractResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetContractTag", ctx, name, tag, req)
+	ret0, _ := ret[0].(*api.ContractResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetContractTag indicates an expected call of SetContractTag
+func (mr *MockOrchestrateClientMockRecorder) SetContractTag(ctx, name, tag, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContractTag", reflect.TypeOf((*MockOrchestrateClient)(nil).SetContractTag), ctx, name, tag, req)
+}
+
 // GetContract mocks base method
 func (m *MockOrchestrateClient) GetContract(ctx context.Context, name, tag string) (*api.ContractResponse, error) {
 	m.ctrl.T.Helper()
@@ -804,6 +997,36 @@ func (mr *MockTransactionClientMockRecorder) SendTransferTransaction(ctx, reques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransferTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendTransferTransaction), ctx, request)
 }
 
+// SendTransactionBatch mocks base method
+func (m *MockTransactionClient) SendTransactionBatch(ctx context.Context, request *api.SendTransactionBatchRequest) ([]*api.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTransactionBatch", ctx, request)
+	ret0, _ := ret[0].([]*api.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTransactionBatch indicates an expected call of SendTransactionBatch
+func (mr *MockTransactionClientMockRecorder) SendTransactionBatch(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransactionBatch", reflect.TypeOf((*MockTransactionClient)(nil).SendTransactionBatch), ctx, request)
+}
+
+// SimulateTransaction mocks base method
+func (m *MockTransactionClient) SimulateTransaction(ctx context.Context, request *api.SendTransactionRequest) (*api.SimulateTransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateTransaction", ctx, request)
+	ret0, _ := ret[0].(*api.SimulateTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateTransaction indicates an expected call of SimulateTransaction
+func (mr *MockTransactionClientMockRecorder) SimulateTransaction(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SimulateTransaction), ctx, request)
+}
+
 // GetTxRequest mocks base method
 func (m *MockTransactionClient) GetTxRequest(ctx context.Context, txRequestUUID string) (*api.TransactionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1028,6 +1251,95 @@ func (mr *MockJobClientMockRecorder) ResendJobTx(ctx, jobUUID interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendJobTx", reflect.TypeOf((*MockJobClient)(nil).ResendJobTx), ctx, jobUUID)
 }
 
+// ApproveJob mocks base method
+func (m *MockJobClient) ApproveJob(ctx context.Context, jobUUID string, request *api.JobApprovalRequest) (*api.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveJob", ctx, jobUUID, request)
+	ret0, _ := ret[0].(*api.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveJob indicates an expected call of ApproveJob
+func (mr *MockJobClientMockRecorder) ApproveJob(ctx, jobUUID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveJob", reflect.TypeOf((*MockJobClient)(nil).ApproveJob), ctx, jobUUID, request)
+}
+
+// RejectJob mocks base method
+func (m *MockJobClient) RejectJob(ctx context.Context, jobUUID string, request *api.JobApprovalRequest) (*api.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectJob", ctx, jobUUID, request)
+	ret0, _ := ret[0].(*api.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectJob indicates an expected call of RejectJob
+func (mr *MockJobClientMockRecorder) RejectJob(ctx, jobUUID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectJob", reflect.TypeOf((*MockJobClient)(nil).RejectJob), ctx, jobUUID, request)
+}
+
+// GetJobApprovals mocks base method
+func (m *MockJobClient) GetJobApprovals(ctx context.Context, jobUUID string) ([]*api.JobApprovalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobApprovals", ctx, jobUUID)
+	ret0, _ := ret[0].([]*api.JobApprovalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobApprovals indicates an expected call of GetJobApprovals
+func (mr *MockJobClientMockRecorder) GetJobApprovals(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobApprovals", reflect.TypeOf((*MockJobClient)(nil).GetJobApprovals), ctx, jobUUID)
+}
+
+// SearchFailedJobs mocks base method
+func (m *MockJobClient) SearchFailedJobs(ctx context.Context, filters *entities.FailedJobFilters) ([]*api.FailedJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFailedJobs", ctx, filters)
+	ret0, _ := ret[0].([]*api.FailedJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFailedJobs indicates an expected call of SearchFailedJobs
+func (mr *MockJobClientMockRecorder) SearchFailedJobs(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFailedJobs", reflect.TypeOf((*MockJobClient)(nil).SearchFailedJobs), ctx, filters)
+}
+
+// ReplayJob mocks base method
+func (m *MockJobClient) ReplayJob(ctx context.Context, jobUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayJob", ctx, jobUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplayJob indicates an expected call of ReplayJob
+func (mr *MockJobClientMockRecorder) ReplayJob(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayJob", reflect.TypeOf((*MockJobClient)(nil).ReplayJob), ctx, jobUUID)
+}
+
+// ReplayFailedJobs mocks base method
+func (m *MockJobClient) ReplayFailedJobs(ctx context.Context, request *api.ReplayFailedJobsRequest) ([]*api.FailedJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayFailedJobs", ctx, request)
+	ret0, _ := ret[0].([]*api.FailedJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayFailedJobs indicates an expected call of ReplayFailedJobs
+func (mr *MockJobClientMockRecorder) ReplayFailedJobs(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayFailedJobs", reflect.TypeOf((*MockJobClient)(nil).ReplayFailedJobs), ctx, request)
+}
+
 // SearchJob mocks base method
 func (m *MockJobClient) SearchJob(ctx context.Context, filters *entities.JobFilters) ([]*api.JobResponse, error) {
 	m.ctrl.T.Helper()
@@ -1043,6 +1355,21 @@ func (mr *MockJobClientMockRecorder) SearchJob(ctx, filters interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJob", reflect.TypeOf((*MockJobClient)(nil).SearchJob), ctx, filters)
 }
 
+// SubscribeJobEvents mocks base method
+func (m *MockJobClient) SubscribeJobEvents(ctx context.Context, filters *entities.JobEventFilters) (<-chan *api.JobEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeJobEvents", ctx, filters)
+	ret0, _ := ret[0].(<-chan *api.JobEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeJobEvents indicates an expected call of SubscribeJobEvents
+func (mr *MockJobClientMockRecorder) SubscribeJobEvents(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeJobEvents", reflect.TypeOf((*MockJobClient)(nil).SubscribeJobEvents), ctx, filters)
+}
+
 // MockMetricClient is a mock of MetricClient interface
 type MockMetricClient struct {
 	ctrl     *gomock.Controller
@@ -1193,6 +1520,36 @@ func (mr *MockAccountClientMockRecorder) UpdateAccount(ctx, address, request int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockAccountClient)(nil).UpdateAccount), ctx, address, request)
 }
 
+// DisableAccount mocks base method
+func (m *MockAccountClient) DisableAccount(ctx context.Context, address common.Address) (*api.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableAccount", ctx, address)
+	ret0, _ := ret[0].(*api.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableAccount indicates an expected call of DisableAccount
+func (mr *MockAccountClientMockRecorder) DisableAccount(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAccount", reflect.TypeOf((*MockAccountClient)(nil).DisableAccount), ctx, address)
+}
+
+// RotateAccount mocks base method
+func (m *MockAccountClient) RotateAccount(ctx context.Context, address common.Address, request *api.RotateAccountRequest) (*api.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateAccount", ctx, address, request)
+	ret0, _ := ret[0].(*api.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateAccount indicates an expected call of RotateAccount
+func (mr *MockAccountClientMockRecorder) RotateAccount(ctx, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAccount", reflect.TypeOf((*MockAccountClient)(nil).RotateAccount), ctx, address, request)
+}
+
 // SignMessage mocks base method
 func (m *MockAccountClient) SignMessage(ctx context.Context, address common.Address, request *types.SignMessageRequest) (string, error) {
 	m.ctrl.T.Helper()
@@ -1497,6 +1854,35 @@ func (mr *MockContractClientMockRecorder) DeregisterContract(ctx, name, tag inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterContract", reflect.TypeOf((*MockContractClient)(nil).DeregisterContract), ctx, name, tag)
 }
 
+// DeleteContract mocks base method
+func (m *MockContractClient) DeleteContract(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContract", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContract indicates an expected call of DeleteContract
+func (mr *MockContractClientMockRecorder) DeleteContract(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContract", reflect.TypeOf((*MockContractClient)(nil).DeleteContract), ctx, name)
+}
+
+// SetContractTag mocks base method
+func (m *MockContractClient) SetContractTag(ctx context.Context, name, tag string, req *api.SetContractTagRequest) (*api.ContractResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetContractTag", ctx, name, tag, req)
+	ret0, _ := ret[0].(*api.ContractResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetContractTag indicates an expected call of SetContractTag
+func (mr *MockContractClientMockRecorder) SetContractTag(ctx, name, tag, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContractTag", reflect.TypeOf((*MockContractClient)(nil).SetContractTag), ctx, name, tag, req)
+}
+
 // GetContract mocks base method
 func (m *MockContractClient) GetContract(ctx context.Context, name, tag string) (*api.ContractResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+var nextLinkRegexp = regexp.MustCompile(`<([^>]*)>;\s*rel="next"`)
+
+func paginationQueryParams(pagination *entities.Pagination) []string {
+	var qParams []string
+	if pagination.Limit > 0 {
+		qParams = append(qParams, "limit="+strconv.Itoa(pagination.Limit))
+	}
+
+	if pagination.Sort != "" {
+		qParams = append(qParams, "sort="+pagination.Sort)
+	}
+
+	if pagination.Next != "" {
+		qParams = append(qParams, "next="+url.QueryEscape(pagination.Next))
+	}
+
+	return qParams
+}
+
+// searchPages runs the search page by page from a copy of the pagination of the caller. A single page is searched
+// when a limit is set, otherwise the next pages are searched as well so that every result is returned
+func searchPages(pagination entities.Pagination, searchPage func(qParams []string) (next string, err error)) error {
+	for {
+		next, err := searchPage(paginationQueryParams(&pagination))
+		if err != nil || pagination.Limit > 0 || next == "" {
+			return err
+		}
+
+		pagination.Next = next
+	}
+}
+
+func withQueryParams(reqURL string, qParams ...[]string) string {
+	var params []string
+	for _, p := range qParams {
+		params = append(params, p...)
+	}
+
+	if len(params) == 0 {
+		return reqURL
+	}
+
+	return reqURL + "?" + strings.Join(params, "&")
+}
+
+// nextPageCursor extracts the cursor of the next page from the Link header, empty on the last page
+func nextPageCursor(response *http.Response) string {
+	match := nextLinkRegexp.FindStringSubmatch(response.Header.Get("Link"))
+	if match == nil {
+		return ""
+	}
+
+	link, err := url.Parse(match[1])
+	if err != nil {
+		return ""
+	}
+
+	return link.Query().Get("next")
+}
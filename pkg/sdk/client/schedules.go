@@ -6,6 +6,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
 	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
 
 	clientutils "github.com/consensys/orchestrate/pkg/toolkit/app/http/client-utils"
 )
@@ -48,14 +49,26 @@ func (c *HTTPClient) GetSchedules(ctx context.Context) ([]*types.ScheduleRespons
 	reqURL := fmt.Sprintf("%v/schedules", c.config.URL)
 	var resp []*types.ScheduleResponse
 
-	err := callWithBackOff(ctx, c.config.backOff, func() error {
-		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
-		if err != nil {
-			return err
-		}
+	err := searchPages(entities.Pagination{}, func(pageParams []string) (string, error) {
+		var page []*types.ScheduleResponse
+		next := ""
+		err := callWithBackOff(ctx, c.config.backOff, func() error {
+			response, err := clientutils.GetRequest(ctx, c.client, withQueryParams(reqURL, pageParams))
+			if err != nil {
+				return err
+			}
 
-		defer clientutils.CloseResponse(response)
-		return httputil.ParseResponse(ctx, response, &resp)
+			defer clientutils.CloseResponse(response)
+			if err := httputil.ParseResponse(ctx, response, &page); err != nil {
+				return err
+			}
+
+			next = nextPageCursor(response)
+			return nil
+		})
+
+		resp = append(resp, page...)
+		return next, err
 	})
 
 	return resp, err
@@ -78,6 +78,40 @@ func (c *HTTPClient) SendTransferTransaction(ctx context.Context, txRequest *typ
 	return resp, err
 }
 
+func (c *HTTPClient) SendTransactionBatch(ctx context.Context, batchRequest *types.SendTransactionBatchRequest) ([]*types.TransactionResponse, error) {
+	reqURL := fmt.Sprintf("%v/transactions/send-batch", c.config.URL)
+	var resp []*types.TransactionResponse
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, batchRequest)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, &resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) SimulateTransaction(ctx context.Context, txRequest *types.SendTransactionRequest) (*types.SimulateTransactionResponse, error) {
+	reqURL := fmt.Sprintf("%v/transactions/simulate", c.config.URL)
+	resp := &types.SimulateTransactionResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, txRequest)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return httputil.ParseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
 func (c *HTTPClient) GetTxRequest(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error) {
 	reqURL := fmt.Sprintf("%v/transactions/%v", c.config.URL, txRequestUUID)
 	resp := &types.TransactionResponse{}
@@ -10,6 +10,7 @@ const authorizationKey authCtxKey = "authorization"
 const apiKey authCtxKey = "api-key"
 const tenantID authCtxKey = "x-tenant-id"
 const username authCtxKey = "x-username"
+const tenantQuota authCtxKey = "x-tenant-quota"
 
 func WithAuthorization(ctx context.Context, authorization string) context.Context {
 	return context.WithValue(ctx, authorizationKey, authorization)
@@ -46,3 +47,14 @@ func UsernameFromContext(ctx context.Context) string {
 	username, _ := ctx.Value(username).(string)
 	return username
 }
+
+// WithTenantQuota marks the internal requests made with the context as counted in the quota of the tenant they are
+// made for
+func WithTenantQuota(ctx context.Context) context.Context {
+	return context.WithValue(ctx, tenantQuota, true)
+}
+
+func TenantQuotaFromContext(ctx context.Context) bool {
+	counted, _ := ctx.Value(tenantQuota).(bool)
+	return counted
+}
@@ -9,6 +9,9 @@ const (
 	APIKeyHeader        = "X-API-Key"
 	TenantIDHeader      = "X-Tenant-ID"
 	UsernameHeader      = "X-Username"
+	// TenantQuotaHeader marks the internal requests authenticated with the API key made on behalf of a tenant and
+	// counted in its quota by the chain proxy
+	TenantQuotaHeader = "X-Tenant-Quota"
 )
 
 func GetAPIKeyHeaderValue(req *http.Request) string {
@@ -50,3 +53,11 @@ func GetUsernameHeaderValue(req *http.Request) string {
 func AddUsernameHeaderValue(req *http.Request, value string) {
 	req.Header.Add(UsernameHeader, value)
 }
+
+func GetTenantQuotaHeaderValue(req *http.Request) string {
+	return req.Header.Get(TenantQuotaHeader)
+}
+
+func AddTenantQuotaHeaderValue(req *http.Request, value string) {
+	req.Header.Add(TenantQuotaHeader, value)
+}
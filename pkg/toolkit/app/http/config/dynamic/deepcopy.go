@@ -414,6 +414,11 @@ func (in *Middleware) DeepCopyInto(out *Middleware) {
 		*out = new(HTTPTrace)
 		**out = **in
 	}
+	if in.RPCPolicy != nil {
+		in, out := &in.RPCPolicy, &out.RPCPolicy
+		*out = new(RPCPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Mock != nil {
 		in, out := &in.Mock, &out.Mock
 		*out = new(Mock)
@@ -490,6 +495,32 @@ func (in *RateLimit) DeepCopy() *RateLimit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RPCPolicy) DeepCopyInto(out *RPCPolicy) {
+	*out = *in
+	if in.AllowedMethods != nil {
+		in, out := &in.AllowedMethods, &out.AllowedMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedMethods != nil {
+		in, out := &in.DeniedMethods, &out.DeniedMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RPCPolicy.
+func (in *RPCPolicy) DeepCopy() *RPCPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RPCPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReverseProxy) DeepCopyInto(out *ReverseProxy) {
 	*out = *in
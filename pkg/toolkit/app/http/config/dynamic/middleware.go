@@ -22,6 +22,7 @@ type Middleware struct {
 	RateLimit    *RateLimit    `json:"rateLimit,omitempty" toml:"rateLimit,omitempty" yaml:"rateLimit,omitempty"`
 	HTTPTrace    *HTTPTrace    `json:"httpTrace,omitempty" toml:"httpTrace,omitempty" yaml:"httpTrace,omitempty"`
 	HTTPCache    *HTTPCache    `json:"httpCache,omitempty" toml:"httpCache,omitempty" yaml:"httpCache,omitempty"`
+	RPCPolicy    *RPCPolicy    `json:"rpcPolicy,omitempty" toml:"rpcPolicy,omitempty" yaml:"rpcPolicy,omitempty"`
 	Mock         *Mock         `json:"mock,omitempty" toml:"mock,omitempty" yaml:"mock,omitempty"`
 	AccessLog    *AccessLog    `json:"accessLog,omitempty" toml:"accessLog,omitempty" yaml:"accessLog,omitempty"`
 }
@@ -149,8 +150,16 @@ type Cookie struct {
 type Server struct {
 	URL    string `json:"url,omitempty" toml:"url,omitempty" yaml:"url,omitempty" label:"-"`
 	Weight int    `json:"weight,omitempty" toml:"weight,omitempty" yaml:"weight,omitempty" label:"-"`
+	// Status is set by providers checking the health of the server, servers DOWN are ejected from the load balancer
+	Status string `json:"status,omitempty" toml:"status,omitempty" yaml:"status,omitempty" label:"-"`
 }
 
+// Health status of a server
+const (
+	ServerStatusUp   = "UP"
+	ServerStatusDown = "DOWN"
+)
+
 // +k8s:deepcopy-gen=true
 
 type RateLimit struct {
@@ -169,6 +178,18 @@ type HTTPCache struct {
 
 // +k8s:deepcopy-gen=true
 
+// RPCPolicy restricts the JSON-RPC methods, the batch size and the number of requests per tenant forwarded to a chain
+type RPCPolicy struct {
+	AllowedMethods []string      `json:"allowedMethods,omitempty" toml:"allowedMethods,omitempty" yaml:"allowedMethods,omitempty" label:"-"`
+	DeniedMethods  []string      `json:"deniedMethods,omitempty" toml:"deniedMethods,omitempty" yaml:"deniedMethods,omitempty" label:"-"`
+	MaxBatchSize   int           `json:"maxBatchSize,omitempty" toml:"maxBatchSize,omitempty" yaml:"maxBatchSize,omitempty" label:"-"`
+	QuotaRequests  int           `json:"quotaRequests,omitempty" toml:"quotaRequests,omitempty" yaml:"quotaRequests,omitempty" label:"-"`
+	QuotaPeriod    time.Duration `json:"quotaPeriod,omitempty" toml:"quotaPeriod,omitempty" yaml:"quotaPeriod,omitempty" label:"-"`
+	KeySuffix      string        `json:"key_suffix,omitempty" toml:"key_suffix,omitempty" yaml:"key_suffix,omitempty" label:"-"`
+}
+
+// +k8s:deepcopy-gen=true
+
 type HTTPTrace struct{}
 
 // +k8s:deepcopy-gen=true
@@ -66,7 +66,15 @@ func NewServiceInfos(services map[string]*dynamic.Service) map[string]*ServiceIn
 	if len(services) > 0 {
 		infos := make(map[string]*ServiceInfo, len(services))
 		for k, v := range services {
-			infos[k] = &ServiceInfo{Service: v, Status: StatusEnabled}
+			info := &ServiceInfo{Service: v, Status: StatusEnabled}
+			if v.ReverseProxy != nil && v.ReverseProxy.LoadBalancer != nil {
+				for _, srv := range v.ReverseProxy.LoadBalancer.Servers {
+					if srv.Status != "" {
+						info.UpdateServerStatus(srv.URL, srv.Status)
+					}
+				}
+			}
+			infos[k] = info
 		}
 		return infos
 
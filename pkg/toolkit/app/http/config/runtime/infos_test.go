@@ -6,6 +6,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
 	testutilsdynamic "github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic/testutils"
 	"github.com/stretchr/testify/assert"
 )
@@ -37,3 +38,24 @@ func TestInfos(t *testing.T) {
 	assert.Len(t, rtInfos["ep-bar"], 1)
 	assert.Contains(t, rtInfos["ep-bar"], "router-proxy@provider1")
 }
+
+func TestNewServiceInfosServerStatus(t *testing.T) {
+	infos := NewServiceInfos(map[string]*dynamic.Service{
+		"chain@provider": {
+			ReverseProxy: &dynamic.ReverseProxy{
+				LoadBalancer: &dynamic.LoadBalancer{
+					Servers: []*dynamic.Server{
+						{URL: "http://node-1:8545", Status: dynamic.ServerStatusUp},
+						{URL: "http://node-2:8545", Status: dynamic.ServerStatusDown},
+						{URL: "http://node-3:8545"},
+					},
+				},
+			},
+		},
+	})
+
+	assert.Equal(t, map[string]string{
+		"http://node-1:8545": dynamic.ServerStatusUp,
+		"http://node-2:8545": dynamic.ServerStatusDown,
+	}, infos["chain@provider"].GetAllStatus())
+}
@@ -77,10 +77,23 @@ func (b *Builder) Build(ctx context.Context, name string, configuration interfac
 		options = append(options, roundrobin.EnableStickySession(roundrobin.NewStickySessionWithOptions(cookieName, opts)))
 	}
 
+	// Servers DOWN are ejected, unless all servers are DOWN in which case traffic keeps being sent to all of them
+	upServers := make([]int, 0, len(cfg.Servers))
+	for i, server := range cfg.Servers {
+		if server.Status != dynamic.ServerStatusDown {
+			upServers = append(upServers, i)
+		}
+	}
+	if len(upServers) == 0 {
+		for i := range cfg.Servers {
+			upServers = append(upServers, i)
+		}
+	}
+
 	return func(h http.Handler) http.Handler {
 		lb, _ := roundrobin.New(h, options...)
-		for i, server := range cfg.Servers {
-			_ = lb.UpsertServer(urls[i], roundrobin.Weight(server.Weight))
+		for _, i := range upServers {
+			_ = lb.UpsertServer(urls[i], roundrobin.Weight(cfg.Servers[i].Weight))
 		}
 		return lb
 	}, nil, nil
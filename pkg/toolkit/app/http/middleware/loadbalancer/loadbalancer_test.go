@@ -3,8 +3,13 @@
 package loadbalancer
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -12,3 +17,45 @@ func TestBuilder(t *testing.T) {
 	b := NewBuilder()
 	require.NotNil(t, b, "Builder should have been created")
 }
+
+func TestBuild(t *testing.T) {
+	b := NewBuilder()
+
+	serveHosts := func(t *testing.T, servers []*dynamic.Server, count int) map[string]int {
+		mid, _, err := b.Build(context.Background(), "test", &dynamic.LoadBalancer{Servers: servers})
+		require.NoError(t, err)
+
+		hosts := make(map[string]int)
+		h := mid(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			hosts[req.URL.Host]++
+		}))
+		for i := 0; i < count; i++ {
+			h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "http://localhost", nil))
+		}
+		return hosts
+	}
+
+	t.Run("should eject servers DOWN", func(t *testing.T) {
+		hosts := serveHosts(t, []*dynamic.Server{
+			{URL: "http://node-1:8545", Status: dynamic.ServerStatusUp},
+			{URL: "http://node-2:8545", Status: dynamic.ServerStatusDown},
+			{URL: "http://node-3:8545"},
+		}, 4)
+
+		assert.Equal(t, map[string]int{"node-1:8545": 2, "node-3:8545": 2}, hosts)
+	})
+
+	t.Run("should keep all servers if they are all DOWN", func(t *testing.T) {
+		hosts := serveHosts(t, []*dynamic.Server{
+			{URL: "http://node-1:8545", Status: dynamic.ServerStatusDown},
+			{URL: "http://node-2:8545", Status: dynamic.ServerStatusDown},
+		}, 2)
+
+		assert.Equal(t, map[string]int{"node-1:8545": 1, "node-2:8545": 1}, hosts)
+	})
+
+	t.Run("should fail if no server", func(t *testing.T) {
+		_, _, err := b.Build(context.Background(), "test", &dynamic.LoadBalancer{})
+		assert.Error(t, err)
+	})
+}
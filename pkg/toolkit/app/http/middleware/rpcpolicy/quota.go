@@ -0,0 +1,45 @@
+package rpcpolicy
+
+import (
+	"sync"
+	"time"
+)
+
+// QuotaManager counts the requests consumed by each key over fixed windows of time
+type QuotaManager struct {
+	mux     *sync.Mutex
+	windows map[string]*quotaWindow
+}
+
+type quotaWindow struct {
+	start time.Time
+	count int
+}
+
+func NewQuotaManager() *QuotaManager {
+	return &QuotaManager{
+		mux:     &sync.Mutex{},
+		windows: make(map[string]*quotaWindow),
+	}
+}
+
+// Take consumes n requests from the quota of the key. If the quota is exceeded nothing is consumed and the time
+// remaining before the quota resets is returned
+func (m *QuotaManager) Take(key string, n, limit int, period time.Duration) (ok bool, retryIn time.Duration) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	now := time.Now()
+	window, exists := m.windows[key]
+	if !exists || now.Sub(window.start) >= period {
+		window = &quotaWindow{start: now}
+		m.windows[key] = window
+	}
+
+	if window.count+n > limit {
+		return false, window.start.Add(period).Sub(now)
+	}
+
+	window.count += n
+	return true, 0
+}
@@ -0,0 +1,31 @@
+// +build unit
+
+package rpcpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaManager(t *testing.T) {
+	m := NewQuotaManager()
+
+	ok, _ := m.Take("foo", 2, 3, 50*time.Millisecond)
+	assert.True(t, ok)
+
+	ok, retryIn := m.Take("foo", 2, 3, 50*time.Millisecond)
+	assert.False(t, ok, "quota should be exceeded")
+	assert.True(t, retryIn > 0 && retryIn <= 50*time.Millisecond)
+
+	ok, _ = m.Take("foo", 1, 3, 50*time.Millisecond)
+	assert.True(t, ok, "rejected requests should not be consumed")
+
+	ok, _ = m.Take("bar", 3, 3, 50*time.Millisecond)
+	assert.True(t, ok, "quotas should be per key")
+
+	time.Sleep(60 * time.Millisecond)
+	ok, _ = m.Take("foo", 3, 3, 50*time.Millisecond)
+	assert.True(t, ok, "quota should have been reset")
+}
@@ -0,0 +1,287 @@
+package rpcpolicy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strings"
+
+	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+)
+
+const component = "http.rpc-policy"
+
+// JSON-RPC error codes returned on policy violations
+const (
+	ParseErrorCode       = -32700
+	InvalidRequestCode   = -32600
+	MethodNotAllowedCode = -32601
+	LimitExceededCode    = -32005
+)
+
+type Builder struct {
+	quotaManager *QuotaManager
+}
+
+func NewBuilder(quotaManager *QuotaManager) *Builder {
+	return &Builder{
+		quotaManager: quotaManager,
+	}
+}
+
+func (b *Builder) Build(_ context.Context, _ string, configuration interface{}) (mid func(http.Handler) http.Handler, respModifier func(resp *http.Response) error, err error) {
+	cfg, ok := configuration.(*dynamic.RPCPolicy)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid configuration type (expected %T but got %T)", cfg, configuration)
+	}
+
+	if cfg.MaxBatchSize < 0 || cfg.QuotaRequests < 0 || cfg.QuotaPeriod < 0 {
+		return nil, nil, fmt.Errorf("max batch size and quota should be >= 0")
+	}
+
+	m := New(b.quotaManager, cfg)
+	return m.Handler, nil, nil
+}
+
+// RPCPolicy rejects the JSON-RPC requests calling methods which are not allowed, the batches which are too large and
+// the requests of tenants which exceeded their quota. Internal requests authenticated with the API key are not restricted,
+// unless they are made on behalf of a tenant with the tenant quota header, e.g. transaction simulations
+type RPCPolicy struct {
+	quotaManager *QuotaManager
+	cfg          *dynamic.RPCPolicy
+	logger       *log.Logger
+}
+
+type jsonRPCRequest struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+// errAmbiguousMethod is returned for messages which could be read as calling another method than the one checked, nodes
+// not resolving duplicate or case-variant keys as the policy does
+var errAmbiguousMethod = errors.New("duplicate or case-variant method key")
+
+// UnmarshalJSON takes the method from the exact "method" key only and rejects messages with duplicate or case-variant
+// method keys
+func (r *jsonRPCRequest) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil {
+		return err
+	} else if tok != json.Delim('{') {
+		return fmt.Errorf("JSON-RPC request must be an object")
+	}
+
+	fields := make(map[string]json.RawMessage)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key := tok.(string)
+
+		var value json.RawMessage
+		if err = dec.Decode(&value); err != nil {
+			return err
+		}
+
+		if strings.EqualFold(key, "method") {
+			if _, ok := fields["method"]; ok || key != "method" {
+				return errAmbiguousMethod
+			}
+		}
+		fields[key] = value
+	}
+
+	r.ID = fields["id"]
+	if method, ok := fields["method"]; ok {
+		return json.Unmarshal(method, &r.Method)
+	}
+
+	return nil
+}
+
+type jsonRPCResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   *jsonRPCError   `json:"error"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func New(quotaManager *QuotaManager, cfg *dynamic.RPCPolicy) *RPCPolicy {
+	return &RPCPolicy{
+		quotaManager: quotaManager,
+		cfg:          cfg,
+		logger:       log.NewLogger().SetComponent(component),
+	}
+}
+
+func (p *RPCPolicy) Handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		p.ServeHTTP(rw, req, h)
+	})
+}
+
+func (p *RPCPolicy) ServeHTTP(rw http.ResponseWriter, req *http.Request, next http.Handler) {
+	userInfo := multitenancy.UserInfoValue(req.Context())
+	if userInfo != nil && userInfo.AuthMode == multitenancy.AuthMethodAPIKey && authutils.GetTenantQuotaHeaderValue(req) != "true" {
+		next.ServeHTTP(rw, req)
+		return
+	}
+
+	// JSON-RPC messages sent over other methods, e.g. websockets, cannot be inspected
+	if req.Method != http.MethodPost {
+		writeError(rw, http.StatusMethodNotAllowed, nil, InvalidRequestCode, fmt.Sprintf("method %s not allowed by the chain proxy", req.Method))
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		writeError(rw, http.StatusBadRequest, nil, ParseErrorCode, "failed to read request body")
+		return
+	}
+	_ = req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	var msgs []*jsonRPCRequest
+	trimmedBody := bytes.TrimSpace(body)
+	isBatch := len(trimmedBody) > 0 && trimmedBody[0] == '['
+	if isBatch {
+		err = json.Unmarshal(body, &msgs)
+	} else {
+		msg := &jsonRPCRequest{}
+		err = json.Unmarshal(body, msg)
+		msgs = append(msgs, msg)
+	}
+	logger := p.logger.WithContext(req.Context())
+
+	if errors.Is(err, errAmbiguousMethod) {
+		logger.Warn("JSON-RPC request with ambiguous method rejected by the chain proxy policy")
+		writeError(rw, http.StatusOK, nil, InvalidRequestCode, "invalid JSON-RPC request with duplicate or case-variant method keys")
+		return
+	}
+	if err != nil {
+		writeError(rw, http.StatusOK, nil, ParseErrorCode, "invalid JSON-RPC request")
+		return
+	}
+
+	for _, msg := range msgs {
+		if msg == nil {
+			writeError(rw, http.StatusOK, nil, InvalidRequestCode, "invalid JSON-RPC request in batch")
+			return
+		}
+	}
+
+	if isBatch && p.cfg.MaxBatchSize > 0 && len(msgs) > p.cfg.MaxBatchSize {
+		logger.WithField("batch_size", len(msgs)).Warn("JSON-RPC batch rejected by the chain proxy policy")
+		writeError(rw, http.StatusOK, nil, InvalidRequestCode, fmt.Sprintf("batch of %d requests exceeds the maximum size of %d", len(msgs), p.cfg.MaxBatchSize))
+		return
+	}
+
+	if resps, denied := p.checkMethods(msgs); denied {
+		logger.Warn("JSON-RPC methods rejected by the chain proxy policy")
+		if isBatch {
+			writeJSON(rw, http.StatusOK, resps)
+		} else {
+			writeJSON(rw, http.StatusOK, resps[0])
+		}
+		return
+	}
+
+	if p.cfg.QuotaRequests > 0 && p.cfg.QuotaPeriod > 0 {
+		tenantID := multitenancy.DefaultTenant
+		if userInfo != nil {
+			tenantID = userInfo.TenantID
+		}
+
+		key := fmt.Sprintf("%s-%s", p.cfg.KeySuffix, tenantID)
+		if ok, retryIn := p.quotaManager.Take(key, len(msgs), p.cfg.QuotaRequests, p.cfg.QuotaPeriod); !ok {
+			logger.WithField("tenant_id", tenantID).Warn("JSON-RPC quota exceeded")
+			rw.Header().Set("Retry-After", fmt.Sprintf("%v", math.Ceil(retryIn.Seconds())))
+			rw.Header().Set("X-Retry-In", retryIn.String())
+
+			var id json.RawMessage
+			if !isBatch {
+				id = msgs[0].ID
+			}
+			writeError(rw, http.StatusTooManyRequests, id, LimitExceededCode, fmt.Sprintf("quota of %d requests per %s exceeded", p.cfg.QuotaRequests, p.cfg.QuotaPeriod))
+			return
+		}
+	}
+
+	next.ServeHTTP(rw, req)
+}
+
+// checkMethods returns an error response for every message of a request calling a method which is not allowed. Messages
+// of a batch are rejected altogether
+func (p *RPCPolicy) checkMethods(msgs []*jsonRPCRequest) (resps []*jsonRPCResponse, denied bool) {
+	resps = make([]*jsonRPCResponse, len(msgs))
+	for idx, msg := range msgs {
+		if p.isAllowed(msg.Method) {
+			resps[idx] = newErrorResponse(msg.ID, InvalidRequestCode, "batch rejected because it calls methods which are not allowed")
+		} else {
+			denied = true
+			resps[idx] = newErrorResponse(msg.ID, MethodNotAllowedCode, fmt.Sprintf("method %s is not allowed", msg.Method))
+		}
+	}
+
+	return resps, denied
+}
+
+func (p *RPCPolicy) isAllowed(method string) bool {
+	if matchAny(p.cfg.DeniedMethods, method) {
+		return false
+	}
+
+	return len(p.cfg.AllowedMethods) == 0 || matchAny(p.cfg.AllowedMethods, method)
+}
+
+// matchAny indicates whether the method matches one of the patterns, a trailing "*" matching any suffix
+func matchAny(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if strings.HasSuffix(pattern, "*") && strings.HasPrefix(method, strings.TrimSuffix(pattern, "*")) {
+			return true
+		}
+		if pattern == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newErrorResponse(id json.RawMessage, code int, message string) *jsonRPCResponse {
+	return &jsonRPCResponse{
+		Version: "2.0",
+		ID:      id,
+		Error: &jsonRPCError{
+			Code:    code,
+			Message: message,
+		},
+	}
+}
+
+func writeError(rw http.ResponseWriter, status int, id json.RawMessage, code int, message string) {
+	writeJSON(rw, status, newErrorResponse(id, code, message))
+}
+
+func writeJSON(rw http.ResponseWriter, status int, resp interface{}) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	_ = json.NewEncoder(rw).Encode(resp)
+}
@@ -0,0 +1,200 @@
+// +build unit
+
+package rpcpolicy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCPolicy(t *testing.T) {
+	cfg := &dynamic.RPCPolicy{
+		AllowedMethods: []string{"eth_*", "net_version"},
+		DeniedMethods:  []string{"eth_sign"},
+		MaxBatchSize:   3,
+		QuotaRequests:  4,
+		QuotaPeriod:    time.Hour,
+		KeySuffix:      "chain-uuid",
+	}
+
+	serve := func(p *RPCPolicy, userInfo *multitenancy.UserInfo, method, body string) (rw *httptest.ResponseRecorder, forwarded bool) {
+		req := httptest.NewRequest(method, "http://localhost/proxy/chains/chain-uuid", strings.NewReader(body))
+		if userInfo != nil {
+			req = req.WithContext(multitenancy.WithUserInfo(req.Context(), userInfo))
+		}
+
+		rw = httptest.NewRecorder()
+		p.Handler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			forwarded = true
+			rw.WriteHeader(http.StatusOK)
+		})).ServeHTTP(rw, req)
+		return rw, forwarded
+	}
+
+	tenantUser := multitenancy.NewUserInfo("tenantFoo", "")
+
+	t.Run("should forward allowed methods", func(t *testing.T) {
+		p := New(NewQuotaManager(), cfg)
+
+		rw, forwarded := serve(p, tenantUser, http.MethodPost, `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`)
+		assert.True(t, forwarded)
+		assert.Equal(t, http.StatusOK, rw.Code)
+
+		_, forwarded = serve(p, tenantUser, http.MethodPost, `[{"jsonrpc":"2.0","id":1,"method":"net_version"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`)
+		assert.True(t, forwarded)
+	})
+
+	t.Run("should reject methods not allowed", func(t *testing.T) {
+		p := New(NewQuotaManager(), cfg)
+
+		rw, forwarded := serve(p, tenantUser, http.MethodPost, `{"jsonrpc":"2.0","id":1,"method":"admin_peers"}`)
+		assert.False(t, forwarded)
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.JSONEq(t, `{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method admin_peers is not allowed"}}`, rw.Body.String())
+
+		rw, forwarded = serve(p, tenantUser, http.MethodPost, `{"jsonrpc":"2.0","id":"a","method":"eth_sign"}`)
+		assert.False(t, forwarded)
+		assert.JSONEq(t, `{"jsonrpc":"2.0","id":"a","error":{"code":-32601,"message":"method eth_sign is not allowed"}}`, rw.Body.String())
+	})
+
+	t.Run("should reject batches calling methods not allowed", func(t *testing.T) {
+		p := New(NewQuotaManager(), cfg)
+
+		rw, forwarded := serve(p, tenantUser, http.MethodPost, `[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"debug_traceTransaction"}]`)
+		assert.False(t, forwarded)
+		assert.JSONEq(t, `[
+			{"jsonrpc":"2.0","id":1,"error":{"code":-32600,"message":"batch rejected because it calls methods which are not allowed"}},
+			{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"method debug_traceTransaction is not allowed"}}
+		]`, rw.Body.String())
+	})
+
+	t.Run("should reject batches too large", func(t *testing.T) {
+		p := New(NewQuotaManager(), cfg)
+
+		rw, forwarded := serve(p, tenantUser, http.MethodPost, `[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_chainId"},{"id":3,"method":"eth_chainId"},{"id":4,"method":"eth_chainId"}]`)
+		assert.False(t, forwarded)
+		assert.JSONEq(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"batch of 4 requests exceeds the maximum size of 3"}}`, rw.Body.String())
+	})
+
+	t.Run("should reject invalid JSON-RPC requests", func(t *testing.T) {
+		p := New(NewQuotaManager(), cfg)
+
+		rw, forwarded := serve(p, tenantUser, http.MethodPost, `{"method":`)
+		assert.False(t, forwarded)
+		assert.JSONEq(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"invalid JSON-RPC request"}}`, rw.Body.String())
+	})
+
+	t.Run("should reject requests with duplicate or case-variant method keys", func(t *testing.T) {
+		p := New(NewQuotaManager(), cfg)
+
+		for _, body := range []string{
+			`{"jsonrpc":"2.0","id":1,"method":"eth_chainId","method":"admin_peers"}`,
+			`{"jsonrpc":"2.0","id":1,"method":"eth_chainId","Method":"admin_peers"}`,
+			`{"jsonrpc":"2.0","id":1,"METHOD":"admin_peers"}`,
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId","mEthod":"debug_traceTransaction"}]`,
+		} {
+			rw, forwarded := serve(p, tenantUser, http.MethodPost, body)
+			assert.False(t, forwarded, body)
+			assert.JSONEq(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"invalid JSON-RPC request with duplicate or case-variant method keys"}}`, rw.Body.String())
+		}
+	})
+
+	t.Run("should reject batches with null requests", func(t *testing.T) {
+		p := New(NewQuotaManager(), cfg)
+
+		rw, forwarded := serve(p, tenantUser, http.MethodPost, `[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},null]`)
+		assert.False(t, forwarded)
+		assert.JSONEq(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"invalid JSON-RPC request in batch"}}`, rw.Body.String())
+	})
+
+	t.Run("should reject requests which are not POST", func(t *testing.T) {
+		p := New(NewQuotaManager(), cfg)
+
+		rw, forwarded := serve(p, tenantUser, http.MethodGet, "")
+		assert.False(t, forwarded)
+		assert.Equal(t, http.StatusMethodNotAllowed, rw.Code)
+	})
+
+	t.Run("should reject requests of tenants exceeding their quota", func(t *testing.T) {
+		p := New(NewQuotaManager(), cfg)
+
+		_, forwarded := serve(p, tenantUser, http.MethodPost, `[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_chainId"},{"id":3,"method":"eth_chainId"}]`)
+		assert.True(t, forwarded)
+		_, forwarded = serve(p, tenantUser, http.MethodPost, `{"id":4,"method":"eth_chainId"}`)
+		assert.True(t, forwarded)
+
+		rw, forwarded := serve(p, tenantUser, http.MethodPost, `{"id":5,"method":"eth_chainId"}`)
+		assert.False(t, forwarded)
+		assert.Equal(t, http.StatusTooManyRequests, rw.Code)
+		assert.Equal(t, "3600", rw.Header().Get("Retry-After"))
+		assert.JSONEq(t, `{"jsonrpc":"2.0","id":5,"error":{"code":-32005,"message":"quota of 4 requests per 1h0m0s exceeded"}}`, rw.Body.String())
+
+		// Quotas are per tenant
+		_, forwarded = serve(p, multitenancy.NewUserInfo("tenantBar", ""), http.MethodPost, `{"id":1,"method":"eth_chainId"}`)
+		assert.True(t, forwarded)
+	})
+
+	t.Run("should not restrict internal requests authenticated with the API key", func(t *testing.T) {
+		p := New(NewQuotaManager(), cfg)
+
+		_, forwarded := serve(p, multitenancy.NewAPIKeyUserInfo("api-key"), http.MethodPost, `{"id":1,"method":"admin_peers"}`)
+		assert.True(t, forwarded)
+	})
+
+	t.Run("should count internal requests made on behalf of a tenant in its quota", func(t *testing.T) {
+		p := New(NewQuotaManager(), cfg)
+		userInfo := multitenancy.NewAPIKeyUserInfo("api-key")
+		require.NoError(t, userInfo.ImpersonateTenant("tenantFoo"))
+
+		serveForTenant := func(body string) (*httptest.ResponseRecorder, bool) {
+			req := httptest.NewRequest(http.MethodPost, "http://localhost/proxy/chains/chain-uuid", strings.NewReader(body))
+			req = req.WithContext(multitenancy.WithUserInfo(req.Context(), userInfo))
+			authutils.AddTenantQuotaHeaderValue(req, "true")
+
+			rw := httptest.NewRecorder()
+			forwarded := false
+			p.Handler(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+				forwarded = true
+			})).ServeHTTP(rw, req)
+			return rw, forwarded
+		}
+
+		_, forwarded := serveForTenant(`[{"id":1,"method":"eth_call"},{"id":2,"method":"eth_estimateGas"},{"id":3,"method":"eth_gasPrice"}]`)
+		assert.True(t, forwarded)
+		_, forwarded = serveForTenant(`{"id":4,"method":"eth_getTransactionCount"}`)
+		assert.True(t, forwarded)
+
+		rw, forwarded := serveForTenant(`{"id":5,"method":"eth_call"}`)
+		assert.False(t, forwarded)
+		assert.Equal(t, http.StatusTooManyRequests, rw.Code)
+
+		// The quota is shared with the requests of the tenant
+		rw, forwarded = serve(p, tenantUser, http.MethodPost, `{"id":6,"method":"eth_call"}`)
+		assert.False(t, forwarded)
+		assert.Equal(t, http.StatusTooManyRequests, rw.Code)
+	})
+}
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder(NewQuotaManager())
+
+	mid, _, err := b.Build(context.Background(), "test", &dynamic.RPCPolicy{})
+	require.NoError(t, err)
+	assert.NotNil(t, mid)
+
+	_, _, err = b.Build(context.Background(), "test", &dynamic.RPCPolicy{MaxBatchSize: -1})
+	assert.Error(t, err)
+
+	_, _, err = b.Build(context.Background(), "test", &dynamic.RateLimit{})
+	assert.Error(t, err)
+}
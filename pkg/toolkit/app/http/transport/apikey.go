@@ -30,6 +30,9 @@ func (t *XAPIKeyHeadersTransport) RoundTrip(req *http.Request) (*http.Response,
 		authutils.AddAPIKeyHeaderValue(req, t.apiKey)
 		if userInfo.TenantID != "" && userInfo.TenantID != multitenancy.WildcardTenant {
 			authutils.AddTenantIDHeaderValue(req, userInfo.TenantID)
+			if authutils.TenantQuotaFromContext(req.Context()) {
+				authutils.AddTenantQuotaHeaderValue(req, "true")
+			}
 		}
 		if userInfo.Username != "" {
 			authutils.AddUsernameHeaderValue(req, userInfo.Username)
@@ -60,6 +60,14 @@ func TestAuthAPIKeyHeadersTransport(t *testing.T) {
 	assert.Equal(t, 1, mockTransport.roundTrips, "Mock transport should have been called")
 	authorization := authutils.GetAPIKeyHeaderValue(req)
 	assert.Equal(t, "test-auth", authorization, "Authorization header should be empty")
+	assert.Empty(t, authutils.GetTenantQuotaHeaderValue(req))
+
+	// Test marking requests made on behalf of a tenant as counted in its quota
+	ctxThree := authutils.WithTenantQuota(multitenancy.WithUserInfo(context.Background(), multitenancy.NewUserInfo("tenantFoo", "")))
+	req, _ = http.NewRequestWithContext(ctxThree, http.MethodGet, "", nil)
+	_, _ = tt.RoundTrip(req)
+	assert.Equal(t, "tenantFoo", authutils.GetTenantIDHeaderValue(req))
+	assert.Equal(t, "true", authutils.GetTenantQuotaHeaderValue(req))
 }
 
 type Mock409Transport struct {
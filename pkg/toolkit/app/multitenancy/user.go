@@ -143,6 +143,17 @@ func (u *UserInfo) HasUsernameAccess(username string) bool {
 	return false
 }
 
+// IsInternal indicates whether the user is not an end user authenticated with a token, i.e. an Orchestrate service
+// authenticated with the API key or any user when multitenancy is disabled
+func (u *UserInfo) IsInternal() bool {
+	return u.AuthMode != AuthMethodJWT
+}
+
+// IsTenantAdmin indicates whether the user administrates its tenant rather than acting as one of its users
+func (u *UserInfo) IsTenantAdmin() bool {
+	return u.IsInternal() || u.Username == ""
+}
+
 func genAllowedTenants(tenantID string) []string {
 	if tenantID == WildcardTenant {
 		return []string{WildcardTenant}
@@ -43,6 +43,17 @@ func (err *Error) ExtendComponent(name string) *Error {
 	return err
 }
 
+// SetExtra sets an extra information on the error
+func (err *Error) SetExtra(key, value string) *Error {
+	if err != nil {
+		if err.Extra == nil {
+			err.Extra = make(map[string]string)
+		}
+		err.Extra[key] = value
+	}
+	return err
+}
+
 func (err *Error) AppendReason(reason string) *Error {
 	err.Message = fmt.Sprintf("%v (%v)", err.Message, reason)
 	return err
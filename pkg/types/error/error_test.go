@@ -27,3 +27,8 @@ func TestExtendComponent(t *testing.T) {
 	e = e.ExtendComponent("bar")
 	assert.Equal(t, "bar.foo", e.GetComponent(), "Should extend component correctly")
 }
+
+func TestSetExtra(t *testing.T) {
+	e := New(0, "test").SetExtra("data", "0x08c379a0")
+	assert.Equal(t, map[string]string{"data": "0x08c379a0"}, e.GetExtra(), "Should set extra correctly")
+}
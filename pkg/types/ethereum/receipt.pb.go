@@ -211,6 +211,16 @@ type Receipt struct {
 	PrivacyGroupId string `protobuf:"bytes,19,opt,name=privacyGroupId,proto3" json:"privacyGroupId,omitempty"`
 	ContractName   string `protobuf:"bytes,20,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
 	ContractTag    string `protobuf:"bytes,21,opt,name=contract_tag,json=contractTag,proto3" json:"contract_tag,omitempty"`
+	// Signature of the method called by the transaction
+	// e.g transfer(address,uint256)
+	Method string `protobuf:"bytes,22,opt,name=method,proto3" json:"method,omitempty"`
+	// Arguments of the method call decoded against the contract ABI
+	DecodedInput map[string]string `protobuf:"bytes,23,rep,name=decoded_input,json=decodedInput,proto3" json:"decoded_input,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Signature of the custom error which reverted the transaction
+	// e.g InsufficientBalance(uint256,uint256)
+	RevertError string `protobuf:"bytes,24,opt,name=revert_error,json=revertError,proto3" json:"revert_error,omitempty"`
+	// Arguments of the custom error decoded against the contract ABI
+	DecodedRevertError map[string]string `protobuf:"bytes,25,rep,name=decoded_revert_error,json=decodedRevertError,proto3" json:"decoded_revert_error,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *Receipt) Reset() {
@@ -378,6 +388,34 @@ func (x *Receipt) GetContractTag() string {
 	return ""
 }
 
+func (x *Receipt) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Receipt) GetDecodedInput() map[string]string {
+	if x != nil {
+		return x.DecodedInput
+	}
+	return nil
+}
+
+func (x *Receipt) GetRevertError() string {
+	if x != nil {
+		return x.RevertError
+	}
+	return ""
+}
+
+func (x *Receipt) GetDecodedRevertError() map[string]string {
+	if x != nil {
+		return x.DecodedRevertError
+	}
+	return nil
+}
+
 var File_pkg_types_ethereum_receipt_proto protoreflect.FileDescriptor
 
 var file_pkg_types_ethereum_receipt_proto_rawDesc = []byte{
@@ -408,7 +446,7 @@ var file_pkg_types_ethereum_receipt_proto_rawDesc = []byte{
 	0x6f, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
 	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
 	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x84, 0x05, 0x0a, 0x07, 0x52, 0x65,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xee, 0x07, 0x0a, 0x07, 0x52, 0x65,
 	0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d,
 	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
@@ -449,10 +487,33 @@ var file_pkg_types_ethereum_receipt_proto_rawDesc = []byte{
 	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
 	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x15, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x54, 0x61, 0x67,
-	0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x79, 0x73, 0x2f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x65,
-	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x48, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x5b, 0x0a, 0x14, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x19, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12,
+	0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x1a, 0x3f, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x17, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x79, 0x73, 0x2f, 0x6f, 0x72, 0x63, 0x68, 0x65, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -467,20 +528,24 @@ func file_pkg_types_ethereum_receipt_proto_rawDescGZIP() []byte {
 	return file_pkg_types_ethereum_receipt_proto_rawDescData
 }
 
-var file_pkg_types_ethereum_receipt_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_types_ethereum_receipt_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_pkg_types_ethereum_receipt_proto_goTypes = []interface{}{
 	(*Log)(nil),     // 0: ethereum.Log
 	(*Receipt)(nil), // 1: ethereum.Receipt
 	nil,             // 2: ethereum.Log.DecodedDataEntry
+	nil,             // 3: ethereum.Receipt.DecodedInputEntry
+	nil,             // 4: ethereum.Receipt.DecodedRevertErrorEntry
 }
 var file_pkg_types_ethereum_receipt_proto_depIdxs = []int32{
 	2, // 0: ethereum.Log.decoded_data:type_name -> ethereum.Log.DecodedDataEntry
 	0, // 1: ethereum.Receipt.logs:type_name -> ethereum.Log
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: ethereum.Receipt.decoded_input:type_name -> ethereum.Receipt.DecodedInputEntry
+	4, // 3: ethereum.Receipt.decoded_revert_error:type_name -> ethereum.Receipt.DecodedRevertErrorEntry
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_pkg_types_ethereum_receipt_proto_init() }
@@ -520,7 +585,7 @@ func file_pkg_types_ethereum_receipt_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_types_ethereum_receipt_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -10,6 +10,7 @@ const (
 	StoreIDLabel      = "storeID"
 	ScheduleUUIDLabel = "scheduleUUID"
 	JobUUIDLabel      = "jobUUID"
+	RequestIDLabel    = "requestID"
 
 	TxFromLabel      = "txFrom"
 	TxFromOneTimeKey = "one-time-key"
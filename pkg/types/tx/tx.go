@@ -53,7 +53,6 @@ func (m *TxRequest) Envelope() (*Envelope, error) {
 	if m.GetParams() != nil {
 		_ = envelope.
 			MustSetDataString(m.GetParams().GetData()).
-			MustSetRawString(m.GetParams().GetRaw()).
 			SetMethodSignature(m.GetParams().GetMethodSignature()).
 			SetArgs(m.GetParams().GetArgs()).
 			SetPrivateFor(m.GetParams().GetPrivateFor()).
@@ -64,6 +63,12 @@ func (m *TxRequest) Envelope() (*Envelope, error) {
 			SetPrivacyGroupID(m.GetParams().GetPrivacyGroupId()).
 			SetAccessList(m.GetParams().GetAccessList()).
 			SetTransactionType(m.GetParams().GetTransactionType())
+
+		if raw := m.GetParams().GetRaw(); raw != "" {
+			if err := envelope.SetRawString(raw); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	errs := envelope.loadPtrFields(m.GetParams().GetGas(),
@@ -105,9 +110,14 @@ func (m *TxResponse) Envelope() (*Envelope, error) {
 	if m.GetTransaction() != nil {
 		_ = envelope.
 			MustSetDataString(m.GetTransaction().GetData()).
-			MustSetRawString(m.GetTransaction().GetRaw()).
 			SetAccessList(m.GetTransaction().GetAccessList()).
 			SetTransactionType(m.GetTransaction().GetTxType())
+
+		if raw := m.GetTransaction().GetRaw(); raw != "" {
+			if err := envelope.SetRawString(raw); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	errs := envelope.loadPtrFields(m.GetTransaction().GetGas(),
@@ -37,6 +37,17 @@ func TestEnvelope(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestEnvelope_InvalidRaw(t *testing.T) {
+	envelope := &TxRequest{
+		Id:     uuid.Must(uuid.NewV4()).String(),
+		Chain:  "testChain",
+		Params: &Params{Raw: "0xnotHex"},
+	}
+	_, err := envelope.Envelope()
+
+	assert.Equal(t, errors.DataError("invalid raw"), err)
+}
+
 func TestRequestToBuilder(t *testing.T) {
 	testSet := []struct {
 		name            string
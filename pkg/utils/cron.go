@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+)
+
+// Cron is a recurrence expressed with the 5 standard cron fields "minute hour day-of-month month day-of-week",
+// each field is "*", a value, a range "a-b" or a list of them separated by commas, optionally with a step "/n"
+type Cron struct {
+	minute, hour, dom, month, dow uint64
+	// Days match when either the day of month or the day of week matches, unless one of them is "*"
+	domStar, dowStar bool
+}
+
+type cronField struct {
+	min, max int
+}
+
+// Sunday is the day of week 0 or 7
+var cronFields = []cronField{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+// maxCronLookup bounds the search of the next occurrence of expressions which never match, such as "0 0 30 2 *"
+const maxCronLookup = 5 * 366 * 24 * 60
+
+// ParseCron parses a cron expression
+func ParseCron(expr string) (*Cron, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return nil, errors.InvalidFormatError("cron expression must have %d fields", len(cronFields))
+	}
+
+	bits := make([]uint64, len(fields))
+	for idx, field := range fields {
+		var err error
+		bits[idx], err = parseCronField(field, cronFields[idx])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Cron{
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     bits[4]&^(1<<7) | bits[4]>>7,
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}, nil
+}
+
+// Next returns the first occurrence strictly after t, truncated to the minute, or the zero time if there is none
+func (c *Cron) Next(t time.Time) time.Time {
+	next := t.Truncate(time.Minute).Add(time.Minute)
+	for i := 0; i < maxCronLookup; i++ {
+		switch {
+		case c.month&(1<<uint(next.Month())) == 0:
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, next.Location())
+		case !c.matchDay(next):
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, next.Location())
+		case c.hour&(1<<uint(next.Hour())) == 0:
+			next = next.Truncate(time.Hour).Add(time.Hour)
+		case c.minute&(1<<uint(next.Minute())) == 0:
+			next = next.Add(time.Minute)
+		default:
+			return next
+		}
+	}
+
+	return time.Time{}
+}
+
+func (c *Cron) matchDay(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
+
+func parseCronField(field string, bounds cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rangeExpr, step := part, 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			var err error
+			rangeExpr = part[:idx]
+			step, err = strconv.Atoi(part[idx+1:])
+			if err != nil || step <= 0 {
+				return 0, errors.InvalidFormatError("invalid cron step in %q", part)
+			}
+		}
+
+		start, end := bounds.min, bounds.max
+		switch {
+		case rangeExpr == "*":
+		case strings.Contains(rangeExpr, "-"):
+			bounds := strings.SplitN(rangeExpr, "-", 2)
+			var err1, err2 error
+			start, err1 = strconv.Atoi(bounds[0])
+			end, err2 = strconv.Atoi(bounds[1])
+			if err1 != nil || err2 != nil {
+				return 0, errors.InvalidFormatError("invalid cron range %q", part)
+			}
+		default:
+			value, err := strconv.Atoi(rangeExpr)
+			if err != nil {
+				return 0, errors.InvalidFormatError("invalid cron value %q", part)
+			}
+			start, end = value, value
+			if step > 1 {
+				end = bounds.max
+			}
+		}
+
+		if start < bounds.min || end > bounds.max || start > end {
+			return 0, errors.InvalidFormatError("cron value %q out of range [%d-%d]", part, bounds.min, bounds.max)
+		}
+
+		for value := start; value <= end; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+
+	return bits, nil
+}
+
+// FirstScheduleRun validates the window [notBefore, notAfter] and the cron recurrence of a schedule and returns the time
+// at which the scheduler must first process it, or nil if it has no timing constraint
+func FirstScheduleRun(notBefore, notAfter *time.Time, cron string, now time.Time) (*time.Time, error) {
+	if notAfter != nil && !notAfter.After(now) {
+		return nil, errors.InvalidParameterError("notAfter must be in the future")
+	}
+	if notBefore != nil && notAfter != nil && !notAfter.After(*notBefore) {
+		return nil, errors.InvalidParameterError("notAfter must be after notBefore")
+	}
+
+	if cron != "" {
+		c, err := ParseCron(cron)
+		if err != nil {
+			return nil, errors.InvalidParameterError("invalid cron expression: %s", errors.FromError(err).GetMessage())
+		}
+
+		from := now
+		if notBefore != nil && notBefore.After(now) {
+			from = notBefore.Add(-time.Nanosecond)
+		}
+		next := c.Next(from.UTC())
+		if next.IsZero() || (notAfter != nil && next.After(*notAfter)) {
+			return nil, errors.InvalidParameterError("cron expression has no occurrence in the schedule window")
+		}
+
+		return &next, nil
+	}
+
+	if notBefore != nil && notBefore.After(now) {
+		return notBefore, nil
+	}
+
+	return notAfter, nil
+}
+
+// NextScheduleRun returns the time at which the scheduler must process a schedule again after running it at t,
+// which is the end of its window once the cron recurrence has no further occurrence in it
+func NextScheduleRun(notAfter *time.Time, cron string, t time.Time) *time.Time {
+	if cron != "" {
+		if c, err := ParseCron(cron); err == nil {
+			next := c.Next(t.UTC())
+			if !next.IsZero() && (notAfter == nil || !next.After(*notAfter)) {
+				return &next
+			}
+		}
+	}
+
+	return notAfter
+}
@@ -0,0 +1,105 @@
+// +build unit
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCron_Next(t *testing.T) {
+	from := time.Date(2022, time.January, 14, 10, 30, 15, 0, time.UTC) // Friday
+
+	testCases := []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2022, time.January, 14, 10, 31, 0, 0, time.UTC)},
+		{"0 9 * * *", time.Date(2022, time.January, 15, 9, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2022, time.January, 14, 10, 45, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2022, time.January, 17, 9, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2022, time.January, 16, 0, 0, 0, 0, time.UTC)},
+		{"30 8 1,15 * *", time.Date(2022, time.January, 15, 8, 30, 0, 0, time.UTC)},
+		{"0 0 1 3 *", time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			cron, err := ParseCron(tc.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, cron.Next(from))
+		})
+	}
+}
+
+func TestParseCron(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "a * * * *", "5-1 * * * *"} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := ParseCron(expr)
+			assert.True(t, errors.IsInvalidFormatError(err))
+		})
+	}
+}
+
+func TestFirstScheduleRun(t *testing.T) {
+	now := time.Date(2022, time.January, 14, 10, 30, 15, 0, time.UTC)
+	before := now.Add(time.Hour)
+	after := now.Add(48 * time.Hour)
+	past := now.Add(-time.Hour)
+
+	t.Run("should return nil without timing constraint", func(t *testing.T) {
+		next, err := FirstScheduleRun(nil, nil, "", now)
+		require.NoError(t, err)
+		assert.Nil(t, next)
+	})
+
+	t.Run("should return notBefore if in the future", func(t *testing.T) {
+		next, err := FirstScheduleRun(&before, &after, "", now)
+		require.NoError(t, err)
+		assert.Equal(t, before, *next)
+	})
+
+	t.Run("should return notAfter if the window is open", func(t *testing.T) {
+		next, err := FirstScheduleRun(&past, &after, "", now)
+		require.NoError(t, err)
+		assert.Equal(t, after, *next)
+	})
+
+	t.Run("should return the first cron occurrence in the window", func(t *testing.T) {
+		next, err := FirstScheduleRun(&before, &after, "0 9 * * *", now)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2022, time.January, 15, 9, 0, 0, 0, time.UTC), *next)
+	})
+
+	t.Run("should fail with InvalidParameterError if the window is invalid", func(t *testing.T) {
+		_, err := FirstScheduleRun(nil, &past, "", now)
+		assert.True(t, errors.IsInvalidParameterError(err))
+
+		_, err = FirstScheduleRun(&after, &before, "", now)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the cron is invalid or out of the window", func(t *testing.T) {
+		_, err := FirstScheduleRun(nil, nil, "* *", now)
+		assert.True(t, errors.IsInvalidParameterError(err))
+
+		_, err = FirstScheduleRun(nil, &after, "0 0 1 3 *", now)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
+
+func TestNextScheduleRun(t *testing.T) {
+	now := time.Date(2022, time.January, 14, 9, 0, 0, 0, time.UTC)
+	notAfter := now.Add(36 * time.Hour)
+
+	assert.Equal(t, now.Add(24*time.Hour), *NextScheduleRun(&notAfter, "0 9 * * *", now))
+	assert.Equal(t, notAfter, *NextScheduleRun(&notAfter, "0 9 * * 1", now))
+	assert.Equal(t, notAfter, *NextScheduleRun(&notAfter, "", now))
+	assert.Nil(t, NextScheduleRun(nil, "", now))
+}
@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+)
+
+type cursor struct {
+	Value time.Time `json:"v"`
+	Key   string    `json:"k"`
+}
+
+// EncodeCursor returns the opaque cursor pointing after the search result with the given sort value and key
+func EncodeCursor(value time.Time, key string) string {
+	b, _ := json.Marshal(&cursor{Value: value, Key: key})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor returns the sort value and key of the search result the cursor points after
+func DecodeCursor(c string) (time.Time, string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(c)
+	if err != nil {
+		return time.Time{}, "", errors.InvalidParameterError("invalid pagination cursor")
+	}
+
+	decoded := &cursor{}
+	if err = json.Unmarshal(b, decoded); err != nil || decoded.Key == "" {
+		return time.Time{}, "", errors.InvalidParameterError("invalid pagination cursor")
+	}
+
+	return decoded.Value, decoded.Key, nil
+}
@@ -0,0 +1,35 @@
+// +build unit
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("should decode an encoded cursor", func(t *testing.T) {
+		value := time.Date(2021, 10, 1, 12, 30, 0, 123456000, time.UTC)
+
+		c := EncodeCursor(value, "key")
+		decodedValue, decodedKey, err := DecodeCursor(c)
+
+		require.NoError(t, err)
+		assert.True(t, value.Equal(decodedValue))
+		assert.Equal(t, "key", decodedKey)
+	})
+
+	t.Run("should fail with InvalidParameterError if cursor is not base64", func(t *testing.T) {
+		_, _, err := DecodeCursor("@@@")
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if cursor has no key", func(t *testing.T) {
+		_, _, err := DecodeCursor("e30")
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
@@ -25,7 +25,7 @@ func NewEnvelopeFromJob(job *entities.Job, headers map[string]string) *tx.TxEnve
 
 	txEnvelope := &tx.TxEnvelope{
 		Msg: &tx.TxEnvelope_TxRequest{TxRequest: &tx.TxRequest{
-			Id:      job.ScheduleUUID,
+			Id:      EnvelopeID(job),
 			Headers: headers,
 			Params: &tx.Params{
 				From:            utils.StringerToString(job.Transaction.From),
@@ -84,6 +84,16 @@ func NewEnvelopeFromJob(job *entities.Job, headers map[string]string) *tx.TxEnve
 	return txEnvelope
 }
 
+// EnvelopeID returns the ID of the envelopes of a job, which is the ID of the request consumed to create the job if any
+// so that the responses echo it, and the UUID of its schedule otherwise
+func EnvelopeID(job *entities.Job) string {
+	if requestID := job.Labels[tx.RequestIDLabel]; requestID != "" {
+		return requestID
+	}
+
+	return job.ScheduleUUID
+}
+
 func NewContextFromEnvelope(ctx context.Context, envelope *tx.Envelope) context.Context {
 	return multitenancy.WithUserInfo(ctx, multitenancy.NewUserInfo(
 		envelope.GetHeadersValue(authutils.TenantIDHeader),
@@ -0,0 +1,35 @@
+// +build unit
+
+package utils
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	assert.True(t, IsPublicIP(net.ParseIP("8.8.8.8")))
+	assert.True(t, IsPublicIP(net.ParseIP("2001:4860:4860::8888")))
+	assert.False(t, IsPublicIP(net.ParseIP("127.0.0.1")))
+	assert.False(t, IsPublicIP(net.ParseIP("10.1.2.3")))
+	assert.False(t, IsPublicIP(net.ParseIP("172.20.0.1")))
+	assert.False(t, IsPublicIP(net.ParseIP("192.168.1.1")))
+	assert.False(t, IsPublicIP(net.ParseIP("169.254.169.254")))
+	assert.False(t, IsPublicIP(net.ParseIP("::1")))
+	assert.False(t, IsPublicIP(net.ParseIP("fe80::1")))
+	assert.False(t, IsPublicIP(net.ParseIP("::ffff:127.0.0.1")))
+}
+
+func TestIsPublicURL(t *testing.T) {
+	assert.True(t, IsPublicURL("https://example.com/notifications"))
+	assert.True(t, IsPublicURL("http://8.8.8.8:8080"))
+	assert.False(t, IsPublicURL("ftp://example.com"))
+	assert.False(t, IsPublicURL("example.com/notifications"))
+	assert.False(t, IsPublicURL("http://localhost:8080"))
+	assert.False(t, IsPublicURL("http://api.localhost"))
+	assert.False(t, IsPublicURL("http://127.0.0.1:8081"))
+	assert.False(t, IsPublicURL("http://[::1]/"))
+	assert.False(t, IsPublicURL("http://169.254.169.254/latest/meta-data"))
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"math/big"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
@@ -140,6 +141,19 @@ func isPrivateTxManagerType(fl validator.FieldLevel) bool {
 	return true
 }
 
+func isGasOracleType(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch entities.GasOracleType(fl.Field().String()) {
+		case entities.DefaultGasOracle, entities.FeeHistoryGasOracle:
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
 func isPriority(fl validator.FieldLevel) bool {
 	if fl.Field().String() != "" {
 		switch fl.Field().String() {
@@ -172,11 +186,34 @@ func isJobType(fl validator.FieldLevel) bool {
 	return true
 }
 
+func isFailedJobErrorClass(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch entities.FailedJobErrorClass(fl.Field().String()) {
+		case
+			entities.FailedJobConnectionError,
+			entities.FailedJobEthereumError,
+			entities.FailedJobInvalidNonceError,
+			entities.FailedJobInvalidDataError,
+			entities.FailedJobAuthenticationError,
+			entities.FailedJobInvalidStateError,
+			entities.FailedJobCryptoError,
+			entities.FailedJobInternalError,
+			entities.FailedJobUnknownError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
 func isJobStatus(fl validator.FieldLevel) bool {
 	if fl.Field().String() != "" {
 		switch entities.JobStatus(fl.Field().String()) {
 		case
 			entities.StatusCreated,
+			entities.StatusAwaitingApproval,
 			entities.StatusStarted,
 			entities.StatusPending,
 			entities.StatusRecovering,
@@ -184,7 +221,10 @@ func isJobStatus(fl validator.FieldLevel) bool {
 			entities.StatusMined,
 			entities.StatusFailed,
 			entities.StatusStored,
-			entities.StatusResending:
+			entities.StatusResending,
+			entities.StatusReorged,
+			entities.StatusSkipped,
+			entities.StatusExpired:
 			return true
 		default:
 			return false
@@ -220,6 +260,36 @@ func isKeyType(fl validator.FieldLevel) bool {
 	return true
 }
 
+func isSortKey(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch strings.TrimPrefix(fl.Field().String(), "-") {
+		case entities.SortByCreatedAt, entities.SortByUpdatedAt:
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isCron(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		_, err := ParseCron(fl.Field().String())
+		return err == nil
+	}
+
+	return true
+}
+
+func isPublicURL(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		return IsPublicURL(fl.Field().String())
+	}
+
+	return true
+}
+
 func init() {
 	if validate != nil {
 		return
@@ -234,12 +304,17 @@ func init() {
 	_ = validate.RegisterValidation("minDuration", minDuration)
 	_ = validate.RegisterValidation("isPrivateTxManagerType", isPrivateTxManagerType)
 	_ = validate.RegisterValidation("isPriority", isPriority)
+	_ = validate.RegisterValidation("isGasOracleType", isGasOracleType)
 	_ = validate.RegisterValidation("isJobType", isJobType)
 	_ = validate.RegisterValidation("isJobStatus", isJobStatus)
+	_ = validate.RegisterValidation("isFailedJobErrorClass", isFailedJobErrorClass)
 	_ = validate.RegisterValidation("isGasIncrementLevel", isGasIncrementLevel)
 	_ = validate.RegisterValidation("isKeyType", isKeyType)
 	_ = validate.RegisterValidation("isTransactionType", isTransactionType)
 	_ = validate.RegisterValidation("isPrivacyFlag", isPrivacyFlag)
+	_ = validate.RegisterValidation("isSortKey", isSortKey)
+	_ = validate.RegisterValidation("isCron", isCron)
+	_ = validate.RegisterValidation("isPublicURL", isPublicURL)
 }
 
 func GetValidator() *validator.Validate {
@@ -7,7 +7,11 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/middleware/httpcache"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/middleware/ratelimit"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/middleware/rpcpolicy"
+	"github.com/consensys/orchestrate/src/api/consumer"
+	"github.com/consensys/orchestrate/src/api/notifier"
 	"github.com/consensys/orchestrate/src/api/proxy"
+	"github.com/consensys/orchestrate/src/api/scheduler"
 	"github.com/dgraph-io/ristretto"
 
 	"github.com/consensys/orchestrate/src/infra/ethclient"
@@ -28,6 +32,7 @@ import (
 	"github.com/consensys/orchestrate/src/api/service/controllers"
 	"github.com/consensys/orchestrate/src/api/store/multi"
 	"github.com/consensys/orchestrate/src/infra/database/postgres"
+	txsenderstore "github.com/consensys/orchestrate/src/tx-sender/store"
 )
 
 func NewAPI(
@@ -36,9 +41,11 @@ func NewAPI(
 	jwt, key auth.Checker,
 	keyManagerClient qkmclient.KeyManagerClient,
 	qkmStoreID string,
-	ec ethclient.Client,
+	ec ethclient.MultiClient,
 	syncProducer sarama.SyncProducer,
 	topicCfg *pkgsarama.KafkaTopicConfig,
+	txRequestConsumerGroup, txRecoverConsumerGroup sarama.ConsumerGroup,
+	nonceSender txsenderstore.NonceSender,
 ) (*app.App, error) {
 	// Create Message agents
 	db, err := multi.Build(context.Background(), cfg.Store, pgmngr)
@@ -53,7 +60,21 @@ func NewAPI(
 		appMetrics = metrics.NewTransactionSchedulerNopMetrics()
 	}
 
-	ucs := builder.NewUseCases(db, appMetrics, keyManagerClient, qkmStoreID, ec, syncProducer, topicCfg)
+	var proxyMetrics metrics.ChainProxyMetrics
+	if cfg.App.Metrics.IsActive(metrics.ModuleName) {
+		proxyMetrics = metrics.NewChainProxyMetrics()
+	} else {
+		proxyMetrics = metrics.NewChainProxyNopMetrics()
+	}
+
+	ucs := builder.NewUseCases(db, appMetrics, keyManagerClient, qkmStoreID, ec, cfg.ProxyURL, syncProducer, topicCfg,
+		nonceSender)
+
+	// Health checks of the chain nodes proxied
+	var healthChecker *proxy.HealthChecker
+	if cfg.Proxy.HealthCheck != nil && cfg.Proxy.HealthCheck.Interval > 0 {
+		healthChecker = proxy.NewHealthChecker(ucs.SearchChains(), ec, ec, proxyMetrics, cfg.Proxy.HealthCheck)
+	}
 
 	// Option of the API
 	apiHandlerOpt := app.HandlerOpt(reflect.TypeOf(&dynamic.API{}), controllers.NewBuilder(ucs, keyManagerClient, qkmStoreID))
@@ -85,6 +106,12 @@ func NewAPI(
 		httpcache.NewBuilder(cache, proxy.HTTPCacheRequest, proxy.HTTPCacheResponse),
 	)
 
+	// JSON-RPC policy Middleware
+	rpcPolicyOpt := app.MiddlewareOpt(
+		reflect.TypeOf(&dynamic.RPCPolicy{}),
+		rpcpolicy.NewBuilder(rpcpolicy.NewQuotaManager()),
+	)
+
 	var accessLogMid app.Option
 	if cfg.App.HTTP.AccessLog {
 		accessLogMid = app.LoggerMiddlewareOpt("base")
@@ -93,18 +120,38 @@ func NewAPI(
 	}
 
 	// Create app
-	return app.New(
+	appli, err := app.New(
 		cfg.App,
 		app.MultiTenancyOpt("auth", jwt, key, cfg.Multitenancy),
 		ReadinessOpt(db),
-		app.MetricsOpt(appMetrics),
+		app.MetricsOpt(appMetrics, proxyMetrics),
 		accessLogMid,
 		rateLimitOpt,
 		apiHandlerOpt,
 		httpCacheOpt,
+		rpcPolicyOpt,
 		reverseProxyOpt,
-		app.ProviderOpt(NewProvider(ucs.SearchChains(), time.Second, cfg.Proxy.ProxyCacheTTL)),
+		app.ProviderOpt(NewProvider(ucs.SearchChains(), time.Second, cfg.Proxy.ProxyCacheTTL, healthChecker)),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	appli.RegisterDaemon(scheduler.New(ucs.RunDueSchedules(), cfg.Scheduler))
+	appli.RegisterDaemon(notifier.New(ucs.DeliverWebhooks(), cfg.Notifier))
+	if healthChecker != nil {
+		appli.RegisterDaemon(healthChecker)
+	}
+	if txRequestConsumerGroup != nil {
+		listener := consumer.NewTxRequestListener(ucs, jwt, key, cfg.Multitenancy, syncProducer, topicCfg.Decoded, cfg.Consumer.BckOff)
+		appli.RegisterDaemon(consumer.New(txRequestConsumerGroup, topicCfg.Request, listener))
+	}
+	if txRecoverConsumerGroup != nil {
+		listener := consumer.NewTxRecoverListener(ucs, cfg.Consumer.BckOff)
+		appli.RegisterDaemon(consumer.New(txRecoverConsumerGroup, topicCfg.Recover, listener))
+	}
+
+	return appli, nil
 }
 
 func ReadinessOpt(db database.DB) app.Option {
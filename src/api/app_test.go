@@ -33,9 +33,12 @@ func TestApp(t *testing.T) {
 		mockauth.NewMockChecker(ctrl), mockauth.NewMockChecker(ctrl),
 		mocks2.NewMockKeyManagerClient(ctrl),
 		"defaultStoreID",
-		ethclientmock.NewMockClient(ctrl),
+		ethclientmock.NewMockMultiClient(ctrl),
 		mocks.NewSyncProducer(t, nil),
 		kCfg,
+		nil,
+		nil,
+		nil,
 	)
 	assert.NoError(t, err, "Creating App should not error")
 }
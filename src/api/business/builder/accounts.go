@@ -5,6 +5,7 @@ import (
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/accounts"
 	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
 )
 
 type accountUseCases struct {
@@ -12,23 +13,31 @@ type accountUseCases struct {
 	getAccountUC     usecases.GetAccountUseCase
 	searchAccountsUC usecases.SearchAccountsUseCase
 	updateAccountUC  usecases.UpdateAccountUseCase
+	disableAccountUC usecases.DisableAccountUseCase
+	rotateAccountUC  usecases.RotateAccountUseCase
 }
 
 func newAccountUseCases(
 	db store.DB,
 	keyManagerClient qkmclient.EthClient,
 	searchChainsUC usecases.SearchChainsUseCase,
+	searchFaucetsUC usecases.SearchFaucetsUseCase,
 	sendTxUC usecases.SendTxUseCase,
 	getFaucetCandidateUC usecases.GetFaucetCandidateUseCase,
+	ec ethclient.Client,
 ) *accountUseCases {
 	searchAccountsUC := accounts.NewSearchAccountsUseCase(db)
-	fundAccountUC := accounts.NewFundAccountUseCase(searchChainsUC, sendTxUC, getFaucetCandidateUC)
+	fundAccountUC := accounts.NewFundAccountUseCase(db, searchChainsUC, searchFaucetsUC, sendTxUC, getFaucetCandidateUC)
+
+	createAccountUC := accounts.NewCreateAccountUseCase(db, searchAccountsUC, fundAccountUC, keyManagerClient)
 
 	return &accountUseCases{
-		createAccountUC:  accounts.NewCreateAccountUseCase(db, searchAccountsUC, fundAccountUC, keyManagerClient),
+		createAccountUC:  createAccountUC,
 		getAccountUC:     accounts.NewGetAccountUseCase(db),
 		searchAccountsUC: searchAccountsUC,
 		updateAccountUC:  accounts.NewUpdateAccountUseCase(db),
+		disableAccountUC: accounts.NewDisableAccountUseCase(db),
+		rotateAccountUC:  accounts.NewRotateAccountUseCase(db, createAccountUC, searchChainsUC, sendTxUC, ec, ec),
 	}
 }
 
@@ -47,3 +56,11 @@ func (u *accountUseCases) CreateAccount() usecases.CreateAccountUseCase {
 func (u *accountUseCases) UpdateAccount() usecases.UpdateAccountUseCase {
 	return u.updateAccountUC
 }
+
+func (u *accountUseCases) DisableAccount() usecases.DisableAccountUseCase {
+	return u.disableAccountUC
+}
+
+func (u *accountUseCases) RotateAccount() usecases.RotateAccountUseCase {
+	return u.rotateAccountUC
+}
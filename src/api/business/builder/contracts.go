@@ -14,6 +14,9 @@ type contractUseCases struct {
 	registerContractUC    usecases.RegisterContractUseCase
 	getContractUC         usecases.GetContractUseCase
 	searchContractUC      usecases.SearchContractUseCase
+	deregisterContractUC  usecases.DeregisterContractUseCase
+	deleteRepositoryUC    usecases.DeleteContractRepositoryUseCase
+	setContractTagUC      usecases.SetContractTagUseCase
 }
 
 func newContractUseCases(db store.DB) *contractUseCases {
@@ -27,6 +30,9 @@ func newContractUseCases(db store.DB) *contractUseCases {
 		getContractTags:       contracts.NewGetTagsUseCase(db.Tag()),
 		setContractCodeHash:   contracts.NewSetCodeHashUseCase(db.CodeHash()),
 		searchContractUC:      contracts.NewSearchContractUseCase(db.Contract()),
+		deregisterContractUC:  contracts.NewDeregisterContractUseCase(db),
+		deleteRepositoryUC:    contracts.NewDeleteRepositoryUseCase(db),
+		setContractTagUC:      contracts.NewSetTagUseCase(db),
 	}
 }
 
@@ -57,3 +63,15 @@ func (u *contractUseCases) SetContractCodeHash() usecases.SetContractCodeHashUse
 func (u *contractUseCases) SearchContract() usecases.SearchContractUseCase {
 	return u.searchContractUC
 }
+
+func (u *contractUseCases) DeregisterContract() usecases.DeregisterContractUseCase {
+	return u.deregisterContractUC
+}
+
+func (u *contractUseCases) DeleteContractRepository() usecases.DeleteContractRepositoryUseCase {
+	return u.deleteRepositoryUC
+}
+
+func (u *contractUseCases) SetContractTag() usecases.SetContractTagUseCase {
+	return u.setContractTagUC
+}
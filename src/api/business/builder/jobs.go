@@ -10,13 +10,20 @@ import (
 )
 
 type jobUseCases struct {
-	createJob   usecases.CreateJobUseCase
-	getJob      usecases.GetJobUseCase
-	startJob    usecases.StartJobUseCase
-	resendJobTx usecases.ResendJobTxUseCase
-	retryJobTx  usecases.RetryJobTxUseCase
-	updateJob   usecases.UpdateJobUseCase
-	searchJobs  usecases.SearchJobsUseCase
+	createJob          usecases.CreateJobUseCase
+	getJob             usecases.GetJobUseCase
+	startJob           usecases.StartJobUseCase
+	resendJobTx        usecases.ResendJobTxUseCase
+	retryJobTx         usecases.RetryJobTxUseCase
+	updateJob          usecases.UpdateJobUseCase
+	searchJobs         usecases.SearchJobsUseCase
+	subscribeJobEvents usecases.SubscribeJobEventsUseCase
+	approveJob         usecases.ApproveJobUseCase
+	rejectJob          usecases.RejectJobUseCase
+	searchJobApprovals usecases.SearchJobApprovalsUseCase
+	createFailedJob    usecases.CreateFailedJobUseCase
+	searchFailedJobs   usecases.SearchFailedJobsUseCase
+	replayFailedJobs   usecases.ReplayFailedJobsUseCase
 }
 
 func newJobUseCases(
@@ -25,21 +32,35 @@ func newJobUseCases(
 	producer sarama.SyncProducer,
 	topicsCfg *pkgsarama.KafkaTopicConfig,
 	getChainUC usecases.GetChainUseCase,
+	notifyWebhooksUC usecases.NotifyWebhooksUseCase,
 	qkmStoreID string,
 ) *jobUseCases {
 	startJobUC := jobs.NewStartJobUseCase(db, producer, topicsCfg, appMetrics)
 	updateChildrenUC := jobs.NewUpdateChildrenUseCase(db)
 	startNextJobUC := jobs.NewStartNextJobUseCase(db, startJobUC)
+	publishJobEventUC := jobs.NewPublishJobEventUseCase(db)
+	startDependentJobsUC := jobs.NewStartDependentJobsUseCase(db, startJobUC, notifyWebhooksUC, publishJobEventUC)
 	createJobUC := jobs.NewCreateJobUseCase(db, getChainUC, qkmStoreID)
+	jobEventsHub := jobs.NewJobEventsHub(db)
+	updateJobUC := jobs.NewUpdateJobUseCase(db, updateChildrenUC, startNextJobUC, startDependentJobsUC,
+		notifyWebhooksUC, publishJobEventUC, appMetrics)
+	replayJobUC := jobs.NewReplayJobUseCase(db, producer, topicsCfg, updateJobUC, notifyWebhooksUC, publishJobEventUC)
 
 	return &jobUseCases{
-		createJob:   createJobUC,
-		getJob:      jobs.NewGetJobUseCase(db),
-		searchJobs:  jobs.NewSearchJobsUseCase(db),
-		updateJob:   jobs.NewUpdateJobUseCase(db, updateChildrenUC, startNextJobUC, appMetrics),
-		startJob:    startJobUC,
-		resendJobTx: jobs.NewResendJobTxUseCase(db, producer, topicsCfg),
-		retryJobTx:  jobs.NewRetryJobTxUseCase(db, createJobUC, startJobUC),
+		createJob:          createJobUC,
+		getJob:             jobs.NewGetJobUseCase(db),
+		searchJobs:         jobs.NewSearchJobsUseCase(db),
+		updateJob:          updateJobUC,
+		startJob:           startJobUC,
+		resendJobTx:        jobs.NewResendJobTxUseCase(db, producer, topicsCfg),
+		retryJobTx:         jobs.NewRetryJobTxUseCase(db, createJobUC, startJobUC),
+		subscribeJobEvents: jobs.NewSubscribeJobEventsUseCase(jobEventsHub),
+		approveJob:         jobs.NewApproveJobUseCase(db, startJobUC),
+		rejectJob:          jobs.NewRejectJobUseCase(db, updateJobUC),
+		searchJobApprovals: jobs.NewSearchJobApprovalsUseCase(db),
+		createFailedJob:    jobs.NewCreateFailedJobUseCase(db),
+		searchFailedJobs:   jobs.NewSearchFailedJobsUseCase(db),
+		replayFailedJobs:   jobs.NewReplayFailedJobsUseCase(db, replayJobUC),
 	}
 }
 
@@ -70,3 +91,31 @@ func (u *jobUseCases) RetryJobTx() usecases.RetryJobTxUseCase {
 func (u *jobUseCases) UpdateJob() usecases.UpdateJobUseCase {
 	return u.updateJob
 }
+
+func (u *jobUseCases) SubscribeJobEvents() usecases.SubscribeJobEventsUseCase {
+	return u.subscribeJobEvents
+}
+
+func (u *jobUseCases) ApproveJob() usecases.ApproveJobUseCase {
+	return u.approveJob
+}
+
+func (u *jobUseCases) RejectJob() usecases.RejectJobUseCase {
+	return u.rejectJob
+}
+
+func (u *jobUseCases) SearchJobApprovals() usecases.SearchJobApprovalsUseCase {
+	return u.searchJobApprovals
+}
+
+func (u *jobUseCases) CreateFailedJob() usecases.CreateFailedJobUseCase {
+	return u.createFailedJob
+}
+
+func (u *jobUseCases) SearchFailedJobs() usecases.SearchFailedJobsUseCase {
+	return u.searchFailedJobs
+}
+
+func (u *jobUseCases) ReplayFailedJobs() usecases.ReplayFailedJobsUseCase {
+	return u.replayFailedJobs
+}
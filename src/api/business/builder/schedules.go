@@ -10,13 +10,15 @@ type scheduleUseCases struct {
 	createSchedule  usecases.CreateScheduleUseCase
 	getSchedule     usecases.GetScheduleUseCase
 	searchSchedules usecases.SearchSchedulesUseCase
+	runDueSchedules usecases.RunDueSchedulesUseCase
 }
 
-func newScheduleUseCases(db store.DB) *scheduleUseCases {
+func newScheduleUseCases(db store.DB, jobUCs *jobUseCases) *scheduleUseCases {
 	return &scheduleUseCases{
 		createSchedule:  schedules.NewCreateScheduleUseCase(db),
 		getSchedule:     schedules.NewGetScheduleUseCase(db),
 		searchSchedules: schedules.NewSearchSchedulesUseCase(db),
+		runDueSchedules: schedules.NewRunDueSchedulesUseCase(db, jobUCs.CreateJob(), jobUCs.StartJob(), jobUCs.UpdateJob()),
 	}
 }
 
@@ -31,3 +33,7 @@ func (u *scheduleUseCases) GetSchedule() usecases.GetScheduleUseCase {
 func (u *scheduleUseCases) SearchSchedules() usecases.SearchSchedulesUseCase {
 	return u.searchSchedules
 }
+
+func (u *scheduleUseCases) RunDueSchedules() usecases.RunDueSchedulesUseCase {
+	return u.runDueSchedules
+}
@@ -4,16 +4,20 @@ import (
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/transactions"
 	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	txsenderstore "github.com/consensys/orchestrate/src/tx-sender/store"
 )
 
 type transactionUseCases struct {
 	sendContractTransaction usecases.SendContractTxUseCase
 	sendDeployTransaction   usecases.SendDeployTxUseCase
 	sendTransaction         usecases.SendTxUseCase
+	sendTransactionBatch    usecases.SendTxBatchUseCase
 	getTransaction          usecases.GetTxUseCase
 	searchTransactions      usecases.SearchTransactionsUseCase
 	speedUpTransactions     usecases.SpeedUpTxUseCase
 	callOffTransactions     usecases.CallOffTxUseCase
+	simulateTransaction     usecases.SimulateTxUseCase
 }
 
 func newTransactionUseCases(
@@ -22,20 +26,29 @@ func newTransactionUseCases(
 	getFaucetCandidateUC usecases.GetFaucetCandidateUseCase,
 	schedulesUCs *scheduleUseCases,
 	jobUCs *jobUseCases,
-	getContractUC usecases.GetContractUseCase,
+	contractUCs *contractUseCases,
+	ec ethclient.MultiClient,
+	proxyURL string,
+	nonceSender txsenderstore.NonceSender,
 ) *transactionUseCases {
+	getContractUC := contractUCs.GetContract()
 	getTransactionUC := transactions.NewGetTxUseCase(db, schedulesUCs.GetSchedule())
 	sendTxUC := transactions.NewSendTxUseCase(db, searchChainsUC, jobUCs.StartJob(), jobUCs.CreateJob(), getTransactionUC, 
 		getFaucetCandidateUC)
+	sendTxBatchUC := transactions.NewSendTxBatchUseCase(db, searchChainsUC, jobUCs.StartJob(), jobUCs.CreateJob(),
+		getTransactionUC, getFaucetCandidateUC, getContractUC)
 
 	return &transactionUseCases{
 		sendContractTransaction: transactions.NewSendContractTxUseCase(sendTxUC, getContractUC),
 		sendDeployTransaction:   transactions.NewSendDeployTxUseCase(sendTxUC, getContractUC),
 		sendTransaction:         sendTxUC,
+		sendTransactionBatch:    sendTxBatchUC,
 		getTransaction:          getTransactionUC,
 		searchTransactions:      transactions.NewSearchTransactionsUseCase(db, getTransactionUC),
 		speedUpTransactions:     transactions.NewSpeedUpTxUseCase(db, getTransactionUC, jobUCs.retryJobTx),
 		callOffTransactions:     transactions.NewCallOffTxUseCase(db, getTransactionUC, jobUCs.retryJobTx),
+		simulateTransaction:     transactions.NewSimulateTxUseCase(searchChainsUC, getContractUC, contractUCs.GetContractEvents(),
+			ec, proxyURL, nonceSender),
 	}
 }
 
@@ -51,6 +64,10 @@ func (u *transactionUseCases) SendTransaction() usecases.SendTxUseCase {
 	return u.sendTransaction
 }
 
+func (u *transactionUseCases) SendTransactionBatch() usecases.SendTxBatchUseCase {
+	return u.sendTransactionBatch
+}
+
 func (u *transactionUseCases) GetTransaction() usecases.GetTxUseCase {
 	return u.getTransaction
 }
@@ -66,3 +83,7 @@ func (u *transactionUseCases) SpeedUpTransaction() usecases.SpeedUpTxUseCase {
 func (u *transactionUseCases) CallOffTransaction() usecases.CallOffTxUseCase {
 	return u.callOffTransactions
 }
+
+func (u *transactionUseCases) SimulateTransaction() usecases.SimulateTxUseCase {
+	return u.simulateTransaction
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/consensys/orchestrate/src/api/business/use-cases/faucets"
 	"github.com/consensys/orchestrate/src/api/metrics"
 	"github.com/consensys/orchestrate/src/api/store"
+	txsenderstore "github.com/consensys/orchestrate/src/tx-sender/store"
 	qkmclient "github.com/consensys/quorum-key-manager/pkg/client"
 	"github.com/Shopify/sarama"
 )
@@ -19,6 +20,7 @@ type useCases struct {
 	*chainUseCases
 	*contractUseCases
 	*accountUseCases
+	*webhookUseCases
 }
 
 func NewUseCases(
@@ -26,21 +28,25 @@ func NewUseCases(
 	appMetrics metrics.TransactionSchedulerMetrics,
 	keyManagerClient qkmclient.EthClient,
 	qkmStoreID string,
-	ec ethclient.Client,
+	ec ethclient.MultiClient,
+	proxyURL string,
 	producer sarama.SyncProducer,
 	topicsCfg *pkgsarama.KafkaTopicConfig,
+	nonceSender txsenderstore.NonceSender,
 ) usecases.UseCases {
 
 	chainUseCases := newChainUseCases(db, ec)
 	contractUseCases := newContractUseCases(db)
 	faucetUseCases := newFaucetUseCases(db)
-	getFaucetCandidateUC := faucets.NewGetFaucetCandidateUseCase(faucetUseCases.SearchFaucets(), ec)
-	scheduleUseCases := newScheduleUseCases(db)
-	jobUseCases := newJobUseCases(db, appMetrics, producer, topicsCfg, chainUseCases.GetChain(), qkmStoreID)
+	getFaucetCandidateUC := faucets.NewGetFaucetCandidateUseCase(db, faucetUseCases.SearchFaucets(), ec, ec)
+	webhookUseCases := newWebhookUseCases(db)
+	jobUseCases := newJobUseCases(db, appMetrics, producer, topicsCfg, chainUseCases.GetChain(), 
+		webhookUseCases.NotifyWebhooks(), qkmStoreID)
+	scheduleUseCases := newScheduleUseCases(db, jobUseCases)
 	transactionUseCases := newTransactionUseCases(db, chainUseCases.SearchChains(), getFaucetCandidateUC, 
-		scheduleUseCases, jobUseCases, contractUseCases.GetContract())
+		scheduleUseCases, jobUseCases, contractUseCases, ec, proxyURL, nonceSender)
 	accountUseCases := newAccountUseCases(db, keyManagerClient, chainUseCases.SearchChains(), 
-		transactionUseCases.SendTransaction(), getFaucetCandidateUC)
+		faucetUseCases.SearchFaucets(), transactionUseCases.SendTransaction(), getFaucetCandidateUC, ec)
 
 	return &useCases{
 		jobUseCases:         jobUseCases,
@@ -50,5 +56,6 @@ func NewUseCases(
 		chainUseCases:       chainUseCases,
 		contractUseCases:    contractUseCases,
 		accountUseCases:     accountUseCases,
+		webhookUseCases:     webhookUseCases,
 	}
 }
@@ -0,0 +1,75 @@
+package builder
+
+import (
+	"time"
+
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/webhooks"
+	"github.com/consensys/orchestrate/src/api/store"
+)
+
+const (
+	webhookDeliveryTimeout       = 10 * time.Second
+	webhookDeliveryMaxAttempts   = 6
+	webhookDeliveryMinRetryDelay = 10 * time.Second
+	webhookDeliveryMaxRetryDelay = 10 * time.Minute
+)
+
+type webhookUseCases struct {
+	createWebhookUC           usecases.CreateWebhookUseCase
+	updateWebhookUC           usecases.UpdateWebhookUseCase
+	getWebhookUC              usecases.GetWebhookUseCase
+	searchWebhooksUC          usecases.SearchWebhooksUseCase
+	deleteWebhookUC           usecases.DeleteWebhookUseCase
+	searchWebhookDeliveriesUC usecases.SearchWebhookDeliveriesUseCase
+	notifyWebhooksUC          usecases.NotifyWebhooksUseCase
+	deliverWebhooksUC         usecases.DeliverWebhooksUseCase
+}
+
+func newWebhookUseCases(db store.DB) *webhookUseCases {
+	client := webhooks.NewDeliveryClient(webhookDeliveryTimeout)
+
+	return &webhookUseCases{
+		createWebhookUC:           webhooks.NewCreateWebhookUseCase(db),
+		updateWebhookUC:           webhooks.NewUpdateWebhookUseCase(db),
+		getWebhookUC:              webhooks.NewGetWebhookUseCase(db),
+		searchWebhooksUC:          webhooks.NewSearchWebhooksUseCase(db),
+		deleteWebhookUC:           webhooks.NewDeleteWebhookUseCase(db),
+		searchWebhookDeliveriesUC: webhooks.NewSearchWebhookDeliveriesUseCase(db),
+		notifyWebhooksUC:          webhooks.NewNotifyWebhooksUseCase(db),
+		deliverWebhooksUC: webhooks.NewDeliverWebhooksUseCase(db, client, webhookDeliveryMaxAttempts,
+			webhookDeliveryMinRetryDelay, webhookDeliveryMaxRetryDelay),
+	}
+}
+
+func (u *webhookUseCases) CreateWebhook() usecases.CreateWebhookUseCase {
+	return u.createWebhookUC
+}
+
+func (u *webhookUseCases) UpdateWebhook() usecases.UpdateWebhookUseCase {
+	return u.updateWebhookUC
+}
+
+func (u *webhookUseCases) GetWebhook() usecases.GetWebhookUseCase {
+	return u.getWebhookUC
+}
+
+func (u *webhookUseCases) SearchWebhooks() usecases.SearchWebhooksUseCase {
+	return u.searchWebhooksUC
+}
+
+func (u *webhookUseCases) DeleteWebhook() usecases.DeleteWebhookUseCase {
+	return u.deleteWebhookUC
+}
+
+func (u *webhookUseCases) SearchWebhookDeliveries() usecases.SearchWebhookDeliveriesUseCase {
+	return u.searchWebhookDeliveriesUC
+}
+
+func (u *webhookUseCases) NotifyWebhooks() usecases.NotifyWebhooksUseCase {
+	return u.notifyWebhooksUC
+}
+
+func (u *webhookUseCases) DeliverWebhooks() usecases.DeliverWebhooksUseCase {
+	return u.deliverWebhooksUC
+}
@@ -16,6 +16,8 @@ type AccountUseCases interface {
 	CreateAccount() CreateAccountUseCase
 	UpdateAccount() UpdateAccountUseCase
 	SearchAccounts() SearchAccountsUseCase
+	DisableAccount() DisableAccountUseCase
+	RotateAccount() RotateAccountUseCase
 }
 
 type GetAccountUseCase interface {
@@ -34,6 +36,14 @@ type UpdateAccountUseCase interface {
 	Execute(ctx context.Context, identity *entities.Account, userInfo *multitenancy.UserInfo) (*entities.Account, error)
 }
 
+type DisableAccountUseCase interface {
+	Execute(ctx context.Context, address ethcommon.Address, userInfo *multitenancy.UserInfo) (*entities.Account, error)
+}
+
+type RotateAccountUseCase interface {
+	Execute(ctx context.Context, address ethcommon.Address, successor *entities.Account, chainName string, userInfo *multitenancy.UserInfo) (*entities.Account, error)
+}
+
 type FundAccountUseCase interface {
 	Execute(ctx context.Context, identity *entities.Account, chainName string, userInfo *multitenancy.UserInfo) error
 }
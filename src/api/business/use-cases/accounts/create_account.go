@@ -52,6 +52,11 @@ func (uc *createAccountUseCase) Execute(ctx context.Context, acc *entities.Accou
 
 	logger.Debug("creating new ethereum account")
 
+	if err := validateApprovalPolicy(acc.ApprovalPolicy); err != nil {
+		logger.WithError(err).Error("invalid approval policy")
+		return nil, errors.FromError(err).ExtendComponent(createAccountComponent)
+	}
+
 	accounts, err := uc.searchUC.Execute(ctx,
 		&entities.AccountFilters{Aliases: []string{acc.Alias}, TenantID: userInfo.TenantID},
 		userInfo)
@@ -142,3 +147,11 @@ func generateKeyID(tenantID, alias string) string {
 	// with AKV and AWS which requires regex [a-zA-z]+$
 	return fmt.Sprintf("%x", md5.Sum([]byte(tenantID+alias)))
 }
+
+func validateApprovalPolicy(policy *entities.ApprovalPolicy) error {
+	if policy != nil && policy.Threshold > len(policy.Approvers) {
+		return errors.InvalidParameterError("approval threshold cannot exceed the number of approvers")
+	}
+
+	return nil
+}
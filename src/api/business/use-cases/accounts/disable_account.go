@@ -0,0 +1,57 @@
+package accounts
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const disableAccountComponent = "use-cases.disable-account"
+
+type disableAccountUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+func NewDisableAccountUseCase(db store.DB) usecases.DisableAccountUseCase {
+	return &disableAccountUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(disableAccountComponent),
+	}
+}
+
+// Execute marks the account as disabled so that no new job can be sent from it
+func (uc *disableAccountUseCase) Execute(ctx context.Context, address ethcommon.Address, userInfo *multitenancy.UserInfo) (*entities.Account, error) {
+	ctx = log.WithFields(ctx, log.Field("address", address))
+	logger := uc.logger.WithContext(ctx)
+
+	model, err := uc.db.Account().FindOneByAddress(ctx, address.Hex(), userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(disableAccountComponent)
+	}
+
+	if model.DisabledAt != nil {
+		errMsg := "account is already disabled"
+		logger.Error(errMsg)
+		return nil, errors.InvalidStateError(errMsg).ExtendComponent(disableAccountComponent)
+	}
+
+	disabledAt := time.Now().UTC()
+	model.DisabledAt = &disabledAt
+	model.DisabledBy = userInfo.Username
+	err = uc.db.Account().Update(ctx, model)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(disableAccountComponent)
+	}
+
+	logger.Info("account disabled successfully")
+	return parsers.NewAccountEntityFromModels(model), nil
+}
@@ -0,0 +1,81 @@
+//go:build unit
+// +build unit
+
+package accounts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	modelstestdata "github.com/consensys/orchestrate/src/api/store/models/testdata"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisableAccount_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	accountAgent := mocks.NewMockAccountAgent(ctrl)
+	mockDB.EXPECT().Account().Return(accountAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewDisableAccountUseCase(mockDB)
+
+	t.Run("should disable account successfully", func(t *testing.T) {
+		accountModel := modelstestdata.FakeAccountModel()
+		address := ethcommon.HexToAddress(accountModel.Address)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(accountModel, nil)
+		accountAgent.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, model *models.Account) error {
+			assert.NotNil(t, model.DisabledAt)
+			assert.Equal(t, userInfo.Username, model.DisabledBy)
+			return nil
+		})
+
+		resp, err := usecase.Execute(ctx, address, userInfo)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, resp.DisabledAt)
+		assert.Equal(t, userInfo.Username, resp.DisabledBy)
+	})
+
+	t.Run("should fail with InvalidStateError if account is already disabled", func(t *testing.T) {
+		accountModel := modelstestdata.FakeAccountModel()
+		disabledAt := time.Now()
+		accountModel.DisabledAt = &disabledAt
+		address := ethcommon.HexToAddress(accountModel.Address)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(accountModel, nil)
+
+		_, err := usecase.Execute(ctx, address, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail with same error if find account fails", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, ethcommon.Address{}, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(disableAccountComponent), err)
+	})
+
+	t.Run("should fail with same error if update account fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+		accountModel := modelstestdata.FakeAccountModel()
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return(accountModel, nil)
+		accountAgent.EXPECT().Update(gomock.Any(), accountModel).Return(expectedErr)
+
+		_, err := usecase.Execute(ctx, ethcommon.HexToAddress(accountModel.Address), userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(disableAccountComponent), err)
+	})
+}
@@ -8,28 +8,39 @@ import (
 	"github.com/consensys/orchestrate/pkg/utils"
 
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/erc20"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gofrs/uuid"
 )
 
 const fundAccountComponent = "use-cases.fund-account"
 
 type fundAccountUseCase struct {
+	db                 store.DB
 	searchChainsUC     usecases.SearchChainsUseCase
+	searchFaucetsUC    usecases.SearchFaucetsUseCase
 	sendTxUseCase      usecases.SendTxUseCase
 	getFaucetCandidate usecases.GetFaucetCandidateUseCase
 	logger             *log.Logger
 }
 
 func NewFundAccountUseCase(
+	db store.DB,
 	searchChainsUC usecases.SearchChainsUseCase,
+	searchFaucetsUC usecases.SearchFaucetsUseCase,
 	sendTxUseCase usecases.SendTxUseCase,
 	getFaucetCandidate usecases.GetFaucetCandidateUseCase,
 ) usecases.FundAccountUseCase {
 	return &fundAccountUseCase{
+		db:                 db,
 		searchChainsUC:     searchChainsUC,
+		searchFaucetsUC:    searchFaucetsUC,
 		sendTxUseCase:      sendTxUseCase,
 		getFaucetCandidate: getFaucetCandidate,
 		logger:             log.NewLogger().SetComponent(fundAccountComponent),
@@ -51,19 +62,46 @@ func (uc *fundAccountUseCase) Execute(ctx context.Context, account *entities.Acc
 		return errors.InvalidParameterError(errMsg).ExtendComponent(fundAccountComponent)
 	}
 
-	faucet, err := uc.getFaucetCandidate.Execute(ctx, account.Address, chains[0], userInfo)
+	// Accounts are funded by one faucet for the native currency and for each ERC-20 token of the chain faucets
+	faucets, err := uc.searchFaucetsUC.Execute(ctx, &entities.FaucetFilters{ChainRule: chains[0].UUID}, userInfo)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(fundAccountComponent)
+	}
+
+	for _, tokenAddress := range faucetTokenAddresses(faucets) {
+		err = uc.fund(ctx, account, chains[0], tokenAddress, userInfo)
+		if err != nil {
+			return errors.FromError(err).ExtendComponent(fundAccountComponent)
+		}
+	}
+
+	return nil
+}
+
+func (uc *fundAccountUseCase) fund(
+	ctx context.Context,
+	account *entities.Account,
+	chain *entities.Chain,
+	tokenAddress *ethcommon.Address,
+	userInfo *multitenancy.UserInfo,
+) error {
+	logger := uc.logger.WithContext(ctx).WithField("token", utils.StringerToString(tokenAddress))
+
+	fundingJobUUID := uuid.Must(uuid.NewV4()).String()
+	faucet, err := uc.getFaucetCandidate.Execute(ctx, account.Address, chain, tokenAddress, fundingJobUUID, userInfo)
 	if err != nil {
 		if errors.IsNotFoundError(err) {
 			logger.Debug("unnecessary funding, skipping top-up")
 			return nil
 		}
 
-		return errors.FromError(err).ExtendComponent(fundAccountComponent)
+		return err
 	}
 
 	txRequest := &entities.TxRequest{
 		IdempotencyKey: utils.RandString(16),
-		ChainName:      chains[0].Name,
+		ChainName:      chain.Name,
+		JobUUID:        fundingJobUUID,
 		Params: &entities.ETHTransactionParams{
 			From:  &faucet.CreditorAccount,
 			To:    &account.Address,
@@ -75,9 +113,28 @@ func (uc *fundAccountUseCase) Execute(ctx context.Context, account *entities.Acc
 		InternalData: &entities.InternalData{},
 	}
 
-	_, err = uc.sendTxUseCase.Execute(ctx, txRequest, nil, userInfo)
+	var txData hexutil.Bytes
+	if faucet.TokenAddress != nil {
+		txData, err = erc20.EncodeTransfer(account.Address, faucet.Amount.ToInt())
+		if err != nil {
+			return errors.EncodingError(err.Error())
+		}
+
+		txRequest.Params = &entities.ETHTransactionParams{
+			From:            &faucet.CreditorAccount,
+			To:              faucet.TokenAddress,
+			MethodSignature: erc20.TransferMethodSignature,
+			Args:            []interface{}{account.Address.Hex(), faucet.Amount.ToInt().String()},
+		}
+	}
+
+	_, err = uc.sendTxUseCase.Execute(ctx, txRequest, txData, userInfo)
 	if err != nil {
-		return errors.FromError(err).ExtendComponent(fundAccountComponent)
+		// The faucet spending is released as the funding job was not sent
+		if der := uc.db.FaucetSpending().DeleteByJobUUID(ctx, fundingJobUUID); der != nil {
+			logger.WithError(der).Error("failed to release faucet spending")
+		}
+		return err
 	}
 
 	logger.WithField("faucet", faucet.UUID).WithField("value", faucet.Amount).
@@ -85,3 +142,20 @@ func (uc *fundAccountUseCase) Execute(ctx context.Context, account *entities.Acc
 
 	return nil
 }
+
+// faucetTokenAddresses returns the distinct tokens credited by the faucets, nil standing for the native currency
+func faucetTokenAddresses(faucets []*entities.Faucet) []*ethcommon.Address {
+	var tokenAddresses []*ethcommon.Address
+	seen := make(map[string]bool)
+	for _, faucet := range faucets {
+		key := utils.StringerToString(faucet.TokenAddress)
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		tokenAddresses = append(tokenAddresses, faucet.TokenAddress)
+	}
+
+	return tokenAddresses
+}
@@ -10,9 +10,13 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/erc20"
 	"github.com/consensys/orchestrate/src/entities/testdata"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -27,11 +31,16 @@ func TestFundingAccount_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+	mockSearchFaucetsUC := mocks.NewMockSearchFaucetsUseCase(ctrl)
 	mockGetFaucetCandidate := mocks.NewMockGetFaucetCandidateUseCase(ctrl)
 	mockSendTxUC := mocks.NewMockSendTxUseCase(ctrl)
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockFaucetSpendingDA := mocks2.NewMockFaucetSpendingAgent(ctrl)
+
+	mockDB.EXPECT().FaucetSpending().Return(mockFaucetSpendingDA).AnyTimes()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewFundAccountUseCase(mockSearchChainsUC, mockSendTxUC, mockGetFaucetCandidate)
+	usecase := NewFundAccountUseCase(mockDB, mockSearchChainsUC, mockSearchFaucetsUC, mockSendTxUC, mockGetFaucetCandidate)
 
 	t.Run("should trigger funding identity successfully", func(t *testing.T) {
 		account := testdata.FakeAccount()
@@ -41,8 +50,20 @@ func TestFundingAccount_Execute(t *testing.T) {
 
 		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chainName}}, userInfo).
 			Return(chains, nil)
-		mockGetFaucetCandidate.EXPECT().Execute(gomock.Any(), account.Address, chains[0], userInfo).Return(faucet, nil)
-		mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).Return(nil, nil)
+		mockSearchFaucetsUC.EXPECT().Execute(gomock.Any(), &entities.FaucetFilters{ChainRule: chains[0].UUID}, userInfo).
+			Return([]*entities.Faucet{testdata.FakeFaucet()}, nil)
+		var fundingJobUUID string
+		mockGetFaucetCandidate.EXPECT().Execute(gomock.Any(), account.Address, chains[0], nil, gomock.Any(), userInfo).
+			DoAndReturn(func(ctx context.Context, _ ethcommon.Address, _ *entities.Chain, _ *ethcommon.Address, jobUUID string, _ *multitenancy.UserInfo) (*entities.Faucet, error) {
+				fundingJobUUID = jobUUID
+				return faucet, nil
+			})
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).
+			DoAndReturn(func(ctx context.Context, txRequest *entities.TxRequest, _ hexutil.Bytes, _ *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.NotEmpty(t, fundingJobUUID)
+				assert.Equal(t, fundingJobUUID, txRequest.JobUUID)
+				return txRequest, nil
+			})
 
 		err := usecase.Execute(ctx, account, chainName, userInfo)
 
@@ -55,7 +76,9 @@ func TestFundingAccount_Execute(t *testing.T) {
 		chainName := "besu"
 
 		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chainName}}, userInfo).Return(chains, nil)
-		mockGetFaucetCandidate.EXPECT().Execute(gomock.Any(), account.Address, chains[0], userInfo).Return(nil, faucetNotFoundErr)
+		mockSearchFaucetsUC.EXPECT().Execute(gomock.Any(), &entities.FaucetFilters{ChainRule: chains[0].UUID}, userInfo).
+			Return([]*entities.Faucet{testdata.FakeFaucet()}, nil)
+		mockGetFaucetCandidate.EXPECT().Execute(gomock.Any(), account.Address, chains[0], nil, gomock.Any(), userInfo).Return(nil, faucetNotFoundErr)
 
 		err := usecase.Execute(ctx, account, chainName, userInfo)
 
@@ -94,8 +117,10 @@ func TestFundingAccount_Execute(t *testing.T) {
 		chainName := "besu"
 
 		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chainName}}, userInfo).Return(chains, nil)
+		mockSearchFaucetsUC.EXPECT().Execute(gomock.Any(), &entities.FaucetFilters{ChainRule: chains[0].UUID}, userInfo).
+			Return([]*entities.Faucet{testdata.FakeFaucet()}, nil)
 		mockGetFaucetCandidate.EXPECT().
-			Execute(gomock.Any(), account.Address, gomock.Any(), userInfo).
+			Execute(gomock.Any(), account.Address, gomock.Any(), nil, gomock.Any(), userInfo).
 			Return(nil, expectedErr)
 
 		err := usecase.Execute(ctx, account, chainName, userInfo)
@@ -104,7 +129,7 @@ func TestFundingAccount_Execute(t *testing.T) {
 		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(fundAccountComponent), err)
 	})
 
-	t.Run("should fail with same error if send funding transaction fails", func(t *testing.T) {
+	t.Run("should release the faucet spending and fail with same error if send funding transaction fails", func(t *testing.T) {
 		expectedErr := errors.NotFoundError("error")
 		account := testdata.FakeAccount()
 		chains := []*entities.Chain{testdata.FakeChain()}
@@ -112,12 +137,62 @@ func TestFundingAccount_Execute(t *testing.T) {
 		chainName := "besu"
 
 		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chainName}}, userInfo).Return(chains, nil)
-		mockGetFaucetCandidate.EXPECT().Execute(gomock.Any(), account.Address, gomock.Any(), userInfo).Return(faucet, nil)
+		mockSearchFaucetsUC.EXPECT().Execute(gomock.Any(), &entities.FaucetFilters{ChainRule: chains[0].UUID}, userInfo).
+			Return([]*entities.Faucet{testdata.FakeFaucet()}, nil)
+		mockGetFaucetCandidate.EXPECT().Execute(gomock.Any(), account.Address, gomock.Any(), nil, gomock.Any(), userInfo).Return(faucet, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).Return(nil, expectedErr)
+		mockFaucetSpendingDA.EXPECT().DeleteByJobUUID(gomock.Any(), gomock.Any()).Return(nil)
 
 		err := usecase.Execute(ctx, account, chainName, userInfo)
 
 		assert.Error(t, err)
 		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(fundAccountComponent), err)
 	})
+
+	t.Run("should fund the account with each token of the chain faucets", func(t *testing.T) {
+		account := testdata.FakeAccount()
+		chains := []*entities.Chain{testdata.FakeChain()}
+		tokenAddress := ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07")
+		nativeFaucet := testdata.FakeFaucet()
+		tokenFaucet := testdata.FakeFaucet()
+		tokenFaucet.TokenAddress = &tokenAddress
+		chainName := "besu"
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return(chains, nil)
+		mockSearchFaucetsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).
+			Return([]*entities.Faucet{nativeFaucet, tokenFaucet, testdata.FakeFaucet()}, nil)
+		mockGetFaucetCandidate.EXPECT().Execute(gomock.Any(), account.Address, chains[0], nil, gomock.Any(), userInfo).Return(nativeFaucet, nil)
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).
+			DoAndReturn(func(ctx context.Context, txRequest *entities.TxRequest, txData hexutil.Bytes, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, account.Address, *txRequest.Params.To)
+				assert.Equal(t, nativeFaucet.Amount, *txRequest.Params.Value)
+				return txRequest, nil
+			})
+		mockGetFaucetCandidate.EXPECT().Execute(gomock.Any(), account.Address, chains[0], &tokenAddress, gomock.Any(), userInfo).Return(tokenFaucet, nil)
+		expectedTxData, _ := erc20.EncodeTransfer(account.Address, tokenFaucet.Amount.ToInt())
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), hexutil.Bytes(expectedTxData), userInfo).
+			DoAndReturn(func(ctx context.Context, txRequest *entities.TxRequest, txData hexutil.Bytes, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, tokenAddress, *txRequest.Params.To)
+				assert.Nil(t, txRequest.Params.Value)
+				assert.Equal(t, erc20.TransferMethodSignature, txRequest.Params.MethodSignature)
+				assert.Equal(t, tokenFaucet.CreditorAccount, *txRequest.Params.From)
+				return txRequest, nil
+			})
+
+		err := usecase.Execute(ctx, account, chainName, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should do nothing if there are no faucets on the chain", func(t *testing.T) {
+		account := testdata.FakeAccount()
+		chains := []*entities.Chain{testdata.FakeChain()}
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return(chains, nil)
+		mockSearchFaucetsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Faucet{}, nil)
+
+		err := usecase.Execute(ctx, account, "besu", userInfo)
+
+		assert.NoError(t, err)
+	})
 }
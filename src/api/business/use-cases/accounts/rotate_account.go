@@ -0,0 +1,232 @@
+package accounts
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/utils"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/database"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const rotateAccountComponent = "use-cases.rotate-account"
+
+// drainGasLimit is the gas consumed by a plain value transfer
+const drainGasLimit uint64 = 21000
+
+type rotateAccountUseCase struct {
+	db               store.DB
+	createAccountUC  usecases.CreateAccountUseCase
+	searchChainsUC   usecases.SearchChainsUseCase
+	sendTxUseCase    usecases.SendTxUseCase
+	chainStateReader ethclient.ChainStateReader
+	gasPricer        ethclient.GasPricer
+	logger           *log.Logger
+}
+
+func NewRotateAccountUseCase(
+	db store.DB,
+	createAccountUC usecases.CreateAccountUseCase,
+	searchChainsUC usecases.SearchChainsUseCase,
+	sendTxUseCase usecases.SendTxUseCase,
+	chainStateReader ethclient.ChainStateReader,
+	gasPricer ethclient.GasPricer,
+) usecases.RotateAccountUseCase {
+	return &rotateAccountUseCase{
+		db:               db,
+		createAccountUC:  createAccountUC,
+		searchChainsUC:   searchChainsUC,
+		sendTxUseCase:    sendTxUseCase,
+		chainStateReader: chainStateReader,
+		gasPricer:        gasPricer,
+		logger:           log.NewLogger().SetComponent(rotateAccountComponent),
+	}
+}
+
+// Execute disables the account, creates its successor and drains the remaining balance of the account on the given
+// chain, if any, to the successor
+func (uc *rotateAccountUseCase) Execute(ctx context.Context, address ethcommon.Address, successor *entities.Account, chainName string,
+	userInfo *multitenancy.UserInfo) (*entities.Account, error) {
+	ctx = log.WithFields(ctx, log.Field("address", address))
+	logger := uc.logger.WithContext(ctx)
+
+	// The account is disabled first so that concurrent rotations fail and no transaction is sent meanwhile
+	model, err := uc.disable(ctx, address, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(rotateAccountComponent)
+	}
+
+	if successor.StoreID == "" {
+		successor.StoreID = model.StoreID
+	}
+	if successor.Attributes == nil {
+		successor.Attributes = model.Attributes
+	}
+	if successor.ApprovalPolicy == nil {
+		successor.ApprovalPolicy = model.ApprovalPolicy
+	}
+
+	newAccount, err := uc.createAccountUC.Execute(ctx, successor, nil, "", userInfo)
+	if err != nil {
+		uc.enable(ctx, model)
+		return nil, errors.FromError(err).ExtendComponent(rotateAccountComponent)
+	}
+
+	newModel, err := uc.db.Account().FindOneByAddress(ctx, newAccount.Address.Hex(), userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(rotateAccountComponent)
+	}
+
+	model.Successor = newModel.Address
+	newModel.Predecessor = model.Address
+	err = database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		for _, m := range []*models.Account{model, newModel} {
+			if der := tx.(store.Tx).Account().Update(ctx, m); der != nil {
+				return der
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(rotateAccountComponent)
+	}
+
+	// Disabled accounts can still send their balance to their successor, so a failed drain can be sent again
+	if chainName != "" {
+		model.DrainTxUUID, err = uc.drain(ctx, address, newAccount.Address, chainName, userInfo)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(rotateAccountComponent)
+		}
+
+		if model.DrainTxUUID != "" {
+			if err = uc.db.Account().Update(ctx, model); err != nil {
+				return nil, errors.FromError(err).ExtendComponent(rotateAccountComponent)
+			}
+		}
+	}
+
+	logger.WithField("successor", newModel.Address).Info("account rotated successfully")
+	return parsers.NewAccountEntityFromModels(newModel), nil
+}
+
+// disable locks the account and disables it, failing if it is already disabled
+func (uc *rotateAccountUseCase) disable(ctx context.Context, address ethcommon.Address, userInfo *multitenancy.UserInfo) (*models.Account, error) {
+	var model *models.Account
+	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		der := tx.(store.Tx).Account().LockOneByAddress(ctx, address.Hex())
+		if der != nil {
+			return der
+		}
+
+		model, der = tx.(store.Tx).Account().FindOneByAddress(ctx, address.Hex(), userInfo.AllowedTenants, userInfo.Username)
+		if der != nil {
+			return der
+		}
+
+		if model.DisabledAt != nil {
+			errMsg := "cannot rotate a disabled account"
+			uc.logger.WithContext(ctx).Error(errMsg)
+			return errors.InvalidStateError(errMsg)
+		}
+
+		disabledAt := time.Now().UTC()
+		model.DisabledAt = &disabledAt
+		model.DisabledBy = userInfo.Username
+		return tx.(store.Tx).Account().Update(ctx, model)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+// enable reverts the account disabled by a rotation which failed before creating the successor
+func (uc *rotateAccountUseCase) enable(ctx context.Context, model *models.Account) {
+	model.DisabledAt = nil
+	model.DisabledBy = ""
+	if err := uc.db.Account().Update(ctx, model); err != nil {
+		uc.logger.WithContext(ctx).WithError(err).Error("failed to enable account after failed rotation")
+	}
+}
+
+// drain sends the balance of the account, minus the transfer fee, to its successor and returns the UUID of the
+// transaction request. An empty UUID is returned when the balance does not cover the fee.
+func (uc *rotateAccountUseCase) drain(ctx context.Context, from, to ethcommon.Address, chainName string, userInfo *multitenancy.UserInfo) (string, error) {
+	logger := uc.logger.WithContext(ctx).WithField("chain", chainName)
+
+	chains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{chainName}}, userInfo)
+	if err != nil {
+		return "", err
+	}
+
+	if len(chains) == 0 {
+		errMsg := "chain does not exist"
+		logger.Warn(errMsg)
+		return "", errors.InvalidParameterError(errMsg)
+	}
+
+	balance, gasPrice, err := uc.balanceAndGasPrice(ctx, chains[0].URLs, from)
+	if err != nil {
+		return "", err
+	}
+
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(drainGasLimit))
+	if balance.Cmp(fee) <= 0 {
+		logger.WithField("balance", balance.String()).Debug("balance does not cover the transfer fee, skipping drain")
+		return "", nil
+	}
+
+	gas := drainGasLimit
+	txRequest, err := uc.sendTxUseCase.Execute(ctx, &entities.TxRequest{
+		IdempotencyKey: utils.RandString(16),
+		ChainName:      chainName,
+		Params: &entities.ETHTransactionParams{
+			From:            &from,
+			To:              &to,
+			Value:           (*hexutil.Big)(new(big.Int).Sub(balance, fee)),
+			Gas:             &gas,
+			GasPrice:        (*hexutil.Big)(gasPrice),
+			TransactionType: string(entities.LegacyTxType),
+		},
+		Labels: map[string]string{
+			"rotatedAccount": from.Hex(),
+		},
+		InternalData: &entities.InternalData{},
+	}, nil, userInfo)
+	if err != nil {
+		return "", err
+	}
+
+	logger.WithField("tx_request", txRequest.Schedule.UUID).Debug("drain transaction sent")
+	return txRequest.Schedule.UUID, nil
+}
+
+func (uc *rotateAccountUseCase) balanceAndGasPrice(ctx context.Context, uris []string, address ethcommon.Address) (balance, gasPrice *big.Int, err error) {
+	for _, uri := range uris {
+		balance, err = uc.chainStateReader.BalanceAt(ctx, uri, address, nil)
+		if err == nil {
+			gasPrice, err = uc.gasPricer.SuggestGasPrice(ctx, uri)
+		}
+		if err != nil {
+			uc.logger.WithContext(ctx).WithField("url", uri).WithError(err).Error("failed to fetch balance and gas price")
+			continue
+		}
+
+		return balance, gasPrice, nil
+	}
+
+	return nil, nil, errors.EthConnectionError("all URLs in the list are unreachable")
+}
@@ -0,0 +1,203 @@
+// +build unit
+
+package accounts
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	storemocks "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	modelstestdata "github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateAccount_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storemocks.NewMockDB(ctrl)
+	mockDBTX := storemocks.NewMockTx(ctrl)
+	accountAgent := storemocks.NewMockAccountAgent(ctrl)
+	mockCreateAccountUC := mocks.NewMockCreateAccountUseCase(ctrl)
+	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+	mockSendTxUC := mocks.NewMockSendTxUseCase(ctrl)
+	mockChainStateReader := mock.NewMockChainStateReader(ctrl)
+	mockGasPricer := mock.NewMockGasPricer(ctrl)
+
+	mockDB.EXPECT().Account().Return(accountAgent).AnyTimes()
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Account().Return(accountAgent).AnyTimes()
+	mockDBTX.EXPECT().Commit().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewRotateAccountUseCase(mockDB, mockCreateAccountUC, mockSearchChainsUC, mockSendTxUC, mockChainStateReader, mockGasPricer)
+
+	t.Run("should rotate account and drain its balance successfully", func(t *testing.T) {
+		oldModel := modelstestdata.FakeAccountModel()
+		oldModel.StoreID = "my-store"
+		newModel := modelstestdata.FakeAccountModel()
+		oldAddress := ethcommon.HexToAddress(oldModel.Address)
+		newAddress := ethcommon.HexToAddress(newModel.Address)
+		chain := testdata.FakeChain()
+		txRequest := testdata.FakeTxRequest()
+
+		accountAgent.EXPECT().LockOneByAddress(gomock.Any(), oldAddress.Hex()).Return(nil)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), oldAddress.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(oldModel, nil)
+		accountAgent.EXPECT().Update(gomock.Any(), oldModel).DoAndReturn(func(_ context.Context, m *models.Account) error {
+			assert.NotNil(t, m.DisabledAt)
+			assert.Empty(t, m.Successor)
+			return nil
+		})
+		mockCreateAccountUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, "", userInfo).
+			DoAndReturn(func(_ context.Context, acc *entities.Account, _ []byte, _ string, _ *multitenancy.UserInfo) (*entities.Account, error) {
+				assert.Equal(t, "my-store", acc.StoreID)
+				assert.Equal(t, oldModel.Attributes, acc.Attributes)
+				return &entities.Account{Address: newAddress}, nil
+			})
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), newAddress.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(newModel, nil)
+		accountAgent.EXPECT().Update(gomock.Any(), oldModel).DoAndReturn(func(_ context.Context, m *models.Account) error {
+			assert.Equal(t, newAddress.Hex(), m.Successor)
+			return nil
+		})
+		accountAgent.EXPECT().Update(gomock.Any(), newModel).Return(nil)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockChainStateReader.EXPECT().BalanceAt(gomock.Any(), chain.URLs[0], oldAddress, nil).Return(big.NewInt(1000000), nil)
+		mockGasPricer.EXPECT().SuggestGasPrice(gomock.Any(), chain.URLs[0]).Return(big.NewInt(10), nil)
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).
+			DoAndReturn(func(_ context.Context, req *entities.TxRequest, _ []byte, _ *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, oldAddress, *req.Params.From)
+				assert.Equal(t, newAddress, *req.Params.To)
+				assert.Equal(t, big.NewInt(1000000-21000*10), req.Params.Value.ToInt())
+				return txRequest, nil
+			})
+		accountAgent.EXPECT().Update(gomock.Any(), oldModel).DoAndReturn(func(_ context.Context, m *models.Account) error {
+			assert.Equal(t, txRequest.Schedule.UUID, m.DrainTxUUID)
+			return nil
+		})
+
+		resp, err := usecase.Execute(ctx, oldAddress, &entities.Account{}, chain.Name, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, newAddress, resp.Address)
+		assert.Equal(t, oldAddress, *resp.Predecessor)
+		assert.Equal(t, newAddress.Hex(), oldModel.Successor)
+		assert.Equal(t, txRequest.Schedule.UUID, oldModel.DrainTxUUID)
+		assert.NotNil(t, oldModel.DisabledAt)
+		assert.Equal(t, userInfo.Username, oldModel.DisabledBy)
+	})
+
+	t.Run("should not drain account if balance does not cover the fee", func(t *testing.T) {
+		oldModel := modelstestdata.FakeAccountModel()
+		newModel := modelstestdata.FakeAccountModel()
+		oldAddress := ethcommon.HexToAddress(oldModel.Address)
+		chain := testdata.FakeChain()
+
+		accountAgent.EXPECT().LockOneByAddress(gomock.Any(), oldAddress.Hex()).Return(nil)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), oldAddress.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(oldModel, nil)
+		mockCreateAccountUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, "", userInfo).
+			Return(&entities.Account{Address: ethcommon.HexToAddress(newModel.Address)}, nil)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), newModel.Address, userInfo.AllowedTenants, userInfo.Username).Return(newModel, nil)
+		accountAgent.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockChainStateReader.EXPECT().BalanceAt(gomock.Any(), chain.URLs[0], oldAddress, nil).Return(big.NewInt(100), nil)
+		mockGasPricer.EXPECT().SuggestGasPrice(gomock.Any(), chain.URLs[0]).Return(big.NewInt(10), nil)
+
+		_, err := usecase.Execute(ctx, oldAddress, &entities.Account{}, chain.Name, userInfo)
+
+		require.NoError(t, err)
+		assert.Empty(t, oldModel.DrainTxUUID)
+		assert.NotNil(t, oldModel.DisabledAt)
+	})
+
+	t.Run("should fail with InvalidStateError if account is disabled", func(t *testing.T) {
+		oldModel := modelstestdata.FakeAccountModel()
+		disabledAt := time.Now()
+		oldModel.DisabledAt = &disabledAt
+
+		accountAgent.EXPECT().LockOneByAddress(gomock.Any(), oldModel.Address).Return(nil)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return(oldModel, nil)
+
+		_, err := usecase.Execute(ctx, ethcommon.HexToAddress(oldModel.Address), &entities.Account{}, "", userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail with same error if create account fails", func(t *testing.T) {
+		expectedErr := errors.DependencyFailureError("error")
+		oldModel := modelstestdata.FakeAccountModel()
+
+		accountAgent.EXPECT().LockOneByAddress(gomock.Any(), oldModel.Address).Return(nil)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return(oldModel, nil)
+		accountAgent.EXPECT().Update(gomock.Any(), oldModel).Return(nil).Times(2)
+		mockCreateAccountUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, "", userInfo).Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, ethcommon.HexToAddress(oldModel.Address), &entities.Account{}, "", userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(rotateAccountComponent), err)
+		assert.Nil(t, oldModel.DisabledAt)
+		assert.Empty(t, oldModel.DisabledBy)
+	})
+
+	t.Run("should fail with EthConnectionError if chain is unreachable", func(t *testing.T) {
+		oldModel := modelstestdata.FakeAccountModel()
+		chain := testdata.FakeChain()
+
+		newModel := modelstestdata.FakeAccountModel()
+
+		accountAgent.EXPECT().LockOneByAddress(gomock.Any(), oldModel.Address).Return(nil)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), oldModel.Address, userInfo.AllowedTenants, userInfo.Username).Return(oldModel, nil)
+		mockCreateAccountUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, "", userInfo).
+			Return(&entities.Account{Address: ethcommon.HexToAddress(newModel.Address)}, nil)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), newModel.Address, userInfo.AllowedTenants, userInfo.Username).Return(newModel, nil)
+		accountAgent.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockChainStateReader.EXPECT().BalanceAt(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+			Return(nil, errors.EthConnectionError("error")).Times(len(chain.URLs))
+
+		_, err := usecase.Execute(ctx, ethcommon.HexToAddress(oldModel.Address), &entities.Account{}, chain.Name, userInfo)
+
+		assert.True(t, errors.IsEthConnectionError(err))
+		assert.NotNil(t, oldModel.DisabledAt)
+		assert.Equal(t, newModel.Address, oldModel.Successor)
+	})
+
+	t.Run("should fail with same error if disable account fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+		oldModel := modelstestdata.FakeAccountModel()
+
+		accountAgent.EXPECT().LockOneByAddress(gomock.Any(), oldModel.Address).Return(nil)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), oldModel.Address, userInfo.AllowedTenants, userInfo.Username).Return(oldModel, nil)
+		accountAgent.EXPECT().Update(gomock.Any(), gomock.AssignableToTypeOf(&models.Account{})).Return(expectedErr)
+
+		_, err := usecase.Execute(ctx, ethcommon.HexToAddress(oldModel.Address), &entities.Account{}, "", userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(rotateAccountComponent), err)
+	})
+
+	t.Run("should fail with same error if lock account fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+		oldModel := modelstestdata.FakeAccountModel()
+
+		accountAgent.EXPECT().LockOneByAddress(gomock.Any(), oldModel.Address).Return(expectedErr)
+
+		_, err := usecase.Execute(ctx, ethcommon.HexToAddress(oldModel.Address), &entities.Account{}, "", userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(rotateAccountComponent), err)
+	})
+}
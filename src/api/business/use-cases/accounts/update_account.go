@@ -31,6 +31,18 @@ func (uc *updateAccountUseCase) Execute(ctx context.Context, account *entities.A
 	ctx = log.WithFields(ctx, log.Field("address", account.Address))
 	logger := uc.logger.WithContext(ctx)
 
+	if err := validateApprovalPolicy(account.ApprovalPolicy); err != nil {
+		logger.WithError(err).Error("invalid approval policy")
+		return nil, errors.FromError(err).ExtendComponent(updateAccountComponent)
+	}
+
+	// Makers must not be able to lift the approvals required on the transactions they send
+	if account.ApprovalPolicy != nil && !userInfo.IsTenantAdmin() {
+		errMessage := "only tenant administrators can change the approval policy of an account"
+		logger.Error(errMessage)
+		return nil, errors.UnauthorizedError(errMessage).ExtendComponent(updateAccountComponent)
+	}
+
 	model, err := uc.db.Account().FindOneByAddress(ctx, account.Address.Hex(), userInfo.AllowedTenants, userInfo.Username)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(updateAccountComponent)
@@ -45,6 +57,9 @@ func (uc *updateAccountUseCase) Execute(ctx context.Context, account *entities.A
 	if account.StoreID != "" {
 		model.StoreID = account.StoreID
 	}
+	if account.ApprovalPolicy != nil {
+		model.ApprovalPolicy = account.ApprovalPolicy
+	}
 
 	err = uc.db.Account().Update(ctx, model)
 	if err != nil {
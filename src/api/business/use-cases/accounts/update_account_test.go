@@ -8,6 +8,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	modelstestdata "github.com/consensys/orchestrate/src/api/store/models/testdata"
@@ -58,6 +59,38 @@ func TestUpdateAccount_Execute(t *testing.T) {
 		assert.Equal(t, resp.Alias, idenModel.Alias)
 	})
 
+	t.Run("should update approval policy successfully", func(t *testing.T) {
+		idenEntity := testdata.FakeAccount()
+		idenEntity.ApprovalPolicy = &entities.ApprovalPolicy{Threshold: 2, Approvers: []string{"alice", "bob", "carol"}}
+		idenModel := modelstestdata.FakeAccountModel()
+		identityAgent.EXPECT().FindOneByAddress(gomock.Any(), idenEntity.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(idenModel, nil)
+
+		identityAgent.EXPECT().Update(gomock.Any(), idenModel).Return(nil)
+		resp, err := usecase.Execute(ctx, idenEntity, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, idenEntity.ApprovalPolicy, resp.ApprovalPolicy)
+	})
+
+	t.Run("should fail with InvalidParameterError if approval threshold exceeds the number of approvers", func(t *testing.T) {
+		idenEntity := testdata.FakeAccount()
+		idenEntity.ApprovalPolicy = &entities.ApprovalPolicy{Threshold: 3, Approvers: []string{"alice", "bob"}}
+
+		_, err := usecase.Execute(ctx, idenEntity, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with UnauthorizedError if a tenant user updates the approval policy", func(t *testing.T) {
+		idenEntity := testdata.FakeAccount()
+		idenEntity.ApprovalPolicy = &entities.ApprovalPolicy{Threshold: 1, Approvers: []string{"username"}}
+		makerInfo := multitenancy.NewJWTUserInfo(&entities.UserClaims{TenantID: "tenantOne", Username: "username"}, "token")
+
+		_, err := usecase.Execute(ctx, idenEntity, makerInfo)
+
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
 	t.Run("should fail with same error if get identity fails", func(t *testing.T) {
 		expectedErr := errors.NotFoundError("error")
 		idenEntity := testdata.FakeAccount()
@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/entities"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -18,32 +19,47 @@ type ContractUseCases interface {
 	SetContractCodeHash() SetContractCodeHashUseCase
 	RegisterContract() RegisterContractUseCase
 	SearchContract() SearchContractUseCase
+	DeregisterContract() DeregisterContractUseCase
+	DeleteContractRepository() DeleteContractRepositoryUseCase
+	SetContractTag() SetContractTagUseCase
 }
 
 type GetContractsCatalogUseCase interface {
-	Execute(ctx context.Context) ([]string, error)
+	Execute(ctx context.Context, userInfo *multitenancy.UserInfo) ([]string, error)
 }
 
 type GetContractUseCase interface {
-	Execute(ctx context.Context, name, tag string) (*entities.Contract, error)
+	Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) (*entities.Contract, error)
 }
 
 type SearchContractUseCase interface {
-	Execute(ctx context.Context, codehash hexutil.Bytes, address *ethcommon.Address) (*entities.Contract, error)
+	Execute(ctx context.Context, codehash hexutil.Bytes, address *ethcommon.Address, userInfo *multitenancy.UserInfo) (*entities.Contract, error)
 }
 
 type GetContractEventsUseCase interface {
-	Execute(ctx context.Context, chainID string, address ethcommon.Address, codeHash hexutil.Bytes, indexedInputCount uint32) (abi string, eventsABI []string, err error)
+	Execute(ctx context.Context, chainID string, address ethcommon.Address, codeHash hexutil.Bytes, indexedInputCount uint32, userInfo *multitenancy.UserInfo) (abi string, eventsABI []string, err error)
 }
 
 type GetContractTagsUseCase interface {
-	Execute(ctx context.Context, name string) ([]string, error)
+	Execute(ctx context.Context, name string, userInfo *multitenancy.UserInfo) ([]string, error)
 }
 
 type RegisterContractUseCase interface {
-	Execute(ctx context.Context, contract *entities.Contract) error
+	Execute(ctx context.Context, contract *entities.Contract, userInfo *multitenancy.UserInfo) error
+}
+
+type DeregisterContractUseCase interface {
+	Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) error
+}
+
+type DeleteContractRepositoryUseCase interface {
+	Execute(ctx context.Context, name string, userInfo *multitenancy.UserInfo) error
+}
+
+type SetContractTagUseCase interface {
+	Execute(ctx context.Context, name, tag, sourceTag string, userInfo *multitenancy.UserInfo) error
 }
 
 type SetContractCodeHashUseCase interface {
-	Execute(ctx context.Context, chainID string, address ethcommon.Address, codeHash hexutil.Bytes) error
+	Execute(ctx context.Context, chainID string, address ethcommon.Address, codeHash hexutil.Bytes, userInfo *multitenancy.UserInfo) error
 }
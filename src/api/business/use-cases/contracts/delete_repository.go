@@ -0,0 +1,71 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/infra/database"
+)
+
+const deleteRepositoryComponent = "use-cases.delete-repository"
+
+type deleteRepositoryUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+func NewDeleteRepositoryUseCase(db store.DB) usecases.DeleteContractRepositoryUseCase {
+	return &deleteRepositoryUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(deleteRepositoryComponent),
+	}
+}
+
+// Execute deletes a contract with all its tags
+func (uc *deleteRepositoryUseCase) Execute(ctx context.Context, name string, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("contract_name", name))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("deleting contract repository")
+
+	repository, err := uc.db.Repository().FindOne(ctx, name, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteRepositoryComponent)
+	}
+
+	if err = checkRepositoryOwnership(repository, userInfo); err != nil {
+		logger.WithError(err).Error("cannot modify contract")
+		return errors.FromError(err).ExtendComponent(deleteRepositoryComponent)
+	}
+
+	err = database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		dbtx := tx.(store.Tx)
+		tags, der := dbtx.Tag().FindAllByRepositoryID(ctx, repository.ID)
+		if der != nil {
+			return der
+		}
+
+		var artifactIDs []int
+		for _, tag := range tags {
+			if der = dbtx.Tag().Delete(ctx, tag); der != nil {
+				return der
+			}
+			artifactIDs = append(artifactIDs, tag.ArtifactID)
+		}
+
+		if der = dbtx.Repository().Delete(ctx, repository); der != nil {
+			return der
+		}
+
+		return deleteUnusedArtifacts(ctx, dbtx, artifactIDs)
+	})
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteRepositoryComponent)
+	}
+
+	logger.Info("contract repository deleted successfully")
+	return nil
+}
@@ -0,0 +1,79 @@
+// +build unit
+
+package contracts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteRepository_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	repositoryAgent := mocks.NewMockRepositoryAgent(ctrl)
+	tagAgent := mocks.NewMockTagAgent(ctrl)
+	artifactAgent := mocks.NewMockArtifactAgent(ctrl)
+	eventAgent := mocks.NewMockEventAgent(ctrl)
+	codeHashAgent := mocks.NewMockCodeHashAgent(ctrl)
+
+	mockDB.EXPECT().Repository().Return(repositoryAgent).AnyTimes()
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Tag().Return(tagAgent).AnyTimes()
+	mockDBTX.EXPECT().Repository().Return(repositoryAgent).AnyTimes()
+	mockDBTX.EXPECT().Artifact().Return(artifactAgent).AnyTimes()
+	mockDBTX.EXPECT().Event().Return(eventAgent).AnyTimes()
+	mockDBTX.EXPECT().CodeHash().Return(codeHashAgent).AnyTimes()
+
+	usecase := NewDeleteRepositoryUseCase(mockDB)
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	repository := &models.RepositoryModel{ID: 1, Name: "myContract", TenantID: "tenantOne"}
+
+	t.Run("should delete all the tags and the repository successfully", func(t *testing.T) {
+		tags := []*models.TagModel{
+			{ID: 2, Name: "v1.0.0", RepositoryID: 1, ArtifactID: 3},
+			{ID: 4, Name: "latest", RepositoryID: 1, ArtifactID: 3},
+		}
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "myContract", userInfo.AllowedTenants, userInfo.Username).Return(repository, nil)
+		tagAgent.EXPECT().FindAllByRepositoryID(gomock.Any(), repository.ID).Return(tags, nil)
+		tagAgent.EXPECT().Delete(gomock.Any(), tags[0]).Return(nil)
+		tagAgent.EXPECT().Delete(gomock.Any(), tags[1]).Return(nil)
+		repositoryAgent.EXPECT().Delete(gomock.Any(), repository).Return(nil)
+		artifactAgent.EXPECT().DeleteUnused(gomock.Any(), []int{3, 3}).Return([]*models.ArtifactModel{{ID: 3, Codehash: "codeHash"}}, nil)
+		eventAgent.EXPECT().DeleteUnused(gomock.Any(), []string{"codeHash"}).Return(nil)
+		codeHashAgent.EXPECT().DeleteUnused(gomock.Any(), []string{"codeHash"}).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		err := usecase.Execute(ctx, "myContract", userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with UnauthorizedError if the repository is shared by the default tenant", func(t *testing.T) {
+		sharedRepository := &models.RepositoryModel{ID: 2, Name: "myContract", TenantID: multitenancy.DefaultTenant}
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "myContract", userInfo.AllowedTenants, userInfo.Username).Return(sharedRepository, nil)
+
+		err := usecase.Execute(ctx, "myContract", userInfo)
+
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should fail with same error if repository is not found", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "myContract", userInfo.AllowedTenants, userInfo.Username).Return(nil, expectedErr)
+
+		err := usecase.Execute(ctx, "myContract", userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(deleteRepositoryComponent), err)
+	})
+}
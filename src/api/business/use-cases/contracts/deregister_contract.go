@@ -0,0 +1,74 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/infra/database"
+)
+
+const deregisterContractComponent = "use-cases.deregister-contract"
+
+type deregisterContractUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+func NewDeregisterContractUseCase(db store.DB) usecases.DeregisterContractUseCase {
+	return &deregisterContractUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(deregisterContractComponent),
+	}
+}
+
+// Execute removes a tag of a contract, the contract being deleted with its last tag
+func (uc *deregisterContractUseCase) Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("contract_name", name), log.Field("contract_tag", tag))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("deregistering contract")
+
+	repository, err := uc.db.Repository().FindOne(ctx, name, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deregisterContractComponent)
+	}
+
+	if err = checkRepositoryOwnership(repository, userInfo); err != nil {
+		logger.WithError(err).Error("cannot modify contract")
+		return errors.FromError(err).ExtendComponent(deregisterContractComponent)
+	}
+
+	tagModel, err := uc.db.Tag().FindOneByName(ctx, repository.ID, tag)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deregisterContractComponent)
+	}
+
+	err = database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		dbtx := tx.(store.Tx)
+		if der := dbtx.Tag().Delete(ctx, tagModel); der != nil {
+			return der
+		}
+
+		remainingTags, der := dbtx.Tag().FindAllByRepositoryID(ctx, repository.ID)
+		if der != nil {
+			return der
+		}
+
+		if len(remainingTags) == 0 {
+			if der = dbtx.Repository().Delete(ctx, repository); der != nil {
+				return der
+			}
+		}
+
+		return deleteUnusedArtifacts(ctx, dbtx, []int{tagModel.ArtifactID})
+	})
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deregisterContractComponent)
+	}
+
+	logger.Info("contract deregistered successfully")
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/store"
 )
@@ -24,9 +25,9 @@ func NewGetCatalogUseCase(agent store.RepositoryAgent) usecases.GetContractsCata
 }
 
 // TODO: Modify to get all contracts and then only return necessary fields instead of getting only names
-// Execute gets all contract names from DB
-func (uc *getCatalogUseCase) Execute(ctx context.Context) ([]string, error) {
-	names, err := uc.agent.FindAll(ctx)
+// Execute gets the names of all the contracts the user is allowed to see from DB
+func (uc *getCatalogUseCase) Execute(ctx context.Context, userInfo *multitenancy.UserInfo) ([]string, error) {
+	names, err := uc.agent.FindAll(ctx, userInfo.AllowedTenants, userInfo.Username)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(getCatalogComponent)
 	}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/consensys/orchestrate/pkg/errors"
@@ -19,12 +21,13 @@ func TestGetCatalog_Execute(t *testing.T) {
 
 	repositoryAgent := mocks.NewMockRepositoryAgent(ctrl)
 	usecase := NewGetCatalogUseCase(repositoryAgent)
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		names := []string{"Contract0", "Contract1"}
-		repositoryAgent.EXPECT().FindAll(gomock.Any()).Return(names, nil)
+		repositoryAgent.EXPECT().FindAll(gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return(names, nil)
 
-		response, err := usecase.Execute(context.Background())
+		response, err := usecase.Execute(context.Background(), userInfo)
 
 		assert.Equal(t, response, names)
 		assert.NoError(t, err)
@@ -32,9 +35,9 @@ func TestGetCatalog_Execute(t *testing.T) {
 
 	t.Run("should fail if data agent fails", func(t *testing.T) {
 		dataAgentError := fmt.Errorf("error")
-		repositoryAgent.EXPECT().FindAll(gomock.Any()).Return(nil, dataAgentError)
+		repositoryAgent.EXPECT().FindAll(gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return(nil, dataAgentError)
 
-		response, err := usecase.Execute(context.Background())
+		response, err := usecase.Execute(context.Background(), userInfo)
 
 		assert.Nil(t, response)
 		assert.Equal(t, errors.FromError(dataAgentError).ExtendComponent(getCatalogComponent), err)
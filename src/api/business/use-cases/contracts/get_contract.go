@@ -2,15 +2,15 @@ package contracts
 
 import (
 	"context"
-	"strings"
 
-	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/consensys/orchestrate/pkg/ethereum/abi"
 
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/quorum/common/hexutil"
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/entities"
 )
@@ -29,17 +29,17 @@ func NewGetContractUseCase(agent store.ArtifactAgent) usecases.GetContractUseCas
 	}
 }
 
-// Execute gets a contract from DB
-func (uc *getContractUseCase) Execute(ctx context.Context, name, tag string) (*entities.Contract, error) {
+// Execute gets a contract from DB, preferring the one registered in the most specific tenant of the user
+func (uc *getContractUseCase) Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) (*entities.Contract, error) {
 	ctx = log.WithFields(ctx, log.Field("contract_name", name), log.Field("contract_tag", name))
 	logger := uc.logger.WithContext(ctx)
 
-	artifact, err := uc.agent.FindOneByNameAndTag(ctx, name, tag)
+	artifact, err := uc.agent.FindOneByNameAndTag(ctx, name, tag, userInfo.AllowedTenants, userInfo.Username)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(getContractComponent)
 	}
 
-	parsedABI, err := abi.JSON(strings.NewReader(artifact.ABI))
+	parsedABI, err := abi.ParseABI(artifact.ABI)
 	if err != nil {
 		errMessage := "failed to parse contract abi"
 		uc.logger.WithError(err).Error(errMessage)
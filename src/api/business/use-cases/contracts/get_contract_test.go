@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
@@ -23,10 +25,11 @@ func TestGetContract_Execute(t *testing.T) {
 	contract := testdata.FakeContract()
 	artifactAgent := mocks.NewMockArtifactAgent(ctrl)
 	usecase := NewGetContractUseCase(artifactAgent)
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		artifactAgent.EXPECT().
-			FindOneByNameAndTag(gomock.Any(), contract.Name, contract.Tag).
+			FindOneByNameAndTag(gomock.Any(), contract.Name, contract.Tag, userInfo.AllowedTenants, userInfo.Username).
 			Return(&models.ArtifactModel{
 				ID:               1,
 				ABI:              contract.RawABI,
@@ -35,7 +38,7 @@ func TestGetContract_Execute(t *testing.T) {
 				Codehash:         "",
 			}, nil)
 
-		response, err := usecase.Execute(ctx, contract.Name, contract.Tag)
+		response, err := usecase.Execute(ctx, contract.Name, contract.Tag, userInfo)
 
 		assert.NoError(t, err)
 		assert.Equal(t, contract.Bytecode, response.Bytecode)
@@ -47,9 +50,9 @@ func TestGetContract_Execute(t *testing.T) {
 
 	t.Run("should fail if data agent fails", func(t *testing.T) {
 		dataAgentError := fmt.Errorf("error")
-		artifactAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), contract.Name, contract.Tag).Return(nil, dataAgentError)
+		artifactAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), contract.Name, contract.Tag, userInfo.AllowedTenants, userInfo.Username).Return(nil, dataAgentError)
 
-		response, err := usecase.Execute(ctx, contract.Name, contract.Tag)
+		response, err := usecase.Execute(ctx, contract.Name, contract.Tag, userInfo)
 
 		assert.Nil(t, response)
 		assert.Equal(t, errors.FromError(dataAgentError).ExtendComponent(getContractComponent), err)
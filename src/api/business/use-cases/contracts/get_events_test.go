@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	"github.com/consensys/orchestrate/src/api/store/models"
@@ -25,15 +26,16 @@ func TestGetEvents_Execute(t *testing.T) {
 		ABI: "eventABI",
 	}
 
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 	eventAgent := mocks.NewMockEventAgent(ctrl)
 	usecase := NewGetEventsUseCase(eventAgent)
 
 	t.Run("should execute use case successfully if event is found", func(t *testing.T) {
 		eventAgent.EXPECT().
-			FindOneByAccountAndSigHash(gomock.Any(), chainID, contractAddress.Hex(), sigHash.String(), indexedInputCount).
+			FindOneByAccountAndSigHash(gomock.Any(), chainID, contractAddress.Hex(), sigHash.String(), indexedInputCount, userInfo.AllowedTenants).
 			Return(eventModel, nil)
 
-		responseABI, eventsABI, err := usecase.Execute(ctx, chainID, contractAddress, sigHash, indexedInputCount)
+		responseABI, eventsABI, err := usecase.Execute(ctx, chainID, contractAddress, sigHash, indexedInputCount, userInfo)
 
 		assert.Equal(t, responseABI, eventModel.ABI)
 		assert.Nil(t, eventsABI)
@@ -43,10 +45,10 @@ func TestGetEvents_Execute(t *testing.T) {
 	t.Run("should fail if data agent returns connection error", func(t *testing.T) {
 		pgError := errors.PostgresConnectionError("error")
 		eventAgent.EXPECT().
-			FindOneByAccountAndSigHash(gomock.Any(), chainID, contractAddress.Hex(), sigHash.String(), indexedInputCount).
+			FindOneByAccountAndSigHash(gomock.Any(), chainID, contractAddress.Hex(), sigHash.String(), indexedInputCount, userInfo.AllowedTenants).
 			Return(nil, pgError)
 
-		responseABI, eventsABI, err := usecase.Execute(ctx, chainID, contractAddress, sigHash, indexedInputCount)
+		responseABI, eventsABI, err := usecase.Execute(ctx, chainID, contractAddress, sigHash, indexedInputCount, userInfo)
 
 		assert.Equal(t, errors.FromError(pgError).ExtendComponent(getEventsComponent), err)
 		assert.Empty(t, responseABI)
@@ -55,14 +57,14 @@ func TestGetEvents_Execute(t *testing.T) {
 
 	t.Run("should execute use case successfully if event is not found", func(t *testing.T) {
 		eventAgent.EXPECT().
-			FindOneByAccountAndSigHash(gomock.Any(), chainID, contractAddress.Hex(), sigHash.String(), indexedInputCount).
+			FindOneByAccountAndSigHash(gomock.Any(), chainID, contractAddress.Hex(), sigHash.String(), indexedInputCount, userInfo.AllowedTenants).
 			Return(nil, nil)
 
 		eventAgent.EXPECT().
-			FindDefaultBySigHash(gomock.Any(), sigHash.String(), indexedInputCount).
+			FindDefaultBySigHash(gomock.Any(), sigHash.String(), indexedInputCount, userInfo.AllowedTenants, userInfo.Username).
 			Return([]*models.EventModel{eventModel, eventModel}, nil)
 
-		responseABI, eventsABI, err := usecase.Execute(ctx, chainID, contractAddress, sigHash, indexedInputCount)
+		responseABI, eventsABI, err := usecase.Execute(ctx, chainID, contractAddress, sigHash, indexedInputCount, userInfo)
 
 		assert.Equal(t, eventsABI, []string{eventModel.ABI, eventModel.ABI})
 		assert.Empty(t, responseABI)
@@ -72,12 +74,12 @@ func TestGetEvents_Execute(t *testing.T) {
 	t.Run("should fail if data agent returns error on find default", func(t *testing.T) {
 		pgError := errors.PostgresConnectionError("error")
 		eventAgent.EXPECT().
-			FindOneByAccountAndSigHash(gomock.Any(), chainID, contractAddress.Hex(), sigHash.String(), indexedInputCount).
+			FindOneByAccountAndSigHash(gomock.Any(), chainID, contractAddress.Hex(), sigHash.String(), indexedInputCount, userInfo.AllowedTenants).
 			Return(nil, nil)
-		eventAgent.EXPECT().FindDefaultBySigHash(gomock.Any(), sigHash.String(), indexedInputCount).
+		eventAgent.EXPECT().FindDefaultBySigHash(gomock.Any(), sigHash.String(), indexedInputCount, userInfo.AllowedTenants, userInfo.Username).
 			Return(nil, pgError)
 
-		responseABI, eventsABI, err := usecase.Execute(ctx, chainID, contractAddress, sigHash, indexedInputCount)
+		responseABI, eventsABI, err := usecase.Execute(ctx, chainID, contractAddress, sigHash, indexedInputCount, userInfo)
 
 		assert.Equal(t, errors.FromError(pgError).ExtendComponent(getEventsComponent), err)
 		assert.Empty(t, responseABI)
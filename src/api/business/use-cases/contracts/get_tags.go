@@ -5,6 +5,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/store"
 )
@@ -23,9 +24,9 @@ func NewGetTagsUseCase(agent store.TagAgent) usecases.GetContractTagsUseCase {
 	}
 }
 
-func (uc *getTagsUseCase) Execute(ctx context.Context, name string) ([]string, error) {
+func (uc *getTagsUseCase) Execute(ctx context.Context, name string, userInfo *multitenancy.UserInfo) ([]string, error) {
 	ctx = log.WithFields(ctx, log.Field("contract_name", name))
-	names, err := uc.agent.FindAllByName(ctx, name)
+	names, err := uc.agent.FindAllByName(ctx, name, userInfo.AllowedTenants, userInfo.Username)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(getTagsComponent)
 	}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/consensys/orchestrate/pkg/errors"
@@ -21,12 +23,13 @@ func TestGetTags_Execute(t *testing.T) {
 	contractName := "myContract"
 	tagAgent := mocks.NewMockTagAgent(ctrl)
 	usecase := NewGetTagsUseCase(tagAgent)
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		tags := []string{"latest", "v1.0.0"}
-		tagAgent.EXPECT().FindAllByName(gomock.Any(), contractName).Return(tags, nil)
+		tagAgent.EXPECT().FindAllByName(gomock.Any(), contractName, userInfo.AllowedTenants, userInfo.Username).Return(tags, nil)
 
-		response, err := usecase.Execute(ctx, contractName)
+		response, err := usecase.Execute(ctx, contractName, userInfo)
 
 		assert.Equal(t, response, tags)
 		assert.NoError(t, err)
@@ -34,9 +37,9 @@ func TestGetTags_Execute(t *testing.T) {
 
 	t.Run("should fail if data agent fails", func(t *testing.T) {
 		dataAgentError := fmt.Errorf("error")
-		tagAgent.EXPECT().FindAllByName(gomock.Any(), contractName).Return(nil, dataAgentError)
+		tagAgent.EXPECT().FindAllByName(gomock.Any(), contractName, userInfo.AllowedTenants, userInfo.Username).Return(nil, dataAgentError)
 
-		response, err := usecase.Execute(ctx, contractName)
+		response, err := usecase.Execute(ctx, contractName, userInfo)
 
 		assert.Nil(t, response)
 		assert.Equal(t, errors.FromError(dataAgentError).ExtendComponent(getTagsComponent), err)
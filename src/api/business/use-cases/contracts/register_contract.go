@@ -9,6 +9,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/api/store/models"
@@ -34,7 +35,7 @@ func NewRegisterContractUseCase(agent store.DB) usecases.RegisterContractUseCase
 	}
 }
 
-func (uc *registerContractUseCase) Execute(ctx context.Context, contract *entities.Contract) error {
+func (uc *registerContractUseCase) Execute(ctx context.Context, contract *entities.Contract, userInfo *multitenancy.UserInfo) error {
 	ctx = log.WithFields(ctx, log.Field("contract_id", contract))
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("registering contract starting...")
@@ -51,7 +52,9 @@ func (uc *registerContractUseCase) Execute(ctx context.Context, contract *entiti
 	}
 
 	repository := &models.RepositoryModel{
-		Name: contract.Name,
+		Name:     contract.Name,
+		TenantID: repositoryTenantID(userInfo),
+		OwnerID:  repositoryOwnerID(userInfo),
 	}
 	artifact := &models.ArtifactModel{
 		ABI:              abiRaw,
@@ -98,6 +101,23 @@ func (uc *registerContractUseCase) Execute(ctx context.Context, contract *entiti
 	return nil
 }
 
+// Contracts registered with wildcard permissions are shared with every tenant
+func repositoryTenantID(userInfo *multitenancy.UserInfo) string {
+	if userInfo.TenantID == multitenancy.WildcardTenant {
+		return multitenancy.DefaultTenant
+	}
+
+	return userInfo.TenantID
+}
+
+func repositoryOwnerID(userInfo *multitenancy.UserInfo) string {
+	if userInfo.Username == multitenancy.WildcardOwner {
+		return ""
+	}
+
+	return userInfo.Username
+}
+
 func getEvents(contractAbi *abi.ABI, deployedBytecode hexutil.Bytes, codeHash common.Hash, eventJSONs map[string]string) []*models.EventModel {
 	var events []*models.EventModel
 	// nolint
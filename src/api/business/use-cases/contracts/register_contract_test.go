@@ -6,6 +6,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	"github.com/consensys/orchestrate/src/api/store/models"
@@ -36,12 +38,36 @@ func TestRegisterContract_Execute(t *testing.T) {
 	//@TODO Add more advance test flows
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		contract := testdata.FakeContract()
-		repositoryAgent.EXPECT().SelectOrInsert(gomock.Any(), gomock.AssignableToTypeOf(&models.RepositoryModel{})).Return(nil)
+		userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+		repositoryAgent.EXPECT().SelectOrInsert(gomock.Any(), gomock.AssignableToTypeOf(&models.RepositoryModel{})).
+			DoAndReturn(func(ctx context.Context, repository *models.RepositoryModel) error {
+				assert.Equal(t, contract.Name, repository.Name)
+				assert.Equal(t, userInfo.TenantID, repository.TenantID)
+				assert.Equal(t, userInfo.Username, repository.OwnerID)
+				return nil
+			})
+		artifactAgent.EXPECT().SelectOrInsert(gomock.Any(), gomock.AssignableToTypeOf(&models.ArtifactModel{})).Return(nil)
+		tagAgent.EXPECT().Insert(gomock.Any(), gomock.AssignableToTypeOf(&models.TagModel{}))
+		eventAgent.EXPECT().InsertMultiple(gomock.Any(), gomock.AssignableToTypeOf([]*models.EventModel{}))
+		mockDBTX.EXPECT().Commit().Return(nil)
+		err := usecase.Execute(ctx, contract, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should register a shared contract if user has wildcard permissions", func(t *testing.T) {
+		contract := testdata.FakeContract()
+		repositoryAgent.EXPECT().SelectOrInsert(gomock.Any(), gomock.AssignableToTypeOf(&models.RepositoryModel{})).
+			DoAndReturn(func(ctx context.Context, repository *models.RepositoryModel) error {
+				assert.Equal(t, multitenancy.DefaultTenant, repository.TenantID)
+				assert.Empty(t, repository.OwnerID)
+				return nil
+			})
 		artifactAgent.EXPECT().SelectOrInsert(gomock.Any(), gomock.AssignableToTypeOf(&models.ArtifactModel{})).Return(nil)
 		tagAgent.EXPECT().Insert(gomock.Any(), gomock.AssignableToTypeOf(&models.TagModel{}))
 		eventAgent.EXPECT().InsertMultiple(gomock.Any(), gomock.AssignableToTypeOf([]*models.EventModel{}))
 		mockDBTX.EXPECT().Commit().Return(nil)
-		err := usecase.Execute(ctx, contract)
+		err := usecase.Execute(ctx, contract, multitenancy.NewInternalAdminUser())
 
 		assert.NoError(t, err)
 	})
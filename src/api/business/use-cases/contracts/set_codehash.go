@@ -5,6 +5,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/store"
 	models2 "github.com/consensys/orchestrate/src/api/store/models"
@@ -26,7 +27,7 @@ func NewSetCodeHashUseCase(agent store.CodeHashAgent) usecases.SetContractCodeHa
 	}
 }
 
-func (uc *setCodeHashUseCase) Execute(ctx context.Context, chainID string, address ethcommon.Address, codeHash hexutil.Bytes) error {
+func (uc *setCodeHashUseCase) Execute(ctx context.Context, chainID string, address ethcommon.Address, codeHash hexutil.Bytes, userInfo *multitenancy.UserInfo) error {
 	ctx = log.WithFields(ctx, log.Field("chain_id", chainID), log.Field("address", chainID))
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("setting code-hash is starting ...")
@@ -35,6 +36,8 @@ func (uc *setCodeHashUseCase) Execute(ctx context.Context, chainID string, addre
 		ChainID:  chainID,
 		Address:  address.Hex(),
 		Codehash: codeHash.String(),
+		// The binding is only used to decode the events of the tenant which declared it
+		TenantID: userInfo.TenantID,
 	}
 
 	err := uc.agent.Insert(ctx, codehash)
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	models2 "github.com/consensys/orchestrate/src/api/store/models"
@@ -21,10 +22,12 @@ func TestSetCodeHash_Execute(t *testing.T) {
 	ctx := context.Background()
 
 	codeHash := utils.StringToHexBytes("0xAB")
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 	codeHashModel := &models2.CodehashModel{
 		ChainID:  chainID,
 		Address:  contractAddress.Hex(),
 		Codehash: codeHash.String(),
+		TenantID: userInfo.TenantID,
 	}
 	codeHashAgent := mocks.NewMockCodeHashAgent(ctrl)
 	usecase := NewSetCodeHashUseCase(codeHashAgent)
@@ -32,7 +35,7 @@ func TestSetCodeHash_Execute(t *testing.T) {
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		codeHashAgent.EXPECT().Insert(gomock.Any(), codeHashModel).Return(nil)
 
-		err := usecase.Execute(ctx, chainID, contractAddress, codeHash)
+		err := usecase.Execute(ctx, chainID, contractAddress, codeHash, userInfo)
 
 		assert.NoError(t, err)
 	})
@@ -41,7 +44,7 @@ func TestSetCodeHash_Execute(t *testing.T) {
 		dataAgentError := fmt.Errorf("error")
 		codeHashAgent.EXPECT().Insert(gomock.Any(), codeHashModel).Return(dataAgentError)
 
-		err := usecase.Execute(ctx, chainID, contractAddress, codeHash)
+		err := usecase.Execute(ctx, chainID, contractAddress, codeHash, userInfo)
 
 		assert.Equal(t, errors.FromError(dataAgentError).ExtendComponent(setCodeHashComponent), err)
 	})
@@ -0,0 +1,84 @@
+package contracts
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/infra/database"
+)
+
+const setTagComponent = "use-cases.set-tag"
+
+type setTagUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+func NewSetTagUseCase(db store.DB) usecases.SetContractTagUseCase {
+	return &setTagUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(setTagComponent),
+	}
+}
+
+// Execute points a tag of a contract to the artifact of another tag, creating the tag if it does not exist
+func (uc *setTagUseCase) Execute(ctx context.Context, name, tag, sourceTag string, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("contract_name", name), log.Field("contract_tag", tag))
+	logger := uc.logger.WithContext(ctx).WithField("source_tag", sourceTag)
+	logger.Debug("setting contract tag")
+
+	repository, err := uc.db.Repository().FindOne(ctx, name, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(setTagComponent)
+	}
+
+	if err = checkRepositoryOwnership(repository, userInfo); err != nil {
+		logger.WithError(err).Error("cannot modify contract")
+		return errors.FromError(err).ExtendComponent(setTagComponent)
+	}
+
+	source, err := uc.db.Tag().FindOneByName(ctx, repository.ID, sourceTag)
+	if errors.IsNotFoundError(err) {
+		return errors.InvalidParameterError("source tag %s not found", sourceTag).ExtendComponent(setTagComponent)
+	}
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(setTagComponent)
+	}
+
+	tagModel := &models.TagModel{
+		Name:         tag,
+		RepositoryID: repository.ID,
+		ArtifactID:   source.ArtifactID,
+	}
+	previous, err := uc.db.Tag().FindOneByName(ctx, repository.ID, tag)
+	switch {
+	case err == nil:
+		tagModel.Name = previous.Name
+	case !errors.IsNotFoundError(err):
+		return errors.FromError(err).ExtendComponent(setTagComponent)
+	}
+
+	err = database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		dbtx := tx.(store.Tx)
+		if der := dbtx.Tag().Insert(ctx, tagModel); der != nil {
+			return der
+		}
+
+		if previous != nil && previous.ArtifactID != source.ArtifactID {
+			return deleteUnusedArtifacts(ctx, dbtx, []int{previous.ArtifactID})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(setTagComponent)
+	}
+
+	logger.Info("contract tag set successfully")
+	return nil
+}
@@ -0,0 +1,89 @@
+// +build unit
+
+package contracts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTag_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	repositoryAgent := mocks.NewMockRepositoryAgent(ctrl)
+	tagAgent := mocks.NewMockTagAgent(ctrl)
+	artifactAgent := mocks.NewMockArtifactAgent(ctrl)
+	eventAgent := mocks.NewMockEventAgent(ctrl)
+	codeHashAgent := mocks.NewMockCodeHashAgent(ctrl)
+
+	mockDB.EXPECT().Repository().Return(repositoryAgent).AnyTimes()
+	mockDB.EXPECT().Tag().Return(tagAgent).AnyTimes()
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Tag().Return(tagAgent).AnyTimes()
+	mockDBTX.EXPECT().Artifact().Return(artifactAgent).AnyTimes()
+	mockDBTX.EXPECT().Event().Return(eventAgent).AnyTimes()
+	mockDBTX.EXPECT().CodeHash().Return(codeHashAgent).AnyTimes()
+
+	usecase := NewSetTagUseCase(mockDB)
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	repository := &models.RepositoryModel{ID: 1, Name: "myContract", TenantID: "tenantOne"}
+	source := &models.TagModel{ID: 2, Name: "v2.0.0", RepositoryID: 1, ArtifactID: 3}
+
+	t.Run("should create an alias of the source tag successfully", func(t *testing.T) {
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "myContract", userInfo.AllowedTenants, userInfo.Username).Return(repository, nil)
+		tagAgent.EXPECT().FindOneByName(gomock.Any(), repository.ID, "v2.0.0").Return(source, nil)
+		tagAgent.EXPECT().FindOneByName(gomock.Any(), repository.ID, "stable").Return(nil, errors.NotFoundError("error"))
+		tagAgent.EXPECT().Insert(gomock.Any(), &models.TagModel{Name: "stable", RepositoryID: 1, ArtifactID: 3}).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		err := usecase.Execute(ctx, "myContract", "stable", "v2.0.0", userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should move an existing tag and delete its former artifact if unused", func(t *testing.T) {
+		previous := &models.TagModel{ID: 4, Name: "latest", RepositoryID: 1, ArtifactID: 5}
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "myContract", userInfo.AllowedTenants, userInfo.Username).Return(repository, nil)
+		tagAgent.EXPECT().FindOneByName(gomock.Any(), repository.ID, "v2.0.0").Return(source, nil)
+		tagAgent.EXPECT().FindOneByName(gomock.Any(), repository.ID, "LATEST").Return(previous, nil)
+		tagAgent.EXPECT().Insert(gomock.Any(), &models.TagModel{Name: "latest", RepositoryID: 1, ArtifactID: 3}).Return(nil)
+		artifactAgent.EXPECT().DeleteUnused(gomock.Any(), []int{5}).Return([]*models.ArtifactModel{}, nil)
+		eventAgent.EXPECT().DeleteUnused(gomock.Any(), nil).Return(nil)
+		codeHashAgent.EXPECT().DeleteUnused(gomock.Any(), nil).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		err := usecase.Execute(ctx, "myContract", "LATEST", "v2.0.0", userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with UnauthorizedError if a tenant user does not own the repository", func(t *testing.T) {
+		makerInfo := multitenancy.NewJWTUserInfo(&entities.UserClaims{TenantID: "tenantOne", Username: "alice"}, "token")
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "myContract", makerInfo.AllowedTenants, makerInfo.Username).Return(repository, nil)
+
+		err := usecase.Execute(ctx, "myContract", "latest", "v2.0.0", makerInfo)
+
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if source tag is not found", func(t *testing.T) {
+		repositoryAgent.EXPECT().FindOne(gomock.Any(), "myContract", userInfo.AllowedTenants, userInfo.Username).Return(repository, nil)
+		tagAgent.EXPECT().FindOneByName(gomock.Any(), repository.ID, "v3.0.0").Return(nil, errors.NotFoundError("error"))
+
+		err := usecase.Execute(ctx, "myContract", "latest", "v3.0.0", userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
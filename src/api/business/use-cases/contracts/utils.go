@@ -1,6 +1,13 @@
 package contracts
 
 import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 )
 
@@ -14,3 +21,38 @@ func getIndexedCount(event *abi.Event) (indexedInputCount uint) {
 
 	return indexedInputCount
 }
+
+// deleteUnusedArtifacts deletes the given artifacts if they are not tagged anymore, along with the events and the
+// account code hashes which do not match any remaining artifact
+func deleteUnusedArtifacts(ctx context.Context, tx store.Tx, artifactIDs []int) error {
+	artifacts, err := tx.Artifact().DeleteUnused(ctx, artifactIDs)
+	if err != nil {
+		return err
+	}
+
+	var codeHashes []string
+	for _, artifact := range artifacts {
+		codeHashes = append(codeHashes, artifact.Codehash)
+	}
+
+	err = tx.Event().DeleteUnused(ctx, codeHashes)
+	if err != nil {
+		return err
+	}
+
+	return tx.CodeHash().DeleteUnused(ctx, codeHashes)
+}
+
+// checkRepositoryOwnership verifies that the user can modify the repository: repositories shared by the default or a
+// parent tenant are read-only, and repositories of the tenant belong to their owner or to the tenant administrators
+func checkRepositoryOwnership(repository *models.RepositoryModel, userInfo *multitenancy.UserInfo) error {
+	if repository.TenantID != userInfo.TenantID && !utils.ContainsString(userInfo.AllowedTenants, multitenancy.WildcardTenant) {
+		return errors.UnauthorizedError("contract %s is shared by tenant %s and cannot be modified", repository.Name, repository.TenantID)
+	}
+
+	if !userInfo.IsTenantAdmin() && repository.OwnerID != userInfo.Username {
+		return errors.UnauthorizedError("contract %s can only be modified by its owner", repository.Name)
+	}
+
+	return nil
+}
@@ -39,5 +39,5 @@ type DeleteFaucetUseCase interface {
 }
 
 type GetFaucetCandidateUseCase interface {
-	Execute(ctx context.Context, account ethcommon.Address, chain *entities.Chain, userInfo *multitenancy.UserInfo) (*entities.Faucet, error)
+	Execute(ctx context.Context, account ethcommon.Address, chain *entities.Chain, tokenAddress *ethcommon.Address, fundingJobUUID string, userInfo *multitenancy.UserInfo) (*entities.Faucet, error)
 }
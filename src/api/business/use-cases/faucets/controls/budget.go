@@ -0,0 +1,88 @@
+package controls
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const budgetComponent = "faucet.control.budget"
+
+// BudgetControl is a controller that ensures a faucet does not credit more than its daily budget, overall and to the
+// beneficiaries of each tenant
+type BudgetControl struct {
+	db store.DB
+}
+
+// NewBudgetControl creates a new budget controller
+func NewBudgetControl(db store.DB) *BudgetControl {
+	return &BudgetControl{
+		db: db,
+	}
+}
+
+// Control removes the candidates which would exceed their daily budgets
+func (ctrl *BudgetControl) Control(ctx context.Context, req *entities.FaucetRequest) error {
+	for key, candidate := range req.Candidates {
+		exceeded, err := ctrl.IsBudgetExceeded(ctx, candidate, req.TenantID)
+		if err != nil {
+			return errors.FromError(err).ExtendComponent(budgetComponent)
+		}
+
+		if exceeded {
+			log.FromContext(ctx).Debug("candidate removed due to BudgetControl")
+			delete(req.Candidates, key)
+		}
+	}
+
+	return nil
+}
+
+// OnSelectedCandidate checks again the budget of the faucet once it is locked, another replica may have credited
+// in between
+func (ctrl *BudgetControl) OnSelectedCandidate(ctx context.Context, faucet *entities.Faucet, req *entities.FaucetRequest) error {
+	exceeded, err := ctrl.IsBudgetExceeded(ctx, faucet, req.TenantID)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(budgetComponent)
+	}
+
+	if exceeded {
+		errMessage := "faucet daily budget exceeded"
+		log.FromContext(ctx).Error(errMessage)
+		return errors.FaucetWarning(errMessage).ExtendComponent(budgetComponent)
+	}
+
+	return nil
+}
+
+// IsBudgetExceeded indicates if a new credit of the faucet to a beneficiary of the tenant would exceed its daily
+// budget or its daily budget per tenant, a faucet without budget is never exceeded
+func (ctrl *BudgetControl) IsBudgetExceeded(ctx context.Context, faucet *entities.Faucet, tenantID string) (bool, error) {
+	since := time.Now().UTC().Add(-entities.FaucetBudgetPeriod)
+
+	exceeded, err := ctrl.isExceeded(ctx, faucet, faucet.DailyBudget, "", since)
+	if err != nil || exceeded {
+		return exceeded, err
+	}
+
+	return ctrl.isExceeded(ctx, faucet, faucet.TenantDailyBudget, tenantID, since)
+}
+
+func (ctrl *BudgetControl) isExceeded(ctx context.Context, faucet *entities.Faucet, budget *hexutil.Big, tenantID string, since time.Time) (bool, error) {
+	if budget == nil || budget.ToInt().Sign() == 0 {
+		return false, nil
+	}
+
+	spent, err := ctrl.db.FaucetSpending().SumSince(ctx, faucet.UUID, tenantID, since)
+	if err != nil {
+		return false, err
+	}
+
+	return new(big.Int).Add(spent, faucet.Amount.ToInt()).Cmp(budget.ToInt()) > 0, nil
+}
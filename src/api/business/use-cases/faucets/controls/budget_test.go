@@ -0,0 +1,121 @@
+// +build unit
+
+package controls
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudgetControl_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockDB := mocks.NewMockDB(mockCtrl)
+	mockFaucetSpendingDA := mocks.NewMockFaucetSpendingAgent(mockCtrl)
+	mockDB.EXPECT().FaucetSpending().Return(mockFaucetSpendingDA).AnyTimes()
+
+	ctrl := NewBudgetControl(mockDB)
+
+	faucet1 := testdata.FakeFaucet()
+	faucet1.Amount = *utils.BigIntStringToHex("10")
+	faucet1.DailyBudget = utils.BigIntStringToHex("100")
+
+	faucet2 := testdata.FakeFaucet()
+	faucet2.Amount = *utils.BigIntStringToHex("10")
+
+	t.Run("should keep candidates within their budget", func(t *testing.T) {
+		candidates := map[string]*entities.Faucet{
+			faucet1.UUID: faucet1,
+			faucet2.UUID: faucet2,
+		}
+		req := newFaucetReq(candidates, chains[0], chainURLs[0], addresses[0])
+
+		mockFaucetSpendingDA.EXPECT().SumSince(gomock.Any(), faucet1.UUID, "", gomock.Any()).Return(big.NewInt(90), nil)
+
+		err := ctrl.Control(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Len(t, req.Candidates, 2)
+	})
+
+	t.Run("should skip candidate exceeding its budget", func(t *testing.T) {
+		candidates := map[string]*entities.Faucet{
+			faucet1.UUID: faucet1,
+			faucet2.UUID: faucet2,
+		}
+		req := newFaucetReq(candidates, chains[0], chainURLs[0], addresses[0])
+
+		mockFaucetSpendingDA.EXPECT().SumSince(gomock.Any(), faucet1.UUID, "", gomock.Any()).Return(big.NewInt(91), nil)
+
+		err := ctrl.Control(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Len(t, req.Candidates, 1)
+		assert.NotNil(t, req.Candidates[faucet2.UUID])
+	})
+
+	t.Run("should fail with FaucetWarning if selected faucet exceeds its budget", func(t *testing.T) {
+		req := newFaucetReq(map[string]*entities.Faucet{}, chains[0], chainURLs[0], addresses[0])
+
+		mockFaucetSpendingDA.EXPECT().SumSince(gomock.Any(), faucet1.UUID, "", gomock.Any()).Return(big.NewInt(100), nil)
+
+		err := ctrl.OnSelectedCandidate(ctx, faucet1, req)
+
+		assert.True(t, errors.IsFaucetWarning(err))
+	})
+
+	t.Run("should skip candidate exceeding its budget for the tenant", func(t *testing.T) {
+		faucet := testdata.FakeFaucet()
+		faucet.Amount = *utils.BigIntStringToHex("10")
+		faucet.DailyBudget = utils.BigIntStringToHex("100")
+		faucet.TenantDailyBudget = utils.BigIntStringToHex("50")
+		req := newFaucetReq(map[string]*entities.Faucet{faucet.UUID: faucet}, chains[0], chainURLs[0], addresses[0])
+
+		mockFaucetSpendingDA.EXPECT().SumSince(gomock.Any(), faucet.UUID, "", gomock.Any()).Return(big.NewInt(45), nil)
+		mockFaucetSpendingDA.EXPECT().SumSince(gomock.Any(), faucet.UUID, req.TenantID, gomock.Any()).Return(big.NewInt(45), nil)
+
+		err := ctrl.Control(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Empty(t, req.Candidates)
+	})
+
+	t.Run("should fail with FaucetWarning if selected faucet exceeds its budget for the tenant", func(t *testing.T) {
+		faucet := testdata.FakeFaucet()
+		faucet.Amount = *utils.BigIntStringToHex("10")
+		faucet.TenantDailyBudget = utils.BigIntStringToHex("50")
+		req := newFaucetReq(map[string]*entities.Faucet{}, chains[0], chainURLs[0], addresses[0])
+
+		mockFaucetSpendingDA.EXPECT().SumSince(gomock.Any(), faucet.UUID, req.TenantID, gomock.Any()).Return(big.NewInt(41), nil)
+
+		err := ctrl.OnSelectedCandidate(ctx, faucet, req)
+
+		assert.True(t, errors.IsFaucetWarning(err))
+	})
+
+	t.Run("should fail with same error if spendings cannot be summed", func(t *testing.T) {
+		candidates := map[string]*entities.Faucet{
+			faucet1.UUID: faucet1,
+		}
+		req := newFaucetReq(candidates, chains[0], chainURLs[0], addresses[0])
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockFaucetSpendingDA.EXPECT().SumSince(gomock.Any(), faucet1.UUID, "", gomock.Any()).Return(nil, expectedErr)
+
+		err := ctrl.Control(ctx, req)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(budgetComponent), err)
+	})
+}
@@ -30,6 +30,7 @@ var (
 
 func newFaucetReq(candidates map[string]*entities.Faucet, chainUUID, chainURL, beneficiary string) *entities.FaucetRequest {
 	return &entities.FaucetRequest{
+		TenantID: "tenantOne",
 		Chain: &entities.Chain{
 			UUID: chainUUID,
 			URLs: []string{chainURL},
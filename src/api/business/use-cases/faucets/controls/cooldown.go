@@ -2,32 +2,29 @@ package controls
 
 import (
 	"context"
-	"fmt"
-	"sync"
 	"time"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/consensys/orchestrate/src/entities"
 
 	"github.com/consensys/orchestrate/pkg/errors"
-	stripedmutex "github.com/nmvalera/striped-mutex"
 )
 
 const cooldownComponent = "faucet.control.cooldown"
 
 // Controller that forces a minimum time interval between 2 credits
+// The last credits are read from the faucet spendings so that the cooldown holds across API replicas
 type CooldownControl struct {
-	mux            *stripedmutex.StripedMutex
-	lastAuthorized *sync.Map
+	db store.DB
 }
 
 // NewController creates a CoolDown controller
-func NewCooldownControl() *CooldownControl {
+func NewCooldownControl(db store.DB) *CooldownControl {
 	return &CooldownControl{
-		lastAuthorized: &sync.Map{},
-		mux:            stripedmutex.New(100),
+		db: db,
 	}
 }
 
@@ -39,10 +36,12 @@ func (ctrl *CooldownControl) Control(ctx context.Context, req *entities.FaucetRe
 
 	// If still cooling down we invalid credit
 	for key, candidate := range req.Candidates {
-		ctrl.lock(key, req.Beneficiary.String())
-		defer ctrl.unlock(key, req.Beneficiary.String())
+		coolingDown, err := ctrl.IsCoolingDown(ctx, candidate, req.Beneficiary)
+		if err != nil {
+			return errors.FromError(err).ExtendComponent(cooldownComponent)
+		}
 
-		if ctrl.IsCoolingDown(key, req.Beneficiary.String(), candidate.Cooldown) {
+		if coolingDown {
 			log.FromContext(ctx).Debug("candidate removed due to CooldownControl")
 			delete(req.Candidates, key)
 		}
@@ -57,28 +56,34 @@ func (ctrl *CooldownControl) Control(ctx context.Context, req *entities.FaucetRe
 	return nil
 }
 
-//nolint
-func (ctrl *CooldownControl) OnSelectedCandidate(_ context.Context, faucet *entities.Faucet, beneficiary ethcommon.Address) error {
-	ctrl.lastAuthorized.Store(mapKey(faucet.UUID, beneficiary.String()), time.Now())
-	return nil
-}
+// OnSelectedCandidate checks again the cooldown of the faucet once it is locked, another replica may have credited
+// the beneficiary in between
+func (ctrl *CooldownControl) OnSelectedCandidate(ctx context.Context, faucet *entities.Faucet, req *entities.FaucetRequest) error {
+	coolingDown, err := ctrl.IsCoolingDown(ctx, faucet, req.Beneficiary)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(cooldownComponent)
+	}
 
-// IsCoolingDown indicates if faucet is cooling down
-func (ctrl *CooldownControl) IsCoolingDown(faucetID, beneficiary, cooldown string) bool {
-	delay, _ := time.ParseDuration(cooldown)
+	if coolingDown {
+		errMessage := "faucet cooling down"
+		log.FromContext(ctx).Error(errMessage)
+		return errors.FaucetWarning(errMessage).ExtendComponent(cooldownComponent)
+	}
 
-	lastAuthorized, _ := ctrl.lastAuthorized.LoadOrStore(mapKey(faucetID, beneficiary), time.Time{})
-	return time.Since(lastAuthorized.(time.Time)) < delay
+	return nil
 }
 
-func (ctrl *CooldownControl) lock(faucetID, account string) {
-	ctrl.mux.Lock(mapKey(faucetID, account))
-}
+// IsCoolingDown indicates if faucet is cooling down
+func (ctrl *CooldownControl) IsCoolingDown(ctx context.Context, faucet *entities.Faucet, beneficiary ethcommon.Address) (bool, error) {
+	delay, _ := time.ParseDuration(faucet.Cooldown)
 
-func (ctrl *CooldownControl) unlock(faucetID, account string) {
-	ctrl.mux.Unlock(mapKey(faucetID, account))
-}
+	lastSpending, err := ctrl.db.FaucetSpending().FindLastByBeneficiary(ctx, faucet.UUID, beneficiary.Hex())
+	if errors.IsNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
 
-func mapKey(faucetID, account string) string {
-	return fmt.Sprintf("%s@%s", account, faucetID)
+	return time.Since(lastSpending.CreatedAt) < delay, nil
 }
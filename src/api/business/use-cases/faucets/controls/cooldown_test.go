@@ -4,15 +4,16 @@ package controls
 
 import (
 	"context"
-	"github.com/consensys/orchestrate/src/entities"
-	"github.com/consensys/orchestrate/src/entities/testdata"
-	"sync"
 	"testing"
 	"time"
 
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
-	"github.com/consensys/orchestrate/pkg/errors"
 )
 
 func TestCooldownControl_Execute(t *testing.T) {
@@ -22,110 +23,83 @@ func TestCooldownControl_Execute(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 
+	mockDB := mocks.NewMockDB(mockCtrl)
+	mockFaucetSpendingDA := mocks.NewMockFaucetSpendingAgent(mockCtrl)
+	mockDB.EXPECT().FaucetSpending().Return(mockFaucetSpendingDA).AnyTimes()
+
 	// Create CoolDown controlled credit
-	ctrl := NewCooldownControl()
-	cooldown := 100 * time.Millisecond
+	ctrl := NewCooldownControl(mockDB)
 
 	faucet1 := testdata.FakeFaucet()
 	faucet2 := testdata.FakeFaucet()
+	recentSpending := &models.FaucetSpending{CreatedAt: time.Now()}
+	oldSpending := &models.FaucetSpending{CreatedAt: time.Now().Add(-time.Minute)}
 
-	t.Run("should skip first faucet on two consecutive requests", func(t *testing.T) {
+	t.Run("should skip faucet which credited the beneficiary during its cooldown", func(t *testing.T) {
 		candidates := map[string]*entities.Faucet{
 			faucet1.UUID: faucet1,
 			faucet2.UUID: faucet2,
 		}
-		reqs := []*entities.FaucetRequest{
-			newFaucetReq(candidates, chains[0], "", addresses[0]),
-			newFaucetReq(candidates, chains[0], "", addresses[0]),
-		}
+		req := newFaucetReq(candidates, chains[0], chainURLs[0], addresses[0])
 
-		fcts := make([]*entities.Faucet, len(reqs))
-		wg := &sync.WaitGroup{}
-		ch := make(chan bool, 1)
-		for idx, req := range reqs {
-			wg.Add(1)
-			go func(idx int, req *entities.FaucetRequest) {
-				err := ctrl.Control(ctx, req)
-				assert.NoError(t, err)
-				fct := electFirstFaucetCandidate(req.Candidates)
-				err = ctrl.OnSelectedCandidate(ctx, fct, req.Beneficiary)
-				ch <- true
-				assert.NoError(t, err)
-				fcts[idx] = fct
-				wg.Done()
-			}(idx, req)
-			<-ch
-		}
+		mockFaucetSpendingDA.EXPECT().FindLastByBeneficiary(gomock.Any(), faucet1.UUID, addresses[0]).Return(recentSpending, nil)
+		mockFaucetSpendingDA.EXPECT().FindLastByBeneficiary(gomock.Any(), faucet2.UUID, addresses[0]).Return(nil, errors.NotFoundError("error"))
+
+		err := ctrl.Control(ctx, req)
 
-		wg.Wait()
-		assert.NotEqual(t, fcts[0].UUID, fcts[1].UUID)
+		assert.NoError(t, err)
+		assert.Len(t, req.Candidates, 1)
+		assert.NotNil(t, req.Candidates[faucet2.UUID])
 	})
 
-	t.Run("should use same faucet in case cooldown time passed between requests", func(t *testing.T) {
+	t.Run("should keep faucet once the cooldown time passed", func(t *testing.T) {
 		candidates := map[string]*entities.Faucet{
 			faucet1.UUID: faucet1,
-			faucet2.UUID: faucet2,
-		}
-		reqs := []*entities.FaucetRequest{
-			newFaucetReq(candidates, chains[1], "", addresses[1]),
-			newFaucetReq(candidates, chains[1], "", addresses[1]),
 		}
+		req := newFaucetReq(candidates, chains[1], chainURLs[1], addresses[1])
 
-		fcts := make([]*entities.Faucet, len(reqs))
-		wg := &sync.WaitGroup{}
-		ch := make(chan bool, 1)
-		for idx, req := range reqs {
-			wg.Add(1)
-			go func(idx int, req *entities.FaucetRequest) {
-				err := ctrl.Control(ctx, req)
-				assert.NoError(t, err)
-				fct := electFirstFaucetCandidate(req.Candidates)
-				err = ctrl.OnSelectedCandidate(ctx, fct, req.Beneficiary)
-				ch <- true
-				assert.NoError(t, err)
-				fcts[idx] = fct
-				wg.Done()
-			}(idx, req)
-			<-ch
-			time.Sleep(cooldown)
-		}
+		mockFaucetSpendingDA.EXPECT().FindLastByBeneficiary(gomock.Any(), faucet1.UUID, addresses[1]).Return(oldSpending, nil)
+
+		err := ctrl.Control(ctx, req)
 
-		wg.Wait()
-		assert.Equal(t, fcts[0].UUID, fcts[0].UUID)
+		assert.NoError(t, err)
+		assert.Len(t, req.Candidates, 1)
 	})
 
-	t.Run("should fail to request twice in less than cooldown requests", func(t *testing.T) {
+	t.Run("should fail with FaucetWarning if all faucets are cooling down", func(t *testing.T) {
 		candidates := map[string]*entities.Faucet{
 			faucet1.UUID: faucet1,
-			faucet2.UUID: faucet2,
-		}
-		reqs := []*entities.FaucetRequest{
-			newFaucetReq(candidates, chains[2], "", addresses[2]),
-			newFaucetReq(candidates, chains[2], "", addresses[2]),
-			newFaucetReq(candidates, chains[2], "", addresses[2]),
 		}
+		req := newFaucetReq(candidates, chains[2], chainURLs[2], addresses[2])
+
+		mockFaucetSpendingDA.EXPECT().FindLastByBeneficiary(gomock.Any(), faucet1.UUID, addresses[2]).Return(recentSpending, nil)
+
+		err := ctrl.Control(ctx, req)
+
+		assert.True(t, errors.IsFaucetWarning(err))
+	})
+
+	t.Run("should fail with FaucetWarning if selected faucet was credited by another replica", func(t *testing.T) {
+		req := newFaucetReq(nil, chains[0], chainURLs[0], addresses[0])
+
+		mockFaucetSpendingDA.EXPECT().FindLastByBeneficiary(gomock.Any(), faucet1.UUID, addresses[0]).Return(recentSpending, nil)
 
-		var eerr error
-		wg := &sync.WaitGroup{}
-		ch := make(chan bool, 1)
-		for idx, req := range reqs {
-			wg.Add(1)
-			go func(idx int, req *entities.FaucetRequest) {
-				err := ctrl.Control(ctx, req)
-				if err == nil {
-					fct := electFirstFaucetCandidate(req.Candidates)
-					err = ctrl.OnSelectedCandidate(ctx, fct, req.Beneficiary)
-					eerr = errors.CombineErrors(err)
-				} else {
-					eerr = err
-				}
-				ch <- true
-				wg.Done()
-			}(idx, req)
-			<-ch
+		err := ctrl.OnSelectedCandidate(ctx, faucet1, req)
+
+		assert.True(t, errors.IsFaucetWarning(err))
+	})
+
+	t.Run("should fail with same error if spendings cannot be read", func(t *testing.T) {
+		candidates := map[string]*entities.Faucet{
+			faucet1.UUID: faucet1,
 		}
+		req := newFaucetReq(candidates, chains[0], chainURLs[0], addresses[0])
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockFaucetSpendingDA.EXPECT().FindLastByBeneficiary(gomock.Any(), faucet1.UUID, addresses[0]).Return(nil, expectedErr)
+
+		err := ctrl.Control(ctx, req)
 
-		wg.Wait()
-		assert.True(t, errors.IsFaucetWarning(eerr))
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(cooldownComponent), err)
 	})
 }
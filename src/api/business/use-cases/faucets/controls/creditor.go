@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
-	ethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/consensys/orchestrate/src/entities"
 
@@ -17,12 +16,14 @@ const creditorComponent = "faucet.control.creditor"
 // Controller is a controller that holds a list of account that should not be credited
 type CreditorControl struct {
 	chainStateReader ethclient.ChainStateReader
+	contractCaller   ethclient.ContractCaller
 }
 
 // NewController creates a new BlackList controller
-func NewCreditorControl(chainStateReader ethclient.ChainStateReader) *CreditorControl {
+func NewCreditorControl(chainStateReader ethclient.ChainStateReader, contractCaller ethclient.ContractCaller) *CreditorControl {
 	return &CreditorControl{
 		chainStateReader: chainStateReader,
+		contractCaller:   contractCaller,
 	}
 }
 
@@ -33,8 +34,8 @@ func (ctrl *CreditorControl) Control(ctx context.Context, req *entities.FaucetRe
 			delete(req.Candidates, key)
 			continue
 		}
-		// Retrieve creditor balance
-		balance, err := getAddressBalance(ctx, ctrl.chainStateReader, req.Chain.URLs, candidate.CreditorAccount)
+		// Retrieve creditor balance, in tokens for ERC-20 faucets
+		balance, err := getFaucetAssetBalance(ctx, ctrl.chainStateReader, ctrl.contractCaller, req.Chain.URLs, candidate, candidate.CreditorAccount)
 		if err != nil {
 			log.FromContext(ctx).WithError(err).Error("failed to get faucet balance")
 			return errors.FromError(err).ExtendComponent(creditorComponent)
@@ -51,7 +52,3 @@ func (ctrl *CreditorControl) Control(ctx context.Context, req *entities.FaucetRe
 
 	return nil
 }
-
-func (ctrl *CreditorControl) OnSelectedCandidate(_ context.Context, _ *entities.Faucet, _ ethcommon.Address) error {
-	return nil
-}
@@ -11,8 +11,11 @@ import (
 	"github.com/consensys/orchestrate/src/entities/testdata"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/erc20"
 	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	eth "github.com/ethereum/go-ethereum"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -26,7 +29,7 @@ func TestCreditorControl_SuccessfulCandidate(t *testing.T) {
 
 	// Create CoolDown controlled credit
 	client := mock.NewMockChainStateReader(mockCtrl)
-	ctrl := NewCreditorControl(client)
+	ctrl := NewCreditorControl(client, mock.NewMockContractCaller(mockCtrl))
 
 	t.Run("should choose first candidate successfully", func(t *testing.T) {
 		faucet1 := testdata.FakeFaucet()
@@ -61,7 +64,7 @@ func TestCreditorControl_SkipBeneficiaryAsCandidate(t *testing.T) {
 
 	// Create CoolDown controlled credit
 	client := mock.NewMockChainStateReader(mockCtrl)
-	ctrl := NewCreditorControl(client)
+	ctrl := NewCreditorControl(client, mock.NewMockContractCaller(mockCtrl))
 
 	t.Run("should skip candidate when beneficiary is same as creditor", func(t *testing.T) {
 		faucet1 := testdata.FakeFaucet()
@@ -78,8 +81,6 @@ func TestCreditorControl_SkipBeneficiaryAsCandidate(t *testing.T) {
 		err := ctrl.Control(ctx, req)
 		assert.NoError(t, err)
 		fct := electFirstFaucetCandidate(req.Candidates)
-		err = ctrl.OnSelectedCandidate(ctx, fct, req.Beneficiary)
-		assert.NoError(t, err)
 		assert.Equal(t, fct.UUID, faucet2.UUID)
 	})
 }
@@ -93,7 +94,7 @@ func TestCreditorControl_SkipCandidateWithNotFounds(t *testing.T) {
 
 	// Create CoolDown controlled credit
 	client := mock.NewMockChainStateReader(mockCtrl)
-	ctrl := NewCreditorControl(client)
+	ctrl := NewCreditorControl(client, mock.NewMockContractCaller(mockCtrl))
 
 	faucet1 := testdata.FakeFaucet()
 	faucet2 := testdata.FakeFaucet()
@@ -121,7 +122,7 @@ func TestCreditorControl_Failure(t *testing.T) {
 
 	// Create CoolDown controlled credit
 	client := mock.NewMockChainStateReader(mockCtrl)
-	ctrl := NewCreditorControl(client)
+	ctrl := NewCreditorControl(client, mock.NewMockContractCaller(mockCtrl))
 
 	faucet1 := testdata.FakeFaucet()
 	faucet2 := testdata.FakeFaucet()
@@ -152,3 +153,51 @@ func TestCreditorControl_Failure(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
+
+func TestCreditorControl_TokenFaucet(t *testing.T) {
+	ctx := context.Background()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	client := mock.NewMockChainStateReader(mockCtrl)
+	caller := mock.NewMockContractCaller(mockCtrl)
+	ctrl := NewCreditorControl(client, caller)
+
+	tokenAddress := ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07")
+	faucet := testdata.FakeFaucet()
+	faucet.TokenAddress = &tokenAddress
+	faucet.Amount = hexutil.Big(*big.NewInt(100))
+
+	t.Run("should keep the candidate if the creditor holds enough tokens", func(t *testing.T) {
+		req := newFaucetReq(map[string]*entities.Faucet{faucet.UUID: faucet}, chains[0], chainURLs[0], addresses[2])
+		expectedData, _ := erc20.EncodeBalanceOf(faucet.CreditorAccount)
+		caller.EXPECT().CallContract(gomock.Any(), chainURLs[0], &eth.CallMsg{To: &tokenAddress, Data: expectedData}, nil).
+			Return(ethcommon.LeftPadBytes(big.NewInt(100).Bytes(), 32), nil)
+
+		err := ctrl.Control(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Len(t, req.Candidates, 1)
+	})
+
+	t.Run("should remove the candidate if the creditor does not hold enough tokens", func(t *testing.T) {
+		req := newFaucetReq(map[string]*entities.Faucet{faucet.UUID: faucet}, chains[0], chainURLs[0], addresses[2])
+		caller.EXPECT().CallContract(gomock.Any(), chainURLs[0], gomock.Any(), nil).
+			Return(ethcommon.LeftPadBytes(big.NewInt(99).Bytes(), 32), nil)
+
+		err := ctrl.Control(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Empty(t, req.Candidates)
+	})
+
+	t.Run("should fail with InvalidParameterError if the token does not implement balanceOf", func(t *testing.T) {
+		req := newFaucetReq(map[string]*entities.Faucet{faucet.UUID: faucet}, chains[0], chainURLs[0], addresses[2])
+		caller.EXPECT().CallContract(gomock.Any(), chainURLs[0], gomock.Any(), nil).Return([]byte{}, nil)
+
+		err := ctrl.Control(ctx, req)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
@@ -5,12 +5,12 @@ import (
 	"math/big"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/utils"
 
 	"github.com/consensys/orchestrate/src/entities"
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
-	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 const maxBalanceComponent = "faucet.control.max-balance"
@@ -18,12 +18,14 @@ const maxBalanceComponent = "faucet.control.max-balance"
 // Controller is a controller that ensures an address can not be credit above a given limit
 type MaxBalanceControl struct {
 	chainStateReader ethclient.ChainStateReader
+	contractCaller   ethclient.ContractCaller
 }
 
 // NewController creates a new max balance controller
-func NewMaxBalanceControl(chainStateReader ethclient.ChainStateReader) *MaxBalanceControl {
+func NewMaxBalanceControl(chainStateReader ethclient.ChainStateReader, contractCaller ethclient.ContractCaller) *MaxBalanceControl {
 	return &MaxBalanceControl{
 		chainStateReader: chainStateReader,
+		contractCaller:   contractCaller,
 	}
 }
 
@@ -33,15 +35,22 @@ func (ctrl *MaxBalanceControl) Control(ctx context.Context, req *entities.Faucet
 		return nil
 	}
 
-	// Retrieve account balance
-	balance, err := getAddressBalance(ctx, ctrl.chainStateReader, req.Chain.URLs, req.Beneficiary)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Error("failed to get faucet balance")
-		return errors.FromError(err).ExtendComponent(maxBalanceComponent)
-	}
-
-	// Ensure MaxBalance is respected
+	// Account balances indexed by asset, the native currency being indexed by an empty token address
+	balances := make(map[string]*big.Int)
 	for key, candidate := range req.Candidates {
+		asset := utils.StringerToString(candidate.TokenAddress)
+		balance, ok := balances[asset]
+		if !ok {
+			var err error
+			balance, err = getFaucetAssetBalance(ctx, ctrl.chainStateReader, ctrl.contractCaller, req.Chain.URLs, candidate, req.Beneficiary)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Error("failed to get faucet balance")
+				return errors.FromError(err).ExtendComponent(maxBalanceComponent)
+			}
+			balances[asset] = balance
+		}
+
+		// Ensure MaxBalance is respected
 		if new(big.Int).Add(candidate.Amount.ToInt(), balance).Cmp(candidate.MaxBalance.ToInt()) > 0 {
 			delete(req.Candidates, key)
 		}
@@ -49,7 +58,3 @@ func (ctrl *MaxBalanceControl) Control(ctx context.Context, req *entities.Faucet
 
 	return nil
 }
-
-func (ctrl *MaxBalanceControl) OnSelectedCandidate(_ context.Context, _ *entities.Faucet, _ ethcommon.Address) error {
-	return nil
-}
@@ -27,7 +27,8 @@ func TestMaxBalanceControl_Execute(t *testing.T) {
 
 	// Create CoolDown controlled credit
 	client := mock.NewMockChainStateReader(mockCtrl)
-	ctrl := NewMaxBalanceControl(client)
+	caller := mock.NewMockContractCaller(mockCtrl)
+	ctrl := NewMaxBalanceControl(client, caller)
 
 	faucet1 := testdata.FakeFaucet()
 	faucet1.Amount = *utils.BigIntStringToHex("10")
@@ -78,6 +79,29 @@ func TestMaxBalanceControl_Execute(t *testing.T) {
 		assert.Empty(t, req.Candidates)
 	})
 
+	t.Run("should read the token balance of the account for ERC-20 faucets", func(t *testing.T) {
+		tokenAddress := ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07")
+		tokenFaucet := testdata.FakeFaucet()
+		tokenFaucet.TokenAddress = &tokenAddress
+		tokenFaucet.Amount = *utils.BigIntStringToHex("10")
+		tokenFaucet.MaxBalance = *utils.BigIntStringToHex("20")
+
+		candidates := map[string]*entities.Faucet{
+			faucet1.UUID:     faucet1,
+			tokenFaucet.UUID: tokenFaucet,
+		}
+		req := newFaucetReq(candidates, chains[0], chainURLs[0], addresses[0])
+		client.EXPECT().BalanceAt(gomock.Any(), gomock.Any(), gomock.Any(), nil).Return(big.NewInt(0), nil)
+		caller.EXPECT().CallContract(gomock.Any(), chainURLs[0], gomock.Any(), nil).
+			Return(ethcommon.LeftPadBytes(big.NewInt(15).Bytes(), 32), nil)
+
+		err := ctrl.Control(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Len(t, req.Candidates, 1)
+		assert.Contains(t, req.Candidates, faucet1.UUID)
+	})
+
 	t.Run("should fail when fetch balance fails", func(t *testing.T) {
 		candidates := map[string]*entities.Faucet{
 			faucet1.UUID: faucet1,
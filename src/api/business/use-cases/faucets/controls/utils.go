@@ -5,11 +5,30 @@ import (
 	"math/big"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/erc20"
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
+	eth "github.com/ethereum/go-ethereum"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	log "github.com/sirupsen/logrus"
 )
 
+// getFaucetAssetBalance returns the balance of an address in the asset credited by the faucet: wei or ERC-20 tokens
+func getFaucetAssetBalance(
+	ctx context.Context,
+	chainStateReader ethclient.ChainStateReader,
+	contractCaller ethclient.ContractCaller,
+	uris []string,
+	faucet *entities.Faucet,
+	address ethcommon.Address,
+) (*big.Int, error) {
+	if faucet.TokenAddress == nil {
+		return getAddressBalance(ctx, chainStateReader, uris, address)
+	}
+
+	return getTokenBalance(ctx, contractCaller, uris, *faucet.TokenAddress, address)
+}
+
 func getAddressBalance(ctx context.Context, chainStateReader ethclient.ChainStateReader, uris []string, address ethcommon.Address) (*big.Int, error) {
 	for _, uri := range uris {
 		balance, err := chainStateReader.BalanceAt(ctx, uri, address, nil)
@@ -23,3 +42,27 @@ func getAddressBalance(ctx context.Context, chainStateReader ethclient.ChainStat
 
 	return nil, errors.EthConnectionError("all URLs in the list are unreachable")
 }
+
+func getTokenBalance(ctx context.Context, contractCaller ethclient.ContractCaller, uris []string, token, address ethcommon.Address) (*big.Int, error) {
+	data, err := erc20.EncodeBalanceOf(address)
+	if err != nil {
+		return nil, errors.EncodingError(err.Error())
+	}
+
+	for _, uri := range uris {
+		result, err := contractCaller.CallContract(ctx, uri, &eth.CallMsg{To: &token, Data: data}, nil)
+		if err != nil {
+			log.WithContext(ctx).WithField("url", uri).WithField("token", token).WithError(err).Error("failed to fetch token balance")
+			continue
+		}
+
+		balance, err := erc20.DecodeBalance(result)
+		if err != nil {
+			return nil, errors.InvalidParameterError("token %s does not implement %s", token, erc20.BalanceOfMethodSignature)
+		}
+
+		return balance, nil
+	}
+
+	return nil, errors.EthConnectionError("all URLs in the list are unreachable")
+}
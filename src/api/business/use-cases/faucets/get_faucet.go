@@ -2,6 +2,7 @@ package faucets
 
 import (
 	"context"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
@@ -10,10 +11,14 @@ import (
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/api/store/parsers"
 	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 const getFaucetComponent = "use-cases.get-faucet"
 
+// faucetSpendingsHistoryLimit is the number of most recent credits returned with a faucet
+const faucetSpendingsHistoryLimit = 100
+
 // getFaucetUseCase is a use case to get a faucet
 type getFaucetUseCase struct {
 	db     store.DB
@@ -38,6 +43,22 @@ func (uc *getFaucetUseCase) Execute(ctx context.Context, uuid string, userInfo *
 		return nil, errors.FromError(err).ExtendComponent(getFaucetComponent)
 	}
 
+	faucet := parsers.NewFaucetFromModel(faucetModel)
+
+	dailySpent, err := uc.db.FaucetSpending().SumSince(ctx, uuid, "", time.Now().UTC().Add(-entities.FaucetBudgetPeriod))
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(getFaucetComponent)
+	}
+	faucet.DailySpent = (*hexutil.Big)(dailySpent)
+
+	spendingModels, err := uc.db.FaucetSpending().Search(ctx, uuid, faucetSpendingsHistoryLimit)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(getFaucetComponent)
+	}
+	for _, spendingModel := range spendingModels {
+		faucet.Spendings = append(faucet.Spendings, parsers.NewFaucetSpendingFromModel(spendingModel))
+	}
+
 	logger.Debug("faucet found successfully")
-	return parsers.NewFaucetFromModel(faucetModel), nil
+	return faucet, nil
 }
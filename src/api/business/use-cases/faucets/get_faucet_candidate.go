@@ -7,46 +7,74 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/utils"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/faucets/controls"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
 	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/database"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 const getFaucetCandidateComponent = "use-cases.faucet-candidate"
 
+// FaucetControl removes the candidates of a faucet request which cannot credit the beneficiary. Controls run before
+// the selected faucet is locked, so that reading the chain state does not hold the lock
 type FaucetControl interface {
 	Control(ctx context.Context, req *entities.FaucetRequest) error
-	OnSelectedCandidate(ctx context.Context, faucet *entities.Faucet, beneficiary ethcommon.Address) error
+}
+
+// FaucetSpendingControl is a FaucetControl based on the faucet spendings, checked again once the selected faucet is
+// locked as another replica may have credited it in between
+type FaucetSpendingControl interface {
+	FaucetControl
+	OnSelectedCandidate(ctx context.Context, faucet *entities.Faucet, req *entities.FaucetRequest) error
 }
 
 // RegisterContract is a use case to register a new contract
 type faucetCandidate struct {
+	db               store.DB
 	chainStateReader ethclient.ChainStateReader
 	searchFaucets    usecases.SearchFaucetsUseCase
 	controls         []FaucetControl
+	spendingControls []FaucetSpendingControl
 	logger           *log.Logger
 }
 
 // NewGetFaucetCandidateUseCase creates a new GetFaucetCandidateUseCase
 func NewGetFaucetCandidateUseCase(
+	db store.DB,
 	searchFaucets usecases.SearchFaucetsUseCase,
 	chainStateReader ethclient.ChainStateReader,
+	contractCaller ethclient.ContractCaller,
 ) usecases.GetFaucetCandidateUseCase {
-	cooldownCtrl := controls.NewCooldownControl()
-	maxBalanceCtrl := controls.NewMaxBalanceControl(chainStateReader)
-	creditorCtrl := controls.NewCreditorControl(chainStateReader)
+	cooldownCtrl := controls.NewCooldownControl(db)
+	budgetCtrl := controls.NewBudgetControl(db)
+	maxBalanceCtrl := controls.NewMaxBalanceControl(chainStateReader, contractCaller)
+	creditorCtrl := controls.NewCreditorControl(chainStateReader, contractCaller)
 
 	return &faucetCandidate{
+		db:               db,
 		chainStateReader: chainStateReader,
 		searchFaucets:    searchFaucets,
-		controls:         []FaucetControl{creditorCtrl, cooldownCtrl, maxBalanceCtrl},
+		controls:         []FaucetControl{creditorCtrl, cooldownCtrl, budgetCtrl, maxBalanceCtrl},
+		spendingControls: []FaucetSpendingControl{cooldownCtrl, budgetCtrl},
 		logger:           log.NewLogger().SetComponent(getFaucetCandidateComponent),
 	}
 }
 
-func (uc *faucetCandidate) Execute(ctx context.Context, account ethcommon.Address, chain *entities.Chain, userInfo *multitenancy.UserInfo) (*entities.Faucet, error) {
+// Execute elects a faucet crediting the account with the given token, or with the native currency if tokenAddress is nil,
+// and records its spending against the funding job to create with the given UUID
+func (uc *faucetCandidate) Execute(
+	ctx context.Context,
+	account ethcommon.Address,
+	chain *entities.Chain,
+	tokenAddress *ethcommon.Address,
+	fundingJobUUID string,
+	userInfo *multitenancy.UserInfo,
+) (*entities.Faucet, error) {
 	ctx = log.With(log.WithFields(ctx, log.Field("chain", chain.UUID), log.Field("account", account)), uc.logger)
 	logger := uc.logger.WithContext(ctx)
 
@@ -55,17 +83,20 @@ func (uc *faucetCandidate) Execute(ctx context.Context, account ethcommon.Addres
 		return nil, errors.FromError(err).ExtendComponent(getFaucetCandidateComponent)
 	}
 
-	if len(faucets) == 0 {
+	candidates := make(map[string]*entities.Faucet)
+	for _, faucet := range faucets {
+		if utils.StringerToString(faucet.TokenAddress) == utils.StringerToString(tokenAddress) {
+			candidates[faucet.UUID] = faucet
+		}
+	}
+
+	if len(candidates) == 0 {
 		errMessage := "no faucet candidate found"
 		logger.Debug(errMessage)
 		return nil, errors.NotFoundError(errMessage).ExtendComponent(getFaucetCandidateComponent)
 	}
-
-	candidates := make(map[string]*entities.Faucet)
-	for _, faucet := range faucets {
-		candidates[faucet.UUID] = faucet
-	}
 	req := &entities.FaucetRequest{
+		TenantID:    userInfo.TenantID,
 		Beneficiary: account,
 		Candidates:  candidates,
 		Chain:       chain,
@@ -85,11 +116,30 @@ func (uc *faucetCandidate) Execute(ctx context.Context, account ethcommon.Addres
 
 	// Select a first faucet candidate for comparison
 	selectedFaucet := req.Candidates[electFaucet(req.Candidates)]
-	for _, ctrl := range uc.controls {
-		err := ctrl.OnSelectedCandidate(ctx, selectedFaucet, req.Beneficiary)
-		if err != nil {
-			return nil, errors.FromError(err).ExtendComponent(getFaucetCandidateComponent)
+
+	// The faucet stays locked until its spending is recorded so that the API replicas credit it one at a time
+	err = database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		der := tx.(store.Tx).Faucet().LockOneByUUID(ctx, selectedFaucet.UUID)
+		if der != nil {
+			return der
 		}
+
+		for _, ctrl := range uc.spendingControls {
+			if der = ctrl.OnSelectedCandidate(ctx, selectedFaucet, req); der != nil {
+				return der
+			}
+		}
+
+		return tx.(store.Tx).FaucetSpending().Insert(ctx, parsers.NewFaucetSpendingModelFromEntity(&entities.FaucetSpending{
+			FaucetUUID:  selectedFaucet.UUID,
+			JobUUID:     fundingJobUUID,
+			TenantID:    req.TenantID,
+			Beneficiary: req.Beneficiary,
+			Amount:      selectedFaucet.Amount,
+		}))
+	})
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(getFaucetCandidateComponent)
 	}
 
 	logger.WithField("creditor_account", selectedFaucet.CreditorAccount).Debug("faucet candidate found successfully")
@@ -0,0 +1,135 @@
+// +build unit
+
+package faucets
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	mocks2 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFaucetCandidate_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockFaucetDA := mocks.NewMockFaucetAgent(ctrl)
+	mockFaucetSpendingDA := mocks.NewMockFaucetSpendingAgent(ctrl)
+	mockSearchFaucetsUC := mocks2.NewMockSearchFaucetsUseCase(ctrl)
+	mockChainStateReader := mock.NewMockChainStateReader(ctrl)
+	mockContractCaller := mock.NewMockContractCaller(ctrl)
+
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDB.EXPECT().FaucetSpending().Return(mockFaucetSpendingDA).AnyTimes()
+	mockDBTX.EXPECT().Faucet().Return(mockFaucetDA).AnyTimes()
+	mockDBTX.EXPECT().FaucetSpending().Return(mockFaucetSpendingDA).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewGetFaucetCandidateUseCase(mockDB, mockSearchFaucetsUC, mockChainStateReader, mockContractCaller)
+
+	chain := testdata.FakeChain()
+	beneficiary := ethcommon.HexToAddress("0xab")
+	fundingJobUUID := "fundingJobUUID"
+
+	t.Run("should select faucet and record its spending against the funding job", func(t *testing.T) {
+		faucet := testdata.FakeFaucet()
+
+		mockSearchFaucetsUC.EXPECT().Execute(gomock.Any(), &entities.FaucetFilters{ChainRule: chain.UUID}, userInfo).Return([]*entities.Faucet{faucet}, nil)
+		mockFaucetSpendingDA.EXPECT().FindLastByBeneficiary(gomock.Any(), faucet.UUID, beneficiary.Hex()).Return(nil, errors.NotFoundError("error")).Times(2)
+		// Balances are read from the chain before locking the faucet
+		gomock.InOrder(
+			mockChainStateReader.EXPECT().BalanceAt(gomock.Any(), gomock.Any(), faucet.CreditorAccount, nil).Return(big.NewInt(1000), nil),
+			mockChainStateReader.EXPECT().BalanceAt(gomock.Any(), gomock.Any(), beneficiary, nil).Return(big.NewInt(0), nil),
+			mockFaucetDA.EXPECT().LockOneByUUID(gomock.Any(), faucet.UUID).Return(nil),
+		)
+		mockFaucetSpendingDA.EXPECT().Insert(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, spending *models.FaucetSpending) error {
+				assert.Equal(t, faucet.UUID, spending.FaucetUUID)
+				assert.Equal(t, fundingJobUUID, spending.JobUUID)
+				assert.Equal(t, userInfo.TenantID, spending.TenantID)
+				assert.Equal(t, beneficiary.Hex(), spending.Beneficiary)
+				assert.Equal(t, "100", spending.Amount)
+				return nil
+			})
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		result, err := usecase.Execute(ctx, beneficiary, chain, nil, fundingJobUUID, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, faucet, result)
+	})
+
+	t.Run("should fail with FaucetWarning if faucet was credited by another replica meanwhile", func(t *testing.T) {
+		faucet := testdata.FakeFaucet()
+
+		mockSearchFaucetsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Faucet{faucet}, nil)
+		mockChainStateReader.EXPECT().BalanceAt(gomock.Any(), gomock.Any(), faucet.CreditorAccount, nil).Return(big.NewInt(1000), nil)
+		mockChainStateReader.EXPECT().BalanceAt(gomock.Any(), gomock.Any(), beneficiary, nil).Return(big.NewInt(0), nil)
+		mockFaucetSpendingDA.EXPECT().FindLastByBeneficiary(gomock.Any(), faucet.UUID, beneficiary.Hex()).Return(nil, errors.NotFoundError("error"))
+		mockFaucetDA.EXPECT().LockOneByUUID(gomock.Any(), faucet.UUID).Return(nil)
+		mockFaucetSpendingDA.EXPECT().FindLastByBeneficiary(gomock.Any(), faucet.UUID, beneficiary.Hex()).Return(&models.FaucetSpending{CreatedAt: time.Now()}, nil)
+		mockDBTX.EXPECT().Rollback().Return(nil)
+
+		result, err := usecase.Execute(ctx, beneficiary, chain, nil, fundingJobUUID, userInfo)
+
+		assert.Nil(t, result)
+		assert.True(t, errors.IsFaucetWarning(err))
+	})
+
+	t.Run("should only elect faucets crediting the requested token", func(t *testing.T) {
+		tokenAddress := ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07")
+		nativeFaucet := testdata.FakeFaucet()
+		tokenFaucet := testdata.FakeFaucet()
+		tokenFaucet.TokenAddress = &tokenAddress
+
+		mockSearchFaucetsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Faucet{nativeFaucet, tokenFaucet}, nil)
+		mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+			Return(ethcommon.LeftPadBytes(big.NewInt(1000).Bytes(), 32), nil)
+		mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+			Return(ethcommon.LeftPadBytes(big.NewInt(0).Bytes(), 32), nil)
+		mockFaucetSpendingDA.EXPECT().FindLastByBeneficiary(gomock.Any(), tokenFaucet.UUID, beneficiary.Hex()).Return(nil, errors.NotFoundError("error")).Times(2)
+		mockFaucetDA.EXPECT().LockOneByUUID(gomock.Any(), tokenFaucet.UUID).Return(nil)
+		mockFaucetSpendingDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+
+		result, err := usecase.Execute(ctx, beneficiary, chain, &tokenAddress, fundingJobUUID, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, tokenFaucet, result)
+	})
+
+	t.Run("should fail with NotFoundError if no faucet credits the requested token", func(t *testing.T) {
+		tokenAddress := ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07")
+
+		mockSearchFaucetsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Faucet{testdata.FakeFaucet()}, nil)
+
+		_, err := usecase.Execute(ctx, beneficiary, chain, &tokenAddress, fundingJobUUID, userInfo)
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail with NotFoundError if no faucet is found", func(t *testing.T) {
+		mockSearchFaucetsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Faucet{}, nil)
+
+		_, err := usecase.Execute(ctx, beneficiary, chain, nil, fundingJobUUID, userInfo)
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
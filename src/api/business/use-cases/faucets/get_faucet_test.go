@@ -4,6 +4,7 @@ package faucets
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
@@ -11,7 +12,10 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/src/api/store/parsers"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
 	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -23,19 +27,44 @@ func TestGetFaucet_Execute(t *testing.T) {
 
 	mockDB := mocks.NewMockDB(ctrl)
 	faucetAgent := mocks.NewMockFaucetAgent(ctrl)
+	faucetSpendingAgent := mocks.NewMockFaucetSpendingAgent(ctrl)
 	mockDB.EXPECT().Faucet().Return(faucetAgent).AnyTimes()
+	mockDB.EXPECT().FaucetSpending().Return(faucetSpendingAgent).AnyTimes()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 	usecase := NewGetFaucetUseCase(mockDB)
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		faucet := testdata.FakeFaucetModel()
+		spending := &models.FaucetSpending{
+			FaucetUUID:  faucet.UUID,
+			Beneficiary: "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18",
+			Amount:      "100",
+		}
 		faucetAgent.EXPECT().FindOneByUUID(gomock.Any(), faucet.UUID, userInfo.AllowedTenants).Return(faucet, nil)
+		faucetSpendingAgent.EXPECT().SumSince(gomock.Any(), faucet.UUID, "", gomock.Any()).Return(big.NewInt(100), nil)
+		faucetSpendingAgent.EXPECT().Search(gomock.Any(), faucet.UUID, faucetSpendingsHistoryLimit).Return([]*models.FaucetSpending{spending}, nil)
 
 		resp, err := usecase.Execute(ctx, faucet.UUID, userInfo)
 
+		expectedFaucet := parsers.NewFaucetFromModel(faucet)
+		expectedFaucet.DailySpent = (*hexutil.Big)(big.NewInt(100))
+		expectedFaucet.Spendings = []*entities.FaucetSpending{parsers.NewFaucetSpendingFromModel(spending)}
 		assert.NoError(t, err)
-		assert.Equal(t, parsers.NewFaucetFromModel(faucet), resp)
+		assert.Equal(t, expectedFaucet, resp)
+	})
+
+	t.Run("should fail with same error if sum of spendings fails", func(t *testing.T) {
+		faucet := testdata.FakeFaucetModel()
+		expectedErr := errors.PostgresConnectionError("error")
+
+		faucetAgent.EXPECT().FindOneByUUID(gomock.Any(), faucet.UUID, userInfo.AllowedTenants).Return(faucet, nil)
+		faucetSpendingAgent.EXPECT().SumSince(gomock.Any(), faucet.UUID, "", gomock.Any()).Return(nil, expectedErr)
+
+		resp, err := usecase.Execute(ctx, faucet.UUID, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(getFaucetComponent), err)
 	})
 
 	t.Run("should fail with same error if get faucet fails", func(t *testing.T) {
@@ -22,6 +22,13 @@ type JobUseCases interface {
 	RetryJobTx() RetryJobTxUseCase
 	UpdateJob() UpdateJobUseCase
 	SearchJobs() SearchJobsUseCase
+	SubscribeJobEvents() SubscribeJobEventsUseCase
+	ApproveJob() ApproveJobUseCase
+	RejectJob() RejectJobUseCase
+	SearchJobApprovals() SearchJobApprovalsUseCase
+	CreateFailedJob() CreateFailedJobUseCase
+	SearchFailedJobs() SearchFailedJobsUseCase
+	ReplayFailedJobs() ReplayFailedJobsUseCase
 }
 
 type CreateJobUseCase interface {
@@ -39,18 +46,23 @@ type SearchJobsUseCase interface {
 
 type StartJobUseCase interface {
 	Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error
+	WithDBTransaction(dbtx store.Tx) StartJobUseCase
 }
 
 type StartNextJobUseCase interface {
 	Execute(ctx context.Context, prevJobUUID string, userInfo *multitenancy.UserInfo) error
 }
 
+type StartDependentJobsUseCase interface {
+	Execute(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error
+}
+
 type UpdateJobUseCase interface {
 	Execute(ctx context.Context, jobEntity *entities.Job, nextStatus entities.JobStatus, logMessage string, userInfo *multitenancy.UserInfo) (*entities.Job, error)
 }
 
 type UpdateChildrenUseCase interface {
-	Execute(ctx context.Context, jobUUID, parentJobUUID string, nextStatus entities.JobStatus, userInfo *multitenancy.UserInfo) error
+	Execute(ctx context.Context, jobUUID, parentJobUUID string, nextStatus entities.JobStatus, userInfo *multitenancy.UserInfo) ([]*entities.Job, error)
 	WithDBTransaction(dbtx store.Tx) UpdateChildrenUseCase
 }
 
@@ -61,3 +73,39 @@ type ResendJobTxUseCase interface {
 type RetryJobTxUseCase interface {
 	Execute(ctx context.Context, jobUUID string, gasIncrement float64, data hexutil.Bytes, userInfo *multitenancy.UserInfo) error
 }
+
+type PublishJobEventUseCase interface {
+	Execute(ctx context.Context, event *entities.JobEvent)
+}
+
+type SubscribeJobEventsUseCase interface {
+	Execute(ctx context.Context, filters *entities.JobEventFilters, userInfo *multitenancy.UserInfo) (<-chan *entities.JobEvent, error)
+}
+
+type ApproveJobUseCase interface {
+	Execute(ctx context.Context, jobUUID, reason string, userInfo *multitenancy.UserInfo) (*entities.Job, error)
+}
+
+type RejectJobUseCase interface {
+	Execute(ctx context.Context, jobUUID, reason string, userInfo *multitenancy.UserInfo) (*entities.Job, error)
+}
+
+type SearchJobApprovalsUseCase interface {
+	Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) ([]*entities.JobApproval, error)
+}
+
+type CreateFailedJobUseCase interface {
+	Execute(ctx context.Context, failedJob *entities.FailedJob) (*entities.FailedJob, error)
+}
+
+type SearchFailedJobsUseCase interface {
+	Execute(ctx context.Context, filters *entities.FailedJobFilters, userInfo *multitenancy.UserInfo) ([]*entities.FailedJob, error)
+}
+
+type ReplayJobUseCase interface {
+	Execute(ctx context.Context, failedJob *entities.FailedJob, userInfo *multitenancy.UserInfo) (*entities.FailedJob, error)
+}
+
+type ReplayFailedJobsUseCase interface {
+	Execute(ctx context.Context, filters *entities.FailedJobFilters, userInfo *multitenancy.UserInfo) ([]*entities.FailedJob, error)
+}
@@ -0,0 +1,61 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const approveJobComponent = "use-cases.approve-job"
+
+// approveJobUseCase is a use case to approve a job awaiting approval
+type approveJobUseCase struct {
+	db         store.DB
+	startJobUC usecases.StartJobUseCase
+	logger     *log.Logger
+}
+
+// NewApproveJobUseCase creates a new ApproveJobUseCase
+func NewApproveJobUseCase(db store.DB, startJobUC usecases.StartJobUseCase) usecases.ApproveJobUseCase {
+	return &approveJobUseCase{
+		db:         db,
+		startJobUC: startJobUC,
+		logger:     log.NewLogger().SetComponent(approveJobComponent),
+	}
+}
+
+// Execute records the approval of the user and starts the job once the threshold of the approval policy is reached
+func (uc *approveJobUseCase) Execute(ctx context.Context, jobUUID, reason string, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+	ctx = log.WithFields(ctx, log.Field("job", jobUUID))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("approving job")
+
+	jobModel, policy, approvals, claimed, err := recordJobDecision(ctx, uc.db, jobUUID, reason, entities.ApprovalDecisionApproved, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(approveJobComponent)
+	}
+
+	count := countApprovals(approvals)
+	logger.WithField("approvals", count).WithField("threshold", policy.Threshold).Info("job approved")
+
+	// Only the approval claiming the job starts it, concurrent approvals find it no longer awaiting approval
+	if claimed {
+		err = uc.startJobUC.Execute(ctx, jobUUID, makerUserInfo(jobModel))
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(approveJobComponent)
+		}
+	}
+
+	jobModel, err = uc.db.Job().FindOneByUUID(ctx, jobUUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, true)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(approveJobComponent)
+	}
+
+	return parsers.NewJobEntityFromModels(jobModel), nil
+}
@@ -0,0 +1,250 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	mocks2 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApproveJob_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockAccountDA := mocks.NewMockAccountAgent(ctrl)
+	mockJobApprovalDA := mocks.NewMockJobApprovalAgent(ctrl)
+	mockLogDA := mocks.NewMockLogAgent(ctrl)
+	mockStartJobUC := mocks2.NewMockStartJobUseCase(ctrl)
+
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Account().Return(mockAccountDA).AnyTimes()
+	mockDBTX.EXPECT().JobApproval().Return(mockJobApprovalDA).AnyTimes()
+	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+
+	maker := multitenancy.NewUserInfo("tenantOne", "maker")
+	approver := multitenancy.NewUserInfo("tenantOne", "alice")
+	usecase := NewApproveJobUseCase(mockDB, mockStartJobUC)
+
+	account := testdata.FakeAccountModel()
+	account.ApprovalPolicy = &entities.ApprovalPolicy{Threshold: 2, Approvers: []string{"alice", "bob", "maker"}}
+
+	newJob := func() *models.Job {
+		job := testdata.FakeJobModel(1)
+		job.Status = entities.StatusAwaitingApproval
+		job.Transaction.Sender = account.Address
+		job.Schedule = testdata.FakeSchedule(maker.TenantID, maker.Username)
+		return job
+	}
+
+	expectJob := func(job *models.Job, userInfo *multitenancy.UserInfo) {
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), job.UUID).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false).Return(job, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), account.Address, maker.AllowedTenants, maker.Username).Return(account, nil)
+	}
+
+	t.Run("should record approval and wait for the threshold", func(t *testing.T) {
+		job := newJob()
+
+		expectJob(job, approver)
+		mockJobApprovalDA.EXPECT().FindAllByJobUUID(gomock.Any(), job.UUID).Return([]*models.JobApproval{}, nil)
+		mockJobApprovalDA.EXPECT().Insert(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, approval *models.JobApproval) error {
+				assert.Equal(t, "alice", approval.Username)
+				assert.Equal(t, string(entities.ApprovalDecisionApproved), approval.Decision)
+				assert.Equal(t, "looks good", approval.Reason)
+				return nil
+			})
+		mockDBTX.EXPECT().Commit().Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, approver.AllowedTenants, multitenancy.WildcardOwner, true).Return(job, nil)
+
+		result, err := usecase.Execute(ctx, job.UUID, "looks good", approver)
+
+		require.NoError(t, err)
+		assert.Equal(t, job.UUID, result.UUID)
+	})
+
+	t.Run("should start job when the threshold is reached", func(t *testing.T) {
+		job := newJob()
+
+		expectJob(job, approver)
+		mockJobApprovalDA.EXPECT().FindAllByJobUUID(gomock.Any(), job.UUID).Return([]*models.JobApproval{
+			{Username: "bob", Decision: string(entities.ApprovalDecisionApproved)},
+		}, nil)
+		mockJobApprovalDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), job).
+			DoAndReturn(func(ctx context.Context, jobModel *models.Job) error {
+				assert.Equal(t, entities.StatusCreated, jobModel.Status)
+				return nil
+			})
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), job.UUID, maker).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, approver.AllowedTenants, multitenancy.WildcardOwner, true).Return(job, nil)
+
+		_, err := usecase.Execute(ctx, job.UUID, "", approver)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail with UnauthorizedError if user is not an approver", func(t *testing.T) {
+		job := newJob()
+		user := multitenancy.NewUserInfo("tenantOne", "mallory")
+
+		expectJob(job, user)
+		mockDBTX.EXPECT().Rollback().Return(nil)
+
+		_, err := usecase.Execute(ctx, job.UUID, "", user)
+
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should fail with UnauthorizedError if the maker approves its own job", func(t *testing.T) {
+		job := newJob()
+
+		expectJob(job, maker)
+		mockDBTX.EXPECT().Rollback().Return(nil)
+
+		_, err := usecase.Execute(ctx, job.UUID, "", maker)
+
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should fail with AlreadyExistsError if user already decided", func(t *testing.T) {
+		job := newJob()
+
+		expectJob(job, approver)
+		mockJobApprovalDA.EXPECT().FindAllByJobUUID(gomock.Any(), job.UUID).Return([]*models.JobApproval{
+			{Username: "alice", Decision: string(entities.ApprovalDecisionApproved)},
+		}, nil)
+		mockDBTX.EXPECT().Rollback().Return(nil)
+
+		_, err := usecase.Execute(ctx, job.UUID, "", approver)
+
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should fail with InvalidStateError if job is not awaiting approval", func(t *testing.T) {
+		job := newJob()
+		job.Status = entities.StatusStarted
+
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), job.UUID).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, approver.AllowedTenants, multitenancy.WildcardOwner, false).Return(job, nil)
+		mockDBTX.EXPECT().Rollback().Return(nil)
+
+		_, err := usecase.Execute(ctx, job.UUID, "", approver)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+}
+
+func TestApproveJob_Execute_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockAccountDA := mocks.NewMockAccountAgent(ctrl)
+	mockJobApprovalDA := mocks.NewMockJobApprovalAgent(ctrl)
+	mockLogDA := mocks.NewMockLogAgent(ctrl)
+	mockStartJobUC := mocks2.NewMockStartJobUseCase(ctrl)
+
+	maker := multitenancy.NewUserInfo("tenantOne", "maker")
+	account := testdata.FakeAccountModel()
+	account.ApprovalPolicy = &entities.ApprovalPolicy{Threshold: 2, Approvers: []string{"alice", "bob", "carol"}}
+
+	job := testdata.FakeJobModel(1)
+	job.Status = entities.StatusAwaitingApproval
+	job.Transaction.Sender = account.Address
+	job.Schedule = testdata.FakeSchedule(maker.TenantID, maker.Username)
+
+	// The job lock is held from LockOneByUUID until the DB transaction ends
+	jobLock := &sync.Mutex{}
+	statusMux := &sync.RWMutex{}
+	status := job.Status
+	var approvals []*models.JobApproval
+
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Account().Return(mockAccountDA).AnyTimes()
+	mockDBTX.EXPECT().JobApproval().Return(mockJobApprovalDA).AnyTimes()
+	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
+	mockDBTX.EXPECT().Commit().DoAndReturn(func() error { jobLock.Unlock(); return nil }).AnyTimes()
+	mockDBTX.EXPECT().Rollback().DoAndReturn(func() error { jobLock.Unlock(); return nil }).AnyTimes()
+
+	mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), job.UUID).DoAndReturn(func(ctx context.Context, jobUUID string) error {
+		jobLock.Lock()
+		return nil
+	}).AnyTimes()
+	mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, gomock.Any(), multitenancy.WildcardOwner, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, jobUUID string, tenants []string, ownerID string, withLogs bool) (*models.Job, error) {
+			statusMux.RLock()
+			defer statusMux.RUnlock()
+			jobModel := *job
+			jobModel.Status = status
+			return &jobModel, nil
+		}).AnyTimes()
+	mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, jobModel *models.Job) error {
+		statusMux.Lock()
+		defer statusMux.Unlock()
+		status = jobModel.Status
+		return nil
+	}).AnyTimes()
+	mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), account.Address, maker.AllowedTenants, maker.Username).Return(account, nil).AnyTimes()
+	mockJobApprovalDA.EXPECT().FindAllByJobUUID(gomock.Any(), job.UUID).DoAndReturn(func(ctx context.Context, jobUUID string) ([]*models.JobApproval, error) {
+		return append([]*models.JobApproval{}, approvals...), nil
+	}).AnyTimes()
+	mockJobApprovalDA.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, approval *models.JobApproval) error {
+		approvals = append(approvals, approval)
+		return nil
+	}).AnyTimes()
+	mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	mockStartJobUC.EXPECT().Execute(gomock.Any(), job.UUID, maker).Return(nil).Times(1)
+
+	usecase := NewApproveJobUseCase(mockDB, mockStartJobUC)
+
+	wg := &sync.WaitGroup{}
+	errs := make(chan error, 3)
+	for _, username := range []string{"alice", "bob", "carol"} {
+		wg.Add(1)
+		go func(username string) {
+			defer wg.Done()
+			_, err := usecase.Execute(ctx, job.UUID, "", multitenancy.NewUserInfo("tenantOne", username))
+			errs <- err
+		}(username)
+	}
+	wg.Wait()
+	close(errs)
+
+	failures := 0
+	for err := range errs {
+		if err != nil {
+			assert.True(t, errors.IsInvalidStateError(err))
+			failures++
+		}
+	}
+	assert.Equal(t, 1, failures)
+	assert.Equal(t, entities.StatusCreated, status)
+}
@@ -0,0 +1,77 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const createFailedJobComponent = "use-cases.create-failed-job"
+
+// createFailedJobUseCase is a use case to store a job failed by the tx-sender so it can be replayed
+type createFailedJobUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewCreateFailedJobUseCase creates a new CreateFailedJobUseCase
+func NewCreateFailedJobUseCase(db store.DB) usecases.CreateFailedJobUseCase {
+	return &createFailedJobUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(createFailedJobComponent),
+	}
+}
+
+// Execute stores the failure of a job with the chain and the owner of the job. A job has at most one pending failure,
+// so redelivered messages update the pending failure instead of creating a new one
+func (uc *createFailedJobUseCase) Execute(ctx context.Context, failedJob *entities.FailedJob) (*entities.FailedJob, error) {
+	ctx = log.WithFields(ctx, log.Field("job", failedJob.JobUUID))
+	logger := uc.logger.WithContext(ctx)
+
+	jobModel, err := uc.db.Job().FindOneByUUID(ctx, failedJob.JobUUID, []string{multitenancy.WildcardTenant}, multitenancy.WildcardOwner, false)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createFailedJobComponent)
+	}
+
+	failedJob.ChainUUID = jobModel.ChainUUID
+	if jobModel.Schedule != nil {
+		failedJob.TenantID = jobModel.Schedule.TenantID
+		failedJob.OwnerID = jobModel.Schedule.OwnerID
+	}
+
+	pendingModels, err := uc.db.FailedJob().Search(ctx, &entities.FailedJobFilters{
+		JobUUIDs:    []string{failedJob.JobUUID},
+		OnlyPending: true,
+	}, []string{multitenancy.WildcardTenant}, multitenancy.WildcardOwner)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createFailedJobComponent)
+	}
+
+	if len(pendingModels) > 0 {
+		failedJob.UUID = pendingModels[0].UUID
+		failedJob.ReplayCount = pendingModels[0].ReplayCount
+		failedJob.CreatedAt = pendingModels[0].CreatedAt
+		failedJobModel := parsers.NewFailedJobModelFromEntity(failedJob)
+		if err = uc.db.FailedJob().Update(ctx, failedJobModel); err != nil {
+			return nil, errors.FromError(err).ExtendComponent(createFailedJobComponent)
+		}
+
+		logger.WithField("failed_job", failedJobModel.UUID).Debug("pending job failure updated successfully")
+		return parsers.NewFailedJobEntityFromModel(failedJobModel), nil
+	}
+
+	failedJobModel := parsers.NewFailedJobModelFromEntity(failedJob)
+	if err = uc.db.FailedJob().Insert(ctx, failedJobModel); err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createFailedJobComponent)
+	}
+
+	logger.WithField("failed_job", failedJobModel.UUID).WithField("error_class", failedJob.ErrorClass).
+		Info("job failure stored successfully")
+	return parsers.NewFailedJobEntityFromModel(failedJobModel), nil
+}
@@ -0,0 +1,104 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	testdata2 "github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateFailedJob_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockFailedJobDA := mocks.NewMockFailedJobAgent(ctrl)
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDB.EXPECT().FailedJob().Return(mockFailedJobDA).AnyTimes()
+
+	allTenants := []string{multitenancy.WildcardTenant}
+	usecase := NewCreateFailedJobUseCase(mockDB)
+
+	t.Run("should store the failure with the chain and the owner of the job", func(t *testing.T) {
+		jobModel := testdata.FakeJobModel(1)
+		jobModel.Schedule = testdata.FakeSchedule("tenantOne", "username")
+		failedJob := testdata2.FakeFailedJob()
+		failedJob.UUID = ""
+		failedJob.JobUUID = jobModel.UUID
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, allTenants, multitenancy.WildcardOwner, false).Return(jobModel, nil)
+		mockFailedJobDA.EXPECT().Search(gomock.Any(), &entities.FailedJobFilters{JobUUIDs: []string{jobModel.UUID}, OnlyPending: true},
+			allTenants, multitenancy.WildcardOwner).Return([]*models.FailedJob{}, nil)
+		mockFailedJobDA.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, failedJobModel *models.FailedJob) error {
+			assert.Equal(t, jobModel.ChainUUID, failedJobModel.ChainUUID)
+			assert.Equal(t, "tenantOne", failedJobModel.TenantID)
+			assert.Equal(t, "username", failedJobModel.OwnerID)
+			assert.Equal(t, string(entities.FailedJobConnectionError), failedJobModel.ErrorClass)
+			failedJobModel.UUID = "failedJobUUID"
+			return nil
+		})
+
+		result, err := usecase.Execute(ctx, failedJob)
+
+		require.NoError(t, err)
+		assert.Equal(t, "failedJobUUID", result.UUID)
+		assert.True(t, result.IsPending())
+	})
+
+	t.Run("should update the pending failure of the job", func(t *testing.T) {
+		jobModel := testdata.FakeJobModel(1)
+		failedJob := testdata2.FakeFailedJob()
+		failedJob.JobUUID = jobModel.UUID
+		pendingModel := testdata.FakeFailedJobModel(jobModel.UUID, jobModel.ChainUUID, jobModel.Schedule.TenantID)
+		pendingModel.ReplayCount = 2
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, allTenants, multitenancy.WildcardOwner, false).Return(jobModel, nil)
+		mockFailedJobDA.EXPECT().Search(gomock.Any(), gomock.Any(), allTenants, multitenancy.WildcardOwner).Return([]*models.FailedJob{pendingModel}, nil)
+		mockFailedJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+
+		result, err := usecase.Execute(ctx, failedJob)
+
+		require.NoError(t, err)
+		assert.Equal(t, pendingModel.UUID, result.UUID)
+		assert.Equal(t, 2, result.ReplayCount)
+	})
+
+	t.Run("should fail with same error if job cannot be found", func(t *testing.T) {
+		failedJob := testdata2.FakeFailedJob()
+		expectedErr := errors.NotFoundError("error")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), failedJob.JobUUID, allTenants, multitenancy.WildcardOwner, false).Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, failedJob)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createFailedJobComponent), err)
+	})
+
+	t.Run("should fail with same error if insert fails", func(t *testing.T) {
+		jobModel := testdata.FakeJobModel(1)
+		failedJob := testdata2.FakeFailedJob()
+		failedJob.JobUUID = jobModel.UUID
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, allTenants, multitenancy.WildcardOwner, false).Return(jobModel, nil)
+		mockFailedJobDA.EXPECT().Search(gomock.Any(), gomock.Any(), allTenants, multitenancy.WildcardOwner).Return(nil, nil)
+		mockFailedJobDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		_, err := usecase.Execute(ctx, failedJob)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createFailedJobComponent), err)
+	})
+}
@@ -67,6 +67,14 @@ func (uc *createJobUseCase) Execute(ctx context.Context, job *entities.Job, user
 		return nil, errors.FromError(err).ExtendComponent(createJobComponent)
 	}
 
+	if len(job.DependsOn) > 0 || len(job.Inputs) > 0 {
+		err = uc.validateDependencies(ctx, job, schedule, userInfo)
+		if err != nil {
+			logger.WithError(err).Error("invalid job dependencies")
+			return nil, errors.FromError(err).ExtendComponent(createJobComponent)
+		}
+	}
+
 	jobModel := parsers.NewJobModelFromEntities(job, &schedule.ID)
 	jobModel.Status = entities.StatusCreated
 	jobModel.Logs = append(jobModel.Logs, &models.Log{
@@ -121,6 +129,45 @@ func (uc *createJobUseCase) Execute(ctx context.Context, job *entities.Job, user
 	return parsers.NewJobEntityFromModels(jobModel), nil
 }
 
+// validateDependencies checks that the dependencies are jobs of the same schedule and that every input is the
+// output of a dependency expected to be MINED
+func (uc *createJobUseCase) validateDependencies(ctx context.Context, job *entities.Job, schedule *models.Schedule,
+	userInfo *multitenancy.UserInfo) error {
+	scheduleJobs := make(map[string]bool)
+	for _, jobModel := range schedule.Jobs {
+		scheduleJobs[jobModel.UUID] = true
+	}
+
+	dependencies := make(map[string]*entities.JobDependency)
+	for _, dependency := range job.DependsOn {
+		if !scheduleJobs[dependency.JobUUID] {
+			return errors.InvalidParameterError("dependency %s is not a job of the schedule", dependency.JobUUID)
+		}
+		if _, ok := dependencies[dependency.JobUUID]; ok {
+			return errors.InvalidParameterError("duplicated dependency %s", dependency.JobUUID)
+		}
+		dependencies[dependency.JobUUID] = dependency
+	}
+
+	for _, input := range job.Inputs {
+		dependency, ok := dependencies[input.JobUUID]
+		if !ok || dependency.Status != entities.StatusMined {
+			return errors.InvalidParameterError("input job %s must be a dependency expected to be %s", input.JobUUID, entities.StatusMined)
+		}
+
+		inputJobModel, err := uc.db.Job().FindOneByUUID(ctx, input.JobUUID, userInfo.AllowedTenants, userInfo.Username, false)
+		if err != nil {
+			return err
+		}
+
+		if err = validateJobInput(input, parsers.NewJobEntityFromModels(inputJobModel), job.Transaction); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // nolint
 func (uc *createJobUseCase) getAccountStoreID(ctx context.Context, address *ethcommon.Address, userInfo *multitenancy.UserInfo) (string, error) {
 	acc, err := uc.db.Account().FindOneByAddress(ctx, address.String(), userInfo.AllowedTenants, userInfo.Username)
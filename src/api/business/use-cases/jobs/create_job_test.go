@@ -38,6 +38,7 @@ func TestCreateJob_Execute(t *testing.T) {
 	mockDB.EXPECT().Schedule().Return(mockScheduleDA).AnyTimes()
 	mockDB.EXPECT().Account().Return(mockAccountDA).AnyTimes()
 	mockDB.EXPECT().Transaction().Return(mockTransactionDA).AnyTimes()
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
 	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
 	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
 	mockDBTX.EXPECT().Transaction().Return(mockTransactionDA).AnyTimes()
@@ -77,6 +78,73 @@ func TestCreateJob_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should execute use case successfully for job with dependencies and inputs", func(t *testing.T) {
+		jobEntity := testdata.FakeJob()
+		fakeSchedule := modelstestdata.FakeSchedule(userInfo.TenantID, userInfo.Username)
+		fakeSchedule.ID = 1
+		fakeSchedule.UUID = jobEntity.ScheduleUUID
+		deployJobModel := fakeSchedule.Jobs[0]
+		jobEntity.Transaction.Data = make([]byte, 36)
+		jobEntity.DependsOn = []*entities.JobDependency{{JobUUID: deployJobModel.UUID, Status: entities.StatusMined}}
+		jobEntity.Inputs = []*entities.JobInput{
+			{JobUUID: deployJobModel.UUID, Output: entities.JobOutputContractAddress, Target: entities.JobInputTargetTo},
+			{JobUUID: deployJobModel.UUID, Output: entities.JobOutputTxHash, Target: entities.JobInputTargetData, DataOffset: 4},
+		}
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), jobEntity.ChainUUID, userInfo).Return(fakeChain, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), jobEntity.Transaction.From.String(), userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeAccount, nil)
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.ScheduleUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeSchedule, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), deployJobModel.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(deployJobModel, nil).Times(2)
+		mockTransactionDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockJobDA.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, jobModel *models.Job) error {
+			assert.Equal(t, jobEntity.DependsOn, jobModel.DependsOn)
+			assert.Equal(t, jobEntity.Inputs, jobModel.Inputs)
+			return nil
+		})
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := usecase.Execute(context.Background(), jobEntity, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with InvalidParameterError if dependency is not a job of the schedule", func(t *testing.T) {
+		jobEntity := testdata.FakeJob()
+		fakeSchedule := modelstestdata.FakeSchedule(userInfo.TenantID, userInfo.Username)
+		jobEntity.DependsOn = []*entities.JobDependency{{JobUUID: "6380e2b6-b828-43ee-abdc-de0f8d57dc5f", Status: entities.StatusFailed}}
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), jobEntity.ChainUUID, userInfo).Return(fakeChain, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), jobEntity.Transaction.From.String(), userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeAccount, nil)
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.ScheduleUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeSchedule, nil)
+
+		_, err := usecase.Execute(context.Background(), jobEntity, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if input is not the output of a MINED dependency", func(t *testing.T) {
+		jobEntity := testdata.FakeJob()
+		fakeSchedule := modelstestdata.FakeSchedule(userInfo.TenantID, userInfo.Username)
+		dependencyUUID := fakeSchedule.Jobs[0].UUID
+		jobEntity.DependsOn = []*entities.JobDependency{{JobUUID: dependencyUUID, Status: entities.StatusFailed}}
+		jobEntity.Inputs = []*entities.JobInput{{JobUUID: dependencyUUID, Output: entities.JobOutputTxHash, Target: entities.JobInputTargetData}}
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), jobEntity.ChainUUID, userInfo).Return(fakeChain, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), jobEntity.Transaction.From.String(), userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeAccount, nil)
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.ScheduleUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeSchedule, nil)
+
+		_, err := usecase.Execute(context.Background(), jobEntity, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
 	t.Run("should execute use case successfully for child job", func(t *testing.T) {
 		jobEntity := testdata.FakeJob()
 		jobEntity.InternalData.ParentJobUUID = "myParentJobUUID"
@@ -0,0 +1,204 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/database"
+)
+
+// findApprovalPolicy returns the approval policy of the account sending the job, nil if the job does not require approvals
+func findApprovalPolicy(ctx context.Context, db store.Agents, jobModel *models.Job) (*entities.ApprovalPolicy, error) {
+	account, err := findSenderAccount(ctx, db, jobModel)
+	if err != nil || account == nil {
+		return nil, err
+	}
+
+	return account.ApprovalPolicy, nil
+}
+
+// findSenderAccount returns the account sending the job, nil if the sender is not registered
+func findSenderAccount(ctx context.Context, db store.Agents, jobModel *models.Job) (*models.Account, error) {
+	if jobModel.Transaction == nil || jobModel.Transaction.Sender == "" || jobModel.Schedule == nil {
+		return nil, nil
+	}
+
+	// The account is the one the maker of the job is allowed to send from
+	maker := makerUserInfo(jobModel)
+	account, err := db.Account().FindOneByAddress(ctx, jobModel.Transaction.Sender, maker.AllowedTenants, maker.Username)
+	if errors.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func makerUserInfo(jobModel *models.Job) *multitenancy.UserInfo {
+	return multitenancy.NewUserInfo(jobModel.Schedule.TenantID, jobModel.Schedule.OwnerID)
+}
+
+func countApprovals(approvals []*models.JobApproval) int {
+	count := 0
+	for _, approval := range approvals {
+		if approval.Decision == string(entities.ApprovalDecisionApproved) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// isJobApproved indicates whether the job reached the threshold of the approval policy and returns its approvals count
+func isJobApproved(ctx context.Context, db store.Agents, jobModel *models.Job, policy *entities.ApprovalPolicy) (approved bool, count int, err error) {
+	// Retries of an approved job inherit its approvals as long as they send the same transaction
+	if jobModel.InternalData != nil && jobModel.InternalData.ParentJobUUID != "" {
+		inherited, der := isParentApproved(ctx, db, jobModel, policy)
+		if der != nil {
+			return false, 0, der
+		}
+		if inherited {
+			return true, policy.Threshold, nil
+		}
+	}
+
+	approvals, err := db.JobApproval().FindAllByJobUUID(ctx, jobModel.UUID)
+	if err != nil {
+		return false, 0, err
+	}
+
+	count = countApprovals(approvals)
+	return count >= policy.Threshold, count, nil
+}
+
+func isParentApproved(ctx context.Context, db store.Agents, jobModel *models.Job, policy *entities.ApprovalPolicy) (bool, error) {
+	maker := makerUserInfo(jobModel)
+	parentModel, err := db.Job().FindOneByUUID(ctx, jobModel.InternalData.ParentJobUUID, maker.AllowedTenants, maker.Username, false)
+	if errors.IsNotFoundError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !isSameTransactionIntent(parentModel.Transaction, jobModel.Transaction) {
+		return false, nil
+	}
+
+	approvals, err := db.JobApproval().FindAllByJobUUID(ctx, parentModel.UUID)
+	if err != nil {
+		return false, err
+	}
+
+	return countApprovals(approvals) >= policy.Threshold, nil
+}
+
+// recordJobDecision stores the decision of an approver on a job AWAITING_APPROVAL and returns the job, its approval
+// policy and all the decisions taken on it so far. The approval reaching the threshold claims the job by moving it
+// back to CREATED under the job lock, so that only the caller getting claimed=true starts it
+func recordJobDecision(
+	ctx context.Context,
+	db store.DB,
+	jobUUID, reason string,
+	decision entities.ApprovalDecision,
+	userInfo *multitenancy.UserInfo,
+) (jobModel *models.Job, policy *entities.ApprovalPolicy, approvals []*models.JobApproval, claimed bool, err error) {
+	err = database.ExecuteInDBTx(db, func(tx database.Tx) error {
+		der := tx.(store.Tx).Job().LockOneByUUID(ctx, jobUUID)
+		if der != nil {
+			return der
+		}
+
+		// Approvers are not the owners of the jobs they approve
+		jobModel, der = tx.(store.Tx).Job().FindOneByUUID(ctx, jobUUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false)
+		if der != nil {
+			return der
+		}
+
+		if jobModel.Status != entities.StatusAwaitingApproval {
+			return errors.InvalidStateError("job is not awaiting approval")
+		}
+
+		policy, der = findApprovalPolicy(ctx, tx.(store.Tx), jobModel)
+		if der != nil {
+			return der
+		}
+		if policy == nil {
+			return errors.InvalidStateError("job sender has no approval policy")
+		}
+
+		if der = checkApprover(policy, jobModel, userInfo); der != nil {
+			return der
+		}
+
+		approvals, der = tx.(store.Tx).JobApproval().FindAllByJobUUID(ctx, jobUUID)
+		if der != nil {
+			return der
+		}
+
+		for _, approval := range approvals {
+			if approval.Username == userInfo.Username {
+				return errors.AlreadyExistsError("user %s already decided on job", userInfo.Username)
+			}
+		}
+
+		approval := parsers.NewJobApprovalModelFromEntity(&entities.JobApproval{
+			JobUUID:  jobUUID,
+			TenantID: jobModel.Schedule.TenantID,
+			Username: userInfo.Username,
+			Decision: decision,
+			Reason:   reason,
+		})
+		if der = tx.(store.Tx).JobApproval().Insert(ctx, approval); der != nil {
+			return der
+		}
+
+		approvals = append(approvals, approval)
+
+		if decision != entities.ApprovalDecisionApproved || countApprovals(approvals) < policy.Threshold {
+			return nil
+		}
+
+		jobModel.Status = entities.StatusCreated
+		if der = tx.(store.Tx).Job().Update(ctx, jobModel); der != nil {
+			return der
+		}
+
+		der = tx.(store.Tx).Log().Insert(ctx, &models.Log{
+			JobID:   &jobModel.ID,
+			Status:  entities.StatusCreated,
+			Message: fmt.Sprintf("approved by %d approvers", countApprovals(approvals)),
+		})
+		if der != nil {
+			return der
+		}
+
+		claimed = true
+		return nil
+	})
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+
+	return jobModel, policy, approvals, claimed, nil
+}
+
+func checkApprover(policy *entities.ApprovalPolicy, jobModel *models.Job, userInfo *multitenancy.UserInfo) error {
+	if userInfo.Username == "" || !policy.HasApprover(userInfo.Username) {
+		return errors.UnauthorizedError("user is not an approver of the job sender")
+	}
+
+	if userInfo.Username == jobModel.Schedule.OwnerID {
+		return errors.UnauthorizedError("user cannot approve its own job")
+	}
+
+	return nil
+}
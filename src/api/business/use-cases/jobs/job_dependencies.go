@@ -0,0 +1,105 @@
+package jobs
+
+import (
+	"fmt"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type jobDependenciesState int
+
+const (
+	jobDependenciesPending jobDependenciesState = iota
+	jobDependenciesSatisfied
+	jobDependenciesUnsatisfiable
+)
+
+// evaluateJobDependencies checks the dependencies against the current status of the jobs indexed by UUID.
+// When the dependencies can no longer be satisfied, the returned message gives the reason
+func evaluateJobDependencies(dependencies []*entities.JobDependency, jobs map[string]*entities.Job) (jobDependenciesState, string) {
+	state := jobDependenciesSatisfied
+	for _, dependency := range dependencies {
+		job, ok := jobs[dependency.JobUUID]
+		switch {
+		case !ok:
+			return jobDependenciesUnsatisfiable, fmt.Sprintf("dependency %s does not exist", dependency.JobUUID)
+		case job.Status == dependency.Status:
+			continue
+		case entities.IsFinalJobStatus(job.Status):
+			return jobDependenciesUnsatisfiable, fmt.Sprintf("dependency %s is %s, expected %s", job.UUID, job.Status, dependency.Status)
+		default:
+			state = jobDependenciesPending
+		}
+	}
+
+	return state, ""
+}
+
+// validateJobInput checks that the input can be resolved from its job once MINED and applied to the transaction
+func validateJobInput(input *entities.JobInput, inputJob *entities.Job, tx *entities.ETHTransaction) error {
+	if input.Output == entities.JobOutputContractAddress &&
+		(inputJob.Type != entities.EthereumTransaction || inputJob.Transaction.To != nil) {
+		return errors.InvalidParameterError("job %s is not a public contract deployment", inputJob.UUID)
+	}
+
+	switch input.Target {
+	case entities.JobInputTargetTo:
+		if input.Output != entities.JobOutputContractAddress {
+			return errors.InvalidParameterError("only a contract address can be set as transaction recipient")
+		}
+	case entities.JobInputTargetData:
+		if input.DataOffset+ethcommon.HashLength > len(tx.Data) {
+			return errors.InvalidParameterError("data offset %d is out of the transaction data", input.DataOffset)
+		}
+	}
+
+	return nil
+}
+
+// setJobInputs sets the transaction fields targeted by the inputs from the outputs of the jobs indexed by UUID
+func setJobInputs(tx *entities.ETHTransaction, inputs []*entities.JobInput, jobs map[string]*entities.Job) error {
+	for _, input := range inputs {
+		job, ok := jobs[input.JobUUID]
+		if !ok || job.Status != entities.StatusMined {
+			return errors.InvalidStateError("input job %s is not mined", input.JobUUID)
+		}
+
+		output, err := jobOutput(job, input.Output)
+		if err != nil {
+			return err
+		}
+
+		switch input.Target {
+		case entities.JobInputTargetTo:
+			to := ethcommon.BytesToAddress(output)
+			tx.To = &to
+		case entities.JobInputTargetData:
+			if input.DataOffset+ethcommon.HashLength > len(tx.Data) {
+				return errors.DataError("data offset %d is out of the transaction data", input.DataOffset)
+			}
+			copy(tx.Data[input.DataOffset:], ethcommon.LeftPadBytes(output, ethcommon.HashLength))
+		}
+	}
+
+	return nil
+}
+
+func jobOutput(job *entities.Job, output entities.JobOutput) ([]byte, error) {
+	switch output {
+	case entities.JobOutputContractAddress:
+		if job.Transaction.From == nil || job.Transaction.Nonce == nil {
+			return nil, errors.DataError("job %s has no sender or nonce", job.UUID)
+		}
+		return crypto.CreateAddress(*job.Transaction.From, *job.Transaction.Nonce).Bytes(), nil
+	case entities.JobOutputTxHash:
+		if job.Transaction.Hash == nil {
+			return nil, errors.DataError("job %s has no transaction hash", job.UUID)
+		}
+		return job.Transaction.Hash.Bytes(), nil
+	default:
+		return nil, errors.DataError("unknown job output %s", output)
+	}
+}
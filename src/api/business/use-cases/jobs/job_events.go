@@ -0,0 +1,175 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const (
+	publishJobEventComponent    = "use-cases.publish-job-event"
+	subscribeJobEventsComponent = "use-cases.subscribe-job-events"
+)
+
+// jobEventsBufferSize is the number of events kept for a slow subscriber before dropping new ones
+const jobEventsBufferSize = 100
+
+type jobEventsSubscriber struct {
+	filters  *entities.JobEventFilters
+	userInfo *multitenancy.UserInfo
+	events   chan *entities.JobEvent
+}
+
+// JobEventsHub dispatches the job events published by every API instance to the subscribers of this instance.
+// Events go through the database so the hub listens to them only while it has subscribers
+type JobEventsHub struct {
+	db            store.DB
+	mux           sync.RWMutex
+	subscribers   map[*jobEventsSubscriber]struct{}
+	stopListening context.CancelFunc
+	logger        *log.Logger
+}
+
+func NewJobEventsHub(db store.DB) *JobEventsHub {
+	return &JobEventsHub{
+		db:          db,
+		subscribers: make(map[*jobEventsSubscriber]struct{}),
+		logger:      log.NewLogger().SetComponent(subscribeJobEventsComponent),
+	}
+}
+
+func (hub *JobEventsHub) subscribe(sub *jobEventsSubscriber) error {
+	hub.mux.Lock()
+	defer hub.mux.Unlock()
+
+	if len(hub.subscribers) == 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		events, err := hub.db.JobEvent().Listen(ctx)
+		if err != nil {
+			cancel()
+			return err
+		}
+
+		hub.stopListening = cancel
+		go hub.dispatch(events)
+	}
+
+	hub.subscribers[sub] = struct{}{}
+	return nil
+}
+
+func (hub *JobEventsHub) unsubscribe(sub *jobEventsSubscriber) {
+	hub.mux.Lock()
+	defer hub.mux.Unlock()
+
+	delete(hub.subscribers, sub)
+	close(sub.events)
+
+	if len(hub.subscribers) == 0 {
+		hub.stopListening()
+	}
+}
+
+// dispatch sends the events without blocking, events are dropped for subscribers not consuming them
+func (hub *JobEventsHub) dispatch(events <-chan *entities.JobEvent) {
+	for event := range events {
+		hub.mux.RLock()
+		for sub := range hub.subscribers {
+			if !matchJobEvent(sub, event) {
+				continue
+			}
+
+			select {
+			case sub.events <- event:
+			default:
+				hub.logger.WithField("job", event.JobUUID).Warn("job events subscriber is too slow, event dropped")
+			}
+		}
+		hub.mux.RUnlock()
+	}
+}
+
+// publishJobEventUseCase is a use case to send a job event to the subscribers of all the API instances
+type publishJobEventUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewPublishJobEventUseCase creates a new PublishJobEventUseCase
+func NewPublishJobEventUseCase(db store.DB) usecases.PublishJobEventUseCase {
+	return &publishJobEventUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(publishJobEventComponent),
+	}
+}
+
+// Execute sends the event, events are best effort so a failure is only logged
+func (uc *publishJobEventUseCase) Execute(ctx context.Context, event *entities.JobEvent) {
+	err := uc.db.JobEvent().Notify(ctx, event)
+	if err != nil {
+		uc.logger.WithContext(ctx).WithError(err).WithField("job", event.JobUUID).Warn("failed to publish job event")
+	}
+}
+
+// subscribeJobEventsUseCase is a use case to receive the job events of the allowed tenants
+type subscribeJobEventsUseCase struct {
+	hub    *JobEventsHub
+	logger *log.Logger
+}
+
+// NewSubscribeJobEventsUseCase creates a new SubscribeJobEventsUseCase
+func NewSubscribeJobEventsUseCase(hub *JobEventsHub) usecases.SubscribeJobEventsUseCase {
+	return &subscribeJobEventsUseCase{
+		hub:    hub,
+		logger: log.NewLogger().SetComponent(subscribeJobEventsComponent),
+	}
+}
+
+// Execute returns a channel of job events, closed when the context is done
+func (uc *subscribeJobEventsUseCase) Execute(ctx context.Context, filters *entities.JobEventFilters,
+	userInfo *multitenancy.UserInfo) (<-chan *entities.JobEvent, error) {
+	sub := &jobEventsSubscriber{
+		filters:  filters,
+		userInfo: userInfo,
+		events:   make(chan *entities.JobEvent, jobEventsBufferSize),
+	}
+
+	err := uc.hub.subscribe(sub)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(subscribeJobEventsComponent)
+	}
+	uc.logger.WithContext(ctx).Debug("subscribed to job events")
+
+	go func() {
+		<-ctx.Done()
+
+		uc.hub.unsubscribe(sub)
+		uc.logger.WithContext(ctx).Debug("unsubscribed from job events")
+	}()
+
+	return sub.events, nil
+}
+
+func matchJobEvent(sub *jobEventsSubscriber, event *entities.JobEvent) bool {
+	if !sub.userInfo.HasTenantAccess(event.TenantID) || !sub.userInfo.HasUsernameAccess(event.OwnerID) {
+		return false
+	}
+
+	if sub.filters.ChainUUID != "" && sub.filters.ChainUUID != event.ChainUUID {
+		return false
+	}
+
+	for key, value := range sub.filters.Labels {
+		if event.Labels[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,128 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockJobEventDA := mocks.NewMockJobEventAgent(ctrl)
+	mockDB.EXPECT().JobEvent().Return(mockJobEventDA).AnyTimes()
+
+	// notified simulates the database notification channel shared by the API instances
+	notified := make(chan *entities.JobEvent, jobEventsBufferSize)
+	mockJobEventDA.EXPECT().Notify(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event *entities.JobEvent) error {
+		notified <- event
+		return nil
+	}).AnyTimes()
+	mockJobEventDA.EXPECT().Listen(gomock.Any()).DoAndReturn(func(ctx context.Context) (<-chan *entities.JobEvent, error) {
+		events := make(chan *entities.JobEvent)
+		go func() {
+			defer close(events)
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case event := <-notified:
+					events <- event
+				}
+			}
+		}()
+		return events, nil
+	}).AnyTimes()
+
+	hub := NewJobEventsHub(mockDB)
+	publishUC := NewPublishJobEventUseCase(mockDB)
+	subscribeUC := NewSubscribeJobEventsUseCase(hub)
+
+	newEvent := func(tenantID, chainUUID string) *entities.JobEvent {
+		return &entities.JobEvent{
+			JobUUID:   "jobUUID",
+			TenantID:  tenantID,
+			ChainUUID: chainUUID,
+			Labels:    map[string]string{"app": "payments"},
+			Status:    entities.StatusMined,
+		}
+	}
+
+	t.Run("should receive events of allowed tenants matching the filters", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		userInfo := multitenancy.NewUserInfo("tenantOne", "")
+
+		events, err := subscribeUC.Execute(ctx, &entities.JobEventFilters{
+			ChainUUID: "chainUUID",
+			Labels:    map[string]string{"app": "payments"},
+		}, userInfo)
+		require.NoError(t, err)
+
+		publishUC.Execute(ctx, newEvent("tenantTwo", "chainUUID"))
+		publishUC.Execute(ctx, newEvent("tenantOne", "otherChainUUID"))
+		expectedEvent := newEvent("tenantOne", "chainUUID")
+		publishUC.Execute(ctx, expectedEvent)
+
+		assert.Equal(t, expectedEvent, <-events)
+		cancel()
+		for range events {
+			t.Error("should not receive events not matching the filters")
+		}
+	})
+
+	t.Run("should not receive events of other owners", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		events, err := subscribeUC.Execute(ctx, &entities.JobEventFilters{}, multitenancy.NewUserInfo("tenantOne", "alice"))
+		require.NoError(t, err)
+
+		event := newEvent("tenantOne", "chainUUID")
+		event.OwnerID = "bob"
+		publishUC.Execute(ctx, event)
+		expectedEvent := newEvent("tenantOne", "chainUUID")
+		expectedEvent.OwnerID = "alice"
+		publishUC.Execute(ctx, expectedEvent)
+
+		assert.Equal(t, expectedEvent, <-events)
+		cancel()
+		for range events {
+			t.Error("should not receive events of other owners")
+		}
+	})
+
+	t.Run("should close channel when context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		events, err := subscribeUC.Execute(ctx, &entities.JobEventFilters{}, multitenancy.NewUserInfo("tenantOne", ""))
+		require.NoError(t, err)
+		cancel()
+
+		_, ok := <-events
+		assert.False(t, ok)
+		publishUC.Execute(context.Background(), newEvent("tenantOne", "chainUUID"))
+	})
+
+	t.Run("should fail with same error if listen to job events fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+		failingDB := mocks.NewMockDB(ctrl)
+		failingJobEventDA := mocks.NewMockJobEventAgent(ctrl)
+		failingDB.EXPECT().JobEvent().Return(failingJobEventDA).AnyTimes()
+		failingJobEventDA.EXPECT().Listen(gomock.Any()).Return(nil, expectedErr)
+
+		_, err := NewSubscribeJobEventsUseCase(NewJobEventsHub(failingDB)).Execute(context.Background(),
+			&entities.JobEventFilters{}, multitenancy.NewUserInfo("tenantOne", ""))
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(subscribeJobEventsComponent), err)
+	})
+}
@@ -0,0 +1,56 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const rejectJobComponent = "use-cases.reject-job"
+
+// rejectJobUseCase is a use case to reject a job awaiting approval
+type rejectJobUseCase struct {
+	db          store.DB
+	updateJobUC usecases.UpdateJobUseCase
+	logger      *log.Logger
+}
+
+// NewRejectJobUseCase creates a new RejectJobUseCase
+func NewRejectJobUseCase(db store.DB, updateJobUC usecases.UpdateJobUseCase) usecases.RejectJobUseCase {
+	return &rejectJobUseCase{
+		db:          db,
+		updateJobUC: updateJobUC,
+		logger:      log.NewLogger().SetComponent(rejectJobComponent),
+	}
+}
+
+// Execute records the rejection of the user and fails the job, a single rejection is enough to cancel a job
+func (uc *rejectJobUseCase) Execute(ctx context.Context, jobUUID, reason string, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+	ctx = log.WithFields(ctx, log.Field("job", jobUUID))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("rejecting job")
+
+	jobModel, _, _, _, err := recordJobDecision(ctx, uc.db, jobUUID, reason, entities.ApprovalDecisionRejected, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(rejectJobComponent)
+	}
+
+	msg := fmt.Sprintf("rejected by %s", userInfo.Username)
+	if reason != "" {
+		msg = fmt.Sprintf("%s: %s", msg, reason)
+	}
+
+	job, err := uc.updateJobUC.Execute(ctx, &entities.Job{UUID: jobUUID}, entities.StatusFailed, msg, makerUserInfo(jobModel))
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(rejectJobComponent)
+	}
+
+	logger.Info("job rejected")
+	return job, nil
+}
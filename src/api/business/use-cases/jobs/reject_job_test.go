@@ -0,0 +1,82 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	mocks2 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectJob_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockAccountDA := mocks.NewMockAccountAgent(ctrl)
+	mockJobApprovalDA := mocks.NewMockJobApprovalAgent(ctrl)
+	mockUpdateJobUC := mocks2.NewMockUpdateJobUseCase(ctrl)
+
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Account().Return(mockAccountDA).AnyTimes()
+	mockDBTX.EXPECT().JobApproval().Return(mockJobApprovalDA).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+
+	maker := multitenancy.NewUserInfo("tenantOne", "maker")
+	approver := multitenancy.NewUserInfo("tenantOne", "alice")
+	usecase := NewRejectJobUseCase(mockDB, mockUpdateJobUC)
+
+	account := testdata.FakeAccountModel()
+	account.ApprovalPolicy = &entities.ApprovalPolicy{Threshold: 2, Approvers: []string{"alice", "bob"}}
+
+	t.Run("should record rejection and fail the job", func(t *testing.T) {
+		job := testdata.FakeJobModel(1)
+		job.Status = entities.StatusAwaitingApproval
+		job.Transaction.Sender = account.Address
+		job.Schedule = testdata.FakeSchedule(maker.TenantID, maker.Username)
+		failedJob := &entities.Job{UUID: job.UUID, Status: entities.StatusFailed}
+
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), job.UUID).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, approver.AllowedTenants, multitenancy.WildcardOwner, false).Return(job, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), account.Address, maker.AllowedTenants, maker.Username).Return(account, nil)
+		mockJobApprovalDA.EXPECT().FindAllByJobUUID(gomock.Any(), job.UUID).Return([]*models.JobApproval{}, nil)
+		mockJobApprovalDA.EXPECT().Insert(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, approval *models.JobApproval) error {
+				assert.Equal(t, string(entities.ApprovalDecisionRejected), approval.Decision)
+				return nil
+			})
+		mockDBTX.EXPECT().Commit().Return(nil)
+		mockUpdateJobUC.EXPECT().Execute(gomock.Any(), &entities.Job{UUID: job.UUID}, entities.StatusFailed, "rejected by alice: wrong amount", maker).
+			Return(failedJob, nil)
+
+		result, err := usecase.Execute(ctx, job.UUID, "wrong amount", approver)
+
+		require.NoError(t, err)
+		assert.Equal(t, failedJob, result)
+	})
+
+	t.Run("should fail with same error if the job cannot be locked", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), "jobUUID").Return(expectedErr)
+		mockDBTX.EXPECT().Rollback().Return(nil)
+
+		_, err := usecase.Execute(ctx, "jobUUID", "", approver)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(rejectJobComponent), err)
+	})
+}
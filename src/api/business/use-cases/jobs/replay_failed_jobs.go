@@ -0,0 +1,91 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/utils"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const replayFailedJobsComponent = "use-cases.replay-failed-jobs"
+
+// maxReplayedFailedJobs is the number of failed jobs replayed at once, and the size of the pages of failures searched
+const maxReplayedFailedJobs = 100
+
+// replayFailedJobsUseCase is a use case to send failed jobs to the tx-sender again
+type replayFailedJobsUseCase struct {
+	db          store.DB
+	replayJobUC usecases.ReplayJobUseCase
+	logger      *log.Logger
+}
+
+// NewReplayFailedJobsUseCase creates a new ReplayFailedJobsUseCase
+func NewReplayFailedJobsUseCase(db store.DB, replayJobUC usecases.ReplayJobUseCase) usecases.ReplayFailedJobsUseCase {
+	return &replayFailedJobsUseCase{
+		db:          db,
+		replayJobUC: replayJobUC,
+		logger:      log.NewLogger().SetComponent(replayFailedJobsComponent),
+	}
+}
+
+// Execute restarts the jobs of the pending failures matching the filters and returns the replayed failures, oldest
+// first. Jobs which cannot be replayed anymore are skipped, the pages of failures are searched until enough jobs are
+// replayed. Only tenant admins can replay failures without filtering them by job or chain
+func (uc *replayFailedJobsUseCase) Execute(ctx context.Context, filters *entities.FailedJobFilters, userInfo *multitenancy.UserInfo) ([]*entities.FailedJob, error) {
+	logger := uc.logger.WithContext(ctx)
+
+	if !hasFailedJobFilters(filters) && !userInfo.IsTenantAdmin() {
+		errMessage := "job UUIDs or a chain UUID are required to replay failed jobs"
+		logger.Error(errMessage)
+		return nil, errors.UnauthorizedError(errMessage).ExtendComponent(replayFailedJobsComponent)
+	}
+
+	filters.OnlyPending = true
+	filters.Pagination = entities.Pagination{Limit: maxReplayedFailedJobs, Sort: entities.SortByCreatedAt}
+
+	replayed := []*entities.FailedJob{}
+	for len(replayed) < maxReplayedFailedJobs {
+		failedJobModels, err := uc.db.FailedJob().Search(ctx, filters, userInfo.AllowedTenants, userInfo.Username)
+		if err != nil {
+			return replayed, errors.FromError(err).ExtendComponent(replayFailedJobsComponent)
+		}
+
+		for _, failedJobModel := range failedJobModels {
+			if len(replayed) == maxReplayedFailedJobs {
+				break
+			}
+
+			failedJob, der := uc.replayJobUC.Execute(ctx, parsers.NewFailedJobEntityFromModel(failedJobModel), userInfo)
+			if errors.IsInvalidStateError(der) {
+				logger.WithError(der).WithField("job", failedJobModel.JobUUID).Warn("job cannot be replayed, replay skipped")
+				continue
+			}
+			if der != nil {
+				return replayed, errors.FromError(der).ExtendComponent(replayFailedJobsComponent)
+			}
+
+			replayed = append(replayed, failedJob)
+		}
+
+		if !filters.HasNextPage(len(failedJobModels)) {
+			break
+		}
+
+		last := failedJobModels[len(failedJobModels)-1]
+		filters.Next = utils.EncodeCursor(last.CreatedAt, last.UUID)
+	}
+
+	logger.WithField("replayed", len(replayed)).Info("failed jobs replayed successfully")
+	return replayed, nil
+}
+
+// hasFailedJobFilters indicates whether the failures are narrowed to some jobs or a chain
+func hasFailedJobFilters(filters *entities.FailedJobFilters) bool {
+	return len(filters.JobUUIDs) > 0 || filters.ChainUUID != ""
+}
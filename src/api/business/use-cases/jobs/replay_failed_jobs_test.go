@@ -0,0 +1,162 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/utils"
+	ucmocks "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayFailedJobs_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFailedJobDA := mocks.NewMockFailedJobAgent(ctrl)
+	mockReplayJobUC := ucmocks.NewMockReplayJobUseCase(ctrl)
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDB.EXPECT().FailedJob().Return(mockFailedJobDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewReplayFailedJobsUseCase(mockDB, mockReplayJobUC)
+
+	t.Run("should replay the jobs of the pending failures", func(t *testing.T) {
+		failedJobModel := testdata.FakeFailedJobModel("jobUUID", "chainUUID", "tenantOne")
+		failedJob := parsers.NewFailedJobEntityFromModel(failedJobModel)
+		replayedJob := parsers.NewFailedJobEntityFromModel(failedJobModel)
+		replayedJob.ReplayCount = 1
+		filters := &entities.FailedJobFilters{ChainUUID: "chainUUID"}
+
+		mockFailedJobDA.EXPECT().Search(gomock.Any(), &entities.FailedJobFilters{
+			Pagination:  entities.Pagination{Limit: maxReplayedFailedJobs, Sort: entities.SortByCreatedAt},
+			ChainUUID:   "chainUUID",
+			OnlyPending: true,
+		}, userInfo.AllowedTenants, userInfo.Username).Return([]*models.FailedJob{failedJobModel}, nil)
+		mockReplayJobUC.EXPECT().Execute(gomock.Any(), failedJob, userInfo).Return(replayedJob, nil)
+
+		result, err := usecase.Execute(ctx, filters, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*entities.FailedJob{replayedJob}, result)
+	})
+
+	t.Run("should skip jobs which cannot be replayed", func(t *testing.T) {
+		failedJobModel := testdata.FakeFailedJobModel("jobUUID", "chainUUID", "tenantOne")
+
+		mockFailedJobDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+			Return([]*models.FailedJob{failedJobModel}, nil)
+		mockReplayJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return(nil, errors.InvalidStateError("error"))
+
+		result, err := usecase.Execute(ctx, &entities.FailedJobFilters{JobUUIDs: []string{"jobUUID"}}, userInfo)
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should search the next pages of failures when the jobs of a page cannot be replayed", func(t *testing.T) {
+		var skippedModels []*models.FailedJob
+		for i := 0; i < maxReplayedFailedJobs; i++ {
+			failedJobModel := testdata.FakeFailedJobModel(fmt.Sprintf("skippedJobUUID%d", i), "chainUUID", "tenantOne")
+			failedJobModel.UUID = fmt.Sprintf("skippedUUID%d", i)
+			skippedModels = append(skippedModels, failedJobModel)
+		}
+		last := skippedModels[len(skippedModels)-1]
+		failedJobModel := testdata.FakeFailedJobModel("jobUUID", "chainUUID", "tenantOne")
+		replayedJob := parsers.NewFailedJobEntityFromModel(failedJobModel)
+
+		gomock.InOrder(
+			mockFailedJobDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+				DoAndReturn(func(ctx context.Context, filters *entities.FailedJobFilters, tenants []string, ownerID string) ([]*models.FailedJob, error) {
+					assert.Empty(t, filters.Next)
+					return skippedModels, nil
+				}),
+			mockFailedJobDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+				DoAndReturn(func(ctx context.Context, filters *entities.FailedJobFilters, tenants []string, ownerID string) ([]*models.FailedJob, error) {
+					assert.Equal(t, utils.EncodeCursor(last.CreatedAt, last.UUID), filters.Next)
+					return []*models.FailedJob{failedJobModel}, nil
+				}),
+		)
+		mockReplayJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).
+			DoAndReturn(func(ctx context.Context, failedJob *entities.FailedJob, userInfo *multitenancy.UserInfo) (*entities.FailedJob, error) {
+				if failedJob.JobUUID != failedJobModel.JobUUID {
+					return nil, errors.InvalidStateError("job is not failed")
+				}
+				return replayedJob, nil
+			}).Times(maxReplayedFailedJobs + 1)
+
+		result, err := usecase.Execute(ctx, &entities.FailedJobFilters{ChainUUID: "chainUUID"}, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*entities.FailedJob{replayedJob}, result)
+	})
+
+	t.Run("should fail with UnauthorizedError if the failures are not filtered by job or chain by a user", func(t *testing.T) {
+		jwtUserInfo := multitenancy.NewJWTUserInfo(&entities.UserClaims{TenantID: "tenantOne", Username: "username"}, "token")
+
+		_, err := usecase.Execute(ctx, &entities.FailedJobFilters{CreatedAfter: time.Unix(0, 0)}, jwtUserInfo)
+
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should replay all the pending failures for tenant admins", func(t *testing.T) {
+		adminInfo := multitenancy.NewInternalAdminUser()
+
+		mockFailedJobDA.EXPECT().Search(gomock.Any(), &entities.FailedJobFilters{
+			Pagination:  entities.Pagination{Limit: maxReplayedFailedJobs, Sort: entities.SortByCreatedAt},
+			OnlyPending: true,
+		}, adminInfo.AllowedTenants, adminInfo.Username).Return([]*models.FailedJob{}, nil)
+
+		result, err := usecase.Execute(ctx, &entities.FailedJobFilters{}, adminInfo)
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should fail with UnauthorizedError if no filter is provided by a user", func(t *testing.T) {
+		jwtUserInfo := multitenancy.NewJWTUserInfo(&entities.UserClaims{TenantID: "tenantOne", Username: "username"}, "token")
+
+		_, err := usecase.Execute(ctx, &entities.FailedJobFilters{}, jwtUserInfo)
+
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should fail with same error if a job cannot be replayed", func(t *testing.T) {
+		expectedErr := errors.KafkaConnectionError("error")
+		failedJobModel := testdata.FakeFailedJobModel("jobUUID", "chainUUID", "tenantOne")
+
+		mockFailedJobDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+			Return([]*models.FailedJob{failedJobModel}, nil)
+		mockReplayJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return(nil, expectedErr)
+
+		result, err := usecase.Execute(ctx, &entities.FailedJobFilters{ChainUUID: "chainUUID"}, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(replayFailedJobsComponent), err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should fail with same error if search fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockFailedJobDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, &entities.FailedJobFilters{ChainUUID: "chainUUID"}, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(replayFailedJobsComponent), err)
+	})
+}
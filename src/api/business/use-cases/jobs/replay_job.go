@@ -0,0 +1,176 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/utils/envelope"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+	pkgsarama "github.com/consensys/orchestrate/src/infra/broker/sarama"
+	"github.com/consensys/orchestrate/src/infra/database"
+)
+
+const replayJobComponent = "use-cases.replay-job"
+
+const replayedJobMessage = "job replayed"
+
+// replayJobUseCase is a use case to send a job failed by the tx-sender to the tx-sender again
+type replayJobUseCase struct {
+	db                    store.DB
+	kafkaProducer         sarama.SyncProducer
+	topicsCfg             *pkgsarama.KafkaTopicConfig
+	updateJobUseCase      usecases.UpdateJobUseCase
+	notifyWebhooksUseCase usecases.NotifyWebhooksUseCase
+	publishJobEventUC     usecases.PublishJobEventUseCase
+	logger                *log.Logger
+}
+
+// NewReplayJobUseCase creates a new ReplayJobUseCase
+func NewReplayJobUseCase(db store.DB, kafkaProducer sarama.SyncProducer, topicsCfg *pkgsarama.KafkaTopicConfig,
+	updateJobUC usecases.UpdateJobUseCase, notifyWebhooksUC usecases.NotifyWebhooksUseCase,
+	publishJobEventUC usecases.PublishJobEventUseCase) usecases.ReplayJobUseCase {
+	return &replayJobUseCase{
+		db:                    db,
+		kafkaProducer:         kafkaProducer,
+		topicsCfg:             topicsCfg,
+		updateJobUseCase:      updateJobUC,
+		notifyWebhooksUseCase: notifyWebhooksUC,
+		publishJobEventUC:     publishJobEventUC,
+		logger:                log.NewLogger().SetComponent(replayJobComponent),
+	}
+}
+
+// Execute restarts the FAILED job of the failure and marks the failure as replayed. Replays are the only way out of
+// the FAILED status, the job must still be allowed to be sent: its sender is enabled and its approvals are reached
+func (uc *replayJobUseCase) Execute(ctx context.Context, failedJob *entities.FailedJob, userInfo *multitenancy.UserInfo) (*entities.FailedJob, error) {
+	ctx = log.WithFields(ctx, log.Field("job", failedJob.JobUUID))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("replaying job")
+
+	jobModel, err := uc.db.Job().FindOneByUUID(ctx, failedJob.JobUUID, userInfo.AllowedTenants, userInfo.Username, false)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(replayJobComponent)
+	}
+
+	if err = uc.checkSendable(ctx, jobModel); err != nil {
+		logger.WithError(err).Error("cannot replay job")
+		return nil, errors.FromError(err).ExtendComponent(replayJobComponent)
+	}
+
+	failedJobModel := parsers.NewFailedJobModelFromEntity(failedJob)
+	now := time.Now().UTC()
+	failedJobModel.ReplayedAt = &now
+	failedJobModel.ReplayCount++
+
+	jobLogModel := &models.Log{
+		Status:  entities.StatusStarted,
+		Message: replayedJobMessage,
+	}
+	err = database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		if der := tx.(store.Tx).Job().LockOneByUUID(ctx, jobModel.UUID); der != nil {
+			return der
+		}
+
+		// The job could have been replayed or updated since it was read
+		var der error
+		jobModel, der = tx.(store.Tx).Job().FindOneByUUID(ctx, jobModel.UUID, userInfo.AllowedTenants, userInfo.Username, false)
+		if der != nil {
+			return der
+		}
+		if jobModel.Status != entities.StatusFailed {
+			return errors.InvalidStateError("job status %s is not failed, cannot be replayed", jobModel.Status)
+		}
+
+		jobModel.Status = entities.StatusStarted
+		if der = tx.(store.Tx).Job().Update(ctx, jobModel); der != nil {
+			return der
+		}
+
+		jobLogModel.JobID = &jobModel.ID
+		if der = tx.(store.Tx).Log().Insert(ctx, jobLogModel); der != nil {
+			return der
+		}
+
+		return tx.(store.Tx).FailedJob().Update(ctx, failedJobModel)
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to restart job")
+		return nil, errors.FromError(err).ExtendComponent(replayJobComponent)
+	}
+
+	jobEntity := parsers.NewJobEntityFromModels(jobModel)
+	uc.notify(ctx, jobEntity, jobLogModel)
+
+	partition, offset, err := envelope.SendJobMessage(jobEntity, uc.kafkaProducer, uc.topicsCfg.Sender)
+	if err != nil {
+		errMsg := "failed to send job message"
+		logger.WithError(err).Error(errMsg)
+		uc.rollback(ctx, jobEntity, failedJob, errMsg, userInfo)
+		return nil, errors.FromError(err).ExtendComponent(replayJobComponent)
+	}
+
+	logger.WithField("partition", partition).WithField("offset", offset).Info("job replayed successfully")
+	return parsers.NewFailedJobEntityFromModel(failedJobModel), nil
+}
+
+// checkSendable applies the checks of the job start to the sender of the job
+func (uc *replayJobUseCase) checkSendable(ctx context.Context, jobModel *models.Job) error {
+	if jobModel.Status != entities.StatusFailed {
+		return errors.InvalidStateError("job status %s is not failed, cannot be replayed", jobModel.Status)
+	}
+
+	account, err := findSenderAccount(ctx, uc.db, jobModel)
+	if err != nil {
+		return err
+	}
+
+	if isSenderDisabled(account, jobModel) {
+		return errors.InvalidStateError("cannot replay job sent from a disabled account")
+	}
+
+	if account == nil || account.ApprovalPolicy == nil {
+		return nil
+	}
+
+	approved, _, err := isJobApproved(ctx, uc.db, jobModel, account.ApprovalPolicy)
+	if err != nil {
+		return err
+	}
+	if !approved {
+		return errors.InvalidStateError("cannot replay job which is not approved")
+	}
+
+	return nil
+}
+
+// notify publishes the job event and notifies the webhooks, their failure must not fail the replay
+func (uc *replayJobUseCase) notify(ctx context.Context, job *entities.Job, jobLogModel *models.Log) {
+	uc.publishJobEventUC.Execute(ctx, newJobEvent(job, jobLogModel))
+	if err := uc.notifyWebhooksUseCase.Execute(ctx, job, jobLogModel.Status, jobLogModel.Message); err != nil {
+		uc.logger.WithContext(ctx).WithError(err).Warn("failed to notify webhooks")
+	}
+}
+
+// rollback fails the job again and keeps its failure pending, so that it can be replayed later
+func (uc *replayJobUseCase) rollback(ctx context.Context, job *entities.Job, failedJob *entities.FailedJob, errMsg string,
+	userInfo *multitenancy.UserInfo) {
+	logger := uc.logger.WithContext(ctx)
+
+	_, err := uc.updateJobUseCase.Execute(ctx, &entities.Job{UUID: job.UUID}, entities.StatusFailed, errMsg, userInfo)
+	if err != nil {
+		logger.WithError(err).Error("failed to fail job again")
+		return
+	}
+
+	if err = uc.db.FailedJob().Update(ctx, parsers.NewFailedJobModelFromEntity(failedJob)); err != nil {
+		logger.WithError(err).Error("failed to keep failure pending")
+	}
+}
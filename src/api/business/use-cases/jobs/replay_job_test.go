@@ -0,0 +1,217 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	mocks2 "github.com/Shopify/sarama/mocks"
+	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/tx"
+	ucmocks "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/broker/sarama"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayJob_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockLogDA := mocks.NewMockLogAgent(ctrl)
+	mockAccountDA := mocks.NewMockAccountAgent(ctrl)
+	mockJobApprovalDA := mocks.NewMockJobApprovalAgent(ctrl)
+	mockFailedJobDA := mocks.NewMockFailedJobAgent(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockKafkaProducer := mocks2.NewSyncProducer(t, nil)
+	mockUpdateJobUC := ucmocks.NewMockUpdateJobUseCase(ctrl)
+	mockNotifyWebhooksUC := ucmocks.NewMockNotifyWebhooksUseCase(ctrl)
+	mockPublishJobEventUC := ucmocks.NewMockPublishJobEventUseCase(ctrl)
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDB.EXPECT().Account().Return(mockAccountDA).AnyTimes()
+	mockDB.EXPECT().JobApproval().Return(mockJobApprovalDA).AnyTimes()
+	mockDB.EXPECT().FailedJob().Return(mockFailedJobDA).AnyTimes()
+	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
+	mockDBTX.EXPECT().FailedJob().Return(mockFailedJobDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewReplayJobUseCase(mockDB, mockKafkaProducer, sarama.NewKafkaTopicConfig(viper.GetViper()),
+		mockUpdateJobUC, mockNotifyWebhooksUC, mockPublishJobEventUC)
+
+	sender := "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+	mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), sender, gomock.Any(), gomock.Any()).Return(nil, errors.NotFoundError("error")).AnyTimes()
+
+	failedJobModel := func() *models.Job {
+		jobModel := testdata.FakeJobModel(1)
+		jobModel.Status = entities.StatusFailed
+		jobModel.Transaction.Sender = sender
+		return jobModel
+	}
+
+	t.Run("should restart the failed job, mark its failure as replayed and notify the status change", func(t *testing.T) {
+		jobModel := failedJobModel()
+		failedJob := parsers.NewFailedJobEntityFromModel(testdata.FakeFailedJobModel(jobModel.UUID, jobModel.ChainUUID, "tenantOne"))
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(jobModel, nil).Times(2)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), jobModel.UUID).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), jobModel).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, jobLog *models.Log) error {
+			assert.Equal(t, entities.StatusStarted, jobLog.Status)
+			assert.Equal(t, replayedJobMessage, jobLog.Message)
+			return nil
+		})
+		mockFailedJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, failedJobModel *models.FailedJob) error {
+			assert.Equal(t, failedJob.UUID, failedJobModel.UUID)
+			assert.NotNil(t, failedJobModel.ReplayedAt)
+			assert.Equal(t, 1, failedJobModel.ReplayCount)
+			return nil
+		})
+		mockDBTX.EXPECT().Commit().Return(nil)
+		mockPublishJobEventUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Do(func(_ context.Context, event *entities.JobEvent) {
+			assert.Equal(t, jobModel.UUID, event.JobUUID)
+			assert.Equal(t, entities.StatusStarted, event.Status)
+		})
+		mockNotifyWebhooksUC.EXPECT().Execute(gomock.Any(), gomock.Any(), entities.StatusStarted, replayedJobMessage).Return(nil)
+		mockKafkaProducer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(val []byte) error {
+			txEnvelope := &tx.TxEnvelope{}
+			if err := encoding.Unmarshal(val, txEnvelope); err != nil {
+				return err
+			}
+			envelope, err := txEnvelope.Envelope()
+			if err != nil {
+				return err
+			}
+
+			assert.Equal(t, jobModel.UUID, envelope.GetJobUUID())
+			return nil
+		})
+
+		result, err := usecase.Execute(ctx, failedJob, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.StatusStarted, jobModel.Status)
+		assert.False(t, result.IsPending())
+		assert.Equal(t, 1, result.ReplayCount)
+	})
+
+	t.Run("should fail with InvalidStateError if job is not failed anymore once locked", func(t *testing.T) {
+		jobModel := failedJobModel()
+		lockedJobModel := failedJobModel()
+		lockedJobModel.UUID = jobModel.UUID
+		lockedJobModel.Status = entities.StatusStarted
+		failedJob := parsers.NewFailedJobEntityFromModel(testdata.FakeFailedJobModel(jobModel.UUID, jobModel.ChainUUID, "tenantOne"))
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(jobModel, nil)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), jobModel.UUID).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(lockedJobModel, nil)
+
+		_, err := usecase.Execute(ctx, failedJob, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail with InvalidStateError if job is not failed", func(t *testing.T) {
+		jobModel := failedJobModel()
+		jobModel.Status = entities.StatusMined
+		failedJob := parsers.NewFailedJobEntityFromModel(testdata.FakeFailedJobModel(jobModel.UUID, jobModel.ChainUUID, "tenantOne"))
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(jobModel, nil)
+
+		_, err := usecase.Execute(ctx, failedJob, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail with InvalidStateError if job is not approved", func(t *testing.T) {
+		approvalSender := "0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"
+		approvalAccount := testdata.FakeAccountModel()
+		approvalAccount.ApprovalPolicy = &entities.ApprovalPolicy{Threshold: 2, Approvers: []string{"alice", "bob", "carol"}}
+		jobModel := failedJobModel()
+		jobModel.Transaction.Sender = approvalSender
+		failedJob := parsers.NewFailedJobEntityFromModel(testdata.FakeFailedJobModel(jobModel.UUID, jobModel.ChainUUID, "tenantOne"))
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(jobModel, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), approvalSender, gomock.Any(), gomock.Any()).Return(approvalAccount, nil)
+		mockJobApprovalDA.EXPECT().FindAllByJobUUID(gomock.Any(), jobModel.UUID).Return([]*models.JobApproval{
+			{Username: "alice", Decision: string(entities.ApprovalDecisionApproved)},
+			{Username: "bob", Decision: string(entities.ApprovalDecisionRejected)},
+		}, nil)
+
+		_, err := usecase.Execute(ctx, failedJob, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail with InvalidStateError if the sender account is disabled", func(t *testing.T) {
+		disabledSender := "0x93f7274c9059e601be4512F656B57b830e019E41"
+		disabledAt := time.Now()
+		disabledAccount := testdata.FakeAccountModel()
+		disabledAccount.DisabledAt = &disabledAt
+		jobModel := failedJobModel()
+		jobModel.Transaction.Sender = disabledSender
+		failedJob := parsers.NewFailedJobEntityFromModel(testdata.FakeFailedJobModel(jobModel.UUID, jobModel.ChainUUID, "tenantOne"))
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(jobModel, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), disabledSender, gomock.Any(), gomock.Any()).Return(disabledAccount, nil)
+
+		_, err := usecase.Execute(ctx, failedJob, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail the job again and keep its failure pending if job message cannot be sent", func(t *testing.T) {
+		jobModel := failedJobModel()
+		failedJob := parsers.NewFailedJobEntityFromModel(testdata.FakeFailedJobModel(jobModel.UUID, jobModel.ChainUUID, "tenantOne"))
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(jobModel, nil).Times(2)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), jobModel.UUID).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), jobModel).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockFailedJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+		mockPublishJobEventUC.EXPECT().Execute(gomock.Any(), gomock.Any())
+		mockNotifyWebhooksUC.EXPECT().Execute(gomock.Any(), gomock.Any(), entities.StatusStarted, replayedJobMessage).Return(nil)
+		mockKafkaProducer.ExpectSendMessageAndFail(fmt.Errorf("error"))
+		mockUpdateJobUC.EXPECT().Execute(gomock.Any(), &entities.Job{UUID: jobModel.UUID}, entities.StatusFailed, "failed to send job message", userInfo).
+			Return(nil, nil)
+		mockFailedJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, failedJobModel *models.FailedJob) error {
+			assert.Nil(t, failedJobModel.ReplayedAt)
+			assert.Equal(t, 0, failedJobModel.ReplayCount)
+			return nil
+		})
+
+		_, err := usecase.Execute(ctx, failedJob, userInfo)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail with same error if job cannot be found", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), "jobUUID", userInfo.AllowedTenants, userInfo.Username, false).Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, &entities.FailedJob{JobUUID: "jobUUID"}, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(replayJobComponent), err)
+	})
+}
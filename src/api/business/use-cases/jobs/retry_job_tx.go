@@ -56,6 +56,12 @@ func (uc *retryJobTxUseCase) Execute(ctx context.Context, jobUUID string, gasInc
 	job.UUID = ""
 	job.InternalData.ParentJobUUID = jobUUID
 	job.Transaction.Data = txData
+	// Marking transactions without data cannot be processed by the private transaction managers, they are replaced
+	// (called off) by public transactions at the same nonce
+	if len(txData) == 0 && (job.Type == entities.TesseraMarkingTransaction || job.Type == entities.EEAMarkingTransaction) {
+		job.Type = entities.EthereumTransaction
+	}
+
 	increment := int64(math.Trunc((gasIncrement + 1.0) * 100))
 	if job.Transaction.TransactionType == entities.LegacyTxType {
 		gasPrice := job.Transaction.GasPrice.ToInt()
@@ -80,6 +80,48 @@ func TestRetryJobTx_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 	
+	t.Run("should keep the marking transaction type when speeding up a private transaction", func(t *testing.T) {
+		job := testdata.FakeJobModel(1)
+		job.Type = entities.TesseraMarkingTransaction
+		job.Transaction.TxType = string(entities.LegacyTxType)
+		job.Transaction.GasPrice = "10000000000"
+		job.Status = entities.StatusPending
+		nextJobUUID := "uuid"
+		enclaveKey := utils.StringToHexBytes("0xac")
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		createJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).DoAndReturn(func(ctx context.Context, nextJob *entities.Job, ui *multitenancy.UserInfo) (*entities.Job, error) {
+			assert.Equal(t, entities.TesseraMarkingTransaction, nextJob.Type)
+			assert.Equal(t, enclaveKey, nextJob.Transaction.Data)
+			nextJob.UUID = nextJobUUID
+			return nextJob, nil
+		})
+		startJobUC.EXPECT().Execute(gomock.Any(), nextJobUUID, userInfo)
+
+		err := usecase.Execute(ctx, job.UUID, 0.1, enclaveKey, userInfo)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should replace a marking transaction by a public transaction when calling off", func(t *testing.T) {
+		job := testdata.FakeJobModel(1)
+		job.Type = entities.EEAMarkingTransaction
+		job.Transaction.TxType = string(entities.LegacyTxType)
+		job.Transaction.GasPrice = "10000000000"
+		job.Status = entities.StatusPending
+		nextJobUUID := "uuid"
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		createJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).DoAndReturn(func(ctx context.Context, nextJob *entities.Job, ui *multitenancy.UserInfo) (*entities.Job, error) {
+			assert.Equal(t, entities.EthereumTransaction, nextJob.Type)
+			assert.Equal(t, job.UUID, nextJob.InternalData.ParentJobUUID)
+			assert.Empty(t, nextJob.Transaction.Data)
+			nextJob.UUID = nextJobUUID
+			return nextJob, nil
+		})
+		startJobUC.EXPECT().Execute(gomock.Any(), nextJobUUID, userInfo)
+
+		err := usecase.Execute(ctx, job.UUID, 0.1, nil, userInfo)
+		assert.NoError(t, err)
+	})
+
 	t.Run("should fail to execute if status is not pending", func(t *testing.T) {
 		job := testdata.FakeJobModel(1)
 		job.Transaction.TxType = string(entities.LegacyTxType)
@@ -0,0 +1,45 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const searchFailedJobsComponent = "use-cases.search-failed-jobs"
+
+// searchFailedJobsUseCase is a use case to search the jobs failed by the tx-sender
+type searchFailedJobsUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewSearchFailedJobsUseCase creates a new SearchFailedJobsUseCase
+func NewSearchFailedJobsUseCase(db store.DB) usecases.SearchFailedJobsUseCase {
+	return &searchFailedJobsUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(searchFailedJobsComponent),
+	}
+}
+
+// Execute returns the failed jobs matching the filters
+func (uc *searchFailedJobsUseCase) Execute(ctx context.Context, filters *entities.FailedJobFilters, userInfo *multitenancy.UserInfo) ([]*entities.FailedJob, error) {
+	failedJobModels, err := uc.db.FailedJob().Search(ctx, filters, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchFailedJobsComponent)
+	}
+
+	failedJobs := make([]*entities.FailedJob, len(failedJobModels))
+	for idx, failedJobModel := range failedJobModels {
+		failedJobs[idx] = parsers.NewFailedJobEntityFromModel(failedJobModel)
+	}
+
+	uc.logger.Debug("failed jobs found successfully")
+	return failedJobs, nil
+}
@@ -0,0 +1,58 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchFailedJobs_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockFailedJobDA := mocks.NewMockFailedJobAgent(ctrl)
+	mockDB.EXPECT().FailedJob().Return(mockFailedJobDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewSearchFailedJobsUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		chainUUID := uuid.Must(uuid.NewV4()).String()
+		failedJobModel := testdata.FakeFailedJobModel(uuid.Must(uuid.NewV4()).String(), chainUUID, "tenantOne")
+		filters := &entities.FailedJobFilters{ChainUUID: chainUUID, ErrorClass: entities.FailedJobConnectionError}
+
+		mockFailedJobDA.EXPECT().Search(gomock.Any(), filters, userInfo.AllowedTenants, userInfo.Username).
+			Return([]*models.FailedJob{failedJobModel}, nil)
+
+		result, err := usecase.Execute(ctx, filters, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entities.FailedJob{parsers.NewFailedJobEntityFromModel(failedJobModel)}, result)
+	})
+
+	t.Run("should fail with same error if search fails", func(t *testing.T) {
+		filters := &entities.FailedJobFilters{}
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockFailedJobDA.EXPECT().Search(gomock.Any(), filters, userInfo.AllowedTenants, userInfo.Username).Return(nil, expectedErr)
+
+		result, err := usecase.Execute(ctx, filters, userInfo)
+
+		assert.Nil(t, result)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(searchFailedJobsComponent), err)
+	})
+}
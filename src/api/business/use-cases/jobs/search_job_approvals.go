@@ -0,0 +1,67 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const searchJobApprovalsComponent = "use-cases.search-job-approvals"
+
+// searchJobApprovalsUseCase is a use case to get the audit trail of the decisions taken on a job
+type searchJobApprovalsUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewSearchJobApprovalsUseCase creates a new SearchJobApprovalsUseCase
+func NewSearchJobApprovalsUseCase(db store.DB) usecases.SearchJobApprovalsUseCase {
+	return &searchJobApprovalsUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(searchJobApprovalsComponent),
+	}
+}
+
+// Execute returns the approvals and rejections of a job, visible to its owner and to the approvers of its sender
+func (uc *searchJobApprovalsUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) ([]*entities.JobApproval, error) {
+	ctx = log.WithFields(ctx, log.Field("job", jobUUID))
+
+	jobModel, err := uc.db.Job().FindOneByUUID(ctx, jobUUID, userInfo.AllowedTenants, multitenancy.WildcardOwner, false)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchJobApprovalsComponent)
+	}
+
+	if jobModel.Schedule == nil || !isJobOwner(jobModel.Schedule.OwnerID, userInfo) {
+		policy, der := findApprovalPolicy(ctx, uc.db, jobModel)
+		if der != nil {
+			return nil, errors.FromError(der).ExtendComponent(searchJobApprovalsComponent)
+		}
+
+		if policy == nil || !policy.HasApprover(userInfo.Username) {
+			return nil, errors.NotFoundError("job not found").ExtendComponent(searchJobApprovalsComponent)
+		}
+	}
+
+	approvalModels, err := uc.db.JobApproval().FindAllByJobUUID(ctx, jobUUID)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchJobApprovalsComponent)
+	}
+
+	approvals := make([]*entities.JobApproval, len(approvalModels))
+	for idx, approvalModel := range approvalModels {
+		approvals[idx] = parsers.NewJobApprovalEntityFromModel(approvalModel)
+	}
+
+	uc.logger.WithContext(ctx).Debug("job approvals found successfully")
+	return approvals, nil
+}
+
+func isJobOwner(ownerID string, userInfo *multitenancy.UserInfo) bool {
+	return userInfo.Username == multitenancy.WildcardOwner || userInfo.Username == ownerID
+}
@@ -0,0 +1,80 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchJobApprovals_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockAccountDA := mocks.NewMockAccountAgent(ctrl)
+	mockJobApprovalDA := mocks.NewMockJobApprovalAgent(ctrl)
+
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDB.EXPECT().Account().Return(mockAccountDA).AnyTimes()
+	mockDB.EXPECT().JobApproval().Return(mockJobApprovalDA).AnyTimes()
+
+	maker := multitenancy.NewUserInfo("tenantOne", "maker")
+	usecase := NewSearchJobApprovalsUseCase(mockDB)
+
+	account := testdata.FakeAccountModel()
+	account.ApprovalPolicy = &entities.ApprovalPolicy{Threshold: 1, Approvers: []string{"alice"}}
+
+	job := testdata.FakeJobModel(1)
+	job.Transaction.Sender = account.Address
+	job.Schedule = testdata.FakeSchedule(maker.TenantID, maker.Username)
+	approvals := []*models.JobApproval{{UUID: "approvalUUID", JobUUID: job.UUID, Username: "alice", Decision: string(entities.ApprovalDecisionApproved)}}
+
+	t.Run("should return the approvals to the owner of the job", func(t *testing.T) {
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, maker.AllowedTenants, multitenancy.WildcardOwner, false).Return(job, nil)
+		mockJobApprovalDA.EXPECT().FindAllByJobUUID(gomock.Any(), job.UUID).Return(approvals, nil)
+
+		result, err := usecase.Execute(ctx, job.UUID, maker)
+
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, "alice", result[0].Username)
+		assert.Equal(t, entities.ApprovalDecisionApproved, result[0].Decision)
+	})
+
+	t.Run("should return the approvals to an approver", func(t *testing.T) {
+		approver := multitenancy.NewUserInfo("tenantOne", "alice")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, approver.AllowedTenants, multitenancy.WildcardOwner, false).Return(job, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), account.Address, maker.AllowedTenants, maker.Username).Return(account, nil)
+		mockJobApprovalDA.EXPECT().FindAllByJobUUID(gomock.Any(), job.UUID).Return(approvals, nil)
+
+		result, err := usecase.Execute(ctx, job.UUID, approver)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("should fail with NotFoundError if user is neither the owner nor an approver", func(t *testing.T) {
+		user := multitenancy.NewUserInfo("tenantOne", "mallory")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, user.AllowedTenants, multitenancy.WildcardOwner, false).Return(job, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), account.Address, maker.AllowedTenants, maker.Username).Return(account, nil)
+
+		_, err := usecase.Execute(ctx, job.UUID, user)
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
@@ -0,0 +1,165 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/database"
+)
+
+const startDependentJobsComponent = "use-cases.start-dependent-jobs"
+
+// startDependentJobsUseCase is a use case to start or skip the jobs depending on a job of the same schedule
+type startDependentJobsUseCase struct {
+	db                store.DB
+	startJobUC        usecases.StartJobUseCase
+	notifyWebhooksUC  usecases.NotifyWebhooksUseCase
+	publishJobEventUC usecases.PublishJobEventUseCase
+	logger            *log.Logger
+}
+
+// NewStartDependentJobsUseCase creates a new StartDependentJobsUseCase
+func NewStartDependentJobsUseCase(db store.DB, startJobUC usecases.StartJobUseCase, notifyWebhooksUC usecases.NotifyWebhooksUseCase,
+	publishJobEventUC usecases.PublishJobEventUseCase) usecases.StartDependentJobsUseCase {
+	return &startDependentJobsUseCase{
+		db:                db,
+		startJobUC:        startJobUC,
+		notifyWebhooksUC:  notifyWebhooksUC,
+		publishJobEventUC: publishJobEventUC,
+		logger:            log.NewLogger().SetComponent(startDependentJobsComponent),
+	}
+}
+
+// Execute starts the created jobs depending on the given job once all their dependencies are satisfied.
+// Jobs whose dependencies can no longer be satisfied are SKIPPED, which in turn resolves the jobs depending on them
+func (uc *startDependentJobsUseCase) Execute(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("job", job.UUID), log.Field("schedule", job.ScheduleUUID))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("starting dependent jobs")
+
+	err := uc.execute(ctx, job.ScheduleUUID, job.UUID, userInfo)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(startDependentJobsComponent)
+	}
+
+	return nil
+}
+
+func (uc *startDependentJobsUseCase) execute(ctx context.Context, scheduleUUID, jobUUID string, userInfo *multitenancy.UserInfo) error {
+	logger := uc.logger.WithContext(ctx)
+
+	schedule, err := uc.db.Schedule().FindOneByUUID(ctx, scheduleUUID, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return err
+	}
+
+	jobs := make(map[string]*entities.Job)
+	for _, jobModel := range schedule.Jobs {
+		jobs[jobModel.UUID] = parsers.NewJobEntityFromModels(jobModel)
+	}
+
+	var lastErr error
+	for _, jobModel := range schedule.Jobs {
+		if jobModel.Status != entities.StatusCreated || !dependsOnJob(jobModel, jobUUID) {
+			continue
+		}
+
+		jobLogger := logger.WithField("dependent_job", jobModel.UUID)
+		state, msg := evaluateJobDependencies(jobModel.DependsOn, jobs)
+		switch state {
+		case jobDependenciesSatisfied:
+			// The job could have been started concurrently by the resolution of another of its dependencies
+			err = uc.startJobUC.Execute(ctx, jobModel.UUID, userInfo)
+			switch {
+			case errors.IsInvalidStateError(err):
+				jobLogger.WithError(err).Warn("dependent job cannot be started")
+			case err != nil:
+				jobLogger.WithError(err).Error("failed to start dependent job")
+				lastErr = err
+			}
+		case jobDependenciesUnsatisfiable:
+			skippedJob, skippedLog, der := uc.skipJob(ctx, jobModel.UUID, msg, userInfo)
+			if der != nil {
+				jobLogger.WithError(der).Error("failed to skip dependent job")
+				lastErr = der
+				continue
+			}
+
+			jobLogger.WithField("reason", msg).Info("dependent job skipped")
+			if skippedJob != nil {
+				// Skipped jobs are notified as any job status change, notifications must not fail the resolution
+				skippedEntity := parsers.NewJobEntityFromModels(skippedJob)
+				uc.publishJobEventUC.Execute(ctx, newJobEvent(skippedEntity, skippedLog))
+				if der = uc.notifyWebhooksUC.Execute(ctx, skippedEntity, skippedLog.Status, skippedLog.Message); der != nil {
+					jobLogger.WithError(der).Warn("failed to notify webhooks")
+				}
+
+				if der = uc.execute(ctx, scheduleUUID, jobModel.UUID, userInfo); der != nil {
+					lastErr = der
+				}
+			}
+		}
+	}
+
+	return lastErr
+}
+
+// skipJob sets the job as SKIPPED if it was not started in the meantime, it returns the skipped job and its status log
+func (uc *startDependentJobsUseCase) skipJob(ctx context.Context, jobUUID, msg string, userInfo *multitenancy.UserInfo) (*models.Job, *models.Log, error) {
+	var skippedJob *models.Job
+	var skippedLog *models.Log
+	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		if der := tx.(store.Tx).Job().LockOneByUUID(ctx, jobUUID); der != nil {
+			return der
+		}
+
+		jobModel, der := tx.(store.Tx).Job().FindOneByUUID(ctx, jobUUID, userInfo.AllowedTenants, userInfo.Username, false)
+		if der != nil {
+			return der
+		}
+
+		if !canUpdateStatus(entities.StatusSkipped, jobModel.Status) {
+			return nil
+		}
+
+		jobModel.Status = entities.StatusSkipped
+		if der = tx.(store.Tx).Job().Update(ctx, jobModel); der != nil {
+			return der
+		}
+
+		jobLog := &models.Log{
+			JobID:   &jobModel.ID,
+			Status:  entities.StatusSkipped,
+			Message: msg,
+		}
+		if der = tx.(store.Tx).Log().Insert(ctx, jobLog); der != nil {
+			return der
+		}
+
+		jobModel.Logs = append(jobModel.Logs, jobLog)
+		skippedJob, skippedLog = jobModel, jobLog
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return skippedJob, skippedLog, nil
+}
+
+func dependsOnJob(jobModel *models.Job, jobUUID string) bool {
+	for _, dependency := range jobModel.DependsOn {
+		if dependency.JobUUID == jobUUID {
+			return true
+		}
+	}
+
+	return false
+}
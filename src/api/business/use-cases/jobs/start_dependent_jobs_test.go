@@ -0,0 +1,171 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	mocks2 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartDependentJobs_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockScheduleDA := mocks.NewMockScheduleAgent(ctrl)
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockLogDA := mocks.NewMockLogAgent(ctrl)
+	mockStartJobUC := mocks2.NewMockStartJobUseCase(ctrl)
+	mockNotifyWebhooksUC := mocks2.NewMockNotifyWebhooksUseCase(ctrl)
+	mockPublishJobEventUC := mocks2.NewMockPublishJobEventUseCase(ctrl)
+
+	mockDB.EXPECT().Schedule().Return(mockScheduleDA).AnyTimes()
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
+	mockDBTX.EXPECT().Commit().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewStartDependentJobsUseCase(mockDB, mockStartJobUC, mockNotifyWebhooksUC, mockPublishJobEventUC)
+
+	newSchedule := func(statuses ...entities.JobStatus) *models.Schedule {
+		schedule := testdata.FakeSchedule(userInfo.TenantID, userInfo.Username)
+		schedule.Jobs = nil
+		for _, status := range statuses {
+			job := testdata.FakeJobModel(1)
+			job.Status = status
+			schedule.Jobs = append(schedule.Jobs, job)
+		}
+		return schedule
+	}
+
+	t.Run("should start dependent job when all dependencies are satisfied", func(t *testing.T) {
+		schedule := newSchedule(entities.StatusMined, entities.StatusMined, entities.StatusCreated, entities.StatusCreated)
+		jobA, jobB, jobC, jobD := schedule.Jobs[0], schedule.Jobs[1], schedule.Jobs[2], schedule.Jobs[3]
+		jobC.DependsOn = []*entities.JobDependency{
+			{JobUUID: jobA.UUID, Status: entities.StatusMined},
+			{JobUUID: jobB.UUID, Status: entities.StatusMined},
+		}
+		jobD.DependsOn = []*entities.JobDependency{{JobUUID: jobC.UUID, Status: entities.StatusMined}}
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), schedule.UUID, userInfo.AllowedTenants, userInfo.Username).Return(schedule, nil)
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), jobC.UUID, userInfo).Return(nil)
+
+		err := usecase.Execute(ctx, &entities.Job{UUID: jobB.UUID, ScheduleUUID: schedule.UUID}, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not start dependent job when dependencies are pending", func(t *testing.T) {
+		schedule := newSchedule(entities.StatusMined, entities.StatusPending, entities.StatusCreated)
+		jobA, jobB, jobC := schedule.Jobs[0], schedule.Jobs[1], schedule.Jobs[2]
+		jobC.DependsOn = []*entities.JobDependency{
+			{JobUUID: jobA.UUID, Status: entities.StatusMined},
+			{JobUUID: jobB.UUID, Status: entities.StatusMined},
+		}
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), schedule.UUID, userInfo.AllowedTenants, userInfo.Username).Return(schedule, nil)
+
+		err := usecase.Execute(ctx, &entities.Job{UUID: jobA.UUID, ScheduleUUID: schedule.UUID}, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should skip unsatisfiable dependent jobs and the jobs depending on them", func(t *testing.T) {
+		schedule := newSchedule(entities.StatusMined, entities.StatusCreated, entities.StatusCreated)
+		jobA, jobB, jobC := schedule.Jobs[0], schedule.Jobs[1], schedule.Jobs[2]
+		jobB.DependsOn = []*entities.JobDependency{{JobUUID: jobA.UUID, Status: entities.StatusFailed}}
+		jobC.DependsOn = []*entities.JobDependency{{JobUUID: jobB.UUID, Status: entities.StatusMined}}
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), schedule.UUID, userInfo.AllowedTenants, userInfo.Username).Return(schedule, nil)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), jobB.UUID).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobB.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(jobB, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), jobB).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, log *models.Log) error {
+			assert.Equal(t, entities.StatusSkipped, log.Status)
+			log.UUID = "skippedLogUUID"
+			return nil
+		})
+		mockPublishJobEventUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, event *entities.JobEvent) {
+			assert.Equal(t, jobB.UUID, event.JobUUID)
+			assert.Equal(t, "skippedLogUUID", event.UUID)
+			assert.Equal(t, entities.StatusSkipped, event.Status)
+		})
+		mockNotifyWebhooksUC.EXPECT().Execute(gomock.Any(), gomock.Any(), entities.StatusSkipped, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, job *entities.Job, status entities.JobStatus, message string) error {
+				assert.Equal(t, jobB.UUID, job.UUID)
+				return nil
+			})
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), schedule.UUID, userInfo.AllowedTenants, userInfo.Username).Return(schedule, nil)
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), jobC.UUID).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobC.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(jobC, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), jobC).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockPublishJobEventUC.EXPECT().Execute(gomock.Any(), gomock.Any())
+		// Webhook failures do not fail the resolution of dependent jobs
+		mockNotifyWebhooksUC.EXPECT().Execute(gomock.Any(), gomock.Any(), entities.StatusSkipped, gomock.Any()).Return(errors.ServiceConnectionError("error"))
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), schedule.UUID, userInfo.AllowedTenants, userInfo.Username).Return(schedule, nil)
+
+		err := usecase.Execute(ctx, &entities.Job{UUID: jobA.UUID, ScheduleUUID: schedule.UUID}, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusSkipped, jobB.Status)
+		assert.Equal(t, entities.StatusSkipped, jobC.Status)
+	})
+
+	t.Run("should not fail if the dependent job was started concurrently", func(t *testing.T) {
+		schedule := newSchedule(entities.StatusMined, entities.StatusMined, entities.StatusCreated)
+		jobA, jobB, jobC := schedule.Jobs[0], schedule.Jobs[1], schedule.Jobs[2]
+		jobC.DependsOn = []*entities.JobDependency{
+			{JobUUID: jobA.UUID, Status: entities.StatusMined},
+			{JobUUID: jobB.UUID, Status: entities.StatusMined},
+		}
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), schedule.UUID, userInfo.AllowedTenants, userInfo.Username).Return(schedule, nil)
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), jobC.UUID, userInfo).Return(errors.InvalidStateError("error"))
+
+		err := usecase.Execute(ctx, &entities.Job{UUID: jobB.UUID, ScheduleUUID: schedule.UUID}, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if start job fails", func(t *testing.T) {
+		schedule := newSchedule(entities.StatusFailed, entities.StatusCreated)
+		jobA, jobB := schedule.Jobs[0], schedule.Jobs[1]
+		jobB.DependsOn = []*entities.JobDependency{{JobUUID: jobA.UUID, Status: entities.StatusFailed}}
+		expectedErr := errors.KafkaConnectionError("error")
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), schedule.UUID, userInfo.AllowedTenants, userInfo.Username).Return(schedule, nil)
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), jobB.UUID, userInfo).Return(expectedErr)
+
+		err := usecase.Execute(ctx, &entities.Job{UUID: jobA.UUID, ScheduleUUID: schedule.UUID}, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(startDependentJobsComponent), err)
+	})
+
+	t.Run("should fail with same error if find schedule fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), "scheduleUUID", userInfo.AllowedTenants, userInfo.Username).Return(nil, expectedErr)
+
+		err := usecase.Execute(ctx, &entities.Job{UUID: "jobUUID", ScheduleUUID: "scheduleUUID"}, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(startDependentJobsComponent), err)
+	})
+}
@@ -2,6 +2,8 @@ package jobs
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
@@ -47,6 +49,11 @@ func NewStartJobUseCase(
 	}
 }
 
+func (uc startJobUseCase) WithDBTransaction(dbtx store.Tx) usecases.StartJobUseCase {
+	uc.db = dbtx
+	return &uc
+}
+
 // Execute sends a job to the Kafka topic
 func (uc *startJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error {
 	logger := uc.logger.WithContext(ctx).WithField("job", jobUUID)
@@ -57,22 +64,76 @@ func (uc *startJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo
 		return errors.FromError(err).ExtendComponent(startJobComponent)
 	}
 
-	jobEntity := parsers.NewJobEntityFromModels(jobModel)
-	if !canUpdateStatus(entities.StatusStarted, jobEntity.Status) {
+	if !canUpdateStatus(entities.StatusStarted, jobModel.Status) {
 		errMessage := "cannot start job at the current status"
-		logger.WithField("status", jobEntity.Status).WithField("next_status", entities.StatusStarted).Error(errMessage)
+		logger.WithField("status", jobModel.Status).WithField("next_status", entities.StatusStarted).Error(errMessage)
 		return errors.InvalidStateError(errMessage)
 	}
 
-	err = uc.updateStatus(ctx, jobModel, entities.StatusStarted, "")
+	// Retries of a job already sent are not bound to the schedule window
+	if jobModel.Schedule != nil && (jobModel.InternalData == nil || jobModel.InternalData.ParentJobUUID == "") {
+		now := time.Now().UTC()
+		switch {
+		case isScheduleDeferred(jobModel.Schedule, now):
+			logger.WithField("next_run_at", jobModel.Schedule.NextRunAt).Info("job deferred until its schedule runs")
+			return nil
+		case isScheduleExpired(jobModel.Schedule, now):
+			errMessage := "schedule window has expired"
+			if err = uc.updateStatus(ctx, jobModel, entities.StatusExpired, errMessage, userInfo); err != nil {
+				logger.WithError(err).Error("failed to expire job")
+				return errors.FromError(err).ExtendComponent(startJobComponent)
+			}
+			logger.WithField("not_after", jobModel.Schedule.NotAfter).Error(errMessage)
+			return errors.InvalidStateError(errMessage)
+		}
+	}
+
+	account, err := findSenderAccount(ctx, uc.db, jobModel)
 	if err != nil {
+		logger.WithError(err).Error("failed to find job sender")
+		return errors.FromError(err).ExtendComponent(startJobComponent)
+	}
+
+	if isSenderDisabled(account, jobModel) {
+		errMessage := "cannot start job sent from a disabled account"
+		logger.WithField("sender", account.Address).Error(errMessage)
+		return errors.InvalidStateError(errMessage)
+	}
+
+	// Jobs sent from an account with an approval policy wait for enough approvals
+	approved, err := uc.isApproved(ctx, jobModel, account, userInfo)
+	if err != nil {
+		logger.WithError(err).Error("failed to check job approvals")
+		return errors.FromError(err).ExtendComponent(startJobComponent)
+	}
+	if !approved {
+		logger.Info("job awaiting approval")
+		return nil
+	}
+
+	// Transaction inputs set from the dependencies are persisted along with the STARTED status
+	var txModel *models.Transaction
+	if len(jobModel.DependsOn) > 0 {
+		txModel, err = uc.resolveDependencies(ctx, jobModel, userInfo)
+		if err != nil {
+			logger.WithError(err).Error("cannot start job with unresolved dependencies")
+			return errors.FromError(err).ExtendComponent(startJobComponent)
+		}
+	}
+
+	jobEntity := parsers.NewJobEntityFromModels(jobModel)
+
+	// The job is sent only once the STARTED status is committed, so that a job started concurrently is sent once
+	err = uc.updateStatusAndTransaction(ctx, jobModel, txModel, entities.StatusStarted, "", userInfo)
+	if err != nil {
+		logger.WithError(err).Error("failed to start job")
 		return errors.FromError(err).ExtendComponent(startJobComponent)
 	}
 
 	partition, offset, err := envelope.SendJobMessage(jobEntity, uc.kafkaProducer, uc.topicsCfg.Sender)
 	if err != nil {
 		errMsg := "failed to send job message"
-		_ = uc.updateStatus(ctx, jobModel, entities.StatusFailed, errMsg)
+		_ = uc.updateStatus(ctx, jobModel, entities.StatusFailed, errMsg, userInfo)
 		logger.WithError(err).Error(errMsg)
 		return errors.FromError(err).ExtendComponent(startJobComponent)
 	}
@@ -82,11 +143,101 @@ func (uc *startJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo
 	return nil
 }
 
-func (uc *startJobUseCase) updateStatus(ctx context.Context, job *models.Job, status entities.JobStatus, msg string) error {
+// resolveDependencies checks that the dependencies of the job are satisfied and sets the transaction inputs
+// from the outputs of the dependencies, it returns the transaction to update when the job has inputs
+func (uc *startJobUseCase) resolveDependencies(ctx context.Context, jobModel *models.Job, userInfo *multitenancy.UserInfo) (*models.Transaction, error) {
+	dependencies := make(map[string]*entities.Job)
+	for _, dependency := range jobModel.DependsOn {
+		dependencyModel, err := uc.db.Job().FindOneByUUID(ctx, dependency.JobUUID, userInfo.AllowedTenants, userInfo.Username, false)
+		if errors.IsNotFoundError(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		dependencies[dependency.JobUUID] = parsers.NewJobEntityFromModels(dependencyModel)
+	}
+
+	if state, msg := evaluateJobDependencies(jobModel.DependsOn, dependencies); state != jobDependenciesSatisfied {
+		if msg == "" {
+			msg = "job dependencies are pending"
+		}
+		return nil, errors.InvalidStateError("job dependencies are not satisfied: %s", msg)
+	}
+
+	if len(jobModel.Inputs) == 0 {
+		return nil, nil
+	}
+
+	txEntity := parsers.NewTransactionEntityFromModels(jobModel.Transaction)
+	if err := setJobInputs(txEntity, jobModel.Inputs, dependencies); err != nil {
+		return nil, err
+	}
+
+	parsers.UpdateTransactionModelFromEntities(jobModel.Transaction, txEntity)
+	return jobModel.Transaction, nil
+}
+
+// isApproved indicates whether the job reached the threshold of the approval policy of its sender, a job
+// not yet approved is moved to AWAITING_APPROVAL
+func (uc *startJobUseCase) isApproved(ctx context.Context, jobModel *models.Job, account *models.Account,
+	userInfo *multitenancy.UserInfo) (bool, error) {
+	if account == nil || account.ApprovalPolicy == nil {
+		return true, nil
+	}
+	policy := account.ApprovalPolicy
+
+	approved, count, err := isJobApproved(ctx, uc.db, jobModel, policy)
+	if err != nil || approved {
+		return approved, err
+	}
+
+	if jobModel.Status == entities.StatusCreated {
+		msg := fmt.Sprintf("waiting for %d approvals", policy.Threshold-count)
+		if err = uc.updateStatus(ctx, jobModel, entities.StatusAwaitingApproval, msg, userInfo); err != nil {
+			return false, err
+		}
+	}
+
+	return false, nil
+}
+
+// isSameTransactionIntent indicates whether both transactions perform the same call, regardless of their fees
+func isSameTransactionIntent(parent, child *models.Transaction) bool {
+	return parent != nil && child != nil &&
+		parent.Sender == child.Sender &&
+		parent.Recipient == child.Recipient &&
+		parent.Value == child.Value &&
+		parent.Data == child.Data
+}
+
+// isScheduleDeferred indicates whether the jobs of the schedule must wait for the scheduler to run it a first time
+func isScheduleDeferred(schedule *models.Schedule, now time.Time) bool {
+	return schedule.LastRunAt == nil && (schedule.Cron != "" || (schedule.NotBefore != nil && now.Before(*schedule.NotBefore)))
+}
+
+func isScheduleExpired(schedule *models.Schedule, now time.Time) bool {
+	return schedule.NotAfter != nil && !now.Before(*schedule.NotAfter)
+}
+
+// isSenderDisabled indicates whether the job is sent from a disabled account. Disabled accounts cannot sign new
+// transactions, except the drain of their balance to their successor
+func isSenderDisabled(account *models.Account, jobModel *models.Job) bool {
+	return account != nil && account.DisabledAt != nil &&
+		(account.Successor == "" || !strings.EqualFold(account.Successor, jobModel.Transaction.Recipient))
+}
+
+func (uc *startJobUseCase) updateStatus(ctx context.Context, job *models.Job, status entities.JobStatus, msg string,
+	userInfo *multitenancy.UserInfo) error {
+	return uc.updateStatusAndTransaction(ctx, job, nil, status, msg, userInfo)
+}
+
+// updateStatusAndTransaction updates the status of the job and, if any, its transaction in the same DB transaction.
+// The job is locked and must still be at the status it was read at, otherwise an InvalidStateError is returned
+func (uc *startJobUseCase) updateStatusAndTransaction(ctx context.Context, job *models.Job, txModel *models.Transaction,
+	status entities.JobStatus, msg string, userInfo *multitenancy.UserInfo) error {
 	prevUpdatedAt := job.UpdatedAt
 	prevStatus := job.Status
 
-	job.Status = status
 	jobLog := &models.Log{
 		JobID:   &job.ID,
 		Status:  status,
@@ -94,11 +245,31 @@ func (uc *startJobUseCase) updateStatus(ctx context.Context, job *models.Job, st
 	}
 
 	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
-		if err := tx.(store.Tx).Job().Update(ctx, job); err != nil {
+		if err := tx.(store.Tx).Job().LockOneByUUID(ctx, job.UUID); err != nil {
+			return err
+		}
+
+		// The job could have been started or updated since it was read
+		current, err := tx.(store.Tx).Job().FindOneByUUID(ctx, job.UUID, userInfo.AllowedTenants, userInfo.Username, false)
+		if err != nil {
+			return err
+		}
+		if current.Status != prevStatus {
+			return errors.InvalidStateError("job status changed from %s to %s, cannot move it to %s", prevStatus, current.Status, status)
+		}
+
+		if txModel != nil {
+			if err = tx.(store.Tx).Transaction().Update(ctx, txModel); err != nil {
+				return err
+			}
+		}
+
+		job.Status = status
+		if err = tx.(store.Tx).Job().Update(ctx, job); err != nil {
 			return err
 		}
 
-		if err := tx.(store.Tx).Log().Insert(ctx, jobLog); err != nil {
+		if err = tx.(store.Tx).Log().Insert(ctx, jobLog); err != nil {
 			return errors.FromError(err).ExtendComponent(startJobComponent)
 		}
 
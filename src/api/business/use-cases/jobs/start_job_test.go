@@ -4,8 +4,12 @@ package jobs
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	mock2 "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/mock"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
@@ -17,8 +21,11 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/types/tx"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
 	"github.com/consensys/orchestrate/src/api/store/models/testdata"
 	mocks2 "github.com/Shopify/sarama/mocks"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -31,6 +38,9 @@ func TestStartJob_Execute(t *testing.T) {
 
 	mockJobDA := mocks.NewMockJobAgent(ctrl)
 	mockLogDA := mocks.NewMockLogAgent(ctrl)
+	mockTransactionDA := mocks.NewMockTransactionAgent(ctrl)
+	mockAccountDA := mocks.NewMockAccountAgent(ctrl)
+	mockJobApprovalDA := mocks.NewMockJobApprovalAgent(ctrl)
 	mockDBTX := mocks.NewMockTx(ctrl)
 	mockKafkaProducer := mocks2.NewSyncProducer(t, nil)
 	mockMetrics := mock.NewMockTransactionSchedulerMetrics(ctrl)
@@ -46,12 +56,23 @@ func TestStartJob_Execute(t *testing.T) {
 
 	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
 	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDB.EXPECT().Account().Return(mockAccountDA).AnyTimes()
+	mockDB.EXPECT().JobApproval().Return(mockJobApprovalDA).AnyTimes()
 	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
 	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Transaction().Return(mockTransactionDA).AnyTimes()
+	mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 	usecase := NewStartJobUseCase(mockDB, mockKafkaProducer, sarama.NewKafkaTopicConfig(viper.GetViper()), mockMetrics)
 
+	sender := "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+	mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), sender, gomock.Any(), gomock.Any()).Return(nil, errors.NotFoundError("error")).AnyTimes()
+
+	approvalSender := "0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"
+	approvalAccount := testdata.FakeAccountModel()
+	approvalAccount.ApprovalPolicy = &entities.ApprovalPolicy{Threshold: 2, Approvers: []string{"alice", "bob", "carol"}}
+
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		job := testdata.FakeJobModel(1)
 		job.ID = 1
@@ -59,7 +80,7 @@ func TestStartJob_Execute(t *testing.T) {
 		job.Transaction.Sender = "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
 		job.Schedule = testdata.FakeSchedule("", "")
 
-		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil).Times(2)
 		mockKafkaProducer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(val []byte) error {
 			txEnvelope := &tx.TxEnvelope{}
 			err := encoding.Unmarshal(val, txEnvelope)
@@ -94,7 +115,7 @@ func TestStartJob_Execute(t *testing.T) {
 			OneTimeKey: true,
 		}
 
-		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil).Times(2)
 		mockKafkaProducer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(val []byte) error {
 			txEnvelope := &tx.TxEnvelope{}
 			err := encoding.Unmarshal(val, txEnvelope)
@@ -119,6 +140,227 @@ func TestStartJob_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should set inputs from dependencies and execute use case successfully", func(t *testing.T) {
+		deployJob := testdata.FakeJobModel(1)
+		deployJob.Status = entities.StatusMined
+		deployJob.Transaction.Sender = "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+		deployJob.Transaction.Nonce = "1"
+		job := testdata.FakeJobModel(1)
+		job.ID = 1
+		job.Transaction.Sender = "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+		job.Transaction.Data = "0xa9059cbb" + strings.Repeat("00", 32)
+		job.Schedule = testdata.FakeSchedule("", "")
+		job.DependsOn = []*entities.JobDependency{{JobUUID: deployJob.UUID, Status: entities.StatusMined}}
+		job.Inputs = []*entities.JobInput{
+			{JobUUID: deployJob.UUID, Output: entities.JobOutputContractAddress, Target: entities.JobInputTargetTo},
+			{JobUUID: deployJob.UUID, Output: entities.JobOutputContractAddress, Target: entities.JobInputTargetData, DataOffset: 4},
+		}
+		contractAddress := crypto.CreateAddress(ethcommon.HexToAddress(deployJob.Transaction.Sender), 1)
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil).Times(2)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), deployJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(deployJob, nil)
+		// Inputs are persisted in the same DB transaction as the STARTED status
+		gomock.InOrder(
+			mockTransactionDA.EXPECT().Update(gomock.Any(), job.Transaction).Return(nil),
+			mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil),
+		)
+		mockKafkaProducer.ExpectSendMessageAndSucceed()
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, contractAddress.Hex(), job.Transaction.Recipient)
+		assert.Equal(t, "0xa9059cbb"+hex.EncodeToString(ethcommon.LeftPadBytes(contractAddress.Bytes(), 32)), job.Transaction.Data)
+	})
+
+	t.Run("should fail with InvalidStateError if dependencies are not satisfied", func(t *testing.T) {
+		dependency := testdata.FakeJobModel(1)
+		dependency.Status = entities.StatusPending
+		job := testdata.FakeJobModel(1)
+		job.DependsOn = []*entities.JobDependency{{JobUUID: dependency.UUID, Status: entities.StatusFailed}}
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), dependency.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(dependency, nil)
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should defer job if its schedule has not run yet", func(t *testing.T) {
+		job := testdata.FakeJobModel(1)
+		job.Schedule = testdata.FakeSchedule("", "")
+		notBefore := time.Now().Add(time.Hour)
+		job.Schedule.NotBefore = &notBefore
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusCreated, job.Status)
+	})
+
+	t.Run("should expire job and fail with InvalidStateError if the schedule window has expired", func(t *testing.T) {
+		job := testdata.FakeJobModel(1)
+		job.Schedule = testdata.FakeSchedule("", "")
+		notAfter := time.Now().Add(-time.Hour)
+		job.Schedule.NotAfter = &notAfter
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil).Times(2)
+		mockJobDA.EXPECT().Update(gomock.Any(), job).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+		assert.Equal(t, entities.StatusExpired, job.Status)
+	})
+
+	t.Run("should fail with same error if expiring the job fails", func(t *testing.T) {
+		job := testdata.FakeJobModel(1)
+		job.Schedule = testdata.FakeSchedule("", "")
+		notAfter := time.Now().Add(-time.Hour)
+		job.Schedule.NotAfter = &notAfter
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil).Times(2)
+		mockJobDA.EXPECT().Update(gomock.Any(), job).Return(expectedErr)
+		mockDBTX.EXPECT().Rollback().Return(nil)
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(startJobComponent), err)
+	})
+
+	t.Run("should move job to AWAITING_APPROVAL if the approval threshold is not reached", func(t *testing.T) {
+		job := testdata.FakeJobModel(1)
+		job.Transaction.Sender = approvalSender
+		job.Schedule = testdata.FakeSchedule(userInfo.TenantID, userInfo.Username)
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil).Times(2)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), approvalSender, userInfo.AllowedTenants, userInfo.Username).Return(approvalAccount, nil)
+		mockJobApprovalDA.EXPECT().FindAllByJobUUID(gomock.Any(), job.UUID).Return([]*models.JobApproval{
+			{Username: "alice", Decision: string(entities.ApprovalDecisionApproved)},
+		}, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), job).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusAwaitingApproval, job.Status)
+	})
+
+	t.Run("should start job once the approval threshold is reached", func(t *testing.T) {
+		job := testdata.FakeJobModel(1)
+		job.Status = entities.StatusAwaitingApproval
+		job.Transaction.Sender = approvalSender
+		job.Schedule = testdata.FakeSchedule(userInfo.TenantID, userInfo.Username)
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil).Times(2)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), approvalSender, userInfo.AllowedTenants, userInfo.Username).Return(approvalAccount, nil)
+		mockJobApprovalDA.EXPECT().FindAllByJobUUID(gomock.Any(), job.UUID).Return([]*models.JobApproval{
+			{Username: "alice", Decision: string(entities.ApprovalDecisionApproved)},
+			{Username: "bob", Decision: string(entities.ApprovalDecisionApproved)},
+		}, nil)
+		mockKafkaProducer.ExpectSendMessageAndSucceed()
+		mockJobDA.EXPECT().Update(gomock.Any(), job).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusStarted, job.Status)
+	})
+
+	t.Run("should start child job if its parent sending the same transaction was approved", func(t *testing.T) {
+		parent := testdata.FakeJobModel(1)
+		parent.Transaction.Sender = approvalSender
+		job := testdata.FakeJobModel(1)
+		job.Transaction.Sender = approvalSender
+		job.Transaction.GasPrice = "0x2"
+		job.InternalData.ParentJobUUID = parent.UUID
+		job.Schedule = testdata.FakeSchedule(userInfo.TenantID, userInfo.Username)
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil).Times(2)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), approvalSender, userInfo.AllowedTenants, userInfo.Username).Return(approvalAccount, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), parent.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(parent, nil)
+		mockJobApprovalDA.EXPECT().FindAllByJobUUID(gomock.Any(), parent.UUID).Return([]*models.JobApproval{
+			{Username: "alice", Decision: string(entities.ApprovalDecisionApproved)},
+			{Username: "bob", Decision: string(entities.ApprovalDecisionApproved)},
+		}, nil)
+		mockKafkaProducer.ExpectSendMessageAndSucceed()
+		mockJobDA.EXPECT().Update(gomock.Any(), job).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusStarted, job.Status)
+	})
+
+	t.Run("should move child job to AWAITING_APPROVAL if it changes the transaction of its parent", func(t *testing.T) {
+		parent := testdata.FakeJobModel(1)
+		parent.Transaction.Sender = approvalSender
+		job := testdata.FakeJobModel(1)
+		job.Transaction.Sender = approvalSender
+		job.Transaction.Data = "0x"
+		job.InternalData.ParentJobUUID = parent.UUID
+		job.Schedule = testdata.FakeSchedule(userInfo.TenantID, userInfo.Username)
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil).Times(2)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), approvalSender, userInfo.AllowedTenants, userInfo.Username).Return(approvalAccount, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), parent.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(parent, nil)
+		mockJobApprovalDA.EXPECT().FindAllByJobUUID(gomock.Any(), job.UUID).Return([]*models.JobApproval{}, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), job).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusAwaitingApproval, job.Status)
+	})
+
+	t.Run("should fail with InvalidStateError if the sender account is disabled", func(t *testing.T) {
+		disabledSender := "0x93f7274c9059e601be4512F656B57b830e019E41"
+		disabledAt := time.Now()
+		disabledAccount := testdata.FakeAccountModel()
+		disabledAccount.DisabledAt = &disabledAt
+		disabledAccount.Successor = "0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"
+		job := testdata.FakeJobModel(1)
+		job.Transaction.Sender = disabledSender
+		job.Schedule = testdata.FakeSchedule(userInfo.TenantID, userInfo.Username)
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), disabledSender, userInfo.AllowedTenants, userInfo.Username).Return(disabledAccount, nil)
+
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should start job draining a disabled account to its successor", func(t *testing.T) {
+		disabledSender := "0x93f7274c9059e601be4512F656B57b830e019E41"
+		disabledAt := time.Now()
+		disabledAccount := testdata.FakeAccountModel()
+		disabledAccount.DisabledAt = &disabledAt
+		disabledAccount.Successor = "0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"
+		job := testdata.FakeJobModel(1)
+		job.Transaction.Sender = disabledSender
+		job.Transaction.Recipient = strings.ToLower(disabledAccount.Successor)
+		job.Schedule = testdata.FakeSchedule(userInfo.TenantID, userInfo.Username)
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil).Times(2)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), disabledSender, userInfo.AllowedTenants, userInfo.Username).Return(disabledAccount, nil)
+		mockKafkaProducer.ExpectSendMessageAndSucceed()
+		mockJobDA.EXPECT().Update(gomock.Any(), job).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockDBTX.EXPECT().Commit().Return(nil)
+		err := usecase.Execute(ctx, job.UUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusStarted, job.Status)
+	})
+
 	t.Run("should fail with same error if FindOne fails", func(t *testing.T) {
 		job := testdata.FakeJobModel(1)
 		job.UUID = "6380e2b6-b828-43ee-abdc-de0f8d57dc5f"
@@ -140,7 +382,7 @@ func TestStartJob_Execute(t *testing.T) {
 		job.Schedule.ID = 1
 		expectedErr := errors.PostgresConnectionError("error")
 
-		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil).Times(2)
 		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(expectedErr)
 		mockDBTX.EXPECT().Rollback().Return(nil)
@@ -154,7 +396,7 @@ func TestStartJob_Execute(t *testing.T) {
 		job.Transaction.Sender = "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
 		job.Schedule = testdata.FakeSchedule("", "")
 
-		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil).Times(3)
 		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
 		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil).Times(2)
 		mockDBTX.EXPECT().Commit().Return(nil).Times(2)
@@ -163,3 +405,102 @@ func TestStartJob_Execute(t *testing.T) {
 		assert.True(t, errors.IsKafkaConnectionError(err))
 	})
 }
+
+func TestStartJob_ExecuteConcurrently(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockLogDA := mocks.NewMockLogAgent(ctrl)
+	mockAccountDA := mocks.NewMockAccountAgent(ctrl)
+	mockKafkaProducer := mocks2.NewSyncProducer(t, nil)
+	mockMetrics := mock.NewMockTransactionSchedulerMetrics(ctrl)
+
+	jobsLatencyHistogram := mock2.NewMockHistogram(ctrl)
+	jobsLatencyHistogram.EXPECT().With(gomock.Any()).AnyTimes().Return(jobsLatencyHistogram)
+	jobsLatencyHistogram.EXPECT().Observe(gomock.Any()).AnyTimes()
+	mockMetrics.EXPECT().JobsLatencyHistogram().AnyTimes().Return(jobsLatencyHistogram)
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+
+	// Both dependencies of the job are mined in the same block, their updates resolve the job concurrently
+	dependencyA := testdata.FakeJobModel(1)
+	dependencyA.Status = entities.StatusMined
+	dependencyB := testdata.FakeJobModel(1)
+	dependencyB.Status = entities.StatusMined
+	job := testdata.FakeJobModel(1)
+	job.Schedule = testdata.FakeSchedule(userInfo.TenantID, userInfo.Username)
+	job.DependsOn = []*entities.JobDependency{
+		{JobUUID: dependencyA.UUID, Status: entities.StatusMined},
+		{JobUUID: dependencyB.UUID, Status: entities.StatusMined},
+	}
+
+	// The job lock is held from LockOneByUUID until the DB transaction ends
+	jobLock := &sync.Mutex{}
+	statusMux := &sync.RWMutex{}
+	status := job.Status
+
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDB.EXPECT().Account().Return(mockAccountDA).AnyTimes()
+	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
+	mockDBTX.EXPECT().Commit().DoAndReturn(func() error { jobLock.Unlock(); return nil }).AnyTimes()
+	mockDBTX.EXPECT().Rollback().DoAndReturn(func() error { jobLock.Unlock(); return nil }).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+
+	mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), job.UUID).DoAndReturn(func(ctx context.Context, jobUUID string) error {
+		jobLock.Lock()
+		return nil
+	}).AnyTimes()
+	mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+		DoAndReturn(func(ctx context.Context, jobUUID string, tenants []string, ownerID string, withLogs bool) (*models.Job, error) {
+			statusMux.RLock()
+			defer statusMux.RUnlock()
+			jobModel := *job
+			jobModel.Status = status
+			return &jobModel, nil
+		}).AnyTimes()
+	mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), dependencyA.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(dependencyA, nil).AnyTimes()
+	mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), dependencyB.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(dependencyB, nil).AnyTimes()
+	mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, jobModel *models.Job) error {
+		statusMux.Lock()
+		defer statusMux.Unlock()
+		status = jobModel.Status
+		return nil
+	}).AnyTimes()
+	mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.NotFoundError("error")).AnyTimes()
+	mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	// A second message fails the mock producer
+	mockKafkaProducer.ExpectSendMessageAndSucceed()
+
+	usecase := NewStartJobUseCase(mockDB, mockKafkaProducer, sarama.NewKafkaTopicConfig(viper.GetViper()), mockMetrics)
+
+	wg := &sync.WaitGroup{}
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- usecase.Execute(ctx, job.UUID, userInfo)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	started := 0
+	for err := range errs {
+		if err == nil {
+			started++
+			continue
+		}
+		assert.True(t, errors.IsInvalidStateError(err))
+	}
+
+	assert.Equal(t, 1, started)
+	assert.Equal(t, entities.StatusStarted, status)
+}
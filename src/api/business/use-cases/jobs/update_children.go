@@ -14,6 +14,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
 )
 
 const updateChildrenComponent = "use-cases.update-children"
@@ -37,29 +38,38 @@ func (uc updateChildrenUseCase) WithDBTransaction(dbtx store.Tx) usecases.Update
 	return &uc
 }
 
+// Execute sets the pending sibling and parent jobs to the final status and returns the updated jobs, whose last log
+// is the status change. The PENDING status reverts the NEVER_MINED sibling and parent jobs of a job removed from the
+// canonical chain
 func (uc *updateChildrenUseCase) Execute(ctx context.Context, jobUUID, parentJobUUID string,
-	nextStatus entities.JobStatus, userInfo *multitenancy.UserInfo) error {
+	nextStatus entities.JobStatus, userInfo *multitenancy.UserInfo) ([]*entities.Job, error) {
 	ctx = log.WithFields(ctx, log.Field("job", jobUUID), log.Field("parent_job", parentJobUUID),
 		log.Field("next_status", nextStatus))
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("updating sibling and/or parent jobs")
 
-	if !entities.IsFinalJobStatus(nextStatus) {
-		errMsg := "expected final job status"
+	if !entities.IsFinalJobStatus(nextStatus) && nextStatus != entities.StatusPending {
+		errMsg := "expected final or pending job status"
 		err := errors.InvalidParameterError(errMsg)
 		logger.WithError(err).Error("failed to update children jobs")
-		return err
+		return nil, err
+	}
+
+	status, msg := entities.StatusPending, fmt.Sprintf("sibling (or parent) job %s was mined instead", jobUUID)
+	if nextStatus == entities.StatusPending {
+		status, msg = entities.StatusNeverMined, fmt.Sprintf("sibling (or parent) job %s was removed from the canonical chain", jobUUID)
 	}
 
 	jobsToUpdate, err := uc.db.Job().Search(ctx, &entities.JobFilters{
 		ParentJobUUID: parentJobUUID,
-		Status:        entities.StatusPending,
+		Status:        status,
 	}, userInfo.AllowedTenants, userInfo.Username)
 
 	if err != nil {
-		return errors.FromError(err).ExtendComponent(updateChildrenComponent)
+		return nil, errors.FromError(err).ExtendComponent(updateChildrenComponent)
 	}
 
+	var updatedJobs []*entities.Job
 	for _, jobModel := range jobsToUpdate {
 		// Skip mined job which trigger the update of sibling/children
 		if jobModel.UUID == jobUUID {
@@ -69,22 +79,25 @@ func (uc *updateChildrenUseCase) Execute(ctx context.Context, jobUUID, parentJob
 		jobLogModel := &models.Log{
 			JobID:   &jobModel.ID,
 			Status:  nextStatus,
-			Message: fmt.Sprintf("sibling (or parent) job %s was mined instead", jobUUID),
+			Message: msg,
 		}
 
 		jobModel.Status = nextStatus
 		if err := uc.db.Job().Update(ctx, jobModel); err != nil {
-			return errors.FromError(err).ExtendComponent(updateChildrenComponent)
+			return nil, errors.FromError(err).ExtendComponent(updateChildrenComponent)
 		}
 
 		if err := uc.db.Log().Insert(ctx, jobLogModel); err != nil {
-			return errors.FromError(err).ExtendComponent(updateChildrenComponent)
+			return nil, errors.FromError(err).ExtendComponent(updateChildrenComponent)
 		}
 
+		jobModel.Logs = append(jobModel.Logs, jobLogModel)
+		updatedJobs = append(updatedJobs, parsers.NewJobEntityFromModels(jobModel))
+
 		logger.WithField("job", jobModel.UUID).
 			WithField("status", nextStatus).Debug("updated children/sibling job successfully")
 	}
 
 	logger.WithField("status", nextStatus).Info("children (and/or parent) jobs updated successfully")
-	return nil
+	return updatedJobs, nil
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUpdateChildren_Execute(t *testing.T) {
@@ -58,9 +59,35 @@ func TestUpdateChildren_Execute(t *testing.T) {
 		}).
 			Return(nil)
 
-		err := usecase.Execute(ctx, jobUUID, parentJobUUID, status, userInfo)
+		updatedJobs, err := usecase.Execute(ctx, jobUUID, parentJobUUID, status, userInfo)
 
-		assert.NoError(t, err)
+		require.NoError(t, err)
+		require.Len(t, updatedJobs, 2)
+		assert.Equal(t, status, updatedJobs[0].Status)
+		assert.Equal(t, status, updatedJobs[0].Logs[len(updatedJobs[0].Logs)-1].Status)
+	})
+
+	t.Run("should revert NEVER_MINED jobs to PENDING", func(t *testing.T) {
+		parentJobUUID := "parentJobUUID"
+		jobUUID := "jobUUID"
+
+		jobsToUpdate := []*models.Job{testdata.FakeJobModel(1)}
+		jobsToUpdate[0].Status = entities.StatusNeverMined
+
+		mockJobDA.EXPECT().Search(gomock.Any(), &entities.JobFilters{ParentJobUUID: parentJobUUID, Status: entities.StatusNeverMined},
+			userInfo.AllowedTenants, userInfo.Username).Return(jobsToUpdate, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), jobsToUpdate[0]).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), &models.Log{
+			JobID:   &jobsToUpdate[0].ID,
+			Status:  entities.StatusPending,
+			Message: fmt.Sprintf("sibling (or parent) job %s was removed from the canonical chain", jobUUID),
+		}).Return(nil)
+
+		updatedJobs, err := usecase.Execute(ctx, jobUUID, parentJobUUID, entities.StatusPending, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, updatedJobs, 1)
+		assert.Equal(t, entities.StatusPending, updatedJobs[0].Status)
 	})
 
 	t.Run("should not update status of the jobUUID job", func(t *testing.T) {
@@ -81,9 +108,11 @@ func TestUpdateChildren_Execute(t *testing.T) {
 			Message: fmt.Sprintf("sibling (or parent) job %s was mined instead", jobUUID),
 		}).Return(nil)
 
-		err := usecase.Execute(ctx, jobUUID, parentJobUUID, status, userInfo)
+		updatedJobs, err := usecase.Execute(ctx, jobUUID, parentJobUUID, status, userInfo)
 
-		assert.NoError(t, err)
+		require.NoError(t, err)
+		require.Len(t, updatedJobs, 1)
+		assert.Equal(t, jobsToUpdate[1].UUID, updatedJobs[0].UUID)
 	})
 
 	t.Run("should fail with same error if Search fails", func(t *testing.T) {
@@ -91,7 +120,7 @@ func TestUpdateChildren_Execute(t *testing.T) {
 
 		mockJobDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return(nil, expectedErr)
 
-		err := usecase.Execute(ctx, "jobUUID", "parentJobUUID", status, userInfo)
+		_, err := usecase.Execute(ctx, "jobUUID", "parentJobUUID", status, userInfo)
 
 		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(updateChildrenComponent), err)
 	})
@@ -106,7 +135,7 @@ func TestUpdateChildren_Execute(t *testing.T) {
 		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(expectedErr)
 
-		err := usecase.Execute(ctx, "jobUUID", "parentJobUUID", status, userInfo)
+		_, err := usecase.Execute(ctx, "jobUUID", "parentJobUUID", status, userInfo)
 
 		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(updateChildrenComponent), err)
 	})
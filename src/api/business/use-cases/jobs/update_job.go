@@ -24,17 +24,24 @@ type updateJobUseCase struct {
 	db                    store.DB
 	updateChildrenUseCase usecases.UpdateChildrenUseCase
 	startNextJobUseCase   usecases.StartNextJobUseCase
+	startDependentJobsUC  usecases.StartDependentJobsUseCase
+	notifyWebhooksUseCase usecases.NotifyWebhooksUseCase
+	publishJobEventUC     usecases.PublishJobEventUseCase
 	metrics               metrics.TransactionSchedulerMetrics
 	logger                *log.Logger
 }
 
 // NewUpdateJobUseCase creates a new UpdateJobUseCase
 func NewUpdateJobUseCase(db store.DB, updateChildrenUseCase usecases.UpdateChildrenUseCase,
-	startJobUC usecases.StartNextJobUseCase, m metrics.TransactionSchedulerMetrics) usecases.UpdateJobUseCase {
+	startJobUC usecases.StartNextJobUseCase, startDependentJobsUC usecases.StartDependentJobsUseCase,
+	notifyWebhooksUC usecases.NotifyWebhooksUseCase, publishJobEventUC usecases.PublishJobEventUseCase, m metrics.TransactionSchedulerMetrics) usecases.UpdateJobUseCase {
 	return &updateJobUseCase{
 		db:                    db,
 		updateChildrenUseCase: updateChildrenUseCase,
 		startNextJobUseCase:   startJobUC,
+		startDependentJobsUC:  startDependentJobsUC,
+		notifyWebhooksUseCase: notifyWebhooksUC,
+		publishJobEventUC:     publishJobEventUC,
 		metrics:               m,
 		logger:                log.NewLogger().SetComponent(updateJobComponent),
 	}
@@ -52,12 +59,27 @@ func (uc *updateJobUseCase) Execute(ctx context.Context, job *entities.Job, next
 		return nil, err
 	}
 
-	if entities.IsFinalJobStatus(jobModel.Status) {
+	// Mined jobs can only be updated when their transaction is removed from the canonical chain
+	if entities.IsFinalJobStatus(jobModel.Status) && !isReorgUpdate(nextStatus, jobModel.Status) {
 		errMessage := fmt.Sprintf("job status %s is final, cannot be updated", jobModel.Status)
 		logger.WithField("status", jobModel.Status).Error(errMessage)
 		return nil, errors.InvalidParameterError(errMessage).ExtendComponent(updateJobComponent)
 	}
 
+	// Transactions can only be rewritten by clients before being started, so that an approved transaction cannot change
+	if job.Transaction != nil && !canUpdateTransaction(jobModel.Status, userInfo) {
+		errMessage := fmt.Sprintf("transaction of a job with status %s cannot be updated", jobModel.Status)
+		logger.WithField("status", jobModel.Status).Error(errMessage)
+		return nil, errors.InvalidStateError(errMessage).ExtendComponent(updateJobComponent)
+	}
+
+	// Decoded data are computed by the tx-listener from the mined transaction
+	if (job.DecodedInput != nil || job.Revert != nil) && !userInfo.IsInternal() {
+		errMessage := "decoded input and revert of a job cannot be updated"
+		logger.Error(errMessage)
+		return nil, errors.UnauthorizedError(errMessage).ExtendComponent(updateJobComponent)
+	}
+
 	// We are not forced to update the transaction
 	if job.Transaction != nil {
 		parsers.UpdateTransactionModelFromEntities(jobModel.Transaction, job.Transaction)
@@ -72,6 +94,12 @@ func (uc *updateJobUseCase) Execute(ctx context.Context, job *entities.Job, next
 	if job.InternalData != nil {
 		jobModel.InternalData = job.InternalData
 	}
+	if job.DecodedInput != nil {
+		jobModel.DecodedInput = job.DecodedInput
+	}
+	if job.Revert != nil {
+		jobModel.Revert = job.Revert
+	}
 
 	var jobLogModel *models.Log
 	// We are not forced to update the status
@@ -88,7 +116,7 @@ func (uc *updateJobUseCase) Execute(ctx context.Context, job *entities.Job, next
 	}
 
 	// In case of status update
-	err = uc.updateJob(ctx, jobModel, jobLogModel, userInfo)
+	updatedChildren, err := uc.updateJob(ctx, jobModel, jobLogModel, userInfo)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(updateJobComponent)
 	}
@@ -100,11 +128,41 @@ func (uc *updateJobUseCase) Execute(ctx context.Context, job *entities.Job, next
 		}
 	}
 
+	jobEntity := parsers.NewJobEntityFromModels(jobModel)
+	// Dependent jobs are resolved once the job is final, their failure must not fail the job update
+	if jobLogModel != nil && entities.IsFinalJobStatus(jobModel.Status) {
+		err = uc.startDependentJobsUC.Execute(ctx, jobEntity, userInfo)
+		if err != nil {
+			logger.WithError(err).Warn("failed to start dependent jobs")
+		}
+	}
+
+	// Webhook notifications must not fail the job update
+	if jobLogModel != nil {
+		uc.publishJobEventUC.Execute(ctx, newJobEvent(jobEntity, jobLogModel))
+		err = uc.notifyWebhooksUseCase.Execute(ctx, jobEntity, nextStatus, logMessage)
+		if err != nil {
+			logger.WithError(err).Warn("failed to notify webhooks")
+		}
+	}
+
+	// Sibling and parent jobs set as NEVER_MINED, or reverted by a reorganisation, are notified the same way
+	for _, childEntity := range updatedChildren {
+		childLog := childEntity.Logs[len(childEntity.Logs)-1]
+		uc.publishJobEventUC.Execute(ctx, newJobEvent(childEntity, parsers.NewLogModelFromEntity(childLog)))
+		err = uc.notifyWebhooksUseCase.Execute(ctx, childEntity, childLog.Status, childLog.Message)
+		if err != nil {
+			logger.WithField("child_job", childEntity.UUID).WithError(err).Warn("failed to notify webhooks")
+		}
+	}
+
 	logger.Info("job updated successfully")
-	return parsers.NewJobEntityFromModels(jobModel), nil
+	return jobEntity, nil
 }
 
-func (uc *updateJobUseCase) updateJob(ctx context.Context, jobModel *models.Job, jobLogModel *models.Log, userInfo *multitenancy.UserInfo) error {
+// updateJob stores the job and its status change, returning the sibling and parent jobs updated as a consequence
+func (uc *updateJobUseCase) updateJob(ctx context.Context, jobModel *models.Job, jobLogModel *models.Log,
+	userInfo *multitenancy.UserInfo) ([]*entities.Job, error) {
 	logger := uc.logger.WithContext(ctx)
 
 	// Does current job belong to a parent/children chains?
@@ -115,6 +173,7 @@ func (uc *updateJobUseCase) updateJob(ctx context.Context, jobModel *models.Job,
 		parentJobUUID = jobModel.UUID
 	}
 
+	var updatedChildren []*entities.Job
 	prevLogModel := jobModel.Logs[len(jobModel.Logs)-1]
 	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
 		// We should lock ONLY when there is children jobs
@@ -147,6 +206,11 @@ func (uc *updateJobUseCase) updateJob(ctx context.Context, jobModel *models.Job,
 			if updateNextJobStatus(prevLogModel.Status, jobLogModel.Status) {
 				jobModel.Status = jobLogModel.Status
 			}
+
+			// Reorganised jobs are pending again until their transaction is mined in the canonical chain
+			if jobLogModel.Status == entities.StatusReorged {
+				jobModel.Status = entities.StatusPending
+			}
 		}
 
 		if err := tx.(store.Tx).Job().Update(ctx, jobModel); err != nil {
@@ -155,19 +219,28 @@ func (uc *updateJobUseCase) updateJob(ctx context.Context, jobModel *models.Job,
 
 		// if we updated to MINED, we need to update the children and sibling jobs to NEVER_MINED
 		if parentJobUUID != "" && jobLogModel != nil && jobLogModel.Status == entities.StatusMined {
-			der := uc.updateChildrenUseCase.
+			children, der := uc.updateChildrenUseCase.
 				WithDBTransaction(tx.(store.Tx)).
 				Execute(ctx, jobModel.UUID, parentJobUUID, entities.StatusNeverMined, userInfo)
 			if der != nil {
 				return der
 			}
+			updatedChildren = children
+		}
+
+		if jobLogModel != nil && jobLogModel.Status == entities.StatusReorged {
+			reorgedJobs, der := uc.rollbackReorgedJob(ctx, tx.(store.Tx), jobModel, parentJobUUID, userInfo)
+			if der != nil {
+				return der
+			}
+			updatedChildren = reorgedJobs
 		}
 
 		return nil
 	})
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Metrics observe request latency over job status changes
@@ -175,7 +248,69 @@ func (uc *updateJobUseCase) updateJob(ctx context.Context, jobModel *models.Job,
 		uc.addMetrics(jobModel.UpdatedAt.Sub(prevLogModel.CreatedAt), prevLogModel.Status, jobLogModel.Status, jobModel.ChainUUID)
 	}
 
-	return nil
+	return updatedChildren, nil
+}
+
+// rollbackReorgedJob reverts the sibling and parent jobs set as NEVER_MINED by the job removed from the canonical chain.
+// Dependent jobs resolved from the job are flagged with a WARNING, as their transactions cannot be reverted
+func (uc *updateJobUseCase) rollbackReorgedJob(ctx context.Context, tx store.Tx, jobModel *models.Job, parentJobUUID string,
+	userInfo *multitenancy.UserInfo) ([]*entities.Job, error) {
+	var updatedJobs []*entities.Job
+	if parentJobUUID != "" {
+		children, err := uc.updateChildrenUseCase.
+			WithDBTransaction(tx).
+			Execute(ctx, jobModel.UUID, parentJobUUID, entities.StatusPending, userInfo)
+		if err != nil {
+			return nil, err
+		}
+		updatedJobs = children
+	}
+
+	if jobModel.Schedule == nil {
+		return updatedJobs, nil
+	}
+
+	schedule, err := tx.Schedule().FindOneByUUID(ctx, jobModel.Schedule.UUID, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dependentJob := range schedule.Jobs {
+		if dependentJob.Status == entities.StatusCreated || !dependsOnJob(dependentJob, jobModel.UUID) {
+			continue
+		}
+
+		jobLogModel := &models.Log{
+			JobID:   &dependentJob.ID,
+			Status:  entities.StatusWarning,
+			Message: fmt.Sprintf("dependency job %s was removed from the canonical chain", jobModel.UUID),
+		}
+		if err = tx.Log().Insert(ctx, jobLogModel); err != nil {
+			return nil, err
+		}
+
+		dependentJob.Logs = append(dependentJob.Logs, jobLogModel)
+		updatedJobs = append(updatedJobs, parsers.NewJobEntityFromModels(dependentJob))
+	}
+
+	return updatedJobs, nil
+}
+
+// newJobEvent returns the event of the job status change recorded by the log, the event is identified by the log UUID
+func newJobEvent(job *entities.Job, jobLogModel *models.Log) *entities.JobEvent {
+	return &entities.JobEvent{
+		UUID:          jobLogModel.UUID,
+		JobUUID:       job.UUID,
+		ParentJobUUID: job.InternalData.ParentJobUUID,
+		ScheduleUUID:  job.ScheduleUUID,
+		ChainUUID:     job.ChainUUID,
+		TenantID:      job.TenantID,
+		OwnerID:       job.OwnerID,
+		Labels:        job.Labels,
+		Status:        jobLogModel.Status,
+		Message:       jobLogModel.Message,
+		CreatedAt:     jobLogModel.CreatedAt,
+	}
 }
 
 func updateNextJobStatus(prevStatus, nextStatus entities.JobStatus) bool {
@@ -196,8 +331,10 @@ func canUpdateStatus(nextStatus, status entities.JobStatus) bool {
 	switch nextStatus {
 	case entities.StatusCreated:
 		return false
-	case entities.StatusStarted:
+	case entities.StatusAwaitingApproval:
 		return status == entities.StatusCreated
+	case entities.StatusStarted:
+		return status == entities.StatusCreated || status == entities.StatusAwaitingApproval
 	case entities.StatusPending:
 		return status == entities.StatusStarted || status == entities.StatusRecovering
 	case entities.StatusResending:
@@ -208,13 +345,24 @@ func canUpdateStatus(nextStatus, status entities.JobStatus) bool {
 		return status == entities.StatusPending
 	case entities.StatusStored:
 		return status == entities.StatusStarted || status == entities.StatusRecovering
+	case entities.StatusReorged:
+		return status == entities.StatusMined
+	case entities.StatusSkipped:
+		return status == entities.StatusCreated
+	case entities.StatusExpired:
+		return status == entities.StatusCreated || status == entities.StatusAwaitingApproval
 	case entities.StatusFailed:
-		return status == entities.StatusStarted || status == entities.StatusRecovering || status == entities.StatusPending || status == entities.StatusWarning || status == entities.StatusResending
+		return status == entities.StatusStarted || status == entities.StatusRecovering || status == entities.StatusPending || status == entities.StatusWarning || status == entities.StatusResending ||
+			status == entities.StatusAwaitingApproval
 	default: // For warning, they can be added at any time
 		return true
 	}
 }
 
+func isReorgUpdate(nextStatus, status entities.JobStatus) bool {
+	return nextStatus == entities.StatusReorged && status == entities.StatusMined
+}
+
 func (uc *updateJobUseCase) addMetrics(elapseTime time.Duration, previousStatus, nextStatus entities.JobStatus, chainUUID string) {
 	if previousStatus == nextStatus {
 		return
@@ -238,3 +386,16 @@ func (uc *updateJobUseCase) addMetrics(elapseTime time.Duration, previousStatus,
 	}
 
 }
+
+// canUpdateTransaction indicates whether the user can update the transaction of a job at the given status, Orchestrate
+// services fill the transaction of started jobs
+func canUpdateTransaction(status entities.JobStatus, userInfo *multitenancy.UserInfo) bool {
+	switch {
+	case status == entities.StatusCreated:
+		return true
+	case status == entities.StatusAwaitingApproval:
+		return false
+	default:
+		return userInfo.IsInternal()
+	}
+}
@@ -4,6 +4,7 @@ package jobs
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
@@ -32,8 +33,12 @@ func TestUpdateJob_Execute(t *testing.T) {
 	mockTransactionDA := mocks.NewMockTransactionAgent(ctrl)
 	mockJobDA := mocks.NewMockJobAgent(ctrl)
 	mockLogDA := mocks.NewMockLogAgent(ctrl)
+	mockScheduleDA := mocks.NewMockScheduleAgent(ctrl)
 	mockUpdateChilrenUC := mocks2.NewMockUpdateChildrenUseCase(ctrl)
 	mockStartNextJobUC := mocks2.NewMockStartNextJobUseCase(ctrl)
+	mockStartDependentJobsUC := mocks2.NewMockStartDependentJobsUseCase(ctrl)
+	mockNotifyWebhooksUC := mocks2.NewMockNotifyWebhooksUseCase(ctrl)
+	mockPublishJobEventUC := mocks2.NewMockPublishJobEventUseCase(ctrl)
 	mockMetrics := mock.NewMockTransactionSchedulerMetrics(ctrl)
 
 	jobsLatencyHistogram := mock2.NewMockHistogram(ctrl)
@@ -46,19 +51,44 @@ func TestUpdateJob_Execute(t *testing.T) {
 	minedLatencyHistogram.EXPECT().Observe(gomock.Any()).AnyTimes()
 	mockMetrics.EXPECT().MinedLatencyHistogram().AnyTimes().Return(minedLatencyHistogram)
 
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
 	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
 	mockDB.EXPECT().Transaction().Return(mockTransactionDA).AnyTimes()
 	mockDBTX.EXPECT().Job().Return(mockJobDA).AnyTimes()
 	mockDBTX.EXPECT().Log().Return(mockLogDA).AnyTimes()
 	mockDBTX.EXPECT().Transaction().Return(mockTransactionDA).AnyTimes()
+	mockDBTX.EXPECT().Schedule().Return(mockScheduleDA).AnyTimes()
 	mockDBTX.EXPECT().Commit().Return(nil).AnyTimes()
 	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
 	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
 	mockUpdateChilrenUC.EXPECT().WithDBTransaction(mockDBTX).Return(mockUpdateChilrenUC).AnyTimes()
 
-	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewUpdateJobUseCase(mockDB, mockUpdateChilrenUC, mockStartNextJobUC, mockMetrics)
+	var notifiedStatus entities.JobStatus
+	var notifiedJobs []string
+	var notifyErr error
+	mockNotifyWebhooksUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, job *entities.Job, status entities.JobStatus, message string) error {
+			notifiedStatus = status
+			notifiedJobs = append(notifiedJobs, job.UUID)
+			return notifyErr
+		}).AnyTimes()
+
+	var dependentJobsStarted bool
+	mockStartDependentJobsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).
+		DoAndReturn(func(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error {
+			dependentJobsStarted = true
+			return errors.PostgresConnectionError("error")
+		}).AnyTimes()
+
+	var publishedEvent *entities.JobEvent
+	mockPublishJobEventUC.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		Do(func(ctx context.Context, event *entities.JobEvent) {
+			publishedEvent = event
+		}).AnyTimes()
+
+	usecase := NewUpdateJobUseCase(mockDB, mockUpdateChilrenUC, mockStartNextJobUC, mockStartDependentJobsUC,
+		mockNotifyWebhooksUC, mockPublishJobEventUC, mockMetrics)
 
 	nextStatus := entities.StatusStarted
 	logMessage := "message"
@@ -89,6 +119,104 @@ func TestUpdateJob_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should fail with InvalidStateError if a client updates the transaction of a job awaiting approval", func(t *testing.T) {
+		jobEntity := testdata.FakeJob()
+		jobModel := modelstestdata.FakeJobModel(0)
+		jobModel.Status = entities.StatusAwaitingApproval
+		makerInfo := multitenancy.NewJWTUserInfo(&entities.UserClaims{TenantID: "tenantOne", Username: "username"}, "token")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, makerInfo.AllowedTenants, makerInfo.Username, true).
+			Return(jobModel, nil)
+
+		_, err := usecase.Execute(ctx, jobEntity, "", "", makerInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail with UnauthorizedError if a client updates the decoded input of the job", func(t *testing.T) {
+		jobEntity := testdata.FakeJob()
+		jobEntity.Transaction = nil
+		jobEntity.DecodedInput = &entities.DecodedCall{Signature: "transfer(address,uint256)"}
+		jobModel := modelstestdata.FakeJobModel(0)
+		makerInfo := multitenancy.NewJWTUserInfo(&entities.UserClaims{TenantID: "tenantOne", Username: "username"}, "token")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, makerInfo.AllowedTenants, makerInfo.Username, true).
+			Return(jobModel, nil)
+
+		_, err := usecase.Execute(ctx, jobEntity, "", "", makerInfo)
+
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should update decoded input and revert of the job successfully", func(t *testing.T) {
+		jobEntity := testdata.FakeJob()
+		jobEntity.Transaction = nil
+		jobEntity.DecodedInput = &entities.DecodedCall{
+			Signature: "transfer(address,uint256)",
+			Args:      map[string]string{"to": "0xfF778b716FC07D98839f48DdB88D8bE583BEB684", "amount": "1"},
+		}
+		jobEntity.Revert = &entities.TxRevert{Reason: "Not enough Ether provided."}
+		jobModel := modelstestdata.FakeJobModel(0)
+		jobModel.Schedule.TenantID = userInfo.TenantID
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, true).
+			Return(jobModel, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, jobModelUpdate *models.Job) error {
+			assert.Equal(t, jobEntity.DecodedInput, jobModelUpdate.DecodedInput)
+			assert.Equal(t, jobEntity.Revert, jobModelUpdate.Revert)
+			return nil
+		})
+
+		job, err := usecase.Execute(ctx, jobEntity, "", "", userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, jobEntity.DecodedInput, job.DecodedInput)
+		assert.Equal(t, jobEntity.Revert, job.Revert)
+	})
+
+	t.Run("should notify webhooks and not fail if notification fails", func(t *testing.T) {
+		jobEntity := testdata.FakeJob()
+		jobModel := modelstestdata.FakeJobModel(0)
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		notifiedStatus = ""
+		notifyErr = errors.PostgresConnectionError("error")
+		defer func() { notifyErr = nil }()
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, true).
+			Return(jobModel, nil)
+		mockTransactionDA.EXPECT().Update(gomock.Any(), jobModel.Transaction).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := usecase.Execute(ctx, jobEntity, nextStatus, logMessage, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, nextStatus, notifiedStatus)
+	})
+
+	t.Run("should publish job event on status update", func(t *testing.T) {
+		jobEntity := testdata.FakeJob()
+		jobModel := modelstestdata.FakeJobModel(0)
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		publishedEvent = nil
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, true).
+			Return(jobModel, nil)
+		mockTransactionDA.EXPECT().Update(gomock.Any(), jobModel.Transaction).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := usecase.Execute(ctx, jobEntity, nextStatus, logMessage, userInfo)
+
+		assert.NoError(t, err)
+		if assert.NotNil(t, publishedEvent) {
+			assert.Equal(t, jobModel.UUID, publishedEvent.JobUUID)
+			assert.Equal(t, jobModel.Schedule.TenantID, publishedEvent.TenantID)
+			assert.Equal(t, nextStatus, publishedEvent.Status)
+			assert.Equal(t, logMessage, publishedEvent.Message)
+		}
+	})
+
 	t.Run("should execute use case successfully if transaction is empty", func(t *testing.T) {
 		jobEntity := testdata.FakeJob()
 		jobEntity.Transaction = nil
@@ -192,6 +320,44 @@ func TestUpdateJob_Execute(t *testing.T) {
 		_, err := usecase.Execute(ctx, jobEntity, status, logMessage, userInfo)
 		assert.NoError(t, err)
 	})
+
+	t.Run("should start dependent jobs and not fail if it fails when status is final", func(t *testing.T) {
+		jobEntity := testdata.FakeJob()
+		jobEntity.Transaction = nil
+		jobModel := modelstestdata.FakeJobModel(0)
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		jobModel.Status = entities.StatusPending
+		jobModel.Logs = append(jobModel.Logs, &models.Log{Status: entities.StatusPending})
+		dependentJobsStarted = false
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, true).
+			Return(jobModel, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := usecase.Execute(ctx, jobEntity, entities.StatusFailed, logMessage, userInfo)
+
+		assert.NoError(t, err)
+		assert.True(t, dependentJobsStarted)
+	})
+
+	t.Run("should not start dependent jobs when status is not final", func(t *testing.T) {
+		jobEntity := testdata.FakeJob()
+		jobEntity.Transaction = nil
+		jobModel := modelstestdata.FakeJobModel(0)
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		dependentJobsStarted = false
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, true).
+			Return(jobModel, nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		_, err := usecase.Execute(ctx, jobEntity, entities.StatusStarted, logMessage, userInfo)
+
+		assert.NoError(t, err)
+		assert.False(t, dependentJobsStarted)
+	})
 	
 	t.Run("should execute use case successfully if status is MINED and update all the children jobs", func(t *testing.T) {
 		jobParentEntity := testdata.FakeJob()
@@ -224,12 +390,133 @@ func TestUpdateJob_Execute(t *testing.T) {
 			Status:  nextStatus,
 			Message: logMessage,
 		}).Return(nil)
+		parentNeverMined := testdata.FakeJob()
+		parentNeverMined.UUID = jobParentEntity.UUID
+		parentNeverMined.Status = entities.StatusNeverMined
+		parentNeverMined.Logs = append(parentNeverMined.Logs, &entities.Log{UUID: "logUUID", Status: entities.StatusNeverMined, Message: "message"})
 		mockUpdateChilrenUC.EXPECT().
 			Execute(gomock.Any(), jobModel.UUID, jobParentEntity.UUID, entities.StatusNeverMined, userInfo).
-			Return(nil)
+			Return([]*entities.Job{parentNeverMined}, nil)
+		notifiedJobs = nil
 
 		_, err := usecase.Execute(ctx, jobEntity, nextStatus, logMessage, userInfo)
 		assert.NoError(t, err)
+		assert.Equal(t, []string{jobEntity.UUID, jobParentEntity.UUID}, notifiedJobs)
+		assert.Equal(t, entities.StatusNeverMined, notifiedStatus)
+		assert.Equal(t, "logUUID", publishedEvent.UUID)
+		assert.Equal(t, jobParentEntity.UUID, publishedEvent.JobUUID)
+		assert.Equal(t, entities.StatusNeverMined, publishedEvent.Status)
+	})
+
+	t.Run("should execute use case successfully if status is REORGED and set job back to PENDING", func(t *testing.T) {
+		jobEntity := testdata.FakeJob()
+		jobEntity.Transaction = nil
+		status := entities.StatusReorged
+		jobModel := modelstestdata.FakeJobModel(0)
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		jobModel.Status = entities.StatusMined
+		jobModel.Logs = append(jobModel.Logs, &models.Log{Status: entities.StatusMined})
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, true).
+			Return(jobModel, nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), &models.Log{
+			JobID:   &jobModel.ID,
+			Status:  status,
+			Message: logMessage,
+		}).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, jobModelUpdate *models.Job) error {
+			assert.Equal(t, entities.StatusPending, jobModelUpdate.Status)
+			return nil
+		})
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.Schedule.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(jobModel.Schedule, nil)
+
+		job, err := usecase.Execute(ctx, jobEntity, status, logMessage, userInfo)
+		assert.NoError(t, err)
+		assert.Equal(t, entities.StatusPending, job.Status)
+	})
+
+	t.Run("should revert NEVER_MINED siblings and flag started dependent jobs if status is REORGED", func(t *testing.T) {
+		jobParentEntity := testdata.FakeJob()
+		jobEntity := testdata.FakeJob()
+		jobEntity.Transaction = nil
+		status := entities.StatusReorged
+		jobModel := modelstestdata.FakeJobModel(0)
+		jobModel.UUID = jobEntity.UUID
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		jobModel.Status = entities.StatusMined
+		jobModel.InternalData.ParentJobUUID = jobParentEntity.UUID
+		jobModel.Logs = append(jobModel.Logs, &models.Log{Status: entities.StatusMined})
+		jobEntity.InternalData = jobModel.InternalData
+
+		startedJob := modelstestdata.FakeJobModel(0)
+		startedJob.ID = 2
+		startedJob.Status = entities.StatusPending
+		startedJob.DependsOn = []*entities.JobDependency{{JobUUID: jobModel.UUID, Status: entities.StatusMined}}
+		createdJob := modelstestdata.FakeJobModel(0)
+		createdJob.DependsOn = []*entities.JobDependency{{JobUUID: jobModel.UUID, Status: entities.StatusMined}}
+		schedule := modelstestdata.FakeSchedule(userInfo.TenantID, userInfo.Username)
+		schedule.Jobs = []*models.Job{jobModel, startedJob, createdJob}
+
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), jobParentEntity.UUID).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, true).
+			Return(jobModel, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(jobModel, nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), &models.Log{
+			JobID:   &jobModel.ID,
+			Status:  status,
+			Message: logMessage,
+		}).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		parentPending := testdata.FakeJob()
+		parentPending.UUID = jobParentEntity.UUID
+		parentPending.Logs = append(parentPending.Logs, &entities.Log{Status: entities.StatusPending, Message: "message"})
+		mockUpdateChilrenUC.EXPECT().
+			Execute(gomock.Any(), jobModel.UUID, jobParentEntity.UUID, entities.StatusPending, userInfo).
+			Return([]*entities.Job{parentPending}, nil)
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), jobModel.Schedule.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(schedule, nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), &models.Log{
+			JobID:   &startedJob.ID,
+			Status:  entities.StatusWarning,
+			Message: fmt.Sprintf("dependency job %s was removed from the canonical chain", jobModel.UUID),
+		}).Return(nil)
+		notifiedJobs = nil
+
+		_, err := usecase.Execute(ctx, jobEntity, status, logMessage, userInfo)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{jobEntity.UUID, jobParentEntity.UUID, startedJob.UUID}, notifiedJobs)
+		assert.Equal(t, entities.StatusWarning, notifiedStatus)
+		assert.Equal(t, entities.StatusPending, startedJob.Status)
+	})
+
+	t.Run("should fail with the same error if reverting the siblings of a REORGED job fails", func(t *testing.T) {
+		jobParentEntity := testdata.FakeJob()
+		jobEntity := testdata.FakeJob()
+		jobEntity.Transaction = nil
+		jobModel := modelstestdata.FakeJobModel(0)
+		jobModel.UUID = jobEntity.UUID
+		jobModel.Schedule.TenantID = userInfo.TenantID
+		jobModel.Status = entities.StatusMined
+		jobModel.InternalData.ParentJobUUID = jobParentEntity.UUID
+		jobModel.Logs = append(jobModel.Logs, &models.Log{Status: entities.StatusMined})
+		jobEntity.InternalData = jobModel.InternalData
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockJobDA.EXPECT().LockOneByUUID(gomock.Any(), jobParentEntity.UUID).Return(nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, true).
+			Return(jobModel, nil)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(jobModel, nil)
+		mockLogDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		mockJobDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+		mockUpdateChilrenUC.EXPECT().
+			Execute(gomock.Any(), jobModel.UUID, jobParentEntity.UUID, entities.StatusPending, userInfo).
+			Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, jobEntity, entities.StatusReorged, logMessage, userInfo)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(updateJobComponent), err)
 	})
 
 	t.Run("should fail with InvalidParameterError if status is MINED", func(t *testing.T) {
@@ -94,6 +94,34 @@ func (mr *MockAccountUseCasesMockRecorder) SearchAccounts() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccounts", reflect.TypeOf((*MockAccountUseCases)(nil).SearchAccounts))
 }
 
+// DisableAccount mocks base method
+func (m *MockAccountUseCases) DisableAccount() usecases.DisableAccountUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableAccount")
+	ret0, _ := ret[0].(usecases.DisableAccountUseCase)
+	return ret0
+}
+
+// DisableAccount indicates an expected call of DisableAccount
+func (mr *MockAccountUseCasesMockRecorder) DisableAccount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAccount", reflect.TypeOf((*MockAccountUseCases)(nil).DisableAccount))
+}
+
+// RotateAccount mocks base method
+func (m *MockAccountUseCases) RotateAccount() usecases.RotateAccountUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateAccount")
+	ret0, _ := ret[0].(usecases.RotateAccountUseCase)
+	return ret0
+}
+
+// RotateAccount indicates an expected call of RotateAccount
+func (mr *MockAccountUseCasesMockRecorder) RotateAccount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAccount", reflect.TypeOf((*MockAccountUseCases)(nil).RotateAccount))
+}
+
 // MockGetAccountUseCase is a mock of GetAccountUseCase interface
 type MockGetAccountUseCase struct {
 	ctrl     *gomock.Controller
@@ -246,6 +274,82 @@ func (mr *MockUpdateAccountUseCaseMockRecorder) Execute(ctx, identity, userInfo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateAccountUseCase)(nil).Execute), ctx, identity, userInfo)
 }
 
+// MockDisableAccountUseCase is a mock of DisableAccountUseCase interface
+type MockDisableAccountUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDisableAccountUseCaseMockRecorder
+}
+
+// MockDisableAccountUseCaseMockRecorder is the mock recorder for MockDisableAccountUseCase
+type MockDisableAccountUseCaseMockRecorder struct {
+	mock *MockDisableAccountUseCase
+}
+
+// NewMockDisableAccountUseCase creates a new mock instance
+func NewMockDisableAccountUseCase(ctrl *gomock.Controller) *MockDisableAccountUseCase {
+	mock := &MockDisableAccountUseCase{ctrl: ctrl}
+	mock.recorder = &MockDisableAccountUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDisableAccountUseCase) EXPECT() *MockDisableAccountUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockDisableAccountUseCase) Execute(ctx context.Context, address common.Address, userInfo *multitenancy.UserInfo) (*entities.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, address, userInfo)
+	ret0, _ := ret[0].(*entities.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockDisableAccountUseCaseMockRecorder) Execute(ctx, address, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDisableAccountUseCase)(nil).Execute), ctx, address, userInfo)
+}
+
+// MockRotateAccountUseCase is a mock of RotateAccountUseCase interface
+type MockRotateAccountUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRotateAccountUseCaseMockRecorder
+}
+
+// MockRotateAccountUseCaseMockRecorder is the mock recorder for MockRotateAccountUseCase
+type MockRotateAccountUseCaseMockRecorder struct {
+	mock *MockRotateAccountUseCase
+}
+
+// NewMockRotateAccountUseCase creates a new mock instance
+func NewMockRotateAccountUseCase(ctrl *gomock.Controller) *MockRotateAccountUseCase {
+	mock := &MockRotateAccountUseCase{ctrl: ctrl}
+	mock.recorder = &MockRotateAccountUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRotateAccountUseCase) EXPECT() *MockRotateAccountUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockRotateAccountUseCase) Execute(ctx context.Context, address common.Address, successor *entities.Account, chainName string, userInfo *multitenancy.UserInfo) (*entities.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, address, successor, chainName, userInfo)
+	ret0, _ := ret[0].(*entities.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockRotateAccountUseCaseMockRecorder) Execute(ctx, address, successor, chainName, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRotateAccountUseCase)(nil).Execute), ctx, address, successor, chainName, userInfo)
+}
+
 // MockFundAccountUseCase is a mock of FundAccountUseCase interface
 type MockFundAccountUseCase struct {
 	ctrl     *gomock.Controller
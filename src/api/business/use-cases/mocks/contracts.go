@@ -12,6 +12,7 @@ import (
 	hexutil "github.com/ethereum/go-ethereum/common/hexutil"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 )
 
 // MockContractUseCases is a mock of ContractUseCases interface
@@ -135,6 +136,48 @@ func (mr *MockContractUseCasesMockRecorder) SearchContract() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchContract", reflect.TypeOf((*MockContractUseCases)(nil).SearchContract))
 }
 
+// DeregisterContract mocks base method
+func (m *MockContractUseCases) DeregisterContract() usecases.DeregisterContractUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterContract")
+	ret0, _ := ret[0].(usecases.DeregisterContractUseCase)
+	return ret0
+}
+
+// DeregisterContract indicates an expected call of DeregisterContract
+func (mr *MockContractUseCasesMockRecorder) DeregisterContract() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterContract", reflect.TypeOf((*MockContractUseCases)(nil).DeregisterContract))
+}
+
+// DeleteContractRepository mocks base method
+func (m *MockContractUseCases) DeleteContractRepository() usecases.DeleteContractRepositoryUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContractRepository")
+	ret0, _ := ret[0].(usecases.DeleteContractRepositoryUseCase)
+	return ret0
+}
+
+// DeleteContractRepository indicates an expected call of DeleteContractRepository
+func (mr *MockContractUseCasesMockRecorder) DeleteContractRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContractRepository", reflect.TypeOf((*MockContractUseCases)(nil).DeleteContractRepository))
+}
+
+// SetContractTag mocks base method
+func (m *MockContractUseCases) SetContractTag() usecases.SetContractTagUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetContractTag")
+	ret0, _ := ret[0].(usecases.SetContractTagUseCase)
+	return ret0
+}
+
+// SetContractTag indicates an expected call of SetContractTag
+func (mr *MockContractUseCasesMockRecorder) SetContractTag() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContractTag", reflect.TypeOf((*MockContractUseCases)(nil).SetContractTag))
+}
+
 // MockGetContractsCatalogUseCase is a mock of GetContractsCatalogUseCase interface
 type MockGetContractsCatalogUseCase struct {
 	ctrl     *gomock.Controller
@@ -159,18 +202,18 @@ func (m *MockGetContractsCatalogUseCase) EXPECT() *MockGetContractsCatalogUseCas
 }
 
 // Execute mocks base method
-func (m *MockGetContractsCatalogUseCase) Execute(ctx context.Context) ([]string, error) {
+func (m *MockGetContractsCatalogUseCase) Execute(ctx context.Context, userInfo *multitenancy.UserInfo) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx)
+	ret := m.ctrl.Call(m, "Execute", ctx, userInfo)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockGetContractsCatalogUseCaseMockRecorder) Execute(ctx interface{}) *gomock.Call {
+func (mr *MockGetContractsCatalogUseCaseMockRecorder) Execute(ctx, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractsCatalogUseCase)(nil).Execute), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractsCatalogUseCase)(nil).Execute), ctx, userInfo)
 }
 
 // MockGetContractUseCase is a mock of GetContractUseCase interface
@@ -197,18 +240,18 @@ func (m *MockGetContractUseCase) EXPECT() *MockGetContractUseCaseMockRecorder {
 }
 
 // Execute mocks base method
-func (m *MockGetContractUseCase) Execute(ctx context.Context, name, tag string) (*entities.Contract, error) {
+func (m *MockGetContractUseCase) Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) (*entities.Contract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, name, tag)
+	ret := m.ctrl.Call(m, "Execute", ctx, name, tag, userInfo)
 	ret0, _ := ret[0].(*entities.Contract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockGetContractUseCaseMockRecorder) Execute(ctx, name, tag interface{}) *gomock.Call {
+func (mr *MockGetContractUseCaseMockRecorder) Execute(ctx, name, tag, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractUseCase)(nil).Execute), ctx, name, tag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractUseCase)(nil).Execute), ctx, name, tag, userInfo)
 }
 
 // MockSearchContractUseCase is a mock of SearchContractUseCase interface
@@ -235,18 +278,18 @@ func (m *MockSearchContractUseCase) EXPECT() *MockSearchContractUseCaseMockRecor
 }
 
 // Execute mocks base method
-func (m *MockSearchContractUseCase) Execute(ctx context.Context, codehash hexutil.Bytes, address *common.Address) (*entities.Contract, error) {
+func (m *MockSearchContractUseCase) Execute(ctx context.Context, codehash hexutil.Bytes, address *common.Address, userInfo *multitenancy.UserInfo) (*entities.Contract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, codehash, address)
+	ret := m.ctrl.Call(m, "Execute", ctx, codehash, address, userInfo)
 	ret0, _ := ret[0].(*entities.Contract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockSearchContractUseCaseMockRecorder) Execute(ctx, codehash, address interface{}) *gomock.Call {
+func (mr *MockSearchContractUseCaseMockRecorder) Execute(ctx, codehash, address, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchContractUseCase)(nil).Execute), ctx, codehash, address)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchContractUseCase)(nil).Execute), ctx, codehash, address, userInfo)
 }
 
 // MockGetContractEventsUseCase is a mock of GetContractEventsUseCase interface
@@ -273,9 +316,9 @@ func (m *MockGetContractEventsUseCase) EXPECT() *MockGetContractEventsUseCaseMoc
 }
 
 // Execute mocks base method
-func (m *MockGetContractEventsUseCase) Execute(ctx context.Context, chainID string, address common.Address, codeHash hexutil.Bytes, indexedInputCount uint32) (string, []string, error) {
+func (m *MockGetContractEventsUseCase) Execute(ctx context.Context, chainID string, address common.Address, codeHash hexutil.Bytes, indexedInputCount uint32, userInfo *multitenancy.UserInfo) (string, []string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, chainID, address, codeHash, indexedInputCount)
+	ret := m.ctrl.Call(m, "Execute", ctx, chainID, address, codeHash, indexedInputCount, userInfo)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].([]string)
 	ret2, _ := ret[2].(error)
@@ -283,9 +326,9 @@ func (m *MockGetContractEventsUseCase) Execute(ctx context.Context, chainID stri
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockGetContractEventsUseCaseMockRecorder) Execute(ctx, chainID, address, codeHash, indexedInputCount interface{}) *gomock.Call {
+func (mr *MockGetContractEventsUseCaseMockRecorder) Execute(ctx, chainID, address, codeHash, indexedInputCount, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractEventsUseCase)(nil).Execute), ctx, chainID, address, codeHash, indexedInputCount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractEventsUseCase)(nil).Execute), ctx, chainID, address, codeHash, indexedInputCount, userInfo)
 }
 
 // MockGetContractTagsUseCase is a mock of GetContractTagsUseCase interface
@@ -312,18 +355,18 @@ func (m *MockGetContractTagsUseCase) EXPECT() *MockGetContractTagsUseCaseMockRec
 }
 
 // Execute mocks base method
-func (m *MockGetContractTagsUseCase) Execute(ctx context.Context, name string) ([]string, error) {
+func (m *MockGetContractTagsUseCase) Execute(ctx context.Context, name string, userInfo *multitenancy.UserInfo) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, name)
+	ret := m.ctrl.Call(m, "Execute", ctx, name, userInfo)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockGetContractTagsUseCaseMockRecorder) Execute(ctx, name interface{}) *gomock.Call {
+func (mr *MockGetContractTagsUseCaseMockRecorder) Execute(ctx, name, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractTagsUseCase)(nil).Execute), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractTagsUseCase)(nil).Execute), ctx, name, userInfo)
 }
 
 // MockRegisterContractUseCase is a mock of RegisterContractUseCase interface
@@ -350,17 +393,128 @@ func (m *MockRegisterContractUseCase) EXPECT() *MockRegisterContractUseCaseMockR
 }
 
 // Execute mocks base method
-func (m *MockRegisterContractUseCase) Execute(ctx context.Context, contract *entities.Contract) error {
+func (m *MockRegisterContractUseCase) Execute(ctx context.Context, contract *entities.Contract, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, contract, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockRegisterContractUseCaseMockRecorder) Execute(ctx, contract, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRegisterContractUseCase)(nil).Execute), ctx, contract, userInfo)
+}
+
+// MockDeregisterContractUseCase is a mock of DeregisterContractUseCase interface
+type MockDeregisterContractUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeregisterContractUseCaseMockRecorder
+}
+
+// MockDeregisterContractUseCaseMockRecorder is the mock recorder for MockDeregisterContractUseCase
+type MockDeregisterContractUseCaseMockRecorder struct {
+	mock *MockDeregisterContractUseCase
+}
+
+// NewMockDeregisterContractUseCase creates a new mock instance
+func NewMockDeregisterContractUseCase(ctrl *gomock.Controller) *MockDeregisterContractUseCase {
+	mock := &MockDeregisterContractUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeregisterContractUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeregisterContractUseCase) EXPECT() *MockDeregisterContractUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockDeregisterContractUseCase) Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, name, tag, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockDeregisterContractUseCaseMockRecorder) Execute(ctx, name, tag, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeregisterContractUseCase)(nil).Execute), ctx, name, tag, userInfo)
+}
+
+// MockDeleteContractRepositoryUseCase is a mock of DeleteContractRepositoryUseCase interface
+type MockDeleteContractRepositoryUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteContractRepositoryUseCaseMockRecorder
+}
+
+// MockDeleteContractRepositoryUseCaseMockRecorder is the mock recorder for MockDeleteContractRepositoryUseCase
+type MockDeleteContractRepositoryUseCaseMockRecorder struct {
+	mock *MockDeleteContractRepositoryUseCase
+}
+
+// NewMockDeleteContractRepositoryUseCase creates a new mock instance
+func NewMockDeleteContractRepositoryUseCase(ctrl *gomock.Controller) *MockDeleteContractRepositoryUseCase {
+	mock := &MockDeleteContractRepositoryUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeleteContractRepositoryUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeleteContractRepositoryUseCase) EXPECT() *MockDeleteContractRepositoryUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockDeleteContractRepositoryUseCase) Execute(ctx context.Context, name string, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, name, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockDeleteContractRepositoryUseCaseMockRecorder) Execute(ctx, name, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteContractRepositoryUseCase)(nil).Execute), ctx, name, userInfo)
+}
+
+// MockSetContractTagUseCase is a mock of SetContractTagUseCase interface
+type MockSetContractTagUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetContractTagUseCaseMockRecorder
+}
+
+// MockSetContractTagUseCaseMockRecorder is the mock recorder for MockSetContractTagUseCase
+type MockSetContractTagUseCaseMockRecorder struct {
+	mock *MockSetContractTagUseCase
+}
+
+// NewMockSetContractTagUseCase creates a new mock instance
+func NewMockSetContractTagUseCase(ctrl *gomock.Controller) *MockSetContractTagUseCase {
+	mock := &MockSetContractTagUseCase{ctrl: ctrl}
+	mock.recorder = &MockSetContractTagUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSetContractTagUseCase) EXPECT() *MockSetContractTagUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSetContractTagUseCase) Execute(ctx context.Context, name, tag, sourceTag string, userInfo *multitenancy.UserInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, contract)
+	ret := m.ctrl.Call(m, "Execute", ctx, name, tag, sourceTag, userInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockRegisterContractUseCaseMockRecorder) Execute(ctx, contract interface{}) *gomock.Call {
+func (mr *MockSetContractTagUseCaseMockRecorder) Execute(ctx, name, tag, sourceTag, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRegisterContractUseCase)(nil).Execute), ctx, contract)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSetContractTagUseCase)(nil).Execute), ctx, name, tag, sourceTag, userInfo)
 }
 
 // MockSetContractCodeHashUseCase is a mock of SetContractCodeHashUseCase interface
@@ -387,15 +541,15 @@ func (m *MockSetContractCodeHashUseCase) EXPECT() *MockSetContractCodeHashUseCas
 }
 
 // Execute mocks base method
-func (m *MockSetContractCodeHashUseCase) Execute(ctx context.Context, chainID string, address common.Address, codeHash hexutil.Bytes) error {
+func (m *MockSetContractCodeHashUseCase) Execute(ctx context.Context, chainID string, address common.Address, codeHash hexutil.Bytes, userInfo *multitenancy.UserInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, chainID, address, codeHash)
+	ret := m.ctrl.Call(m, "Execute", ctx, chainID, address, codeHash, userInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockSetContractCodeHashUseCaseMockRecorder) Execute(ctx, chainID, address, codeHash interface{}) *gomock.Call {
+func (mr *MockSetContractCodeHashUseCaseMockRecorder) Execute(ctx, chainID, address, codeHash, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSetContractCodeHashUseCase)(nil).Execute), ctx, chainID, address, codeHash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSetContractCodeHashUseCase)(nil).Execute), ctx, chainID, address, codeHash, userInfo)
 }
@@ -320,16 +320,16 @@ func (m *MockGetFaucetCandidateUseCase) EXPECT() *MockGetFaucetCandidateUseCaseM
 }
 
 // Execute mocks base method
-func (m *MockGetFaucetCandidateUseCase) Execute(ctx context.Context, account common.Address, chain *entities.Chain, userInfo *multitenancy.UserInfo) (*entities.Faucet, error) {
+func (m *MockGetFaucetCandidateUseCase) Execute(ctx context.Context, account common.Address, chain *entities.Chain, tokenAddress *common.Address, fundingJobUUID string, userInfo *multitenancy.UserInfo) (*entities.Faucet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, account, chain, userInfo)
+	ret := m.ctrl.Call(m, "Execute", ctx, account, chain, tokenAddress, fundingJobUUID, userInfo)
 	ret0, _ := ret[0].(*entities.Faucet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockGetFaucetCandidateUseCaseMockRecorder) Execute(ctx, account, chain, userInfo interface{}) *gomock.Call {
+func (mr *MockGetFaucetCandidateUseCaseMockRecorder) Execute(ctx, account, chain, tokenAddress, fundingJobUUID, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetFaucetCandidateUseCase)(nil).Execute), ctx, account, chain, userInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetFaucetCandidateUseCase)(nil).Execute), ctx, account, chain, tokenAddress, fundingJobUUID, userInfo)
 }
@@ -136,6 +136,104 @@ func (mr *MockJobUseCasesMockRecorder) SearchJobs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJobs", reflect.TypeOf((*MockJobUseCases)(nil).SearchJobs))
 }
 
+// SubscribeJobEvents mocks base method
+func (m *MockJobUseCases) SubscribeJobEvents() usecases.SubscribeJobEventsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeJobEvents")
+	ret0, _ := ret[0].(usecases.SubscribeJobEventsUseCase)
+	return ret0
+}
+
+// SubscribeJobEvents indicates an expected call of SubscribeJobEvents
+func (mr *MockJobUseCasesMockRecorder) SubscribeJobEvents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeJobEvents", reflect.TypeOf((*MockJobUseCases)(nil).SubscribeJobEvents))
+}
+
+// ApproveJob mocks base method
+func (m *MockJobUseCases) ApproveJob() usecases.ApproveJobUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveJob")
+	ret0, _ := ret[0].(usecases.ApproveJobUseCase)
+	return ret0
+}
+
+// ApproveJob indicates an expected call of ApproveJob
+func (mr *MockJobUseCasesMockRecorder) ApproveJob() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveJob", reflect.TypeOf((*MockJobUseCases)(nil).ApproveJob))
+}
+
+// RejectJob mocks base method
+func (m *MockJobUseCases) RejectJob() usecases.RejectJobUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectJob")
+	ret0, _ := ret[0].(usecases.RejectJobUseCase)
+	return ret0
+}
+
+// RejectJob indicates an expected call of RejectJob
+func (mr *MockJobUseCasesMockRecorder) RejectJob() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectJob", reflect.TypeOf((*MockJobUseCases)(nil).RejectJob))
+}
+
+// SearchJobApprovals mocks base method
+func (m *MockJobUseCases) SearchJobApprovals() usecases.SearchJobApprovalsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchJobApprovals")
+	ret0, _ := ret[0].(usecases.SearchJobApprovalsUseCase)
+	return ret0
+}
+
+// SearchJobApprovals indicates an expected call of SearchJobApprovals
+func (mr *MockJobUseCasesMockRecorder) SearchJobApprovals() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJobApprovals", reflect.TypeOf((*MockJobUseCases)(nil).SearchJobApprovals))
+}
+
+// CreateFailedJob mocks base method
+func (m *MockJobUseCases) CreateFailedJob() usecases.CreateFailedJobUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFailedJob")
+	ret0, _ := ret[0].(usecases.CreateFailedJobUseCase)
+	return ret0
+}
+
+// CreateFailedJob indicates an expected call of CreateFailedJob
+func (mr *MockJobUseCasesMockRecorder) CreateFailedJob() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFailedJob", reflect.TypeOf((*MockJobUseCases)(nil).CreateFailedJob))
+}
+
+// SearchFailedJobs mocks base method
+func (m *MockJobUseCases) SearchFailedJobs() usecases.SearchFailedJobsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFailedJobs")
+	ret0, _ := ret[0].(usecases.SearchFailedJobsUseCase)
+	return ret0
+}
+
+// SearchFailedJobs indicates an expected call of SearchFailedJobs
+func (mr *MockJobUseCasesMockRecorder) SearchFailedJobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFailedJobs", reflect.TypeOf((*MockJobUseCases)(nil).SearchFailedJobs))
+}
+
+// ReplayFailedJobs mocks base method
+func (m *MockJobUseCases) ReplayFailedJobs() usecases.ReplayFailedJobsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayFailedJobs")
+	ret0, _ := ret[0].(usecases.ReplayFailedJobsUseCase)
+	return ret0
+}
+
+// ReplayFailedJobs indicates an expected call of ReplayFailedJobs
+func (mr *MockJobUseCasesMockRecorder) ReplayFailedJobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayFailedJobs", reflect.TypeOf((*MockJobUseCases)(nil).ReplayFailedJobs))
+}
+
 // MockCreateJobUseCase is a mock of CreateJobUseCase interface
 type MockCreateJobUseCase struct {
 	ctrl     *gomock.Controller
@@ -301,6 +399,20 @@ func (mr *MockStartJobUseCaseMockRecorder) Execute(ctx, jobUUID, userInfo interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStartJobUseCase)(nil).Execute), ctx, jobUUID, userInfo)
 }
 
+// WithDBTransaction mocks base method
+func (m *MockStartJobUseCase) WithDBTransaction(dbtx store.Tx) usecases.StartJobUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithDBTransaction", dbtx)
+	ret0, _ := ret[0].(usecases.StartJobUseCase)
+	return ret0
+}
+
+// WithDBTransaction indicates an expected call of WithDBTransaction
+func (mr *MockStartJobUseCaseMockRecorder) WithDBTransaction(dbtx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDBTransaction", reflect.TypeOf((*MockStartJobUseCase)(nil).WithDBTransaction), dbtx)
+}
+
 // MockStartNextJobUseCase is a mock of StartNextJobUseCase interface
 type MockStartNextJobUseCase struct {
 	ctrl     *gomock.Controller
@@ -338,6 +450,43 @@ func (mr *MockStartNextJobUseCaseMockRecorder) Execute(ctx, prevJobUUID, userInf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStartNextJobUseCase)(nil).Execute), ctx, prevJobUUID, userInfo)
 }
 
+// MockStartDependentJobsUseCase is a mock of StartDependentJobsUseCase interface
+type MockStartDependentJobsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockStartDependentJobsUseCaseMockRecorder
+}
+
+// MockStartDependentJobsUseCaseMockRecorder is the mock recorder for MockStartDependentJobsUseCase
+type MockStartDependentJobsUseCaseMockRecorder struct {
+	mock *MockStartDependentJobsUseCase
+}
+
+// NewMockStartDependentJobsUseCase creates a new mock instance
+func NewMockStartDependentJobsUseCase(ctrl *gomock.Controller) *MockStartDependentJobsUseCase {
+	mock := &MockStartDependentJobsUseCase{ctrl: ctrl}
+	mock.recorder = &MockStartDependentJobsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStartDependentJobsUseCase) EXPECT() *MockStartDependentJobsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockStartDependentJobsUseCase) Execute(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, job, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockStartDependentJobsUseCaseMockRecorder) Execute(ctx, job, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStartDependentJobsUseCase)(nil).Execute), ctx, job, userInfo)
+}
+
 // MockUpdateJobUseCase is a mock of UpdateJobUseCase interface
 type MockUpdateJobUseCase struct {
 	ctrl     *gomock.Controller
@@ -400,11 +549,12 @@ func (m *MockUpdateChildrenUseCase) EXPECT() *MockUpdateChildrenUseCaseMockRecor
 }
 
 // Execute mocks base method
-func (m *MockUpdateChildrenUseCase) Execute(ctx context.Context, jobUUID, parentJobUUID string, nextStatus entities.JobStatus, userInfo *multitenancy.UserInfo) error {
+func (m *MockUpdateChildrenUseCase) Execute(ctx context.Context, jobUUID, parentJobUUID string, nextStatus entities.JobStatus, userInfo *multitenancy.UserInfo) ([]*entities.Job, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", ctx, jobUUID, parentJobUUID, nextStatus, userInfo)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]*entities.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
@@ -500,3 +650,342 @@ func (mr *MockRetryJobTxUseCaseMockRecorder) Execute(ctx, jobUUID, gasIncrement,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRetryJobTxUseCase)(nil).Execute), ctx, jobUUID, gasIncrement, data, userInfo)
 }
+
+// MockPublishJobEventUseCase is a mock of PublishJobEventUseCase interface
+type MockPublishJobEventUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublishJobEventUseCaseMockRecorder
+}
+
+// MockPublishJobEventUseCaseMockRecorder is the mock recorder for MockPublishJobEventUseCase
+type MockPublishJobEventUseCaseMockRecorder struct {
+	mock *MockPublishJobEventUseCase
+}
+
+// NewMockPublishJobEventUseCase creates a new mock instance
+func NewMockPublishJobEventUseCase(ctrl *gomock.Controller) *MockPublishJobEventUseCase {
+	mock := &MockPublishJobEventUseCase{ctrl: ctrl}
+	mock.recorder = &MockPublishJobEventUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPublishJobEventUseCase) EXPECT() *MockPublishJobEventUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockPublishJobEventUseCase) Execute(ctx context.Context, event *entities.JobEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Execute", ctx, event)
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockPublishJobEventUseCaseMockRecorder) Execute(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockPublishJobEventUseCase)(nil).Execute), ctx, event)
+}
+
+// MockSubscribeJobEventsUseCase is a mock of SubscribeJobEventsUseCase interface
+type MockSubscribeJobEventsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscribeJobEventsUseCaseMockRecorder
+}
+
+// MockSubscribeJobEventsUseCaseMockRecorder is the mock recorder for MockSubscribeJobEventsUseCase
+type MockSubscribeJobEventsUseCaseMockRecorder struct {
+	mock *MockSubscribeJobEventsUseCase
+}
+
+// NewMockSubscribeJobEventsUseCase creates a new mock instance
+func NewMockSubscribeJobEventsUseCase(ctrl *gomock.Controller) *MockSubscribeJobEventsUseCase {
+	mock := &MockSubscribeJobEventsUseCase{ctrl: ctrl}
+	mock.recorder = &MockSubscribeJobEventsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSubscribeJobEventsUseCase) EXPECT() *MockSubscribeJobEventsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSubscribeJobEventsUseCase) Execute(ctx context.Context, filters *entities.JobEventFilters, userInfo *multitenancy.UserInfo) (<-chan *entities.JobEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
+	ret0, _ := ret[0].(<-chan *entities.JobEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSubscribeJobEventsUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSubscribeJobEventsUseCase)(nil).Execute), ctx, filters, userInfo)
+}
+
+// MockApproveJobUseCase is a mock of ApproveJobUseCase interface
+type MockApproveJobUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockApproveJobUseCaseMockRecorder
+}
+
+// MockApproveJobUseCaseMockRecorder is the mock recorder for MockApproveJobUseCase
+type MockApproveJobUseCaseMockRecorder struct {
+	mock *MockApproveJobUseCase
+}
+
+// NewMockApproveJobUseCase creates a new mock instance
+func NewMockApproveJobUseCase(ctrl *gomock.Controller) *MockApproveJobUseCase {
+	mock := &MockApproveJobUseCase{ctrl: ctrl}
+	mock.recorder = &MockApproveJobUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApproveJobUseCase) EXPECT() *MockApproveJobUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockApproveJobUseCase) Execute(ctx context.Context, jobUUID, reason string, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, jobUUID, reason, userInfo)
+	ret0, _ := ret[0].(*entities.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockApproveJobUseCaseMockRecorder) Execute(ctx, jobUUID, reason, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockApproveJobUseCase)(nil).Execute), ctx, jobUUID, reason, userInfo)
+}
+
+// MockRejectJobUseCase is a mock of RejectJobUseCase interface
+type MockRejectJobUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRejectJobUseCaseMockRecorder
+}
+
+// MockRejectJobUseCaseMockRecorder is the mock recorder for MockRejectJobUseCase
+type MockRejectJobUseCaseMockRecorder struct {
+	mock *MockRejectJobUseCase
+}
+
+// NewMockRejectJobUseCase creates a new mock instance
+func NewMockRejectJobUseCase(ctrl *gomock.Controller) *MockRejectJobUseCase {
+	mock := &MockRejectJobUseCase{ctrl: ctrl}
+	mock.recorder = &MockRejectJobUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRejectJobUseCase) EXPECT() *MockRejectJobUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockRejectJobUseCase) Execute(ctx context.Context, jobUUID, reason string, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, jobUUID, reason, userInfo)
+	ret0, _ := ret[0].(*entities.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockRejectJobUseCaseMockRecorder) Execute(ctx, jobUUID, reason, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRejectJobUseCase)(nil).Execute), ctx, jobUUID, reason, userInfo)
+}
+
+// MockSearchJobApprovalsUseCase is a mock of SearchJobApprovalsUseCase interface
+type MockSearchJobApprovalsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchJobApprovalsUseCaseMockRecorder
+}
+
+// MockSearchJobApprovalsUseCaseMockRecorder is the mock recorder for MockSearchJobApprovalsUseCase
+type MockSearchJobApprovalsUseCaseMockRecorder struct {
+	mock *MockSearchJobApprovalsUseCase
+}
+
+// NewMockSearchJobApprovalsUseCase creates a new mock instance
+func NewMockSearchJobApprovalsUseCase(ctrl *gomock.Controller) *MockSearchJobApprovalsUseCase {
+	mock := &MockSearchJobApprovalsUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchJobApprovalsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSearchJobApprovalsUseCase) EXPECT() *MockSearchJobApprovalsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSearchJobApprovalsUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) ([]*entities.JobApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, jobUUID, userInfo)
+	ret0, _ := ret[0].([]*entities.JobApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSearchJobApprovalsUseCaseMockRecorder) Execute(ctx, jobUUID, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchJobApprovalsUseCase)(nil).Execute), ctx, jobUUID, userInfo)
+}
+
+// MockCreateFailedJobUseCase is a mock of CreateFailedJobUseCase interface
+type MockCreateFailedJobUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateFailedJobUseCaseMockRecorder
+}
+
+// MockCreateFailedJobUseCaseMockRecorder is the mock recorder for MockCreateFailedJobUseCase
+type MockCreateFailedJobUseCaseMockRecorder struct {
+	mock *MockCreateFailedJobUseCase
+}
+
+// NewMockCreateFailedJobUseCase creates a new mock instance
+func NewMockCreateFailedJobUseCase(ctrl *gomock.Controller) *MockCreateFailedJobUseCase {
+	mock := &MockCreateFailedJobUseCase{ctrl: ctrl}
+	mock.recorder = &MockCreateFailedJobUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCreateFailedJobUseCase) EXPECT() *MockCreateFailedJobUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockCreateFailedJobUseCase) Execute(ctx context.Context, failedJob *entities.FailedJob) (*entities.FailedJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, failedJob)
+	ret0, _ := ret[0].(*entities.FailedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockCreateFailedJobUseCaseMockRecorder) Execute(ctx, failedJob interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateFailedJobUseCase)(nil).Execute), ctx, failedJob)
+}
+
+// MockSearchFailedJobsUseCase is a mock of SearchFailedJobsUseCase interface
+type MockSearchFailedJobsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchFailedJobsUseCaseMockRecorder
+}
+
+// MockSearchFailedJobsUseCaseMockRecorder is the mock recorder for MockSearchFailedJobsUseCase
+type MockSearchFailedJobsUseCaseMockRecorder struct {
+	mock *MockSearchFailedJobsUseCase
+}
+
+// NewMockSearchFailedJobsUseCase creates a new mock instance
+func NewMockSearchFailedJobsUseCase(ctrl *gomock.Controller) *MockSearchFailedJobsUseCase {
+	mock := &MockSearchFailedJobsUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchFailedJobsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSearchFailedJobsUseCase) EXPECT() *MockSearchFailedJobsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSearchFailedJobsUseCase) Execute(ctx context.Context, filters *entities.FailedJobFilters, userInfo *multitenancy.UserInfo) ([]*entities.FailedJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
+	ret0, _ := ret[0].([]*entities.FailedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSearchFailedJobsUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchFailedJobsUseCase)(nil).Execute), ctx, filters, userInfo)
+}
+
+// MockReplayJobUseCase is a mock of ReplayJobUseCase interface
+type MockReplayJobUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReplayJobUseCaseMockRecorder
+}
+
+// MockReplayJobUseCaseMockRecorder is the mock recorder for MockReplayJobUseCase
+type MockReplayJobUseCaseMockRecorder struct {
+	mock *MockReplayJobUseCase
+}
+
+// NewMockReplayJobUseCase creates a new mock instance
+func NewMockReplayJobUseCase(ctrl *gomock.Controller) *MockReplayJobUseCase {
+	mock := &MockReplayJobUseCase{ctrl: ctrl}
+	mock.recorder = &MockReplayJobUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockReplayJobUseCase) EXPECT() *MockReplayJobUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockReplayJobUseCase) Execute(ctx context.Context, failedJob *entities.FailedJob, userInfo *multitenancy.UserInfo) (*entities.FailedJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, failedJob, userInfo)
+	ret0, _ := ret[0].(*entities.FailedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockReplayJobUseCaseMockRecorder) Execute(ctx, failedJob, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockReplayJobUseCase)(nil).Execute), ctx, failedJob, userInfo)
+}
+
+// MockReplayFailedJobsUseCase is a mock of ReplayFailedJobsUseCase interface
+type MockReplayFailedJobsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReplayFailedJobsUseCaseMockRecorder
+}
+
+// MockReplayFailedJobsUseCaseMockRecorder is the mock recorder for MockReplayFailedJobsUseCase
+type MockReplayFailedJobsUseCaseMockRecorder struct {
+	mock *MockReplayFailedJobsUseCase
+}
+
+// NewMockReplayFailedJobsUseCase creates a new mock instance
+func NewMockReplayFailedJobsUseCase(ctrl *gomock.Controller) *MockReplayFailedJobsUseCase {
+	mock := &MockReplayFailedJobsUseCase{ctrl: ctrl}
+	mock.recorder = &MockReplayFailedJobsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockReplayFailedJobsUseCase) EXPECT() *MockReplayFailedJobsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockReplayFailedJobsUseCase) Execute(ctx context.Context, filters *entities.FailedJobFilters, userInfo *multitenancy.UserInfo) ([]*entities.FailedJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
+	ret0, _ := ret[0].([]*entities.FailedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockReplayFailedJobsUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockReplayFailedJobsUseCase)(nil).Execute), ctx, filters, userInfo)
+}
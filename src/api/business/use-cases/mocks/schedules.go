@@ -79,6 +79,20 @@ func (mr *MockScheduleUseCasesMockRecorder) SearchSchedules() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSchedules", reflect.TypeOf((*MockScheduleUseCases)(nil).SearchSchedules))
 }
 
+// RunDueSchedules mocks base method
+func (m *MockScheduleUseCases) RunDueSchedules() usecases.RunDueSchedulesUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunDueSchedules")
+	ret0, _ := ret[0].(usecases.RunDueSchedulesUseCase)
+	return ret0
+}
+
+// RunDueSchedules indicates an expected call of RunDueSchedules
+func (mr *MockScheduleUseCasesMockRecorder) RunDueSchedules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDueSchedules", reflect.TypeOf((*MockScheduleUseCases)(nil).RunDueSchedules))
+}
+
 // MockCreateScheduleUseCase is a mock of CreateScheduleUseCase interface
 type MockCreateScheduleUseCase struct {
 	ctrl     *gomock.Controller
@@ -193,16 +207,54 @@ func (m *MockSearchSchedulesUseCase) EXPECT() *MockSearchSchedulesUseCaseMockRec
 }
 
 // Execute mocks base method
-func (m *MockSearchSchedulesUseCase) Execute(ctx context.Context, userInfo *multitenancy.UserInfo) ([]*entities.Schedule, error) {
+func (m *MockSearchSchedulesUseCase) Execute(ctx context.Context, filters *entities.ScheduleFilters, userInfo *multitenancy.UserInfo) ([]*entities.Schedule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, userInfo)
+	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
 	ret0, _ := ret[0].([]*entities.Schedule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockSearchSchedulesUseCaseMockRecorder) Execute(ctx, userInfo interface{}) *gomock.Call {
+func (mr *MockSearchSchedulesUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchSchedulesUseCase)(nil).Execute), ctx, filters, userInfo)
+}
+
+// MockRunDueSchedulesUseCase is a mock of RunDueSchedulesUseCase interface
+type MockRunDueSchedulesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRunDueSchedulesUseCaseMockRecorder
+}
+
+// MockRunDueSchedulesUseCaseMockRecorder is the mock recorder for MockRunDueSchedulesUseCase
+type MockRunDueSchedulesUseCaseMockRecorder struct {
+	mock *MockRunDueSchedulesUseCase
+}
+
+// NewMockRunDueSchedulesUseCase creates a new mock instance
+func NewMockRunDueSchedulesUseCase(ctrl *gomock.Controller) *MockRunDueSchedulesUseCase {
+	mock := &MockRunDueSchedulesUseCase{ctrl: ctrl}
+	mock.recorder = &MockRunDueSchedulesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRunDueSchedulesUseCase) EXPECT() *MockRunDueSchedulesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockRunDueSchedulesUseCase) Execute(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockRunDueSchedulesUseCaseMockRecorder) Execute(ctx, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchSchedulesUseCase)(nil).Execute), ctx, userInfo)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRunDueSchedulesUseCase)(nil).Execute), ctx, limit)
 }
@@ -79,6 +79,20 @@ func (mr *MockTransactionUseCasesMockRecorder) SendTransaction() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransaction", reflect.TypeOf((*MockTransactionUseCases)(nil).SendTransaction))
 }
 
+// SendTransactionBatch mocks base method
+func (m *MockTransactionUseCases) SendTransactionBatch() usecases.SendTxBatchUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTransactionBatch")
+	ret0, _ := ret[0].(usecases.SendTxBatchUseCase)
+	return ret0
+}
+
+// SendTransactionBatch indicates an expected call of SendTransactionBatch
+func (mr *MockTransactionUseCasesMockRecorder) SendTransactionBatch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransactionBatch", reflect.TypeOf((*MockTransactionUseCases)(nil).SendTransactionBatch))
+}
+
 // GetTransaction mocks base method
 func (m *MockTransactionUseCases) GetTransaction() usecases.GetTxUseCase {
 	m.ctrl.T.Helper()
@@ -135,6 +149,20 @@ func (mr *MockTransactionUseCasesMockRecorder) CallOffTransaction() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallOffTransaction", reflect.TypeOf((*MockTransactionUseCases)(nil).CallOffTransaction))
 }
 
+// SimulateTransaction mocks base method
+func (m *MockTransactionUseCases) SimulateTransaction() usecases.SimulateTxUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateTransaction")
+	ret0, _ := ret[0].(usecases.SimulateTxUseCase)
+	return ret0
+}
+
+// SimulateTransaction indicates an expected call of SimulateTransaction
+func (mr *MockTransactionUseCasesMockRecorder) SimulateTransaction() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateTransaction", reflect.TypeOf((*MockTransactionUseCases)(nil).SimulateTransaction))
+}
+
 // MockGetTxUseCase is a mock of GetTxUseCase interface
 type MockGetTxUseCase struct {
 	ctrl     *gomock.Controller
@@ -325,6 +353,44 @@ func (mr *MockSendTxUseCaseMockRecorder) Execute(ctx, txRequest, txData, userInf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSendTxUseCase)(nil).Execute), ctx, txRequest, txData, userInfo)
 }
 
+// MockSendTxBatchUseCase is a mock of SendTxBatchUseCase interface
+type MockSendTxBatchUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSendTxBatchUseCaseMockRecorder
+}
+
+// MockSendTxBatchUseCaseMockRecorder is the mock recorder for MockSendTxBatchUseCase
+type MockSendTxBatchUseCaseMockRecorder struct {
+	mock *MockSendTxBatchUseCase
+}
+
+// NewMockSendTxBatchUseCase creates a new mock instance
+func NewMockSendTxBatchUseCase(ctrl *gomock.Controller) *MockSendTxBatchUseCase {
+	mock := &MockSendTxBatchUseCase{ctrl: ctrl}
+	mock.recorder = &MockSendTxBatchUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSendTxBatchUseCase) EXPECT() *MockSendTxBatchUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSendTxBatchUseCase) Execute(ctx context.Context, txRequests []*entities.TxRequest, inOrder bool, userInfo *multitenancy.UserInfo) ([]*entities.TxRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, txRequests, inOrder, userInfo)
+	ret0, _ := ret[0].([]*entities.TxRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSendTxBatchUseCaseMockRecorder) Execute(ctx, txRequests, inOrder, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSendTxBatchUseCase)(nil).Execute), ctx, txRequests, inOrder, userInfo)
+}
+
 // MockSpeedUpTxUseCase is a mock of SpeedUpTxUseCase interface
 type MockSpeedUpTxUseCase struct {
 	ctrl     *gomock.Controller
@@ -400,3 +466,41 @@ func (mr *MockCallOffTxUseCaseMockRecorder) Execute(ctx, scheduleUUID, userInfo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCallOffTxUseCase)(nil).Execute), ctx, scheduleUUID, userInfo)
 }
+
+// MockSimulateTxUseCase is a mock of SimulateTxUseCase interface
+type MockSimulateTxUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSimulateTxUseCaseMockRecorder
+}
+
+// MockSimulateTxUseCaseMockRecorder is the mock recorder for MockSimulateTxUseCase
+type MockSimulateTxUseCaseMockRecorder struct {
+	mock *MockSimulateTxUseCase
+}
+
+// NewMockSimulateTxUseCase creates a new mock instance
+func NewMockSimulateTxUseCase(ctrl *gomock.Controller) *MockSimulateTxUseCase {
+	mock := &MockSimulateTxUseCase{ctrl: ctrl}
+	mock.recorder = &MockSimulateTxUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSimulateTxUseCase) EXPECT() *MockSimulateTxUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSimulateTxUseCase) Execute(ctx context.Context, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TxSimulation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, txRequest, userInfo)
+	ret0, _ := ret[0].(*entities.TxSimulation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSimulateTxUseCaseMockRecorder) Execute(ctx, txRequest, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSimulateTxUseCase)(nil).Execute), ctx, txRequest, userInfo)
+}
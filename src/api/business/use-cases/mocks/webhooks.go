@@ -0,0 +1,437 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhooks.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	entities "github.com/consensys/orchestrate/src/entities"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockWebhookUseCases is a mock of WebhookUseCases interface
+type MockWebhookUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookUseCasesMockRecorder
+}
+
+// MockWebhookUseCasesMockRecorder is the mock recorder for MockWebhookUseCases
+type MockWebhookUseCasesMockRecorder struct {
+	mock *MockWebhookUseCases
+}
+
+// NewMockWebhookUseCases creates a new mock instance
+func NewMockWebhookUseCases(ctrl *gomock.Controller) *MockWebhookUseCases {
+	mock := &MockWebhookUseCases{ctrl: ctrl}
+	mock.recorder = &MockWebhookUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookUseCases) EXPECT() *MockWebhookUseCasesMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method
+func (m *MockWebhookUseCases) CreateWebhook() usecases.CreateWebhookUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook")
+	ret0, _ := ret[0].(usecases.CreateWebhookUseCase)
+	return ret0
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook
+func (mr *MockWebhookUseCasesMockRecorder) CreateWebhook() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhookUseCases)(nil).CreateWebhook))
+}
+
+// UpdateWebhook mocks base method
+func (m *MockWebhookUseCases) UpdateWebhook() usecases.UpdateWebhookUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook")
+	ret0, _ := ret[0].(usecases.UpdateWebhookUseCase)
+	return ret0
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook
+func (mr *MockWebhookUseCasesMockRecorder) UpdateWebhook() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockWebhookUseCases)(nil).UpdateWebhook))
+}
+
+// GetWebhook mocks base method
+func (m *MockWebhookUseCases) GetWebhook() usecases.GetWebhookUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhook")
+	ret0, _ := ret[0].(usecases.GetWebhookUseCase)
+	return ret0
+}
+
+// GetWebhook indicates an expected call of GetWebhook
+func (mr *MockWebhookUseCasesMockRecorder) GetWebhook() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*MockWebhookUseCases)(nil).GetWebhook))
+}
+
+// SearchWebhooks mocks base method
+func (m *MockWebhookUseCases) SearchWebhooks() usecases.SearchWebhooksUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchWebhooks")
+	ret0, _ := ret[0].(usecases.SearchWebhooksUseCase)
+	return ret0
+}
+
+// SearchWebhooks indicates an expected call of SearchWebhooks
+func (mr *MockWebhookUseCasesMockRecorder) SearchWebhooks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchWebhooks", reflect.TypeOf((*MockWebhookUseCases)(nil).SearchWebhooks))
+}
+
+// DeleteWebhook mocks base method
+func (m *MockWebhookUseCases) DeleteWebhook() usecases.DeleteWebhookUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook")
+	ret0, _ := ret[0].(usecases.DeleteWebhookUseCase)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook
+func (mr *MockWebhookUseCasesMockRecorder) DeleteWebhook() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookUseCases)(nil).DeleteWebhook))
+}
+
+// SearchWebhookDeliveries mocks base method
+func (m *MockWebhookUseCases) SearchWebhookDeliveries() usecases.SearchWebhookDeliveriesUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchWebhookDeliveries")
+	ret0, _ := ret[0].(usecases.SearchWebhookDeliveriesUseCase)
+	return ret0
+}
+
+// SearchWebhookDeliveries indicates an expected call of SearchWebhookDeliveries
+func (mr *MockWebhookUseCasesMockRecorder) SearchWebhookDeliveries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchWebhookDeliveries", reflect.TypeOf((*MockWebhookUseCases)(nil).SearchWebhookDeliveries))
+}
+
+// DeliverWebhooks mocks base method
+func (m *MockWebhookUseCases) DeliverWebhooks() usecases.DeliverWebhooksUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverWebhooks")
+	ret0, _ := ret[0].(usecases.DeliverWebhooksUseCase)
+	return ret0
+}
+
+// DeliverWebhooks indicates an expected call of DeliverWebhooks
+func (mr *MockWebhookUseCasesMockRecorder) DeliverWebhooks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverWebhooks", reflect.TypeOf((*MockWebhookUseCases)(nil).DeliverWebhooks))
+}
+
+// MockCreateWebhookUseCase is a mock of CreateWebhookUseCase interface
+type MockCreateWebhookUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateWebhookUseCaseMockRecorder
+}
+
+// MockCreateWebhookUseCaseMockRecorder is the mock recorder for MockCreateWebhookUseCase
+type MockCreateWebhookUseCaseMockRecorder struct {
+	mock *MockCreateWebhookUseCase
+}
+
+// NewMockCreateWebhookUseCase creates a new mock instance
+func NewMockCreateWebhookUseCase(ctrl *gomock.Controller) *MockCreateWebhookUseCase {
+	mock := &MockCreateWebhookUseCase{ctrl: ctrl}
+	mock.recorder = &MockCreateWebhookUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCreateWebhookUseCase) EXPECT() *MockCreateWebhookUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockCreateWebhookUseCase) Execute(ctx context.Context, webhook *entities.Webhook, userInfo *multitenancy.UserInfo) (*entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, webhook, userInfo)
+	ret0, _ := ret[0].(*entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockCreateWebhookUseCaseMockRecorder) Execute(ctx, webhook, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateWebhookUseCase)(nil).Execute), ctx, webhook, userInfo)
+}
+
+// MockUpdateWebhookUseCase is a mock of UpdateWebhookUseCase interface
+type MockUpdateWebhookUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateWebhookUseCaseMockRecorder
+}
+
+// MockUpdateWebhookUseCaseMockRecorder is the mock recorder for MockUpdateWebhookUseCase
+type MockUpdateWebhookUseCaseMockRecorder struct {
+	mock *MockUpdateWebhookUseCase
+}
+
+// NewMockUpdateWebhookUseCase creates a new mock instance
+func NewMockUpdateWebhookUseCase(ctrl *gomock.Controller) *MockUpdateWebhookUseCase {
+	mock := &MockUpdateWebhookUseCase{ctrl: ctrl}
+	mock.recorder = &MockUpdateWebhookUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockUpdateWebhookUseCase) EXPECT() *MockUpdateWebhookUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockUpdateWebhookUseCase) Execute(ctx context.Context, webhook *entities.Webhook, userInfo *multitenancy.UserInfo) (*entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, webhook, userInfo)
+	ret0, _ := ret[0].(*entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockUpdateWebhookUseCaseMockRecorder) Execute(ctx, webhook, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateWebhookUseCase)(nil).Execute), ctx, webhook, userInfo)
+}
+
+// MockGetWebhookUseCase is a mock of GetWebhookUseCase interface
+type MockGetWebhookUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetWebhookUseCaseMockRecorder
+}
+
+// MockGetWebhookUseCaseMockRecorder is the mock recorder for MockGetWebhookUseCase
+type MockGetWebhookUseCaseMockRecorder struct {
+	mock *MockGetWebhookUseCase
+}
+
+// NewMockGetWebhookUseCase creates a new mock instance
+func NewMockGetWebhookUseCase(ctrl *gomock.Controller) *MockGetWebhookUseCase {
+	mock := &MockGetWebhookUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetWebhookUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGetWebhookUseCase) EXPECT() *MockGetWebhookUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockGetWebhookUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, uuid, userInfo)
+	ret0, _ := ret[0].(*entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockGetWebhookUseCaseMockRecorder) Execute(ctx, uuid, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetWebhookUseCase)(nil).Execute), ctx, uuid, userInfo)
+}
+
+// MockSearchWebhooksUseCase is a mock of SearchWebhooksUseCase interface
+type MockSearchWebhooksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchWebhooksUseCaseMockRecorder
+}
+
+// MockSearchWebhooksUseCaseMockRecorder is the mock recorder for MockSearchWebhooksUseCase
+type MockSearchWebhooksUseCaseMockRecorder struct {
+	mock *MockSearchWebhooksUseCase
+}
+
+// NewMockSearchWebhooksUseCase creates a new mock instance
+func NewMockSearchWebhooksUseCase(ctrl *gomock.Controller) *MockSearchWebhooksUseCase {
+	mock := &MockSearchWebhooksUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchWebhooksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSearchWebhooksUseCase) EXPECT() *MockSearchWebhooksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSearchWebhooksUseCase) Execute(ctx context.Context, filters *entities.WebhookFilters, userInfo *multitenancy.UserInfo) ([]*entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
+	ret0, _ := ret[0].([]*entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSearchWebhooksUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchWebhooksUseCase)(nil).Execute), ctx, filters, userInfo)
+}
+
+// MockDeleteWebhookUseCase is a mock of DeleteWebhookUseCase interface
+type MockDeleteWebhookUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteWebhookUseCaseMockRecorder
+}
+
+// MockDeleteWebhookUseCaseMockRecorder is the mock recorder for MockDeleteWebhookUseCase
+type MockDeleteWebhookUseCaseMockRecorder struct {
+	mock *MockDeleteWebhookUseCase
+}
+
+// NewMockDeleteWebhookUseCase creates a new mock instance
+func NewMockDeleteWebhookUseCase(ctrl *gomock.Controller) *MockDeleteWebhookUseCase {
+	mock := &MockDeleteWebhookUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeleteWebhookUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeleteWebhookUseCase) EXPECT() *MockDeleteWebhookUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockDeleteWebhookUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, uuid, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockDeleteWebhookUseCaseMockRecorder) Execute(ctx, uuid, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteWebhookUseCase)(nil).Execute), ctx, uuid, userInfo)
+}
+
+// MockSearchWebhookDeliveriesUseCase is a mock of SearchWebhookDeliveriesUseCase interface
+type MockSearchWebhookDeliveriesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchWebhookDeliveriesUseCaseMockRecorder
+}
+
+// MockSearchWebhookDeliveriesUseCaseMockRecorder is the mock recorder for MockSearchWebhookDeliveriesUseCase
+type MockSearchWebhookDeliveriesUseCaseMockRecorder struct {
+	mock *MockSearchWebhookDeliveriesUseCase
+}
+
+// NewMockSearchWebhookDeliveriesUseCase creates a new mock instance
+func NewMockSearchWebhookDeliveriesUseCase(ctrl *gomock.Controller) *MockSearchWebhookDeliveriesUseCase {
+	mock := &MockSearchWebhookDeliveriesUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchWebhookDeliveriesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSearchWebhookDeliveriesUseCase) EXPECT() *MockSearchWebhookDeliveriesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSearchWebhookDeliveriesUseCase) Execute(ctx context.Context, webhookUUID string, filters *entities.WebhookDeliveryFilters, userInfo *multitenancy.UserInfo) ([]*entities.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, webhookUUID, filters, userInfo)
+	ret0, _ := ret[0].([]*entities.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSearchWebhookDeliveriesUseCaseMockRecorder) Execute(ctx, webhookUUID, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchWebhookDeliveriesUseCase)(nil).Execute), ctx, webhookUUID, filters, userInfo)
+}
+
+// MockNotifyWebhooksUseCase is a mock of NotifyWebhooksUseCase interface
+type MockNotifyWebhooksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifyWebhooksUseCaseMockRecorder
+}
+
+// MockNotifyWebhooksUseCaseMockRecorder is the mock recorder for MockNotifyWebhooksUseCase
+type MockNotifyWebhooksUseCaseMockRecorder struct {
+	mock *MockNotifyWebhooksUseCase
+}
+
+// NewMockNotifyWebhooksUseCase creates a new mock instance
+func NewMockNotifyWebhooksUseCase(ctrl *gomock.Controller) *MockNotifyWebhooksUseCase {
+	mock := &MockNotifyWebhooksUseCase{ctrl: ctrl}
+	mock.recorder = &MockNotifyWebhooksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNotifyWebhooksUseCase) EXPECT() *MockNotifyWebhooksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockNotifyWebhooksUseCase) Execute(ctx context.Context, job *entities.Job, status entities.JobStatus, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, job, status, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockNotifyWebhooksUseCaseMockRecorder) Execute(ctx, job, status, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockNotifyWebhooksUseCase)(nil).Execute), ctx, job, status, message)
+}
+
+// MockDeliverWebhooksUseCase is a mock of DeliverWebhooksUseCase interface
+type MockDeliverWebhooksUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliverWebhooksUseCaseMockRecorder
+}
+
+// MockDeliverWebhooksUseCaseMockRecorder is the mock recorder for MockDeliverWebhooksUseCase
+type MockDeliverWebhooksUseCaseMockRecorder struct {
+	mock *MockDeliverWebhooksUseCase
+}
+
+// NewMockDeliverWebhooksUseCase creates a new mock instance
+func NewMockDeliverWebhooksUseCase(ctrl *gomock.Controller) *MockDeliverWebhooksUseCase {
+	mock := &MockDeliverWebhooksUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeliverWebhooksUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeliverWebhooksUseCase) EXPECT() *MockDeliverWebhooksUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockDeliverWebhooksUseCase) Execute(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockDeliverWebhooksUseCaseMockRecorder) Execute(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeliverWebhooksUseCase)(nil).Execute), ctx, limit)
+}
@@ -17,6 +17,7 @@ type ScheduleUseCases interface {
 	CreateSchedule() CreateScheduleUseCase
 	GetSchedule() GetScheduleUseCase
 	SearchSchedules() SearchSchedulesUseCase
+	RunDueSchedules() RunDueSchedulesUseCase
 }
 
 type CreateScheduleUseCase interface {
@@ -29,5 +30,9 @@ type GetScheduleUseCase interface {
 }
 
 type SearchSchedulesUseCase interface {
-	Execute(ctx context.Context, userInfo *multitenancy.UserInfo) ([]*entities.Schedule, error)
+	Execute(ctx context.Context, filters *entities.ScheduleFilters, userInfo *multitenancy.UserInfo) ([]*entities.Schedule, error)
+}
+
+type RunDueSchedulesUseCase interface {
+	Execute(ctx context.Context, limit int) (int, error)
 }
@@ -2,6 +2,7 @@ package schedules
 
 import (
 	"context"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/api/store/parsers"
 )
@@ -39,7 +41,13 @@ func (uc *createScheduleUseCase) Execute(ctx context.Context, schedule *entities
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("creating new schedule")
 
+	nextRunAt, err := utils.FirstScheduleRun(schedule.NotBefore, schedule.NotAfter, schedule.Cron, time.Now().UTC())
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createScheduleComponent)
+	}
+
 	scheduleModel := parsers.NewScheduleModelFromEntities(schedule)
+	scheduleModel.NextRunAt = nextRunAt
 	scheduleModel.TenantID = userInfo.TenantID
 	scheduleModel.OwnerID = userInfo.Username
 	if err := uc.db.Schedule().Insert(ctx, scheduleModel); err != nil {
@@ -4,6 +4,8 @@ package schedules
 
 import (
 	"context"
+	"time"
+
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"testing"
@@ -55,4 +57,33 @@ func TestCreateSchedule_Execute(t *testing.T) {
 		assert.Nil(t, scheduleResponse)
 		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createScheduleComponent), err)
 	})
+
+	t.Run("should set the next run of a delayed schedule", func(t *testing.T) {
+		scheduleEntity := testdata.FakeSchedule()
+		notBefore := time.Now().Add(time.Hour)
+		scheduleEntity.NotBefore = &notBefore
+
+		mockScheduleDA.EXPECT().
+			Insert(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, schedule *models.Schedule) error {
+				assert.Equal(t, &notBefore, schedule.NextRunAt)
+				return nil
+			})
+
+		scheduleResponse, err := usecase.Execute(ctx, scheduleEntity, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &notBefore, scheduleResponse.NextRunAt)
+	})
+
+	t.Run("should fail with InvalidParameterError if the schedule window is in the past", func(t *testing.T) {
+		scheduleEntity := testdata.FakeSchedule()
+		notAfter := time.Now().Add(-time.Hour)
+		scheduleEntity.NotAfter = &notAfter
+
+		scheduleResponse, err := usecase.Execute(ctx, scheduleEntity, userInfo)
+
+		assert.Nil(t, scheduleResponse)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
 }
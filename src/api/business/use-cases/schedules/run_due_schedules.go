@@ -0,0 +1,325 @@
+package schedules
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/utils"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/database"
+)
+
+const runDueSchedulesComponent = "use-cases.run-due-schedules"
+
+// scheduleRunRetryDelay is the delay after which a schedule is run again if its run fails or does not complete
+const scheduleRunRetryDelay = time.Minute
+
+// runDueSchedulesUseCase is a use case to start and expire the jobs of the schedules whose time has come
+type runDueSchedulesUseCase struct {
+	db          store.DB
+	createJobUC usecases.CreateJobUseCase
+	startJobUC  usecases.StartJobUseCase
+	updateJobUC usecases.UpdateJobUseCase
+	logger      *log.Logger
+}
+
+type scheduleRun struct {
+	schedule  *models.Schedule
+	lastRunAt *time.Time
+	nextRunAt *time.Time
+	firstRun  bool
+	expired   bool
+}
+
+// NewRunDueSchedulesUseCase creates a new RunDueSchedulesUseCase
+func NewRunDueSchedulesUseCase(
+	db store.DB,
+	createJobUC usecases.CreateJobUseCase,
+	startJobUC usecases.StartJobUseCase,
+	updateJobUC usecases.UpdateJobUseCase,
+) usecases.RunDueSchedulesUseCase {
+	return &runDueSchedulesUseCase{
+		db:          db,
+		createJobUC: createJobUC,
+		startJobUC:  startJobUC,
+		updateJobUC: updateJobUC,
+		logger:      log.NewLogger().SetComponent(runDueSchedulesComponent),
+	}
+}
+
+// Execute runs at most limit due schedules and returns the number of schedules run.
+// The first run of a schedule starts its jobs, the next ones send a copy of its first job, and the jobs
+// still CREATED or AWAITING_APPROVAL when the schedule window closes are EXPIRED.
+// Due schedules are claimed until scheduleRunRetryDelay expires and are only advanced to their next run in the
+// same database transaction as a successful start, so that a failed run is attempted again
+func (uc *runDueSchedulesUseCase) Execute(ctx context.Context, limit int) (int, error) {
+	logger := uc.logger.WithContext(ctx)
+	now := time.Now().UTC()
+	retryAt := now.Add(scheduleRunRetryDelay)
+
+	var runs []*scheduleRun
+	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		schedules, der := tx.(store.Tx).Schedule().LockDue(ctx, now, limit)
+		if der != nil {
+			return der
+		}
+
+		runs = make([]*scheduleRun, len(schedules))
+		for idx, schedule := range schedules {
+			runs[idx] = &scheduleRun{
+				schedule:  schedule,
+				lastRunAt: &now,
+				nextRunAt: utils.NextScheduleRun(schedule.NotAfter, schedule.Cron, now),
+				firstRun:  schedule.LastRunAt == nil,
+				expired:   schedule.NotAfter != nil && !now.Before(*schedule.NotAfter),
+			}
+
+			schedule.NextRunAt = &retryAt
+			if der = tx.(store.Tx).Schedule().Update(ctx, schedule); der != nil {
+				return der
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to lock due schedules")
+		return 0, errors.FromError(err).ExtendComponent(runDueSchedulesComponent)
+	}
+
+	for _, run := range runs {
+		uc.run(ctx, run)
+	}
+
+	return len(runs), nil
+}
+
+func (uc *runDueSchedulesUseCase) run(ctx context.Context, run *scheduleRun) {
+	schedule := run.schedule
+	ctx = log.WithFields(ctx, log.Field("schedule", schedule.UUID))
+	logger := uc.logger.WithContext(ctx)
+	userInfo := multitenancy.NewUserInfo(schedule.TenantID, schedule.OwnerID)
+
+	switch {
+	case run.expired:
+		if err := uc.expire(ctx, schedule, userInfo); err != nil {
+			logger.WithError(err).WithField("retry_at", schedule.NextRunAt).Error("failed to expire schedule")
+			return
+		}
+		logger.Info("schedule expired")
+	case run.firstRun:
+		if err := uc.start(ctx, run, userInfo); err != nil {
+			logger.WithError(err).WithField("retry_at", schedule.NextRunAt).Error("failed to start schedule")
+			return
+		}
+		logger.WithField("next_run_at", schedule.NextRunAt).Info("schedule started")
+	case schedule.Cron != "" && len(schedule.Jobs) > 0:
+		job, err := uc.recurJob(ctx, run, userInfo)
+		if err != nil {
+			logger.WithError(err).WithField("retry_at", schedule.NextRunAt).Error("failed to send recurring job")
+			return
+		}
+		logger.WithField("job", job.UUID).WithField("next_run_at", schedule.NextRunAt).Info("recurring job sent")
+	default:
+		if err := uc.advance(ctx, uc.db, run); err != nil {
+			logger.WithError(err).Error("failed to update schedule")
+		}
+	}
+}
+
+func (run *scheduleRun) markDone() {
+	run.schedule.LastRunAt = run.lastRunAt
+	run.schedule.NextRunAt = run.nextRunAt
+}
+
+// expire expires the jobs of the schedule and closes it, the schedule is run again if a job fails to be expired
+func (uc *runDueSchedulesUseCase) expire(ctx context.Context, schedule *models.Schedule, userInfo *multitenancy.UserInfo) error {
+	for _, job := range schedule.Jobs {
+		if (job.Status != entities.StatusCreated && job.Status != entities.StatusAwaitingApproval) || isChildJob(job) {
+			continue
+		}
+
+		_, err := uc.updateJobUC.Execute(ctx, &entities.Job{UUID: job.UUID}, entities.StatusExpired, "schedule window has expired", userInfo)
+		if err != nil && !errors.IsInvalidStateError(err) {
+			return err
+		}
+	}
+
+	closedSchedule := *schedule
+	closedSchedule.NextRunAt = nil
+	if err := uc.db.Schedule().Update(ctx, &closedSchedule); err != nil {
+		return err
+	}
+
+	*schedule = closedSchedule
+	return nil
+}
+
+// start starts the jobs of the schedule, each in the same database transaction as the schedule update. If no job
+// can be started the schedule is run again, otherwise the jobs which failed to start are marked as FAILED
+func (uc *runDueSchedulesUseCase) start(ctx context.Context, run *scheduleRun, userInfo *multitenancy.UserInfo) error {
+	logger := uc.logger.WithContext(ctx)
+
+	var failedJobs []*models.Job
+	var startErr error
+	started := false
+	for _, job := range rootJobs(run.schedule.Jobs) {
+		err := uc.startInDBTx(ctx, run, job.UUID, userInfo)
+		switch {
+		case err == nil:
+			started = true
+		case errors.IsInvalidStateError(err):
+			// Starting again a job which cannot be started at its current status would not succeed
+			logger.WithError(err).WithField("job", job.UUID).Warn("scheduled job cannot be started")
+		default:
+			logger.WithError(err).WithField("job", job.UUID).Error("failed to start scheduled job")
+			failedJobs = append(failedJobs, job)
+			startErr = err
+		}
+	}
+
+	if !started && startErr != nil {
+		return startErr
+	}
+
+	if !started {
+		return uc.advance(ctx, uc.db, run)
+	}
+
+	for _, job := range failedJobs {
+		uc.failJob(ctx, job.UUID, userInfo)
+	}
+
+	return nil
+}
+
+// recurJob creates a copy of the first job of the schedule and starts it, a copy failing to start is marked as FAILED
+// and the schedule is run again
+func (uc *runDueSchedulesUseCase) recurJob(ctx context.Context, run *scheduleRun, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+	job, err := uc.createRecurringJob(ctx, run.schedule, userInfo)
+	if errors.IsInvalidParameterError(err) || errors.IsNotFoundError(err) {
+		// The run is skipped as creating the job again would fail the same way
+		if der := uc.advance(ctx, uc.db, run); der != nil {
+			return nil, der
+		}
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err = uc.startInDBTx(ctx, run, job.UUID, userInfo); err != nil {
+		uc.failJob(ctx, job.UUID, userInfo)
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// startInDBTx starts the job and advances the schedule to its next run in the same database transaction
+func (uc *runDueSchedulesUseCase) startInDBTx(ctx context.Context, run *scheduleRun, jobUUID string, userInfo *multitenancy.UserInfo) error {
+	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		if err := uc.updateSchedule(ctx, tx.(store.Tx), run); err != nil {
+			return err
+		}
+
+		return uc.startJobUC.WithDBTransaction(tx.(store.Tx)).Execute(ctx, jobUUID, userInfo)
+	})
+	if err != nil {
+		return err
+	}
+
+	run.markDone()
+	return nil
+}
+
+// advance moves the schedule to its next run without starting any job
+func (uc *runDueSchedulesUseCase) advance(ctx context.Context, db store.Agents, run *scheduleRun) error {
+	if err := uc.updateSchedule(ctx, db, run); err != nil {
+		return err
+	}
+
+	run.markDone()
+	return nil
+}
+
+func (uc *runDueSchedulesUseCase) updateSchedule(ctx context.Context, db store.Agents, run *scheduleRun) error {
+	schedule := *run.schedule
+	schedule.LastRunAt = run.lastRunAt
+	schedule.NextRunAt = run.nextRunAt
+	return db.Schedule().Update(ctx, &schedule)
+}
+
+// failJob records a failed run on the job, so that it is not started again
+func (uc *runDueSchedulesUseCase) failJob(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) {
+	_, err := uc.updateJobUC.Execute(ctx, &entities.Job{UUID: jobUUID}, entities.StatusFailed, "failed to start scheduled job", userInfo)
+	if err != nil && !errors.IsInvalidStateError(err) {
+		uc.logger.WithContext(ctx).WithError(err).WithField("job", jobUUID).Error("failed to record failed scheduled job")
+	}
+}
+
+// createRecurringJob creates a copy of the first job of the schedule, the transaction fields filled by the
+// tx-sender such as the nonce and the gas are computed again
+func (uc *runDueSchedulesUseCase) createRecurringJob(ctx context.Context, schedule *models.Schedule, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+	templateModel, err := uc.db.Job().FindOneByUUID(ctx, schedule.Jobs[0].UUID, userInfo.AllowedTenants, userInfo.Username, false)
+	if err != nil {
+		return nil, err
+	}
+
+	template := parsers.NewJobEntityFromModels(templateModel)
+	if template.Type != entities.EthereumTransaction {
+		return nil, errors.InvalidParameterError("only %s jobs can recur", entities.EthereumTransaction)
+	}
+
+	internalData := &entities.InternalData{}
+	if template.InternalData != nil {
+		*internalData = *template.InternalData
+		internalData.ParentJobUUID = ""
+	}
+
+	return uc.createJobUC.Execute(ctx, &entities.Job{
+		ScheduleUUID: schedule.UUID,
+		ChainUUID:    template.ChainUUID,
+		Type:         template.Type,
+		Labels:       template.Labels,
+		InternalData: internalData,
+		Transaction: &entities.ETHTransaction{
+			From:            template.Transaction.From,
+			To:              template.Transaction.To,
+			Value:           template.Transaction.Value,
+			Data:            template.Transaction.Data,
+			TransactionType: template.Transaction.TransactionType,
+			AccessList:      template.Transaction.AccessList,
+		},
+	}, userInfo)
+}
+
+// rootJobs returns the created jobs which are not started by another job of the schedule
+func rootJobs(jobs []*models.Job) []*models.Job {
+	nextJobs := make(map[string]bool)
+	for _, job := range jobs {
+		if job.NextJobUUID != "" {
+			nextJobs[job.NextJobUUID] = true
+		}
+	}
+
+	var roots []*models.Job
+	for _, job := range jobs {
+		if job.Status == entities.StatusCreated && !isChildJob(job) && len(job.DependsOn) == 0 && !nextJobs[job.UUID] {
+			roots = append(roots, job)
+		}
+	}
+
+	return roots
+}
+
+func isChildJob(job *models.Job) bool {
+	return job.InternalData != nil && job.InternalData.ParentJobUUID != ""
+}
@@ -0,0 +1,231 @@
+// +build unit
+
+package schedules
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	mocks2 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunDueSchedules_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	mockScheduleDA := mocks.NewMockScheduleAgent(ctrl)
+	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockCreateJobUC := mocks2.NewMockCreateJobUseCase(ctrl)
+	mockStartJobUC := mocks2.NewMockStartJobUseCase(ctrl)
+	mockUpdateJobUC := mocks2.NewMockUpdateJobUseCase(ctrl)
+
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDB.EXPECT().Schedule().Return(mockScheduleDA).AnyTimes()
+	mockDBTX.EXPECT().Schedule().Return(mockScheduleDA).AnyTimes()
+	mockDBTX.EXPECT().Commit().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+	mockStartJobUC.EXPECT().WithDBTransaction(mockDBTX).Return(mockStartJobUC).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewRunDueSchedulesUseCase(mockDB, mockCreateJobUC, mockStartJobUC, mockUpdateJobUC)
+
+	newSchedule := func() *models.Schedule {
+		schedule := testdata.FakeSchedule(userInfo.TenantID, userInfo.Username)
+		schedule.ID = 1
+		schedule.Jobs[0].Status = entities.StatusCreated
+		return schedule
+	}
+
+	t.Run("should start the jobs of a delayed schedule", func(t *testing.T) {
+		schedule := newSchedule()
+		notBefore := time.Now().Add(-time.Minute)
+		schedule.NotBefore = &notBefore
+		schedule.NextRunAt = &notBefore
+		nextJob := testdata.FakeJobModel(1)
+		schedule.Jobs[0].NextJobUUID = nextJob.UUID
+		schedule.Jobs = append(schedule.Jobs, nextJob)
+
+		mockScheduleDA.EXPECT().LockDue(gomock.Any(), gomock.Any(), 10).Return([]*models.Schedule{schedule}, nil)
+		gomock.InOrder(
+			mockScheduleDA.EXPECT().Update(gomock.Any(), schedule).Return(nil),
+			mockScheduleDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, updated *models.Schedule) error {
+				assert.NotNil(t, updated.LastRunAt)
+				assert.Nil(t, updated.NextRunAt)
+				return nil
+			}),
+			mockStartJobUC.EXPECT().Execute(gomock.Any(), schedule.Jobs[0].UUID, userInfo).Return(nil),
+		)
+
+		n, err := usecase.Execute(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.NotNil(t, schedule.LastRunAt)
+		assert.Nil(t, schedule.NextRunAt)
+	})
+
+	t.Run("should run the schedule again if its jobs fail to start", func(t *testing.T) {
+		schedule := newSchedule()
+		notBefore := time.Now().Add(-time.Minute)
+		schedule.NotBefore = &notBefore
+		schedule.NextRunAt = &notBefore
+
+		mockScheduleDA.EXPECT().LockDue(gomock.Any(), gomock.Any(), 10).Return([]*models.Schedule{schedule}, nil)
+		mockScheduleDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), schedule.Jobs[0].UUID, userInfo).Return(errors.KafkaConnectionError("error"))
+
+		n, err := usecase.Execute(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Nil(t, schedule.LastRunAt)
+		require.NotNil(t, schedule.NextRunAt)
+		assert.True(t, schedule.NextRunAt.After(time.Now()))
+	})
+
+	t.Run("should mark as failed the jobs failing to start once the schedule has run", func(t *testing.T) {
+		schedule := newSchedule()
+		otherJob := testdata.FakeJobModel(1)
+		otherJob.Status = entities.StatusCreated
+		schedule.Jobs = append(schedule.Jobs, otherJob)
+		notBefore := time.Now().Add(-time.Minute)
+		schedule.NotBefore = &notBefore
+		schedule.NextRunAt = &notBefore
+
+		mockScheduleDA.EXPECT().LockDue(gomock.Any(), gomock.Any(), 10).Return([]*models.Schedule{schedule}, nil)
+		mockScheduleDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), schedule.Jobs[0].UUID, userInfo).Return(errors.KafkaConnectionError("error"))
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), otherJob.UUID, userInfo).Return(nil)
+		mockUpdateJobUC.EXPECT().Execute(gomock.Any(), &entities.Job{UUID: schedule.Jobs[0].UUID}, entities.StatusFailed, gomock.Any(), userInfo).Return(nil, nil)
+
+		n, err := usecase.Execute(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.NotNil(t, schedule.LastRunAt)
+		assert.Nil(t, schedule.NextRunAt)
+	})
+
+	t.Run("should send a copy of the first job of a recurring schedule", func(t *testing.T) {
+		schedule := newSchedule()
+		schedule.Cron = "0 9 * * *"
+		lastRunAt := time.Now().Add(-24 * time.Hour)
+		schedule.LastRunAt = &lastRunAt
+		schedule.Jobs[0].Status = entities.StatusMined
+		template := testdata.FakeJobModel(1)
+		template.UUID = schedule.Jobs[0].UUID
+		template.Transaction.Recipient = "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+		template.Transaction.Nonce = "1"
+
+		mockScheduleDA.EXPECT().LockDue(gomock.Any(), gomock.Any(), 10).Return([]*models.Schedule{schedule}, nil)
+		mockScheduleDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), template.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(template, nil)
+		mockCreateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).
+			DoAndReturn(func(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+				assert.Equal(t, schedule.UUID, job.ScheduleUUID)
+				assert.Nil(t, job.Transaction.Nonce)
+				assert.Equal(t, template.Transaction.Recipient, job.Transaction.To.Hex())
+				job.UUID = "newJobUUID"
+				return job, nil
+			})
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), "newJobUUID", userInfo).Return(nil)
+
+		n, err := usecase.Execute(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.True(t, schedule.LastRunAt.After(lastRunAt))
+		assert.True(t, schedule.NextRunAt.After(time.Now().Add(scheduleRunRetryDelay)))
+	})
+
+	t.Run("should mark as failed the copy failing to start and run the recurring schedule again", func(t *testing.T) {
+		schedule := newSchedule()
+		schedule.Cron = "0 9 * * *"
+		lastRunAt := time.Now().Add(-24 * time.Hour)
+		schedule.LastRunAt = &lastRunAt
+		schedule.Jobs[0].Status = entities.StatusMined
+		template := testdata.FakeJobModel(1)
+		template.UUID = schedule.Jobs[0].UUID
+
+		mockScheduleDA.EXPECT().LockDue(gomock.Any(), gomock.Any(), 10).Return([]*models.Schedule{schedule}, nil)
+		mockScheduleDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), template.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(template, nil)
+		mockCreateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).
+			DoAndReturn(func(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+				job.UUID = "newJobUUID"
+				return job, nil
+			})
+		mockStartJobUC.EXPECT().Execute(gomock.Any(), "newJobUUID", userInfo).Return(errors.KafkaConnectionError("error"))
+		mockUpdateJobUC.EXPECT().Execute(gomock.Any(), &entities.Job{UUID: "newJobUUID"}, entities.StatusFailed, gomock.Any(), userInfo).Return(nil, nil)
+
+		n, err := usecase.Execute(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, &lastRunAt, schedule.LastRunAt)
+		assert.False(t, schedule.NextRunAt.After(time.Now().Add(scheduleRunRetryDelay)))
+	})
+
+	t.Run("should expire the created jobs of a schedule whose window has closed", func(t *testing.T) {
+		schedule := newSchedule()
+		notAfter := time.Now().Add(-time.Minute)
+		schedule.NotAfter = &notAfter
+		schedule.NextRunAt = &notAfter
+
+		mockScheduleDA.EXPECT().LockDue(gomock.Any(), gomock.Any(), 10).Return([]*models.Schedule{schedule}, nil)
+		mockScheduleDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		mockUpdateJobUC.EXPECT().Execute(gomock.Any(), &entities.Job{UUID: schedule.Jobs[0].UUID}, entities.StatusExpired, gomock.Any(), userInfo).Return(nil, nil)
+
+		n, err := usecase.Execute(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Nil(t, schedule.NextRunAt)
+		assert.Nil(t, schedule.LastRunAt)
+	})
+
+	t.Run("should run the schedule again if its jobs fail to expire", func(t *testing.T) {
+		schedule := newSchedule()
+		notAfter := time.Now().Add(-time.Minute)
+		schedule.NotAfter = &notAfter
+		schedule.NextRunAt = &notAfter
+
+		mockScheduleDA.EXPECT().LockDue(gomock.Any(), gomock.Any(), 10).Return([]*models.Schedule{schedule}, nil)
+		mockScheduleDA.EXPECT().Update(gomock.Any(), schedule).Return(nil)
+		mockUpdateJobUC.EXPECT().Execute(gomock.Any(), &entities.Job{UUID: schedule.Jobs[0].UUID}, entities.StatusExpired, gomock.Any(), userInfo).
+			Return(nil, errors.PostgresConnectionError("error"))
+
+		n, err := usecase.Execute(ctx, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		require.NotNil(t, schedule.NextRunAt)
+		assert.True(t, schedule.NextRunAt.After(time.Now()))
+	})
+
+	t.Run("should fail with same error if LockDue fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockScheduleDA.EXPECT().LockDue(gomock.Any(), gomock.Any(), 10).Return(nil, expectedErr)
+
+		n, err := usecase.Execute(ctx, 10)
+
+		assert.Zero(t, n)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(runDueSchedulesComponent), err)
+	})
+}
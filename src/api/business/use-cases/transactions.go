@@ -17,10 +17,12 @@ type TransactionUseCases interface {
 	SendContractTransaction() SendContractTxUseCase
 	SendDeployTransaction() SendDeployTxUseCase
 	SendTransaction() SendTxUseCase
+	SendTransactionBatch() SendTxBatchUseCase
 	GetTransaction() GetTxUseCase
 	SearchTransactions() SearchTransactionsUseCase
 	SpeedUpTransaction() SpeedUpTxUseCase
 	CallOffTransaction() CallOffTxUseCase
+	SimulateTransaction() SimulateTxUseCase
 }
 
 type GetTxUseCase interface {
@@ -42,6 +44,10 @@ type SendTxUseCase interface {
 	Execute(ctx context.Context, txRequest *entities.TxRequest, txData hexutil.Bytes, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error)
 }
 
+type SendTxBatchUseCase interface {
+	Execute(ctx context.Context, txRequests []*entities.TxRequest, inOrder bool, userInfo *multitenancy.UserInfo) ([]*entities.TxRequest, error)
+}
+
 type SpeedUpTxUseCase interface {
 	Execute(ctx context.Context, scheduleUUID string, gasIncrement float64, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error)
 }
@@ -49,3 +55,7 @@ type SpeedUpTxUseCase interface {
 type CallOffTxUseCase interface {
 	Execute(ctx context.Context, scheduleUUID string, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error)
 }
+
+type SimulateTxUseCase interface {
+	Execute(ctx context.Context, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TxSimulation, error)
+}
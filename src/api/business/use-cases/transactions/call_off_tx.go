@@ -42,19 +42,7 @@ func (uc *callOffTxUseCase) Execute(ctx context.Context, scheduleUUID string, us
 		return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
 	}
 
-	if tx.Params.Protocol != "" {
-		errMsg := "call off is not supported for private transaction"
-		logger.Error(errMsg)
-		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(sendTxComponent)
-	}
-
-	if tx.InternalData != nil && tx.InternalData.OneTimeKey {
-		errMsg := "call off is not supported for oneTimeKey transactions"
-		logger.Error(errMsg)
-		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(sendTxComponent)
-	}
-
-	job := tx.Schedule.Jobs[0]
+	job := jobToReplace(tx)
 	err = uc.retryJobTxUC.Execute(ctx, job.UUID, 0.1, nil, userInfo)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
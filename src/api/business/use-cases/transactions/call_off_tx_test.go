@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
@@ -44,26 +43,31 @@ func TestCallOffTx_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 	
-	t.Run("should fail to if it is a private transaction", func(t *testing.T) {
+	t.Run("should replace the marking transaction of private transactions", func(t *testing.T) {
 		txRequest := testdata.FakeTxRequest()
 		txRequest.Params.Protocol = entities.TesseraChainType
-		
-		getTxUC.EXPECT().Execute(gomock.Any(), txRequest.Schedule.UUID, userInfo).Return(txRequest, nil)
+		txRequest.Schedule.Jobs[0].Type = entities.TesseraPrivateTransaction
+		markingJob := testdata.FakeJob()
+		markingJob.Type = entities.TesseraMarkingTransaction
+		txRequest.Schedule.Jobs = append(txRequest.Schedule.Jobs, markingJob)
+
+		getTxUC.EXPECT().Execute(gomock.Any(), txRequest.Schedule.UUID, userInfo).Times(2).Return(txRequest, nil)
+		retryJobUC.EXPECT().Execute(gomock.Any(), markingJob.UUID, gasIncrement, nil, userInfo).Return(nil)
 		_, err := usecase.Execute(ctx, txRequest.Schedule.UUID, userInfo)
-		assert.Error(t, err)
-		assert.True(t, errors.IsInvalidParameterError(err))
+		assert.NoError(t, err)
 	})
-	
-	t.Run("should fail to if it is a oneTimeKey transaction", func(t *testing.T) {
+
+	t.Run("should execute successfully for oneTimeKey transactions", func(t *testing.T) {
 		txRequest := testdata.FakeTxRequest()
 		txRequest.InternalData.OneTimeKey = true
-		
-		getTxUC.EXPECT().Execute(gomock.Any(), txRequest.Schedule.UUID, userInfo).Return(txRequest, nil)
+		job := txRequest.Schedule.Jobs[0]
+
+		getTxUC.EXPECT().Execute(gomock.Any(), txRequest.Schedule.UUID, userInfo).Times(2).Return(txRequest, nil)
+		retryJobUC.EXPECT().Execute(gomock.Any(), job.UUID, gasIncrement, nil, userInfo).Return(nil)
 		_, err := usecase.Execute(ctx, txRequest.Schedule.UUID, userInfo)
-		assert.Error(t, err)
-		assert.True(t, errors.IsInvalidParameterError(err))
+		assert.NoError(t, err)
 	})
-	
+
 	t.Run("should fail to execute if getTxUC fails", func(t *testing.T) {
 		expectedErr := fmt.Errorf("err")
 		txRequest := testdata.FakeTxRequest()
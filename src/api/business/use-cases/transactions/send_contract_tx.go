@@ -8,6 +8,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/umbracle/go-web3/abi"
 )
 
@@ -39,7 +40,7 @@ func (uc *sendContractTxUseCase) Execute(ctx context.Context, txRequest *entitie
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("creating new contract transaction")
 
-	contract, err := uc.getContractUseCase.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag)
+	contract, err := uc.getContractUseCase.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo)
 	if errors.IsNotFoundError(err) {
 		return nil, errors.InvalidParameterError("contract not found")
 	}
@@ -47,31 +48,37 @@ func (uc *sendContractTxUseCase) Execute(ctx context.Context, txRequest *entitie
 		return nil, errors.FromError(err).ExtendComponent(sendContractTxComponent)
 	}
 
-	// TODO: We restrict the usage of web3-go to only generate the txData but ideally we should use it as much as possible and change the ABI type everywhere in the codebase
-	web3ABI, err := abi.NewABI(contract.RawABI)
+	txData, err := encodeContractTxData(contract, txRequest.Params.MethodSignature, txRequest.Params.Args)
 	if err != nil {
-		errMessage := "failed to parse contract ABI for contract transaction"
-		logger.WithError(err).Error(errMessage)
-		return nil, errors.DataCorruptedError(errMessage).ExtendComponent(sendContractTxComponent)
+		logger.WithError(err).Error("failed to compute tx data from method signature and arguments")
+		return nil, errors.FromError(err).ExtendComponent(sendContractTxComponent)
 	}
 
-	method := web3ABI.GetMethodBySignature(txRequest.Params.MethodSignature)
-	if method == nil {
-		errMessage := "method not found"
-		logger.WithError(err).Error(errMessage)
-		return nil, errors.InvalidParameterError(errMessage).ExtendComponent(sendContractTxComponent)
+	tx, err := uc.sendTxUseCase.Execute(ctx, txRequest, txData, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(sendContractTxComponent)
 	}
 
-	txData, err := method.Encode(txRequest.Params.Args)
+	return tx, nil
+}
+
+// encodeContractTxData computes the data of a transaction calling the given method of the contract
+func encodeContractTxData(contract *entities.Contract, methodSignature string, args []interface{}) (hexutil.Bytes, error) {
+	// TODO: We restrict the usage of web3-go to only generate the txData but ideally we should use it as much as possible and change the ABI type everywhere in the codebase
+	web3ABI, err := abi.NewABI(contract.RawABI)
 	if err != nil {
-		logger.WithError(err).Error("failed to compute tx data from method signature and arguments")
-		return nil, errors.InvalidParameterError(err.Error()).ExtendComponent(sendContractTxComponent)
+		return nil, errors.DataCorruptedError("failed to parse contract ABI for contract transaction")
 	}
 
-	tx, err := uc.sendTxUseCase.Execute(ctx, txRequest, txData, userInfo)
+	method := web3ABI.GetMethodBySignature(methodSignature)
+	if method == nil {
+		return nil, errors.InvalidParameterError("method not found")
+	}
+
+	txData, err := method.Encode(args)
 	if err != nil {
-		return nil, errors.FromError(err).ExtendComponent(sendContractTxComponent)
+		return nil, errors.InvalidParameterError(err.Error())
 	}
 
-	return tx, nil
+	return txData, nil
 }
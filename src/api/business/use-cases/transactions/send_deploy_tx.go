@@ -10,6 +10,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 const sendDeployTxComponent = "use-cases.send-deploy-tx"
@@ -40,7 +41,7 @@ func (uc *sendDeployTxUsecase) Execute(ctx context.Context, txRequest *entities.
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("creating new deployment transaction")
 
-	contract, err := uc.getContractUseCase.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag)
+	contract, err := uc.getContractUseCase.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo)
 	if errors.IsNotFoundError(err) {
 		return nil, errors.InvalidParameterError("contract not found")
 	}
@@ -48,29 +49,34 @@ func (uc *sendDeployTxUsecase) Execute(ctx context.Context, txRequest *entities.
 		return nil, errors.FromError(err).ExtendComponent(sendDeployTxComponent)
 	}
 
-	if contract.Bytecode == nil || len(contract.Bytecode) == 0 {
-		errMessage := "contract has no bytecode"
-		uc.logger.WithError(err).Error(errMessage)
-		return nil, errors.DataCorruptedError(errMessage).ExtendComponent(sendDeployTxComponent)
+	txData, err := encodeDeployTxData(contract, txRequest.Params.Args)
+	if err != nil {
+		logger.WithError(err).Error("failed to compute tx data from constructor and arguments")
+		return nil, errors.FromError(err).ExtendComponent(sendDeployTxComponent)
+	}
+
+	return uc.sendTxUseCase.Execute(ctx, txRequest, txData, userInfo)
+}
+
+// encodeDeployTxData computes the data of a transaction deploying the contract with the given constructor arguments
+func encodeDeployTxData(contract *entities.Contract, args []interface{}) (hexutil.Bytes, error) {
+	if len(contract.Bytecode) == 0 {
+		return nil, errors.DataCorruptedError("contract has no bytecode")
 	}
 
 	// TODO: We restrict the usage of web3-go to only generate the txData but ideally we should use it as much as possible and change the ABI type everywhere in the codebase
 	web3ABI, err := abi.NewABI(contract.RawABI)
 	if err != nil {
-		errMessage := "failed to parse contract ABI"
-		logger.WithError(err).Error(errMessage)
-		return nil, errors.DataCorruptedError(errMessage).ExtendComponent(sendContractTxComponent)
+		return nil, errors.DataCorruptedError("failed to parse contract ABI")
 	}
 
 	var arguments []byte
 	if web3ABI.Constructor != nil { // It is possible to create a smart contract without constructor
-		arguments, err = abi.Encode(txRequest.Params.Args, web3ABI.Constructor.Inputs)
+		arguments, err = abi.Encode(args, web3ABI.Constructor.Inputs)
 		if err != nil {
-			logger.WithError(err).Error("failed to compute tx data from constructor and arguments")
-			return nil, errors.InvalidParameterError(err.Error()).ExtendComponent(sendContractTxComponent)
+			return nil, errors.InvalidParameterError(err.Error())
 		}
 	}
 
-	txData := append(contract.Bytecode, arguments...)
-	return uc.sendTxUseCase.Execute(ctx, txRequest, txData, userInfo)
+	return append(contract.Bytecode, arguments...), nil
 }
@@ -33,7 +33,7 @@ func TestSendDeployTx_Execute(t *testing.T) {
 		txRequestResponse := testdata.FakeTxRequest()
 		fakeContract := testdata.FakeContract()
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(fakeContract, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(fakeContract, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, gomock.Any(), userInfo).Return(txRequestResponse, nil)
 
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
@@ -45,7 +45,7 @@ func TestSendDeployTx_Execute(t *testing.T) {
 	t.Run("should fail with same error if validator fails", func(t *testing.T) {
 		expectedErr := fmt.Errorf("error")
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(nil, expectedErr)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(nil, expectedErr)
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
 
 		assert.Nil(t, response)
@@ -56,7 +56,7 @@ func TestSendDeployTx_Execute(t *testing.T) {
 		expectedErr := fmt.Errorf("error")
 		fakeContract := testdata.FakeContract()
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(fakeContract, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(fakeContract, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, gomock.Any(), userInfo).Return(nil, expectedErr)
 
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
@@ -6,10 +6,14 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/erc20"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/utils"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/api/store/models"
@@ -43,6 +47,17 @@ func NewSendTxUseCase(
 	getTxUC usecases.GetTxUseCase,
 	getFaucetCandidate usecases.GetFaucetCandidateUseCase,
 ) usecases.SendTxUseCase {
+	return newSendTxUseCase(db, searchChainsUC, startJobUseCase, createJobUC, getTxUC, getFaucetCandidate)
+}
+
+func newSendTxUseCase(
+	db store.DB,
+	searchChainsUC usecases.SearchChainsUseCase,
+	startJobUseCase usecases.StartJobUseCase,
+	createJobUC usecases.CreateJobUseCase,
+	getTxUC usecases.GetTxUseCase,
+	getFaucetCandidate usecases.GetFaucetCandidateUseCase,
+) *sendTxUsecase {
 	return &sendTxUsecase{
 		db:                 db,
 		searchChainsUC:     searchChainsUC,
@@ -60,6 +75,12 @@ func (uc *sendTxUsecase) Execute(ctx context.Context, txRequest *entities.TxRequ
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("creating new transaction")
 
+	// Disabled accounts cannot send new transactions
+	if err := uc.checkSenderEnabled(ctx, txRequest.Params.From, txRequest.Params.To, userInfo); err != nil {
+		logger.WithError(err).Error("invalid sender")
+		return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
+	}
+
 	// Step 1: Get chain from chain registry
 	chain, err := uc.getChain(ctx, txRequest.ChainName, userInfo)
 	if err != nil {
@@ -84,13 +105,15 @@ func (uc *sendTxUsecase) Execute(ctx context.Context, txRequest *entities.TxRequ
 	// Otherwise there was another request with same idempotency key and same reqHash
 	job := txRequest.Schedule.Jobs[0]
 	if job.Status == entities.StatusCreated {
-		var fctJob *entities.Job
-		fctJob, err = uc.startFaucetJob(ctx, job.Transaction.From, job.ScheduleUUID, chain, userInfo)
-		if err != nil {
-			return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
-		}
-		if fctJob != nil {
-			txRequest.Schedule.Jobs = append(txRequest.Schedule.Jobs, fctJob)
+		for _, tokenAddress := range faucetTokens(job.Transaction) {
+			var fctJob *entities.Job
+			fctJob, err = uc.startFaucetJob(ctx, job.Transaction.From, tokenAddress, job.ScheduleUUID, chain, userInfo)
+			if err != nil {
+				return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
+			}
+			if fctJob != nil {
+				txRequest.Schedule.Jobs = append(txRequest.Schedule.Jobs, fctJob)
+			}
 		}
 
 		if err = uc.startJobUC.Execute(ctx, job.UUID, userInfo); err != nil {
@@ -107,8 +130,35 @@ func (uc *sendTxUsecase) Execute(ctx context.Context, txRequest *entities.TxRequ
 	return txRequest, nil
 }
 
+func (uc *sendTxUsecase) checkSenderEnabled(ctx context.Context, from, to *ethcommon.Address, userInfo *multitenancy.UserInfo) error {
+	if from == nil {
+		return nil
+	}
+
+	account, err := uc.db.Account().FindOneByAddress(ctx, from.Hex(), userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		// Senders unknown to the account registry are allowed
+		if errors.IsNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	// Rotated accounts remain allowed to drain their balance to their successor
+	if account.DisabledAt != nil && (to == nil || account.Successor == "" || !strings.EqualFold(account.Successor, to.Hex())) {
+		return errors.InvalidStateError("account %s is disabled", from.Hex())
+	}
+
+	return nil
+}
+
 func (uc *sendTxUsecase) getChain(ctx context.Context, chainName string, userInfo *multitenancy.UserInfo) (*entities.Chain, error) {
-	chains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{chainName}}, userInfo)
+	return getChain(ctx, uc.searchChainsUC, chainName, userInfo)
+}
+
+// getChain returns the chain registered under the given name
+func getChain(ctx context.Context, searchChainsUC usecases.SearchChainsUseCase, chainName string, userInfo *multitenancy.UserInfo) (*entities.Chain, error) {
+	chains, err := searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{chainName}}, userInfo)
 	if err != nil {
 		return nil, errors.FromError(err)
 	}
@@ -153,68 +203,84 @@ func (uc *sendTxUsecase) insertNewTxRequest(
 	userInfo *multitenancy.UserInfo,
 ) (*entities.TxRequest, error) {
 	err := database.ExecuteInDBTx(uc.db, func(dbtx database.Tx) error {
-		schedule := &models.Schedule{TenantID: tenantID, OwnerID: userInfo.Username}
-		if err := dbtx.(store.Tx).Schedule().Insert(ctx, schedule); err != nil {
-			return err
-		}
+		return uc.insertTxRequest(ctx, dbtx.(store.Tx), txRequest, txData, requestHash, chainUUID, tenantID, userInfo)
+	})
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
+	}
 
-		txRequestModel := parsers.NewTxRequestModelFromEntities(txRequest, requestHash, schedule.ID)
-		if err := dbtx.(store.Tx).TransactionRequest().Insert(ctx, txRequestModel); err != nil {
+	return txRequest, nil
+}
+
+// insertTxRequest inserts the Schedule, TxRequest and Jobs of a new transaction using the given DB transaction
+func (uc *sendTxUsecase) insertTxRequest(
+	ctx context.Context,
+	dbtx store.Tx,
+	txRequest *entities.TxRequest,
+	txData []byte, requestHash, chainUUID, tenantID string,
+	userInfo *multitenancy.UserInfo,
+) error {
+	schedule := &models.Schedule{TenantID: tenantID, OwnerID: userInfo.Username}
+	if txRequest.Schedule != nil {
+		nextRunAt, err := utils.FirstScheduleRun(txRequest.Schedule.NotBefore, txRequest.Schedule.NotAfter, txRequest.Schedule.Cron, time.Now().UTC())
+		if err != nil {
 			return err
 		}
+		schedule.NotBefore = txRequest.Schedule.NotBefore
+		schedule.NotAfter = txRequest.Schedule.NotAfter
+		schedule.Cron = txRequest.Schedule.Cron
+		schedule.NextRunAt = nextRunAt
+	}
+	if err := dbtx.Schedule().Insert(ctx, schedule); err != nil {
+		return err
+	}
 
-		txRequest.Schedule = parsers.NewScheduleEntityFromModels(schedule)
-		return nil
-	})
-
-	if err != nil {
-		return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
+	txRequestModel := parsers.NewTxRequestModelFromEntities(txRequest, requestHash, schedule.ID)
+	if err := dbtx.TransactionRequest().Insert(ctx, txRequestModel); err != nil {
+		return err
 	}
 
+	txRequest.Schedule = parsers.NewScheduleEntityFromModels(schedule)
+
 	sendTxJobs, err := parsers.NewJobEntitiesFromTxRequest(txRequest, chainUUID, txData)
 	if err != nil {
-		return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
+		return err
 	}
 
 	txRequest.Schedule.Jobs = make([]*entities.Job, len(sendTxJobs))
-	err = database.ExecuteInDBTx(uc.db, func(dbtx database.Tx) error {
-		var nextJobUUID string
-		for idx, txJob := range sendTxJobs {
-			if nextJobUUID != "" {
-				txJob.UUID = nextJobUUID
-			}
-
-			if idx < len(sendTxJobs)-1 {
-				nextJobUUID = uuid.Must(uuid.NewV4()).String()
-				txJob.NextJobUUID = nextJobUUID
-			}
+	nextJobUUID := txRequest.JobUUID
+	for idx, txJob := range sendTxJobs {
+		if nextJobUUID != "" {
+			txJob.UUID = nextJobUUID
+		}
 
-			var job *entities.Job
-			job, err = uc.createJobUC.WithDBTransaction(dbtx.(store.Tx)).Execute(ctx, txJob, userInfo)
-			if err != nil {
-				return err
-			}
+		if idx < len(sendTxJobs)-1 {
+			nextJobUUID = uuid.Must(uuid.NewV4()).String()
+			txJob.NextJobUUID = nextJobUUID
+		}
 
-			txRequest.Schedule.Jobs[idx] = job
+		job, err := uc.createJobUC.WithDBTransaction(dbtx).Execute(ctx, txJob, userInfo)
+		if err != nil {
+			return err
 		}
-		return nil
-	})
 
-	if err != nil {
-		return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
+		txRequest.Schedule.Jobs[idx] = job
 	}
 
-	return txRequest, nil
+	return nil
 }
 
-// Execute validates, creates and starts a new transaction for pre funding users account
-func (uc *sendTxUsecase) startFaucetJob(ctx context.Context, account *ethcommon.Address, scheduleUUID string, chain *entities.Chain, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+// Execute validates, creates and starts a new transaction for pre funding users account with the given token, or with
+// the native currency if tokenAddress is nil
+func (uc *sendTxUsecase) startFaucetJob(ctx context.Context, account, tokenAddress *ethcommon.Address, scheduleUUID string,
+	chain *entities.Chain, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
 	if account == nil {
 		return nil, nil
 	}
 
-	logger := uc.logger.WithContext(ctx).WithField("chain", chain.UUID)
-	faucet, err := uc.getFaucetCandidate.Execute(ctx, *account, chain, userInfo)
+	logger := uc.logger.WithContext(ctx).WithField("chain", chain.UUID).WithField("token", utils.StringerToString(tokenAddress))
+	fundingJobUUID := uuid.Must(uuid.NewV4()).String()
+	faucet, err := uc.getFaucetCandidate.Execute(ctx, *account, chain, tokenAddress, fundingJobUUID, userInfo)
 	if err != nil {
 		if errors.IsNotFoundError(err) {
 			return nil, nil
@@ -224,6 +290,7 @@ func (uc *sendTxUsecase) startFaucetJob(ctx context.Context, account *ethcommon.
 	logger.WithField("faucet_amount", faucet.Amount).Debug("faucet: credit approved")
 
 	txJob := &entities.Job{
+		UUID:         fundingJobUUID,
 		ScheduleUUID: scheduleUUID,
 		ChainUUID:    chain.UUID,
 		Type:         entities.EthereumTransaction,
@@ -237,8 +304,13 @@ func (uc *sendTxUsecase) startFaucetJob(ctx context.Context, account *ethcommon.
 			Value: &faucet.Amount,
 		},
 	}
-	fctJob, err := uc.createJobUC.Execute(ctx, txJob, userInfo)
+
+	fctJob, err := uc.createFaucetJob(ctx, txJob, faucet, account, userInfo)
 	if err != nil {
+		// The faucet spending is released as the funding job does not exist
+		if der := uc.db.FaucetSpending().DeleteByJobUUID(ctx, fundingJobUUID); der != nil {
+			logger.WithError(der).Error("failed to release faucet spending")
+		}
 		return nil, err
 	}
 
@@ -250,6 +322,42 @@ func (uc *sendTxUsecase) startFaucetJob(ctx context.Context, account *ethcommon.
 	return fctJob, nil
 }
 
+// createFaucetJob creates the funding job, a transfer call to the token contract for the faucets of ERC-20 tokens
+func (uc *sendTxUsecase) createFaucetJob(ctx context.Context, txJob *entities.Job, faucet *entities.Faucet, account *ethcommon.Address,
+	userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+	if faucet.TokenAddress != nil {
+		txData, err := erc20.EncodeTransfer(*account, faucet.Amount.ToInt())
+		if err != nil {
+			return nil, errors.EncodingError(err.Error())
+		}
+
+		txJob.Transaction = &entities.ETHTransaction{
+			From: &faucet.CreditorAccount,
+			To:   faucet.TokenAddress,
+			Data: txData,
+		}
+	}
+
+	return uc.createJobUC.Execute(ctx, txJob, userInfo)
+}
+
+// faucetTokens returns the currencies the sender of the transactions is credited with by faucets: the native currency,
+// as nil, and the ERC-20 tokens transferred by the transactions
+func faucetTokens(transactions ...*entities.ETHTransaction) []*ethcommon.Address {
+	tokenAddresses := []*ethcommon.Address{nil}
+	seen := make(map[ethcommon.Address]bool)
+	for _, transaction := range transactions {
+		if transaction.To == nil || !erc20.IsTransfer(transaction.Data) || seen[*transaction.To] {
+			continue
+		}
+
+		seen[*transaction.To] = true
+		tokenAddresses = append(tokenAddresses, transaction.To)
+	}
+
+	return tokenAddresses
+}
+
 func generateRequestHash(chainUUID string, params interface{}) (string, error) {
 	jsonParams, err := json.Marshal(params)
 	if err != nil {
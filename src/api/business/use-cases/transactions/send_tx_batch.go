@@ -0,0 +1,352 @@
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/workerpool"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/database"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const sendTxBatchComponent = "use-cases.send-tx-batch"
+
+// sendTxBatchWorkers is the maximum number of senders, and of jobs, of a batch started concurrently
+const sendTxBatchWorkers = 20
+
+type txBatchItem struct {
+	txRequest   *entities.TxRequest
+	chain       *entities.Chain
+	txData      hexutil.Bytes
+	requestHash string
+	// existing is true when the idempotency key matches an already created transaction request
+	existing bool
+}
+
+// sendTxBatchUseCase is a use case to create a batch of transactions atomically
+type sendTxBatchUseCase struct {
+	db            store.DB
+	sendTxUC      *sendTxUsecase
+	getContractUC usecases.GetContractUseCase
+	logger        *log.Logger
+}
+
+// NewSendTxBatchUseCase creates a new SendTxBatchUseCase
+func NewSendTxBatchUseCase(
+	db store.DB,
+	searchChainsUC usecases.SearchChainsUseCase,
+	startJobUC usecases.StartJobUseCase,
+	createJobUC usecases.CreateJobUseCase,
+	getTxUC usecases.GetTxUseCase,
+	getFaucetCandidate usecases.GetFaucetCandidateUseCase,
+	getContractUC usecases.GetContractUseCase,
+) usecases.SendTxBatchUseCase {
+	return &sendTxBatchUseCase{
+		db:            db,
+		sendTxUC:      newSendTxUseCase(db, searchChainsUC, startJobUC, createJobUC, getTxUC, getFaucetCandidate),
+		getContractUC: getContractUC,
+		logger:        log.NewLogger().SetComponent(sendTxBatchComponent),
+	}
+}
+
+// Execute validates every transaction of the batch, creates all of them in a single DB transaction and starts them.
+// Nothing is created if one of the transactions is invalid. If inOrder is set, the transactions of a same sender
+// are started sequentially in the batch order so that their nonces are assigned in that order.
+func (uc *sendTxBatchUseCase) Execute(ctx context.Context, txRequests []*entities.TxRequest, inOrder bool,
+	userInfo *multitenancy.UserInfo) ([]*entities.TxRequest, error) {
+	ctx = log.WithFields(ctx, log.Field("batch_size", len(txRequests)), log.Field("in_order", inOrder))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("creating new transaction batch")
+
+	// Step 1: Validate every transaction request and compute its data
+	items, err := uc.prepareItems(ctx, txRequests, userInfo)
+	if err != nil {
+		logger.WithError(err).Error("invalid transaction batch")
+		return nil, errors.FromError(err).ExtendComponent(sendTxBatchComponent)
+	}
+
+	// Step 2: Insert Schedules + Jobs + Transactions + TxRequests of all new transactions atomically
+	err = database.ExecuteInDBTx(uc.db, func(dbtx database.Tx) error {
+		for idx, item := range items {
+			if item.existing {
+				continue
+			}
+
+			insertErr := uc.sendTxUC.insertTxRequest(ctx, dbtx.(store.Tx), item.txRequest, item.txData, item.requestHash,
+				item.chain.UUID, userInfo.TenantID, userInfo)
+			if insertErr != nil {
+				if isTxBatchItemError(insertErr) {
+					return txBatchError([]string{formatTxBatchItemError(idx, insertErr)})
+				}
+				return insertErr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to insert transaction batch")
+		return nil, errors.FromError(err).ExtendComponent(sendTxBatchComponent)
+	}
+
+	// Step 3: Start the first job of every schedule which is in status CREATED
+	err = uc.startJobs(ctx, items, inOrder, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(sendTxBatchComponent)
+	}
+
+	result := make([]*entities.TxRequest, len(items))
+	for idx, item := range items {
+		result[idx] = item.txRequest
+	}
+
+	logger.Info("transaction batch created successfully")
+	return result, nil
+}
+
+func (uc *sendTxBatchUseCase) prepareItems(ctx context.Context, txRequests []*entities.TxRequest,
+	userInfo *multitenancy.UserInfo) ([]*txBatchItem, error) {
+	chains := make(map[string]*entities.Chain)
+	contracts := make(map[string]*entities.Contract)
+	idempotencyKeys := make(map[string]bool)
+
+	items := make([]*txBatchItem, len(txRequests))
+	var itemErrs []string
+	for idx, txRequest := range txRequests {
+		if txRequest.IdempotencyKey != "" {
+			if idempotencyKeys[txRequest.IdempotencyKey] {
+				itemErrs = append(itemErrs, formatTxBatchItemError(idx, errors.InvalidParameterError("duplicated idempotency key")))
+				continue
+			}
+			idempotencyKeys[txRequest.IdempotencyKey] = true
+		}
+
+		item, err := uc.prepareItem(ctx, txRequest, chains, contracts, userInfo)
+		if err != nil {
+			if !isTxBatchItemError(err) {
+				return nil, err
+			}
+			itemErrs = append(itemErrs, formatTxBatchItemError(idx, err))
+			continue
+		}
+
+		items[idx] = item
+	}
+
+	if err := txBatchError(itemErrs); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (uc *sendTxBatchUseCase) prepareItem(
+	ctx context.Context,
+	txRequest *entities.TxRequest,
+	chains map[string]*entities.Chain,
+	contracts map[string]*entities.Contract,
+	userInfo *multitenancy.UserInfo,
+) (*txBatchItem, error) {
+	item := &txBatchItem{txRequest: txRequest}
+
+	err := uc.sendTxUC.checkSenderEnabled(ctx, txRequest.Params.From, txRequest.Params.To, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	item.chain = chains[txRequest.ChainName]
+	if item.chain == nil {
+		item.chain, err = uc.sendTxUC.getChain(ctx, txRequest.ChainName, userInfo)
+		if err != nil {
+			return nil, err
+		}
+		chains[txRequest.ChainName] = item.chain
+	}
+
+	item.txData, err = uc.computeTxData(ctx, txRequest.Params, contracts, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	item.requestHash, err = generateRequestHash(item.chain.UUID, txRequest.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	if txRequest.IdempotencyKey == "" {
+		return item, nil
+	}
+
+	txRequestModel, err := uc.db.TransactionRequest().FindOneByIdempotencyKey(ctx, txRequest.IdempotencyKey, userInfo.TenantID, userInfo.Username)
+	switch {
+	case errors.IsNotFoundError(err):
+		return item, nil
+	case err != nil:
+		return nil, err
+	case txRequestModel.RequestHash != item.requestHash:
+		return nil, errors.AlreadyExistsError("transaction request with the same idempotency key and different params already exists")
+	}
+
+	item.txRequest, err = uc.sendTxUC.getTxUC.Execute(ctx, txRequestModel.Schedule.UUID, userInfo)
+	if err != nil {
+		return nil, err
+	}
+	item.existing = true
+
+	return item, nil
+}
+
+// computeTxData computes the data of contract calls (method signature set) and deployments (no recipient),
+// transfers have no data
+func (uc *sendTxBatchUseCase) computeTxData(ctx context.Context, params *entities.ETHTransactionParams,
+	contracts map[string]*entities.Contract, userInfo *multitenancy.UserInfo) (hexutil.Bytes, error) {
+	if params.MethodSignature == "" && params.To != nil {
+		return nil, nil
+	}
+
+	contractKey := params.ContractName + ":" + params.ContractTag
+	contract := contracts[contractKey]
+	if contract == nil {
+		var err error
+		contract, err = uc.getContractUC.Execute(ctx, params.ContractName, params.ContractTag, userInfo)
+		if errors.IsNotFoundError(err) {
+			return nil, errors.InvalidParameterError("contract not found")
+		}
+		if err != nil {
+			return nil, err
+		}
+		contracts[contractKey] = contract
+	}
+
+	if params.MethodSignature != "" {
+		return encodeContractTxData(contract, params.MethodSignature, params.Args)
+	}
+
+	return encodeDeployTxData(contract, params.Args)
+}
+
+// startJobs starts the faucet job and the created jobs of every sender, senders and jobs are processed concurrently by
+// bounded worker pools
+func (uc *sendTxBatchUseCase) startJobs(ctx context.Context, items []*txBatchItem, inOrder bool, userInfo *multitenancy.UserInfo) error {
+	var senders [][]*txBatchItem
+	senderIdx := make(map[string]int)
+	for _, item := range items {
+		if item.txRequest.Schedule.Jobs[0].Status != entities.StatusCreated {
+			continue
+		}
+
+		from := item.txRequest.Params.From
+		if from == nil {
+			senders = append(senders, []*txBatchItem{item})
+			continue
+		}
+
+		key := fmt.Sprintf("%s@%s", from.Hex(), item.chain.UUID)
+		if idx, ok := senderIdx[key]; ok {
+			senders[idx] = append(senders[idx], item)
+			continue
+		}
+		senderIdx[key] = len(senders)
+		senders = append(senders, []*txBatchItem{item})
+	}
+
+	// Sender tasks wait for their jobs, which are started by a distinct pool so that waiting senders cannot hold every worker
+	senderPool := workerpool.New(sendTxBatchWorkers)
+	jobPool := workerpool.New(sendTxBatchWorkers)
+	errs := make([]error, len(senders))
+	for idx, senderItems := range senders {
+		idx, senderItems := idx, senderItems
+		senderPool.Submit(func() {
+			errs[idx] = uc.startSenderJobs(ctx, senderItems, inOrder, jobPool, userInfo)
+		})
+	}
+	senderPool.StopWait()
+	jobPool.StopWait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uc *sendTxBatchUseCase) startSenderJobs(ctx context.Context, items []*txBatchItem, inOrder bool, jobPool *workerpool.WorkerPool,
+	userInfo *multitenancy.UserInfo) error {
+	logger := uc.logger.WithContext(ctx)
+
+	// A single faucet credit per currency is requested for all the transactions of the sender
+	first := items[0]
+	transactions := make([]*entities.ETHTransaction, len(items))
+	for idx, item := range items {
+		transactions[idx] = item.txRequest.Schedule.Jobs[0].Transaction
+	}
+	for _, tokenAddress := range faucetTokens(transactions...) {
+		fctJob, err := uc.sendTxUC.startFaucetJob(ctx, first.txRequest.Params.From, tokenAddress, first.txRequest.Schedule.UUID,
+			first.chain, userInfo)
+		if err != nil {
+			return err
+		}
+		if fctJob != nil {
+			first.txRequest.Schedule.Jobs = append(first.txRequest.Schedule.Jobs, fctJob)
+		}
+	}
+
+	if inOrder {
+		for _, item := range items {
+			// Following transactions are not started so that no nonce is assigned before the ones of this transaction
+			if err := uc.sendTxUC.startJobUC.Execute(ctx, item.txRequest.Schedule.Jobs[0].UUID, userInfo); err != nil {
+				logger.WithError(err).WithField("schedule", item.txRequest.Schedule.UUID).Error("failed to start job, next jobs of the sender are not started")
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(items))
+	for idx, item := range items {
+		idx, item := idx, item
+		wg.Add(1)
+		jobPool.Submit(func() {
+			defer wg.Done()
+			errs[idx] = uc.sendTxUC.startJobUC.Execute(ctx, item.txRequest.Schedule.Jobs[0].UUID, userInfo)
+		})
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isTxBatchItemError returns true if the error is caused by the transaction request itself
+func isTxBatchItemError(err error) bool {
+	return errors.IsInvalidParameterError(err) || errors.IsAlreadyExistsError(err) || errors.IsInvalidStateError(err)
+}
+
+func formatTxBatchItemError(idx int, err error) string {
+	return fmt.Sprintf("transactions[%d]: %s", idx, errors.FromError(err).GetMessage())
+}
+
+func txBatchError(itemErrs []string) error {
+	if len(itemErrs) == 0 {
+		return nil
+	}
+
+	return errors.InvalidParameterError("invalid transactions in batch: %s", strings.Join(itemErrs, "; "))
+}
@@ -0,0 +1,273 @@
+// +build unit
+
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	modelstestdata "github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sendTxBatchMocks struct {
+	db                 *mocks2.MockDB
+	dbtx               *mocks2.MockTx
+	txRequestDA        *mocks2.MockTransactionRequestAgent
+	accountDA          *mocks2.MockAccountAgent
+	scheduleDA         *mocks2.MockScheduleAgent
+	searchChainsUC     *mocks.MockSearchChainsUseCase
+	startJobUC         *mocks.MockStartJobUseCase
+	createJobUC        *mocks.MockCreateJobUseCase
+	getTxUC            *mocks.MockGetTxUseCase
+	getFaucetCandidate *mocks.MockGetFaucetCandidateUseCase
+	getContractUC      *mocks.MockGetContractUseCase
+}
+
+func newSendTxBatchUseCase(ctrl *gomock.Controller) (usecases.SendTxBatchUseCase, *sendTxBatchMocks) {
+	m := &sendTxBatchMocks{
+		db:                 mocks2.NewMockDB(ctrl),
+		dbtx:               mocks2.NewMockTx(ctrl),
+		txRequestDA:        mocks2.NewMockTransactionRequestAgent(ctrl),
+		accountDA:          mocks2.NewMockAccountAgent(ctrl),
+		scheduleDA:         mocks2.NewMockScheduleAgent(ctrl),
+		searchChainsUC:     mocks.NewMockSearchChainsUseCase(ctrl),
+		startJobUC:         mocks.NewMockStartJobUseCase(ctrl),
+		createJobUC:        mocks.NewMockCreateJobUseCase(ctrl),
+		getTxUC:            mocks.NewMockGetTxUseCase(ctrl),
+		getFaucetCandidate: mocks.NewMockGetFaucetCandidateUseCase(ctrl),
+		getContractUC:      mocks.NewMockGetContractUseCase(ctrl),
+	}
+
+	m.db.EXPECT().TransactionRequest().Return(m.txRequestDA).AnyTimes()
+	m.db.EXPECT().Account().Return(m.accountDA).AnyTimes()
+	m.accountDA.EXPECT().FindOneByAddress(gomock.Any(), gomock.Not(disabledSender.Hex()), gomock.Any(), gomock.Any()).
+		Return(nil, errors.NotFoundError("account not found")).AnyTimes()
+	m.dbtx.EXPECT().Schedule().Return(m.scheduleDA).AnyTimes()
+	m.dbtx.EXPECT().TransactionRequest().Return(m.txRequestDA).AnyTimes()
+	m.createJobUC.EXPECT().WithDBTransaction(m.dbtx).Return(m.createJobUC).AnyTimes()
+
+	return NewSendTxBatchUseCase(m.db, m.searchChainsUC, m.startJobUC, m.createJobUC, m.getTxUC, m.getFaucetCandidate,
+		m.getContractUC), m
+}
+
+func TestSendTxBatch_Execute(t *testing.T) {
+	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	chain := testdata.FakeChain()
+
+	newTxRequests := func() []*entities.TxRequest {
+		transfer1 := testdata.FakeTransferTxRequest()
+		transfer1.IdempotencyKey = "key1"
+		transfer2 := testdata.FakeTransferTxRequest()
+		transfer2.IdempotencyKey = ""
+		contractTx := testdata.FakeTxRequest()
+		contractTx.IdempotencyKey = ""
+		return []*entities.TxRequest{transfer1, contractTx, transfer2}
+	}
+
+	expectCreateJobs := func(m *sendTxBatchMocks, times int) {
+		jobIdx := 0
+		m.createJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).
+			DoAndReturn(func(_ context.Context, job *entities.Job, _ *multitenancy.UserInfo) (*entities.Job, error) {
+				job.UUID = fmt.Sprintf("job%d", jobIdx)
+				job.Status = entities.StatusCreated
+				jobIdx++
+				return job, nil
+			}).Times(times)
+	}
+
+	t.Run("should create all transactions in a single DB transaction and start them in order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		usecase, m := newSendTxBatchUseCase(ctrl)
+		txRequests := newTxRequests()
+
+		m.searchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{"chain"}}, userInfo).
+			Return([]*entities.Chain{chain}, nil).Times(1)
+		m.getContractUC.EXPECT().Execute(gomock.Any(), "ContractName", "ContractTag", userInfo).Return(testdata.FakeContract(), nil)
+		m.txRequestDA.EXPECT().FindOneByIdempotencyKey(gomock.Any(), "key1", userInfo.TenantID, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+		m.db.EXPECT().Begin().Return(m.dbtx, nil).Times(1)
+		m.scheduleDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+		m.txRequestDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+		expectCreateJobs(m, 3)
+		m.dbtx.EXPECT().Commit().Return(nil)
+		m.getFaucetCandidate.EXPECT().Execute(gomock.Any(), gomock.Any(), chain, nil, gomock.Any(), userInfo).
+			Return(nil, errors.NotFoundError("error")).Times(2)
+		// The contract transaction transfers the tokens of its sender
+		m.getFaucetCandidate.EXPECT().Execute(gomock.Any(), *txRequests[1].Params.From, chain, txRequests[1].Params.To, gomock.Any(), userInfo).
+			Return(nil, errors.NotFoundError("error"))
+		m.startJobUC.EXPECT().Execute(gomock.Any(), "job1", userInfo).Return(nil)
+		gomock.InOrder(
+			m.startJobUC.EXPECT().Execute(gomock.Any(), "job0", userInfo).Return(nil),
+			m.startJobUC.EXPECT().Execute(gomock.Any(), "job2", userInfo).Return(nil),
+		)
+
+		result, err := usecase.Execute(ctx, txRequests, true, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, result, 3)
+		assert.Equal(t, "job0", result[0].Schedule.Jobs[0].UUID)
+		assert.Equal(t, "job1", result[1].Schedule.Jobs[0].UUID)
+		assert.Equal(t, "job2", result[2].Schedule.Jobs[0].UUID)
+	})
+
+	t.Run("should not start next jobs of a sender if a job fails to start in order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		usecase, m := newSendTxBatchUseCase(ctrl)
+		txRequests := newTxRequests()[:1]
+		secondTransfer := testdata.FakeTransferTxRequest()
+		secondTransfer.IdempotencyKey = ""
+		txRequests = append(txRequests, secondTransfer)
+		expectedErr := errors.KafkaConnectionError("error")
+
+		m.searchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		m.txRequestDA.EXPECT().FindOneByIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.NotFoundError("error"))
+		m.db.EXPECT().Begin().Return(m.dbtx, nil)
+		m.scheduleDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		m.txRequestDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		expectCreateJobs(m, 2)
+		m.dbtx.EXPECT().Commit().Return(nil)
+		m.getFaucetCandidate.EXPECT().Execute(gomock.Any(), gomock.Any(), chain, nil, gomock.Any(), userInfo).Return(nil, errors.NotFoundError("error"))
+		m.startJobUC.EXPECT().Execute(gomock.Any(), "job0", userInfo).Return(expectedErr)
+
+		_, err := usecase.Execute(ctx, txRequests, true, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(sendTxBatchComponent), err)
+	})
+
+	t.Run("should fail with an error per invalid transaction and create nothing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		usecase, m := newSendTxBatchUseCase(ctrl)
+		txRequests := newTxRequests()
+		txRequests[1].Params.MethodSignature = "unknown()"
+		txRequests[2].ChainName = "unknownChain"
+
+		m.searchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{"chain"}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		m.searchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{"unknownChain"}}, userInfo).
+			Return([]*entities.Chain{}, nil)
+		m.getContractUC.EXPECT().Execute(gomock.Any(), "ContractName", "ContractTag", userInfo).Return(testdata.FakeContract(), nil)
+		m.txRequestDA.EXPECT().FindOneByIdempotencyKey(gomock.Any(), "key1", userInfo.TenantID, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+
+		_, err := usecase.Execute(ctx, txRequests, false, userInfo)
+
+		require.True(t, errors.IsInvalidParameterError(err))
+		assert.Contains(t, err.Error(), "transactions[1]: method not found")
+		assert.Contains(t, err.Error(), "transactions[2]: chain 'unknownChain' does not exist")
+	})
+
+	t.Run("should fail with an error per transaction sent from a disabled account", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		usecase, m := newSendTxBatchUseCase(ctrl)
+		txRequests := newTxRequests()
+		txRequests[2].Params.From = &disabledSender
+		accountModel := modelstestdata.FakeAccountModel()
+		disabledAt := time.Now()
+		accountModel.DisabledAt = &disabledAt
+
+		m.accountDA.EXPECT().FindOneByAddress(gomock.Any(), disabledSender.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(accountModel, nil)
+		m.searchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		m.getContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).Return(testdata.FakeContract(), nil)
+		m.txRequestDA.EXPECT().FindOneByIdempotencyKey(gomock.Any(), "key1", userInfo.TenantID, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+
+		_, err := usecase.Execute(ctx, txRequests, false, userInfo)
+
+		require.True(t, errors.IsInvalidParameterError(err))
+		assert.Contains(t, err.Error(), fmt.Sprintf("transactions[2]: account %s is disabled", disabledSender.Hex()))
+	})
+
+	t.Run("should fail if an idempotency key is duplicated in the batch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		usecase, m := newSendTxBatchUseCase(ctrl)
+		txRequests := newTxRequests()
+		txRequests[2].IdempotencyKey = "key1"
+
+		m.searchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		m.getContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).Return(testdata.FakeContract(), nil)
+		m.txRequestDA.EXPECT().FindOneByIdempotencyKey(gomock.Any(), "key1", userInfo.TenantID, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+
+		_, err := usecase.Execute(ctx, txRequests, false, userInfo)
+
+		require.True(t, errors.IsInvalidParameterError(err))
+		assert.Contains(t, err.Error(), "transactions[2]: duplicated idempotency key")
+	})
+
+	t.Run("should return existing transaction if idempotency key was already used with same params", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		usecase, m := newSendTxBatchUseCase(ctrl)
+		txRequests := newTxRequests()[:1]
+		requestHash, _ := generateRequestHash(chain.UUID, txRequests[0].Params)
+		existingTx := testdata.FakeTxRequest()
+		existingTx.Schedule.Jobs[0].Status = entities.StatusPending
+
+		m.searchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		m.txRequestDA.EXPECT().FindOneByIdempotencyKey(gomock.Any(), "key1", userInfo.TenantID, userInfo.Username).
+			Return(&models.TransactionRequest{RequestHash: requestHash, Schedule: &models.Schedule{UUID: existingTx.Schedule.UUID}}, nil)
+		m.getTxUC.EXPECT().Execute(gomock.Any(), existingTx.Schedule.UUID, userInfo).Return(existingTx, nil)
+		m.db.EXPECT().Begin().Return(m.dbtx, nil)
+		m.dbtx.EXPECT().Commit().Return(nil)
+
+		result, err := usecase.Execute(ctx, txRequests, false, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, existingTx, result[0])
+	})
+
+	t.Run("should rollback all transactions if a job cannot be created", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		usecase, m := newSendTxBatchUseCase(ctrl)
+		txRequests := newTxRequests()[:1]
+
+		m.searchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		m.txRequestDA.EXPECT().FindOneByIdempotencyKey(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.NotFoundError("error"))
+		m.db.EXPECT().Begin().Return(m.dbtx, nil)
+		m.scheduleDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		m.txRequestDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		m.createJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return(nil, errors.InvalidParameterError("account not found"))
+		m.dbtx.EXPECT().Rollback().Return(nil)
+
+		_, err := usecase.Execute(ctx, txRequests, false, userInfo)
+
+		require.True(t, errors.IsInvalidParameterError(err))
+		assert.Contains(t, err.Error(), "transactions[0]: account not found")
+	})
+
+	t.Run("should fail with same error if search chains fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		usecase, m := newSendTxBatchUseCase(ctrl)
+		expectedErr := errors.PostgresConnectionError("error")
+
+		m.searchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, newTxRequests(), false, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(sendTxBatchComponent), err)
+	})
+}
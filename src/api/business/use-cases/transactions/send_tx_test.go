@@ -5,7 +5,9 @@ package transactions
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/consensys/orchestrate/src/api/store/parsers"
@@ -14,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/erc20"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/entities"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
@@ -34,6 +37,7 @@ type sendTxSuite struct {
 	DBTX               *mocks2.MockTx
 	SearchChainsUC     *mocks.MockSearchChainsUseCase
 	TxRequestDA        *mocks2.MockTransactionRequestAgent
+	AccountDA          *mocks2.MockAccountAgent
 	ScheduleDA         *mocks2.MockScheduleAgent
 	StartJobUC         *mocks.MockStartJobUseCase
 	CreateJobUC        *mocks.MockCreateJobUseCase
@@ -44,6 +48,7 @@ type sendTxSuite struct {
 
 var (
 	faucetNotFoundErr = errors.NotFoundError("not found faucet candidate")
+	disabledSender    = ethcommon.HexToAddress("0x93f7274c9059e601be4512F656B57b830e019E41")
 )
 
 func TestSendTx(t *testing.T) {
@@ -59,6 +64,7 @@ func (s *sendTxSuite) SetupTest() {
 	s.DBTX = mocks2.NewMockTx(ctrl)
 	s.SearchChainsUC = mocks.NewMockSearchChainsUseCase(ctrl)
 	s.TxRequestDA = mocks2.NewMockTransactionRequestAgent(ctrl)
+	s.AccountDA = mocks2.NewMockAccountAgent(ctrl)
 	s.ScheduleDA = mocks2.NewMockScheduleAgent(ctrl)
 	s.StartJobUC = mocks.NewMockStartJobUseCase(ctrl)
 	s.CreateJobUC = mocks.NewMockCreateJobUseCase(ctrl)
@@ -68,6 +74,9 @@ func (s *sendTxSuite) SetupTest() {
 	s.DB.EXPECT().Begin().Return(s.DBTX, nil).AnyTimes()
 	s.DB.EXPECT().TransactionRequest().Return(s.TxRequestDA).AnyTimes()
 	s.DB.EXPECT().Schedule().Return(s.ScheduleDA).AnyTimes()
+	s.DB.EXPECT().Account().Return(s.AccountDA).AnyTimes()
+	s.AccountDA.EXPECT().FindOneByAddress(gomock.Any(), gomock.Not(disabledSender.Hex()), gomock.Any(), gomock.Any()).
+		Return(nil, errors.NotFoundError("account not found")).AnyTimes()
 	s.DBTX.EXPECT().Schedule().Return(s.ScheduleDA).AnyTimes()
 	s.DBTX.EXPECT().Commit().Return(nil).AnyTimes()
 	s.DBTX.EXPECT().Rollback().Return(nil).AnyTimes()
@@ -114,6 +123,52 @@ func (s *sendTxSuite) TestSendTx_Success() {
 		assert.Equal(t, txRequest.Schedule.UUID, response.Schedule.UUID)
 	})
 
+	s.T().Run("should execute send successfully a token transfer with the faucet of the token", func(t *testing.T) {
+		ctx := context.Background()
+		chains := []*entities.Chain{testdata.FakeChain()}
+		txRequest := testdata.FakeTxRequest()
+		txRequest.Schedule.UUID = scheduleUUID
+		txRequest.Schedule.Jobs[0].UUID = jobUUID
+		from, tokenAddress := *txRequest.Params.From, txRequest.Params.To
+		txData, _ := erc20.EncodeTransfer(ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"), big.NewInt(1))
+		faucet := testdata.FakeFaucet()
+		faucet.TokenAddress = tokenAddress
+		fundingData, _ := erc20.EncodeTransfer(from, faucet.Amount.ToInt())
+
+		s.SearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{txRequest.ChainName}}, s.userInfo).
+			Return(chains, nil)
+		s.TxRequestDA.EXPECT().FindOneByIdempotencyKey(gomock.Any(), txRequest.IdempotencyKey, s.userInfo.TenantID,
+			s.userInfo.Username).Return(nil, errors.NotFoundError(""))
+		s.ScheduleDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		s.ScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), txRequest.Schedule.UUID, s.userInfo.AllowedTenants, s.userInfo.Username).
+			Return(modelstestdata.FakeSchedule(s.userInfo.TenantID, s.userInfo.Username), nil)
+		s.TxRequestDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+		s.CreateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			DoAndReturn(func(_ context.Context, jobEntity *entities.Job, _ *multitenancy.UserInfo) (*entities.Job, error) {
+				jobEntity.UUID = jobUUID
+				jobEntity.Status = entities.StatusCreated
+				return jobEntity, nil
+			})
+		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), from, chains[0], nil, gomock.Any(), s.userInfo).Return(nil, faucetNotFoundErr)
+		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), from, chains[0], tokenAddress, gomock.Any(), s.userInfo).Return(faucet, nil)
+		s.CreateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			DoAndReturn(func(_ context.Context, jobEntity *entities.Job, _ *multitenancy.UserInfo) (*entities.Job, error) {
+				assert.Equal(t, faucet.CreditorAccount, *jobEntity.Transaction.From)
+				assert.Equal(t, tokenAddress, jobEntity.Transaction.To)
+				assert.Equal(t, hexutil.Bytes(fundingData), jobEntity.Transaction.Data)
+				assert.Nil(t, jobEntity.Transaction.Value)
+				jobEntity.UUID = faucet.UUID
+				return jobEntity, nil
+			})
+		s.StartJobUC.EXPECT().Execute(gomock.Any(), faucet.UUID, s.userInfo).Return(nil)
+		s.StartJobUC.EXPECT().Execute(gomock.Any(), jobUUID, s.userInfo).Return(nil)
+
+		response, err := s.usecase.Execute(ctx, txRequest, txData, s.userInfo)
+
+		require.NoError(t, err)
+		assert.Len(t, response.Schedule.Jobs, 2)
+	})
+
 	s.T().Run("should execute send successfully a EEA tx", func(t *testing.T) {
 		txRequest := testdata.FakeEEATxRequest()
 		txRequest.Schedule.UUID = scheduleUUID
@@ -169,7 +224,7 @@ func (s *sendTxSuite) TestSendTx_Success() {
 		s.TxRequestDA.EXPECT().FindOneByIdempotencyKey(gomock.Any(), txRequest.IdempotencyKey, s.userInfo.TenantID,
 			s.userInfo.Username).Return(txRequestModel, nil)
 		s.GetTxUC.EXPECT().Execute(gomock.Any(), txRequestModel.Schedule.UUID, s.userInfo).Return(txRequest, nil)
-		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), gomock.Any(), chains[0], s.userInfo).
+		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), gomock.Any(), chains[0], nil, gomock.Any(), s.userInfo).
 			Return(nil, faucetNotFoundErr)
 		s.StartJobUC.EXPECT().Execute(gomock.Any(), jobUUID, s.userInfo).Return(nil)
 		s.GetTxUC.EXPECT().Execute(gomock.Any(), txRequest.Schedule.UUID, s.userInfo).Return(txRequest, nil)
@@ -233,6 +288,40 @@ func (s *sendTxSuite) TestSendTx_ExpectedErrors() {
 	scheduleUUID := uuid.Must(uuid.NewV4()).String()
 	txData := (hexutil.Bytes)(hexutil.MustDecode("0x"))
 
+	s.T().Run("should fail with InvalidStateError if sender account is disabled", func(t *testing.T) {
+		txRequest := testdata.FakeTxRequest()
+		txRequest.Params.From = &disabledSender
+		accountModel := modelstestdata.FakeAccountModel()
+		disabledAt := time.Now()
+		accountModel.DisabledAt = &disabledAt
+
+		s.AccountDA.EXPECT().FindOneByAddress(gomock.Any(), disabledSender.Hex(), s.userInfo.AllowedTenants, s.userInfo.Username).
+			Return(accountModel, nil)
+
+		response, err := s.usecase.Execute(ctx, txRequest, txData, s.userInfo)
+		assert.Nil(t, response)
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	s.T().Run("should let a disabled account drain its balance to its successor", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+		txRequest := testdata.FakeTxRequest()
+		txRequest.Params.From = &disabledSender
+		successor := ethcommon.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4")
+		txRequest.Params.To = &successor
+		accountModel := modelstestdata.FakeAccountModel()
+		disabledAt := time.Now()
+		accountModel.DisabledAt = &disabledAt
+		accountModel.Successor = successor.Hex()
+
+		s.AccountDA.EXPECT().FindOneByAddress(gomock.Any(), disabledSender.Hex(), s.userInfo.AllowedTenants, s.userInfo.Username).
+			Return(accountModel, nil)
+		s.SearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return(nil, expectedErr)
+
+		_, err := s.usecase.Execute(ctx, txRequest, txData, s.userInfo)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(sendTxComponent), err)
+	})
+
 	s.T().Run("should fail with same error if chain agent fails", func(t *testing.T) {
 		expectedErr := fmt.Errorf("error")
 		txRequest := testdata.FakeTxRequest()
@@ -350,7 +439,7 @@ func (s *sendTxSuite) TestSendTx_ExpectedErrors() {
 		s.ScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), txRequest.Schedule.UUID, s.userInfo.AllowedTenants, s.userInfo.Username).
 			Return(scheduleModel, nil)
 		s.TxRequestDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
-		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), *txRequest.Schedule.Jobs[0].Transaction.From, chains[0], s.userInfo).
+		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), *txRequest.Schedule.Jobs[0].Transaction.From, chains[0], nil, gomock.Any(), s.userInfo).
 			Return(nil, faucetNotFoundErr)
 		s.CreateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
 			Return(txRequest.Schedule.Jobs[0], expectedErr)
@@ -379,7 +468,7 @@ func (s *sendTxSuite) TestSendTx_ExpectedErrors() {
 			Return(scheduleModel, nil)
 		s.TxRequestDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
 		s.CreateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return(txRequest.Schedule.Jobs[0], nil)
-		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), *txRequest.Schedule.Jobs[0].Transaction.From, gomock.Any(), s.userInfo).
+		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), *txRequest.Schedule.Jobs[0].Transaction.From, gomock.Any(), nil, gomock.Any(), s.userInfo).
 			Return(nil, expectedErr)
 
 		response, err := s.usecase.Execute(ctx, txRequest, txData, s.userInfo)
@@ -404,7 +493,7 @@ func (s *sendTxSuite) TestSendTx_ExpectedErrors() {
 			Return(scheduleModel, nil)
 		s.TxRequestDA.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
 		s.CreateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return(txRequest.Schedule.Jobs[0], nil)
-		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), *txRequest.Schedule.Jobs[0].Transaction.From, gomock.Any(), s.userInfo).Return(nil, faucetNotFoundErr)
+		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), *txRequest.Schedule.Jobs[0].Transaction.From, gomock.Any(), nil, gomock.Any(), s.userInfo).Return(nil, faucetNotFoundErr)
 		s.StartJobUC.EXPECT().Execute(gomock.Any(), jobUUID, s.userInfo).Return(expectedErr)
 
 		response, err := s.usecase.Execute(ctx, txRequest, txData, s.userInfo)
@@ -474,20 +563,28 @@ func successfulTestExecution(s *sendTxSuite, txRequest *entities.TxRequest, with
 	// We flag this "special" scenario as faucet funding tx flow
 	if withFaucet {
 		faucet := testdata.FakeFaucet()
-		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), *from, chains[0], s.userInfo).Return(faucet, nil)
+		var fundingJobUUID string
+		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), *from, chains[0], nil, gomock.Any(), s.userInfo).
+			DoAndReturn(func(ctx context.Context, _ ethcommon.Address, _ *entities.Chain, _ *ethcommon.Address, jobUUID string, _ *multitenancy.UserInfo) (*entities.Faucet, error) {
+				fundingJobUUID = jobUUID
+				return faucet, nil
+			})
 
 		s.CreateJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
 			DoAndReturn(func(ctx context.Context, jobEntity *entities.Job, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
 				if jobEntity.Transaction.From.String() != faucet.CreditorAccount.String() {
 					return nil, fmt.Errorf("invalid from account. Got %s, expected %s", jobEntity.Transaction.From, faucet.CreditorAccount)
 				}
+				if jobEntity.UUID != fundingJobUUID {
+					return nil, fmt.Errorf("invalid funding job UUID. Got %s, expected %s", jobEntity.UUID, fundingJobUUID)
+				}
 
 				jobEntity.UUID = faucet.UUID
 				return jobEntity, nil
 			})
 		s.StartJobUC.EXPECT().Execute(gomock.Any(), faucet.UUID, s.userInfo).Return(nil)
 	} else {
-		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), *from, chains[0], s.userInfo).Return(nil, faucetNotFoundErr)
+		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), *from, chains[0], nil, gomock.Any(), s.userInfo).Return(nil, faucetNotFoundErr)
 	}
 
 	s.StartJobUC.EXPECT().Execute(gomock.Any(), jobUUID, s.userInfo).Return(nil)
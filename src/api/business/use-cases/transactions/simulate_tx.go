@@ -0,0 +1,324 @@
+package transactions
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/abi"
+	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	proto "github.com/consensys/orchestrate/pkg/types/ethereum"
+	"github.com/consensys/orchestrate/pkg/utils"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	"github.com/consensys/orchestrate/src/infra/ethclient/rpc"
+	ethclientutils "github.com/consensys/orchestrate/src/infra/ethclient/utils"
+	txsenderstore "github.com/consensys/orchestrate/src/tx-sender/store"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/gas/oracle"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/nonce/manager"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases/crafter"
+	"github.com/ethereum/go-ethereum"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const simulateTxComponent = "use-cases.simulate-tx"
+
+const logsUnavailableWarning = "logs are not available, the chain nodes failed to trace the transaction with debug_traceCall"
+
+// simulateTxUseCase is a use case to execute a contract transaction against the latest state of a chain without sending it
+type simulateTxUseCase struct {
+	searchChainsUC      usecases.SearchChainsUseCase
+	getContractUC       usecases.GetContractUseCase
+	getContractEventsUC usecases.GetContractEventsUseCase
+	nonceReader         *nonceReader
+	ec                  ethclient.MultiClient
+	proxyURL            string
+	logger              *log.Logger
+}
+
+// NewSimulateTxUseCase creates a new SimulateTxUseCase, nonces are previewed from the last nonces sent by the tx-sender
+// kept in nonceSender, or from the chain if nonceSender is nil
+func NewSimulateTxUseCase(
+	searchChainsUC usecases.SearchChainsUseCase,
+	getContractUC usecases.GetContractUseCase,
+	getContractEventsUC usecases.GetContractEventsUseCase,
+	ec ethclient.MultiClient,
+	proxyURL string,
+	nonceSender txsenderstore.NonceSender,
+) usecases.SimulateTxUseCase {
+	nr := &nonceReader{ec: ec, proxyURL: proxyURL}
+	if nonceSender != nil {
+		nr.manager = manager.NewNonceManager(ec, nonceSender, nil, nil, proxyURL, 0, 0)
+	}
+
+	return &simulateTxUseCase{
+		searchChainsUC:      searchChainsUC,
+		getContractUC:       getContractUC,
+		getContractEventsUC: getContractEventsUC,
+		nonceReader:         nr,
+		ec:                  ec,
+		proxyURL:            proxyURL,
+		logger:              log.NewLogger().SetComponent(simulateTxComponent),
+	}
+}
+
+// Execute crafts the transaction as the tx-sender would and executes it against the latest state of the chain.
+// No job is created and nothing is sent to the chain
+func (uc *simulateTxUseCase) Execute(ctx context.Context, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TxSimulation, error) {
+	ctx = log.WithFields(
+		ctx,
+		log.Field("chain", txRequest.ChainName),
+		log.Field("method", txRequest.Params.MethodSignature),
+	)
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("simulating transaction")
+
+	// Private transactions are executed by the private transaction manager and cannot be simulated against the public state
+	if txRequest.Params.Protocol != "" {
+		errMessage := "private transactions cannot be simulated"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	chain, err := getChain(ctx, uc.searchChainsUC, txRequest.ChainName, userInfo)
+	if err != nil {
+		logger.WithError(err).Error("failed to get chain")
+		return nil, errors.FromError(err).ExtendComponent(simulateTxComponent)
+	}
+
+	contract, err := uc.getContractUC.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo)
+	if errors.IsNotFoundError(err) {
+		return nil, errors.InvalidParameterError("contract not found")
+	}
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(simulateTxComponent)
+	}
+
+	txData, err := encodeContractTxData(contract, txRequest.Params.MethodSignature, txRequest.Params.Args)
+	if err != nil {
+		logger.WithError(err).Error("failed to compute tx data from method signature and arguments")
+		return nil, errors.FromError(err).ExtendComponent(simulateTxComponent)
+	}
+
+	txRequest.Schedule = &entities.Schedule{TenantID: userInfo.TenantID, OwnerID: userInfo.Username}
+	jobs, err := parsers.NewJobEntitiesFromTxRequest(txRequest, chain.UUID, txData)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(simulateTxComponent)
+	}
+	job := jobs[0]
+
+	// End user tokens are not forwarded to the chain proxy, requests are authenticated with the API key for the tenant
+	// and still counted in its quota
+	proxyCtx := authutils.WithTenantQuota(multitenancy.WithUserInfo(ctx, &multitenancy.UserInfo{
+		AuthMode:       multitenancy.AuthMethodAPIKey,
+		TenantID:       userInfo.TenantID,
+		Username:       userInfo.Username,
+		AllowedTenants: userInfo.AllowedTenants,
+	}))
+	proxyURL := utils.GetProxyURL(uc.proxyURL, chain.UUID)
+	simulation := &entities.TxSimulation{Transaction: job.Transaction}
+	msg := newCallMsg(job.Transaction)
+
+	output, err := uc.ec.CallContract(proxyCtx, proxyURL, msg, nil)
+	switch {
+	case errors.IsEthereumError(err):
+		simulation.Revert = decodeRevert(contract, err)
+		logger.WithField("revert_reason", simulation.Revert.Reason).Info("simulated transaction reverted")
+		return simulation, nil
+	case err != nil:
+		logger.WithError(err).Error("failed to call contract")
+		return nil, errors.FromError(err).ExtendComponent(simulateTxComponent)
+	}
+
+	// Gas, fees and nonce are crafted the same way the tx-sender does, with the gas oracle of the chain
+	craftTxUC := crafter.NewCraftTransactionUseCase(uc.ec, uc.proxyURL, uc.nonceReader, oracle.New(chain.GasOracle, uc.ec, uc.proxyURL))
+	err = craftTxUC.Execute(proxyCtx, job)
+	if err != nil {
+		logger.WithError(err).Error("failed to craft transaction")
+		return nil, errors.FromError(err).ExtendComponent(simulateTxComponent)
+	}
+	simulation.Fee = computeFee(job.Transaction)
+
+	simulation.Output = output
+	method, err := contract.ABI.MethodById(txData)
+	if err == nil && len(output) > 0 {
+		simulation.DecodedOutput, err = abi.DecodeOutput(method, output)
+		if err != nil {
+			logger.WithError(err).Warn("failed to decode simulated transaction output")
+		}
+	}
+
+	trace, err := uc.traceCall(ctx, chain, msg)
+	if err != nil {
+		logger.WithError(err).Warn("failed to trace simulated transaction, logs are omitted")
+		simulation.Warning = logsUnavailableWarning
+	} else {
+		for _, l := range trace.AllLogs() {
+			simulation.Logs = append(simulation.Logs, uc.decodeLog(ctx, chain, l.Address, l.Topics, l.Data, userInfo))
+		}
+	}
+
+	logger.Info("transaction simulated successfully")
+	return simulation, nil
+}
+
+// traceCall traces the transaction on the chain nodes directly, the debug namespace is usually denied by the chain proxy
+func (uc *simulateTxUseCase) traceCall(ctx context.Context, chain *entities.Chain, msg *ethereum.CallMsg) (trace *rpc.CallTrace, err error) {
+	err = errors.InvalidStateError("chain has no node URL")
+	for _, chainURL := range chain.URLs {
+		trace, err = uc.ec.TraceCall(ctx, chainURL, msg)
+		if err == nil {
+			return trace, nil
+		}
+	}
+
+	return nil, err
+}
+
+// decodeLog decodes a log against the events registered for the emitting contract, or the default events otherwise
+func (uc *simulateTxUseCase) decodeLog(ctx context.Context, chain *entities.Chain, address ethcommon.Address, topics []ethcommon.Hash,
+	data hexutil.Bytes, userInfo *multitenancy.UserInfo) *entities.SimulatedLog {
+	logger := uc.logger.WithContext(ctx).WithField("address", address.Hex())
+	simulatedLog := &entities.SimulatedLog{Address: address, Topics: topics, Data: data}
+	if len(topics) == 0 {
+		return simulatedLog
+	}
+
+	eventABI, defaultEventsABI, err := uc.getContractEventsUC.Execute(ctx, chain.ChainID.String(), address, topics[0].Bytes(), uint32(len(topics)-1), userInfo)
+	if err != nil {
+		logger.WithError(err).Debug("could not retrieve event ABI")
+		return simulatedLog
+	}
+
+	eventsABI := defaultEventsABI
+	if eventABI != "" {
+		eventsABI = []string{eventABI}
+	}
+
+	protoLog := &proto.Log{Address: address.Hex(), Data: data.String()}
+	for _, topic := range topics {
+		protoLog.Topics = append(protoLog.Topics, topic.Hex())
+	}
+
+	for _, potentialEvent := range eventsABI {
+		event := &ethabi.Event{}
+		if err = json.Unmarshal([]byte(potentialEvent), event); err != nil {
+			continue
+		}
+
+		mapping, err := abi.Decode(event, protoLog)
+		if err != nil {
+			continue
+		}
+
+		simulatedLog.Event = eventSignature(event)
+		simulatedLog.DecodedData = mapping
+		return simulatedLog
+	}
+
+	logger.Debug("could not decode log")
+	return simulatedLog
+}
+
+// decodeRevert extracts the revert reason or the custom error returned by a reverted call
+func decodeRevert(contract *entities.Contract, err error) *entities.TxRevert {
+	callErr := errors.FromError(err)
+	txRevert := &entities.TxRevert{Reason: callErr.GetMessage()}
+
+	revertData, err := hexutil.Decode(callErr.GetExtra()[ethclientutils.RevertDataExtraKey])
+	if err != nil {
+		return txRevert
+	}
+
+	reason, err := abi.DecodeRevertReason(revertData)
+	if err == nil {
+		txRevert.Reason = reason
+		return txRevert
+	}
+
+	contractErrors, err := abi.ParseErrors(contract.RawABI)
+	if err != nil {
+		return txRevert
+	}
+
+	contractError, mapping, err := abi.DecodeError(contractErrors, revertData)
+	if err != nil {
+		return txRevert
+	}
+
+	return &entities.TxRevert{Error: &entities.DecodedCall{Signature: contractError.Sig(), Args: mapping}}
+}
+
+func newCallMsg(tx *entities.ETHTransaction) *ethereum.CallMsg {
+	msg := &ethereum.CallMsg{
+		To:   tx.To,
+		Data: tx.Data,
+	}
+	if tx.From != nil {
+		msg.From = *tx.From
+	}
+	if tx.Value != nil {
+		msg.Value = tx.Value.ToInt()
+	}
+	if tx.Gas != nil {
+		msg.Gas = *tx.Gas
+	}
+
+	return msg
+}
+
+// computeFee returns the maximum fee paid by the transaction
+func computeFee(tx *entities.ETHTransaction) *hexutil.Big {
+	gasPrice := tx.GasPrice
+	if tx.TransactionType == entities.DynamicFeeTxType {
+		gasPrice = tx.GasFeeCap
+	}
+	if gasPrice == nil || tx.Gas == nil {
+		return nil
+	}
+
+	fee := new(big.Int).Mul(gasPrice.ToInt(), new(big.Int).SetUint64(*tx.Gas))
+	return (*hexutil.Big)(fee)
+}
+
+// eventSignature formats an event as EventName(argType1,argType2)
+func eventSignature(event *ethabi.Event) string {
+	inputs := make([]string, len(event.Inputs))
+	for i := range event.Inputs {
+		inputs[i] = event.Inputs[i].Type.String()
+	}
+
+	return event.Name + "(" + strings.Join(inputs, ",") + ")"
+}
+
+// nonceReader previews the nonce the tx-sender would assign to a transaction without reserving it. The nonces of a
+// tx-sender keeping them in memory are not visible to the API, the pending nonce of the chain is used instead
+type nonceReader struct {
+	manager  *manager.Manager
+	ec       ethclient.MultiClient
+	proxyURL string
+}
+
+func (nr *nonceReader) GetNonce(ctx context.Context, job *entities.Job) (uint64, error) {
+	if nr.manager != nil {
+		return nr.manager.PeekNonce(ctx, job)
+	}
+
+	return nr.ec.PendingNonceAt(ctx, utils.GetProxyURL(nr.proxyURL, job.ChainUUID), *job.Transaction.From)
+}
+
+func (nr *nonceReader) CleanNonce(context.Context, *entities.Job, error) error {
+	return nil
+}
+
+func (nr *nonceReader) IncrementNonce(context.Context, *entities.Job) error {
+	return nil
+}
@@ -0,0 +1,190 @@
+// +build unit
+
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	"github.com/consensys/orchestrate/src/infra/ethclient/rpc"
+	txsendermock "github.com/consensys/orchestrate/src/tx-sender/store/mock"
+	ethclientutils "github.com/consensys/orchestrate/src/infra/ethclient/utils"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const transferEventABI = `{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}`
+
+func TestSimulateTx_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+	mockGetContractUC := mocks.NewMockGetContractUseCase(ctrl)
+	mockGetContractEventsUC := mocks.NewMockGetContractEventsUseCase(ctrl)
+	mockEthClient := mock.NewMockMultiClient(ctrl)
+
+	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	chain := testdata.FakeChain()
+	contract := testdata.FakeContract()
+	proxyURL := "http://api:8081"
+	chainProxyURL := fmt.Sprintf("%s/proxy/chains/%s", proxyURL, chain.UUID)
+
+	usecase := NewSimulateTxUseCase(mockSearchChainsUC, mockGetContractUC, mockGetContractEventsUC, mockEthClient, proxyURL, nil)
+
+	t.Run("should simulate transaction successfully", func(t *testing.T) {
+		txRequest := testdata.FakeTxRequest()
+		txRequest.Params.Gas = nil
+		txRequest.Params.GasPrice = nil
+		txRequest.Params.Nonce = nil
+		output := hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000001")
+		trace := &rpc.CallTrace{
+			Logs: []*rpc.CallTraceLog{{
+				Address: *txRequest.Params.To,
+				Topics: []ethcommon.Hash{
+					ethcommon.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+					ethcommon.HexToHash("0x0000000000000000000000007357589f8e367c2c31f51242fb77b350a11830f3"),
+					ethcommon.HexToHash("0x0000000000000000000000007357589f8e367c2c31f51242fb77b350a11830f2"),
+				},
+				Data: hexutil.MustDecode("0x00000000000000000000000000000000000000000000000000000000000001f4"),
+			}},
+		}
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{txRequest.ChainName}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(contract, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chainProxyURL, gomock.Any(), nil).
+			DoAndReturn(func(ctx context.Context, _ string, _ interface{}, _ interface{}) ([]byte, error) {
+				proxyUser := multitenancy.UserInfoValue(ctx)
+				require.NotNil(t, proxyUser)
+				assert.Equal(t, multitenancy.AuthMethodAPIKey, proxyUser.AuthMode)
+				assert.Equal(t, userInfo.TenantID, proxyUser.TenantID)
+				assert.Equal(t, userInfo.Username, proxyUser.Username)
+				assert.True(t, authutils.TenantQuotaFromContext(ctx), "simulations should be counted in the tenant quota")
+				return output, nil
+			})
+		mockEthClient.EXPECT().FeeHistory(gomock.Any(), chainProxyURL, 1, "latest", nil).Return(nil, fmt.Errorf("not supported"))
+		mockEthClient.EXPECT().SuggestGasPrice(gomock.Any(), chainProxyURL).Return(big.NewInt(1000), nil)
+		mockEthClient.EXPECT().EstimateGas(gomock.Any(), chainProxyURL, gomock.Any()).Return(uint64(30000), nil)
+		mockEthClient.EXPECT().PendingNonceAt(gomock.Any(), chainProxyURL, *txRequest.Params.From).Return(uint64(5), nil)
+		mockEthClient.EXPECT().TraceCall(gomock.Any(), chain.URLs[0], gomock.Any()).Return(trace, nil)
+		mockGetContractEventsUC.EXPECT().Execute(gomock.Any(), chain.ChainID.String(), *txRequest.Params.To, trace.Logs[0].Topics[0].Bytes(), uint32(2), userInfo).
+			Return(transferEventABI, nil, nil)
+
+		simulation, err := usecase.Execute(ctx, txRequest, userInfo)
+
+		require.NoError(t, err)
+		assert.False(t, simulation.Reverted())
+		assert.Equal(t, uint64(30000), *simulation.Transaction.Gas)
+		assert.Equal(t, uint64(5), *simulation.Transaction.Nonce)
+		assert.Equal(t, "0x1c9c380", simulation.Fee.String())
+		assert.Equal(t, hexutil.Bytes(output), simulation.Output)
+		assert.Equal(t, map[string]string{"0": "true"}, simulation.DecodedOutput)
+		require.Len(t, simulation.Logs, 1)
+		assert.Equal(t, "Transfer(address,address,uint256)", simulation.Logs[0].Event)
+		assert.Equal(t, map[string]string{
+			"from":  txRequest.Params.From.Hex(),
+			"to":    txRequest.Params.To.Hex(),
+			"value": "500",
+		}, simulation.Logs[0].DecodedData)
+		assert.Empty(t, simulation.Warning)
+	})
+
+	t.Run("should omit logs with a warning if the nodes cannot trace the call", func(t *testing.T) {
+		txRequest := testdata.FakeTxRequest()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).Return(contract, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chainProxyURL, gomock.Any(), nil).Return(nil, nil)
+		mockEthClient.EXPECT().TraceCall(gomock.Any(), chain.URLs[0], gomock.Any()).Return(nil, errors.EthereumError("method not found"))
+
+		simulation, err := usecase.Execute(ctx, txRequest, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, "0xc845880", simulation.Fee.String())
+		assert.Nil(t, simulation.DecodedOutput)
+		assert.Empty(t, simulation.Logs)
+		assert.Equal(t, logsUnavailableWarning, simulation.Warning)
+	})
+
+	t.Run("should preview the next nonce sent by the tx-sender", func(t *testing.T) {
+		mockNonceSender := txsendermock.NewMockNonceSender(ctrl)
+		nonceUsecase := NewSimulateTxUseCase(mockSearchChainsUC, mockGetContractUC, mockGetContractEventsUC, mockEthClient,
+			proxyURL, mockNonceSender)
+		txRequest := testdata.FakeTxRequest()
+		txRequest.Params.Nonce = nil
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).Return(contract, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chainProxyURL, gomock.Any(), nil).Return(nil, nil)
+		mockNonceSender.EXPECT().GetLastSent(gomock.Any()).Return(uint64(7), nil)
+		mockEthClient.EXPECT().TraceCall(gomock.Any(), chain.URLs[0], gomock.Any()).Return(&rpc.CallTrace{}, nil)
+
+		simulation, err := nonceUsecase.Execute(ctx, txRequest, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8), *simulation.Transaction.Nonce)
+	})
+
+	t.Run("should decode the revert reason of a reverted transaction", func(t *testing.T) {
+		txRequest := testdata.FakeTxRequest()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).Return(contract, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chainProxyURL, gomock.Any(), nil).
+			Return(nil, errors.EthereumError("execution reverted").SetExtra(ethclientutils.RevertDataExtraKey,
+				"0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000001a4e6f7420656e6f7567682045746865722070726f76696465642e000000000000"))
+
+		simulation, err := usecase.Execute(ctx, txRequest, userInfo)
+
+		require.NoError(t, err)
+		assert.True(t, simulation.Reverted())
+		assert.Equal(t, &entities.TxRevert{Reason: "Not enough Ether provided."}, simulation.Revert)
+		assert.Nil(t, simulation.Fee)
+	})
+
+	t.Run("should fail with InvalidParameterError if transaction is private", func(t *testing.T) {
+		txRequest := testdata.FakeTesseraTxRequest()
+
+		_, err := usecase.Execute(ctx, txRequest, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if contract is not found", func(t *testing.T) {
+		txRequest := testdata.FakeTxRequest()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).Return(nil, errors.NotFoundError("error"))
+
+		_, err := usecase.Execute(ctx, txRequest, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if call fails", func(t *testing.T) {
+		txRequest := testdata.FakeTxRequest()
+		expectedErr := errors.ServiceConnectionError("error")
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).Return(contract, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chainProxyURL, gomock.Any(), nil).Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, txRequest, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(simulateTxComponent), err)
+	})
+}
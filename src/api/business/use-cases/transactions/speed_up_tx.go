@@ -42,19 +42,7 @@ func (uc *speedUpTxUseCase) Execute(ctx context.Context, scheduleUUID string, ga
 		return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
 	}
 
-	if tx.Params.Protocol != "" {
-		errMsg := "speed up is not supported for private transactions"
-		logger.Error(errMsg)
-		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(sendTxComponent)
-	}
-
-	if tx.InternalData != nil && tx.InternalData.OneTimeKey {
-		errMsg := "speed up is not supported for oneTimeKey transactions"
-		logger.Error(errMsg)
-		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(sendTxComponent)
-	}
-
-	job := tx.Schedule.Jobs[0]
+	job := jobToReplace(tx)
 	err = uc.retryJobTxUC.Execute(ctx, job.UUID, gasIncrement, job.Transaction.Data, userInfo)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
@@ -68,3 +56,15 @@ func (uc *speedUpTxUseCase) Execute(ctx context.Context, scheduleUUID string, ga
 	logger.WithField("schedule", txRequest.Schedule.UUID).Info("speed-up transaction was sent successfully")
 	return txRequest, nil
 }
+
+// jobToReplace returns the job sending the transaction on chain. Private transactions are replaced through their
+// marking transaction as the private transaction itself is only stored by the private transaction manager
+func jobToReplace(tx *entities.TxRequest) *entities.Job {
+	for _, job := range tx.Schedule.Jobs {
+		if job.Type == entities.TesseraMarkingTransaction || job.Type == entities.EEAMarkingTransaction {
+			return job
+		}
+	}
+
+	return tx.Schedule.Jobs[0]
+}
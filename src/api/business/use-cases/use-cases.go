@@ -8,4 +8,5 @@ type UseCases interface {
 	FaucetUseCases
 	ChainUseCases
 	ContractUseCases
+	WebhookUseCases
 }
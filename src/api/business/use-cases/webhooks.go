@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+//go:generate mockgen -source=webhooks.go -destination=mocks/webhooks.go -package=mocks
+
+type WebhookUseCases interface {
+	CreateWebhook() CreateWebhookUseCase
+	UpdateWebhook() UpdateWebhookUseCase
+	GetWebhook() GetWebhookUseCase
+	SearchWebhooks() SearchWebhooksUseCase
+	DeleteWebhook() DeleteWebhookUseCase
+	SearchWebhookDeliveries() SearchWebhookDeliveriesUseCase
+	DeliverWebhooks() DeliverWebhooksUseCase
+}
+
+type CreateWebhookUseCase interface {
+	Execute(ctx context.Context, webhook *entities.Webhook, userInfo *multitenancy.UserInfo) (*entities.Webhook, error)
+}
+
+type UpdateWebhookUseCase interface {
+	Execute(ctx context.Context, webhook *entities.Webhook, userInfo *multitenancy.UserInfo) (*entities.Webhook, error)
+}
+
+type GetWebhookUseCase interface {
+	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.Webhook, error)
+}
+
+type SearchWebhooksUseCase interface {
+	Execute(ctx context.Context, filters *entities.WebhookFilters, userInfo *multitenancy.UserInfo) ([]*entities.Webhook, error)
+}
+
+type DeleteWebhookUseCase interface {
+	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error
+}
+
+type SearchWebhookDeliveriesUseCase interface {
+	Execute(ctx context.Context, webhookUUID string, filters *entities.WebhookDeliveryFilters, userInfo *multitenancy.UserInfo) ([]*entities.WebhookDelivery, error)
+}
+
+type NotifyWebhooksUseCase interface {
+	Execute(ctx context.Context, job *entities.Job, status entities.JobStatus, message string) error
+}
+
+type DeliverWebhooksUseCase interface {
+	Execute(ctx context.Context, limit int) (int, error)
+}
@@ -0,0 +1,40 @@
+package webhooks
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/utils"
+)
+
+// NewDeliveryClient creates an HTTP client which refuses to connect to non public IPs, so that webhooks cannot
+// target the internal network even through a host name resolving to a private address or a redirection
+func NewDeliveryClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if ip := net.ParseIP(host); ip == nil || !utils.IsPublicIP(ip) {
+				return fmt.Errorf("webhook target address %s is not public", host)
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}
+}
@@ -0,0 +1,46 @@
+package webhooks
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const createWebhookComponent = "use-cases.create-webhook"
+
+// createWebhookUseCase is a use case to create a new webhook
+type createWebhookUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewCreateWebhookUseCase creates a new CreateWebhookUseCase
+func NewCreateWebhookUseCase(db store.DB) usecases.CreateWebhookUseCase {
+	return &createWebhookUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(createWebhookComponent),
+	}
+}
+
+// Execute creates a new webhook
+func (uc *createWebhookUseCase) Execute(ctx context.Context, webhook *entities.Webhook, userInfo *multitenancy.UserInfo) (*entities.Webhook, error) {
+	ctx = log.WithFields(ctx, log.Field("url", webhook.URL))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("creating new webhook")
+
+	webhookModel := parsers.NewWebhookModelFromEntity(webhook)
+	webhookModel.TenantID = userInfo.TenantID
+	err := uc.db.Webhook().Insert(ctx, webhookModel)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createWebhookComponent)
+	}
+
+	logger.WithField("webhook", webhookModel.UUID).Info("webhook created successfully")
+	return parsers.NewWebhookFromModel(webhookModel), nil
+}
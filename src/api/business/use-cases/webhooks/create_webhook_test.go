@@ -0,0 +1,53 @@
+// +build unit
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWebhook_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	webhookAgent := mocks.NewMockWebhookAgent(ctrl)
+	mockDB.EXPECT().Webhook().Return(webhookAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewCreateWebhookUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		webhook := testdata.FakeWebhook()
+		webhookModel := parsers.NewWebhookModelFromEntity(webhook)
+		webhookModel.TenantID = userInfo.TenantID
+
+		webhookAgent.EXPECT().Insert(gomock.Any(), webhookModel).Return(nil)
+
+		resp, err := usecase.Execute(ctx, webhook, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, parsers.NewWebhookFromModel(webhookModel), resp)
+	})
+
+	t.Run("should fail with same error if insert webhook fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+
+		webhookAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		resp, err := usecase.Execute(ctx, testdata.FakeWebhook(), userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createWebhookComponent), err)
+	})
+}
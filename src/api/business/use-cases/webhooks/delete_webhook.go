@@ -0,0 +1,44 @@
+package webhooks
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+)
+
+const deleteWebhookComponent = "use-cases.delete-webhook"
+
+type deleteWebhookUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+func NewDeleteWebhookUseCase(db store.DB) usecases.DeleteWebhookUseCase {
+	return &deleteWebhookUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(deleteWebhookComponent),
+	}
+}
+
+func (uc *deleteWebhookUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("webhook", uuid))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("deleting webhook")
+
+	webhookModel, err := uc.db.Webhook().FindOneByUUID(ctx, uuid, userInfo.AllowedTenants)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteWebhookComponent)
+	}
+
+	err = uc.db.Webhook().Delete(ctx, webhookModel, userInfo.AllowedTenants)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteWebhookComponent)
+	}
+
+	logger.Info("webhook was deleted successfully")
+	return nil
+}
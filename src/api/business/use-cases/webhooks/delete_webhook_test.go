@@ -0,0 +1,60 @@
+// +build unit
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteWebhook_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	webhookAgent := mocks.NewMockWebhookAgent(ctrl)
+	mockDB.EXPECT().Webhook().Return(webhookAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewDeleteWebhookUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		webhookModel := testdata.FakeWebhookModel()
+
+		webhookAgent.EXPECT().FindOneByUUID(gomock.Any(), "uuid", userInfo.AllowedTenants).Return(webhookModel, nil)
+		webhookAgent.EXPECT().Delete(gomock.Any(), webhookModel, userInfo.AllowedTenants).Return(nil)
+
+		err := usecase.Execute(ctx, "uuid", userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if findOne webhook fails", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+
+		webhookAgent.EXPECT().FindOneByUUID(gomock.Any(), "uuid", userInfo.AllowedTenants).Return(nil, expectedErr)
+
+		err := usecase.Execute(ctx, "uuid", userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(deleteWebhookComponent), err)
+	})
+
+	t.Run("should fail with same error if delete webhook fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+
+		webhookAgent.EXPECT().FindOneByUUID(gomock.Any(), "uuid", userInfo.AllowedTenants).Return(testdata.FakeWebhookModel(), nil)
+		webhookAgent.EXPECT().Delete(gomock.Any(), gomock.Any(), userInfo.AllowedTenants).Return(expectedErr)
+
+		err := usecase.Execute(ctx, "uuid", userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(deleteWebhookComponent), err)
+	})
+}
@@ -0,0 +1,170 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/database"
+)
+
+const deliverWebhooksComponent = "use-cases.deliver-webhooks"
+
+// deliverWebhooksUseCase is a use case to send the pending webhook deliveries
+type deliverWebhooksUseCase struct {
+	db            store.DB
+	client        *http.Client
+	maxAttempts   int
+	minRetryDelay time.Duration
+	maxRetryDelay time.Duration
+	logger        *log.Logger
+}
+
+// NewDeliverWebhooksUseCase creates a new DeliverWebhooksUseCase, failed attempts are retried after a delay doubling
+// from minRetryDelay up to maxRetryDelay until maxAttempts is reached
+func NewDeliverWebhooksUseCase(db store.DB, client *http.Client, maxAttempts int, minRetryDelay, maxRetryDelay time.Duration) usecases.DeliverWebhooksUseCase {
+	return &deliverWebhooksUseCase{
+		db:            db,
+		client:        client,
+		maxAttempts:   maxAttempts,
+		minRetryDelay: minRetryDelay,
+		maxRetryDelay: maxRetryDelay,
+		logger:        log.NewLogger().SetComponent(deliverWebhooksComponent),
+	}
+}
+
+// Execute attempts at most limit pending deliveries and returns the number of deliveries attempted.
+// Deliveries are claimed until the HTTP client timeout expires so that they are attempted again if the API stops
+// before recording the result
+func (uc *deliverWebhooksUseCase) Execute(ctx context.Context, limit int) (int, error) {
+	logger := uc.logger.WithContext(ctx)
+	now := time.Now().UTC()
+	claimedUntil := now.Add(2 * uc.client.Timeout)
+
+	var deliveries []*models.WebhookDelivery
+	err := database.ExecuteInDBTx(uc.db, func(tx database.Tx) error {
+		var der error
+		deliveries, der = tx.(store.Tx).WebhookDelivery().LockPending(ctx, now, limit)
+		if der != nil {
+			return der
+		}
+
+		for _, delivery := range deliveries {
+			delivery.Attempts++
+			delivery.NextAttemptAt = &claimedUntil
+			if der = tx.(store.Tx).WebhookDelivery().Update(ctx, delivery); der != nil {
+				return der
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to lock pending webhook deliveries")
+		return 0, errors.FromError(err).ExtendComponent(deliverWebhooksComponent)
+	}
+
+	wg := &sync.WaitGroup{}
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *models.WebhookDelivery) {
+			defer wg.Done()
+			uc.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+func (uc *deliverWebhooksUseCase) deliver(ctx context.Context, delivery *models.WebhookDelivery) {
+	logger := uc.logger.WithContext(ctx).WithField("webhook", delivery.WebhookUUID).WithField("delivery", delivery.UUID)
+
+	retry, err := uc.send(ctx, delivery)
+	switch {
+	case err == nil:
+		logger.Debug("webhook delivered successfully")
+		delivery.State = string(entities.WebhookDeliveryDelivered)
+		delivery.Error = ""
+		delivery.NextAttemptAt = nil
+	case retry && delivery.Attempts < uc.maxAttempts:
+		logger.WithError(err).WithField("attempts", delivery.Attempts).Debug("webhook delivery failed, retrying later")
+		nextAttemptAt := time.Now().UTC().Add(uc.retryDelay(delivery.Attempts))
+		delivery.Error = err.Error()
+		delivery.NextAttemptAt = &nextAttemptAt
+	default:
+		logger.WithError(err).Warn("failed to deliver webhook")
+		delivery.State = string(entities.WebhookDeliveryFailed)
+		delivery.Error = err.Error()
+		delivery.NextAttemptAt = nil
+	}
+
+	err = uc.db.WebhookDelivery().Update(ctx, delivery)
+	if err != nil {
+		logger.WithError(err).Error("failed to update webhook delivery")
+	}
+}
+
+// send posts the delivery payload to the webhook URL and indicates whether a failed attempt can be retried
+func (uc *deliverWebhooksUseCase) send(ctx context.Context, delivery *models.WebhookDelivery) (bool, error) {
+	webhook, err := uc.db.Webhook().FindOneByUUID(ctx, delivery.WebhookUUID, []string{multitenancy.WildcardTenant})
+	if errors.IsNotFoundError(err) {
+		return false, fmt.Errorf("webhook was deleted")
+	}
+	if err != nil {
+		return true, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, "sha256="+Sign(webhook.Secret, delivery.Payload))
+	req.Header.Set(EventHeader, delivery.JobStatus)
+	req.Header.Set(DeliveryHeader, delivery.UUID)
+
+	resp, err := uc.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	delivery.ResponseStatus = resp.StatusCode
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+
+	err = fmt.Errorf("webhook endpoint responded with status %d", resp.StatusCode)
+	// Client errors will not be fixed by retrying, except rate limiting
+	if resp.StatusCode >= http.StatusBadRequest && resp.StatusCode < http.StatusInternalServerError &&
+		resp.StatusCode != http.StatusTooManyRequests {
+		return false, err
+	}
+
+	return true, err
+}
+
+func (uc *deliverWebhooksUseCase) retryDelay(attempts int) time.Duration {
+	delay := uc.minRetryDelay
+	for i := 1; i < attempts && delay < uc.maxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > uc.maxRetryDelay {
+		return uc.maxRetryDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,187 @@
+// +build unit
+
+package webhooks
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliverWebhooks_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockTx(ctrl)
+	webhookAgent := mocks.NewMockWebhookAgent(ctrl)
+	deliveryAgent := mocks.NewMockWebhookDeliveryAgent(ctrl)
+
+	mockDB.EXPECT().Begin().Return(mockDBTX, nil).AnyTimes()
+	mockDB.EXPECT().Webhook().Return(webhookAgent).AnyTimes()
+	mockDB.EXPECT().WebhookDelivery().Return(deliveryAgent).AnyTimes()
+	mockDBTX.EXPECT().WebhookDelivery().Return(deliveryAgent).AnyTimes()
+	mockDBTX.EXPECT().Commit().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Rollback().Return(nil).AnyTimes()
+	mockDBTX.EXPECT().Close().Return(nil).AnyTimes()
+
+	client := &http.Client{Timeout: time.Second}
+	usecase := NewDeliverWebhooksUseCase(mockDB, client, 3, time.Second, 2*time.Second)
+
+	newDelivery := func(webhookModel *models.Webhook, attempts int) *models.WebhookDelivery {
+		return &models.WebhookDelivery{
+			UUID:        "deliveryUUID",
+			WebhookUUID: webhookModel.UUID,
+			JobStatus:   string(entities.StatusMined),
+			State:       string(entities.WebhookDeliveryPending),
+			Attempts:    attempts,
+			Payload:     []byte(`{"status":"MINED"}`),
+		}
+	}
+
+	// expectDelivery expects the delivery to be claimed and returns the delivery recorded after the attempt
+	expectDelivery := func(webhookModel *models.Webhook, delivery *models.WebhookDelivery) chan *models.WebhookDelivery {
+		updated := make(chan *models.WebhookDelivery, 1)
+		deliveryAgent.EXPECT().LockPending(gomock.Any(), gomock.Any(), 10).Return([]*models.WebhookDelivery{delivery}, nil)
+		deliveryAgent.EXPECT().Update(gomock.Any(), delivery).Return(nil)
+		webhookAgent.EXPECT().FindOneByUUID(gomock.Any(), webhookModel.UUID, gomock.Any()).Return(webhookModel, nil)
+		deliveryAgent.EXPECT().Update(gomock.Any(), delivery).DoAndReturn(func(_ context.Context, delivery *models.WebhookDelivery) error {
+			updated <- delivery
+			return nil
+		})
+		return updated
+	}
+
+	t.Run("should deliver signed payload to webhook", func(t *testing.T) {
+		received := make(chan *http.Request, 1)
+		webhookModel := testdata.FakeWebhookModel()
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.Equal(t, "sha256="+Sign(webhookModel.Secret, body), req.Header.Get(SignatureHeader))
+			received <- req
+			rw.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		webhookModel.URL = server.URL
+		updated := expectDelivery(webhookModel, newDelivery(webhookModel, 0))
+
+		n, err := usecase.Execute(ctx, 10)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, n)
+		req := <-received
+		assert.Equal(t, string(entities.StatusMined), req.Header.Get(EventHeader))
+		assert.Equal(t, "deliveryUUID", req.Header.Get(DeliveryHeader))
+		delivery := <-updated
+		assert.Equal(t, string(entities.WebhookDeliveryDelivered), delivery.State)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, http.StatusNoContent, delivery.ResponseStatus)
+		assert.Nil(t, delivery.NextAttemptAt)
+	})
+
+	t.Run("should reschedule delivery on server errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		webhookModel := testdata.FakeWebhookModel()
+		webhookModel.URL = server.URL
+		updated := expectDelivery(webhookModel, newDelivery(webhookModel, 0))
+
+		_, err := usecase.Execute(ctx, 10)
+		require.NoError(t, err)
+
+		delivery := <-updated
+		assert.Equal(t, string(entities.WebhookDeliveryPending), delivery.State)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, http.StatusBadGateway, delivery.ResponseStatus)
+		require.NotNil(t, delivery.NextAttemptAt)
+		assert.True(t, delivery.NextAttemptAt.After(time.Now()))
+	})
+
+	t.Run("should mark delivery as failed when max attempts is reached", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		webhookModel := testdata.FakeWebhookModel()
+		webhookModel.URL = server.URL
+		updated := expectDelivery(webhookModel, newDelivery(webhookModel, 2))
+
+		_, err := usecase.Execute(ctx, 10)
+		require.NoError(t, err)
+
+		delivery := <-updated
+		assert.Equal(t, string(entities.WebhookDeliveryFailed), delivery.State)
+		assert.Equal(t, 3, delivery.Attempts)
+		assert.Nil(t, delivery.NextAttemptAt)
+	})
+
+	t.Run("should not retry on client errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		webhookModel := testdata.FakeWebhookModel()
+		webhookModel.URL = server.URL
+		updated := expectDelivery(webhookModel, newDelivery(webhookModel, 0))
+
+		_, err := usecase.Execute(ctx, 10)
+		require.NoError(t, err)
+
+		delivery := <-updated
+		assert.Equal(t, string(entities.WebhookDeliveryFailed), delivery.State)
+		assert.Equal(t, 1, delivery.Attempts)
+	})
+
+	t.Run("should mark delivery as failed if webhook was deleted", func(t *testing.T) {
+		webhookModel := testdata.FakeWebhookModel()
+		delivery := newDelivery(webhookModel, 0)
+		deliveryAgent.EXPECT().LockPending(gomock.Any(), gomock.Any(), 10).Return([]*models.WebhookDelivery{delivery}, nil)
+		deliveryAgent.EXPECT().Update(gomock.Any(), delivery).Return(nil).Times(2)
+		webhookAgent.EXPECT().FindOneByUUID(gomock.Any(), webhookModel.UUID, gomock.Any()).Return(nil, errors.NotFoundError("error"))
+
+		_, err := usecase.Execute(ctx, 10)
+		require.NoError(t, err)
+
+		assert.Equal(t, string(entities.WebhookDeliveryFailed), delivery.State)
+	})
+
+	t.Run("should fail with same error if lock pending deliveries fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+		deliveryAgent.EXPECT().LockPending(gomock.Any(), gomock.Any(), 10).Return(nil, expectedErr)
+
+		n, err := usecase.Execute(ctx, 10)
+
+		assert.Equal(t, 0, n)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(deliverWebhooksComponent), err)
+	})
+}
+
+func TestNewDeliveryClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	_, err := NewDeliveryClient(time.Second).Get(server.URL)
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,43 @@
+package webhooks
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const getWebhookComponent = "use-cases.get-webhook"
+
+// getWebhookUseCase is a use case to get a webhook
+type getWebhookUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewGetWebhookUseCase creates a new GetWebhookUseCase
+func NewGetWebhookUseCase(db store.DB) usecases.GetWebhookUseCase {
+	return &getWebhookUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(getWebhookComponent),
+	}
+}
+
+// Execute gets a webhook
+func (uc *getWebhookUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.Webhook, error) {
+	ctx = log.WithFields(ctx, log.Field("webhook", uuid))
+	logger := uc.logger.WithContext(ctx)
+
+	webhookModel, err := uc.db.Webhook().FindOneByUUID(ctx, uuid, userInfo.AllowedTenants)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(getWebhookComponent)
+	}
+
+	logger.Debug("webhook found successfully")
+	return parsers.NewWebhookFromModel(webhookModel), nil
+}
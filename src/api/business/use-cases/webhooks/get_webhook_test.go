@@ -0,0 +1,51 @@
+// +build unit
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWebhook_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	webhookAgent := mocks.NewMockWebhookAgent(ctrl)
+	mockDB.EXPECT().Webhook().Return(webhookAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewGetWebhookUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		webhookModel := testdata.FakeWebhookModel()
+
+		webhookAgent.EXPECT().FindOneByUUID(gomock.Any(), webhookModel.UUID, userInfo.AllowedTenants).Return(webhookModel, nil)
+
+		resp, err := usecase.Execute(ctx, webhookModel.UUID, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, parsers.NewWebhookFromModel(webhookModel), resp)
+	})
+
+	t.Run("should fail with same error if findOne webhook fails", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+
+		webhookAgent.EXPECT().FindOneByUUID(gomock.Any(), "uuid", userInfo.AllowedTenants).Return(nil, expectedErr)
+
+		resp, err := usecase.Execute(ctx, "uuid", userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(getWebhookComponent), err)
+	})
+}
@@ -0,0 +1,140 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/gofrs/uuid"
+)
+
+const notifyWebhooksComponent = "use-cases.notify-webhooks"
+
+const (
+	SignatureHeader = "X-Orchestrate-Signature"
+	EventHeader     = "X-Orchestrate-Event"
+	DeliveryHeader  = "X-Orchestrate-Delivery"
+)
+
+type webhookEvent struct {
+	UUID         string                   `json:"uuid"`
+	WebhookUUID  string                   `json:"webhookUUID"`
+	Status       entities.JobStatus       `json:"status"`
+	Message      string                   `json:"message,omitempty"`
+	JobUUID      string                   `json:"jobUUID"`
+	ScheduleUUID string                   `json:"scheduleUUID"`
+	ChainUUID    string                   `json:"chainUUID"`
+	Labels       map[string]string        `json:"labels,omitempty"`
+	Transaction  *entities.ETHTransaction `json:"transaction,omitempty"`
+	CreatedAt    time.Time                `json:"createdAt"`
+}
+
+// notifyWebhooksUseCase is a use case to record the delivery of job status changes to the webhooks of the job tenant
+type notifyWebhooksUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewNotifyWebhooksUseCase creates a new NotifyWebhooksUseCase
+func NewNotifyWebhooksUseCase(db store.DB) usecases.NotifyWebhooksUseCase {
+	return &notifyWebhooksUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(notifyWebhooksComponent),
+	}
+}
+
+// Execute records a pending delivery for every webhook matching the job, deliveries are sent by DeliverWebhooksUseCase
+func (uc *notifyWebhooksUseCase) Execute(ctx context.Context, job *entities.Job, status entities.JobStatus, message string) error {
+	ctx = log.WithFields(ctx, log.Field("job", job.UUID), log.Field("status", status))
+	logger := uc.logger.WithContext(ctx)
+
+	webhookModels, err := uc.db.Webhook().Search(ctx, &entities.WebhookFilters{}, []string{job.TenantID})
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(notifyWebhooksComponent)
+	}
+
+	for _, webhookModel := range webhookModels {
+		if !matchWebhook(webhookModel, job, status) {
+			continue
+		}
+
+		now := time.Now().UTC()
+		deliveryModel := &models.WebhookDelivery{
+			UUID:          uuid.Must(uuid.NewV4()).String(),
+			WebhookUUID:   webhookModel.UUID,
+			JobUUID:       job.UUID,
+			JobStatus:     string(status),
+			State:         string(entities.WebhookDeliveryPending),
+			NextAttemptAt: &now,
+			CreatedAt:     now,
+		}
+
+		deliveryModel.Payload, err = json.Marshal(&webhookEvent{
+			UUID:         deliveryModel.UUID,
+			WebhookUUID:  webhookModel.UUID,
+			Status:       status,
+			Message:      message,
+			JobUUID:      job.UUID,
+			ScheduleUUID: job.ScheduleUUID,
+			ChainUUID:    job.ChainUUID,
+			Labels:       job.Labels,
+			Transaction:  job.Transaction,
+			CreatedAt:    deliveryModel.CreatedAt,
+		})
+		if err != nil {
+			return errors.EncodingError("failed to marshal webhook event: %v", err).ExtendComponent(notifyWebhooksComponent)
+		}
+
+		err = uc.db.WebhookDelivery().Insert(ctx, deliveryModel)
+		if err != nil {
+			return errors.FromError(err).ExtendComponent(notifyWebhooksComponent)
+		}
+
+		logger.WithField("webhook", webhookModel.UUID).WithField("delivery", deliveryModel.UUID).Debug("webhook delivery scheduled")
+	}
+
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the payload sent in the signature header
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func matchWebhook(webhook *models.Webhook, job *entities.Job, status entities.JobStatus) bool {
+	if webhook.ChainUUID != "" && webhook.ChainUUID != job.ChainUUID {
+		return false
+	}
+
+	if len(webhook.Statuses) > 0 {
+		found := false
+		for _, s := range webhook.Statuses {
+			if s == string(status) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for key, value := range webhook.Labels {
+		if job.Labels[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,111 @@
+// +build unit
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	testdata2 "github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifyWebhooks_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	webhookAgent := mocks.NewMockWebhookAgent(ctrl)
+	deliveryAgent := mocks.NewMockWebhookDeliveryAgent(ctrl)
+	mockDB.EXPECT().Webhook().Return(webhookAgent).AnyTimes()
+	mockDB.EXPECT().WebhookDelivery().Return(deliveryAgent).AnyTimes()
+
+	usecase := NewNotifyWebhooksUseCase(mockDB)
+
+	newJob := func(webhookModel *models.Webhook) *entities.Job {
+		job := testdata2.FakeJob()
+		job.TenantID = webhookModel.TenantID
+		job.ChainUUID = webhookModel.ChainUUID
+		job.Labels = webhookModel.Labels
+		return job
+	}
+
+	t.Run("should record pending delivery for matching webhook", func(t *testing.T) {
+		webhookModel := testdata.FakeWebhookModel()
+		job := newJob(webhookModel)
+		webhookAgent.EXPECT().Search(gomock.Any(), gomock.Any(), []string{job.TenantID}).Return([]*models.Webhook{webhookModel}, nil)
+		deliveryAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, delivery *models.WebhookDelivery) error {
+			assert.NotEmpty(t, delivery.UUID)
+			assert.Equal(t, webhookModel.UUID, delivery.WebhookUUID)
+			assert.Equal(t, job.UUID, delivery.JobUUID)
+			assert.Equal(t, string(entities.StatusMined), delivery.JobStatus)
+			assert.Equal(t, string(entities.WebhookDeliveryPending), delivery.State)
+			assert.NotNil(t, delivery.NextAttemptAt)
+
+			event := &webhookEvent{}
+			require.NoError(t, json.Unmarshal(delivery.Payload, event))
+			assert.Equal(t, delivery.UUID, event.UUID)
+			assert.Equal(t, entities.StatusMined, event.Status)
+			assert.Equal(t, job.UUID, event.JobUUID)
+			return nil
+		})
+
+		err := usecase.Execute(ctx, job, entities.StatusMined, "")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should skip webhooks not matching the job", func(t *testing.T) {
+		webhookModel := testdata.FakeWebhookModel()
+		job := newJob(webhookModel)
+		job.Labels = map[string]string{"app": "other"}
+		webhookAgent.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*models.Webhook{webhookModel}, nil)
+
+		err := usecase.Execute(ctx, job, entities.StatusMined, "")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if search webhooks fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+		webhookAgent.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		err := usecase.Execute(ctx, testdata2.FakeJob(), entities.StatusMined, "")
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(notifyWebhooksComponent), err)
+	})
+
+	t.Run("should fail with same error if insert delivery fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+		webhookModel := testdata.FakeWebhookModel()
+		webhookAgent.EXPECT().Search(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*models.Webhook{webhookModel}, nil)
+		deliveryAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		err := usecase.Execute(ctx, newJob(webhookModel), entities.StatusMined, "")
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(notifyWebhooksComponent), err)
+	})
+}
+
+func TestMatchWebhook(t *testing.T) {
+	webhookModel := testdata.FakeWebhookModel()
+	job := testdata2.FakeJob()
+	job.ChainUUID = webhookModel.ChainUUID
+	job.Labels = map[string]string{"app": "payments", "env": "prod"}
+
+	assert.True(t, matchWebhook(webhookModel, job, entities.StatusMined))
+	assert.False(t, matchWebhook(webhookModel, job, entities.StatusPending))
+	assert.True(t, matchWebhook(&models.Webhook{}, job, entities.StatusPending))
+
+	job.ChainUUID = "otherChain"
+	assert.False(t, matchWebhook(webhookModel, job, entities.StatusMined))
+}
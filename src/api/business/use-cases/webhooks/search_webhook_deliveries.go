@@ -0,0 +1,54 @@
+package webhooks
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const searchWebhookDeliveriesComponent = "use-cases.search-webhook-deliveries"
+
+// searchWebhookDeliveriesUseCase is a use case to get the delivery log of a webhook
+type searchWebhookDeliveriesUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewSearchWebhookDeliveriesUseCase creates a new SearchWebhookDeliveriesUseCase
+func NewSearchWebhookDeliveriesUseCase(db store.DB) usecases.SearchWebhookDeliveriesUseCase {
+	return &searchWebhookDeliveriesUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(searchWebhookDeliveriesComponent),
+	}
+}
+
+// Execute search the latest deliveries of a webhook
+func (uc *searchWebhookDeliveriesUseCase) Execute(ctx context.Context, webhookUUID string, filters *entities.WebhookDeliveryFilters,
+	userInfo *multitenancy.UserInfo) ([]*entities.WebhookDelivery, error) {
+	ctx = log.WithFields(ctx, log.Field("webhook", webhookUUID))
+
+	// Ensures the webhook belongs to the allowed tenants
+	_, err := uc.db.Webhook().FindOneByUUID(ctx, webhookUUID, userInfo.AllowedTenants)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchWebhookDeliveriesComponent)
+	}
+
+	deliveryModels, err := uc.db.WebhookDelivery().Search(ctx, webhookUUID, filters)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchWebhookDeliveriesComponent)
+	}
+
+	var deliveries []*entities.WebhookDelivery
+	for _, deliveryModel := range deliveryModels {
+		deliveries = append(deliveries, parsers.NewWebhookDeliveryFromModel(deliveryModel))
+	}
+
+	uc.logger.WithContext(ctx).Debug("webhook deliveries found successfully")
+	return deliveries, nil
+}
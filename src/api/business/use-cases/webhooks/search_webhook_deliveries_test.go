@@ -0,0 +1,70 @@
+// +build unit
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchWebhookDeliveries_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	webhookAgent := mocks.NewMockWebhookAgent(ctrl)
+	deliveryAgent := mocks.NewMockWebhookDeliveryAgent(ctrl)
+	mockDB.EXPECT().Webhook().Return(webhookAgent).AnyTimes()
+	mockDB.EXPECT().WebhookDelivery().Return(deliveryAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewSearchWebhookDeliveriesUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		webhookModel := testdata.FakeWebhookModel()
+		deliveryModel := testdata.FakeWebhookDeliveryModel(webhookModel.UUID)
+		filters := &entities.WebhookDeliveryFilters{State: entities.WebhookDeliveryFailed}
+
+		webhookAgent.EXPECT().FindOneByUUID(gomock.Any(), webhookModel.UUID, userInfo.AllowedTenants).Return(webhookModel, nil)
+		deliveryAgent.EXPECT().Search(gomock.Any(), webhookModel.UUID, filters).Return([]*models.WebhookDelivery{deliveryModel}, nil)
+
+		resp, err := usecase.Execute(ctx, webhookModel.UUID, filters, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entities.WebhookDelivery{parsers.NewWebhookDeliveryFromModel(deliveryModel)}, resp)
+	})
+
+	t.Run("should fail with same error if findOne webhook fails", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+
+		webhookAgent.EXPECT().FindOneByUUID(gomock.Any(), "uuid", userInfo.AllowedTenants).Return(nil, expectedErr)
+
+		resp, err := usecase.Execute(ctx, "uuid", &entities.WebhookDeliveryFilters{}, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(searchWebhookDeliveriesComponent), err)
+	})
+
+	t.Run("should fail with same error if search deliveries fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+
+		webhookAgent.EXPECT().FindOneByUUID(gomock.Any(), "uuid", userInfo.AllowedTenants).Return(testdata.FakeWebhookModel(), nil)
+		deliveryAgent.EXPECT().Search(gomock.Any(), "uuid", gomock.Any()).Return(nil, expectedErr)
+
+		resp, err := usecase.Execute(ctx, "uuid", &entities.WebhookDeliveryFilters{}, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(searchWebhookDeliveriesComponent), err)
+	})
+}
@@ -0,0 +1,45 @@
+package webhooks
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const searchWebhooksComponent = "use-cases.search-webhooks"
+
+// searchWebhooksUseCase is a use case to search webhooks
+type searchWebhooksUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewSearchWebhooksUseCase creates a new SearchWebhooksUseCase
+func NewSearchWebhooksUseCase(db store.DB) usecases.SearchWebhooksUseCase {
+	return &searchWebhooksUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(searchWebhooksComponent),
+	}
+}
+
+// Execute search webhooks
+func (uc *searchWebhooksUseCase) Execute(ctx context.Context, filters *entities.WebhookFilters, userInfo *multitenancy.UserInfo) ([]*entities.Webhook, error) {
+	webhookModels, err := uc.db.Webhook().Search(ctx, filters, userInfo.AllowedTenants)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchWebhooksComponent)
+	}
+
+	var webhooks []*entities.Webhook
+	for _, webhookModel := range webhookModels {
+		webhooks = append(webhooks, parsers.NewWebhookFromModel(webhookModel))
+	}
+
+	uc.logger.Debug("webhooks found successfully")
+	return webhooks, nil
+}
@@ -0,0 +1,54 @@
+// +build unit
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchWebhooks_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	webhookAgent := mocks.NewMockWebhookAgent(ctrl)
+	mockDB.EXPECT().Webhook().Return(webhookAgent).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewSearchWebhooksUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		webhookModel := testdata.FakeWebhookModel()
+		filters := &entities.WebhookFilters{TenantID: "tenantOne"}
+
+		webhookAgent.EXPECT().Search(gomock.Any(), filters, userInfo.AllowedTenants).Return([]*models.Webhook{webhookModel}, nil)
+
+		resp, err := usecase.Execute(ctx, filters, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entities.Webhook{parsers.NewWebhookFromModel(webhookModel)}, resp)
+	})
+
+	t.Run("should fail with same error if search webhooks fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+
+		webhookAgent.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants).Return(nil, expectedErr)
+
+		resp, err := usecase.Execute(ctx, &entities.WebhookFilters{}, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(searchWebhooksComponent), err)
+	})
+}
@@ -0,0 +1,50 @@
+package webhooks
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const updateWebhookComponent = "use-cases.update-webhook"
+
+// updateWebhookUseCase is a use case to update a webhook
+type updateWebhookUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+// NewUpdateWebhookUseCase creates a new UpdateWebhookUseCase
+func NewUpdateWebhookUseCase(db store.DB) usecases.UpdateWebhookUseCase {
+	return &updateWebhookUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(updateWebhookComponent),
+	}
+}
+
+// Execute updates a webhook
+func (uc *updateWebhookUseCase) Execute(ctx context.Context, webhook *entities.Webhook, userInfo *multitenancy.UserInfo) (*entities.Webhook, error) {
+	ctx = log.WithFields(ctx, log.Field("webhook", webhook.UUID))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("updating webhook")
+
+	webhookModel := parsers.NewWebhookModelFromEntity(webhook)
+	err := uc.db.Webhook().Update(ctx, webhookModel, userInfo.AllowedTenants)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(updateWebhookComponent)
+	}
+
+	webhookRetrieved, err := uc.db.Webhook().FindOneByUUID(ctx, webhook.UUID, userInfo.AllowedTenants)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(updateWebhookComponent)
+	}
+
+	logger.Info("webhook updated successfully")
+	return parsers.NewWebhookFromModel(webhookRetrieved), nil
+}
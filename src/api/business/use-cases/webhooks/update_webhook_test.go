@@ -0,0 +1,63 @@
+// +build unit
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateWebhook_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	webhookAgent := mocks.NewMockWebhookAgent(ctrl)
+	mockDB.EXPECT().Webhook().Return(webhookAgent).AnyTimes()
+	webhook := testdata.FakeWebhook()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewUpdateWebhookUseCase(mockDB)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		webhookModel := parsers.NewWebhookModelFromEntity(webhook)
+		webhookAgent.EXPECT().Update(gomock.Any(), webhookModel, userInfo.AllowedTenants).Return(nil)
+		webhookAgent.EXPECT().FindOneByUUID(gomock.Any(), webhook.UUID, userInfo.AllowedTenants).Return(webhookModel, nil)
+
+		resp, err := usecase.Execute(ctx, webhook, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, parsers.NewWebhookFromModel(webhookModel), resp)
+	})
+
+	t.Run("should fail with same error if update webhook fails", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+
+		webhookAgent.EXPECT().Update(gomock.Any(), gomock.Any(), userInfo.AllowedTenants).Return(expectedErr)
+
+		resp, err := usecase.Execute(ctx, webhook, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(updateWebhookComponent), err)
+	})
+
+	t.Run("should fail with same error if findOne webhook fails", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+
+		webhookAgent.EXPECT().Update(gomock.Any(), gomock.Any(), userInfo.AllowedTenants).Return(nil)
+		webhookAgent.EXPECT().FindOneByUUID(gomock.Any(), webhook.UUID, userInfo.AllowedTenants).Return(nil, expectedErr)
+
+		resp, err := usecase.Execute(ctx, webhook, userInfo)
+
+		assert.Nil(t, resp)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(updateWebhookComponent), err)
+	})
+}
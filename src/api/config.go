@@ -1,6 +1,10 @@
 package api
 
 import (
+	"fmt"
+
+	"github.com/consensys/orchestrate/cmd/flags"
+	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 	authjwt "github.com/consensys/orchestrate/pkg/toolkit/app/auth/jwt/jose"
 	authkey "github.com/consensys/orchestrate/pkg/toolkit/app/auth/key"
@@ -9,15 +13,35 @@ import (
 	metricregistry "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/registry"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	tcpmetrics "github.com/consensys/orchestrate/pkg/toolkit/tcp/metrics"
+	"github.com/consensys/orchestrate/src/api/consumer"
 	"github.com/consensys/orchestrate/src/api/metrics"
+	"github.com/consensys/orchestrate/src/api/notifier"
 	"github.com/consensys/orchestrate/src/api/proxy"
+	"github.com/consensys/orchestrate/src/api/scheduler"
 	store "github.com/consensys/orchestrate/src/api/store/multi"
 	broker "github.com/consensys/orchestrate/src/infra/broker/sarama"
+	"github.com/consensys/orchestrate/src/infra/keystore"
 	qkm "github.com/consensys/orchestrate/src/infra/quorum-key-manager"
+	"github.com/consensys/orchestrate/src/infra/redis/redigo"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
+func init() {
+	viper.SetDefault(nonceManagerTypeViperKey, nonceManagerTypeDefault)
+	_ = viper.BindEnv(nonceManagerTypeViperKey, nonceManagerTypeEnv)
+}
+
+const (
+	nonceManagerTypeFlag     = "nonce-manager-type"
+	nonceManagerTypeViperKey = "api.nonce.manager.type"
+	nonceManagerTypeDefault  = NonceManagerTypeInMemory
+	nonceManagerTypeEnv      = "NONCE_MANAGER_TYPE"
+
+	NonceManagerTypeInMemory = "in-memory"
+	NonceManagerTypeRedis    = "redis"
+)
+
 // Flags register flags for API
 func Flags(f *pflag.FlagSet) {
 	log.Flags(f)
@@ -26,12 +50,29 @@ func Flags(f *pflag.FlagSet) {
 	authkey.Flags(f)
 	broker.KafkaProducerFlags(f)
 	broker.KafkaTopicTxSender(f)
+	broker.KafkaTopicTxDecoded(f)
+	broker.KafkaTopicTxRequest(f)
+	broker.KafkaTopicTxRecover(f)
 	qkm.Flags(f)
+	keystore.Flags(f)
 	store.Flags(f)
 	app.Flags(f)
 	app.MetricFlags(f)
 	metricregistry.Flags(f, httpmetrics.ModuleName, tcpmetrics.ModuleName, metrics.ModuleName)
 	proxy.Flags(f)
+	scheduler.Flags(f)
+	notifier.Flags(f)
+	consumer.Flags(f)
+	orchestrateclient.URL(f)
+	flags.RedisFlags(f)
+	nonceManagerType(f)
+}
+
+func nonceManagerType(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Type of Nonce manager cache of the tx-sender (one of %q), transaction simulations preview nonces from the Redis cache shared with the tx-sender or from the chain otherwise.
+Environment variable: %q`, []string{NonceManagerTypeInMemory, NonceManagerTypeRedis}, nonceManagerTypeEnv)
+	f.String(nonceManagerTypeFlag, nonceManagerTypeDefault, desc)
+	_ = viper.BindPFlag(nonceManagerTypeViperKey, f.Lookup(nonceManagerTypeFlag))
 }
 
 type Config struct {
@@ -39,6 +80,14 @@ type Config struct {
 	Store        *store.Config
 	Multitenancy bool
 	Proxy        *proxy.Config
+	Scheduler    *scheduler.Config
+	Notifier     *notifier.Config
+	Consumer     *consumer.Config
+	// ProxyURL is the URL of the API used to reach the chain proxy, e.g. to simulate transactions
+	ProxyURL string
+	// NonceManagerType and RedisCfg locate the nonces sent by the tx-sender
+	NonceManagerType string
+	RedisCfg         *redigo.Config
 }
 
 func NewConfig(vipr *viper.Viper) *Config {
@@ -47,5 +96,12 @@ func NewConfig(vipr *viper.Viper) *Config {
 		Store:        store.NewConfig(vipr),
 		Multitenancy: viper.GetBool(multitenancy.EnabledViperKey),
 		Proxy:        proxy.NewConfig(),
+		Scheduler:    scheduler.NewConfig(vipr),
+		Notifier:     notifier.NewConfig(vipr),
+		Consumer:     consumer.NewConfig(vipr),
+		ProxyURL:     vipr.GetString(orchestrateclient.URLViperKey),
+
+		NonceManagerType: vipr.GetString(nonceManagerTypeViperKey),
+		RedisCfg:         flags.NewRedisConfig(vipr),
 	}
 }
@@ -0,0 +1,96 @@
+package consumer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	enabledFlag     = "api-tx-request-consumer-enabled"
+	enabledViperKey = "api.tx-request-consumer.enabled"
+	enabledDefault  = false
+	enabledEnv      = "API_TX_REQUEST_CONSUMER_ENABLED"
+)
+
+const (
+	groupNameFlag     = "api-tx-request-consumer-group-name"
+	groupNameViperKey = "api.tx-request-consumer.group-name"
+	groupNameDefault  = "group-api"
+	groupNameEnv      = "API_TX_REQUEST_CONSUMER_GROUP_NAME"
+)
+
+const (
+	recoverEnabledFlag     = "api-tx-recover-consumer-enabled"
+	recoverEnabledViperKey = "api.tx-recover-consumer.enabled"
+	recoverEnabledDefault  = false
+	recoverEnabledEnv      = "API_TX_RECOVER_CONSUMER_ENABLED"
+)
+
+const (
+	recoverGroupNameFlag     = "api-tx-recover-consumer-group-name"
+	recoverGroupNameViperKey = "api.tx-recover-consumer.group-name"
+	recoverGroupNameDefault  = "group-api-recover"
+	recoverGroupNameEnv      = "API_TX_RECOVER_CONSUMER_GROUP_NAME"
+)
+
+func init() {
+	viper.SetDefault(enabledViperKey, enabledDefault)
+	_ = viper.BindEnv(enabledViperKey, enabledEnv)
+
+	viper.SetDefault(groupNameViperKey, groupNameDefault)
+	_ = viper.BindEnv(groupNameViperKey, groupNameEnv)
+
+	viper.SetDefault(recoverEnabledViperKey, recoverEnabledDefault)
+	_ = viper.BindEnv(recoverEnabledViperKey, recoverEnabledEnv)
+
+	viper.SetDefault(recoverGroupNameViperKey, recoverGroupNameDefault)
+	_ = viper.BindEnv(recoverGroupNameViperKey, recoverGroupNameEnv)
+}
+
+// Flags register flags for the consumers of transaction requests and of jobs failed by the tx-sender
+func Flags(f *pflag.FlagSet) {
+	enabledDesc := fmt.Sprintf(`Whether the API consumes transaction requests from Kafka. Environment variable: %q`, enabledEnv)
+	f.Bool(enabledFlag, enabledDefault, enabledDesc)
+	_ = viper.BindPFlag(enabledViperKey, f.Lookup(enabledFlag))
+
+	groupNameDesc := fmt.Sprintf(`Kafka consumer group of the API consuming transaction requests. Environment variable: %q`, groupNameEnv)
+	f.String(groupNameFlag, groupNameDefault, groupNameDesc)
+	_ = viper.BindPFlag(groupNameViperKey, f.Lookup(groupNameFlag))
+
+	recoverEnabledDesc := fmt.Sprintf(`Whether the API stores the jobs failed by the tx-sender so they can be replayed. Environment variable: %q`, recoverEnabledEnv)
+	f.Bool(recoverEnabledFlag, recoverEnabledDefault, recoverEnabledDesc)
+	_ = viper.BindPFlag(recoverEnabledViperKey, f.Lookup(recoverEnabledFlag))
+
+	recoverGroupNameDesc := fmt.Sprintf(`Kafka consumer group of the API consuming failed jobs. Environment variable: %q`, recoverGroupNameEnv)
+	f.String(recoverGroupNameFlag, recoverGroupNameDefault, recoverGroupNameDesc)
+	_ = viper.BindPFlag(recoverGroupNameViperKey, f.Lookup(recoverGroupNameFlag))
+}
+
+type Config struct {
+	Enabled          bool
+	GroupName        string
+	RecoverEnabled   bool
+	RecoverGroupName string
+	BckOff           backoff.BackOff
+}
+
+func NewConfig(vipr *viper.Viper) *Config {
+	return &Config{
+		Enabled:          vipr.GetBool(enabledViperKey),
+		GroupName:        vipr.GetString(groupNameViperKey),
+		RecoverEnabled:   vipr.GetBool(recoverEnabledViperKey),
+		RecoverGroupName: vipr.GetString(recoverGroupNameViperKey),
+		BckOff:           retryMessageBackOff(),
+	}
+}
+
+func retryMessageBackOff() backoff.BackOff {
+	bckOff := backoff.NewExponentialBackOff()
+	bckOff.MaxInterval = time.Second * 15
+	bckOff.MaxElapsedTime = time.Minute
+	return bckOff
+}
@@ -0,0 +1,122 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+)
+
+const consumerComponent = "api.consumer"
+
+// Consumer is the daemon consuming a topic with one of the listeners of the API
+type Consumer struct {
+	consumerGroup sarama.ConsumerGroup
+	topic         string
+	listener      sarama.ConsumerGroupHandler
+	logger        *log.Logger
+}
+
+func New(consumerGroup sarama.ConsumerGroup, topic string, listener sarama.ConsumerGroupHandler) *Consumer {
+	return &Consumer{
+		consumerGroup: consumerGroup,
+		topic:         topic,
+		listener:      listener,
+		logger:        log.NewLogger().SetComponent(consumerComponent),
+	}
+}
+
+func (c *Consumer) Run(ctx context.Context) error {
+	c.logger.WithField("topic", c.topic).Info("consumer started")
+
+	// We retry after consume exits to prevent entire stack to exit after kafka rebalance is triggered
+	return backoff.RetryNotify(
+		func() error {
+			err := c.consumerGroup.Consume(ctx, []string{c.topic}, c.listener)
+
+			// In this case, kafka rebalance was triggered and we want to retry
+			if err == nil && ctx.Err() == nil {
+				return fmt.Errorf("kafka rebalance was triggered")
+			}
+
+			return backoff.Permanent(err)
+		},
+		backoff.NewConstantBackOff(time.Millisecond*500),
+		func(err error, duration time.Duration) {
+			c.logger.WithError(err).Warnf("consuming session exited, retrying in %s", duration.String())
+		},
+	)
+}
+
+func (c *Consumer) Close() error {
+	return c.consumerGroup.Close()
+}
+
+// consumeClaim processes the messages of a claim until the session ends. Messages are retried on connection errors until
+// they are processed, so the partition is paused rather than the consumer stopped, and committed once processed
+func consumeClaim(
+	session sarama.ConsumerGroupSession,
+	claim sarama.ConsumerGroupClaim,
+	retryBackOff backoff.BackOff,
+	logger *log.Logger,
+	processMessage func(ctx context.Context, msg *sarama.ConsumerMessage) error,
+) error {
+	ctx := session.Context()
+	logger = logger.WithContext(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.WithField("reason", ctx.Err().Error()).Info("gracefully stopping listener...")
+			return nil
+		case msg, ok := <-claim.Messages():
+			// Input channel has been close so we leave the loop
+			if !ok {
+				return nil
+			}
+
+			for {
+				err := backoff.RetryNotify(
+					func() error {
+						err := processMessage(ctx, msg)
+						switch {
+						case err == nil:
+							return nil
+						case ctx.Err() != nil:
+							return backoff.Permanent(ctx.Err())
+						case errors.IsConnectionError(err):
+							return err
+						default:
+							return backoff.Permanent(err)
+						}
+					},
+					backoff.WithContext(retryBackOff, ctx),
+					func(err error, duration time.Duration) {
+						logger.WithError(err).Warnf("error processing message, retrying in %v...", duration)
+					},
+				)
+				if err == nil {
+					break
+				}
+				if ctx.Err() != nil {
+					logger.WithField("reason", ctx.Err().Error()).Info("gracefully stopping listener...")
+					return nil
+				}
+				if !errors.IsConnectionError(err) {
+					logger.WithError(err).WithField("offset", msg.Offset).Error("error processing message, message ignored")
+					break
+				}
+
+				// The back off restarts so the message is processed once the dependencies are reachable again
+				logger.WithError(err).WithField("offset", msg.Offset).Error("error processing message, retrying")
+			}
+
+			session.MarkMessage(msg, "")
+			session.Commit()
+		}
+	}
+}
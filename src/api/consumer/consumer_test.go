@@ -0,0 +1,87 @@
+// +build unit
+
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/cenkalti/backoff/v4"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/infra/broker/sarama/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsumeClaim(t *testing.T) {
+	logger := log.NewLogger()
+	bckOff := func() backoff.BackOff {
+		return backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Millisecond), 2)
+	}
+
+	t.Run("should keep retrying connection errors once the back off expires", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		session := mock.NewConsumerGroupSession(ctx, "group", nil)
+		claim := mock.NewConsumerGroupClaim("topic", 0, 0)
+
+		attempts := 0
+		done := make(chan error)
+		go func() {
+			done <- consumeClaim(session, claim, bckOff(), logger, func(_ context.Context, _ *sarama.ConsumerMessage) error {
+				attempts++
+				if attempts < 10 {
+					return errors.KafkaConnectionError("error")
+				}
+				cancel()
+				return nil
+			})
+		}()
+		claim.ExpectMessage(&sarama.ConsumerMessage{Topic: "topic", Offset: 0})
+
+		require.NoError(t, <-done)
+		assert.Equal(t, 10, attempts)
+	})
+
+	t.Run("should stop retrying when the session ends", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+		defer cancel()
+		session := mock.NewConsumerGroupSession(ctx, "group", nil)
+		claim := mock.NewConsumerGroupClaim("topic", 0, 0)
+
+		done := make(chan error)
+		go func() {
+			done <- consumeClaim(session, claim, bckOff(), logger, func(_ context.Context, _ *sarama.ConsumerMessage) error {
+				return errors.KafkaConnectionError("error")
+			})
+		}()
+		claim.ExpectMessage(&sarama.ConsumerMessage{Topic: "topic", Offset: 0})
+
+		assert.NoError(t, <-done)
+		require.NotNil(t, session.LastMarkedOffset("topic", 0))
+		assert.Equal(t, int64(0), session.LastMarkedOffset("topic", 0).Offset)
+	})
+
+	t.Run("should ignore messages failing with other errors", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+		defer cancel()
+		session := mock.NewConsumerGroupSession(ctx, "group", nil)
+		claim := mock.NewConsumerGroupClaim("topic", 0, 0)
+
+		done := make(chan error)
+		go func() {
+			done <- consumeClaim(session, claim, bckOff(), logger, func(_ context.Context, _ *sarama.ConsumerMessage) error {
+				return fmt.Errorf("error")
+			})
+		}()
+		claim.ExpectMessage(&sarama.ConsumerMessage{Topic: "topic", Offset: 0})
+
+		assert.NoError(t, <-done)
+		require.NotNil(t, session.LastMarkedOffset("topic", 0))
+		assert.Equal(t, int64(1), session.LastMarkedOffset("topic", 0).Offset)
+	})
+}
@@ -0,0 +1,330 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	"github.com/cenkalti/backoff/v4"
+	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/auth"
+	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	ierror "github.com/consensys/orchestrate/pkg/types/error"
+	"github.com/consensys/orchestrate/pkg/types/tx"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/pkg/utils/envelope"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/service/controllers"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const txRequestListenerComponent = "api.consumer.tx-request"
+
+// TxRequestListener consumes the transaction requests published on Kafka and sends them as the REST API would.
+// Requests which cannot be sent are reported on the decoded topic with their errors
+type TxRequestListener struct {
+	txUCs        usecases.TransactionUseCases
+	checker      auth.Checker
+	multitenancy bool
+	producer     sarama.SyncProducer
+	decodedTopic string
+	retryBackOff backoff.BackOff
+	logger       *log.Logger
+}
+
+func NewTxRequestListener(
+	txUCs usecases.TransactionUseCases,
+	jwt, key auth.Checker,
+	multitenancyEnabled bool,
+	producer sarama.SyncProducer,
+	decodedTopic string,
+	bck backoff.BackOff,
+) *TxRequestListener {
+	return &TxRequestListener{
+		txUCs:        txUCs,
+		checker:      auth.NewCombineCheckers(key, jwt),
+		multitenancy: multitenancyEnabled,
+		producer:     producer,
+		decodedTopic: decodedTopic,
+		retryBackOff: bck,
+		logger:       log.NewLogger().SetComponent(txRequestListenerComponent),
+	}
+}
+
+func (l *TxRequestListener) Setup(session sarama.ConsumerGroupSession) error {
+	l.logger.WithContext(session.Context()).
+		WithField("kafka.generation_id", session.GenerationID()).
+		WithField("kafka.member_id", session.MemberID()).
+		WithField("claims", session.Claims()).
+		Info("ready to consume transaction requests")
+
+	return nil
+}
+
+func (l *TxRequestListener) Cleanup(session sarama.ConsumerGroupSession) error {
+	l.logger.WithContext(session.Context()).Info("all claims consumed")
+	return nil
+}
+
+func (l *TxRequestListener) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	return consumeClaim(session, claim, l.retryBackOff, l.logger, l.processMessage)
+}
+
+// processMessage sends the transaction request of a message. Only connection errors are returned, other errors are
+// reported on the decoded topic
+func (l *TxRequestListener) processMessage(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	txReqMsg := &tx.TxRequest{}
+	if err := encoding.Unmarshal(msg.Value, txReqMsg); err != nil {
+		// Messages which cannot be decoded cannot be answered either
+		l.logger.WithContext(ctx).WithError(err).WithField("offset", msg.Offset).Error("failed to decode transaction request, message ignored")
+		return nil
+	}
+
+	logger := l.logger.WithContext(ctx).WithField("request_id", txReqMsg.GetId())
+	ctx = log.With(ctx, logger)
+	logger.WithField("timestamp", msg.Timestamp).Debug("transaction request consumed")
+
+	evlp, err := txReqMsg.Envelope()
+	if err != nil {
+		logger.WithError(err).Error("invalid transaction request")
+		return l.sendErrorResponse(ctx, txReqMsg, err)
+	}
+
+	userInfo, err := l.authenticate(ctx, evlp)
+	if err != nil {
+		logger.WithError(err).Error("unauthorized transaction request")
+		return l.sendErrorResponse(ctx, txReqMsg, err)
+	}
+
+	txRequest, err := l.sendTx(multitenancy.WithUserInfo(ctx, userInfo), evlp, txReqMsg.GetMethod(), userInfo)
+	if err != nil && errors.IsConnectionError(err) {
+		return err
+	}
+	if err != nil {
+		logger.WithError(err).Error("failed to send transaction request")
+		return l.sendErrorResponse(ctx, txReqMsg, err)
+	}
+
+	logger.WithField("schedule", txRequest.Schedule.UUID).Info("transaction request sent successfully")
+	return nil
+}
+
+func (l *TxRequestListener) authenticate(ctx context.Context, evlp *tx.Envelope) (*multitenancy.UserInfo, error) {
+	if !l.multitenancy {
+		return multitenancy.DefaultUser(), nil
+	}
+
+	authCtx := authutils.WithAuthorization(ctx, evlp.GetHeadersValue(authutils.AuthorizationHeader))
+	authCtx = authutils.WithAPIKey(authCtx, evlp.GetHeadersValue(authutils.APIKeyHeader))
+	authCtx = authutils.WithTenantID(authCtx, evlp.GetHeadersValue(authutils.TenantIDHeader))
+	authCtx = authutils.WithUsername(authCtx, evlp.GetHeadersValue(authutils.UsernameHeader))
+
+	userInfo, err := l.checker.Check(authCtx)
+	if err != nil {
+		return nil, err
+	}
+	if userInfo == nil {
+		return nil, errors.UnauthorizedError("missing required credentials")
+	}
+
+	return userInfo, nil
+}
+
+func (l *TxRequestListener) sendTx(ctx context.Context, evlp *tx.Envelope, method tx.Method, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+	txRequest := newTxRequest(evlp, method)
+	if err := validateTxRequest(evlp, txRequest); err != nil {
+		return nil, errors.InvalidParameterError("%s", err.Error())
+	}
+
+	switch {
+	case len(txRequest.Params.Raw) > 0:
+		return l.txUCs.SendTransaction().Execute(ctx, txRequest, nil, userInfo)
+	case evlp.IsContractCreation() && txRequest.Params.ContractName != "":
+		return l.txUCs.SendDeployTransaction().Execute(ctx, txRequest, userInfo)
+	case txRequest.Params.MethodSignature != "":
+		return l.txUCs.SendContractTransaction().Execute(ctx, txRequest, userInfo)
+	default:
+		return l.txUCs.SendTransaction().Execute(ctx, txRequest, evlp.GetData(), userInfo)
+	}
+}
+
+func (l *TxRequestListener) sendErrorResponse(ctx context.Context, txReqMsg *tx.TxRequest, err error) error {
+	logger := l.logger.WithContext(ctx).WithField("topic", l.decodedTopic)
+
+	txResponse := &tx.TxResponse{
+		Id:            txReqMsg.GetId(),
+		ContextLabels: txReqMsg.GetContextLabels(),
+		Chain:         txReqMsg.GetChain(),
+		Errors:        []*ierror.Error{errors.FromError(err).ExtendComponent(txRequestListenerComponent)},
+	}
+
+	b, err := encoding.Marshal(txResponse)
+	if err != nil {
+		errMessage := "failed to marshal transaction response"
+		logger.WithError(err).Error(errMessage)
+		return errors.EncodingError(errMessage).ExtendComponent(txRequestListenerComponent)
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: l.decodedTopic,
+		Value: sarama.ByteEncoder(b),
+	}
+	if txReqMsg.GetId() != "" {
+		msg.Key = sarama.StringEncoder(txReqMsg.GetId())
+	}
+
+	if _, _, err = l.producer.SendMessage(msg); err != nil {
+		errMessage := "failed to produce kafka message"
+		logger.WithError(err).Error(errMessage)
+		return errors.KafkaConnectionError(errMessage).ExtendComponent(txRequestListenerComponent)
+	}
+
+	logger.Debug("transaction request failure reported")
+	return nil
+}
+
+// newTxRequest maps a transaction request message as the REST API formatters do. The idempotency key is taken from the
+// headers and defaults to the ID of the request so redelivered messages do not create new transactions
+func newTxRequest(evlp *tx.Envelope, method tx.Method) *entities.TxRequest {
+	idempotencyKey := evlp.GetHeadersValue(controllers.IdempotencyKeyHeader)
+	if idempotencyKey == "" {
+		idempotencyKey = evlp.GetID()
+	}
+
+	priority := evlp.GetContextLabelsValue(tx.PriorityLabel)
+	if priority == "" {
+		priority = utils.PriorityMedium
+	}
+
+	// Responses published for the jobs of the request echo its ID
+	labels := evlp.GetContextLabels()
+	if evlp.GetID() != "" {
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[tx.RequestIDLabel] = evlp.GetID()
+	}
+
+	txRequest := &entities.TxRequest{
+		IdempotencyKey: idempotencyKey,
+		ChainName:      evlp.GetChainName(),
+		Labels:         labels,
+		InternalData: &entities.InternalData{
+			Priority: priority,
+			// Messages do not carry internal labels, the signature with a one-time key is requested by a context label
+			OneTimeKey: evlp.IsOneTimeKeySignature() || evlp.GetContextLabelsValue(tx.TxFromLabel) == tx.TxFromOneTimeKey,
+		},
+	}
+
+	if raw := evlp.GetRaw(); len(raw) > 0 {
+		txRequest.Params = &entities.ETHTransactionParams{Raw: raw}
+		return txRequest
+	}
+
+	var args []interface{}
+	for _, arg := range evlp.GetArgs() {
+		args = append(args, arg)
+	}
+
+	contractTag := evlp.ContractTag
+	if evlp.ContractName != "" && contractTag == "" {
+		contractTag = entities.DefaultTagValue
+	}
+
+	txRequest.Params = &entities.ETHTransactionParams{
+		From:            evlp.GetFrom(),
+		To:              evlp.GetTo(),
+		Value:           evlp.GetValue(),
+		Gas:             evlp.GetGas(),
+		GasPrice:        evlp.GetGasPrice(),
+		GasFeeCap:       evlp.GetGasFeeCap(),
+		GasTipCap:       evlp.GetGasTipCap(),
+		AccessList:      envelope.ConvertToAccessList(evlp.GetAccessList()),
+		TransactionType: evlp.GetTransactionType(),
+		MethodSignature: evlp.GetMethodSignature(),
+		Args:            args,
+		ContractName:    evlp.ContractName,
+		ContractTag:     contractTag,
+		Protocol:        protocol(method),
+		PrivateFrom:     evlp.GetPrivateFrom(),
+		PrivateFor:      evlp.GetPrivateFor(),
+		MandatoryFor:    evlp.GetMandatoryFor(),
+		PrivacyGroupID:  evlp.GetPrivacyGroupID(),
+		PrivacyFlag:     evlp.GetPrivacyFlag(),
+	}
+
+	return txRequest
+}
+
+// validateTxRequest applies the validation of the REST request matching the transaction request
+func validateTxRequest(evlp *tx.Envelope, txRequest *entities.TxRequest) error {
+	params := txRequest.Params
+	oneTimeKey := txRequest.InternalData.OneTimeKey
+
+	switch {
+	case len(params.Raw) > 0:
+		return nil
+	case evlp.IsContractCreation() && params.ContractName != "":
+		deployParams := &types.DeployContractParams{
+			Value:           params.Value,
+			Gas:             params.Gas,
+			GasPrice:        params.GasPrice,
+			GasFeeCap:       params.GasFeeCap,
+			GasTipCap:       params.GasTipCap,
+			AccessList:      params.AccessList,
+			TransactionType: params.TransactionType,
+			From:            params.From,
+			ContractName:    params.ContractName,
+			ContractTag:     params.ContractTag,
+			Args:            params.Args,
+			OneTimeKey:      oneTimeKey,
+			Protocol:        params.Protocol,
+			PrivateFrom:     params.PrivateFrom,
+			PrivateFor:      params.PrivateFor,
+			MandatoryFor:    params.MandatoryFor,
+			PrivacyGroupID:  params.PrivacyGroupID,
+			PrivacyFlag:     int(params.PrivacyFlag),
+		}
+		return deployParams.Validate()
+	case params.MethodSignature != "":
+		contractParams := &types.TransactionParams{
+			Value:           params.Value,
+			Gas:             params.Gas,
+			GasPrice:        params.GasPrice,
+			GasFeeCap:       params.GasFeeCap,
+			GasTipCap:       params.GasTipCap,
+			AccessList:      params.AccessList,
+			TransactionType: params.TransactionType,
+			From:            params.From,
+			To:              params.To,
+			MethodSignature: params.MethodSignature,
+			Args:            params.Args,
+			OneTimeKey:      oneTimeKey,
+			Protocol:        params.Protocol,
+			PrivateFrom:     params.PrivateFrom,
+			PrivateFor:      params.PrivateFor,
+			MandatoryFor:    params.MandatoryFor,
+			PrivacyGroupID:  params.PrivacyGroupID,
+			PrivacyFlag:     params.PrivacyFlag,
+			ContractName:    params.ContractName,
+			ContractTag:     params.ContractTag,
+		}
+		return contractParams.Validate()
+	default:
+		return types.ValidateTxParams(params, oneTimeKey)
+	}
+}
+
+func protocol(method tx.Method) entities.PrivateTxManagerType {
+	switch method {
+	case tx.Method_ETH_SENDRAWPRIVATETRANSACTION:
+		return entities.TesseraChainType
+	case tx.Method_EEA_SENDPRIVATETRANSACTION:
+		return entities.EEAChainType
+	default:
+		return ""
+	}
+}
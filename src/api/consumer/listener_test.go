@@ -0,0 +1,344 @@
+// +build unit
+
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/cenkalti/backoff/v4"
+	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
+	"github.com/consensys/orchestrate/pkg/errors"
+	mockauth "github.com/consensys/orchestrate/pkg/toolkit/app/auth/mock"
+	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/tx"
+	"github.com/consensys/orchestrate/pkg/utils"
+	ucmocks "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/service/controllers"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	requestID    = "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"
+	decodedTopic = "topic-tx-decoded"
+	from         = "0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"
+	to           = "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+)
+
+func TestTxRequestListener(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txUCs := ucmocks.NewMockTransactionUseCases(ctrl)
+	sendContractTxUC := ucmocks.NewMockSendContractTxUseCase(ctrl)
+	sendDeployTxUC := ucmocks.NewMockSendDeployTxUseCase(ctrl)
+	sendTxUC := ucmocks.NewMockSendTxUseCase(ctrl)
+	txUCs.EXPECT().SendContractTransaction().Return(sendContractTxUC).AnyTimes()
+	txUCs.EXPECT().SendDeployTransaction().Return(sendDeployTxUC).AnyTimes()
+	txUCs.EXPECT().SendTransaction().Return(sendTxUC).AnyTimes()
+
+	jwtChecker := mockauth.NewMockChecker(ctrl)
+	keyChecker := mockauth.NewMockChecker(ctrl)
+	producer := mocks.NewSyncProducer(t, nil)
+	defer func() { _ = producer.Close() }()
+
+	listener := NewTxRequestListener(txUCs, jwtChecker, keyChecker, true, producer, decodedTopic, &backoff.StopBackOff{})
+	userInfo := multitenancy.NewUserInfo("tenantOne", "")
+	ctx := context.Background()
+
+	t.Run("should send a contract transaction authenticated with the API key", func(t *testing.T) {
+		msg := newMessage(t, &tx.TxRequest{
+			Id:      requestID,
+			Chain:   "besu",
+			Headers: map[string]string{authutils.APIKeyHeader: "api-key", authutils.TenantIDHeader: "tenantOne"},
+			Params: &tx.Params{
+				From:            from,
+				To:              to,
+				Contract:        "ERC20[v1.0.0]",
+				MethodSignature: "transfer(address,uint256)",
+				Args:            []string{to, "0x1"},
+			},
+			ContextLabels: map[string]string{"correlationID": "myID"},
+		})
+
+		keyChecker.EXPECT().Check(gomock.Any()).DoAndReturn(func(ctx context.Context) (*multitenancy.UserInfo, error) {
+			assert.Equal(t, "api-key", authutils.APIKeyFromContext(ctx))
+			assert.Equal(t, "tenantOne", authutils.TenantIDFromContext(ctx))
+			return userInfo, nil
+		})
+		sendContractTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).
+			DoAndReturn(func(ctx context.Context, txRequest *entities.TxRequest, _ *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, requestID, txRequest.IdempotencyKey)
+				assert.Equal(t, "besu", txRequest.ChainName)
+				assert.Equal(t, map[string]string{"correlationID": "myID", tx.RequestIDLabel: requestID}, txRequest.Labels)
+				assert.Equal(t, utils.PriorityMedium, txRequest.InternalData.Priority)
+				assert.Equal(t, from, txRequest.Params.From.Hex())
+				assert.Equal(t, to, txRequest.Params.To.Hex())
+				assert.Equal(t, "ERC20", txRequest.Params.ContractName)
+				assert.Equal(t, "v1.0.0", txRequest.Params.ContractTag)
+				assert.Equal(t, []interface{}{to, "0x1"}, txRequest.Params.Args)
+				return testdata.FakeTxRequest(), nil
+			})
+
+		err := listener.processMessage(ctx, msg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should send a contract deployment using the idempotency key of the headers", func(t *testing.T) {
+		msg := newMessage(t, &tx.TxRequest{
+			Id:    requestID,
+			Chain: "besu",
+			Headers: map[string]string{
+				authutils.AuthorizationHeader:    "Bearer token",
+				controllers.IdempotencyKeyHeader: "myKey",
+			},
+			Params: &tx.Params{
+				From:     from,
+				Contract: "ERC20",
+				Args:     []string{"0x1"},
+			},
+			ContextLabels: map[string]string{tx.PriorityLabel: utils.PriorityHigh},
+		})
+
+		keyChecker.EXPECT().Check(gomock.Any()).Return(nil, nil)
+		jwtChecker.EXPECT().Check(gomock.Any()).Return(userInfo, nil)
+		sendDeployTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).
+			DoAndReturn(func(ctx context.Context, txRequest *entities.TxRequest, _ *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, "myKey", txRequest.IdempotencyKey)
+				assert.Equal(t, utils.PriorityHigh, txRequest.InternalData.Priority)
+				assert.Equal(t, "ERC20", txRequest.Params.ContractName)
+				assert.Equal(t, entities.DefaultTagValue, txRequest.Params.ContractTag)
+				return testdata.FakeTxRequest(), nil
+			})
+
+		err := listener.processMessage(ctx, msg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should send a contract transaction signed with a one-time key", func(t *testing.T) {
+		msg := newMessage(t, &tx.TxRequest{
+			Id:      requestID,
+			Chain:   "besu",
+			Headers: map[string]string{authutils.APIKeyHeader: "api-key"},
+			Params: &tx.Params{
+				To:              to,
+				Contract:        "ERC20",
+				MethodSignature: "transfer(address,uint256)",
+				Args:            []string{to, "0x1"},
+			},
+			ContextLabels: map[string]string{tx.TxFromLabel: tx.TxFromOneTimeKey},
+		})
+
+		keyChecker.EXPECT().Check(gomock.Any()).Return(userInfo, nil)
+		sendContractTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).
+			DoAndReturn(func(ctx context.Context, txRequest *entities.TxRequest, _ *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.True(t, txRequest.InternalData.OneTimeKey)
+				assert.Nil(t, txRequest.Params.From)
+				return testdata.FakeTxRequest(), nil
+			})
+
+		err := listener.processMessage(ctx, msg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should send a transaction with its data", func(t *testing.T) {
+		msg := newMessage(t, &tx.TxRequest{
+			Id:      requestID,
+			Chain:   "besu",
+			Method:  tx.Method_ETH_SENDRAWPRIVATETRANSACTION,
+			Headers: map[string]string{authutils.APIKeyHeader: "api-key"},
+			Params: &tx.Params{
+				From:        from,
+				To:          to,
+				Data:        "0xa9059cbb",
+				PrivateFrom: "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=",
+				PrivateFor:  []string{"B1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="},
+			},
+		})
+
+		keyChecker.EXPECT().Check(gomock.Any()).Return(userInfo, nil)
+		sendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).
+			DoAndReturn(func(ctx context.Context, txRequest *entities.TxRequest, txData []byte, _ *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, "0xa9059cbb", fmt.Sprintf("%#x", txData))
+				assert.Equal(t, entities.TesseraChainType, txRequest.Params.Protocol)
+				assert.Equal(t, "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=", txRequest.Params.PrivateFrom)
+				return testdata.FakeTxRequest(), nil
+			})
+
+		err := listener.processMessage(ctx, msg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should report requests without credentials on the decoded topic", func(t *testing.T) {
+		msg := newMessage(t, &tx.TxRequest{
+			Id:            requestID,
+			Chain:         "besu",
+			Params:        &tx.Params{From: from, To: to},
+			ContextLabels: map[string]string{"correlationID": "myID"},
+		})
+
+		keyChecker.EXPECT().Check(gomock.Any()).Return(nil, nil)
+		jwtChecker.EXPECT().Check(gomock.Any()).Return(nil, nil)
+		producer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(b []byte) error {
+			txResponse := &tx.TxResponse{}
+			require.NoError(t, encoding.Unmarshal(b, txResponse))
+			assert.Equal(t, requestID, txResponse.GetId())
+			assert.Equal(t, "besu", txResponse.GetChain())
+			assert.Equal(t, map[string]string{"correlationID": "myID"}, txResponse.GetContextLabels())
+			require.Len(t, txResponse.GetErrors(), 1)
+			assert.True(t, errors.IsUnauthorizedError(txResponse.GetErrors()[0]))
+			return nil
+		})
+
+		err := listener.processMessage(ctx, msg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should report invalid requests on the decoded topic", func(t *testing.T) {
+		msg := newMessage(t, &tx.TxRequest{
+			Chain:  "besu",
+			Params: &tx.Params{From: from, To: to},
+		})
+
+		producer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(b []byte) error {
+			txResponse := &tx.TxResponse{}
+			require.NoError(t, encoding.Unmarshal(b, txResponse))
+			require.Len(t, txResponse.GetErrors(), 1)
+			assert.True(t, errors.IsDataError(txResponse.GetErrors()[0]))
+			return nil
+		})
+
+		err := listener.processMessage(ctx, msg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should report raw transactions which cannot be decoded on the decoded topic", func(t *testing.T) {
+		msg := newMessage(t, &tx.TxRequest{
+			Id:     requestID,
+			Chain:  "besu",
+			Method: tx.Method_ETH_SENDRAWTRANSACTION,
+			Params: &tx.Params{Raw: "0xnotHex"},
+		})
+
+		producer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(b []byte) error {
+			txResponse := &tx.TxResponse{}
+			require.NoError(t, encoding.Unmarshal(b, txResponse))
+			assert.Equal(t, requestID, txResponse.GetId())
+			require.Len(t, txResponse.GetErrors(), 1)
+			assert.True(t, errors.IsDataError(txResponse.GetErrors()[0]))
+			return nil
+		})
+
+		err := listener.processMessage(ctx, msg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should report requests with invalid parameters on the decoded topic", func(t *testing.T) {
+		msg := newMessage(t, &tx.TxRequest{
+			Id:      requestID,
+			Chain:   "besu",
+			Headers: map[string]string{authutils.APIKeyHeader: "api-key", authutils.TenantIDHeader: "tenantOne"},
+			Params: &tx.Params{
+				To:              to,
+				Contract:        "ERC20[v1.0.0]",
+				MethodSignature: "transfer(address,uint256)",
+				Args:            []string{to, "0x1"},
+			},
+		})
+
+		keyChecker.EXPECT().Check(gomock.Any()).Return(userInfo, nil)
+		producer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(b []byte) error {
+			txResponse := &tx.TxResponse{}
+			require.NoError(t, encoding.Unmarshal(b, txResponse))
+			require.Len(t, txResponse.GetErrors(), 1)
+			assert.True(t, errors.IsInvalidParameterError(txResponse.GetErrors()[0]))
+			return nil
+		})
+
+		err := listener.processMessage(ctx, msg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should report the errors of the use cases on the decoded topic", func(t *testing.T) {
+		msg := newMessage(t, &tx.TxRequest{
+			Id:      requestID,
+			Chain:   "besu",
+			Headers: map[string]string{authutils.APIKeyHeader: "api-key"},
+			Params:  &tx.Params{From: from, To: to, Value: "0x1"},
+		})
+
+		keyChecker.EXPECT().Check(gomock.Any()).Return(userInfo, nil)
+		sendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).Return(nil, errors.InvalidParameterError("error"))
+		producer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(b []byte) error {
+			txResponse := &tx.TxResponse{}
+			require.NoError(t, encoding.Unmarshal(b, txResponse))
+			require.Len(t, txResponse.GetErrors(), 1)
+			assert.True(t, errors.IsInvalidParameterError(txResponse.GetErrors()[0]))
+			return nil
+		})
+
+		err := listener.processMessage(ctx, msg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with the connection errors of the use cases", func(t *testing.T) {
+		msg := newMessage(t, &tx.TxRequest{
+			Id:      requestID,
+			Chain:   "besu",
+			Headers: map[string]string{authutils.APIKeyHeader: "api-key"},
+			Params:  &tx.Params{From: from, To: to, Value: "0x1"},
+		})
+
+		keyChecker.EXPECT().Check(gomock.Any()).Return(userInfo, nil)
+		sendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).Return(nil, errors.PostgresConnectionError("error"))
+
+		err := listener.processMessage(ctx, msg)
+		assert.True(t, errors.IsConnectionError(err))
+	})
+
+	t.Run("should ignore messages which cannot be decoded", func(t *testing.T) {
+		err := listener.processMessage(ctx, &sarama.ConsumerMessage{Value: []byte("invalid")})
+		assert.NoError(t, err)
+	})
+}
+
+func TestTxRequestListener_MultitenancyDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txUCs := ucmocks.NewMockTransactionUseCases(ctrl)
+	sendTxUC := ucmocks.NewMockSendTxUseCase(ctrl)
+	txUCs.EXPECT().SendTransaction().Return(sendTxUC).AnyTimes()
+	producer := mocks.NewSyncProducer(t, nil)
+	defer func() { _ = producer.Close() }()
+
+	listener := NewTxRequestListener(txUCs, mockauth.NewMockChecker(ctrl), mockauth.NewMockChecker(ctrl), false, producer, decodedTopic, &backoff.StopBackOff{})
+
+	t.Run("should send transactions as the default user", func(t *testing.T) {
+		msg := newMessage(t, &tx.TxRequest{
+			Id:     requestID,
+			Chain:  "besu",
+			Params: &tx.Params{From: from, To: to, Value: "0x1"},
+		})
+
+		sendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, multitenancy.DefaultUser()).Return(testdata.FakeTxRequest(), nil)
+
+		err := listener.processMessage(context.Background(), msg)
+		assert.NoError(t, err)
+	})
+}
+
+func newMessage(t *testing.T, txRequest *tx.TxRequest) *sarama.ConsumerMessage {
+	b, err := encoding.Marshal(txRequest)
+	require.NoError(t, err)
+
+	return &sarama.ConsumerMessage{Value: b}
+}
@@ -0,0 +1,121 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	"github.com/cenkalti/backoff/v4"
+	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	ierror "github.com/consensys/orchestrate/pkg/types/error"
+	"github.com/consensys/orchestrate/pkg/types/tx"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const txRecoverListenerComponent = "api.consumer.tx-recover"
+
+// TxRecoverListener consumes the envelopes of the jobs failed by the tx-sender and stores them so they can be replayed
+type TxRecoverListener struct {
+	jobUCs       usecases.JobUseCases
+	retryBackOff backoff.BackOff
+	logger       *log.Logger
+}
+
+func NewTxRecoverListener(jobUCs usecases.JobUseCases, bck backoff.BackOff) *TxRecoverListener {
+	return &TxRecoverListener{
+		jobUCs:       jobUCs,
+		retryBackOff: bck,
+		logger:       log.NewLogger().SetComponent(txRecoverListenerComponent),
+	}
+}
+
+func (l *TxRecoverListener) Setup(session sarama.ConsumerGroupSession) error {
+	l.logger.WithContext(session.Context()).
+		WithField("kafka.generation_id", session.GenerationID()).
+		WithField("kafka.member_id", session.MemberID()).
+		WithField("claims", session.Claims()).
+		Info("ready to consume failed jobs")
+
+	return nil
+}
+
+func (l *TxRecoverListener) Cleanup(session sarama.ConsumerGroupSession) error {
+	l.logger.WithContext(session.Context()).Info("all claims consumed")
+	return nil
+}
+
+func (l *TxRecoverListener) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	return consumeClaim(session, claim, l.retryBackOff, l.logger, l.processMessage)
+}
+
+// processMessage stores the failure of the job of a message. Only connection errors are returned, other messages
+// are logged and ignored
+func (l *TxRecoverListener) processMessage(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	txResponse := &tx.TxResponse{}
+	if err := encoding.Unmarshal(msg.Value, txResponse); err != nil {
+		l.logger.WithContext(ctx).WithError(err).WithField("offset", msg.Offset).Error("failed to decode failed job envelope, message ignored")
+		return nil
+	}
+
+	logger := l.logger.WithContext(ctx).WithField("envelope_id", txResponse.GetId())
+	if txResponse.GetJobUUID() == "" {
+		logger.Warn("failed job envelope has no job, message ignored")
+		return nil
+	}
+
+	logger = logger.WithField("job", txResponse.GetJobUUID())
+	failedJob, err := l.jobUCs.CreateFailedJob().Execute(log.With(ctx, logger), newFailedJob(txResponse))
+	switch {
+	case err != nil && errors.IsConnectionError(err):
+		return err
+	case err != nil:
+		logger.WithError(err).Error("failed to store failed job, message ignored")
+		return nil
+	}
+
+	logger.WithField("error_code", failedJob.ErrorCode).Debug("failed job stored successfully")
+	return nil
+}
+
+// newFailedJob describes the failure of a job by the last error of its envelope
+func newFailedJob(txResponse *tx.TxResponse) *entities.FailedJob {
+	failedJob := &entities.FailedJob{
+		JobUUID:    txResponse.GetJobUUID(),
+		EnvelopeID: txResponse.GetId(),
+		ErrorClass: entities.FailedJobUnknownError,
+	}
+
+	if errs := txResponse.GetErrors(); len(errs) > 0 {
+		lastErr := errs[len(errs)-1]
+		failedJob.ErrorCode = lastErr.Hex()
+		failedJob.ErrorMessage = lastErr.GetMessage()
+		failedJob.ErrorClass = classifyError(lastErr)
+	}
+
+	return failedJob
+}
+
+func classifyError(err *ierror.Error) entities.FailedJobErrorClass {
+	switch {
+	case errors.IsInvalidNonceWarning(err):
+		return entities.FailedJobInvalidNonceError
+	case errors.IsConnectionError(err):
+		return entities.FailedJobConnectionError
+	case errors.IsInvalidAuthenticationError(err):
+		return entities.FailedJobAuthenticationError
+	case errors.IsInvalidStateError(err):
+		return entities.FailedJobInvalidStateError
+	case errors.IsDataError(err):
+		return entities.FailedJobInvalidDataError
+	case errors.IsEthereumError(err):
+		return entities.FailedJobEthereumError
+	case errors.IsCryptoOperationError(err):
+		return entities.FailedJobCryptoError
+	case errors.IsInternalError(err), errors.IsStorageError(err), errors.IsFeatureNotSupportedError(err):
+		return entities.FailedJobInternalError
+	default:
+		return entities.FailedJobUnknownError
+	}
+}
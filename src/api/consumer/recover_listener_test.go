@@ -0,0 +1,102 @@
+// +build unit
+
+package consumer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/cenkalti/backoff/v4"
+	encoding "github.com/consensys/orchestrate/pkg/encoding/proto"
+	"github.com/consensys/orchestrate/pkg/errors"
+	ierror "github.com/consensys/orchestrate/pkg/types/error"
+	"github.com/consensys/orchestrate/pkg/types/tx"
+	ucmocks "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const jobUUID = "6380e2b6-b828-43ee-abdc-de0f8d57dc5f"
+
+func TestTxRecoverListener(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	jobUCs := ucmocks.NewMockJobUseCases(ctrl)
+	createFailedJobUC := ucmocks.NewMockCreateFailedJobUseCase(ctrl)
+	jobUCs.EXPECT().CreateFailedJob().Return(createFailedJobUC).AnyTimes()
+
+	listener := NewTxRecoverListener(jobUCs, &backoff.StopBackOff{})
+	ctx := context.Background()
+
+	t.Run("should store the failed job classified by its last error", func(t *testing.T) {
+		msg := newRecoverMessage(t, &tx.TxResponse{
+			Id:      requestID,
+			JobUUID: jobUUID,
+			Errors: []*ierror.Error{
+				errors.InvalidNonceWarning("nonce too low"),
+				errors.EthConnectionError("failed to connect to chain"),
+			},
+		})
+
+		createFailedJobUC.EXPECT().Execute(gomock.Any(), &entities.FailedJob{
+			JobUUID:      jobUUID,
+			EnvelopeID:   requestID,
+			ErrorCode:    errors.EthConnectionError("").Hex(),
+			ErrorClass:   entities.FailedJobConnectionError,
+			ErrorMessage: "failed to connect to chain",
+		}).Return(testdata.FakeFailedJob(), nil)
+
+		err := listener.processMessage(ctx, msg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should ignore envelopes without job", func(t *testing.T) {
+		msg := newRecoverMessage(t, &tx.TxResponse{Id: requestID})
+
+		err := listener.processMessage(ctx, msg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should ignore failed jobs which cannot be stored", func(t *testing.T) {
+		msg := newRecoverMessage(t, &tx.TxResponse{Id: requestID, JobUUID: jobUUID})
+
+		createFailedJobUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, errors.NotFoundError("job not found"))
+
+		err := listener.processMessage(ctx, msg)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return connection errors to retry the message", func(t *testing.T) {
+		msg := newRecoverMessage(t, &tx.TxResponse{Id: requestID, JobUUID: jobUUID})
+		expectedErr := errors.PostgresConnectionError("error")
+
+		createFailedJobUC.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		err := listener.processMessage(ctx, msg)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestClassifyError(t *testing.T) {
+	assert.Equal(t, entities.FailedJobInvalidNonceError, classifyError(errors.NonceTooLowWarning("error")))
+	assert.Equal(t, entities.FailedJobConnectionError, classifyError(errors.KafkaConnectionError("error")))
+	assert.Equal(t, entities.FailedJobAuthenticationError, classifyError(errors.UnauthorizedError("error")))
+	assert.Equal(t, entities.FailedJobInvalidStateError, classifyError(errors.InvalidStateError("error")))
+	assert.Equal(t, entities.FailedJobInvalidDataError, classifyError(errors.InvalidParameterError("error")))
+	assert.Equal(t, entities.FailedJobEthereumError, classifyError(errors.EthereumError("error")))
+	assert.Equal(t, entities.FailedJobCryptoError, classifyError(errors.CryptoOperationError("error")))
+	assert.Equal(t, entities.FailedJobInternalError, classifyError(errors.InternalError("error")))
+	assert.Equal(t, entities.FailedJobUnknownError, classifyError(errors.Warningf("error")))
+}
+
+func newRecoverMessage(t *testing.T, txResponse *tx.TxResponse) *sarama.ConsumerMessage {
+	b, err := encoding.Marshal(txResponse)
+	require.NoError(t, err)
+
+	return &sarama.ConsumerMessage{Value: b}
+}
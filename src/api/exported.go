@@ -3,15 +3,22 @@ package api
 import (
 	"context"
 
+	sarama2 "github.com/Shopify/sarama"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+
 	authjwt "github.com/consensys/orchestrate/pkg/toolkit/app/auth/jwt"
 	ethclient "github.com/consensys/orchestrate/src/infra/ethclient/rpc"
 
+	"github.com/consensys/orchestrate/src/infra/keystore"
 	qkm "github.com/consensys/orchestrate/src/infra/quorum-key-manager"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 	authkey "github.com/consensys/orchestrate/pkg/toolkit/app/auth/key"
 	"github.com/consensys/orchestrate/src/infra/broker/sarama"
 	"github.com/consensys/orchestrate/src/infra/database/postgres"
+	"github.com/consensys/orchestrate/src/infra/redis/redigo"
+	txsenderstore "github.com/consensys/orchestrate/src/tx-sender/store"
+	redisnoncemngr "github.com/consensys/orchestrate/src/tx-sender/store/redis"
 	"github.com/spf13/viper"
 )
 
@@ -22,21 +29,54 @@ func New(ctx context.Context) (*app.App, error) {
 	authkey.Init(ctx)
 	sarama.InitSyncProducer(ctx)
 	ethclient.Init(ctx)
-	qkm.Init()
+	keystore.Init(ctx)
 
 	config := NewConfig(viper.GetViper())
 	pgmngr := postgres.GetManager()
 
+	var nonceSender txsenderstore.NonceSender
+	if config.NonceManagerType == NonceManagerTypeRedis {
+		redisClient, err := redigo.New(config.RedisCfg)
+		if err != nil {
+			return nil, err
+		}
+		// Nonces are only read, expiration is managed by the tx-sender
+		nonceSender = redisnoncemngr.NewNonceSender(redisClient, 0)
+	}
+
+	var txRequestConsumerGroup sarama2.ConsumerGroup
+	if config.Consumer.Enabled {
+		var err error
+		txRequestConsumerGroup, err = newSaramaConsumer(viper.GetStringSlice(sarama.KafkaURLViperKey), config.Consumer.GroupName)
+		if err != nil {
+			return nil, err
+		}
+		log.FromContext(ctx).WithField("group_name", config.Consumer.GroupName).Info("transaction request consumer client ready")
+	}
+
+	var txRecoverConsumerGroup sarama2.ConsumerGroup
+	if config.Consumer.RecoverEnabled {
+		var err error
+		txRecoverConsumerGroup, err = newSaramaConsumer(viper.GetStringSlice(sarama.KafkaURLViperKey), config.Consumer.RecoverGroupName)
+		if err != nil {
+			return nil, err
+		}
+		log.FromContext(ctx).WithField("group_name", config.Consumer.RecoverGroupName).Info("failed job consumer client ready")
+	}
+
 	return NewAPI(
 		config,
 		pgmngr,
 		authjwt.GlobalChecker(),
 		authkey.GlobalChecker(),
-		qkm.GlobalClient(),
+		keystore.GlobalClient(),
 		qkm.GlobalStoreName(),
 		ethclient.GlobalClient(),
 		sarama.GlobalSyncProducer(),
 		sarama.NewKafkaTopicConfig(viper.GetViper()),
+		txRequestConsumerGroup,
+		txRecoverConsumerGroup,
+		nonceSender,
 	)
 }
 
@@ -47,3 +87,17 @@ func Run(ctx context.Context) error {
 	}
 	return appli.Run(ctx)
 }
+
+func newSaramaConsumer(hostnames []string, groupName string) (sarama2.ConsumerGroup, error) {
+	config, err := sarama.NewSaramaConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := sarama.NewClient(hostnames, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return sarama.NewConsumerGroupFromClient(groupName, client)
+}
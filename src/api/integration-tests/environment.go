@@ -430,6 +430,9 @@ func newAPI(ctx context.Context, topicCfg *sarama.KafkaTopicConfig) (*app.App, e
 		ethclient.GlobalClient(),
 		sarama.GlobalSyncProducer(),
 		topicCfg,
+		nil,
+		nil,
+		nil,
 	)
 }
 
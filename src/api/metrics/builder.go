@@ -26,3 +26,23 @@ func (r *metrics) JobsLatencyHistogram() kitmetrics.Histogram {
 func (r *metrics) MinedLatencyHistogram() kitmetrics.Histogram {
 	return r.minedLatencyHistogram
 }
+
+type proxyMetrics struct {
+	nodeUpGauge       kitmetrics.Gauge
+	nodeBlockLagGauge kitmetrics.Gauge
+}
+
+func buildProxyMetrics(nodeUpGauge, nodeBlockLagGauge kitmetrics.Gauge) *proxyMetrics {
+	return &proxyMetrics{
+		nodeUpGauge:       nodeUpGauge,
+		nodeBlockLagGauge: nodeBlockLagGauge,
+	}
+}
+
+func (r *proxyMetrics) NodeUpGauge() kitmetrics.Gauge {
+	return r.nodeUpGauge
+}
+
+func (r *proxyMetrics) NodeBlockLagGauge() kitmetrics.Gauge {
+	return r.nodeBlockLagGauge
+}
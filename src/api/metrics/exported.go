@@ -16,3 +16,9 @@ type TransactionSchedulerMetrics interface {
 	MinedLatencyHistogram() kitmetrics.Histogram
 	pkgmetrics.Prometheus
 }
+
+type ChainProxyMetrics interface {
+	NodeUpGauge() kitmetrics.Gauge
+	NodeBlockLagGauge() kitmetrics.Gauge
+	pkgmetrics.Prometheus
+}
@@ -12,6 +12,8 @@ const (
 	Subsystem           = "api"
 	JobLatencySeconds   = "job_latency_seconds"
 	MinedLatencySeconds = "mined_latency_seconds"
+	ProxyNodeUp         = "proxy_node_up"
+	ProxyNodeBlockLag   = "proxy_node_block_lag"
 )
 
 type tpcMetrics struct {
@@ -64,3 +66,52 @@ func NewTransactionSchedulerNopMetrics() TransactionSchedulerMetrics {
 		),
 	}
 }
+
+type chainProxyMetrics struct {
+	prometheus.Collector
+	*proxyMetrics
+}
+
+func NewChainProxyMetrics() ChainProxyMetrics {
+	multi := pkgmetrics.NewMulti()
+
+	nodeUpGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics1.Namespace,
+			Subsystem: Subsystem,
+			Name:      ProxyNodeUp,
+			Help:      "Health of chain nodes behind the proxy (1 if healthy, 0 if ejected)",
+		},
+		[]string{"chain_uuid", "url"},
+	)
+	multi.Collectors = append(multi.Collectors, nodeUpGauge)
+
+	nodeBlockLagGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics1.Namespace,
+			Subsystem: Subsystem,
+			Name:      ProxyNodeBlockLag,
+			Help:      "Number of blocks chain nodes behind the proxy lag behind the most advanced node of the chain",
+		},
+		[]string{"chain_uuid", "url"},
+	)
+	multi.Collectors = append(multi.Collectors, nodeBlockLagGauge)
+
+	return &chainProxyMetrics{
+		Collector: multi,
+		proxyMetrics: buildProxyMetrics(
+			kitprometheus.NewGauge(nodeUpGauge),
+			kitprometheus.NewGauge(nodeBlockLagGauge),
+		),
+	}
+}
+
+func NewChainProxyNopMetrics() ChainProxyMetrics {
+	return &chainProxyMetrics{
+		Collector: pkgmetrics.NewMulti(),
+		proxyMetrics: buildProxyMetrics(
+			discard.NewGauge(),
+			discard.NewGauge(),
+		),
+	}
+}
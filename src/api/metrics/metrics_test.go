@@ -39,3 +39,28 @@ func TestTransactionSchedulerMetrics(t *testing.T) {
 	testutils.AssertHistogramFamily(t, families[0], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), JobLatencySeconds, []uint64{1}, "Histogram of job latency between status (second). Except PENDING and MINED, see mined_latency_seconds", nil)
 	testutils.AssertHistogramFamily(t, families[1], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), MinedLatencySeconds, []uint64{1}, "Histogram of latency between PENDING and MINED (second)", nil)
 }
+
+func TestChainProxyMetrics(t *testing.T) {
+	ep := NewChainProxyMetrics()
+
+	registry := prometheus.NewRegistry()
+	err := registry.Register(ep)
+	assert.NoError(t, err, "Registering ChainProxyMetrics should not fail")
+
+	ep.NodeUpGauge().
+		With("chain_uuid", "chain_uuid").
+		With("url", "http://localhost:8545").
+		Set(1)
+
+	ep.NodeBlockLagGauge().
+		With("chain_uuid", "chain_uuid").
+		With("url", "http://localhost:8545").
+		Set(3)
+
+	families, err := registry.Gather()
+	require.NoError(t, err, "Gathering metrics should not error")
+	require.Len(t, families, 2, "Count of metrics families should be correct")
+
+	testutils.AssertGaugeFamily(t, families[0], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), ProxyNodeBlockLag, []float64{3}, "Number of blocks chain nodes behind the proxy lag behind the most advanced node of the chain", nil)
+	testutils.AssertGaugeFamily(t, families[1], fmt.Sprintf("%s_%s", metrics1.Namespace, Subsystem), ProxyNodeUp, []float64{1}, "Health of chain nodes behind the proxy (1 if healthy, 0 if ejected)", nil)
+}
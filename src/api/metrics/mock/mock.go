@@ -62,6 +62,81 @@ func (mr *MockTransactionSchedulerMetricsMockRecorder) MinedLatencyHistogram() *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MinedLatencyHistogram", reflect.TypeOf((*MockTransactionSchedulerMetrics)(nil).MinedLatencyHistogram))
 }
 
+// MockChainProxyMetrics is a mock of ChainProxyMetrics interface
+type MockChainProxyMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockChainProxyMetricsMockRecorder
+}
+
+// MockChainProxyMetricsMockRecorder is the mock recorder for MockChainProxyMetrics
+type MockChainProxyMetricsMockRecorder struct {
+	mock *MockChainProxyMetrics
+}
+
+// NewMockChainProxyMetrics creates a new mock instance
+func NewMockChainProxyMetrics(ctrl *gomock.Controller) *MockChainProxyMetrics {
+	mock := &MockChainProxyMetrics{ctrl: ctrl}
+	mock.recorder = &MockChainProxyMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockChainProxyMetrics) EXPECT() *MockChainProxyMetricsMockRecorder {
+	return m.recorder
+}
+
+// Collect mocks base method
+func (m *MockChainProxyMetrics) Collect(arg0 chan<- prometheus.Metric) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Collect", arg0)
+}
+
+// Collect indicates an expected call of Collect
+func (mr *MockChainProxyMetricsMockRecorder) Collect(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collect", reflect.TypeOf((*MockChainProxyMetrics)(nil).Collect), arg0)
+}
+
+// Describe mocks base method
+func (m *MockChainProxyMetrics) Describe(arg0 chan<- *prometheus.Desc) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Describe", arg0)
+}
+
+// Describe indicates an expected call of Describe
+func (mr *MockChainProxyMetricsMockRecorder) Describe(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockChainProxyMetrics)(nil).Describe), arg0)
+}
+
+// NodeUpGauge mocks base method
+func (m *MockChainProxyMetrics) NodeUpGauge() metrics.Gauge {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NodeUpGauge")
+	ret0, _ := ret[0].(metrics.Gauge)
+	return ret0
+}
+
+// NodeUpGauge indicates an expected call of NodeUpGauge
+func (mr *MockChainProxyMetricsMockRecorder) NodeUpGauge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeUpGauge", reflect.TypeOf((*MockChainProxyMetrics)(nil).NodeUpGauge))
+}
+
+// NodeBlockLagGauge mocks base method
+func (m *MockChainProxyMetrics) NodeBlockLagGauge() metrics.Gauge {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NodeBlockLagGauge")
+	ret0, _ := ret[0].(metrics.Gauge)
+	return ret0
+}
+
+// NodeBlockLagGauge indicates an expected call of NodeBlockLagGauge
+func (mr *MockChainProxyMetricsMockRecorder) NodeBlockLagGauge() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeBlockLagGauge", reflect.TypeOf((*MockChainProxyMetrics)(nil).NodeBlockLagGauge))
+}
+
 // Describe mocks base method
 func (m *MockTransactionSchedulerMetrics) Describe(arg0 chan<- *prometheus.Desc) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,54 @@
+package notifier
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	intervalFlag     = "api-webhook-delivery-interval"
+	intervalViperKey = "api.webhook-delivery.interval"
+	intervalDefault  = 5 * time.Second
+	intervalEnv      = "API_WEBHOOK_DELIVERY_INTERVAL"
+)
+
+const (
+	batchSizeFlag     = "api-webhook-delivery-batch-size"
+	batchSizeViperKey = "api.webhook-delivery.batch-size"
+	batchSizeDefault  = 50
+	batchSizeEnv      = "API_WEBHOOK_DELIVERY_BATCH_SIZE"
+)
+
+func init() {
+	viper.SetDefault(intervalViperKey, intervalDefault)
+	_ = viper.BindEnv(intervalViperKey, intervalEnv)
+
+	viper.SetDefault(batchSizeViperKey, batchSizeDefault)
+	_ = viper.BindEnv(batchSizeViperKey, batchSizeEnv)
+}
+
+// Flags register flags for the delivery of webhooks
+func Flags(f *pflag.FlagSet) {
+	intervalDesc := fmt.Sprintf(`Interval of time between checks for pending webhook deliveries. Environment variable: %q`, intervalEnv)
+	f.Duration(intervalFlag, intervalDefault, intervalDesc)
+	_ = viper.BindPFlag(intervalViperKey, f.Lookup(intervalFlag))
+
+	batchSizeDesc := fmt.Sprintf(`Maximum number of webhook deliveries sent concurrently. Environment variable: %q`, batchSizeEnv)
+	f.Int(batchSizeFlag, batchSizeDefault, batchSizeDesc)
+	_ = viper.BindPFlag(batchSizeViperKey, f.Lookup(batchSizeFlag))
+}
+
+type Config struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+func NewConfig(vipr *viper.Viper) *Config {
+	return &Config{
+		Interval:  vipr.GetDuration(intervalViperKey),
+		BatchSize: vipr.GetInt(batchSizeViperKey),
+	}
+}
@@ -0,0 +1,64 @@
+package notifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+)
+
+const notifierComponent = "application.notifier"
+
+// Notifier periodically sends the pending webhook deliveries. Deliveries are claimed while they are sent so several
+// API instances can run a notifier concurrently
+type Notifier struct {
+	deliverWebhooksUC usecases.DeliverWebhooksUseCase
+	config            *Config
+	logger            *log.Logger
+}
+
+func New(deliverWebhooksUC usecases.DeliverWebhooksUseCase, config *Config) *Notifier {
+	return &Notifier{
+		deliverWebhooksUC: deliverWebhooksUC,
+		config:            config,
+		logger:            log.NewLogger().SetComponent(notifierComponent),
+	}
+}
+
+func (n *Notifier) Run(ctx context.Context) error {
+	ctx = log.With(ctx, n.logger)
+	n.logger.WithField("interval", n.config.Interval).Info("notifier started")
+
+	ticker := time.NewTicker(n.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			n.logger.Info("notifier stopped")
+			return nil
+		case <-ticker.C:
+			n.deliverWebhooks(ctx)
+		}
+	}
+}
+
+func (n *Notifier) Close() error {
+	return nil
+}
+
+// deliverWebhooks sends batches of pending webhook deliveries until there are none left
+func (n *Notifier) deliverWebhooks(ctx context.Context) {
+	for ctx.Err() == nil {
+		count, err := n.deliverWebhooksUC.Execute(ctx, n.config.BatchSize)
+		if err != nil {
+			n.logger.WithError(err).Error("failed to deliver webhooks")
+			return
+		}
+
+		if count < n.config.BatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// +build unit
+
+package notifier
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifier_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDeliverWebhooksUC := mocks.NewMockDeliverWebhooksUseCase(ctrl)
+	cfg := &Config{Interval: 10 * time.Millisecond, BatchSize: 2}
+
+	t.Run("should deliver webhooks until there are none left and stop when context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		notifier := New(mockDeliverWebhooksUC, cfg)
+
+		gomock.InOrder(
+			mockDeliverWebhooksUC.EXPECT().Execute(gomock.Any(), cfg.BatchSize).Return(2, nil),
+			mockDeliverWebhooksUC.EXPECT().Execute(gomock.Any(), cfg.BatchSize).DoAndReturn(func(ctx context.Context, limit int) (int, error) {
+				cancel()
+				return 1, nil
+			}),
+		)
+
+		err := notifier.Run(ctx)
+
+		assert.NoError(t, err)
+		assert.NoError(t, notifier.Close())
+	})
+
+	t.Run("should keep running if webhooks fail to be delivered", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		notifier := New(mockDeliverWebhooksUC, cfg)
+
+		gomock.InOrder(
+			mockDeliverWebhooksUC.EXPECT().Execute(gomock.Any(), cfg.BatchSize).Return(0, errors.PostgresConnectionError("error")),
+			mockDeliverWebhooksUC.EXPECT().Execute(gomock.Any(), cfg.BatchSize).DoAndReturn(func(ctx context.Context, limit int) (int, error) {
+				cancel()
+				return 0, nil
+			}),
+		)
+
+		err := notifier.Run(ctx)
+
+		assert.NoError(t, err)
+	})
+}
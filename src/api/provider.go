@@ -20,10 +20,10 @@ const (
 	InternalProvider = "internal"
 )
 
-func NewProvider(searchChains usecases.SearchChainsUseCase, refresh time.Duration, proxyCacheTTL *time.Duration) provider.Provider {
+func NewProvider(searchChains usecases.SearchChainsUseCase, refresh time.Duration, proxyCacheTTL *time.Duration, healthChecker *proxy.HealthChecker) provider.Provider {
 	prvdr := aggregator.New()
 	prvdr.AddProvider(NewInternalProvider())
-	prvdr.AddProvider(proxy.NewChainsProxyProvider(searchChains, refresh, proxyCacheTTL))
+	prvdr.AddProvider(proxy.NewChainsProxyProvider(searchChains, refresh, proxyCacheTTL, healthChecker))
 	return prvdr
 
 }
@@ -35,7 +35,7 @@ func NewInternalProvider() provider.Provider {
 func newInternalConfig() *dynamic.Configuration {
 	cfg := dynamic.NewConfig()
 
-	pathPrefix := []string{"/transactions", "/schedules", "/jobs", "/accounts", "/faucets", "/contracts", "/chains"}
+	pathPrefix := []string{"/transactions", "/schedules", "/jobs", "/accounts", "/faucets", "/contracts", "/chains", "/webhooks"}
 	for idx, path := range pathPrefix {
 		pathPrefix[idx] = fmt.Sprintf("PathPrefix(`%s`)", path)
 	}
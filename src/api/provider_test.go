@@ -26,7 +26,7 @@ func TestNewInternalConfig(t *testing.T) {
 						EntryPoints: []string{http.DefaultHTTPAppEntryPoint},
 						Service:     "api",
 						Priority:    math.MaxInt32,
-						Rule:        "PathPrefix(`/transactions`) || PathPrefix(`/schedules`) || PathPrefix(`/jobs`) || PathPrefix(`/accounts`) || PathPrefix(`/faucets`) || PathPrefix(`/contracts`) || PathPrefix(`/chains`)",
+						Rule:        "PathPrefix(`/transactions`) || PathPrefix(`/schedules`) || PathPrefix(`/jobs`) || PathPrefix(`/accounts`) || PathPrefix(`/faucets`) || PathPrefix(`/contracts`) || PathPrefix(`/chains`) || PathPrefix(`/webhooks`)",
 						Middlewares: []string{"base@logger-base", "auth@multitenancy"},
 					},
 				},
@@ -94,6 +94,7 @@ func TestNewChainsProxyConfig(t *testing.T) {
 							"auth@multitenancy",
 							"auth-testTenantId@multitenancy",
 							"strip-path@internal",
+							"0d60a85e-0b90-4482-a14c-108aea2557aa@rpc-policy",
 							"ratelimit@internal",
 						},
 					},
@@ -116,6 +117,13 @@ func TestNewChainsProxyConfig(t *testing.T) {
 					},
 				}
 
+				cfg.HTTP.Middlewares["0d60a85e-0b90-4482-a14c-108aea2557aa@rpc-policy"] = &dynamic.Middleware{
+					RPCPolicy: &dynamic.RPCPolicy{
+						DeniedMethods: []string{"admin_*", "debug_*", "personal_*"},
+						KeySuffix:     "0d60a85e-0b90-4482-a14c-108aea2557aa",
+					},
+				}
+
 				return cfg
 			},
 		},
@@ -141,6 +149,11 @@ func TestNewChainsProxyConfig(t *testing.T) {
 						URL:  "http://testURL10.com/tessera",
 						Type: entities.TesseraChainType,
 					},
+					RPCPolicy: &entities.ChainRPCPolicy{
+						AllowedMethods: []string{"eth_*"},
+						MaxBatchSize:   10,
+						TenantQuota:    &entities.ChainRPCQuota{Requests: 100, Period: "1m"},
+					},
 				},
 			},
 			func(cfg *dynamic.Configuration) *dynamic.Configuration {
@@ -155,6 +168,7 @@ func TestNewChainsProxyConfig(t *testing.T) {
 							"auth@multitenancy",
 							"auth-testTenantId@multitenancy",
 							"strip-path@internal",
+							"0d60a85e-0b90-4482-a14c-108aea2557aa@rpc-policy",
 							"ratelimit@internal",
 						},
 					},
@@ -170,6 +184,7 @@ func TestNewChainsProxyConfig(t *testing.T) {
 							"auth@multitenancy",
 							"auth-testTenantId@multitenancy",
 							"strip-path@internal",
+							"39240e9f-ae09-4e95-9fd0-a712035c8ad7@rpc-policy",
 							"ratelimit@internal",
 						},
 					},
@@ -229,13 +244,31 @@ func TestNewChainsProxyConfig(t *testing.T) {
 						Tenant: "testTenantId",
 					},
 				}
+
+				cfg.HTTP.Middlewares["0d60a85e-0b90-4482-a14c-108aea2557aa@rpc-policy"] = &dynamic.Middleware{
+					RPCPolicy: &dynamic.RPCPolicy{
+						DeniedMethods: []string{"admin_*", "debug_*", "personal_*"},
+						KeySuffix:     "0d60a85e-0b90-4482-a14c-108aea2557aa",
+					},
+				}
+
+				cfg.HTTP.Middlewares["39240e9f-ae09-4e95-9fd0-a712035c8ad7@rpc-policy"] = &dynamic.Middleware{
+					RPCPolicy: &dynamic.RPCPolicy{
+						AllowedMethods: []string{"eth_*"},
+						DeniedMethods:  []string{"admin_*", "debug_*", "personal_*"},
+						MaxBatchSize:   10,
+						QuotaRequests:  100,
+						QuotaPeriod:    time.Minute,
+						KeySuffix:      "39240e9f-ae09-4e95-9fd0-a712035c8ad7",
+					},
+				}
 				return cfg
 			},
 		},
 	}
 
 	for i, test := range testSet {
-		cfg := proxy.NewProxyConfig(test.chains, nil)
+		cfg := proxy.NewProxyConfig(test.chains, nil, nil)
 		expectedCfg := test.expectedCfg(dynamic.NewConfig())
 		assert.Equal(t, expectedCfg, cfg, "Chain-registry - Store (%d/%d): expected %v but got %v", i+1, len(testSet), expectedCfg, cfg)
 	}
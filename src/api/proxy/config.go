@@ -17,6 +17,10 @@ func init() {
 	viper.SetDefault(CacheTTLViperKey, cacheDefault)
 	_ = viper.BindEnv(MaxIdleConnsPerHostViperKey, maxIdleConnsPerHostEnv)
 	viper.SetDefault(MaxIdleConnsPerHostViperKey, maxIdleConnsPerHostDefault)
+	_ = viper.BindEnv(HealthCheckIntervalViperKey, healthCheckIntervalEnv)
+	viper.SetDefault(HealthCheckIntervalViperKey, healthCheckIntervalDefault)
+	_ = viper.BindEnv(HealthCheckMaxBlockLagViperKey, healthCheckMaxBlockLagEnv)
+	viper.SetDefault(HealthCheckMaxBlockLagViperKey, healthCheckMaxBlockLagDefault)
 }
 
 var (
@@ -29,6 +33,16 @@ var (
 	MaxIdleConnsPerHostViperKey = "proxy.max-idle-connections-per-host"
 	maxIdleConnsPerHostDefault  = 50
 	maxIdleConnsPerHostEnv      = "PROXY_MAXIDLECONNSPERHOST"
+
+	healthCheckIntervalFlag     = "proxy-healthcheck-interval"
+	HealthCheckIntervalViperKey = "proxy.healthcheck.interval"
+	healthCheckIntervalDefault  = 10 * time.Second
+	healthCheckIntervalEnv      = "PROXY_HEALTHCHECK_INTERVAL"
+
+	healthCheckMaxBlockLagFlag     = "proxy-healthcheck-max-block-lag"
+	HealthCheckMaxBlockLagViperKey = "proxy.healthcheck.max-block-lag"
+	healthCheckMaxBlockLagDefault  = uint64(5)
+	healthCheckMaxBlockLagEnv      = "PROXY_HEALTHCHECK_MAX_BLOCK_LAG"
 )
 
 type Config struct {
@@ -37,6 +51,12 @@ type Config struct {
 	ProxyCacheTTL    *time.Duration
 	ServersTransport *traefikstatic.ServersTransport
 	Multitenancy     bool
+	HealthCheck      *HealthCheckConfig
+}
+
+type HealthCheckConfig struct {
+	Interval    time.Duration
+	MaxBlockLag uint64
 }
 
 func Flags(f *pflag.FlagSet) {
@@ -47,6 +67,14 @@ func Flags(f *pflag.FlagSet) {
 	maxIdleConnsPerHostDesc := fmt.Sprintf(`Maximum number of open HTTP connections to a chain proxied. Environment variable: %q`, maxIdleConnsPerHostEnv)
 	f.Int(maxIdleConnsPerHostFlag, maxIdleConnsPerHostDefault, maxIdleConnsPerHostDesc)
 	_ = viper.BindPFlag(MaxIdleConnsPerHostViperKey, f.Lookup(maxIdleConnsPerHostFlag))
+
+	healthCheckIntervalDesc := fmt.Sprintf(`Interval of time between health checks of the chain nodes proxied (0 to disable). Environment variable: %q`, healthCheckIntervalEnv)
+	f.Duration(healthCheckIntervalFlag, healthCheckIntervalDefault, healthCheckIntervalDesc)
+	_ = viper.BindPFlag(HealthCheckIntervalViperKey, f.Lookup(healthCheckIntervalFlag))
+
+	healthCheckMaxBlockLagDesc := fmt.Sprintf(`Maximum number of blocks a chain node can lag behind the most advanced node of the chain before being ejected from the proxy. Environment variable: %q`, healthCheckMaxBlockLagEnv)
+	f.Uint64(healthCheckMaxBlockLagFlag, healthCheckMaxBlockLagDefault, healthCheckMaxBlockLagDesc)
+	_ = viper.BindPFlag(HealthCheckMaxBlockLagViperKey, f.Lookup(healthCheckMaxBlockLagFlag))
 }
 
 func NewConfig() *Config {
@@ -61,6 +89,10 @@ func NewConfig() *Config {
 			InsecureSkipVerify:  true,
 		},
 		Multitenancy: viper.GetBool(multitenancy.EnabledViperKey),
+		HealthCheck: &HealthCheckConfig{
+			Interval:    viper.GetDuration(HealthCheckIntervalViperKey),
+			MaxBlockLag: viper.GetUint64(HealthCheckMaxBlockLagViperKey),
+		},
 	}
 
 	cacheStr := viper.GetDuration(CacheTTLViperKey)
@@ -76,3 +76,39 @@ func TestMaxIdleConnsPerHostFlag(t *testing.T) {
 	cfg := NewConfig()
 	assert.Equal(t, 3, cfg.ServersTransport.MaxIdleConnsPerHost)
 }
+
+func TestHealthCheckDefault(t *testing.T) {
+	Flags(pflag.NewFlagSet("test-healthcheck-default", pflag.ContinueOnError))
+
+	cfg := NewConfig()
+	assert.Equal(t, 10*time.Second, cfg.HealthCheck.Interval)
+	assert.Equal(t, uint64(5), cfg.HealthCheck.MaxBlockLag)
+}
+
+func TestHealthCheckENV(t *testing.T) {
+	Flags(pflag.NewFlagSet("test-healthcheck-env", pflag.ContinueOnError))
+
+	_ = os.Setenv(healthCheckIntervalEnv, "30s")
+	_ = os.Setenv(healthCheckMaxBlockLagEnv, "10")
+
+	cfg := NewConfig()
+	assert.Equal(t, 30*time.Second, cfg.HealthCheck.Interval)
+	assert.Equal(t, uint64(10), cfg.HealthCheck.MaxBlockLag)
+	_ = os.Unsetenv(healthCheckIntervalEnv)
+	_ = os.Unsetenv(healthCheckMaxBlockLagEnv)
+}
+
+func TestHealthCheckFlag(t *testing.T) {
+	flgs := pflag.NewFlagSet("test-healthcheck-flag", pflag.ContinueOnError)
+	Flags(flgs)
+
+	err := flgs.Parse([]string{
+		fmt.Sprintf("--%s=%s", healthCheckIntervalFlag, "0s"),
+		fmt.Sprintf("--%s=%s", healthCheckMaxBlockLagFlag, "2"),
+	})
+	assert.NoError(t, err, "No error expected")
+
+	cfg := NewConfig()
+	assert.Equal(t, time.Duration(0), cfg.HealthCheck.Interval)
+	assert.Equal(t, uint64(2), cfg.HealthCheck.MaxBlockLag)
+}
@@ -0,0 +1,220 @@
+package proxy
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/metrics"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+)
+
+const healthCheckComponent = "api.proxy.healthcheck"
+
+// HealthChecker periodically checks the nodes of every registered chain. Nodes which are unreachable, syncing or
+// lagging behind the most advanced node of their chain are flagged DOWN so they get ejected from the proxy.
+// Tessera private transaction managers are flagged DOWN when their upcheck fails
+type HealthChecker struct {
+	searchChainsUC usecases.SearchChainsUseCase
+	ec             ethclient.ChainSyncReader
+	qc             ethclient.QuorumChainStateReader
+	metrics        metrics.ChainProxyMetrics
+	config         *HealthCheckConfig
+	logger         *log.Logger
+
+	statusesMux sync.RWMutex
+	statuses    map[string]map[string]string // keyed by chain UUID then node URL
+}
+
+type nodeHealth struct {
+	blockNumber uint64
+	syncing     bool
+	err         error
+}
+
+func NewHealthChecker(searchChainsUC usecases.SearchChainsUseCase, ec ethclient.ChainSyncReader, qc ethclient.QuorumChainStateReader,
+	m metrics.ChainProxyMetrics, config *HealthCheckConfig) *HealthChecker {
+	return &HealthChecker{
+		searchChainsUC: searchChainsUC,
+		ec:             ec,
+		qc:             qc,
+		metrics:        m,
+		config:         config,
+		logger:         log.NewLogger().SetComponent(healthCheckComponent),
+		statuses:       make(map[string]map[string]string),
+	}
+}
+
+func (hc *HealthChecker) Run(ctx context.Context) error {
+	ctx = log.With(ctx, hc.logger)
+	hc.logger.WithField("interval", hc.config.Interval).Info("chain proxy health checks started")
+
+	ticker := time.NewTicker(hc.config.Interval)
+	defer ticker.Stop()
+
+	hc.checkChains(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			hc.logger.Info("chain proxy health checks stopped")
+			return nil
+		case <-ticker.C:
+			hc.checkChains(ctx)
+		}
+	}
+}
+
+func (hc *HealthChecker) Close() error {
+	return nil
+}
+
+// Status returns the health status of a node of a chain. Nodes not checked yet are considered UP
+func (hc *HealthChecker) Status(chainUUID, nodeURL string) string {
+	hc.statusesMux.RLock()
+	defer hc.statusesMux.RUnlock()
+
+	if status, ok := hc.statuses[chainUUID][nodeURL]; ok {
+		return status
+	}
+
+	return dynamic.ServerStatusUp
+}
+
+func (hc *HealthChecker) checkChains(ctx context.Context) {
+	// Wildcard user including chains owned by individual users (Special rights)
+	chains, err := hc.searchChainsUC.Execute(ctx, &entities.ChainFilters{}, multitenancy.NewInternalAdminUser())
+	if err != nil {
+		hc.logger.WithError(err).Error("failed to search chains to health check")
+		return
+	}
+
+	statuses := make(map[string]map[string]string, len(chains))
+	for _, chain := range chains {
+		statuses[chain.UUID] = hc.checkChain(ctx, chain)
+	}
+
+	hc.statusesMux.Lock()
+	hc.statuses = statuses
+	hc.statusesMux.Unlock()
+}
+
+func (hc *HealthChecker) checkChain(ctx context.Context, chain *entities.Chain) map[string]string {
+	ctx, cancel := context.WithTimeout(ctx, hc.config.Interval)
+	defer cancel()
+
+	healths := make([]*nodeHealth, len(chain.URLs))
+	wg := &sync.WaitGroup{}
+	for idx, nodeURL := range chain.URLs {
+		wg.Add(1)
+		go func(idx int, nodeURL string) {
+			defer wg.Done()
+			healths[idx] = hc.checkNode(ctx, nodeURL)
+		}(idx, nodeURL)
+	}
+
+	var privTxManagerErr error
+	privTxManager := chain.PrivateTxManager
+	if privTxManager != nil && privTxManager.Type == entities.TesseraChainType {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, privTxManagerErr = hc.qc.GetStatus(ctx, privTxManager.URL)
+		}()
+	}
+	wg.Wait()
+
+	var highestBlock uint64
+	for _, health := range healths {
+		if health.err == nil && health.blockNumber > highestBlock {
+			highestBlock = health.blockNumber
+		}
+	}
+
+	statuses := make(map[string]string, len(chain.URLs))
+	for idx, nodeURL := range chain.URLs {
+		health := healths[idx]
+		logger := hc.logger.WithField("chain", chain.UUID).WithField("url", redactURL(nodeURL))
+		labels := []string{"chain_uuid", chain.UUID, "url", redactURL(nodeURL)}
+
+		status := dynamic.ServerStatusUp
+		switch {
+		case health.err != nil:
+			status = dynamic.ServerStatusDown
+			logger = logger.WithError(health.err)
+		case health.syncing:
+			status = dynamic.ServerStatusDown
+			logger = logger.WithField("reason", "syncing")
+		case highestBlock-health.blockNumber > hc.config.MaxBlockLag:
+			status = dynamic.ServerStatusDown
+			logger = logger.WithField("reason", "lagging").WithField("lag", highestBlock-health.blockNumber)
+		}
+		statuses[nodeURL] = status
+
+		if health.err == nil {
+			hc.metrics.NodeBlockLagGauge().With(labels...).Set(float64(highestBlock - health.blockNumber))
+		}
+		hc.reportStatus(chain.UUID, nodeURL, status, labels, logger)
+	}
+
+	if privTxManager != nil && privTxManager.Type == entities.TesseraChainType {
+		logger := hc.logger.WithField("chain", chain.UUID).WithField("url", redactURL(privTxManager.URL))
+		labels := []string{"chain_uuid", chain.UUID, "url", redactURL(privTxManager.URL)}
+
+		status := dynamic.ServerStatusUp
+		if privTxManagerErr != nil {
+			status = dynamic.ServerStatusDown
+			logger = logger.WithError(privTxManagerErr)
+		}
+		statuses[privTxManager.URL] = status
+
+		hc.reportStatus(chain.UUID, privTxManager.URL, status, labels, logger)
+	}
+
+	return statuses
+}
+
+func (hc *HealthChecker) reportStatus(chainUUID, nodeURL, status string, labels []string, logger *log.Logger) {
+	if status == dynamic.ServerStatusUp {
+		hc.metrics.NodeUpGauge().With(labels...).Set(1)
+	} else {
+		hc.metrics.NodeUpGauge().With(labels...).Set(0)
+	}
+
+	if prevStatus := hc.Status(chainUUID, nodeURL); prevStatus != status {
+		if status == dynamic.ServerStatusDown {
+			logger.Warn("chain node ejected from proxy")
+		} else {
+			logger.Info("chain node added back to proxy")
+		}
+	}
+}
+
+func (hc *HealthChecker) checkNode(ctx context.Context, nodeURL string) *nodeHealth {
+	blockNumber, err := hc.ec.BlockNumber(ctx, nodeURL)
+	if err != nil {
+		return &nodeHealth{err: err}
+	}
+
+	progress, err := hc.ec.SyncProgress(ctx, nodeURL)
+	if err != nil {
+		return &nodeHealth{err: err}
+	}
+
+	return &nodeHealth{blockNumber: blockNumber, syncing: progress != nil}
+}
+
+// redactURL removes credentials from node URLs so they can be logged and used as metric labels
+func redactURL(nodeURL string) string {
+	u, err := url.Parse(nodeURL)
+	if err != nil {
+		return ""
+	}
+	u.User = nil
+	return u.String()
+}
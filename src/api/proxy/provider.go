@@ -19,7 +19,7 @@ import (
 
 const ChainsProxyProvider = "chains-proxy"
 
-func NewChainsProxyProvider(searchChains usecases.SearchChainsUseCase, refresh time.Duration, proxyCacheTTL *time.Duration) provider.Provider {
+func NewChainsProxyProvider(searchChains usecases.SearchChainsUseCase, refresh time.Duration, proxyCacheTTL *time.Duration, healthChecker *HealthChecker) provider.Provider {
 	poller := func(ctx context.Context) (provider.Message, error) {
 		// Wildcard user including chains owned by individual users (Special rights)
 		chains, err := searchChains.Execute(ctx, &entities.ChainFilters{}, multitenancy.NewInternalAdminUser())
@@ -27,13 +27,15 @@ func NewChainsProxyProvider(searchChains usecases.SearchChainsUseCase, refresh t
 			return nil, err
 		}
 
-		return dynamic.NewMessage(ChainsProxyProvider, NewProxyConfig(chains, proxyCacheTTL)), nil
+		return dynamic.NewMessage(ChainsProxyProvider, NewProxyConfig(chains, proxyCacheTTL, healthChecker)), nil
 	}
 
 	return poll.New(poller, refresh)
 }
 
-func NewProxyConfig(chains []*entities.Chain, proxyCacheTTL *time.Duration) *dynamic.Configuration {
+// NewProxyConfig builds the proxy configuration of the chains, nodes flagged DOWN by the health checker (if any) are
+// ejected from the load balancer
+func NewProxyConfig(chains []*entities.Chain, proxyCacheTTL *time.Duration, healthChecker *HealthChecker) *dynamic.Configuration {
 	cfg := dynamic.NewConfig()
 
 	for _, chain := range chains {
@@ -58,9 +60,18 @@ func NewProxyConfig(chains []*entities.Chain, proxyCacheTTL *time.Duration) *dyn
 			},
 		}
 
+		// JSON-RPC policy only applies to the chain, not to its private transaction manager
+		rpcPolicyMid := fmt.Sprintf("%s@rpc-policy", chain.UUID)
+		cfg.HTTP.Middlewares[rpcPolicyMid] = &dynamic.Middleware{
+			RPCPolicy: newRPCPolicy(chain),
+		}
+		chainMiddlewares := append([]string{}, middlewares...)
+		chainMiddlewares = append(chainMiddlewares, rpcPolicyMid)
+
 		if proxyCacheTTL != nil {
 			httpCacheMid := fmt.Sprintf("%s@http-cache", chain.UUID)
 			middlewares = append(middlewares, httpCacheMid)
+			chainMiddlewares = append(chainMiddlewares, httpCacheMid)
 			cfg.HTTP.Middlewares[httpCacheMid] = &dynamic.Middleware{
 				HTTPCache: &dynamic.HTTPCache{
 					TTL:       *proxyCacheTTL,
@@ -70,17 +81,52 @@ func NewProxyConfig(chains []*entities.Chain, proxyCacheTTL *time.Duration) *dyn
 		}
 
 		middlewares = append(middlewares, "ratelimit@internal")
+		chainMiddlewares = append(chainMiddlewares, "ratelimit@internal")
 
-		appendChainServices(cfg, chain, middlewares)
+		appendChainServices(cfg, chain, chainMiddlewares, healthChecker)
 
 		if chain.PrivateTxManager != nil {
-			appendTesseraPrivateTxServices(cfg, chain, middlewares)
+			appendTesseraPrivateTxServices(cfg, chain, middlewares, healthChecker)
 		}
 	}
 
 	return cfg
 }
 
+func newRPCPolicy(chain *entities.Chain) *dynamic.RPCPolicy {
+	// Chains keep denying the default methods unless their policy sets its own denied methods
+	policy := entities.DefaultChainRPCPolicy()
+	if chain.RPCPolicy != nil {
+		deniedMethods := policy.DeniedMethods
+		if len(chain.RPCPolicy.DeniedMethods) > 0 {
+			deniedMethods = chain.RPCPolicy.DeniedMethods
+		}
+
+		policy = &entities.ChainRPCPolicy{
+			AllowedMethods: chain.RPCPolicy.AllowedMethods,
+			DeniedMethods:  deniedMethods,
+			MaxBatchSize:   chain.RPCPolicy.MaxBatchSize,
+			TenantQuota:    chain.RPCPolicy.TenantQuota,
+		}
+	}
+
+	rpcPolicy := &dynamic.RPCPolicy{
+		AllowedMethods: policy.AllowedMethods,
+		DeniedMethods:  policy.DeniedMethods,
+		MaxBatchSize:   policy.MaxBatchSize,
+		KeySuffix:      chain.UUID,
+	}
+
+	if policy.TenantQuota != nil {
+		// Period is validated when the chain is registered
+		period, _ := time.ParseDuration(policy.TenantQuota.Period)
+		rpcPolicy.QuotaRequests = policy.TenantQuota.Requests
+		rpcPolicy.QuotaPeriod = period
+	}
+
+	return rpcPolicy
+}
+
 func NewInternalConfig(dynamicCfg *dynamic.Configuration) *dynamic.Configuration {
 	// Log Middleware for Chains
 	dynamicCfg.HTTP.Middlewares["chain-proxy-accesslog"] = &dynamic.Middleware{
@@ -112,14 +158,18 @@ func NewInternalConfig(dynamicCfg *dynamic.Configuration) *dynamic.Configuration
 	return dynamicCfg
 }
 
-func appendChainServices(cfg *dynamic.Configuration, chain *entities.Chain, middlewares []string) {
+func appendChainServices(cfg *dynamic.Configuration, chain *entities.Chain, middlewares []string, healthChecker *HealthChecker) {
 	chainService := fmt.Sprintf("chain-%v", chain.UUID)
 
 	servers := make([]*dynamic.Server, 0)
 	for _, chainURL := range chain.URLs {
-		servers = append(servers, &dynamic.Server{
+		server := &dynamic.Server{
 			URL: chainURL,
-		})
+		}
+		if healthChecker != nil {
+			server.Status = healthChecker.Status(chain.UUID, chainURL)
+		}
+		servers = append(servers, server)
 	}
 
 	cfg.HTTP.Routers[chainService] = &dynamic.Router{
@@ -142,12 +192,16 @@ func appendChainServices(cfg *dynamic.Configuration, chain *entities.Chain, midd
 	}
 }
 
-func appendTesseraPrivateTxServices(cfg *dynamic.Configuration, chain *entities.Chain, middlewares []string) {
+func appendTesseraPrivateTxServices(cfg *dynamic.Configuration, chain *entities.Chain, middlewares []string, healthChecker *HealthChecker) {
 	servers := make([]*dynamic.Server, 0)
 	if chain.PrivateTxManager.Type == entities.TesseraChainType {
-		servers = append(servers, &dynamic.Server{
+		server := &dynamic.Server{
 			URL: chain.PrivateTxManager.URL,
-		})
+		}
+		if healthChecker != nil {
+			server.Status = healthChecker.Status(chain.UUID, chain.PrivateTxManager.URL)
+		}
+		servers = append(servers, server)
 	}
 
 	// Not servers identified
@@ -0,0 +1,49 @@
+// +build unit
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRPCPolicy(t *testing.T) {
+	defaultDeniedMethods := entities.DefaultChainRPCPolicy().DeniedMethods
+
+	t.Run("should apply the default policy to chains without policy", func(t *testing.T) {
+		policy := newRPCPolicy(&entities.Chain{UUID: "chainUUID"})
+
+		assert.Equal(t, defaultDeniedMethods, policy.DeniedMethods)
+		assert.Empty(t, policy.AllowedMethods)
+		assert.Equal(t, "chainUUID", policy.KeySuffix)
+	})
+
+	t.Run("should keep the default denied methods if the policy does not set any", func(t *testing.T) {
+		policy := newRPCPolicy(&entities.Chain{
+			UUID: "chainUUID",
+			RPCPolicy: &entities.ChainRPCPolicy{
+				AllowedMethods: []string{"eth_*"},
+				MaxBatchSize:   10,
+				TenantQuota:    &entities.ChainRPCQuota{Requests: 100, Period: "1m"},
+			},
+		})
+
+		assert.Equal(t, defaultDeniedMethods, policy.DeniedMethods)
+		assert.Equal(t, []string{"eth_*"}, policy.AllowedMethods)
+		assert.Equal(t, 10, policy.MaxBatchSize)
+		assert.Equal(t, 100, policy.QuotaRequests)
+		assert.Equal(t, time.Minute, policy.QuotaPeriod)
+	})
+
+	t.Run("should replace the default denied methods by the ones of the policy", func(t *testing.T) {
+		policy := newRPCPolicy(&entities.Chain{
+			UUID:      "chainUUID",
+			RPCPolicy: &entities.ChainRPCPolicy{DeniedMethods: []string{"eth_sign"}},
+		})
+
+		assert.Equal(t, []string{"eth_sign"}, policy.DeniedMethods)
+	})
+}
@@ -0,0 +1,54 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	intervalFlag     = "api-scheduler-interval"
+	intervalViperKey = "api.scheduler.interval"
+	intervalDefault  = 10 * time.Second
+	intervalEnv      = "API_SCHEDULER_INTERVAL"
+)
+
+const (
+	batchSizeFlag     = "api-scheduler-batch-size"
+	batchSizeViperKey = "api.scheduler.batch-size"
+	batchSizeDefault  = 100
+	batchSizeEnv      = "API_SCHEDULER_BATCH_SIZE"
+)
+
+func init() {
+	viper.SetDefault(intervalViperKey, intervalDefault)
+	_ = viper.BindEnv(intervalViperKey, intervalEnv)
+
+	viper.SetDefault(batchSizeViperKey, batchSizeDefault)
+	_ = viper.BindEnv(batchSizeViperKey, batchSizeEnv)
+}
+
+// Flags register flags for the scheduler of delayed and recurring transactions
+func Flags(f *pflag.FlagSet) {
+	intervalDesc := fmt.Sprintf(`Interval of time between checks for due schedules. Environment variable: %q`, intervalEnv)
+	f.Duration(intervalFlag, intervalDefault, intervalDesc)
+	_ = viper.BindPFlag(intervalViperKey, f.Lookup(intervalFlag))
+
+	batchSizeDesc := fmt.Sprintf(`Maximum number of due schedules run in a single database transaction. Environment variable: %q`, batchSizeEnv)
+	f.Int(batchSizeFlag, batchSizeDefault, batchSizeDesc)
+	_ = viper.BindPFlag(batchSizeViperKey, f.Lookup(batchSizeFlag))
+}
+
+type Config struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+func NewConfig(vipr *viper.Viper) *Config {
+	return &Config{
+		Interval:  vipr.GetDuration(intervalViperKey),
+		BatchSize: vipr.GetInt(batchSizeViperKey),
+	}
+}
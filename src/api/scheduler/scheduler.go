@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+)
+
+const schedulerComponent = "application.scheduler"
+
+// Scheduler periodically runs the due schedules. Schedules are locked while they run so several
+// API instances can run a scheduler concurrently
+type Scheduler struct {
+	runDueSchedulesUC usecases.RunDueSchedulesUseCase
+	config            *Config
+	logger            *log.Logger
+}
+
+func New(runDueSchedulesUC usecases.RunDueSchedulesUseCase, config *Config) *Scheduler {
+	return &Scheduler{
+		runDueSchedulesUC: runDueSchedulesUC,
+		config:            config,
+		logger:            log.NewLogger().SetComponent(schedulerComponent),
+	}
+}
+
+func (s *Scheduler) Run(ctx context.Context) error {
+	ctx = log.With(ctx, s.logger)
+	s.logger.WithField("interval", s.config.Interval).Info("scheduler started")
+
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("scheduler stopped")
+			return nil
+		case <-ticker.C:
+			s.runDueSchedules(ctx)
+		}
+	}
+}
+
+func (s *Scheduler) Close() error {
+	return nil
+}
+
+// runDueSchedules runs batches of due schedules until there are none left
+func (s *Scheduler) runDueSchedules(ctx context.Context) {
+	for ctx.Err() == nil {
+		n, err := s.runDueSchedulesUC.Execute(ctx, s.config.BatchSize)
+		if err != nil {
+			s.logger.WithError(err).Error("failed to run due schedules")
+			return
+		}
+
+		if n < s.config.BatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// +build unit
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRunDueSchedulesUC := mocks.NewMockRunDueSchedulesUseCase(ctrl)
+	cfg := &Config{Interval: 10 * time.Millisecond, BatchSize: 2}
+
+	t.Run("should run due schedules until there are none left and stop when context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		scheduler := New(mockRunDueSchedulesUC, cfg)
+
+		gomock.InOrder(
+			mockRunDueSchedulesUC.EXPECT().Execute(gomock.Any(), cfg.BatchSize).Return(2, nil),
+			mockRunDueSchedulesUC.EXPECT().Execute(gomock.Any(), cfg.BatchSize).DoAndReturn(func(ctx context.Context, limit int) (int, error) {
+				cancel()
+				return 1, nil
+			}),
+		)
+
+		err := scheduler.Run(ctx)
+
+		assert.NoError(t, err)
+		assert.NoError(t, scheduler.Close())
+	})
+
+	t.Run("should keep running if due schedules fail to run", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		scheduler := New(mockRunDueSchedulesUC, cfg)
+
+		gomock.InOrder(
+			mockRunDueSchedulesUC.EXPECT().Execute(gomock.Any(), cfg.BatchSize).Return(0, errors.PostgresConnectionError("error")),
+			mockRunDueSchedulesUC.EXPECT().Execute(gomock.Any(), cfg.BatchSize).DoAndReturn(func(ctx context.Context, limit int) (int, error) {
+				cancel()
+				return 0, nil
+			}),
+		)
+
+		err := scheduler.Run(ctx)
+
+		assert.NoError(t, err)
+	})
+}
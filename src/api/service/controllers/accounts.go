@@ -39,6 +39,8 @@ func (c *AccountsController) Append(router *mux.Router) {
 	router.Methods(http.MethodPost).Path("/accounts/import").HandlerFunc(c.importKey)
 	router.Methods(http.MethodGet).Path("/accounts/{address}").HandlerFunc(c.getOne)
 	router.Methods(http.MethodPatch).Path("/accounts/{address}").HandlerFunc(c.update)
+	router.Methods(http.MethodPut).Path("/accounts/{address}/disable").HandlerFunc(c.disable)
+	router.Methods(http.MethodPost).Path("/accounts/{address}/rotate").HandlerFunc(c.rotate)
 	router.Methods(http.MethodPost).Path("/accounts/{address}/sign-message").HandlerFunc(c.signMessage)
 	router.Methods(http.MethodPost).Path("/accounts/{address}/sign-typed-data").HandlerFunc(c.signTypedData)
 	router.Methods(http.MethodPost).Path("/accounts/verify-message").HandlerFunc(c.verifyMessageSignature)
@@ -118,7 +120,11 @@ func (c *AccountsController) getOne(rw http.ResponseWriter, request *http.Reques
 // @Security     ApiKeyAuth
 // @Security     JWTAuth
 // @Param        aliases  query     []string                false  "List of account aliases"  collectionFormat(csv)
+// @Param        limit    query     int                     false  "Maximum number of results (default 100), a Link header to the next page is returned on full pages"
+// @Param        next     query     string                  false  "Opaque cursor of the next page, as returned in the Link header"
+// @Param        sort     query     string                  false  "Sort key (created_at or updated_at), prefixed by - for descending order"
 // @Success      200      {array}   api.AccountResponse     "List of identities found"
+// @Header       200      {string}  Link                    "Link to the next page of results"
 // @Failure      400      {object}  httputil.ErrorResponse  "Invalid filter in the request"
 // @Failure      401      {object}  httputil.ErrorResponse  "Unauthorized"
 // @Failure      500      {object}  httputil.ErrorResponse  "Internal server error"
@@ -139,6 +145,11 @@ func (c *AccountsController) search(rw http.ResponseWriter, request *http.Reques
 		return
 	}
 
+	if filters.HasNextPage(len(accs)) {
+		last := accs[len(accs)-1]
+		rw.Header().Set("Link", formatters.FormatNextPageLink(request, filters.Sort, last.CreatedAt, last.UpdatedAt, last.Address.String()))
+	}
+
 	response := []*api.AccountResponse{}
 	for _, iden := range accs {
 		response = append(response, formatters.FormatAccountResponse(iden))
@@ -227,6 +238,82 @@ func (c *AccountsController) update(rw http.ResponseWriter, request *http.Reques
 	_ = json.NewEncoder(rw).Encode(formatters.FormatAccountResponse(accRes))
 }
 
+// @Summary      Disable account by Address
+// @Description  Disable a specific account by Address. Disabled accounts cannot send new transactions.
+// @Tags         Accounts
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        address  path      string                  true  "selected account address"
+// @Success      200      {object}  api.AccountResponse     "Account disabled"
+// @Failure      400      {object}  httputil.ErrorResponse  "Invalid request"
+// @Failure      401      {object}  httputil.ErrorResponse  "Unauthorized"
+// @Failure      404      {object}  httputil.ErrorResponse  "Account not found"
+// @Failure      409      {object}  httputil.ErrorResponse  "Account already disabled"
+// @Failure      500      {object}  httputil.ErrorResponse  "Internal server error"
+// @Router       /accounts/{address}/disable [put]
+func (c *AccountsController) disable(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	address, err := utils.ParseHexToMixedCaseEthAddress(mux.Vars(request)["address"])
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	accRes, err := c.ucs.DisableAccount().Execute(ctx, *address, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatAccountResponse(accRes))
+}
+
+// @Summary      Rotate account by Address
+// @Description  Create a successor of a specific account, send its remaining balance on the given chain to the successor and disable it
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.RotateAccountRequest  true  "Account rotation request"
+// @Param        address  path      string                    true  "selected account address"
+// @Success      200      {object}  api.AccountResponse       "Successor account"
+// @Failure      400      {object}  httputil.ErrorResponse    "Invalid request"
+// @Failure      401      {object}  httputil.ErrorResponse    "Unauthorized"
+// @Failure      404      {object}  httputil.ErrorResponse    "Account not found"
+// @Failure      409      {object}  httputil.ErrorResponse    "Account already disabled"
+// @Failure      500      {object}  httputil.ErrorResponse    "Internal server error"
+// @Router       /accounts/{address}/rotate [post]
+func (c *AccountsController) rotate(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	rotateRequest := &api.RotateAccountRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, rotateRequest)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	address, err := utils.ParseHexToMixedCaseEthAddress(mux.Vars(request)["address"])
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	accRes, err := c.ucs.RotateAccount().Execute(ctx, *address, formatters.FormatRotateAccountRequest(rotateRequest),
+		rotateRequest.Chain, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatAccountResponse(accRes))
+}
+
 // @Summary      Sign Message (EIP-191)
 // @Description  Sign message, following EIP-191, data using selected account
 // @Tags         Accounts
@@ -10,7 +10,9 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/consensys/orchestrate/pkg/errors"
 	qkm "github.com/consensys/orchestrate/src/infra/quorum-key-manager"
 	api "github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/api/business/use-cases"
@@ -38,6 +40,8 @@ type accountsCtrlTestSuite struct {
 	getAccountUC     *mocks.MockGetAccountUseCase
 	searchAccountUC  *mocks.MockSearchAccountsUseCase
 	updateAccountUC  *mocks.MockUpdateAccountUseCase
+	disableAccountUC *mocks.MockDisableAccountUseCase
+	rotateAccountUC  *mocks.MockRotateAccountUseCase
 	fundAccountUC    *mocks.MockFundAccountUseCase
 	keyManagerClient *qkmmock.MockKeyManagerClient
 	ctx              context.Context
@@ -63,6 +67,14 @@ func (s *accountsCtrlTestSuite) UpdateAccount() usecases.UpdateAccountUseCase {
 	return s.updateAccountUC
 }
 
+func (s *accountsCtrlTestSuite) DisableAccount() usecases.DisableAccountUseCase {
+	return s.disableAccountUC
+}
+
+func (s *accountsCtrlTestSuite) RotateAccount() usecases.RotateAccountUseCase {
+	return s.rotateAccountUC
+}
+
 func (s *accountsCtrlTestSuite) FundAccount() usecases.FundAccountUseCase {
 	return s.fundAccountUC
 }
@@ -86,6 +98,8 @@ func (s *accountsCtrlTestSuite) SetupTest() {
 	s.getAccountUC = mocks.NewMockGetAccountUseCase(ctrl)
 	s.searchAccountUC = mocks.NewMockSearchAccountsUseCase(ctrl)
 	s.updateAccountUC = mocks.NewMockUpdateAccountUseCase(ctrl)
+	s.disableAccountUC = mocks.NewMockDisableAccountUseCase(ctrl)
+	s.rotateAccountUC = mocks.NewMockRotateAccountUseCase(ctrl)
 	s.keyManagerClient = qkmmock.NewMockKeyManagerClient(ctrl)
 	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
 	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
@@ -243,6 +257,78 @@ func (s *accountsCtrlTestSuite) TestAccountController_UpdateAccount() {
 	})
 }
 
+func (s *accountsCtrlTestSuite) TestAccountController_DisableAccount() {
+	s.T().Run("should execute disable account request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/accounts/"+inputTestAddress+"/disable", nil).
+			WithContext(s.ctx)
+
+		disabledAt := time.Now()
+		acc := testdata.FakeAccount()
+		acc.DisabledAt = &disabledAt
+		acc.DisabledBy = s.userInfo.Username
+
+		s.disableAccountUC.EXPECT().Execute(gomock.Any(), ethcommon.HexToAddress(mixedCaseTestAddress), s.userInfo).Return(acc, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatAccountResponse(acc)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 409 if account is already disabled", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/accounts/"+inputTestAddress+"/disable", nil).
+			WithContext(s.ctx)
+
+		s.disableAccountUC.EXPECT().Execute(gomock.Any(), ethcommon.HexToAddress(mixedCaseTestAddress), s.userInfo).
+			Return(nil, errors.InvalidStateError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusConflict, rw.Code)
+	})
+}
+
+func (s *accountsCtrlTestSuite) TestAccountController_RotateAccount() {
+	s.T().Run("should execute rotate account request successfully", func(t *testing.T) {
+		req := &api.RotateAccountRequest{Alias: "successor", Chain: "besu"}
+		rw := httptest.NewRecorder()
+		requestBytes, _ := json.Marshal(req)
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/accounts/"+inputTestAddress+"/rotate", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		predecessor := ethcommon.HexToAddress(mixedCaseTestAddress)
+		acc := testdata.FakeAccount()
+		acc.Predecessor = &predecessor
+
+		s.rotateAccountUC.EXPECT().Execute(gomock.Any(), predecessor, &entities.Account{Alias: "successor"}, "besu", s.userInfo).
+			Return(acc, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatAccountResponse(acc)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail to rotate account if invalid address", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/accounts/invalidAddress/rotate", bytes.NewReader([]byte("{}"))).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
 func (s *accountsCtrlTestSuite) TestAccountController_SearchIdentity() {
 	s.T().Run("should execute search account request successfully", func(t *testing.T) {
 		accResp := testdata.FakeAccount()
@@ -254,7 +340,8 @@ func (s *accountsCtrlTestSuite) TestAccountController_SearchIdentity() {
 			WithContext(s.ctx)
 
 		filter := &entities.AccountFilters{
-			Aliases: aliases,
+			Pagination: entities.Pagination{Limit: entities.DefaultPageLimit},
+			Aliases:    aliases,
 		}
 
 		s.searchAccountUC.EXPECT().Execute(gomock.Any(), filter, s.userInfo).Return([]*entities.Account{accResp}, nil)
@@ -25,6 +25,7 @@ import (
 // @description Faucets represent funded accounts (holding ETH) linked to specific chains, allowed to fund newly created accounts automatically for them to be able to send transactions.
 // @description Accounts represent Ethereum accounts (private keys). By usage of the generated cryptographic key pair, accounts can be used to sign/verify and to encrypt/decrypt messages.
 // @description Contracts represent Solidity contracts management.
+// @description Webhooks represent subscriptions to the job status changes of a tenant, delivered to an HTTP endpoint.
 
 // @contact.name Contact ConsenSys Codefi Orchestrate
 // @contact.url https://consensys.net/codefi/orchestrate/contact
@@ -49,6 +50,7 @@ type Builder struct {
 	faucetsCtrl   *FaucetsController
 	chainsCtrl    *ChainsController
 	contractsCtrl *ContractsController
+	webhooksCtrl  *WebhooksController
 }
 
 func NewBuilder(ucs usecases.UseCases, keyManagerClient qkm.KeyManagerClient, qkmStoreID string) *Builder {
@@ -60,6 +62,7 @@ func NewBuilder(ucs usecases.UseCases, keyManagerClient qkm.KeyManagerClient, qk
 		faucetsCtrl:   NewFaucetsController(ucs),
 		chainsCtrl:    NewChainsController(ucs),
 		contractsCtrl: NewContractsController(ucs),
+		webhooksCtrl:  NewWebhooksController(ucs),
 	}
 }
 
@@ -77,6 +80,7 @@ func (b *Builder) Build(_ context.Context, _ string, configuration interface{},
 	b.faucetsCtrl.Append(router)
 	b.chainsCtrl.Append(router)
 	b.contractsCtrl.Append(router)
+	b.webhooksCtrl.Append(router)
 
 	return router, nil
 }
@@ -39,9 +39,13 @@ func (c *ChainsController) Append(router *mux.Router) {
 // @Produce   json
 // @Security  ApiKeyAuth
 // @Security  JWTAuth
-// @Success   200  {array}   api.ChainResponse{privateTxManager=entities.PrivateTxManager}
-// @Failure   400  {object}  httputil.ErrorResponse  "Invalid request"
-// @Failure   500  {object}  httputil.ErrorResponse  "Internal server error"
+// @Param     limit  query     int                     false  "Maximum number of results (default 100), a Link header to the next page is returned on full pages"
+// @Param     next   query     string                  false  "Opaque cursor of the next page, as returned in the Link header"
+// @Param     sort   query     string                  false  "Sort key (created_at or updated_at), prefixed by - for descending order"
+// @Success   200    {array}   api.ChainResponse{privateTxManager=entities.PrivateTxManager}
+// @Header    200    {string}  Link                    "Link to the next page of results"
+// @Failure   400    {object}  httputil.ErrorResponse  "Invalid request"
+// @Failure   500    {object}  httputil.ErrorResponse  "Internal server error"
 // @Router    /chains [get]
 func (c *ChainsController) search(rw http.ResponseWriter, request *http.Request) {
 	rw.Header().Set("Content-Type", "application/json")
@@ -59,6 +63,11 @@ func (c *ChainsController) search(rw http.ResponseWriter, request *http.Request)
 		return
 	}
 
+	if filters.HasNextPage(len(chains)) {
+		last := chains[len(chains)-1]
+		rw.Header().Set("Link", formatters.FormatNextPageLink(request, filters.Sort, last.CreatedAt, last.UpdatedAt, last.UUID))
+	}
+
 	response := []*api.ChainResponse{}
 	for _, chain := range chains {
 		response = append(response, formatters.FormatChainResponse(chain))
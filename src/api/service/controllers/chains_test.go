@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math/big"
 	api "github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/api/business/use-cases"
@@ -21,11 +22,13 @@ import (
 	"github.com/stretchr/testify/suite"
 	"encoding/json"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	apitestdata "github.com/consensys/orchestrate/src/api/service/types/testdata"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 const chainsEndpoint = "/chains"
@@ -130,6 +133,94 @@ func (s *chainsCtrlTestSuite) TestRegister() {
 		assert.Equal(t, http.StatusOK, rw.Code)
 	})
 
+	s.T().Run("should execute request successfully with a JSON-RPC policy", func(t *testing.T) {
+		req := apitestdata.FakeRegisterChainRequest()
+		req.RPCPolicy = &entities.ChainRPCPolicy{
+			DeniedMethods: []string{"admin_*"},
+			MaxBatchSize:  10,
+			TenantQuota:   &entities.ChainRPCQuota{Requests: 1000, Period: "1m"},
+		}
+		requestBytes, _ := json.Marshal(req)
+		chain := testdata.FakeChain()
+		chain.RPCPolicy = req.RPCPolicy
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, chainsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		expectedChain, _ := formatters.FormatRegisterChainRequest(req, true)
+		s.registerChainUC.EXPECT().Execute(gomock.Any(), expectedChain, true, s.userInfo).Return(chain, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatChainResponse(chain)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if invalid JSON-RPC policy", func(t *testing.T) {
+		req := apitestdata.FakeRegisterChainRequest()
+		req.RPCPolicy = &entities.ChainRPCPolicy{
+			TenantQuota: &entities.ChainRPCQuota{Requests: 1000, Period: "invalid"},
+		}
+		requestBytes, _ := json.Marshal(req)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, chainsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should execute request successfully with a gas oracle", func(t *testing.T) {
+		req := apitestdata.FakeRegisterChainRequest()
+		req.GasOracle = &entities.ChainGasOracle{
+			Type:              entities.FeeHistoryGasOracle,
+			BlockCount:        10,
+			RewardPercentiles: map[string]float64{utils.PriorityHigh: 80},
+			MaxFee:            (*hexutil.Big)(big.NewInt(100000000000)),
+		}
+		requestBytes, _ := json.Marshal(req)
+		chain := testdata.FakeChain()
+		chain.GasOracle = req.GasOracle
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, chainsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		expectedChain, _ := formatters.FormatRegisterChainRequest(req, true)
+		s.registerChainUC.EXPECT().Execute(gomock.Any(), expectedChain, true, s.userInfo).Return(chain, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatChainResponse(chain)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if invalid gas oracle", func(t *testing.T) {
+		req := apitestdata.FakeRegisterChainRequest()
+		req.GasOracle = &entities.ChainGasOracle{
+			Type:              entities.FeeHistoryGasOracle,
+			RewardPercentiles: map[string]float64{"urgent": 99},
+		}
+		requestBytes, _ := json.Marshal(req)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, chainsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
 	s.T().Run("should fail with Bad request if invalid format", func(t *testing.T) {
 		req := apitestdata.FakeRegisterChainRequest()
 		req.Name = ""
@@ -257,7 +348,7 @@ func (s *chainsCtrlTestSuite) TestSearch() {
 			NewRequest(http.MethodGet, "/chains?names="+strings.Join(names, ","), nil).
 			WithContext(s.ctx)
 
-		expectedFilters := &entities.ChainFilters{Names: names}
+		expectedFilters := &entities.ChainFilters{Pagination: entities.Pagination{Limit: entities.DefaultPageLimit}, Names: names}
 		s.searchChainUC.EXPECT().Execute(gomock.Any(), expectedFilters, s.userInfo).Return([]*entities.Chain{chain}, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
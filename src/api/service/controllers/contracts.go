@@ -9,6 +9,7 @@ import (
 	jsonutils "github.com/consensys/orchestrate/pkg/encoding/json"
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/service/formatters"
 	api "github.com/consensys/orchestrate/src/api/service/types"
@@ -35,11 +36,14 @@ func (c *ContractsController) Append(router *mux.Router) {
 	router.Methods(http.MethodPost).Path("/contracts/accounts/{chain_id}/{address}").HandlerFunc(c.setCodeHash)
 	router.Methods(http.MethodGet).Path("/contracts/accounts/{chain_id}/{address}/events").HandlerFunc(c.getEvents)
 	router.Methods(http.MethodGet).Path("/contracts/{name}").HandlerFunc(c.getTags)
+	router.Methods(http.MethodDelete).Path("/contracts/{name}").HandlerFunc(c.deleteRepository)
 	router.Methods(http.MethodGet).Path("/contracts/{name}/{tag}").HandlerFunc(c.getContract)
+	router.Methods(http.MethodPut).Path("/contracts/{name}/{tag}").HandlerFunc(c.setTag)
+	router.Methods(http.MethodDelete).Path("/contracts/{name}/{tag}").HandlerFunc(c.deregister)
 }
 
 // @Summary      Returns a list of all registered contracts
-// @Description  Returns a list of all registered contracts
+// @Description  Returns a list of the registered contracts visible to the caller, including the shared ones
 // @Tags         Contracts
 // @Produce      json
 // @Security     ApiKeyAuth
@@ -51,7 +55,7 @@ func (c *ContractsController) getCatalog(rw http.ResponseWriter, request *http.R
 	rw.Header().Set("Content-Type", "application/json")
 	ctx := request.Context()
 
-	names, err := c.ucs.GetContractsCatalog().Execute(ctx)
+	names, err := c.ucs.GetContractsCatalog().Execute(ctx, multitenancy.UserInfoValue(ctx))
 
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
@@ -94,13 +98,13 @@ func (c *ContractsController) register(rw http.ResponseWriter, request *http.Req
 		return
 	}
 
-	err = c.ucs.RegisterContract().Execute(ctx, contract)
+	err = c.ucs.RegisterContract().Execute(ctx, contract, multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
-	contract, err = c.ucs.GetContract().Execute(ctx, contract.Name, contract.Tag)
+	contract, err = c.ucs.GetContract().Execute(ctx, contract.Name, contract.Tag, multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
 		return
@@ -133,7 +137,7 @@ func (c *ContractsController) search(rw http.ResponseWriter, request *http.Reque
 		return
 	}
 
-	contract, err := c.ucs.SearchContract().Execute(ctx, req.CodeHash, req.Address)
+	contract, err := c.ucs.SearchContract().Execute(ctx, req.CodeHash, req.Address, multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
 		return
@@ -174,7 +178,7 @@ func (c *ContractsController) setCodeHash(rw http.ResponseWriter, request *http.
 		return
 	}
 
-	err = c.ucs.SetContractCodeHash().Execute(ctx, chainID, ethcommon.HexToAddress(address), req.CodeHash)
+	err = c.ucs.SetContractCodeHash().Execute(ctx, chainID, ethcommon.HexToAddress(address), req.CodeHash, multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
 		return
@@ -216,7 +220,7 @@ func (c *ContractsController) getEvents(rw http.ResponseWriter, request *http.Re
 	}
 
 	abi, abiEvents, err := c.ucs.GetContractEvents().Execute(ctx, chainID, ethcommon.HexToAddress(address),
-		req.SigHash, req.IndexedInputCount)
+		req.SigHash, req.IndexedInputCount, multitenancy.UserInfoValue(ctx))
 
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
@@ -240,7 +244,7 @@ func (c *ContractsController) getTags(rw http.ResponseWriter, request *http.Requ
 	rw.Header().Set("Content-Type", "application/json")
 	ctx := request.Context()
 
-	tags, err := c.ucs.GetContractTags().Execute(ctx, mux.Vars(request)["name"])
+	tags, err := c.ucs.GetContractTags().Execute(ctx, mux.Vars(request)["name"], multitenancy.UserInfoValue(ctx))
 
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
@@ -269,7 +273,7 @@ func (c *ContractsController) getContract(rw http.ResponseWriter, request *http.
 	rw.Header().Set("Content-Type", "application/json")
 	ctx := request.Context()
 
-	contract, err := c.ucs.GetContract().Execute(ctx, mux.Vars(request)["name"], mux.Vars(request)["tag"])
+	contract, err := c.ucs.GetContract().Execute(ctx, mux.Vars(request)["name"], mux.Vars(request)["tag"], multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
 		return
@@ -277,3 +281,92 @@ func (c *ContractsController) getContract(rw http.ResponseWriter, request *http.
 
 	_ = json.NewEncoder(rw).Encode(formatters.FormatContractResponse(contract))
 }
+
+// @Summary      Point a tag to a registered contract
+// @Description  Point the tag {tag} of the contract {name} to the contract registered with the source tag, creating {tag} if it does not exist
+// @Tags         Contracts
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        name     path      string                                                                                                                  true  "solidity contract registered name"
+// @Param        tag      path      string                                                                                                                  true  "solidity contract tag to set"
+// @Param        request  body      api.SetContractTagRequest                                                                                               true  "Contract tag request"
+// @Success      200      {object}  api.ContractResponse{constructor=entities.ABIComponent,methods=[]entities.ABIComponent,events=[]entities.ABIComponent}  "Contract object"
+// @Failure      400      {object}  httputil.ErrorResponse                                                                                                  "Invalid request"
+// @Failure      404      {object}  httputil.ErrorResponse                                                                                                  "Contract not found"
+// @Failure      500      {object}  httputil.ErrorResponse                                                                                                  "Internal server error"
+// @Router       /contracts/{name}/{tag} [put]
+func (c *ContractsController) setTag(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.SetContractTagRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, req)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	name, tag := mux.Vars(request)["name"], mux.Vars(request)["tag"]
+	err = c.ucs.SetContractTag().Execute(ctx, name, tag, req.SourceTag, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	contract, err := c.ucs.GetContract().Execute(ctx, name, tag, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatContractResponse(contract))
+}
+
+// @Summary      Deregister a contract tag
+// @Description  Deregister the tag {tag} of the contract {name}, the contract being deleted with its last tag
+// @Tags         Contracts
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        name  path  string  true  "solidity contract registered name"
+// @Param        tag   path  string  true  "solidity contract registered tag"
+// @Success      204
+// @Failure      404  {object}  httputil.ErrorResponse  "Contract not found"
+// @Failure      500  {object}  httputil.ErrorResponse  "Internal server error"
+// @Router       /contracts/{name}/{tag} [delete]
+func (c *ContractsController) deregister(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	err := c.ucs.DeregisterContract().Execute(ctx, mux.Vars(request)["name"], mux.Vars(request)["tag"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Delete a contract
+// @Description  Delete the contract {name} with all its tags
+// @Tags         Contracts
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        name  path  string  true  "solidity contract registered name"
+// @Success      204
+// @Failure      404  {object}  httputil.ErrorResponse  "Contract not found"
+// @Failure      500  {object}  httputil.ErrorResponse  "Internal server error"
+// @Router       /contracts/{name} [delete]
+func (c *ContractsController) deleteRepository(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	err := c.ucs.DeleteContractRepository().Execute(ctx, mux.Vars(request)["name"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
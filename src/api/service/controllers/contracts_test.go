@@ -14,6 +14,8 @@ import (
 	api "github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	apitestdata "github.com/consensys/orchestrate/src/api/service/types/testdata"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/utils"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
@@ -34,7 +36,12 @@ type contractsCtrlTestSuite struct {
 	setContractCodeHash *mocks.MockSetContractCodeHashUseCase
 	registerContract    *mocks.MockRegisterContractUseCase
 	searchContract      *mocks.MockSearchContractUseCase
+	deregisterContract  *mocks.MockDeregisterContractUseCase
+	deleteRepository    *mocks.MockDeleteContractRepositoryUseCase
+	setContractTag      *mocks.MockSetContractTagUseCase
 	router              *mux.Router
+	userInfo            *multitenancy.UserInfo
+	ctx                 context.Context
 }
 
 var _ usecases.ContractUseCases = &contractsCtrlTestSuite{}
@@ -60,6 +67,15 @@ func (s *contractsCtrlTestSuite) RegisterContract() usecases.RegisterContractUse
 func (s *contractsCtrlTestSuite) SearchContract() usecases.SearchContractUseCase {
 	return s.searchContract
 }
+func (s *contractsCtrlTestSuite) DeregisterContract() usecases.DeregisterContractUseCase {
+	return s.deregisterContract
+}
+func (s *contractsCtrlTestSuite) DeleteContractRepository() usecases.DeleteContractRepositoryUseCase {
+	return s.deleteRepository
+}
+func (s *contractsCtrlTestSuite) SetContractTag() usecases.SetContractTagUseCase {
+	return s.setContractTag
+}
 
 func TestContractController(t *testing.T) {
 	s := new(contractsCtrlTestSuite)
@@ -77,14 +93,19 @@ func (s *contractsCtrlTestSuite) SetupTest() {
 	s.setContractCodeHash = mocks.NewMockSetContractCodeHashUseCase(ctrl)
 	s.registerContract = mocks.NewMockRegisterContractUseCase(ctrl)
 	s.searchContract = mocks.NewMockSearchContractUseCase(ctrl)
+	s.deregisterContract = mocks.NewMockDeregisterContractUseCase(ctrl)
+	s.deleteRepository = mocks.NewMockDeleteContractRepositoryUseCase(ctrl)
+	s.setContractTag = mocks.NewMockSetContractTagUseCase(ctrl)
 	s.router = mux.NewRouter()
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
 
 	controller := NewContractsController(s)
 	controller.Append(s.router)
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_Register() {
-	ctx := context.Background()
+	ctx := s.ctx
 	s.T().Run("should execute register contract request successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
 		req := apitestdata.FakeRegisterContractRequest()
@@ -94,10 +115,10 @@ func (s *contractsCtrlTestSuite) TestContractsController_Register() {
 			WithContext(ctx)
 
 		expectedContract, _ := formatters.FormatRegisterContractRequest(req)
-		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract).Return(nil)
+		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract, s.userInfo).Return(nil)
 
 		contract := testdata.FakeContract()
-		s.getContract.EXPECT().Execute(gomock.Any(), req.Name, req.Tag).Return(contract, nil)
+		s.getContract.EXPECT().Execute(gomock.Any(), req.Name, req.Tag, s.userInfo).Return(contract, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(formatters.FormatContractResponse(contract))
@@ -126,7 +147,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_Register() {
 			WithContext(ctx)
 
 		expectedContract, _ := formatters.FormatRegisterContractRequest(req)
-		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract).Return(fmt.Errorf("error"))
+		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract, s.userInfo).Return(fmt.Errorf("error"))
 
 		s.router.ServeHTTP(rw, httpRequest)
 		assert.Equal(t, http.StatusInternalServerError, rw.Code)
@@ -141,9 +162,9 @@ func (s *contractsCtrlTestSuite) TestContractsController_Register() {
 			WithContext(ctx)
 
 		expectedContract, _ := formatters.FormatRegisterContractRequest(req)
-		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract).Return(nil)
+		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract, s.userInfo).Return(nil)
 
-		s.getContract.EXPECT().Execute(gomock.Any(), req.Name, req.Tag).Return(nil, fmt.Errorf("error"))
+		s.getContract.EXPECT().Execute(gomock.Any(), req.Name, req.Tag, s.userInfo).Return(nil, fmt.Errorf("error"))
 
 		s.router.ServeHTTP(rw, httpRequest)
 		assert.Equal(t, http.StatusInternalServerError, rw.Code)
@@ -151,7 +172,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_Register() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_CodeHash() {
-	ctx := context.Background()
+	ctx := s.ctx
 	chainID := "2017"
 	address := testdata.FakeAddress()
 
@@ -164,7 +185,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_CodeHash() {
 			WithContext(ctx)
 
 		s.setContractCodeHash.EXPECT().
-			Execute(gomock.Any(), chainID, *address, req.CodeHash).
+			Execute(gomock.Any(), chainID, *address, req.CodeHash, s.userInfo).
 			Return(nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
@@ -193,7 +214,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_CodeHash() {
 			WithContext(ctx)
 
 		s.setContractCodeHash.EXPECT().
-			Execute(gomock.Any(), chainID, *address, req.CodeHash).
+			Execute(gomock.Any(), chainID, *address, req.CodeHash, s.userInfo).
 			Return(fmt.Errorf("error"))
 
 		s.router.ServeHTTP(rw, httpRequest)
@@ -202,7 +223,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_CodeHash() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_GetContract() {
-	ctx := context.Background()
+	ctx := s.ctx
 
 	s.T().Run("should execute get contract successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -211,7 +232,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContract() {
 			NewRequest(http.MethodGet, fmt.Sprintf("/contracts/%s/%s", contract.Name, contract.Tag), nil).
 			WithContext(ctx)
 
-		s.getContract.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag).Return(contract, nil)
+		s.getContract.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, s.userInfo).Return(contract, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(formatters.FormatContractResponse(contract))
@@ -225,7 +246,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContract() {
 			NewRequest(http.MethodGet, fmt.Sprintf("/contracts/%s/%s", contract.Name, contract.Tag), nil).
 			WithContext(ctx)
 
-		s.getContract.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag).Return(nil, fmt.Errorf("error"))
+		s.getContract.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, s.userInfo).Return(nil, fmt.Errorf("error"))
 
 		s.router.ServeHTTP(rw, httpRequest)
 		assert.Equal(t, http.StatusInternalServerError, rw.Code)
@@ -233,7 +254,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContract() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_SearchContract() {
-	ctx := context.Background()
+	ctx := s.ctx
 
 	req := apitestdata.FakeSearchContractRequest()
 
@@ -244,7 +265,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_SearchContract() {
 			NewRequest(http.MethodGet, fmt.Sprintf("/contracts/search?code_hash=%s", req.CodeHash.String()), nil).
 			WithContext(ctx)
 
-		s.searchContract.EXPECT().Execute(gomock.Any(), req.CodeHash, nil).Return(contract, nil)
+		s.searchContract.EXPECT().Execute(gomock.Any(), req.CodeHash, nil, s.userInfo).Return(contract, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(formatters.FormatContractResponse(contract))
@@ -258,7 +279,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_SearchContract() {
 			NewRequest(http.MethodGet, fmt.Sprintf("/contracts/search?address=%s", req.Address.String()), nil).
 			WithContext(ctx)
 
-		s.searchContract.EXPECT().Execute(gomock.Any(), nil, req.Address).Return(contract, nil)
+		s.searchContract.EXPECT().Execute(gomock.Any(), nil, req.Address, s.userInfo).Return(contract, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(formatters.FormatContractResponse(contract))
@@ -267,7 +288,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_SearchContract() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_GetContractEvents() {
-	ctx := context.Background()
+	ctx := s.ctx
 	address := ethcommon.HexToAddress(utils.RandHexString(10))
 	sigHash := utils.StringToHexBytes("0x" + utils.RandHexString(10))
 	indexInput := uint32(2)
@@ -285,7 +306,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContractEvents() {
 				chainID, address.Hex(), sigHash, indexInput), nil).
 			WithContext(ctx)
 
-		s.getContractEvents.EXPECT().Execute(gomock.Any(), chainID, address, sigHash, indexInput).Return(string(rawEvent), []string{string(rawDefaultEvent)}, nil)
+		s.getContractEvents.EXPECT().Execute(gomock.Any(), chainID, address, sigHash, indexInput, s.userInfo).Return(string(rawEvent), []string{string(rawDefaultEvent)}, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(api.GetContractEventsBySignHashResponse{Event: string(rawEvent), DefaultEvents: []string{string(rawDefaultEvent)}})
@@ -306,7 +327,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContractEvents() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_GetContractsCatalog() {
-	ctx := context.Background()
+	ctx := s.ctx
 
 	s.T().Run("should execute get catalog successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -315,10 +336,105 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContractsCatalog() {
 			WithContext(ctx)
 
 		catalog := []string{"contractOne", "contractTwo"}
-		s.getContractsCatalog.EXPECT().Execute(gomock.Any()).Return(catalog, nil)
+		s.getContractsCatalog.EXPECT().Execute(gomock.Any(), s.userInfo).Return(catalog, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(catalog)
 		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
 	})
 }
+
+func (s *contractsCtrlTestSuite) TestContractsController_GetContractTags() {
+	ctx := s.ctx
+
+	s.T().Run("should execute get tags successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, "/contracts/contractOne", nil).
+			WithContext(ctx)
+
+		tags := []string{"latest", "v1.0.0"}
+		s.getContractTags.EXPECT().Execute(gomock.Any(), "contractOne", s.userInfo).Return(tags, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		expectedBody, _ := json.Marshal(tags)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+	})
+}
+
+func (s *contractsCtrlTestSuite) TestContractsController_SetContractTag() {
+	ctx := s.ctx
+
+	s.T().Run("should execute set tag request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		requestBytes, _ := json.Marshal(&api.SetContractTagRequest{SourceTag: "v1.0.0"})
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/contracts/contractOne/latest", bytes.NewReader(requestBytes)).
+			WithContext(ctx)
+
+		contract := testdata.FakeContract()
+		s.setContractTag.EXPECT().Execute(gomock.Any(), "contractOne", "latest", "v1.0.0", s.userInfo).Return(nil)
+		s.getContract.EXPECT().Execute(gomock.Any(), "contractOne", "latest", s.userInfo).Return(contract, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatContractResponse(contract)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if source tag is missing", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/contracts/contractOne/latest", bytes.NewReader([]byte("{}"))).
+			WithContext(ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *contractsCtrlTestSuite) TestContractsController_Deregister() {
+	ctx := s.ctx
+
+	s.T().Run("should execute deregister request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, "/contracts/contractOne/v1.0.0", nil).
+			WithContext(ctx)
+
+		s.deregisterContract.EXPECT().Execute(gomock.Any(), "contractOne", "v1.0.0", s.userInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+
+	s.T().Run("should fail with 404 if use case fails with NotFoundError", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, "/contracts/contractOne/v1.0.0", nil).
+			WithContext(ctx)
+
+		s.deregisterContract.EXPECT().Execute(gomock.Any(), "contractOne", "v1.0.0", s.userInfo).Return(errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *contractsCtrlTestSuite) TestContractsController_DeleteRepository() {
+	ctx := s.ctx
+
+	s.T().Run("should execute delete request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, "/contracts/contractOne", nil).
+			WithContext(ctx)
+
+		s.deleteRepository.EXPECT().Execute(gomock.Any(), "contractOne", s.userInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+}
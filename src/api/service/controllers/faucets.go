@@ -36,9 +36,13 @@ func (c *FaucetsController) Append(router *mux.Router) {
 // @Produce   json
 // @Security  ApiKeyAuth
 // @Security  JWTAuth
-// @Success   200  {array}   api.FaucetResponse
-// @Failure   400  {object}  httputil.ErrorResponse  "Invalid request"
-// @Failure   500  {object}  httputil.ErrorResponse  "Internal server error"
+// @Param     limit  query     int                     false  "Maximum number of results (default 100), a Link header to the next page is returned on full pages"
+// @Param     next   query     string                  false  "Opaque cursor of the next page, as returned in the Link header"
+// @Param     sort   query     string                  false  "Sort key (created_at or updated_at), prefixed by - for descending order"
+// @Success   200    {array}   api.FaucetResponse
+// @Header    200    {string}  Link                    "Link to the next page of results"
+// @Failure   400    {object}  httputil.ErrorResponse  "Invalid request"
+// @Failure   500    {object}  httputil.ErrorResponse  "Internal server error"
 // @Router    /faucets [get]
 func (c *FaucetsController) search(rw http.ResponseWriter, request *http.Request) {
 	rw.Header().Set("Content-Type", "application/json")
@@ -56,6 +60,11 @@ func (c *FaucetsController) search(rw http.ResponseWriter, request *http.Request
 		return
 	}
 
+	if filters.HasNextPage(len(faucets)) {
+		last := faucets[len(faucets)-1]
+		rw.Header().Set("Link", formatters.FormatNextPageLink(request, filters.Sort, last.CreatedAt, last.UpdatedAt, last.UUID))
+	}
+
 	response := []*api.FaucetResponse{}
 	for _, faucet := range faucets {
 		response = append(response, formatters.FormatFaucetResponse(faucet))
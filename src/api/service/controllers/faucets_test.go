@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -24,6 +25,7 @@ import (
 	apitestdata "github.com/consensys/orchestrate/src/api/service/types/testdata"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
@@ -160,6 +162,37 @@ func (s *faucetsCtrlTestSuite) TestFaucetsController_GetOne() {
 		assert.Equal(t, http.StatusOK, rw.Code)
 	})
 
+	s.T().Run("should return the daily spending and the spending history of the faucet", func(t *testing.T) {
+		faucet := testdata.FakeFaucet()
+		faucet.DailyBudget = (*hexutil.Big)(big.NewInt(1000))
+		faucet.DailySpent = (*hexutil.Big)(big.NewInt(60))
+		faucet.Spendings = []*entities.FaucetSpending{{
+			FaucetUUID:  faucet.UUID,
+			TenantID:    faucet.TenantID,
+			Beneficiary: ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"),
+			Amount:      hexutil.Big(*big.NewInt(60)),
+		}}
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, endpoint+"/faucetUUID", nil).
+			WithContext(s.ctx)
+
+		s.getFaucetUC.EXPECT().Execute(gomock.Any(), "faucetUUID", s.userInfo).Return(faucet, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := &api.FaucetResponse{}
+		err := json.Unmarshal(rw.Body.Bytes(), response)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, "0x3e8", response.DailyBudget.String())
+		assert.Equal(t, "0x3c", response.DailySpent.String())
+		assert.Len(t, response.Spendings, 1)
+		assert.Equal(t, faucet.Spendings[0].Beneficiary, response.Spendings[0].Beneficiary)
+		assert.Equal(t, "0x3c", response.Spendings[0].Amount.String())
+	})
+
 	s.T().Run("should fail with Internal server error if use case fails", func(t *testing.T) {
 		rw := httptest.NewRecorder()
 		httpRequest := httptest.
@@ -238,8 +271,9 @@ func (s *faucetsCtrlTestSuite) TestFaucetsController_Search() {
 			WithContext(s.ctx)
 
 		expectedFilters := &entities.FaucetFilters{
-			Names:     names,
-			ChainRule: chainRule,
+			Pagination: entities.Pagination{Limit: entities.DefaultPageLimit},
+			Names:      names,
+			ChainRule:  chainRule,
 		}
 		s.searchFaucetUC.EXPECT().Execute(gomock.Any(), expectedFilters, s.userInfo).Return([]*entities.Faucet{faucet}, nil)
 
@@ -1,22 +1,34 @@
 package controllers
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/consensys/orchestrate/src/entities"
 
 	jsonutils "github.com/consensys/orchestrate/pkg/encoding/json"
+	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/service/formatters"
 	api "github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
 )
 
 var _ entities.ETHTransaction
 
+const (
+	jobEventName            = "job"
+	streamHeartbeatInterval = 30 * time.Second
+)
+
+var wsUpgrader = websocket.Upgrader{}
+
 type JobsController struct {
 	ucs usecases.JobUseCases
 }
@@ -31,10 +43,17 @@ func NewJobsController(useCases usecases.JobUseCases) *JobsController {
 func (c *JobsController) Append(router *mux.Router) {
 	router.Methods(http.MethodGet).Path("/jobs").HandlerFunc(c.search)
 	router.Methods(http.MethodPost).Path("/jobs").HandlerFunc(c.create)
+	router.Methods(http.MethodGet).Path("/jobs/stream").HandlerFunc(c.stream)
+	router.Methods(http.MethodGet).Path("/jobs/failed").HandlerFunc(c.searchFailed)
+	router.Methods(http.MethodPost).Path("/jobs/failed/replay").HandlerFunc(c.replayFailed)
 	router.Methods(http.MethodGet).Path("/jobs/{uuid}").HandlerFunc(c.getOne)
 	router.Methods(http.MethodPatch).Path("/jobs/{uuid}").HandlerFunc(c.update)
 	router.Methods(http.MethodPut).Path("/jobs/{uuid}/start").HandlerFunc(c.start)
 	router.Methods(http.MethodPut).Path("/jobs/{uuid}/resend").HandlerFunc(c.resend)
+	router.Methods(http.MethodPut).Path("/jobs/{uuid}/replay").HandlerFunc(c.replay)
+	router.Methods(http.MethodPut).Path("/jobs/{uuid}/approve").HandlerFunc(c.approve)
+	router.Methods(http.MethodPut).Path("/jobs/{uuid}/reject").HandlerFunc(c.reject)
+	router.Methods(http.MethodGet).Path("/jobs/{uuid}/approvals").HandlerFunc(c.getApprovals)
 }
 
 // @Summary      Search jobs by provided filters
@@ -46,7 +65,11 @@ func (c *JobsController) Append(router *mux.Router) {
 // @Security     JWTAuth
 // @Param        tx_hashes   query     []string                                                                                                                                                              false  "List of transaction hashes"  collectionFormat(csv)
 // @Param        chain_uuid  query     string                                                                                                                                                                false  "Chain UUID"
+// @Param        limit       query     int                                                                                                                                                                   false  "Maximum number of results (default 100), a Link header to the next page is returned on full pages"
+// @Param        next        query     string                                                                                                                                                                false  "Opaque cursor of the next page, as returned in the Link header"
+// @Param        sort        query     string                                                                                                                                                                false  "Sort key (created_at or updated_at), prefixed by - for descending order"
 // @Success      200         {array}   api.JobResponse{annotations=api.Annotations{gasPricePolicy=api.GasPriceParams{retryPolicy=api.RetryParams}},transaction=entities.ETHTransaction,logs=[]entities.Log}  "List of Jobs found"
+// @Header       200         {string}  Link                                                                                                                                                                  "Link to the next page of results"
 // @Failure      400         {object}  httputil.ErrorResponse                                                                                                                                                "Invalid filter in the request"
 // @Failure      500         {object}  httputil.ErrorResponse                                                                                                                                                "Internal server error"
 // @Router       /jobs [get]
@@ -66,6 +89,11 @@ func (c *JobsController) search(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
+	if filters.HasNextPage(len(jobRes)) {
+		last := jobRes[len(jobRes)-1]
+		rw.Header().Set("Link", formatters.FormatNextPageLink(request, filters.Sort, last.CreatedAt, last.UpdatedAt, last.UUID))
+	}
+
 	response := []*api.JobResponse{}
 	for _, jb := range jobRes {
 		response = append(response, formatters.FormatJobResponse(jb))
@@ -113,6 +141,117 @@ func (c *JobsController) create(rw http.ResponseWriter, request *http.Request) {
 	_ = json.NewEncoder(rw).Encode(formatters.FormatJobResponse(jobRes))
 }
 
+// @Summary      Stream job status changes
+// @Description  Pushes the status changes of the jobs, as they are written, using Server-Sent Events (one JSON event per data field) or a WebSocket if the request asks for a connection upgrade
+// @Tags         Jobs
+// @Produce      text/event-stream
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        chain_uuid  query     string                  false  "Chain UUID"
+// @Param        labels      query     []string                false  "Job labels, formatted as key:value"  collectionFormat(csv)
+// @Success      200         {object}  api.JobEventResponse    "Job events"
+// @Failure      400         {object}  httputil.ErrorResponse  "Invalid filter in the request"
+// @Failure      500         {object}  httputil.ErrorResponse  "Internal server error"
+// @Router       /jobs/stream [get]
+func (c *JobsController) stream(rw http.ResponseWriter, request *http.Request) {
+	ctx, cancel := context.WithCancel(request.Context())
+	defer cancel()
+
+	filters, err := formatters.FormatJobEventFilterRequest(request)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	events, err := c.ucs.SubscribeJobEvents().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	if websocket.IsWebSocketUpgrade(request) {
+		c.streamWebSocket(ctx, cancel, rw, request, events)
+		return
+	}
+
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		httputil.WriteError(rw, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("Connection", "keep-alive")
+	rw.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			data, _ := json.Marshal(formatters.FormatJobEventResponse(event))
+			_, err = fmt.Fprintf(rw, "event: %s\ndata: %s\n\n", jobEventName, data)
+		case <-heartbeat.C:
+			// Comments keep the connection open through proxies
+			_, err = fmt.Fprint(rw, ": heartbeat\n\n")
+		}
+
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+func (c *JobsController) streamWebSocket(ctx context.Context, cancel context.CancelFunc, rw http.ResponseWriter,
+	request *http.Request, events <-chan *entities.JobEvent) {
+	conn, err := wsUpgrader.Upgrade(rw, request, nil)
+	if err != nil {
+		// Upgrader already replied with an HTTP error
+		return
+	}
+	defer conn.Close()
+
+	// Control messages are handled by the reader, which stops the stream when the peer closes the connection
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			err = conn.WriteJSON(formatters.FormatJobEventResponse(event))
+		case <-heartbeat.C:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamHeartbeatInterval))
+		case <-ctx.Done():
+			return
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
 // @Summary      Fetch a job by uuid
 // @Description  Fetch a single job by uuid
 // @Tags         Jobs
@@ -189,6 +328,210 @@ func (c *JobsController) resend(rw http.ResponseWriter, request *http.Request) {
 	rw.WriteHeader(http.StatusAccepted)
 }
 
+// @Summary      Search failed jobs by provided filters
+// @Description  Get the jobs failed by the tx-sender, oldest first, with the classification of their last error
+// @Tags         Jobs
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        job_uuids       query     []string                false  "List of job UUIDs"  collectionFormat(csv)
+// @Param        chain_uuid      query     string                  false  "Chain UUID"
+// @Param        error_code      query     string                  false  "Code of the last error, e.g. 08000"
+// @Param        error_class     query     string                  false  "Class of the last error, e.g. CONNECTION"
+// @Param        created_after   query     string                  false  "Failed after the given time (RFC3339)"
+// @Param        created_before  query     string                  false  "Failed before the given time (RFC3339)"
+// @Param        pending         query     bool                    false  "Only failures which have not been replayed"
+// @Param        limit           query     int                     false  "Maximum number of results (default 100), a Link header to the next page is returned on full pages"
+// @Param        next            query     string                  false  "Opaque cursor of the next page, as returned in the Link header"
+// @Param        sort            query     string                  false  "Sort key (created_at), prefixed by - for descending order"
+// @Success      200             {array}   api.FailedJobResponse   "List of failed jobs found"
+// @Header       200             {string}  Link                    "Link to the next page of results"
+// @Failure      400             {object}  httputil.ErrorResponse  "Invalid filter in the request"
+// @Failure      500             {object}  httputil.ErrorResponse  "Internal server error"
+// @Router       /jobs/failed [get]
+func (c *JobsController) searchFailed(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	filters, err := formatters.FormatFailedJobFilterRequest(request)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	failedJobs, err := c.ucs.SearchFailedJobs().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	if filters.HasNextPage(len(failedJobs)) {
+		last := failedJobs[len(failedJobs)-1]
+		rw.Header().Set("Link", formatters.FormatNextPageLink(request, filters.Sort, last.CreatedAt, last.CreatedAt, last.UUID))
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatFailedJobsResponse(failedJobs))
+}
+
+// @Summary      Replay failed jobs by provided filters
+// @Description  Sends the pending failed jobs matching the filters to the tx-sender again, at most 100 jobs per request starting with the oldest failures. The jobUUIDs or chainUUID filter is required unless the user is a tenant admin
+// @Tags         Jobs
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.ReplayFailedJobsRequest  true  "Filters of the failed jobs to replay"
+// @Success      202      {array}   api.FailedJobResponse        "List of replayed failed jobs"
+// @Failure      400      {object}  httputil.ErrorResponse       "Invalid request"
+// @Failure      401      {object}  httputil.ErrorResponse       "Unauthorized"
+// @Failure      500      {object}  httputil.ErrorResponse       "Internal server error"
+// @Router       /jobs/failed/replay [post]
+func (c *JobsController) replayFailed(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	replayRequest := &api.ReplayFailedJobsRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, replayRequest)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	failedJobs, err := c.ucs.ReplayFailedJobs().Execute(ctx, formatters.FormatReplayFailedJobsRequest(replayRequest), multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(rw).Encode(formatFailedJobsResponse(failedJobs))
+}
+
+// @Summary      Replay a failed Job by UUID
+// @Description  Sends a job failed by the tx-sender to the tx-sender again
+// @Tags         Jobs
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        uuid  path  string  true  "UUID of the job"
+// @Success      202
+// @Failure      404  {object}  httputil.ErrorResponse  "No pending failure found for the job"
+// @Failure      500  {object}  httputil.ErrorResponse  "Internal server error"
+// @Router       /jobs/{uuid}/replay [put]
+func (c *JobsController) replay(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	filters := &entities.FailedJobFilters{JobUUIDs: []string{mux.Vars(request)["uuid"]}}
+	failedJobs, err := c.ucs.ReplayFailedJobs().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	if len(failedJobs) == 0 {
+		httputil.WriteHTTPErrorResponse(rw, errors.NotFoundError("no pending failure found for the job"))
+		return
+	}
+
+	rw.WriteHeader(http.StatusAccepted)
+}
+
+// @Summary      Approve a Job by UUID
+// @Description  Approves a job AWAITING_APPROVAL on behalf of the user, the job starts once the approval policy of its sender is satisfied
+// @Tags         Jobs
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        uuid     path      string                  true   "UUID of the job"
+// @Param        request  body      api.JobApprovalRequest  false  "Approval request"
+// @Success      200      {object}  api.JobResponse         "Job approved"
+// @Failure      400      {object}  httputil.ErrorResponse  "Invalid request"
+// @Failure      401      {object}  httputil.ErrorResponse  "User is not an approver of the job"
+// @Failure      404      {object}  httputil.ErrorResponse  "Job not found"
+// @Failure      409      {object}  httputil.ErrorResponse  "Job not awaiting approval or already decided by the user"
+// @Failure      500      {object}  httputil.ErrorResponse  "Internal server error"
+// @Router       /jobs/{uuid}/approve [put]
+func (c *JobsController) approve(rw http.ResponseWriter, request *http.Request) {
+	c.decide(rw, request, c.ucs.ApproveJob().Execute)
+}
+
+// @Summary      Reject a Job by UUID
+// @Description  Rejects a job AWAITING_APPROVAL on behalf of the user, the job is FAILED
+// @Tags         Jobs
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        uuid     path      string                  true   "UUID of the job"
+// @Param        request  body      api.JobApprovalRequest  false  "Rejection request"
+// @Success      200      {object}  api.JobResponse         "Job rejected"
+// @Failure      400      {object}  httputil.ErrorResponse  "Invalid request"
+// @Failure      401      {object}  httputil.ErrorResponse  "User is not an approver of the job"
+// @Failure      404      {object}  httputil.ErrorResponse  "Job not found"
+// @Failure      409      {object}  httputil.ErrorResponse  "Job not awaiting approval or already decided by the user"
+// @Failure      500      {object}  httputil.ErrorResponse  "Internal server error"
+// @Router       /jobs/{uuid}/reject [put]
+func (c *JobsController) reject(rw http.ResponseWriter, request *http.Request) {
+	c.decide(rw, request, c.ucs.RejectJob().Execute)
+}
+
+func (c *JobsController) decide(
+	rw http.ResponseWriter,
+	request *http.Request,
+	execute func(ctx context.Context, jobUUID, reason string, userInfo *multitenancy.UserInfo) (*entities.Job, error),
+) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	approvalRequest := &api.JobApprovalRequest{}
+	if request.ContentLength != 0 {
+		err := jsonutils.UnmarshalBody(request.Body, approvalRequest)
+		if err != nil {
+			httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	jobRes, err := execute(ctx, mux.Vars(request)["uuid"], approvalRequest.Reason, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatJobResponse(jobRes))
+}
+
+// @Summary      Get the approvals of a Job by UUID
+// @Description  Audit trail of the approvals and rejections of a job, visible to its owner and to the approvers of its sender
+// @Tags         Jobs
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        uuid  path      string                   true  "UUID of the job"
+// @Success      200   {array}   api.JobApprovalResponse  "Approvals of the job"
+// @Failure      404   {object}  httputil.ErrorResponse   "Job not found"
+// @Failure      500   {object}  httputil.ErrorResponse   "Internal server error"
+// @Router       /jobs/{uuid}/approvals [get]
+func (c *JobsController) getApprovals(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	approvals, err := c.ucs.SearchJobApprovals().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.JobApprovalResponse{}
+	for _, approval := range approvals {
+		response = append(response, formatters.FormatJobApprovalResponse(approval))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
 // @Summary      Update job by UUID
 // @Description  Update a specific job by UUID
 // @Description  WARNING: Reserved for advanced users. Orchestrate does not recommend using this endpoint.
@@ -227,3 +570,12 @@ func (c *JobsController) update(rw http.ResponseWriter, request *http.Request) {
 
 	_ = json.NewEncoder(rw).Encode(formatters.FormatJobResponse(jobRes))
 }
+
+func formatFailedJobsResponse(failedJobs []*entities.FailedJob) []*api.FailedJobResponse {
+	response := []*api.FailedJobResponse{}
+	for _, failedJob := range failedJobs {
+		response = append(response, formatters.FormatFailedJobResponse(failedJob))
+	}
+
+	return response
+}
@@ -20,28 +20,38 @@ import (
 	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/api/service/formatters"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
 type jobsCtrlTestSuite struct {
 	suite.Suite
-	createJobUC    *mocks.MockCreateJobUseCase
-	getJobUC       *mocks.MockGetJobUseCase
-	startJobUC     *mocks.MockStartJobUseCase
-	resentJobTxUC  *mocks.MockResendJobTxUseCase
-	retryJobTxUC   *mocks.MockRetryJobTxUseCase
-	startNextJobUC *mocks.MockStartNextJobUseCase
-	updateJobUC    *mocks.MockUpdateJobUseCase
-	searchJobUC    *mocks.MockSearchJobsUseCase
-	ctx            context.Context
-	userInfo       *multitenancy.UserInfo
-	router         *mux.Router
+	createJobUC          *mocks.MockCreateJobUseCase
+	getJobUC             *mocks.MockGetJobUseCase
+	startJobUC           *mocks.MockStartJobUseCase
+	resentJobTxUC        *mocks.MockResendJobTxUseCase
+	retryJobTxUC         *mocks.MockRetryJobTxUseCase
+	startNextJobUC       *mocks.MockStartNextJobUseCase
+	updateJobUC          *mocks.MockUpdateJobUseCase
+	searchJobUC          *mocks.MockSearchJobsUseCase
+	subscribeJobEventsUC *mocks.MockSubscribeJobEventsUseCase
+	approveJobUC         *mocks.MockApproveJobUseCase
+	rejectJobUC          *mocks.MockRejectJobUseCase
+	searchJobApprovalsUC *mocks.MockSearchJobApprovalsUseCase
+	createFailedJobUC    *mocks.MockCreateFailedJobUseCase
+	searchFailedJobsUC   *mocks.MockSearchFailedJobsUseCase
+	replayFailedJobsUC   *mocks.MockReplayFailedJobsUseCase
+	ctx                  context.Context
+	userInfo             *multitenancy.UserInfo
+	router               *mux.Router
 }
 
 var _ usecases.JobUseCases = &jobsCtrlTestSuite{}
@@ -78,6 +88,34 @@ func (s jobsCtrlTestSuite) SearchJobs() usecases.SearchJobsUseCase {
 	return s.searchJobUC
 }
 
+func (s jobsCtrlTestSuite) SubscribeJobEvents() usecases.SubscribeJobEventsUseCase {
+	return s.subscribeJobEventsUC
+}
+
+func (s jobsCtrlTestSuite) ApproveJob() usecases.ApproveJobUseCase {
+	return s.approveJobUC
+}
+
+func (s jobsCtrlTestSuite) RejectJob() usecases.RejectJobUseCase {
+	return s.rejectJobUC
+}
+
+func (s jobsCtrlTestSuite) SearchJobApprovals() usecases.SearchJobApprovalsUseCase {
+	return s.searchJobApprovalsUC
+}
+
+func (s jobsCtrlTestSuite) CreateFailedJob() usecases.CreateFailedJobUseCase {
+	return s.createFailedJobUC
+}
+
+func (s jobsCtrlTestSuite) SearchFailedJobs() usecases.SearchFailedJobsUseCase {
+	return s.searchFailedJobsUC
+}
+
+func (s jobsCtrlTestSuite) ReplayFailedJobs() usecases.ReplayFailedJobsUseCase {
+	return s.replayFailedJobsUC
+}
+
 func TestJobsController(t *testing.T) {
 	s := new(jobsCtrlTestSuite)
 	suite.Run(t, s)
@@ -93,6 +131,13 @@ func (s *jobsCtrlTestSuite) SetupTest() {
 	s.updateJobUC = mocks.NewMockUpdateJobUseCase(ctrl)
 	s.searchJobUC = mocks.NewMockSearchJobsUseCase(ctrl)
 	s.resentJobTxUC = mocks.NewMockResendJobTxUseCase(ctrl)
+	s.subscribeJobEventsUC = mocks.NewMockSubscribeJobEventsUseCase(ctrl)
+	s.approveJobUC = mocks.NewMockApproveJobUseCase(ctrl)
+	s.rejectJobUC = mocks.NewMockRejectJobUseCase(ctrl)
+	s.searchJobApprovalsUC = mocks.NewMockSearchJobApprovalsUseCase(ctrl)
+	s.createFailedJobUC = mocks.NewMockCreateFailedJobUseCase(ctrl)
+	s.searchFailedJobsUC = mocks.NewMockSearchFailedJobsUseCase(ctrl)
+	s.replayFailedJobsUC = mocks.NewMockReplayFailedJobsUseCase(ctrl)
 	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
 	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
 	s.router = mux.NewRouter()
@@ -161,6 +206,23 @@ func (s *jobsCtrlTestSuite) TestJobsController_Create() {
 		assert.Equal(t, http.StatusBadRequest, rw.Code)
 	})
 
+	s.T().Run("should fail with Bad request if invalid dependency status", func(t *testing.T) {
+		jobRequest := apitestdata.FakeCreateJobRequest()
+		jobRequest.DependsOn = []*entities.JobDependency{{
+			JobUUID: "b4374e6f-b28a-4bad-b4fe-bda36eaf849c",
+			Status:  entities.StatusPending,
+		}}
+		requestBytes, _ := json.Marshal(jobRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/jobs", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.T().Run("should fail with 422 if use case fails with InvalidParameterError", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -210,7 +272,7 @@ func (s *jobsCtrlTestSuite) TestJobsController_GetOne() {
 func (s *jobsCtrlTestSuite) TestJobsController_Search() {
 	s.T().Run("should execute search jobs successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
-		filters := &entities.JobFilters{}
+		filters := &entities.JobFilters{Pagination: entities.Pagination{Limit: entities.DefaultPageLimit}}
 		httpRequest := httptest.NewRequest(http.MethodGet, "/jobs", nil).WithContext(s.ctx)
 		jobEntities := []*entities.Job{testdata.FakeJob()}
 
@@ -226,7 +288,10 @@ func (s *jobsCtrlTestSuite) TestJobsController_Search() {
 
 	s.T().Run("should execute search jobs by tx_hashes successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
-		filters := &entities.JobFilters{TxHashes: []string{common.HexToHash("0x1").String(), common.HexToHash("0x2").String()}}
+		filters := &entities.JobFilters{
+			Pagination: entities.Pagination{Limit: entities.DefaultPageLimit},
+			TxHashes:   []string{common.HexToHash("0x1").String(), common.HexToHash("0x2").String()},
+		}
 		url := fmt.Sprintf("/jobs?tx_hashes=%s", strings.Join([]string{
 			common.HexToHash("0x1").String(),
 			common.HexToHash("0x2").String(),
@@ -247,6 +312,43 @@ func (s *jobsCtrlTestSuite) TestJobsController_Search() {
 		assert.Equal(t, http.StatusOK, rw.Code)
 	})
 
+	s.T().Run("should execute search jobs with pagination and return the link to the next page", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		filters := &entities.JobFilters{Pagination: entities.Pagination{Limit: 1, Sort: "-updated_at"}}
+		httpRequest := httptest.NewRequest(http.MethodGet, "/jobs?limit=1&sort=-updated_at", nil).WithContext(s.ctx)
+		jobEntities := []*entities.Job{testdata.FakeJob()}
+
+		s.searchJobUC.EXPECT().Execute(gomock.Any(), filters, s.userInfo).Return(jobEntities, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		next := utils.EncodeCursor(jobEntities[0].UpdatedAt, jobEntities[0].UUID)
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, "</jobs?limit=1&next="+next+"&sort=-updated_at>; rel=\"next\"", rw.Header().Get("Link"))
+	})
+
+	s.T().Run("should not return the link to the next page on the last page", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		filters := &entities.JobFilters{Pagination: entities.Pagination{Limit: 2}}
+		httpRequest := httptest.NewRequest(http.MethodGet, "/jobs?limit=2", nil).WithContext(s.ctx)
+
+		s.searchJobUC.EXPECT().Execute(gomock.Any(), filters, s.userInfo).Return([]*entities.Job{testdata.FakeJob()}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Empty(t, rw.Header().Get("Link"))
+	})
+
+	s.T().Run("should fail with 400 if sort key is invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/jobs?sort=status", nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.T().Run("should fail with 422 if use case fails on invalid tx hashes as input", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -266,7 +368,7 @@ func (s *jobsCtrlTestSuite) TestJobsController_Search() {
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.T().Run("should fail with 422 if use case fails with NotFoundError", func(t *testing.T) {
 		rw := httptest.NewRecorder()
-		filters := &entities.JobFilters{}
+		filters := &entities.JobFilters{Pagination: entities.Pagination{Limit: entities.DefaultPageLimit}}
 		httpRequest := httptest.
 			NewRequest(http.MethodGet, "/jobs", bytes.NewReader(nil)).
 			WithContext(s.ctx)
@@ -334,6 +436,205 @@ func (s *jobsCtrlTestSuite) TestJobsController_ResendTxJob() {
 	})
 }
 
+func (s *jobsCtrlTestSuite) TestJobsController_Approve() {
+	s.T().Run("should execute approve job request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/jobs/jobUUID/approve", bytes.NewReader([]byte(`{"reason":"checked"}`))).
+			WithContext(s.ctx)
+		jobEntity := testdata.FakeJob()
+
+		s.approveJobUC.EXPECT().Execute(gomock.Any(), "jobUUID", "checked", s.userInfo).Return(jobEntity, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response, _ := json.Marshal(formatters.FormatJobResponse(jobEntity))
+		assert.Equal(t, string(response)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 401 if use case fails with UnauthorizedError", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/jobs/jobUUID/approve", nil).
+			WithContext(s.ctx)
+
+		s.approveJobUC.EXPECT().Execute(gomock.Any(), "jobUUID", "", s.userInfo).Return(nil, errors.UnauthorizedError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusUnauthorized, rw.Code)
+	})
+}
+
+func (s *jobsCtrlTestSuite) TestJobsController_Reject() {
+	s.T().Run("should execute reject job request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/jobs/jobUUID/reject", bytes.NewReader([]byte(`{"reason":"wrong amount"}`))).
+			WithContext(s.ctx)
+		jobEntity := testdata.FakeJob()
+		jobEntity.Status = entities.StatusFailed
+
+		s.rejectJobUC.EXPECT().Execute(gomock.Any(), "jobUUID", "wrong amount", s.userInfo).Return(jobEntity, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 409 if use case fails with InvalidStateError", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/jobs/jobUUID/reject", nil).
+			WithContext(s.ctx)
+
+		s.rejectJobUC.EXPECT().Execute(gomock.Any(), "jobUUID", "", s.userInfo).Return(nil, errors.InvalidStateError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusConflict, rw.Code)
+	})
+}
+
+func (s *jobsCtrlTestSuite) TestJobsController_GetApprovals() {
+	s.T().Run("should execute get job approvals request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, "/jobs/jobUUID/approvals", nil).
+			WithContext(s.ctx)
+		approval := &entities.JobApproval{UUID: "approvalUUID", JobUUID: "jobUUID", Username: "alice", Decision: entities.ApprovalDecisionApproved}
+
+		s.searchJobApprovalsUC.EXPECT().Execute(gomock.Any(), "jobUUID", s.userInfo).Return([]*entities.JobApproval{approval}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response, _ := json.Marshal([]*apitypes.JobApprovalResponse{formatters.FormatJobApprovalResponse(approval)})
+		assert.Equal(t, string(response)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+}
+
+func (s *jobsCtrlTestSuite) TestJobsController_SearchFailed() {
+	s.T().Run("should execute search failed jobs request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		chainUUID := "b4374e6f-b28a-4bad-b4fe-bda36eaf849c"
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, "/jobs/failed?chain_uuid="+chainUUID+"&error_class=CONNECTION&pending=true&created_after=2021-01-01T00:00:00Z", nil).
+			WithContext(s.ctx)
+		failedJob := testdata.FakeFailedJob()
+
+		s.searchFailedJobsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			DoAndReturn(func(_ context.Context, filters *entities.FailedJobFilters, _ *multitenancy.UserInfo) ([]*entities.FailedJob, error) {
+				assert.Equal(t, chainUUID, filters.ChainUUID)
+				assert.Equal(t, entities.FailedJobConnectionError, filters.ErrorClass)
+				assert.True(t, filters.OnlyPending)
+				assert.Equal(t, 2021, filters.CreatedAfter.Year())
+				return []*entities.FailedJob{failedJob}, nil
+			})
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response, _ := json.Marshal([]*apitypes.FailedJobResponse{formatters.FormatFailedJobResponse(failedJob)})
+		assert.Equal(t, string(response)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should execute search failed jobs with pagination and return the link to the next page", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		filters := &entities.FailedJobFilters{Pagination: entities.Pagination{Limit: 1, Sort: "-created_at"}}
+		httpRequest := httptest.NewRequest(http.MethodGet, "/jobs/failed?limit=1&sort=-created_at", nil).WithContext(s.ctx)
+		failedJob := testdata.FakeFailedJob()
+
+		s.searchFailedJobsUC.EXPECT().Execute(gomock.Any(), filters, s.userInfo).Return([]*entities.FailedJob{failedJob}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		next := utils.EncodeCursor(failedJob.CreatedAt, failedJob.UUID)
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, "</jobs/failed?limit=1&next="+next+"&sort=-created_at>; rel=\"next\"", rw.Header().Get("Link"))
+	})
+
+	s.T().Run("should fail with 400 if error class is invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, "/jobs/failed?error_class=invalid", nil).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *jobsCtrlTestSuite) TestJobsController_ReplayFailed() {
+	s.T().Run("should execute replay failed jobs request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		chainUUID := "b4374e6f-b28a-4bad-b4fe-bda36eaf849c"
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/jobs/failed/replay", bytes.NewReader([]byte(`{"chainUUID":"`+chainUUID+`","errorCode":"08000"}`))).
+			WithContext(s.ctx)
+		failedJob := testdata.FakeFailedJob()
+
+		s.replayFailedJobsUC.EXPECT().Execute(gomock.Any(), &entities.FailedJobFilters{ChainUUID: chainUUID, ErrorCode: "08000"}, s.userInfo).
+			Return([]*entities.FailedJob{failedJob}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response, _ := json.Marshal([]*apitypes.FailedJobResponse{formatters.FormatFailedJobResponse(failedJob)})
+		assert.Equal(t, string(response)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusAccepted, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if chain UUID is invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/jobs/failed/replay", bytes.NewReader([]byte(`{"chainUUID":"invalid"}`))).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 401 if no filter is provided by a user", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/jobs/failed/replay", bytes.NewReader([]byte(`{}`))).
+			WithContext(s.ctx)
+
+		s.replayFailedJobsUC.EXPECT().Execute(gomock.Any(), &entities.FailedJobFilters{}, s.userInfo).
+			Return(nil, errors.UnauthorizedError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusUnauthorized, rw.Code)
+	})
+}
+
+func (s *jobsCtrlTestSuite) TestJobsController_Replay() {
+	s.T().Run("should execute replay job request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/jobs/jobUUID/replay", nil).
+			WithContext(s.ctx)
+
+		s.replayFailedJobsUC.EXPECT().Execute(gomock.Any(), &entities.FailedJobFilters{JobUUIDs: []string{"jobUUID"}}, s.userInfo).
+			Return([]*entities.FailedJob{testdata.FakeFailedJob()}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusAccepted, rw.Code)
+	})
+
+	s.T().Run("should fail with 404 if the job has no pending failure", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPut, "/jobs/jobUUID/replay", nil).
+			WithContext(s.ctx)
+
+		s.replayFailedJobsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return([]*entities.FailedJob{}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
+
 func (s *jobsCtrlTestSuite) TestJobsController_Update() {
 	s.T().Run("should execute update a job request successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -374,3 +675,70 @@ func (s *jobsCtrlTestSuite) TestJobsController_Update() {
 		assert.Equal(t, http.StatusConflict, rw.Code)
 	})
 }
+
+func (s *jobsCtrlTestSuite) TestJobsController_Stream() {
+	newEvents := func(event *entities.JobEvent) <-chan *entities.JobEvent {
+		events := make(chan *entities.JobEvent, 1)
+		events <- event
+		close(events)
+		return events
+	}
+
+	s.T().Run("should stream job events as Server-Sent Events", func(t *testing.T) {
+		event := &entities.JobEvent{JobUUID: "jobUUID", TenantID: "tenantOne", Status: entities.StatusMined}
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/jobs/stream?chain_uuid=a0c91d52-f6d9-4bdb-ae49-ef8f0bb5e1a1&labels=app:payments", nil).
+			WithContext(s.ctx)
+
+		expectedFilters := &entities.JobEventFilters{
+			ChainUUID: "a0c91d52-f6d9-4bdb-ae49-ef8f0bb5e1a1",
+			Labels:    map[string]string{"app": "payments"},
+		}
+		s.subscribeJobEventsUC.EXPECT().Execute(gomock.Any(), expectedFilters, s.userInfo).Return(newEvents(event), nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		data, _ := json.Marshal(formatters.FormatJobEventResponse(event))
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, "text/event-stream", rw.Header().Get("Content-Type"))
+		assert.Equal(t, fmt.Sprintf("event: job\ndata: %s\n\n", data), rw.Body.String())
+	})
+
+	s.T().Run("should stream job events over WebSocket", func(t *testing.T) {
+		event := &entities.JobEvent{JobUUID: "jobUUID", TenantID: "tenantOne", Status: entities.StatusMined}
+		server := httptest.NewServer(s.router)
+		defer server.Close()
+
+		s.subscribeJobEventsUC.EXPECT().Execute(gomock.Any(), &entities.JobEventFilters{}, gomock.Any()).Return(newEvents(event), nil)
+
+		conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/jobs/stream", nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		resp := &apitypes.JobEventResponse{}
+		err = conn.ReadJSON(resp)
+		require.NoError(t, err)
+		assert.Equal(t, formatters.FormatJobEventResponse(event), resp)
+	})
+
+	s.T().Run("should fail with 400 if labels filter is invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/jobs/stream?labels=invalid", nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with same error if subscription fails", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/jobs/stream", nil).WithContext(s.ctx)
+
+		s.subscribeJobEventsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			Return(nil, errors.InvalidParameterError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rw.Code)
+	})
+}
@@ -5,7 +5,6 @@ import (
 	"net/http"
 
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
-	"github.com/consensys/orchestrate/src/entities"
 
 	jsonutils "github.com/consensys/orchestrate/pkg/encoding/json"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
@@ -56,7 +55,7 @@ func (c *SchedulesController) create(rw http.ResponseWriter, request *http.Reque
 		return
 	}
 
-	scheduleEntity, err := c.ucs.CreateSchedule().Execute(ctx, &entities.Schedule{}, multitenancy.UserInfoValue(ctx))
+	scheduleEntity, err := c.ucs.CreateSchedule().Execute(ctx, formatters.FormatScheduleCreateRequest(scheduleRequest), multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
 		return
@@ -99,19 +98,35 @@ func (c *SchedulesController) getOne(rw http.ResponseWriter, request *http.Reque
 // @Produce      json
 // @Security     ApiKeyAuth
 // @Security     JWTAuth
-// @Success      200  {array}   api.ScheduleResponse    "List of schedules found"
-// @Failure      500  {object}  httputil.ErrorResponse  "Internal server error"
+// @Param        limit  query     int                     false  "Maximum number of results (default 100), a Link header to the next page is returned on full pages"
+// @Param        next   query     string                  false  "Opaque cursor of the next page, as returned in the Link header"
+// @Param        sort   query     string                  false  "Sort key (created_at), prefixed by - for descending order"
+// @Success      200    {array}   api.ScheduleResponse    "List of schedules found"
+// @Header       200    {string}  Link                    "Link to the next page of results"
+// @Failure      422    {object}  httputil.ErrorResponse  "Invalid filter in the request"
+// @Failure      500    {object}  httputil.ErrorResponse  "Internal server error"
 // @Router       /schedules [get]
 func (c *SchedulesController) getAll(rw http.ResponseWriter, request *http.Request) {
 	rw.Header().Set("Content-Type", "application/json")
 	ctx := request.Context()
 
-	schedules, err := c.ucs.SearchSchedules().Execute(ctx, multitenancy.UserInfoValue(ctx))
+	filters, err := formatters.FormatScheduleFiltersRequest(request)
 	if err != nil {
 		httputil.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
+	schedules, err := c.ucs.SearchSchedules().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	if filters.HasNextPage(len(schedules)) {
+		last := schedules[len(schedules)-1]
+		rw.Header().Set("Link", formatters.FormatNextPageLink(request, filters.Sort, last.CreatedAt, last.CreatedAt, last.UUID))
+	}
+
 	response := []*api.ScheduleResponse{}
 	for _, schedule := range schedules {
 		response = append(response, formatters.FormatScheduleResponse(schedule))
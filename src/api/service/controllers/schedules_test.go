@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	apitypes "github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities"
@@ -31,6 +32,7 @@ type schedulesCtrlTestSuite struct {
 	createScheduleUC  *mocks.MockCreateScheduleUseCase
 	getScheduleUC     *mocks.MockGetScheduleUseCase
 	searchSchedulesUC *mocks.MockSearchSchedulesUseCase
+	runDueSchedulesUC *mocks.MockRunDueSchedulesUseCase
 	ctx               context.Context
 	userInfo          *multitenancy.UserInfo
 	router            *mux.Router
@@ -48,6 +50,10 @@ func (s *schedulesCtrlTestSuite) SearchSchedules() usecases.SearchSchedulesUseCa
 	return s.searchSchedulesUC
 }
 
+func (s *schedulesCtrlTestSuite) RunDueSchedules() usecases.RunDueSchedulesUseCase {
+	return s.runDueSchedulesUC
+}
+
 var _ usecases.ScheduleUseCases = &schedulesCtrlTestSuite{}
 
 func TestSchedulesController(t *testing.T) {
@@ -62,6 +68,7 @@ func (s *schedulesCtrlTestSuite) SetupTest() {
 	s.createScheduleUC = mocks.NewMockCreateScheduleUseCase(ctrl)
 	s.getScheduleUC = mocks.NewMockGetScheduleUseCase(ctrl)
 	s.searchSchedulesUC = mocks.NewMockSearchSchedulesUseCase(ctrl)
+	s.runDueSchedulesUC = mocks.NewMockRunDueSchedulesUseCase(ctrl)
 	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
 	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
 	s.router = mux.NewRouter()
@@ -94,6 +101,40 @@ func (s *schedulesCtrlTestSuite) TestScheduleController_Create() {
 		assert.Equal(t, http.StatusOK, rw.Code)
 	})
 
+	s.T().Run("should execute request with timing successfully", func(t *testing.T) {
+		notBefore := time.Now().Add(time.Hour).UTC()
+		timedRequest := apitestdata.FakeCreateScheduleRequest()
+		timedRequest.NotBefore = &notBefore
+		timedRequest.Cron = "0 9 * * 1-5"
+		timedRequestBytes, _ := json.Marshal(timedRequest)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/schedules", bytes.NewReader(timedRequestBytes)).
+			WithContext(s.ctx)
+
+		scheduleEntityResp := testdata.FakeSchedule()
+
+		s.createScheduleUC.EXPECT().
+			Execute(gomock.Any(), &entities.Schedule{NotBefore: &notBefore, Cron: timedRequest.Cron}, s.userInfo).
+			Return(scheduleEntityResp, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if cron expression is invalid", func(t *testing.T) {
+		invalidRequest := apitestdata.FakeCreateScheduleRequest()
+		invalidRequest.Cron = "every day"
+		invalidRequestBytes, _ := json.Marshal(invalidRequest)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/schedules", bytes.NewReader(invalidRequestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.T().Run("should fail with 422 if use case fails with InvalidParameterError", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -150,7 +191,8 @@ func (s *schedulesCtrlTestSuite) TestScheduleController_GetAll() {
 		httpRequest := httptest.NewRequest(http.MethodGet, "/schedules", nil).WithContext(s.ctx)
 		schedulesEntities := []*entities.Schedule{testdata.FakeSchedule()}
 
-		s.searchSchedulesUC.EXPECT().Execute(gomock.Any(), s.userInfo).Return(schedulesEntities, nil)
+		expectedFilters := &entities.ScheduleFilters{Pagination: entities.Pagination{Limit: entities.DefaultPageLimit}}
+		s.searchSchedulesUC.EXPECT().Execute(gomock.Any(), expectedFilters, s.userInfo).Return(schedulesEntities, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 
@@ -160,6 +202,29 @@ func (s *schedulesCtrlTestSuite) TestScheduleController_GetAll() {
 		assert.Equal(t, http.StatusOK, rw.Code)
 	})
 
+	s.T().Run("should return link to next page if page is full", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/schedules?limit=1", nil).WithContext(s.ctx)
+		schedulesEntities := []*entities.Schedule{testdata.FakeSchedule()}
+
+		expectedFilters := &entities.ScheduleFilters{Pagination: entities.Pagination{Limit: 1}}
+		s.searchSchedulesUC.EXPECT().Execute(gomock.Any(), expectedFilters, s.userInfo).Return(schedulesEntities, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Contains(t, rw.Header().Get("Link"), `rel="next"`)
+	})
+
+	s.T().Run("should fail with 422 if limit is greater than the maximum", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/schedules?limit=5000", nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.T().Run("should fail with 404 if use case fails with NotFoundError", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -38,12 +38,16 @@ func NewTransactionsController(ucs usecases.TransactionUseCases) *TransactionsCo
 func (c *TransactionsController) Append(router *mux.Router) {
 	router.Methods(http.MethodPost).Path("/transactions/send").
 		Handler(http.HandlerFunc(c.send))
+	router.Methods(http.MethodPost).Path("/transactions/send-batch").
+		Handler(http.HandlerFunc(c.sendBatch))
 	router.Methods(http.MethodPost).Path("/transactions/send-raw").
 		Handler(http.HandlerFunc(c.sendRaw))
 	router.Methods(http.MethodPost).Path("/transactions/transfer").
 		Handler(http.HandlerFunc(c.transfer))
 	router.Methods(http.MethodPost).Path("/transactions/deploy-contract").
 		Handler(http.HandlerFunc(c.deployContract))
+	router.Methods(http.MethodPost).Path("/transactions/simulate").
+		Handler(http.HandlerFunc(c.simulate))
 	router.Methods(http.MethodGet).Path("/transactions/{uuid}").
 		Handler(http.HandlerFunc(c.getOne))
 	router.Methods(http.MethodPut).Path("/transactions/{uuid}/speed-up").
@@ -96,6 +100,45 @@ func (c *TransactionsController) send(rw http.ResponseWriter, request *http.Requ
 	_ = json.NewEncoder(rw).Encode(formatters.FormatTxResponse(txResponse))
 }
 
+// @Summary      Simulates a contract transaction
+// @Description  Crafts a contract transaction and executes it against the latest state of the chain without sending it.
+// @Description  Returns the estimated gas and fee, the decoded return values or revert reason and the decoded logs.
+// @Description  No job is created. Private transactions cannot be simulated
+// @Tags         Transactions
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.SendTransactionRequest{params=api.TransactionParams{gasPricePolicy=api.GasPriceParams{retryPolicy=api.RetryParams}}}  true  "Contract transaction request"
+// @Success      200      {object}  api.SimulateTransactionResponse{logs=[]api.SimulatedLogResponse}                                                         "Simulated transaction"
+// @Failure      400      {object}  httputil.ErrorResponse                                                                                                    "Invalid request"
+// @Failure      422      {object}  httputil.ErrorResponse                                                                                                    "Unprocessable parameters were sent"
+// @Failure      500      {object}  httputil.ErrorResponse                                                                                                    "Internal server error"
+// @Router       /transactions/simulate [post]
+func (c *TransactionsController) simulate(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	txRequest := &api.SendTransactionRequest{}
+	if err := jsonutils.UnmarshalBody(request.Body, txRequest); err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := txRequest.Params.Validate(); err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	simulation, err := c.ucs.SimulateTransaction().Execute(ctx, formatters.FormatSendTxRequest(txRequest, ""), multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatSimulateTxResponse(simulation))
+}
+
 // @Summary      Creates and sends a new contract deployment
 // @Description  Creates and executes a new contract deployment request
 // @Description  The transaction can be private (Tessera, EEA).
@@ -138,6 +181,53 @@ func (c *TransactionsController) deployContract(rw http.ResponseWriter, request
 	_ = json.NewEncoder(rw).Encode(formatters.FormatTxResponse(txResponse))
 }
 
+// @Summary      Creates and sends a batch of transactions
+// @Description  Creates a batch of contract transactions, transfers and contract deployments atomically and executes them
+// @Description  Nothing is created if one of the transactions is invalid
+// @Description  If inOrder is set, nonces of the transactions of a same sender are assigned in the batch order
+// @Tags         Transactions
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.SendTransactionBatchRequest  true  "Batch of transaction requests"
+// @Success      202      {array}   api.TransactionResponse          "Created transaction requests"
+// @Failure      400      {object}  httputil.ErrorResponse           "Invalid request"
+// @Failure      409      {object}  httputil.ErrorResponse           "Already existing transaction"
+// @Failure      422      {object}  httputil.ErrorResponse           "Unprocessable parameters were sent"
+// @Failure      500      {object}  httputil.ErrorResponse           "Internal server error"
+// @Router       /transactions/send-batch [post]
+func (c *TransactionsController) sendBatch(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	batchRequest := &api.SendTransactionBatchRequest{}
+	if err := jsonutils.UnmarshalBody(request.Body, batchRequest); err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := batchRequest.Validate(); err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	txReqs := formatters.FormatSendTxBatchRequest(batchRequest)
+	txResponses, err := c.ucs.SendTransactionBatch().Execute(ctx, txReqs, batchRequest.InOrder, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := make([]*api.TransactionResponse, len(txResponses))
+	for idx, txResponse := range txResponses {
+		response[idx] = formatters.FormatTxResponse(txResponse)
+	}
+
+	rw.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
 // @Summary      Creates and sends a raw transaction
 // @Description  Creates and executes a new raw transaction request
 // @Tags         Transactions
@@ -249,7 +339,11 @@ func (c *TransactionsController) getOne(rw http.ResponseWriter, request *http.Re
 // @Security     ApiKeyAuth
 // @Security     JWTAuth
 // @Param        idempotency_keys  query     []string                 false  "List of idempotency keys"  collectionFormat(csv)
+// @Param        limit             query     int                      false  "Maximum number of results (default 100), a Link header to the next page is returned on full pages"
+// @Param        next              query     string                   false  "Opaque cursor of the next page, as returned in the Link header"
+// @Param        sort              query     string                   false  "Sort key (created_at), prefixed by - for descending order"
 // @Success      200               {array}   api.TransactionResponse  "List of transaction requests found"
+// @Header       200               {string}  Link                     "Link to the next page of results"
 // @Failure      400               {object}  httputil.ErrorResponse   "Invalid filter in the request"
 // @Failure      500               {object}  httputil.ErrorResponse   "Internal server error"
 // @Router       /transactions [get]
@@ -269,6 +363,11 @@ func (c *TransactionsController) search(rw http.ResponseWriter, request *http.Re
 		return
 	}
 
+	if filters.HasNextPage(len(txRequests)) {
+		last := txRequests[len(txRequests)-1]
+		rw.Header().Set("Link", formatters.FormatNextPageLink(request, filters.Sort, last.CreatedAt, last.CreatedAt, last.Schedule.UUID))
+	}
+
 	response := []*api.TransactionResponse{}
 	for _, txRequest := range txRequests {
 		response = append(response, formatters.FormatTxResponse(txRequest))
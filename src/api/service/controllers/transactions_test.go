@@ -35,10 +35,12 @@ type transactionsControllerTestSuite struct {
 	sendContractTxUseCase *mocks.MockSendContractTxUseCase
 	sendDeployTxUseCase   *mocks.MockSendDeployTxUseCase
 	sendTxUseCase         *mocks.MockSendTxUseCase
+	sendTxBatchUseCase    *mocks.MockSendTxBatchUseCase
 	getTxUseCase          *mocks.MockGetTxUseCase
 	searchTxsUsecase      *mocks.MockSearchTransactionsUseCase
 	speedUpTxUseCase      *mocks.MockSpeedUpTxUseCase
 	callOffTxUseCase      *mocks.MockCallOffTxUseCase
+	simulateTxUseCase     *mocks.MockSimulateTxUseCase
 	ctx                   context.Context
 	userInfo              *multitenancy.UserInfo
 	defaultRetryInterval  time.Duration
@@ -56,6 +58,10 @@ func (s *transactionsControllerTestSuite) SendTransaction() usecases.SendTxUseCa
 	return s.sendTxUseCase
 }
 
+func (s *transactionsControllerTestSuite) SendTransactionBatch() usecases.SendTxBatchUseCase {
+	return s.sendTxBatchUseCase
+}
+
 func (s *transactionsControllerTestSuite) GetTransaction() usecases.GetTxUseCase {
 	return s.getTxUseCase
 }
@@ -72,6 +78,10 @@ func (s *transactionsControllerTestSuite) CallOffTransaction() usecases.CallOffT
 	return s.callOffTxUseCase
 }
 
+func (s *transactionsControllerTestSuite) SimulateTransaction() usecases.SimulateTxUseCase {
+	return s.simulateTxUseCase
+}
+
 var _ usecases.TransactionUseCases = &transactionsControllerTestSuite{}
 
 func TestTransactionsController(t *testing.T) {
@@ -86,10 +96,12 @@ func (s *transactionsControllerTestSuite) SetupTest() {
 	s.sendContractTxUseCase = mocks.NewMockSendContractTxUseCase(ctrl)
 	s.sendDeployTxUseCase = mocks.NewMockSendDeployTxUseCase(ctrl)
 	s.sendTxUseCase = mocks.NewMockSendTxUseCase(ctrl)
+	s.sendTxBatchUseCase = mocks.NewMockSendTxBatchUseCase(ctrl)
 	s.getTxUseCase = mocks.NewMockGetTxUseCase(ctrl)
 	s.searchTxsUsecase = mocks.NewMockSearchTransactionsUseCase(ctrl)
 	s.speedUpTxUseCase = mocks.NewMockSpeedUpTxUseCase(ctrl)
 	s.callOffTxUseCase = mocks.NewMockCallOffTxUseCase(ctrl)
+	s.simulateTxUseCase = mocks.NewMockSimulateTxUseCase(ctrl)
 	s.searchTxsUsecase = mocks.NewMockSearchTransactionsUseCase(ctrl)
 	s.defaultRetryInterval = time.Second * 2
 	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
@@ -188,6 +200,66 @@ func (s *transactionsControllerTestSuite) TestSend() {
 	})
 }
 
+func (s *transactionsControllerTestSuite) TestSimulate() {
+	urlPath := "/transactions/simulate"
+
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		txRequest := apitestdata.FakeSendTransactionRequest()
+		requestBytes, _ := json.Marshal(txRequest)
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		simulation := &entities.TxSimulation{
+			Transaction: testdata.FakeETHTransaction(),
+			Revert:      &entities.TxRevert{Reason: "Not enough Ether provided."},
+		}
+
+		s.simulateTxUseCase.EXPECT().
+			Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			DoAndReturn(func(ctx context.Context, txReq *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TxSimulation, error) {
+				assert.Equal(t, txRequest.Params.MethodSignature, txReq.Params.MethodSignature)
+				assert.Empty(t, txReq.IdempotencyKey)
+				return simulation, nil
+			})
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatSimulateTxResponse(simulation)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.True(t, response.Reverted)
+	})
+
+	s.T().Run("should fail with 422 if use case fails with InvalidParameterError", func(t *testing.T) {
+		txRequest := apitestdata.FakeSendTransactionRequest()
+		requestBytes, _ := json.Marshal(txRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.simulateTxUseCase.EXPECT().
+			Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			Return(nil, errors.InvalidParameterError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusUnprocessableEntity, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if invalid format", func(t *testing.T) {
+		txRequest := apitestdata.FakeSendTransactionRequest()
+		txRequest.Params.MethodSignature = ""
+		requestBytes, _ := json.Marshal(txRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
 func (s *transactionsControllerTestSuite) TestDeploy() {
 	urlPath := "/transactions/deploy-contract"
 	idempotencyKey := "idempotencyKey"
@@ -365,6 +437,102 @@ func (s *transactionsControllerTestSuite) TestTransfer() {
 	})
 }
 
+func (s *transactionsControllerTestSuite) TestSendBatch() {
+	urlPath := "/transactions/send-batch"
+
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		batchRequest := apitestdata.FakeSendTransactionBatchRequest()
+		requestBytes, _ := json.Marshal(batchRequest)
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		txRequestEntitiesResp := []*entities.TxRequest{testdata.FakeTxRequest(), testdata.FakeTransferTxRequest(), testdata.FakeTxRequest()}
+		s.sendTxBatchUseCase.EXPECT().Execute(gomock.Any(), gomock.Len(3), true, s.userInfo).Return(txRequestEntitiesResp, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := []*apitypes.TransactionResponse{}
+		for _, txRequest := range txRequestEntitiesResp {
+			response = append(response, formatters.FormatTxResponse(txRequest))
+		}
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusAccepted, rw.Code)
+	})
+
+	s.T().Run("should fail with 422 if use case fails with InvalidParameterError", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		requestBytes, _ := json.Marshal(apitestdata.FakeSendTransactionBatchRequest())
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.sendTxBatchUseCase.EXPECT().Execute(gomock.Any(), gomock.Any(), true, s.userInfo).
+			Return(nil, errors.InvalidParameterError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusUnprocessableEntity, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if a transaction is invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		batchRequest := apitestdata.FakeSendTransactionBatchRequest()
+		batchRequest.Transactions[1].Transfer.ChainName = ""
+		requestBytes, _ := json.Marshal(batchRequest)
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+		assert.Contains(t, rw.Body.String(), "transactions[1]")
+	})
+
+	s.T().Run("should fail with Bad request if a transaction has several types", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		batchRequest := apitestdata.FakeSendTransactionBatchRequest()
+		batchRequest.Transactions[0].Transfer = apitestdata.FakeSendTransferTransactionRequest()
+		requestBytes, _ := json.Marshal(batchRequest)
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should accept batches of 500 transfers and reject larger batches", func(t *testing.T) {
+		batchRequest := &apitypes.SendTransactionBatchRequest{}
+		for i := 0; i < 500; i++ {
+			batchRequest.Transactions = append(batchRequest.Transactions, &apitypes.TransactionBatchItem{Transfer: apitestdata.FakeSendTransferTransactionRequest()})
+		}
+
+		rw := httptest.NewRecorder()
+		requestBytes, _ := json.Marshal(batchRequest)
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+		s.sendTxBatchUseCase.EXPECT().Execute(gomock.Any(), gomock.Len(500), false, s.userInfo).Return([]*entities.TxRequest{}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusAccepted, rw.Code)
+
+		batchRequest.Transactions = append(batchRequest.Transactions, &apitypes.TransactionBatchItem{Transfer: apitestdata.FakeSendTransferTransactionRequest()})
+		rw = httptest.NewRecorder()
+		requestBytes, _ = json.Marshal(batchRequest)
+		httpRequest = httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if batch is empty", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		requestBytes, _ := json.Marshal(&apitypes.SendTransactionBatchRequest{})
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
 func (s *transactionsControllerTestSuite) TestGetOne() {
 	uuid := "uuid"
 	urlPath := "/transactions/" + uuid
@@ -479,6 +647,7 @@ func (s *transactionsControllerTestSuite) TestSearch() {
 		httpRequest := httptest.NewRequest(http.MethodGet, urlPath+"?idempotency_keys=mykey,mykey1", nil).WithContext(s.ctx)
 		txRequest := testdata.FakeTransferTxRequest()
 		expectedFilers := &entities.TransactionRequestFilters{
+			Pagination:      entities.Pagination{Limit: entities.DefaultPageLimit},
 			IdempotencyKeys: []string{"mykey", "mykey1"},
 		}
 
@@ -505,6 +674,7 @@ func (s *transactionsControllerTestSuite) TestSearch() {
 		rw := httptest.NewRecorder()
 		httpRequest := httptest.NewRequest(http.MethodGet, urlPath+"?idempotency_keys=mykey,mykey1", nil).WithContext(s.ctx)
 		expectedFilers := &entities.TransactionRequestFilters{
+			Pagination:      entities.Pagination{Limit: entities.DefaultPageLimit},
 			IdempotencyKeys: []string{"mykey", "mykey1"},
 		}
 
@@ -0,0 +1,217 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	jsonutils "github.com/consensys/orchestrate/pkg/encoding/json"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/gorilla/mux"
+)
+
+type WebhooksController struct {
+	ucs usecases.WebhookUseCases
+}
+
+func NewWebhooksController(ucs usecases.WebhookUseCases) *WebhooksController {
+	return &WebhooksController{ucs: ucs}
+}
+
+// Add routes to router
+func (c *WebhooksController) Append(router *mux.Router) {
+	router.Methods(http.MethodGet).Path("/webhooks").HandlerFunc(c.search)
+	router.Methods(http.MethodGet).Path("/webhooks/{uuid}").HandlerFunc(c.getOne)
+	router.Methods(http.MethodGet).Path("/webhooks/{uuid}/deliveries").HandlerFunc(c.searchDeliveries)
+	router.Methods(http.MethodPost).Path("/webhooks").HandlerFunc(c.create)
+	router.Methods(http.MethodPatch).Path("/webhooks/{uuid}").HandlerFunc(c.update)
+	router.Methods(http.MethodDelete).Path("/webhooks/{uuid}").HandlerFunc(c.delete)
+}
+
+// @Summary   Retrieves a list of all registered webhooks
+// @Tags      Webhooks
+// @Produce   json
+// @Security  ApiKeyAuth
+// @Security  JWTAuth
+// @Success   200  {array}   api.WebhookResponse
+// @Failure   500  {object}  httputil.ErrorResponse  "Internal server error"
+// @Router    /webhooks [get]
+func (c *WebhooksController) search(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	webhooks, err := c.ucs.SearchWebhooks().Execute(ctx, &entities.WebhookFilters{}, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.WebhookResponse{}
+	for _, webhook := range webhooks {
+		response = append(response, formatters.FormatWebhookResponse(webhook))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary   Retrieves a webhook by ID
+// @Tags      Webhooks
+// @Produce   json
+// @Security  ApiKeyAuth
+// @Security  JWTAuth
+// @Param     uuid  path      string  true  "ID of the webhook"
+// @Success   200   {object}  api.WebhookResponse
+// @Failure   404   {object}  httputil.ErrorResponse  "Webhook not found"
+// @Failure   500   {object}  httputil.ErrorResponse  "Internal server error"
+// @Router    /webhooks/{uuid} [get]
+func (c *WebhooksController) getOne(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	webhook, err := c.ucs.GetWebhook().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatWebhookResponse(webhook))
+}
+
+// @Summary   Retrieves the latest deliveries of a webhook
+// @Tags      Webhooks
+// @Produce   json
+// @Security  ApiKeyAuth
+// @Security  JWTAuth
+// @Param     uuid      path      string                  true   "ID of the webhook"
+// @Param     job_uuid  query     string                  false  "ID of the notified job"
+// @Param     state     query     string                  false  "state of the delivery (PENDING, DELIVERED or FAILED)"
+// @Param     limit     query     int                     false  "Maximum number of results (default 100), a Link header to the next page is returned on full pages"
+// @Param     next      query     string                  false  "Opaque cursor of the next page, as returned in the Link header"
+// @Param     sort      query     string                  false  "Sort key (created_at or updated_at), prefixed by - for descending order (default -created_at)"
+// @Success   200       {array}   api.WebhookDeliveryResponse
+// @Header    200       {string}  Link                    "Link to the next page of results"
+// @Failure   400       {object}  httputil.ErrorResponse  "Invalid request"
+// @Failure   404       {object}  httputil.ErrorResponse  "Webhook not found"
+// @Failure   500       {object}  httputil.ErrorResponse  "Internal server error"
+// @Router    /webhooks/{uuid}/deliveries [get]
+func (c *WebhooksController) searchDeliveries(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	filters, err := formatters.FormatWebhookDeliveryFilters(request)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	deliveries, err := c.ucs.SearchWebhookDeliveries().Execute(ctx, mux.Vars(request)["uuid"], filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	if filters.HasNextPage(len(deliveries)) {
+		last := deliveries[len(deliveries)-1]
+		rw.Header().Set("Link", formatters.FormatNextPageLink(request, filters.Sort, last.CreatedAt, last.UpdatedAt, last.UUID))
+	}
+
+	response := []*api.WebhookDeliveryResponse{}
+	for _, delivery := range deliveries {
+		response = append(response, formatters.FormatWebhookDeliveryResponse(delivery))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary      Creates a new webhook
+// @Description  Job status changes matching the filters of the webhook are sent to its URL in a POST request, signed with an HMAC-SHA256 of the body in the X-Orchestrate-Signature header
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.CreateWebhookRequest  true  "Webhook creation request"
+// @Success      200      {object}  api.WebhookResponse
+// @Failure      400      {object}  httputil.ErrorResponse  "Invalid request"
+// @Failure      422      {object}  httputil.ErrorResponse  "Unprocessable entity"
+// @Failure      500      {object}  httputil.ErrorResponse  "Internal server error"
+// @Router       /webhooks [post]
+func (c *WebhooksController) create(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	webhookRequest := &api.CreateWebhookRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, webhookRequest)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	webhook, err := c.ucs.CreateWebhook().Execute(ctx, formatters.FormatCreateWebhookRequest(webhookRequest), multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatWebhookResponse(webhook))
+}
+
+// @Summary   Updates a webhook by ID
+// @Tags      Webhooks
+// @Accept    json
+// @Produce   json
+// @Security  ApiKeyAuth
+// @Security  JWTAuth
+// @Param     uuid     path      string                    true  "ID of the webhook"
+// @Param     request  body      api.UpdateWebhookRequest  true  "Webhook update request"
+// @Success   200      {object}  api.WebhookResponse
+// @Failure   400      {object}  httputil.ErrorResponse  "Invalid request"
+// @Failure   404      {object}  httputil.ErrorResponse  "Webhook not found"
+// @Failure   422      {object}  httputil.ErrorResponse  "Unprocessable entity"
+// @Failure   500      {object}  httputil.ErrorResponse  "Internal server error"
+// @Router    /webhooks/{uuid} [patch]
+func (c *WebhooksController) update(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	webhookRequest := &api.UpdateWebhookRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, webhookRequest)
+	if err != nil {
+		httputil.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	uuid := mux.Vars(request)["uuid"]
+	webhook, err := c.ucs.UpdateWebhook().Execute(ctx, formatters.FormatUpdateWebhookRequest(webhookRequest, uuid), multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatWebhookResponse(webhook))
+}
+
+// @Summary   Deletes a webhook by ID
+// @Tags      Webhooks
+// @Security  ApiKeyAuth
+// @Security  JWTAuth
+// @Param     uuid  path  string  true  "ID of the webhook"
+// @Success   204
+// @Failure   404  {object}  httputil.ErrorResponse  "Webhook not found"
+// @Failure   500  {object}  httputil.ErrorResponse  "Internal server error"
+// @Router    /webhooks/{uuid} [delete]
+func (c *WebhooksController) delete(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	err := c.ucs.DeleteWebhook().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		httputil.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
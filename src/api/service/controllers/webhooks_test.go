@@ -0,0 +1,335 @@
+// +build unit
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	apitestdata "github.com/consensys/orchestrate/src/api/service/types/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const webhooksEndpoint = "/webhooks"
+
+type webhooksCtrlTestSuite struct {
+	suite.Suite
+	createWebhookUC           *mocks.MockCreateWebhookUseCase
+	updateWebhookUC           *mocks.MockUpdateWebhookUseCase
+	getWebhookUC              *mocks.MockGetWebhookUseCase
+	searchWebhooksUC          *mocks.MockSearchWebhooksUseCase
+	deleteWebhookUC           *mocks.MockDeleteWebhookUseCase
+	searchWebhookDeliveriesUC *mocks.MockSearchWebhookDeliveriesUseCase
+	ctx                       context.Context
+	userInfo                  *multitenancy.UserInfo
+	router                    *mux.Router
+}
+
+var _ usecases.WebhookUseCases = &webhooksCtrlTestSuite{}
+
+func (s *webhooksCtrlTestSuite) CreateWebhook() usecases.CreateWebhookUseCase {
+	return s.createWebhookUC
+}
+
+func (s *webhooksCtrlTestSuite) UpdateWebhook() usecases.UpdateWebhookUseCase {
+	return s.updateWebhookUC
+}
+
+func (s *webhooksCtrlTestSuite) GetWebhook() usecases.GetWebhookUseCase {
+	return s.getWebhookUC
+}
+
+func (s *webhooksCtrlTestSuite) SearchWebhooks() usecases.SearchWebhooksUseCase {
+	return s.searchWebhooksUC
+}
+
+func (s *webhooksCtrlTestSuite) DeleteWebhook() usecases.DeleteWebhookUseCase {
+	return s.deleteWebhookUC
+}
+
+func (s *webhooksCtrlTestSuite) SearchWebhookDeliveries() usecases.SearchWebhookDeliveriesUseCase {
+	return s.searchWebhookDeliveriesUC
+}
+
+func (s *webhooksCtrlTestSuite) DeliverWebhooks() usecases.DeliverWebhooksUseCase {
+	return nil
+}
+
+func TestWebhooksController(t *testing.T) {
+	s := new(webhooksCtrlTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *webhooksCtrlTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.createWebhookUC = mocks.NewMockCreateWebhookUseCase(ctrl)
+	s.updateWebhookUC = mocks.NewMockUpdateWebhookUseCase(ctrl)
+	s.getWebhookUC = mocks.NewMockGetWebhookUseCase(ctrl)
+	s.searchWebhooksUC = mocks.NewMockSearchWebhooksUseCase(ctrl)
+	s.deleteWebhookUC = mocks.NewMockDeleteWebhookUseCase(ctrl)
+	s.searchWebhookDeliveriesUC = mocks.NewMockSearchWebhookDeliveriesUseCase(ctrl)
+
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
+	s.router = mux.NewRouter()
+
+	controller := NewWebhooksController(s)
+	controller.Append(s.router)
+}
+
+func (s *webhooksCtrlTestSuite) TestWebhooksController_Create() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := apitestdata.FakeCreateWebhookRequest()
+		requestBytes, _ := json.Marshal(req)
+		webhook := testdata.FakeWebhook()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, webhooksEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.createWebhookUC.EXPECT().Execute(gomock.Any(), formatters.FormatCreateWebhookRequest(req), s.userInfo).Return(webhook, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatWebhookResponse(webhook)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.NotContains(t, rw.Body.String(), webhook.Secret)
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if invalid format", func(t *testing.T) {
+		req := apitestdata.FakeCreateWebhookRequest()
+		req.URL = "not-an-url"
+		requestBytes, _ := json.Marshal(req)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, webhooksEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if invalid status filter", func(t *testing.T) {
+		req := apitestdata.FakeCreateWebhookRequest()
+		req.Statuses = []entities.JobStatus{"INVALID"}
+		requestBytes, _ := json.Marshal(req)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, webhooksEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 500 if use case fails with an unexpected error", func(t *testing.T) {
+		req := apitestdata.FakeCreateWebhookRequest()
+		requestBytes, _ := json.Marshal(req)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, webhooksEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.createWebhookUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return(nil, fmt.Errorf("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+	})
+}
+
+func (s *webhooksCtrlTestSuite) TestWebhooksController_GetOne() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		webhook := testdata.FakeWebhook()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, webhooksEndpoint+"/webhookUUID", nil).
+			WithContext(s.ctx)
+
+		s.getWebhookUC.EXPECT().Execute(gomock.Any(), "webhookUUID", s.userInfo).Return(webhook, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatWebhookResponse(webhook)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 404 if webhook is not found", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, webhooksEndpoint+"/webhookUUID", nil).
+			WithContext(s.ctx)
+
+		s.getWebhookUC.EXPECT().Execute(gomock.Any(), "webhookUUID", s.userInfo).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *webhooksCtrlTestSuite) TestWebhooksController_Search() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		webhook := testdata.FakeWebhook()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, webhooksEndpoint, nil).
+			WithContext(s.ctx)
+
+		s.searchWebhooksUC.EXPECT().Execute(gomock.Any(), &entities.WebhookFilters{}, s.userInfo).
+			Return([]*entities.Webhook{webhook}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := []*api.WebhookResponse{formatters.FormatWebhookResponse(webhook)}
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+}
+
+func (s *webhooksCtrlTestSuite) TestWebhooksController_SearchDeliveries() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		delivery := testdata.FakeWebhookDelivery()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, webhooksEndpoint+"/webhookUUID/deliveries?state=FAILED&job_uuid="+delivery.JobUUID, nil).
+			WithContext(s.ctx)
+
+		expectedFilters := &entities.WebhookDeliveryFilters{
+			Pagination: entities.Pagination{Limit: entities.DefaultPageLimit, Sort: "-" + entities.SortByCreatedAt},
+			JobUUID:    delivery.JobUUID,
+			State:      entities.WebhookDeliveryFailed,
+		}
+		s.searchWebhookDeliveriesUC.EXPECT().Execute(gomock.Any(), "webhookUUID", expectedFilters, s.userInfo).
+			Return([]*entities.WebhookDelivery{delivery}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := []*api.WebhookDeliveryResponse{formatters.FormatWebhookDeliveryResponse(delivery)}
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should return a link to the next page of deliveries", func(t *testing.T) {
+		delivery := testdata.FakeWebhookDelivery()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, webhooksEndpoint+"/webhookUUID/deliveries?limit=1", nil).
+			WithContext(s.ctx)
+
+		expectedFilters := &entities.WebhookDeliveryFilters{
+			Pagination: entities.Pagination{Limit: 1, Sort: "-" + entities.SortByCreatedAt},
+		}
+		s.searchWebhookDeliveriesUC.EXPECT().Execute(gomock.Any(), "webhookUUID", expectedFilters, s.userInfo).
+			Return([]*entities.WebhookDelivery{delivery}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Contains(t, rw.Header().Get("Link"), "rel=\"next\"")
+	})
+
+	s.T().Run("should fail with Bad request if invalid state", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, webhooksEndpoint+"/webhookUUID/deliveries?state=UNKNOWN", nil).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *webhooksCtrlTestSuite) TestWebhooksController_Update() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := apitestdata.FakeUpdateWebhookRequest()
+		requestBytes, _ := json.Marshal(req)
+		webhook := testdata.FakeWebhook()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPatch, webhooksEndpoint+"/webhookUUID", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.updateWebhookUC.EXPECT().
+			Execute(gomock.Any(), formatters.FormatUpdateWebhookRequest(req, "webhookUUID"), s.userInfo).
+			Return(webhook, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatWebhookResponse(webhook)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if invalid format", func(t *testing.T) {
+		req := apitestdata.FakeUpdateWebhookRequest()
+		req.ChainUUID = "invalidUUID"
+		requestBytes, _ := json.Marshal(req)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPatch, webhooksEndpoint+"/webhookUUID", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *webhooksCtrlTestSuite) TestWebhooksController_Delete() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, webhooksEndpoint+"/webhookUUID", nil).
+			WithContext(s.ctx)
+
+		s.deleteWebhookUC.EXPECT().Execute(gomock.Any(), "webhookUUID", s.userInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+
+	s.T().Run("should fail with 404 if webhook is not found", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, webhooksEndpoint+"/webhookUUID", nil).
+			WithContext(s.ctx)
+
+		s.deleteWebhookUC.EXPECT().Execute(gomock.Any(), "webhookUUID", s.userInfo).Return(errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
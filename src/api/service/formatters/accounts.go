@@ -12,9 +12,10 @@ import (
 
 func FormatCreateAccountRequest(req *api.CreateAccountRequest, defaultStoreID string) *entities.Account {
 	acc := &entities.Account{
-		Alias:      req.Alias,
-		Attributes: req.Attributes,
-		StoreID:    req.StoreID,
+		Alias:          req.Alias,
+		Attributes:     req.Attributes,
+		StoreID:        req.StoreID,
+		ApprovalPolicy: req.ApprovalPolicy,
 	}
 
 	if acc.StoreID == "" {
@@ -26,9 +27,10 @@ func FormatCreateAccountRequest(req *api.CreateAccountRequest, defaultStoreID st
 
 func FormatImportAccountRequest(req *api.ImportAccountRequest, defaultStoreID string) *entities.Account {
 	acc := &entities.Account{
-		Alias:      req.Alias,
-		Attributes: req.Attributes,
-		StoreID:    req.StoreID,
+		Alias:          req.Alias,
+		Attributes:     req.Attributes,
+		StoreID:        req.StoreID,
+		ApprovalPolicy: req.ApprovalPolicy,
 	}
 
 	if acc.StoreID == "" {
@@ -39,6 +41,15 @@ func FormatImportAccountRequest(req *api.ImportAccountRequest, defaultStoreID st
 }
 
 func FormatUpdateAccountRequest(req *api.UpdateAccountRequest) *entities.Account {
+	return &entities.Account{
+		Alias:          req.Alias,
+		Attributes:     req.Attributes,
+		StoreID:        req.StoreID,
+		ApprovalPolicy: req.ApprovalPolicy,
+	}
+}
+
+func FormatRotateAccountRequest(req *api.RotateAccountRequest) *entities.Account {
 	return &entities.Account{
 		Alias:      req.Alias,
 		Attributes: req.Attributes,
@@ -56,6 +67,12 @@ func FormatAccountResponse(iden *entities.Account) *api.AccountResponse {
 		TenantID:            iden.TenantID,
 		OwnerID:             iden.OwnerID,
 		StoreID:             iden.StoreID,
+		ApprovalPolicy:      iden.ApprovalPolicy,
+		DisabledAt:          iden.DisabledAt,
+		DisabledBy:          iden.DisabledBy,
+		Successor:           iden.Successor,
+		Predecessor:         iden.Predecessor,
+		DrainTxUUID:         iden.DrainTxUUID,
 		CreatedAt:           iden.CreatedAt,
 		UpdatedAt:           iden.UpdatedAt,
 	}
@@ -64,6 +81,12 @@ func FormatAccountResponse(iden *entities.Account) *api.AccountResponse {
 func FormatAccountFilterRequest(req *http.Request) (*entities.AccountFilters, error) {
 	filters := &entities.AccountFilters{}
 
+	pagination, err := FormatPaginationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
 	qAliases := req.URL.Query().Get("aliases")
 	if qAliases != "" {
 		filters.Aliases = strings.Split(qAliases, ",")
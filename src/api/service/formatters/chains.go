@@ -28,6 +28,8 @@ func FormatChainResponse(chain *entities.Chain) *types.ChainResponse {
 		ListenerExternalTxEnabled: chain.ListenerExternalTxEnabled,
 		PrivateTxManager:          chain.PrivateTxManager,
 		Labels:                    chain.Labels,
+		RPCPolicy:                 chain.RPCPolicy,
+		GasOracle:                 chain.GasOracle,
 		CreatedAt:                 chain.CreatedAt,
 		UpdatedAt:                 chain.UpdatedAt,
 	}
@@ -41,6 +43,8 @@ func FormatRegisterChainRequest(request *types.RegisterChainRequest, fromLatest
 		ListenerBackOffDuration:   request.Listener.BackOffDuration,
 		ListenerExternalTxEnabled: request.Listener.ExternalTxEnabled,
 		Labels:                    request.Labels,
+		RPCPolicy:                 request.RPCPolicy,
+		GasOracle:                 request.GasOracle,
 	}
 
 	if request.Listener.BackOffDuration == "" {
@@ -70,9 +74,11 @@ func FormatRegisterChainRequest(request *types.RegisterChainRequest, fromLatest
 
 func FormatUpdateChainRequest(request *types.UpdateChainRequest, uuid string) *entities.Chain {
 	chain := &entities.Chain{
-		UUID:   uuid,
-		Name:   request.Name,
-		Labels: request.Labels,
+		UUID:      uuid,
+		Name:      request.Name,
+		Labels:    request.Labels,
+		RPCPolicy: request.RPCPolicy,
+		GasOracle: request.GasOracle,
 	}
 
 	if request.Listener != nil {
@@ -95,6 +101,12 @@ func FormatUpdateChainRequest(request *types.UpdateChainRequest, uuid string) *e
 func FormatChainFiltersRequest(req *http.Request) (*entities.ChainFilters, error) {
 	filters := &entities.ChainFilters{}
 
+	pagination, err := FormatPaginationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
 	qNames := req.URL.Query().Get("names")
 	if qNames != "" {
 		filters.Names = strings.Split(qNames, ",")
@@ -3,9 +3,8 @@ package formatters
 import (
 	"net/http"
 	"strconv"
-	"strings"
 
-	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/consensys/orchestrate/pkg/ethereum/abi"
 
 	"encoding/json"
 
@@ -27,7 +26,7 @@ func FormatRegisterContractRequest(req *types.RegisterContractRequest) (*entitie
 		tag = entities.DefaultTagValue
 	}
 
-	parsedABI, err := abi.JSON(strings.NewReader(string(rawABI)))
+	parsedABI, err := abi.ParseABI(string(rawABI))
 	if err != nil {
 		return nil, err
 	}
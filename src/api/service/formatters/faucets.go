@@ -11,45 +11,77 @@ import (
 
 func FormatRegisterFaucetRequest(request *types.RegisterFaucetRequest) *entities.Faucet {
 	return &entities.Faucet{
-		Name:            request.Name,
-		ChainRule:       request.ChainRule,
-		CreditorAccount: request.CreditorAccount,
-		MaxBalance:      request.MaxBalance,
-		Amount:          request.Amount,
-		Cooldown:        request.Cooldown,
+		Name:              request.Name,
+		ChainRule:         request.ChainRule,
+		CreditorAccount:   request.CreditorAccount,
+		TokenAddress:      request.TokenAddress,
+		MaxBalance:        request.MaxBalance,
+		Amount:            request.Amount,
+		Cooldown:          request.Cooldown,
+		DailyBudget:       request.DailyBudget,
+		TenantDailyBudget: request.TenantDailyBudget,
 	}
 }
 
 func FormatUpdateFaucetRequest(request *types.UpdateFaucetRequest, uuid string) *entities.Faucet {
 	return &entities.Faucet{
-		UUID:            uuid,
-		Name:            request.Name,
-		ChainRule:       request.ChainRule,
-		CreditorAccount: request.CreditorAccount,
-		MaxBalance:      request.MaxBalance,
-		Amount:          request.Amount,
-		Cooldown:        request.Cooldown,
+		UUID:              uuid,
+		Name:              request.Name,
+		ChainRule:         request.ChainRule,
+		CreditorAccount:   request.CreditorAccount,
+		TokenAddress:      request.TokenAddress,
+		MaxBalance:        request.MaxBalance,
+		Amount:            request.Amount,
+		Cooldown:          request.Cooldown,
+		DailyBudget:       request.DailyBudget,
+		TenantDailyBudget: request.TenantDailyBudget,
 	}
 }
 
 func FormatFaucetResponse(faucet *entities.Faucet) *types.FaucetResponse {
 	return &types.FaucetResponse{
-		UUID:            faucet.UUID,
-		Name:            faucet.Name,
-		TenantID:        faucet.TenantID,
-		ChainRule:       faucet.ChainRule,
-		CreditorAccount: faucet.CreditorAccount,
-		MaxBalance:      faucet.MaxBalance,
-		Amount:          faucet.Amount,
-		Cooldown:        faucet.Cooldown,
-		CreatedAt:       faucet.CreatedAt,
-		UpdatedAt:       faucet.UpdatedAt,
+		UUID:              faucet.UUID,
+		Name:              faucet.Name,
+		TenantID:          faucet.TenantID,
+		ChainRule:         faucet.ChainRule,
+		CreditorAccount:   faucet.CreditorAccount,
+		TokenAddress:      faucet.TokenAddress,
+		MaxBalance:        faucet.MaxBalance,
+		Amount:            faucet.Amount,
+		Cooldown:          faucet.Cooldown,
+		DailyBudget:       faucet.DailyBudget,
+		TenantDailyBudget: faucet.TenantDailyBudget,
+		DailySpent:        faucet.DailySpent,
+		Spendings:         FormatFaucetSpendingsResponse(faucet.Spendings),
+		CreatedAt:         faucet.CreatedAt,
+		UpdatedAt:         faucet.UpdatedAt,
 	}
 }
 
+func FormatFaucetSpendingsResponse(spendings []*entities.FaucetSpending) []*types.FaucetSpendingResponse {
+	var res []*types.FaucetSpendingResponse
+	for _, spending := range spendings {
+		res = append(res, &types.FaucetSpendingResponse{
+			JobUUID:     spending.JobUUID,
+			TenantID:    spending.TenantID,
+			Beneficiary: spending.Beneficiary,
+			Amount:      spending.Amount,
+			CreatedAt:   spending.CreatedAt,
+		})
+	}
+
+	return res
+}
+
 func FormatFaucetFilters(req *http.Request) (*entities.FaucetFilters, error) {
 	filters := &entities.FaucetFilters{}
 
+	pagination, err := FormatPaginationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
 	qNames := req.URL.Query().Get("names")
 	if qNames != "" {
 		filters.Names = strings.Split(qNames, ",")
@@ -27,6 +27,10 @@ func FormatJobResponse(job *entities.Job) *types.JobResponse {
 		TenantID:      job.TenantID,
 		OwnerID:       job.OwnerID,
 		Annotations:   FormatInternalDataToAnnotations(job.InternalData),
+		DependsOn:     job.DependsOn,
+		Inputs:        job.Inputs,
+		DecodedInput:  job.DecodedInput,
+		Revert:        job.Revert,
 		Type:          job.Type,
 		Status:        job.Status,
 		ParentJobUUID: job.InternalData.ParentJobUUID,
@@ -35,6 +39,45 @@ func FormatJobResponse(job *entities.Job) *types.JobResponse {
 	}
 }
 
+func FormatJobApprovalResponse(approval *entities.JobApproval) *types.JobApprovalResponse {
+	return &types.JobApprovalResponse{
+		UUID:      approval.UUID,
+		JobUUID:   approval.JobUUID,
+		Username:  approval.Username,
+		Decision:  approval.Decision,
+		Reason:    approval.Reason,
+		CreatedAt: approval.CreatedAt,
+	}
+}
+
+func FormatFailedJobResponse(failedJob *entities.FailedJob) *types.FailedJobResponse {
+	return &types.FailedJobResponse{
+		UUID:         failedJob.UUID,
+		JobUUID:      failedJob.JobUUID,
+		ChainUUID:    failedJob.ChainUUID,
+		TenantID:     failedJob.TenantID,
+		OwnerID:      failedJob.OwnerID,
+		EnvelopeID:   failedJob.EnvelopeID,
+		ErrorCode:    failedJob.ErrorCode,
+		ErrorClass:   failedJob.ErrorClass,
+		ErrorMessage: failedJob.ErrorMessage,
+		ReplayCount:  failedJob.ReplayCount,
+		ReplayedAt:   failedJob.ReplayedAt,
+		CreatedAt:    failedJob.CreatedAt,
+	}
+}
+
+func FormatReplayFailedJobsRequest(request *types.ReplayFailedJobsRequest) *entities.FailedJobFilters {
+	return &entities.FailedJobFilters{
+		JobUUIDs:      request.JobUUIDs,
+		ChainUUID:     request.ChainUUID,
+		ErrorCode:     request.ErrorCode,
+		ErrorClass:    request.ErrorClass,
+		CreatedAfter:  request.CreatedAfter,
+		CreatedBefore: request.CreatedBefore,
+	}
+}
+
 func FormatJobCreateRequest(request *types.CreateJobRequest) *entities.Job {
 	job := &entities.Job{
 		ChainUUID:    request.ChainUUID,
@@ -44,6 +87,8 @@ func FormatJobCreateRequest(request *types.CreateJobRequest) *entities.Job {
 		Labels:       request.Labels,
 		InternalData: FormatAnnotationsToInternalData(request.Annotations),
 		Transaction:  &request.Transaction,
+		DependsOn:    request.DependsOn,
+		Inputs:       request.Inputs,
 	}
 
 	if request.ParentJobUUID != "" {
@@ -59,8 +104,10 @@ func FormatJobCreateRequest(request *types.CreateJobRequest) *entities.Job {
 
 func FormatJobUpdateRequest(request *types.UpdateJobRequest) *entities.Job {
 	job := &entities.Job{
-		Labels:      request.Labels,
-		Transaction: request.Transaction,
+		Labels:       request.Labels,
+		Transaction:  request.Transaction,
+		DecodedInput: request.DecodedInput,
+		Revert:       request.Revert,
 	}
 
 	if request.Annotations != nil {
@@ -73,6 +120,12 @@ func FormatJobUpdateRequest(request *types.UpdateJobRequest) *entities.Job {
 func FormatJobFilterRequest(req *http.Request) (*entities.JobFilters, error) {
 	filters := &entities.JobFilters{}
 
+	pagination, err := FormatPaginationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
 	qTxHashes := req.URL.Query().Get("tx_hashes")
 	if qTxHashes != "" {
 		filters.TxHashes = strings.Split(qTxHashes, ",")
@@ -122,6 +175,104 @@ func FormatJobFilterRequest(req *http.Request) (*entities.JobFilters, error) {
 	return filters, nil
 }
 
+func FormatFailedJobFilterRequest(req *http.Request) (*entities.FailedJobFilters, error) {
+	filters := &entities.FailedJobFilters{
+		ChainUUID:  req.URL.Query().Get("chain_uuid"),
+		ErrorCode:  req.URL.Query().Get("error_code"),
+		ErrorClass: entities.FailedJobErrorClass(req.URL.Query().Get("error_class")),
+	}
+
+	pagination, err := FormatPaginationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
+	qJobUUIDs := req.URL.Query().Get("job_uuids")
+	if qJobUUIDs != "" {
+		filters.JobUUIDs = strings.Split(qJobUUIDs, ",")
+	}
+
+	qCreatedAfter := req.URL.Query().Get("created_after")
+	if qCreatedAfter != "" {
+		createdAfter, err := time.Parse(time.RFC3339, qCreatedAfter)
+		if err != nil {
+			errMessage := "failed to parse created_after as time"
+			log.WithError(err).WithField("created_after", qCreatedAfter).Error(errMessage)
+			return nil, errors.InvalidParameterError(errMessage)
+		}
+
+		filters.CreatedAfter = createdAfter
+	}
+
+	qCreatedBefore := req.URL.Query().Get("created_before")
+	if qCreatedBefore != "" {
+		createdBefore, err := time.Parse(time.RFC3339, qCreatedBefore)
+		if err != nil {
+			errMessage := "failed to parse created_before as time"
+			log.WithError(err).WithField("created_before", qCreatedBefore).Error(errMessage)
+			return nil, errors.InvalidParameterError(errMessage)
+		}
+
+		filters.CreatedBefore = createdBefore
+	}
+
+	qPending := req.URL.Query().Get("pending")
+	if qPending == "true" {
+		filters.OnlyPending = true
+	}
+
+	if err := utils.GetValidator().Struct(filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
+
+func FormatJobEventResponse(event *entities.JobEvent) *types.JobEventResponse {
+	return &types.JobEventResponse{
+		JobUUID:       event.JobUUID,
+		ParentJobUUID: event.ParentJobUUID,
+		ScheduleUUID:  event.ScheduleUUID,
+		ChainUUID:     event.ChainUUID,
+		TenantID:      event.TenantID,
+		OwnerID:       event.OwnerID,
+		Labels:        event.Labels,
+		Status:        event.Status,
+		Message:       event.Message,
+		CreatedAt:     event.CreatedAt,
+	}
+}
+
+// FormatJobEventFilterRequest parses the chain_uuid filter and labels filter, formatted as key:value pairs separated by commas
+func FormatJobEventFilterRequest(req *http.Request) (*entities.JobEventFilters, error) {
+	filters := &entities.JobEventFilters{}
+
+	qChainUUID := req.URL.Query().Get("chain_uuid")
+	if qChainUUID != "" {
+		filters.ChainUUID = qChainUUID
+	}
+
+	qLabels := req.URL.Query().Get("labels")
+	if qLabels != "" {
+		filters.Labels = make(map[string]string)
+		for _, label := range strings.Split(qLabels, ",") {
+			kv := strings.SplitN(label, ":", 2)
+			if len(kv) != 2 || kv[0] == "" {
+				return nil, errors.InvalidParameterError("invalid labels filter, expected key:value pairs")
+			}
+
+			filters.Labels[kv[0]] = kv[1]
+		}
+	}
+
+	if err := utils.GetValidator().Struct(filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
+
 func JobResponseToEntity(jobResponse *types.JobResponse) *entities.Job {
 	// Cannot fail as the duration coming from a response is expected to be valid
 	return &entities.Job{
@@ -0,0 +1,62 @@
+package formatters
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/entities"
+	log "github.com/sirupsen/logrus"
+)
+
+// FormatPaginationRequest parses the pagination query parameters, pages are limited to DefaultPageLimit results by default
+func FormatPaginationRequest(req *http.Request) (entities.Pagination, error) {
+	pagination := entities.Pagination{
+		Limit: entities.DefaultPageLimit,
+		Sort:  req.URL.Query().Get("sort"),
+	}
+
+	qLimit := req.URL.Query().Get("limit")
+	if qLimit != "" {
+		limit, err := strconv.Atoi(qLimit)
+		if err != nil {
+			errMessage := "failed to parse limit as integer"
+			log.WithError(err).WithField("limit", qLimit).Error(errMessage)
+			return pagination, errors.InvalidParameterError(errMessage)
+		}
+
+		if limit < 1 || limit > entities.MaxPageLimit {
+			return pagination, errors.InvalidParameterError("limit must be between 1 and %d", entities.MaxPageLimit)
+		}
+
+		pagination.Limit = limit
+	}
+
+	qNext := req.URL.Query().Get("next")
+	if qNext != "" {
+		if _, _, err := utils.DecodeCursor(qNext); err != nil {
+			return pagination, err
+		}
+
+		pagination.Next = qNext
+	}
+
+	return pagination, nil
+}
+
+// FormatNextPageLink returns the Link header pointing to the page following the search result with the given key
+func FormatNextPageLink(req *http.Request, sort string, createdAt, updatedAt time.Time, key string) string {
+	value := createdAt
+	if strings.TrimPrefix(sort, "-") == entities.SortByUpdatedAt {
+		value = updatedAt
+	}
+
+	qParams := req.URL.Query()
+	qParams.Set("next", utils.EncodeCursor(value, key))
+
+	return fmt.Sprintf("<%s?%s>; rel=\"next\"", req.URL.Path, qParams.Encode())
+}
@@ -0,0 +1,73 @@
+// +build unit
+
+package formatters
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatPaginationRequest(t *testing.T) {
+	t.Run("should parse pagination query parameters", func(t *testing.T) {
+		next := utils.EncodeCursor(time.Now(), "key")
+		req := httptest.NewRequest("GET", "/jobs?limit=10&sort=-updated_at&next="+next, nil)
+
+		pagination, err := FormatPaginationRequest(req)
+
+		require.NoError(t, err)
+		assert.Equal(t, 10, pagination.Limit)
+		assert.Equal(t, "-updated_at", pagination.Sort)
+		assert.Equal(t, next, pagination.Next)
+	})
+
+	t.Run("should apply default limit if none is set", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/jobs", nil)
+
+		pagination, err := FormatPaginationRequest(req)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.DefaultPageLimit, pagination.Limit)
+	})
+
+	t.Run("should fail with InvalidParameterError if limit is greater than the maximum", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/jobs?limit=1001", nil)
+
+		_, err := FormatPaginationRequest(req)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if limit is not an integer", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/jobs?limit=ten", nil)
+
+		_, err := FormatPaginationRequest(req)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if cursor is invalid", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/jobs?next=invalid", nil)
+
+		_, err := FormatPaginationRequest(req)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
+
+func TestFormatNextPageLink(t *testing.T) {
+	createdAt := time.Now().Add(-time.Minute)
+	updatedAt := time.Now()
+	req := httptest.NewRequest("GET", "/jobs?chain_uuid=uuid&limit=1&sort=-updated_at", nil)
+
+	link := FormatNextPageLink(req, "-updated_at", createdAt, updatedAt, "key")
+
+	expectedNext := utils.EncodeCursor(updatedAt, "key")
+	assert.Equal(t, "</jobs?chain_uuid=uuid&limit=1&next="+expectedNext+"&sort=-updated_at>; rel=\"next\"", link)
+}
@@ -1,6 +1,9 @@
 package formatters
 
 import (
+	"net/http"
+
+	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities"
 )
@@ -10,6 +13,11 @@ func FormatScheduleResponse(schedule *entities.Schedule) *types.ScheduleResponse
 		UUID:      schedule.UUID,
 		TenantID:  schedule.TenantID,
 		OwnerID:   schedule.OwnerID,
+		NotBefore: schedule.NotBefore,
+		NotAfter:  schedule.NotAfter,
+		Cron:      schedule.Cron,
+		NextRunAt: schedule.NextRunAt,
+		LastRunAt: schedule.LastRunAt,
 		CreatedAt: schedule.CreatedAt,
 		Jobs:      []*types.JobResponse{},
 	}
@@ -20,3 +28,29 @@ func FormatScheduleResponse(schedule *entities.Schedule) *types.ScheduleResponse
 
 	return scheduleResponse
 }
+
+func FormatScheduleCreateRequest(request *types.CreateScheduleRequest) *entities.Schedule {
+	if request == nil {
+		return nil
+	}
+
+	return &entities.Schedule{
+		NotBefore: request.NotBefore,
+		NotAfter:  request.NotAfter,
+		Cron:      request.Cron,
+	}
+}
+
+func FormatScheduleFiltersRequest(req *http.Request) (*entities.ScheduleFilters, error) {
+	pagination, err := FormatPaginationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &entities.ScheduleFilters{Pagination: pagination}
+	if err := utils.GetValidator().Struct(filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
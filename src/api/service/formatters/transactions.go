@@ -20,6 +20,7 @@ func FormatSendTxRequest(sendTxRequest *types.SendTransactionRequest, idempotenc
 		IdempotencyKey: idempotencyKey,
 		ChainName:      sendTxRequest.ChainName,
 		Labels:         sendTxRequest.Labels,
+		Schedule:       FormatScheduleCreateRequest(sendTxRequest.Schedule),
 		Params: &entities.ETHTransactionParams{
 			From:            sendTxRequest.Params.From,
 			To:              sendTxRequest.Params.To,
@@ -59,6 +60,7 @@ func FormatDeployContractRequest(deployRequest *types.DeployContractRequest, ide
 		IdempotencyKey: idempotencyKey,
 		ChainName:      deployRequest.ChainName,
 		Labels:         deployRequest.Labels,
+		Schedule:       FormatScheduleCreateRequest(deployRequest.Schedule),
 		Params: &entities.ETHTransactionParams{
 			From:            deployRequest.Params.From,
 			Value:           deployRequest.Params.Value,
@@ -111,6 +113,7 @@ func FormatTransferRequest(transferRequest *types.TransferRequest, idempotencyKe
 		IdempotencyKey: idempotencyKey,
 		ChainName:      transferRequest.ChainName,
 		Labels:         transferRequest.Labels,
+		Schedule:       FormatScheduleCreateRequest(transferRequest.Schedule),
 		Params: &entities.ETHTransactionParams{
 			From:            &transferRequest.Params.From,
 			To:              &transferRequest.Params.To,
@@ -129,6 +132,22 @@ func FormatTransferRequest(transferRequest *types.TransferRequest, idempotencyKe
 	}
 }
 
+func FormatSendTxBatchRequest(batchRequest *types.SendTransactionBatchRequest) []*entities.TxRequest {
+	txRequests := make([]*entities.TxRequest, len(batchRequest.Transactions))
+	for idx, item := range batchRequest.Transactions {
+		switch {
+		case item.Send != nil:
+			txRequests[idx] = FormatSendTxRequest(item.Send, item.IdempotencyKey)
+		case item.Transfer != nil:
+			txRequests[idx] = FormatTransferRequest(item.Transfer, item.IdempotencyKey)
+		case item.Deploy != nil:
+			txRequests[idx] = FormatDeployContractRequest(item.Deploy, item.IdempotencyKey)
+		}
+	}
+
+	return txRequests
+}
+
 func FormatTxResponse(txRequest *entities.TxRequest) *types.TransactionResponse {
 	scheduleRes := FormatScheduleResponse(txRequest.Schedule)
 
@@ -142,9 +161,42 @@ func FormatTxResponse(txRequest *entities.TxRequest) *types.TransactionResponse
 	}
 }
 
+func FormatSimulateTxResponse(simulation *entities.TxSimulation) *types.SimulateTransactionResponse {
+	res := &types.SimulateTransactionResponse{
+		Transaction:   simulation.Transaction,
+		Fee:           simulation.Fee,
+		Output:        simulation.Output,
+		DecodedOutput: simulation.DecodedOutput,
+		Reverted:      simulation.Reverted(),
+		Revert:        simulation.Revert,
+		Warning:       simulation.Warning,
+	}
+
+	for _, l := range simulation.Logs {
+		logRes := &types.SimulatedLogResponse{
+			Address:     l.Address.Hex(),
+			Data:        l.Data.String(),
+			Event:       l.Event,
+			DecodedData: l.DecodedData,
+		}
+		for _, topic := range l.Topics {
+			logRes.Topics = append(logRes.Topics, topic.Hex())
+		}
+		res.Logs = append(res.Logs, logRes)
+	}
+
+	return res
+}
+
 func FormatTransactionsFilterRequest(req *http.Request) (*entities.TransactionRequestFilters, error) {
 	filters := &entities.TransactionRequestFilters{}
 
+	pagination, err := FormatPaginationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
 	qIdempotencyKeys := req.URL.Query().Get("idempotency_keys")
 	if qIdempotencyKeys != "" {
 		filters.IdempotencyKeys = strings.Split(qIdempotencyKeys, ",")
@@ -0,0 +1,88 @@
+package formatters
+
+import (
+	"net/http"
+
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+func FormatCreateWebhookRequest(request *types.CreateWebhookRequest) *entities.Webhook {
+	return &entities.Webhook{
+		URL:       request.URL,
+		Secret:    request.Secret,
+		Statuses:  request.Statuses,
+		ChainUUID: request.ChainUUID,
+		Labels:    request.Labels,
+	}
+}
+
+func FormatUpdateWebhookRequest(request *types.UpdateWebhookRequest, uuid string) *entities.Webhook {
+	return &entities.Webhook{
+		UUID:      uuid,
+		URL:       request.URL,
+		Secret:    request.Secret,
+		Statuses:  request.Statuses,
+		ChainUUID: request.ChainUUID,
+		Labels:    request.Labels,
+	}
+}
+
+func FormatWebhookResponse(webhook *entities.Webhook) *types.WebhookResponse {
+	return &types.WebhookResponse{
+		UUID:      webhook.UUID,
+		TenantID:  webhook.TenantID,
+		URL:       webhook.URL,
+		Statuses:  webhook.Statuses,
+		ChainUUID: webhook.ChainUUID,
+		Labels:    webhook.Labels,
+		CreatedAt: webhook.CreatedAt,
+		UpdatedAt: webhook.UpdatedAt,
+	}
+}
+
+func FormatWebhookDeliveryResponse(delivery *entities.WebhookDelivery) *types.WebhookDeliveryResponse {
+	return &types.WebhookDeliveryResponse{
+		UUID:           delivery.UUID,
+		WebhookUUID:    delivery.WebhookUUID,
+		JobUUID:        delivery.JobUUID,
+		JobStatus:      delivery.JobStatus,
+		State:          delivery.State,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
+
+func FormatWebhookDeliveryFilters(req *http.Request) (*entities.WebhookDeliveryFilters, error) {
+	filters := &entities.WebhookDeliveryFilters{}
+
+	pagination, err := FormatPaginationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+	// The delivery log lists the latest deliveries first
+	if filters.Sort == "" {
+		filters.Sort = "-" + entities.SortByCreatedAt
+	}
+
+	qJobUUID := req.URL.Query().Get("job_uuid")
+	if qJobUUID != "" {
+		filters.JobUUID = qJobUUID
+	}
+
+	qState := req.URL.Query().Get("state")
+	if qState != "" {
+		filters.State = entities.WebhookDeliveryState(qState)
+	}
+
+	if err := utils.GetValidator().Struct(filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
@@ -1,29 +1,40 @@
 package types
 
 import (
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type CreateAccountRequest struct {
-	Alias      string            `json:"alias" validate:"omitempty" example:"personal-account" ` // Alias of the account.
-	Chain      string            `json:"chain" validate:"omitempty" example:"besu"`              // Name of the chain. This value should match the chain name defined in the chain creation.
-	StoreID    string            `json:"storeID" validate:"omitempty" example:"qkmStoreID"`      // ID of the Quorum Key Manager store containing the account.
-	Attributes map[string]string `json:"attributes,omitempty"`                                   // Additional information attached to the account.
+	Alias          string                   `json:"alias" validate:"omitempty" example:"personal-account" ` // Alias of the account.
+	Chain          string                   `json:"chain" validate:"omitempty" example:"besu"`              // Name of the chain. This value should match the chain name defined in the chain creation.
+	StoreID        string                   `json:"storeID" validate:"omitempty" example:"qkmStoreID"`      // ID of the Quorum Key Manager store containing the account.
+	Attributes     map[string]string        `json:"attributes,omitempty"`                                   // Additional information attached to the account.
+	ApprovalPolicy *entities.ApprovalPolicy `json:"approvalPolicy,omitempty" validate:"omitempty"`          // Approvals required before the transactions sent from the account start.
 }
 
 type ImportAccountRequest struct {
-	Alias      string            `json:"alias" validate:"omitempty" example:"personal-account"`                                                                            // Alias of the account.
-	Chain      string            `json:"chain" validate:"omitempty" example:"quorum"`                                                                                      // Name of the chain. This value should match the chain name defined in the chain creation.
-	PrivateKey hexutil.Bytes     `json:"privateKey" validate:"required" example:"0x66232652FDFFD802B7252A456DBD8F3ECC0352BBDE76C23B40AFE8AEBD714E2D" swaggertype:"string"` // Private key of the account.
-	StoreID    string            `json:"storeID" validate:"omitempty" example:"qkmStoreID"`                                                                                // ID of the Quorum Key Manager store containing the account.
-	Attributes map[string]string `json:"attributes,omitempty"`                                                                                                             // Additional information attached to the account.
+	Alias          string                   `json:"alias" validate:"omitempty" example:"personal-account"`                                                                            // Alias of the account.
+	Chain          string                   `json:"chain" validate:"omitempty" example:"quorum"`                                                                                      // Name of the chain. This value should match the chain name defined in the chain creation.
+	PrivateKey     hexutil.Bytes            `json:"privateKey" validate:"required" example:"0x66232652FDFFD802B7252A456DBD8F3ECC0352BBDE76C23B40AFE8AEBD714E2D" swaggertype:"string"` // Private key of the account.
+	StoreID        string                   `json:"storeID" validate:"omitempty" example:"qkmStoreID"`                                                                                // ID of the Quorum Key Manager store containing the account.
+	Attributes     map[string]string        `json:"attributes,omitempty"`                                                                                                             // Additional information attached to the account.
+	ApprovalPolicy *entities.ApprovalPolicy `json:"approvalPolicy,omitempty" validate:"omitempty"`                                                                                    // Approvals required before the transactions sent from the account start.
 }
 
 type UpdateAccountRequest struct {
-	Alias      string            `json:"alias" validate:"omitempty"  example:"personal-account"`
-	StoreID    string            `json:"storeID" validate:"omitempty" example:"qkmStoreID"`
-	Attributes map[string]string `json:"attributes,omitempty"`
+	Alias          string                   `json:"alias" validate:"omitempty"  example:"personal-account"`
+	StoreID        string                   `json:"storeID" validate:"omitempty" example:"qkmStoreID"`
+	Attributes     map[string]string        `json:"attributes,omitempty"`
+	ApprovalPolicy *entities.ApprovalPolicy `json:"approvalPolicy,omitempty" validate:"omitempty"`
+}
+
+type RotateAccountRequest struct {
+	Alias      string            `json:"alias" validate:"omitempty" example:"personal-account-v2"` // Alias of the successor account.
+	Chain      string            `json:"chain" validate:"omitempty" example:"besu"`                // Name of the chain on which the remaining balance is sent to the successor account.
+	StoreID    string            `json:"storeID" validate:"omitempty" example:"qkmStoreID"`        // ID of the Quorum Key Manager store containing the successor account. Defaults to the store of the rotated account.
+	Attributes map[string]string `json:"attributes,omitempty"`                                     // Additional information attached to the successor account. Defaults to the attributes of the rotated account.
 }
 
 type SignMessageRequest struct {
@@ -4,34 +4,49 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/entities"
+
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type AccountResponse struct {
-	Alias               string            `json:"alias" example:"personal-account"`                                                                                                                                              // Alias of the account.
-	Address             ethcommon.Address `json:"address" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`                                                                                             // Address of the account.
-	PublicKey           hexutil.Bytes     `json:"publicKey" example:"0x048e66b3e549818ea2cb354fb70749f6c8de8fa484f7530fc447d5fe80a1c424e4f5ae648d648c980ae7095d1efad87161d83886ca4b6c498ac22a93da5099014a" swaggertype:"string"` // Public key of the account.
-	CompressedPublicKey hexutil.Bytes     `json:"compressedPublicKey" example:"0x048e66b3e549818ea2cb354fb70749f6c8de8fa484f7530fc447" swaggertype:"string"`                                                                     // Compressed public key of the account.
-	TenantID            string            `json:"tenantID" example:"tenantFoo"`                                                                                                                                                  // ID of the tenant executing the API.
-	OwnerID             string            `json:"ownerID,omitempty" example:"foo"`                                                                                                                                               // ID of the account owner.
-	StoreID             string            `json:"storeID,omitempty" example:"myQKMStoreID"`                                                                                                                                      // ID of the Quorum Key Manager store containing the account.
-	Attributes          map[string]string `json:"attributes,omitempty"`                                                                                                                                                          // Additional information attached to the account.
-	CreatedAt           time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`                                                                                                                               // Date and time at which the account was created.
-	UpdatedAt           time.Time         `json:"updatedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`                                                                                                                     // Date and time at which the account details were updated.
+	Alias               string                   `json:"alias" example:"personal-account"`                                                                                                                                              // Alias of the account.
+	Address             ethcommon.Address        `json:"address" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`                                                                                             // Address of the account.
+	PublicKey           hexutil.Bytes            `json:"publicKey" example:"0x048e66b3e549818ea2cb354fb70749f6c8de8fa484f7530fc447d5fe80a1c424e4f5ae648d648c980ae7095d1efad87161d83886ca4b6c498ac22a93da5099014a" swaggertype:"string"` // Public key of the account.
+	CompressedPublicKey hexutil.Bytes            `json:"compressedPublicKey" example:"0x048e66b3e549818ea2cb354fb70749f6c8de8fa484f7530fc447" swaggertype:"string"`                                                                     // Compressed public key of the account.
+	TenantID            string                   `json:"tenantID" example:"tenantFoo"`                                                                                                                                                  // ID of the tenant executing the API.
+	OwnerID             string                   `json:"ownerID,omitempty" example:"foo"`                                                                                                                                               // ID of the account owner.
+	StoreID             string                   `json:"storeID,omitempty" example:"myQKMStoreID"`                                                                                                                                      // ID of the Quorum Key Manager store containing the account.
+	Attributes          map[string]string        `json:"attributes,omitempty"`                                                                                                                                                          // Additional information attached to the account.
+	ApprovalPolicy      *entities.ApprovalPolicy `json:"approvalPolicy,omitempty"`                                                                                                                                                      // Approvals required before the transactions sent from the account start.
+	DisabledAt          *time.Time               `json:"disabledAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`                                                                                                                    // Date and time at which the account was disabled.
+	DisabledBy          string                   `json:"disabledBy,omitempty" example:"foo"`                                                                                                                                            // ID of the user who disabled the account.
+	Successor           *ethcommon.Address       `json:"successor,omitempty" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`                                                                                 // Address of the account replacing this account after a rotation.
+	Predecessor         *ethcommon.Address       `json:"predecessor,omitempty" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`                                                                               // Address of the account replaced by this account after a rotation.
+	DrainTxUUID         string                   `json:"drainTxUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                                                                                                          // UUID of the transaction request sending the remaining balance to the successor.
+	CreatedAt           time.Time                `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`                                                                                                                               // Date and time at which the account was created.
+	UpdatedAt           time.Time                `json:"updatedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`                                                                                                                     // Date and time at which the account details were updated.
 }
 
 type accountResponseJSON struct {
-	Alias               string            `json:"alias"`
-	Address             string            `json:"address"`
-	PublicKey           string            `json:"publicKey"`
-	CompressedPublicKey string            `json:"compressedPublicKey"`
-	TenantID            string            `json:"tenantID"`
-	OwnerID             string            `json:"ownerID,omitempty"`
-	StoreID             string            `json:"storeID,omitempty"`
-	Attributes          map[string]string `json:"attributes,omitempty"`
-	CreatedAt           time.Time         `json:"createdAt"`
-	UpdatedAt           time.Time         `json:"updatedAt,omitempty"`
+	Alias               string                   `json:"alias"`
+	Address             string                   `json:"address"`
+	PublicKey           string                   `json:"publicKey"`
+	CompressedPublicKey string                   `json:"compressedPublicKey"`
+	TenantID            string                   `json:"tenantID"`
+	OwnerID             string                   `json:"ownerID,omitempty"`
+	StoreID             string                   `json:"storeID,omitempty"`
+	Attributes          map[string]string        `json:"attributes,omitempty"`
+	ApprovalPolicy      *entities.ApprovalPolicy `json:"approvalPolicy,omitempty"`
+	DisabledAt          *time.Time               `json:"disabledAt,omitempty"`
+	DisabledBy          string                   `json:"disabledBy,omitempty"`
+	Successor           string                   `json:"successor,omitempty"`
+	Predecessor         string                   `json:"predecessor,omitempty"`
+	DrainTxUUID         string                   `json:"drainTxUUID,omitempty"`
+	CreatedAt           time.Time                `json:"createdAt"`
+	UpdatedAt           time.Time                `json:"updatedAt,omitempty"`
 }
 
 func (a *AccountResponse) MarshalJSON() ([]byte, error) {
@@ -44,6 +59,12 @@ func (a *AccountResponse) MarshalJSON() ([]byte, error) {
 		OwnerID:             a.OwnerID,
 		StoreID:             a.StoreID,
 		Attributes:          a.Attributes,
+		ApprovalPolicy:      a.ApprovalPolicy,
+		DisabledAt:          a.DisabledAt,
+		DisabledBy:          a.DisabledBy,
+		Successor:           utils.StringerToString(a.Successor),
+		Predecessor:         utils.StringerToString(a.Predecessor),
+		DrainTxUUID:         a.DrainTxUUID,
 		CreatedAt:           a.CreatedAt,
 		UpdatedAt:           a.UpdatedAt,
 	}
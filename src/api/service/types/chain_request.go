@@ -9,7 +9,9 @@ type RegisterChainRequest struct {
 	URLs             []string                 `json:"urls" pg:"urls,array" validate:"required,min=1,unique,dive,url" example:"https://mainnet.infura.io/v3/a73136601e6f4924a0baa4ed880b535e"` // List of URLs of Ethereum nodes to connect to.
 	Listener         RegisterListenerRequest  `json:"listener,omitempty"`
 	PrivateTxManager *PrivateTxManagerRequest `json:"privateTxManager,omitempty"`
-	Labels           map[string]string        `json:"labels,omitempty"` // List of custom labels. Useful for adding custom information to the chain.
+	Labels           map[string]string        `json:"labels,omitempty"`                         // List of custom labels. Useful for adding custom information to the chain.
+	RPCPolicy        *entities.ChainRPCPolicy `json:"rpcPolicy,omitempty" validate:"omitempty"` // JSON-RPC methods, batch size and tenant quota allowed on the chain proxy. Denies `admin_*`, `debug_*` and `personal_*` unless `deniedMethods` is set.
+	GasOracle        *entities.ChainGasOracle `json:"gasOracle,omitempty" validate:"omitempty"` // Pricing of the transactions crafted for the chain and fee caps. Uses the `default` oracle if empty.
 }
 
 type RegisterListenerRequest struct {
@@ -24,6 +26,8 @@ type UpdateChainRequest struct {
 	Listener         *UpdateListenerRequest   `json:"listener,omitempty"`
 	PrivateTxManager *PrivateTxManagerRequest `json:"privateTxManager,omitempty"`
 	Labels           map[string]string        `json:"labels,omitempty"`
+	RPCPolicy        *entities.ChainRPCPolicy `json:"rpcPolicy,omitempty" validate:"omitempty"`
+	GasOracle        *entities.ChainGasOracle `json:"gasOracle,omitempty" validate:"omitempty"`
 }
 
 type UpdateListenerRequest struct {
@@ -21,6 +21,8 @@ type ChainResponse struct {
 	ListenerExternalTxEnabled bool                       `json:"listenerExternalTxEnabled" example:"false"`                                    // Whether the chain listens for external transactions not crafted by Orchestrate.
 	PrivateTxManager          *entities.PrivateTxManager `json:"privateTxManager,omitempty"`
 	Labels                    map[string]string          `json:"labels,omitempty"`                                // List of custom labels.
+	RPCPolicy                 *entities.ChainRPCPolicy   `json:"rpcPolicy,omitempty"`                             // JSON-RPC methods, batch size and tenant quota allowed on the chain proxy.
+	GasOracle                 *entities.ChainGasOracle   `json:"gasOracle,omitempty"`                             // Pricing of the transactions crafted for the chain and fee caps.
 	CreatedAt                 time.Time                  `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"` // Date and time at which the chain was registered.
 	UpdatedAt                 time.Time                  `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"` // Date and time at which the chain details were updated.
 }
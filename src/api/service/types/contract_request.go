@@ -14,6 +14,10 @@ type RegisterContractRequest struct {
 	Tag              string        `json:"tag,omitempty" example:"v1.0.0"`
 }
 
+type SetContractTagRequest struct {
+	SourceTag string `json:"sourceTag" validate:"required" example:"v1.0.0"`
+}
+
 type ContractResponse struct {
 	Name             string                  `json:"name" example:"ERC20"`
 	Tag              string                  `json:"tag" example:"v1.0.0"`
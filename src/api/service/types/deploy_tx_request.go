@@ -9,9 +9,10 @@ import (
 )
 
 type DeployContractRequest struct {
-	ChainName string               `json:"chain" validate:"required" example:"myChain"`
-	Labels    map[string]string    `json:"labels,omitempty"`
-	Params    DeployContractParams `json:"params" validate:"required"`
+	ChainName string                 `json:"chain" validate:"required" example:"myChain"`
+	Labels    map[string]string      `json:"labels,omitempty"`
+	Params    DeployContractParams   `json:"params" validate:"required"`
+	Schedule  *CreateScheduleRequest `json:"schedule,omitempty"`
 }
 
 type DeployContractParams struct {
@@ -4,48 +4,72 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/consensys/orchestrate/pkg/utils"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type FaucetResponse struct {
-	UUID            string            `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
-	Name            string            `json:"name" validate:"required" example:"faucet-mainnet"`
-	TenantID        string            `json:"tenantID,omitempty" example:"foo"`
-	ChainRule       string            `json:"chainRule,omitempty" example:"mainnet"`
-	CreditorAccount ethcommon.Address `json:"creditorAccount"  example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"string"`
-	MaxBalance      hexutil.Big       `json:"maxBalance,omitempty" validate:"required" example:"0x16345785D8A0000" swaggertype:"string"`
-	Amount          hexutil.Big       `json:"amount,omitempty" validate:"required" example:"0xD529AE9E860000" swaggertype:"string"`
-	Cooldown        string            `json:"cooldown,omitempty" validate:"required,isDuration" example:"10s"`
-	CreatedAt       time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
-	UpdatedAt       time.Time         `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+	UUID              string                    `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	Name              string                    `json:"name" validate:"required" example:"faucet-mainnet"`
+	TenantID          string                    `json:"tenantID,omitempty" example:"foo"`
+	ChainRule         string                    `json:"chainRule,omitempty" example:"mainnet"`
+	CreditorAccount   ethcommon.Address         `json:"creditorAccount"  example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"string"`
+	TokenAddress      *ethcommon.Address        `json:"tokenAddress,omitempty" example:"0x6230592812dE2E256D1512504c3E8A3C49975f07" swaggertype:"string"`
+	MaxBalance        hexutil.Big               `json:"maxBalance,omitempty" validate:"required" example:"0x16345785D8A0000" swaggertype:"string"`
+	Amount            hexutil.Big               `json:"amount,omitempty" validate:"required" example:"0xD529AE9E860000" swaggertype:"string"`
+	Cooldown          string                    `json:"cooldown,omitempty" validate:"required,isDuration" example:"10s"`
+	DailyBudget       *hexutil.Big              `json:"dailyBudget,omitempty" example:"0x8AC7230489E80000" swaggertype:"string"`
+	TenantDailyBudget *hexutil.Big              `json:"tenantDailyBudget,omitempty" example:"0x1BC16D674EC80000" swaggertype:"string"`
+	DailySpent        *hexutil.Big              `json:"dailySpent,omitempty" example:"0x1BC16D674EC80000" swaggertype:"string"`
+	Spendings         []*FaucetSpendingResponse `json:"spendings,omitempty"`
+	CreatedAt         time.Time                 `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt         time.Time                 `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+type FaucetSpendingResponse struct {
+	JobUUID     string            `json:"jobUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	TenantID    string            `json:"tenantID" example:"foo"`
+	Beneficiary ethcommon.Address `json:"beneficiary" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"string"`
+	Amount      hexutil.Big       `json:"amount" example:"0xD529AE9E860000" swaggertype:"string"`
+	CreatedAt   time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
 }
 
 type faucetResponseJSON struct {
-	UUID            string    `json:"uuid"`
-	Name            string    `json:"name"`
-	TenantID        string    `json:"tenantID"`
-	ChainRule       string    `json:"chainRule,omitempty"`
-	CreditorAccount string    `json:"creditorAccount"`
-	MaxBalance      string    `json:"maxBalance,omitempty"`
-	Amount          string    `json:"amount,omitempty"`
-	Cooldown        string    `json:"cooldown,omitempty"`
-	CreatedAt       time.Time `json:"createdAt"`
-	UpdatedAt       time.Time `json:"updatedAt,omitempty"`
+	UUID              string                    `json:"uuid"`
+	Name              string                    `json:"name"`
+	TenantID          string                    `json:"tenantID"`
+	ChainRule         string                    `json:"chainRule,omitempty"`
+	CreditorAccount   string                    `json:"creditorAccount"`
+	TokenAddress      string                    `json:"tokenAddress,omitempty"`
+	MaxBalance        string                    `json:"maxBalance,omitempty"`
+	Amount            string                    `json:"amount,omitempty"`
+	Cooldown          string                    `json:"cooldown,omitempty"`
+	DailyBudget       *hexutil.Big              `json:"dailyBudget,omitempty"`
+	TenantDailyBudget *hexutil.Big              `json:"tenantDailyBudget,omitempty"`
+	DailySpent        *hexutil.Big              `json:"dailySpent,omitempty"`
+	Spendings         []*FaucetSpendingResponse `json:"spendings,omitempty"`
+	CreatedAt         time.Time                 `json:"createdAt"`
+	UpdatedAt         time.Time                 `json:"updatedAt,omitempty"`
 }
 
 func (a *FaucetResponse) MarshalJSON() ([]byte, error) {
 	res := &faucetResponseJSON{
-		UUID:            a.UUID,
-		Name:            a.Name,
-		TenantID:        a.TenantID,
-		ChainRule:       a.ChainRule,
-		CreditorAccount: a.CreditorAccount.String(),
-		MaxBalance:      a.MaxBalance.String(),
-		Amount:          a.Amount.String(),
-		Cooldown:        a.Cooldown,
-		CreatedAt:       a.CreatedAt,
-		UpdatedAt:       a.UpdatedAt,
+		UUID:              a.UUID,
+		Name:              a.Name,
+		TenantID:          a.TenantID,
+		ChainRule:         a.ChainRule,
+		CreditorAccount:   a.CreditorAccount.String(),
+		TokenAddress:      utils.StringerToString(a.TokenAddress),
+		MaxBalance:        a.MaxBalance.String(),
+		Amount:            a.Amount.String(),
+		Cooldown:          a.Cooldown,
+		DailyBudget:       a.DailyBudget,
+		TenantDailyBudget: a.TenantDailyBudget,
+		DailySpent:        a.DailySpent,
+		Spendings:         a.Spendings,
+		CreatedAt:         a.CreatedAt,
+		UpdatedAt:         a.UpdatedAt,
 	}
 
 	return json.Marshal(res)
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+type JobEventResponse struct {
+	JobUUID       string             `json:"jobUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	ParentJobUUID string             `json:"parentJobUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	ScheduleUUID  string             `json:"scheduleUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	ChainUUID     string             `json:"chainUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	TenantID      string             `json:"tenantID" example:"tenantFoo"`
+	OwnerID       string             `json:"ownerID,omitempty" example:"foo"`
+	Labels        map[string]string  `json:"labels,omitempty"`
+	Status        entities.JobStatus `json:"status" example:"MINED"`
+	Message       string             `json:"message,omitempty" example:"transaction mined"`
+	CreatedAt     time.Time          `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+}
@@ -1,22 +1,43 @@
 package types
 
-import "github.com/consensys/orchestrate/src/entities"
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+)
 
 type CreateJobRequest struct {
-	ScheduleUUID  string                  `json:"scheduleUUID" validate:"required,uuid4" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
-	ChainUUID     string                  `json:"chainUUID" validate:"required,uuid4" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
-	NextJobUUID   string                  `json:"nextJobUUID,omitempty" validate:"omitempty,uuid4" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
-	Type          entities.JobType        `json:"type" validate:"required,isJobType" example:"eth://ethereum/transaction"`
-	Labels        map[string]string       `json:"labels,omitempty"`
-	Annotations   Annotations             `json:"annotations,omitempty"`
-	Transaction   entities.ETHTransaction `json:"transaction" validate:"required"`
-	ParentJobUUID string                  `json:"parentJobUUID" validate:"omitempty,uuid4" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	ScheduleUUID  string                    `json:"scheduleUUID" validate:"required,uuid4" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	ChainUUID     string                    `json:"chainUUID" validate:"required,uuid4" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	NextJobUUID   string                    `json:"nextJobUUID,omitempty" validate:"omitempty,uuid4" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	Type          entities.JobType          `json:"type" validate:"required,isJobType" example:"eth://ethereum/transaction"`
+	Labels        map[string]string         `json:"labels,omitempty"`
+	Annotations   Annotations               `json:"annotations,omitempty"`
+	Transaction   entities.ETHTransaction   `json:"transaction" validate:"required"`
+	ParentJobUUID string                    `json:"parentJobUUID" validate:"omitempty,uuid4" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	DependsOn     []*entities.JobDependency `json:"dependsOn,omitempty" validate:"omitempty,dive,required"`
+	Inputs        []*entities.JobInput      `json:"inputs,omitempty" validate:"omitempty,dive,required"`
 }
 
 type UpdateJobRequest struct {
-	Labels      map[string]string        `json:"labels,omitempty"`
-	Annotations *Annotations             `json:"annotations,omitempty"`
-	Transaction *entities.ETHTransaction `json:"transaction,omitempty"`
-	Status      entities.JobStatus       `json:"status,omitempty" validate:"isJobStatus" example:"MINED"`
-	Message     string                   `json:"message,omitempty" example:"Update message"`
+	Labels       map[string]string        `json:"labels,omitempty"`
+	Annotations  *Annotations             `json:"annotations,omitempty"`
+	Transaction  *entities.ETHTransaction `json:"transaction,omitempty"`
+	Status       entities.JobStatus       `json:"status,omitempty" validate:"isJobStatus" example:"MINED"`
+	Message      string                   `json:"message,omitempty" example:"Update message"`
+	DecodedInput *entities.DecodedCall    `json:"decodedInput,omitempty"`
+	Revert       *entities.TxRevert       `json:"revert,omitempty"`
+}
+
+type JobApprovalRequest struct {
+	Reason string `json:"reason,omitempty" example:"Amount checked against invoice"`
+}
+
+type ReplayFailedJobsRequest struct {
+	JobUUIDs      []string                     `json:"jobUUIDs,omitempty" validate:"omitempty,unique,dive,uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	ChainUUID     string                       `json:"chainUUID,omitempty" validate:"omitempty,uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	ErrorCode     string                       `json:"errorCode,omitempty" example:"08000"`
+	ErrorClass    entities.FailedJobErrorClass `json:"errorClass,omitempty" validate:"isFailedJobErrorClass" example:"CONNECTION"`
+	CreatedAfter  time.Time                    `json:"createdAfter,omitempty" example:"2020-07-09T12:35:42.115395Z"`
+	CreatedBefore time.Time                    `json:"createdBefore,omitempty" example:"2020-07-10T12:35:42.115395Z"`
 }
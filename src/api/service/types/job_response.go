@@ -7,19 +7,47 @@ import (
 )
 
 type JobResponse struct {
-	UUID          string                  `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
-	ChainUUID     string                  `json:"chainUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
-	ScheduleUUID  string                  `json:"scheduleUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
-	NextJobUUID   string                  `json:"nextJobUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
-	ParentJobUUID string                  `json:"parentJobUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
-	TenantID      string                  `json:"tenantID" example:"foo"`
-	OwnerID       string                  `json:"ownerID,omitempty" example:"foo"`
-	Transaction   entities.ETHTransaction `json:"transaction"`
-	Logs          []*entities.Log         `json:"logs,omitempty"`
-	Labels        map[string]string       `json:"labels,omitempty"`
-	Annotations   Annotations             `json:"annotations,omitempty"`
-	Status        entities.JobStatus      `json:"status" example:"MINED"`
-	Type          entities.JobType        `json:"type" example:"eth://ethereum/transaction"`
-	CreatedAt     time.Time               `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
-	UpdatedAt     time.Time               `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+	UUID          string                    `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	ChainUUID     string                    `json:"chainUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	ScheduleUUID  string                    `json:"scheduleUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	NextJobUUID   string                    `json:"nextJobUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	ParentJobUUID string                    `json:"parentJobUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	TenantID      string                    `json:"tenantID" example:"foo"`
+	OwnerID       string                    `json:"ownerID,omitempty" example:"foo"`
+	Transaction   entities.ETHTransaction   `json:"transaction"`
+	Logs          []*entities.Log           `json:"logs,omitempty"`
+	Labels        map[string]string         `json:"labels,omitempty"`
+	Annotations   Annotations               `json:"annotations,omitempty"`
+	DependsOn     []*entities.JobDependency `json:"dependsOn,omitempty"`
+	Inputs        []*entities.JobInput      `json:"inputs,omitempty"`
+	DecodedInput  *entities.DecodedCall     `json:"decodedInput,omitempty"`
+	Revert        *entities.TxRevert        `json:"revert,omitempty"`
+	Status        entities.JobStatus        `json:"status" example:"MINED"`
+	Type          entities.JobType          `json:"type" example:"eth://ethereum/transaction"`
+	CreatedAt     time.Time                 `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt     time.Time                 `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+type JobApprovalResponse struct {
+	UUID      string                    `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	JobUUID   string                    `json:"jobUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	Username  string                    `json:"username" example:"alice"`
+	Decision  entities.ApprovalDecision `json:"decision" example:"APPROVED"`
+	Reason    string                    `json:"reason,omitempty" example:"Amount checked against invoice"`
+	CreatedAt time.Time                 `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+type FailedJobResponse struct {
+	UUID         string                       `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	JobUUID      string                       `json:"jobUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	ChainUUID    string                       `json:"chainUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	TenantID     string                       `json:"tenantID" example:"tenant_id"`
+	OwnerID      string                       `json:"ownerID,omitempty" example:"foo"`
+	EnvelopeID   string                       `json:"envelopeID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	ErrorCode    string                       `json:"errorCode,omitempty" example:"08000"`
+	ErrorClass   entities.FailedJobErrorClass `json:"errorClass" example:"CONNECTION"`
+	ErrorMessage string                       `json:"errorMessage,omitempty" example:"failed to connect to chain"`
+	ReplayCount  int                          `json:"replayCount" example:"1"`
+	ReplayedAt   *time.Time                   `json:"replayedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
+	CreatedAt    time.Time                    `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
 }
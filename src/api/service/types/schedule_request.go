@@ -1,3 +1,9 @@
 package types
 
-type CreateScheduleRequest struct{}
+import "time"
+
+type CreateScheduleRequest struct {
+	NotBefore *time.Time `json:"notBefore,omitempty" example:"2022-01-14T09:00:00Z"`
+	NotAfter  *time.Time `json:"notAfter,omitempty" example:"2022-12-31T18:00:00Z"`
+	Cron      string     `json:"cron,omitempty" validate:"omitempty,isCron" example:"0 9 * * 1-5"`
+}
@@ -7,5 +7,10 @@ type ScheduleResponse struct {
 	TenantID  string         `json:"tenantID" example:"tenant_id"`
 	OwnerID   string         `json:"ownerID,omitempty" example:"foo"`
 	Jobs      []*JobResponse `json:"jobs"`
+	NotBefore *time.Time     `json:"notBefore,omitempty" example:"2022-01-14T09:00:00Z"`
+	NotAfter  *time.Time     `json:"notAfter,omitempty" example:"2022-12-31T18:00:00Z"`
+	Cron      string         `json:"cron,omitempty" example:"0 9 * * 1-5"`
+	NextRunAt *time.Time     `json:"nextRunAt,omitempty" example:"2022-01-17T09:00:00Z"`
+	LastRunAt *time.Time     `json:"lastRunAt,omitempty" example:"2022-01-14T09:00:00Z"`
 	CreatedAt time.Time      `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
 }
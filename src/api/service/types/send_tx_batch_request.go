@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
+)
+
+type SendTransactionBatchRequest struct {
+	InOrder      bool                    `json:"inOrder,omitempty" example:"true"`
+	Transactions []*TransactionBatchItem `json:"transactions" validate:"required,min=1,max=500"`
+}
+
+// TransactionBatchItem contains exactly one of a contract transaction, a transfer or a contract deployment
+type TransactionBatchItem struct {
+	IdempotencyKey string                  `json:"idempotencyKey,omitempty" example:"myIdempotencyKey"`
+	Send           *SendTransactionRequest `json:"send,omitempty"`
+	Transfer       *TransferRequest        `json:"transfer,omitempty"`
+	Deploy         *DeployContractRequest  `json:"deploy,omitempty"`
+}
+
+func (req *SendTransactionBatchRequest) Validate() error {
+	var itemErrs []string
+	for idx, item := range req.Transactions {
+		if err := item.Validate(); err != nil {
+			itemErrs = append(itemErrs, fmt.Sprintf("transactions[%d]: %s", idx, errors.FromError(err).GetMessage()))
+		}
+	}
+
+	if len(itemErrs) > 0 {
+		return errors.InvalidFormatError("invalid transactions in batch: %s", strings.Join(itemErrs, "; "))
+	}
+
+	return nil
+}
+
+func (item *TransactionBatchItem) Validate() error {
+	switch {
+	case item == nil:
+		return errors.InvalidFormatError("transaction cannot be empty")
+	case item.Send != nil && item.Transfer == nil && item.Deploy == nil:
+		if err := utils.GetValidator().Struct(item.Send); err != nil {
+			return err
+		}
+		return item.Send.Params.Validate()
+	case item.Transfer != nil && item.Send == nil && item.Deploy == nil:
+		if err := utils.GetValidator().Struct(item.Transfer); err != nil {
+			return err
+		}
+		return item.Transfer.Params.Validate()
+	case item.Deploy != nil && item.Send == nil && item.Transfer == nil:
+		if err := utils.GetValidator().Struct(item.Deploy); err != nil {
+			return err
+		}
+		return item.Deploy.Params.Validate()
+	default:
+		return errors.InvalidFormatError("exactly one of fields 'send', 'transfer' and 'deploy' must be set")
+	}
+}
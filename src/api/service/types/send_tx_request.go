@@ -9,9 +9,10 @@ import (
 )
 
 type SendTransactionRequest struct {
-	ChainName string            `json:"chain" validate:"required" example:"myChain"`
-	Labels    map[string]string `json:"labels,omitempty"`
-	Params    TransactionParams `json:"params" validate:"required"`
+	ChainName string                 `json:"chain" validate:"required" example:"myChain"`
+	Labels    map[string]string      `json:"labels,omitempty"`
+	Params    TransactionParams      `json:"params" validate:"required"`
+	Schedule  *CreateScheduleRequest `json:"schedule,omitempty"`
 }
 
 // go validator does not support mutually exclusive parameters for now
@@ -57,6 +57,17 @@ func FakeDeployContractRequest() *types.DeployContractRequest {
 	}
 }
 
+func FakeSendTransactionBatchRequest() *types.SendTransactionBatchRequest {
+	return &types.SendTransactionBatchRequest{
+		InOrder: true,
+		Transactions: []*types.TransactionBatchItem{
+			{IdempotencyKey: "idempotencyKey", Send: FakeSendTransactionRequest()},
+			{Transfer: FakeSendTransferTransactionRequest()},
+			{Deploy: FakeDeployContractRequest()},
+		},
+	}
+}
+
 func FakeSendTesseraRequest() *types.SendTransactionRequest {
 	return &types.SendTransactionRequest{
 		ChainName: "ganache",
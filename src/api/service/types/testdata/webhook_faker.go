@@ -0,0 +1,24 @@
+package testdata
+
+import (
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/gofrs/uuid"
+)
+
+func FakeCreateWebhookRequest() *api.CreateWebhookRequest {
+	return &api.CreateWebhookRequest{
+		URL:       "https://example.com/notifications",
+		Secret:    "my-webhook-secret",
+		Statuses:  []entities.JobStatus{entities.StatusMined, entities.StatusFailed},
+		ChainUUID: uuid.Must(uuid.NewV4()).String(),
+		Labels:    map[string]string{"app": "payments"},
+	}
+}
+
+func FakeUpdateWebhookRequest() *api.UpdateWebhookRequest {
+	return &api.UpdateWebhookRequest{
+		URL:      "https://example.com/new-notifications",
+		Statuses: []entities.JobStatus{entities.StatusMined},
+	}
+}
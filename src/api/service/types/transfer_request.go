@@ -8,9 +8,10 @@ import (
 )
 
 type TransferRequest struct {
-	ChainName string            `json:"chain" validate:"required" example:"myChain"`
-	Labels    map[string]string `json:"labels,omitempty"`
-	Params    TransferParams    `json:"params" validate:"required"`
+	ChainName string                 `json:"chain" validate:"required" example:"myChain"`
+	Labels    map[string]string      `json:"labels,omitempty"`
+	Params    TransferParams         `json:"params" validate:"required"`
+	Schedule  *CreateScheduleRequest `json:"schedule,omitempty"`
 }
 
 type TransferParams struct {
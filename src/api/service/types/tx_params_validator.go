@@ -2,10 +2,24 @@ package types
 
 import (
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/entities"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
+// ValidateTxParams checks the private and sender parameters of transactions sent without REST request parameters
+func ValidateTxParams(params *entities.ETHTransactionParams, oneTimeKey bool) error {
+	if err := utils.GetValidator().Struct(params); err != nil {
+		return err
+	}
+
+	if params.Protocol != "" || params.PrivateFrom != "" {
+		return validatePrivateTxParams(params.Protocol, params.PrivateFrom, params.PrivacyGroupID, params.PrivateFor)
+	}
+
+	return validateTxFromParams(params.From, oneTimeKey)
+}
+
 func validatePrivateTxParams(protocol entities.PrivateTxManagerType, privateFrom, privacyGroupID string, privateFor []string) error {
 	if protocol == "" {
 		return errors.InvalidParameterError("field 'protocol' cannot be empty")
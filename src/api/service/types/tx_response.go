@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type TransactionResponse struct {
@@ -14,3 +15,22 @@ type TransactionResponse struct {
 	Jobs           []*JobResponse                 `json:"jobs"`
 	CreatedAt      time.Time                      `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
 }
+
+type SimulateTransactionResponse struct {
+	Transaction   *entities.ETHTransaction `json:"transaction"`
+	Fee           *hexutil.Big             `json:"fee,omitempty" example:"0x1c9c380" swaggertype:"string"`
+	Output        hexutil.Bytes            `json:"output,omitempty" example:"0x0000000000000000000000000000000000000000000000000000000000000001" swaggertype:"string"`
+	DecodedOutput map[string]string        `json:"decodedOutput,omitempty"`
+	Reverted      bool                     `json:"reverted" example:"false"`
+	Revert        *entities.TxRevert       `json:"revert,omitempty"`
+	Logs          []*SimulatedLogResponse  `json:"logs,omitempty"`
+	Warning       string                   `json:"warning,omitempty" example:"logs are not available, the chain does not support debug_traceCall"`
+}
+
+type SimulatedLogResponse struct {
+	Address     string            `json:"address" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534"`
+	Topics      []string          `json:"topics" example:"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"`
+	Data        string            `json:"data,omitempty" example:"0x00000000000000000000000000000000000000000000000000000000000001f4"`
+	Event       string            `json:"event,omitempty" example:"Transfer(address,address,uint256)"`
+	DecodedData map[string]string `json:"decodedData,omitempty"`
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+type CreateWebhookRequest struct {
+	URL       string               `json:"url" validate:"required,url,isPublicURL" example:"https://example.com/notifications"`
+	Secret    string               `json:"secret" validate:"required" example:"my-webhook-secret"`
+	Statuses  []entities.JobStatus `json:"statuses,omitempty" validate:"omitempty,dive,isJobStatus" example:"MINED,FAILED"`
+	ChainUUID string               `json:"chainUUID,omitempty" validate:"omitempty,uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	Labels    map[string]string    `json:"labels,omitempty"`
+}
+
+type UpdateWebhookRequest struct {
+	URL       string               `json:"url,omitempty" validate:"omitempty,url,isPublicURL" example:"https://example.com/notifications"`
+	Secret    string               `json:"secret,omitempty" validate:"omitempty" example:"my-webhook-secret"`
+	Statuses  []entities.JobStatus `json:"statuses,omitempty" validate:"omitempty,dive,isJobStatus" example:"MINED,FAILED"`
+	ChainUUID string               `json:"chainUUID,omitempty" validate:"omitempty,uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	Labels    map[string]string    `json:"labels,omitempty"`
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+type WebhookResponse struct {
+	UUID      string               `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	TenantID  string               `json:"tenantID" example:"foo"`
+	URL       string               `json:"url" example:"https://example.com/notifications"`
+	Statuses  []entities.JobStatus `json:"statuses,omitempty" example:"MINED,FAILED"`
+	ChainUUID string               `json:"chainUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	Labels    map[string]string    `json:"labels,omitempty"`
+	CreatedAt time.Time            `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt time.Time            `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+type WebhookDeliveryResponse struct {
+	UUID           string                        `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	WebhookUUID    string                        `json:"webhookUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	JobUUID        string                        `json:"jobUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	JobStatus      entities.JobStatus            `json:"jobStatus" example:"MINED"`
+	State          entities.WebhookDeliveryState `json:"state" example:"DELIVERED"`
+	Attempts       int                           `json:"attempts" example:"1"`
+	ResponseStatus int                           `json:"responseStatus,omitempty" example:"200"`
+	Error          string                        `json:"error,omitempty" example:"webhook endpoint responded with status 502"`
+	CreatedAt      time.Time                     `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt      time.Time                     `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
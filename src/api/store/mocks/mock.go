@@ -12,6 +12,8 @@ import (
 	models "github.com/consensys/orchestrate/src/api/store/models"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
+	big "math/big"
 )
 
 // MockStore is a mock of Store interface
@@ -173,6 +175,20 @@ func (mr *MockAgentsMockRecorder) Faucet() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Faucet", reflect.TypeOf((*MockAgents)(nil).Faucet))
 }
 
+// FaucetSpending mocks base method
+func (m *MockAgents) FaucetSpending() store.FaucetSpendingAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FaucetSpending")
+	ret0, _ := ret[0].(store.FaucetSpendingAgent)
+	return ret0
+}
+
+// FaucetSpending indicates an expected call of FaucetSpending
+func (mr *MockAgentsMockRecorder) FaucetSpending() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FaucetSpending", reflect.TypeOf((*MockAgents)(nil).FaucetSpending))
+}
+
 // Artifact mocks base method
 func (m *MockAgents) Artifact() store.ArtifactAgent {
 	m.ctrl.T.Helper()
@@ -285,6 +301,76 @@ func (mr *MockAgentsMockRecorder) PrivateTxManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivateTxManager", reflect.TypeOf((*MockAgents)(nil).PrivateTxManager))
 }
 
+// Webhook mocks base method
+func (m *MockAgents) Webhook() store.WebhookAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Webhook")
+	ret0, _ := ret[0].(store.WebhookAgent)
+	return ret0
+}
+
+// Webhook indicates an expected call of Webhook
+func (mr *MockAgentsMockRecorder) Webhook() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Webhook", reflect.TypeOf((*MockAgents)(nil).Webhook))
+}
+
+// WebhookDelivery mocks base method
+func (m *MockAgents) WebhookDelivery() store.WebhookDeliveryAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookDelivery")
+	ret0, _ := ret[0].(store.WebhookDeliveryAgent)
+	return ret0
+}
+
+// WebhookDelivery indicates an expected call of WebhookDelivery
+func (mr *MockAgentsMockRecorder) WebhookDelivery() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookDelivery", reflect.TypeOf((*MockAgents)(nil).WebhookDelivery))
+}
+
+// JobApproval mocks base method
+func (m *MockAgents) JobApproval() store.JobApprovalAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobApproval")
+	ret0, _ := ret[0].(store.JobApprovalAgent)
+	return ret0
+}
+
+// JobApproval indicates an expected call of JobApproval
+func (mr *MockAgentsMockRecorder) JobApproval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobApproval", reflect.TypeOf((*MockAgents)(nil).JobApproval))
+}
+
+// FailedJob mocks base method
+func (m *MockAgents) FailedJob() store.FailedJobAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailedJob")
+	ret0, _ := ret[0].(store.FailedJobAgent)
+	return ret0
+}
+
+// FailedJob indicates an expected call of FailedJob
+func (mr *MockAgentsMockRecorder) FailedJob() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailedJob", reflect.TypeOf((*MockAgents)(nil).FailedJob))
+}
+
+// JobEvent mocks base method
+func (m *MockAgents) JobEvent() store.JobEventAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobEvent")
+	ret0, _ := ret[0].(store.JobEventAgent)
+	return ret0
+}
+
+// JobEvent indicates an expected call of JobEvent
+func (mr *MockAgentsMockRecorder) JobEvent() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobEvent", reflect.TypeOf((*MockAgents)(nil).JobEvent))
+}
+
 // MockDB is a mock of DB interface
 type MockDB struct {
 	ctrl     *gomock.Controller
@@ -351,6 +437,34 @@ func (mr *MockDBMockRecorder) Job() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockDB)(nil).Job))
 }
 
+// JobApproval mocks base method
+func (m *MockDB) JobApproval() store.JobApprovalAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobApproval")
+	ret0, _ := ret[0].(store.JobApprovalAgent)
+	return ret0
+}
+
+// JobApproval indicates an expected call of JobApproval
+func (mr *MockDBMockRecorder) JobApproval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobApproval", reflect.TypeOf((*MockDB)(nil).JobApproval))
+}
+
+// JobEvent mocks base method
+func (m *MockDB) JobEvent() store.JobEventAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobEvent")
+	ret0, _ := ret[0].(store.JobEventAgent)
+	return ret0
+}
+
+// JobEvent indicates an expected call of JobEvent
+func (mr *MockDBMockRecorder) JobEvent() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobEvent", reflect.TypeOf((*MockDB)(nil).JobEvent))
+}
+
 // Log mocks base method
 func (m *MockDB) Log() store.LogAgent {
 	m.ctrl.T.Helper()
@@ -393,6 +507,34 @@ func (mr *MockDBMockRecorder) TransactionRequest() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionRequest", reflect.TypeOf((*MockDB)(nil).TransactionRequest))
 }
 
+// Webhook mocks base method
+func (m *MockDB) Webhook() store.WebhookAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Webhook")
+	ret0, _ := ret[0].(store.WebhookAgent)
+	return ret0
+}
+
+// Webhook indicates an expected call of Webhook
+func (mr *MockDBMockRecorder) Webhook() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Webhook", reflect.TypeOf((*MockDB)(nil).Webhook))
+}
+
+// WebhookDelivery mocks base method
+func (m *MockDB) WebhookDelivery() store.WebhookDeliveryAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookDelivery")
+	ret0, _ := ret[0].(store.WebhookDeliveryAgent)
+	return ret0
+}
+
+// WebhookDelivery indicates an expected call of WebhookDelivery
+func (mr *MockDBMockRecorder) WebhookDelivery() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookDelivery", reflect.TypeOf((*MockDB)(nil).WebhookDelivery))
+}
+
 // Account mocks base method
 func (m *MockDB) Account() store.AccountAgent {
 	m.ctrl.T.Helper()
@@ -421,6 +563,20 @@ func (mr *MockDBMockRecorder) Faucet() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Faucet", reflect.TypeOf((*MockDB)(nil).Faucet))
 }
 
+// FaucetSpending mocks base method
+func (m *MockDB) FaucetSpending() store.FaucetSpendingAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FaucetSpending")
+	ret0, _ := ret[0].(store.FaucetSpendingAgent)
+	return ret0
+}
+
+// FaucetSpending indicates an expected call of FaucetSpending
+func (mr *MockDBMockRecorder) FaucetSpending() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FaucetSpending", reflect.TypeOf((*MockDB)(nil).FaucetSpending))
+}
+
 // Artifact mocks base method
 func (m *MockDB) Artifact() store.ArtifactAgent {
 	m.ctrl.T.Helper()
@@ -463,6 +619,20 @@ func (mr *MockDBMockRecorder) Event() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Event", reflect.TypeOf((*MockDB)(nil).Event))
 }
 
+// FailedJob mocks base method
+func (m *MockDB) FailedJob() store.FailedJobAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailedJob")
+	ret0, _ := ret[0].(store.FailedJobAgent)
+	return ret0
+}
+
+// FailedJob indicates an expected call of FailedJob
+func (mr *MockDBMockRecorder) FailedJob() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailedJob", reflect.TypeOf((*MockDB)(nil).FailedJob))
+}
+
 // Repository mocks base method
 func (m *MockDB) Repository() store.RepositoryAgent {
 	m.ctrl.T.Helper()
@@ -641,6 +811,34 @@ func (mr *MockTxMockRecorder) Job() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockTx)(nil).Job))
 }
 
+// JobApproval mocks base method
+func (m *MockTx) JobApproval() store.JobApprovalAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobApproval")
+	ret0, _ := ret[0].(store.JobApprovalAgent)
+	return ret0
+}
+
+// JobApproval indicates an expected call of JobApproval
+func (mr *MockTxMockRecorder) JobApproval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobApproval", reflect.TypeOf((*MockTx)(nil).JobApproval))
+}
+
+// JobEvent mocks base method
+func (m *MockTx) JobEvent() store.JobEventAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobEvent")
+	ret0, _ := ret[0].(store.JobEventAgent)
+	return ret0
+}
+
+// JobEvent indicates an expected call of JobEvent
+func (mr *MockTxMockRecorder) JobEvent() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobEvent", reflect.TypeOf((*MockTx)(nil).JobEvent))
+}
+
 // Log mocks base method
 func (m *MockTx) Log() store.LogAgent {
 	m.ctrl.T.Helper()
@@ -683,6 +881,34 @@ func (mr *MockTxMockRecorder) TransactionRequest() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionRequest", reflect.TypeOf((*MockTx)(nil).TransactionRequest))
 }
 
+// Webhook mocks base method
+func (m *MockTx) Webhook() store.WebhookAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Webhook")
+	ret0, _ := ret[0].(store.WebhookAgent)
+	return ret0
+}
+
+// Webhook indicates an expected call of Webhook
+func (mr *MockTxMockRecorder) Webhook() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Webhook", reflect.TypeOf((*MockTx)(nil).Webhook))
+}
+
+// WebhookDelivery mocks base method
+func (m *MockTx) WebhookDelivery() store.WebhookDeliveryAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WebhookDelivery")
+	ret0, _ := ret[0].(store.WebhookDeliveryAgent)
+	return ret0
+}
+
+// WebhookDelivery indicates an expected call of WebhookDelivery
+func (mr *MockTxMockRecorder) WebhookDelivery() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WebhookDelivery", reflect.TypeOf((*MockTx)(nil).WebhookDelivery))
+}
+
 // Account mocks base method
 func (m *MockTx) Account() store.AccountAgent {
 	m.ctrl.T.Helper()
@@ -711,6 +937,20 @@ func (mr *MockTxMockRecorder) Faucet() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Faucet", reflect.TypeOf((*MockTx)(nil).Faucet))
 }
 
+// FaucetSpending mocks base method
+func (m *MockTx) FaucetSpending() store.FaucetSpendingAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FaucetSpending")
+	ret0, _ := ret[0].(store.FaucetSpendingAgent)
+	return ret0
+}
+
+// FaucetSpending indicates an expected call of FaucetSpending
+func (mr *MockTxMockRecorder) FaucetSpending() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FaucetSpending", reflect.TypeOf((*MockTx)(nil).FaucetSpending))
+}
+
 // Artifact mocks base method
 func (m *MockTx) Artifact() store.ArtifactAgent {
 	m.ctrl.T.Helper()
@@ -753,6 +993,20 @@ func (mr *MockTxMockRecorder) Event() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Event", reflect.TypeOf((*MockTx)(nil).Event))
 }
 
+// FailedJob mocks base method
+func (m *MockTx) FailedJob() store.FailedJobAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailedJob")
+	ret0, _ := ret[0].(store.FailedJobAgent)
+	return ret0
+}
+
+// FailedJob indicates an expected call of FailedJob
+func (mr *MockTxMockRecorder) FailedJob() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailedJob", reflect.TypeOf((*MockTx)(nil).FailedJob))
+}
+
 // Repository mocks base method
 func (m *MockTx) Repository() store.RepositoryAgent {
 	m.ctrl.T.Helper()
@@ -957,19 +1211,48 @@ func (mr *MockScheduleAgentMockRecorder) FindOneByUUID(ctx, uuid, tenants, owner
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByUUID", reflect.TypeOf((*MockScheduleAgent)(nil).FindOneByUUID), ctx, uuid, tenants, ownerID)
 }
 
-// FindAll mocks base method
-func (m *MockScheduleAgent) FindAll(ctx context.Context, tenants []string, ownerID string) ([]*models.Schedule, error) {
+// Search mocks base method
+func (m *MockScheduleAgent) Search(ctx context.Context, filters *entities.ScheduleFilters, tenants []string, ownerID string) ([]*models.Schedule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx, tenants, ownerID)
+	ret := m.ctrl.Call(m, "Search", ctx, filters, tenants, ownerID)
 	ret0, _ := ret[0].([]*models.Schedule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindAll indicates an expected call of FindAll
-func (mr *MockScheduleAgentMockRecorder) FindAll(ctx, tenants, ownerID interface{}) *gomock.Call {
+// Search indicates an expected call of Search
+func (mr *MockScheduleAgentMockRecorder) Search(ctx, filters, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockScheduleAgent)(nil).Search), ctx, filters, tenants, ownerID)
+}
+
+// Update mocks base method
+func (m *MockScheduleAgent) Update(ctx context.Context, schedule *models.Schedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockScheduleAgentMockRecorder) Update(ctx, schedule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockScheduleAgent)(nil).Update), ctx, schedule)
+}
+
+// LockDue mocks base method
+func (m *MockScheduleAgent) LockDue(ctx context.Context, now time.Time, limit int) ([]*models.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockDue", ctx, now, limit)
+	ret0, _ := ret[0].([]*models.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockDue indicates an expected call of LockDue
+func (mr *MockScheduleAgentMockRecorder) LockDue(ctx, now, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockScheduleAgent)(nil).FindAll), ctx, tenants, ownerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockDue", reflect.TypeOf((*MockScheduleAgent)(nil).LockDue), ctx, now, limit)
 }
 
 // MockJobAgent is a mock of JobAgent interface
@@ -1067,6 +1350,124 @@ func (mr *MockJobAgentMockRecorder) Search(ctx, filters, tenants, ownerID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockJobAgent)(nil).Search), ctx, filters, tenants, ownerID)
 }
 
+// MockJobApprovalAgent is a mock of JobApprovalAgent interface
+type MockJobApprovalAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobApprovalAgentMockRecorder
+}
+
+// MockJobApprovalAgentMockRecorder is the mock recorder for MockJobApprovalAgent
+type MockJobApprovalAgentMockRecorder struct {
+	mock *MockJobApprovalAgent
+}
+
+// NewMockJobApprovalAgent creates a new mock instance
+func NewMockJobApprovalAgent(ctrl *gomock.Controller) *MockJobApprovalAgent {
+	mock := &MockJobApprovalAgent{ctrl: ctrl}
+	mock.recorder = &MockJobApprovalAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockJobApprovalAgent) EXPECT() *MockJobApprovalAgentMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockJobApprovalAgent) Insert(ctx context.Context, approval *models.JobApproval) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, approval)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockJobApprovalAgentMockRecorder) Insert(ctx, approval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockJobApprovalAgent)(nil).Insert), ctx, approval)
+}
+
+// FindAllByJobUUID mocks base method
+func (m *MockJobApprovalAgent) FindAllByJobUUID(ctx context.Context, jobUUID string) ([]*models.JobApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByJobUUID", ctx, jobUUID)
+	ret0, _ := ret[0].([]*models.JobApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByJobUUID indicates an expected call of FindAllByJobUUID
+func (mr *MockJobApprovalAgentMockRecorder) FindAllByJobUUID(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByJobUUID", reflect.TypeOf((*MockJobApprovalAgent)(nil).FindAllByJobUUID), ctx, jobUUID)
+}
+
+// MockFailedJobAgent is a mock of FailedJobAgent interface
+type MockFailedJobAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockFailedJobAgentMockRecorder
+}
+
+// MockFailedJobAgentMockRecorder is the mock recorder for MockFailedJobAgent
+type MockFailedJobAgentMockRecorder struct {
+	mock *MockFailedJobAgent
+}
+
+// NewMockFailedJobAgent creates a new mock instance
+func NewMockFailedJobAgent(ctrl *gomock.Controller) *MockFailedJobAgent {
+	mock := &MockFailedJobAgent{ctrl: ctrl}
+	mock.recorder = &MockFailedJobAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFailedJobAgent) EXPECT() *MockFailedJobAgentMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockFailedJobAgent) Insert(ctx context.Context, failedJob *models.FailedJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, failedJob)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockFailedJobAgentMockRecorder) Insert(ctx, failedJob interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockFailedJobAgent)(nil).Insert), ctx, failedJob)
+}
+
+// Update mocks base method
+func (m *MockFailedJobAgent) Update(ctx context.Context, failedJob *models.FailedJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, failedJob)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockFailedJobAgentMockRecorder) Update(ctx, failedJob interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFailedJobAgent)(nil).Update), ctx, failedJob)
+}
+
+// Search mocks base method
+func (m *MockFailedJobAgent) Search(ctx context.Context, filters *entities.FailedJobFilters, tenants []string, ownerID string) ([]*models.FailedJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filters, tenants, ownerID)
+	ret0, _ := ret[0].([]*models.FailedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockFailedJobAgentMockRecorder) Search(ctx, filters, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockFailedJobAgent)(nil).Search), ctx, filters, tenants, ownerID)
+}
+
 // MockLogAgent is a mock of LogAgent interface
 type MockLogAgent struct {
 	ctrl     *gomock.Controller
@@ -1236,6 +1637,20 @@ func (mr *MockAccountAgentMockRecorder) Search(ctx, filters, tenants, ownerID in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockAccountAgent)(nil).Search), ctx, filters, tenants, ownerID)
 }
 
+// LockOneByAddress mocks base method
+func (m *MockAccountAgent) LockOneByAddress(ctx context.Context, address string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockOneByAddress", ctx, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockOneByAddress indicates an expected call of LockOneByAddress
+func (mr *MockAccountAgentMockRecorder) LockOneByAddress(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockOneByAddress", reflect.TypeOf((*MockAccountAgent)(nil).LockOneByAddress), ctx, address)
+}
+
 // MockFaucetAgent is a mock of FaucetAgent interface
 type MockFaucetAgent struct {
 	ctrl     *gomock.Controller
@@ -1331,6 +1746,116 @@ func (mr *MockFaucetAgentMockRecorder) Delete(ctx, faucet, tenants interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFaucetAgent)(nil).Delete), ctx, faucet, tenants)
 }
 
+// LockOneByUUID mocks base method
+func (m *MockFaucetAgent) LockOneByUUID(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockOneByUUID", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockOneByUUID indicates an expected call of LockOneByUUID
+func (mr *MockFaucetAgentMockRecorder) LockOneByUUID(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockOneByUUID", reflect.TypeOf((*MockFaucetAgent)(nil).LockOneByUUID), ctx, uuid)
+}
+
+// MockFaucetSpendingAgent is a mock of FaucetSpendingAgent interface
+type MockFaucetSpendingAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockFaucetSpendingAgentMockRecorder
+}
+
+// MockFaucetSpendingAgentMockRecorder is the mock recorder for MockFaucetSpendingAgent
+type MockFaucetSpendingAgentMockRecorder struct {
+	mock *MockFaucetSpendingAgent
+}
+
+// NewMockFaucetSpendingAgent creates a new mock instance
+func NewMockFaucetSpendingAgent(ctrl *gomock.Controller) *MockFaucetSpendingAgent {
+	mock := &MockFaucetSpendingAgent{ctrl: ctrl}
+	mock.recorder = &MockFaucetSpendingAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFaucetSpendingAgent) EXPECT() *MockFaucetSpendingAgentMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockFaucetSpendingAgent) Insert(ctx context.Context, spending *models.FaucetSpending) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, spending)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockFaucetSpendingAgentMockRecorder) Insert(ctx, spending interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockFaucetSpendingAgent)(nil).Insert), ctx, spending)
+}
+
+// FindLastByBeneficiary mocks base method
+func (m *MockFaucetSpendingAgent) FindLastByBeneficiary(ctx context.Context, faucetUUID, beneficiary string) (*models.FaucetSpending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastByBeneficiary", ctx, faucetUUID, beneficiary)
+	ret0, _ := ret[0].(*models.FaucetSpending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastByBeneficiary indicates an expected call of FindLastByBeneficiary
+func (mr *MockFaucetSpendingAgentMockRecorder) FindLastByBeneficiary(ctx, faucetUUID, beneficiary interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastByBeneficiary", reflect.TypeOf((*MockFaucetSpendingAgent)(nil).FindLastByBeneficiary), ctx, faucetUUID, beneficiary)
+}
+
+// SumSince mocks base method
+func (m *MockFaucetSpendingAgent) SumSince(ctx context.Context, faucetUUID, tenantID string, since time.Time) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumSince", ctx, faucetUUID, tenantID, since)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumSince indicates an expected call of SumSince
+func (mr *MockFaucetSpendingAgentMockRecorder) SumSince(ctx, faucetUUID, tenantID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumSince", reflect.TypeOf((*MockFaucetSpendingAgent)(nil).SumSince), ctx, faucetUUID, tenantID, since)
+}
+
+// Search mocks base method
+func (m *MockFaucetSpendingAgent) Search(ctx context.Context, faucetUUID string, limit int) ([]*models.FaucetSpending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, faucetUUID, limit)
+	ret0, _ := ret[0].([]*models.FaucetSpending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockFaucetSpendingAgentMockRecorder) Search(ctx, faucetUUID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockFaucetSpendingAgent)(nil).Search), ctx, faucetUUID, limit)
+}
+
+// DeleteByJobUUID mocks base method
+func (m *MockFaucetSpendingAgent) DeleteByJobUUID(ctx context.Context, jobUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByJobUUID", ctx, jobUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByJobUUID indicates an expected call of DeleteByJobUUID
+func (mr *MockFaucetSpendingAgentMockRecorder) DeleteByJobUUID(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByJobUUID", reflect.TypeOf((*MockFaucetSpendingAgent)(nil).DeleteByJobUUID), ctx, jobUUID)
+}
+
 // MockChainAgent is a mock of ChainAgent interface
 type MockChainAgent struct {
 	ctrl     *gomock.Controller
@@ -1441,6 +1966,182 @@ func (mr *MockChainAgentMockRecorder) Delete(ctx, chain, tenants interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChainAgent)(nil).Delete), ctx, chain, tenants)
 }
 
+// MockWebhookAgent is a mock of WebhookAgent interface
+type MockWebhookAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookAgentMockRecorder
+}
+
+// MockWebhookAgentMockRecorder is the mock recorder for MockWebhookAgent
+type MockWebhookAgentMockRecorder struct {
+	mock *MockWebhookAgent
+}
+
+// NewMockWebhookAgent creates a new mock instance
+func NewMockWebhookAgent(ctrl *gomock.Controller) *MockWebhookAgent {
+	mock := &MockWebhookAgent{ctrl: ctrl}
+	mock.recorder = &MockWebhookAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookAgent) EXPECT() *MockWebhookAgentMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockWebhookAgent) Insert(ctx context.Context, webhook *models.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, webhook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockWebhookAgentMockRecorder) Insert(ctx, webhook interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockWebhookAgent)(nil).Insert), ctx, webhook)
+}
+
+// Update mocks base method
+func (m *MockWebhookAgent) Update(ctx context.Context, webhook *models.Webhook, tenants []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, webhook, tenants)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockWebhookAgentMockRecorder) Update(ctx, webhook, tenants interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookAgent)(nil).Update), ctx, webhook, tenants)
+}
+
+// FindOneByUUID mocks base method
+func (m *MockWebhookAgent) FindOneByUUID(ctx context.Context, uuid string, tenants []string) (*models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByUUID", ctx, uuid, tenants)
+	ret0, _ := ret[0].(*models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByUUID indicates an expected call of FindOneByUUID
+func (mr *MockWebhookAgentMockRecorder) FindOneByUUID(ctx, uuid, tenants interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByUUID", reflect.TypeOf((*MockWebhookAgent)(nil).FindOneByUUID), ctx, uuid, tenants)
+}
+
+// Search mocks base method
+func (m *MockWebhookAgent) Search(ctx context.Context, filters *entities.WebhookFilters, tenants []string) ([]*models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filters, tenants)
+	ret0, _ := ret[0].([]*models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockWebhookAgentMockRecorder) Search(ctx, filters, tenants interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockWebhookAgent)(nil).Search), ctx, filters, tenants)
+}
+
+// Delete mocks base method
+func (m *MockWebhookAgent) Delete(ctx context.Context, webhook *models.Webhook, tenants []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, webhook, tenants)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockWebhookAgentMockRecorder) Delete(ctx, webhook, tenants interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookAgent)(nil).Delete), ctx, webhook, tenants)
+}
+
+// MockWebhookDeliveryAgent is a mock of WebhookDeliveryAgent interface
+type MockWebhookDeliveryAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryAgentMockRecorder
+}
+
+// MockWebhookDeliveryAgentMockRecorder is the mock recorder for MockWebhookDeliveryAgent
+type MockWebhookDeliveryAgentMockRecorder struct {
+	mock *MockWebhookDeliveryAgent
+}
+
+// NewMockWebhookDeliveryAgent creates a new mock instance
+func NewMockWebhookDeliveryAgent(ctrl *gomock.Controller) *MockWebhookDeliveryAgent {
+	mock := &MockWebhookDeliveryAgent{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookDeliveryAgent) EXPECT() *MockWebhookDeliveryAgentMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockWebhookDeliveryAgent) Insert(ctx context.Context, delivery *models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockWebhookDeliveryAgentMockRecorder) Insert(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockWebhookDeliveryAgent)(nil).Insert), ctx, delivery)
+}
+
+// Update mocks base method
+func (m *MockWebhookDeliveryAgent) Update(ctx context.Context, delivery *models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockWebhookDeliveryAgentMockRecorder) Update(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookDeliveryAgent)(nil).Update), ctx, delivery)
+}
+
+// Search mocks base method
+func (m *MockWebhookDeliveryAgent) Search(ctx context.Context, webhookUUID string, filters *entities.WebhookDeliveryFilters) ([]*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, webhookUUID, filters)
+	ret0, _ := ret[0].([]*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockWebhookDeliveryAgentMockRecorder) Search(ctx, webhookUUID, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockWebhookDeliveryAgent)(nil).Search), ctx, webhookUUID, filters)
+}
+
+// LockPending mocks base method
+func (m *MockWebhookDeliveryAgent) LockPending(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPending", ctx, now, limit)
+	ret0, _ := ret[0].([]*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockPending indicates an expected call of LockPending
+func (mr *MockWebhookDeliveryAgentMockRecorder) LockPending(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPending", reflect.TypeOf((*MockWebhookDeliveryAgent)(nil).LockPending), ctx, now, limit)
+}
+
 // MockPrivateTxManagerAgent is a mock of PrivateTxManagerAgent interface
 type MockPrivateTxManagerAgent struct {
 	ctrl     *gomock.Controller
@@ -1588,18 +2289,33 @@ func (mr *MockArtifactAgentMockRecorder) Insert(ctx, artifact interface{}) *gomo
 }
 
 // FindOneByNameAndTag mocks base method
-func (m *MockArtifactAgent) FindOneByNameAndTag(ctx context.Context, name, tag string) (*models.ArtifactModel, error) {
+func (m *MockArtifactAgent) FindOneByNameAndTag(ctx context.Context, name, tag string, tenants []string, ownerID string) (*models.ArtifactModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneByNameAndTag", ctx, name, tag)
+	ret := m.ctrl.Call(m, "FindOneByNameAndTag", ctx, name, tag, tenants, ownerID)
 	ret0, _ := ret[0].(*models.ArtifactModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOneByNameAndTag indicates an expected call of FindOneByNameAndTag
-func (mr *MockArtifactAgentMockRecorder) FindOneByNameAndTag(ctx, name, tag interface{}) *gomock.Call {
+func (mr *MockArtifactAgentMockRecorder) FindOneByNameAndTag(ctx, name, tag, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByNameAndTag", reflect.TypeOf((*MockArtifactAgent)(nil).FindOneByNameAndTag), ctx, name, tag, tenants, ownerID)
+}
+
+// DeleteUnused mocks base method
+func (m *MockArtifactAgent) DeleteUnused(ctx context.Context, ids []int) ([]*models.ArtifactModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnused", ctx, ids)
+	ret0, _ := ret[0].([]*models.ArtifactModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUnused indicates an expected call of DeleteUnused
+func (mr *MockArtifactAgentMockRecorder) DeleteUnused(ctx, ids interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByNameAndTag", reflect.TypeOf((*MockArtifactAgent)(nil).FindOneByNameAndTag), ctx, name, tag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnused", reflect.TypeOf((*MockArtifactAgent)(nil).DeleteUnused), ctx, ids)
 }
 
 // MockContractAgent is a mock of ContractAgent interface
@@ -1626,33 +2342,33 @@ func (m *MockContractAgent) EXPECT() *MockContractAgentMockRecorder {
 }
 
 // FindOneByCodeHash mocks base method
-func (m *MockContractAgent) FindOneByCodeHash(ctx context.Context, codeHash string) (*entities.Contract, error) {
+func (m *MockContractAgent) FindOneByCodeHash(ctx context.Context, codeHash string, tenants []string, ownerID string) (*entities.Contract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneByCodeHash", ctx, codeHash)
+	ret := m.ctrl.Call(m, "FindOneByCodeHash", ctx, codeHash, tenants, ownerID)
 	ret0, _ := ret[0].(*entities.Contract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOneByCodeHash indicates an expected call of FindOneByCodeHash
-func (mr *MockContractAgentMockRecorder) FindOneByCodeHash(ctx, codeHash interface{}) *gomock.Call {
+func (mr *MockContractAgentMockRecorder) FindOneByCodeHash(ctx, codeHash, tenants, ownerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByCodeHash", reflect.TypeOf((*MockContractAgent)(nil).FindOneByCodeHash), ctx, codeHash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByCodeHash", reflect.TypeOf((*MockContractAgent)(nil).FindOneByCodeHash), ctx, codeHash, tenants, ownerID)
 }
 
 // FindOneByAddress mocks base method
-func (m *MockContractAgent) FindOneByAddress(ctx context.Context, address string) (*entities.Contract, error) {
+func (m *MockContractAgent) FindOneByAddress(ctx context.Context, address string, tenants []string, ownerID string) (*entities.Contract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneByAddress", ctx, address)
+	ret := m.ctrl.Call(m, "FindOneByAddress", ctx, address, tenants, ownerID)
 	ret0, _ := ret[0].(*entities.Contract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOneByAddress indicates an expected call of FindOneByAddress
-func (mr *MockContractAgentMockRecorder) FindOneByAddress(ctx, address interface{}) *gomock.Call {
+func (mr *MockContractAgentMockRecorder) FindOneByAddress(ctx, address, tenants, ownerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByAddress", reflect.TypeOf((*MockContractAgent)(nil).FindOneByAddress), ctx, address)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByAddress", reflect.TypeOf((*MockContractAgent)(nil).FindOneByAddress), ctx, address, tenants, ownerID)
 }
 
 // MockCodeHashAgent is a mock of CodeHashAgent interface
@@ -1692,6 +2408,20 @@ func (mr *MockCodeHashAgentMockRecorder) Insert(ctx, codehash interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCodeHashAgent)(nil).Insert), ctx, codehash)
 }
 
+// DeleteUnused mocks base method
+func (m *MockCodeHashAgent) DeleteUnused(ctx context.Context, codeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnused", ctx, codeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUnused indicates an expected call of DeleteUnused
+func (mr *MockCodeHashAgentMockRecorder) DeleteUnused(ctx, codeHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnused", reflect.TypeOf((*MockCodeHashAgent)(nil).DeleteUnused), ctx, codeHashes)
+}
+
 // MockEventAgent is a mock of EventAgent interface
 type MockEventAgent struct {
 	ctrl     *gomock.Controller
@@ -1730,33 +2460,47 @@ func (mr *MockEventAgentMockRecorder) InsertMultiple(ctx, events interface{}) *g
 }
 
 // FindOneByAccountAndSigHash mocks base method
-func (m *MockEventAgent) FindOneByAccountAndSigHash(ctx context.Context, chainID, address, sighash string, indexedInputCount uint32) (*models.EventModel, error) {
+func (m *MockEventAgent) FindOneByAccountAndSigHash(ctx context.Context, chainID, address, sighash string, indexedInputCount uint32, tenants []string) (*models.EventModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneByAccountAndSigHash", ctx, chainID, address, sighash, indexedInputCount)
+	ret := m.ctrl.Call(m, "FindOneByAccountAndSigHash", ctx, chainID, address, sighash, indexedInputCount, tenants)
 	ret0, _ := ret[0].(*models.EventModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOneByAccountAndSigHash indicates an expected call of FindOneByAccountAndSigHash
-func (mr *MockEventAgentMockRecorder) FindOneByAccountAndSigHash(ctx, chainID, address, sighash, indexedInputCount interface{}) *gomock.Call {
+func (mr *MockEventAgentMockRecorder) FindOneByAccountAndSigHash(ctx, chainID, address, sighash, indexedInputCount, tenants interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByAccountAndSigHash", reflect.TypeOf((*MockEventAgent)(nil).FindOneByAccountAndSigHash), ctx, chainID, address, sighash, indexedInputCount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByAccountAndSigHash", reflect.TypeOf((*MockEventAgent)(nil).FindOneByAccountAndSigHash), ctx, chainID, address, sighash, indexedInputCount, tenants)
 }
 
 // FindDefaultBySigHash mocks base method
-func (m *MockEventAgent) FindDefaultBySigHash(ctx context.Context, sighash string, indexedInputCount uint32) ([]*models.EventModel, error) {
+func (m *MockEventAgent) FindDefaultBySigHash(ctx context.Context, sighash string, indexedInputCount uint32, tenants []string, ownerID string) ([]*models.EventModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindDefaultBySigHash", ctx, sighash, indexedInputCount)
+	ret := m.ctrl.Call(m, "FindDefaultBySigHash", ctx, sighash, indexedInputCount, tenants, ownerID)
 	ret0, _ := ret[0].([]*models.EventModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindDefaultBySigHash indicates an expected call of FindDefaultBySigHash
-func (mr *MockEventAgentMockRecorder) FindDefaultBySigHash(ctx, sighash, indexedInputCount interface{}) *gomock.Call {
+func (mr *MockEventAgentMockRecorder) FindDefaultBySigHash(ctx, sighash, indexedInputCount, tenants, ownerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDefaultBySigHash", reflect.TypeOf((*MockEventAgent)(nil).FindDefaultBySigHash), ctx, sighash, indexedInputCount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDefaultBySigHash", reflect.TypeOf((*MockEventAgent)(nil).FindDefaultBySigHash), ctx, sighash, indexedInputCount, tenants, ownerID)
+}
+
+// DeleteUnused mocks base method
+func (m *MockEventAgent) DeleteUnused(ctx context.Context, codeHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnused", ctx, codeHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUnused indicates an expected call of DeleteUnused
+func (mr *MockEventAgentMockRecorder) DeleteUnused(ctx, codeHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnused", reflect.TypeOf((*MockEventAgent)(nil).DeleteUnused), ctx, codeHashes)
 }
 
 // MockRepositoryAgent is a mock of RepositoryAgent interface
@@ -1811,33 +2555,47 @@ func (mr *MockRepositoryAgentMockRecorder) Insert(ctx, repository interface{}) *
 }
 
 // FindOne mocks base method
-func (m *MockRepositoryAgent) FindOne(ctx context.Context, name string) (*models.RepositoryModel, error) {
+func (m *MockRepositoryAgent) FindOne(ctx context.Context, name string, tenants []string, ownerID string) (*models.RepositoryModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOne", ctx, name)
+	ret := m.ctrl.Call(m, "FindOne", ctx, name, tenants, ownerID)
 	ret0, _ := ret[0].(*models.RepositoryModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOne indicates an expected call of FindOne
-func (mr *MockRepositoryAgentMockRecorder) FindOne(ctx, name interface{}) *gomock.Call {
+func (mr *MockRepositoryAgentMockRecorder) FindOne(ctx, name, tenants, ownerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockRepositoryAgent)(nil).FindOne), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOne", reflect.TypeOf((*MockRepositoryAgent)(nil).FindOne), ctx, name, tenants, ownerID)
 }
 
 // FindAll mocks base method
-func (m *MockRepositoryAgent) FindAll(ctx context.Context) ([]string, error) {
+func (m *MockRepositoryAgent) FindAll(ctx context.Context, tenants []string, ownerID string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret := m.ctrl.Call(m, "FindAll", ctx, tenants, ownerID)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAll indicates an expected call of FindAll
-func (mr *MockRepositoryAgentMockRecorder) FindAll(ctx interface{}) *gomock.Call {
+func (mr *MockRepositoryAgentMockRecorder) FindAll(ctx, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRepositoryAgent)(nil).FindAll), ctx, tenants, ownerID)
+}
+
+// Delete mocks base method
+func (m *MockRepositoryAgent) Delete(ctx context.Context, repository *models.RepositoryModel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, repository)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockRepositoryAgentMockRecorder) Delete(ctx, repository interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRepositoryAgent)(nil).FindAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepositoryAgent)(nil).Delete), ctx, repository)
 }
 
 // MockTagAgent is a mock of TagAgent interface
@@ -1878,16 +2636,112 @@ func (mr *MockTagAgentMockRecorder) Insert(ctx, tag interface{}) *gomock.Call {
 }
 
 // FindAllByName mocks base method
-func (m *MockTagAgent) FindAllByName(ctx context.Context, name string) ([]string, error) {
+func (m *MockTagAgent) FindAllByName(ctx context.Context, name string, tenants []string, ownerID string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAllByName", ctx, name)
+	ret := m.ctrl.Call(m, "FindAllByName", ctx, name, tenants, ownerID)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindAllByName indicates an expected call of FindAllByName
-func (mr *MockTagAgentMockRecorder) FindAllByName(ctx, name interface{}) *gomock.Call {
+func (mr *MockTagAgentMockRecorder) FindAllByName(ctx, name, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByName", reflect.TypeOf((*MockTagAgent)(nil).FindAllByName), ctx, name, tenants, ownerID)
+}
+
+// FindOneByName mocks base method
+func (m *MockTagAgent) FindOneByName(ctx context.Context, repositoryID int, name string) (*models.TagModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByName", ctx, repositoryID, name)
+	ret0, _ := ret[0].(*models.TagModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByName indicates an expected call of FindOneByName
+func (mr *MockTagAgentMockRecorder) FindOneByName(ctx, repositoryID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByName", reflect.TypeOf((*MockTagAgent)(nil).FindOneByName), ctx, repositoryID, name)
+}
+
+// FindAllByRepositoryID mocks base method
+func (m *MockTagAgent) FindAllByRepositoryID(ctx context.Context, repositoryID int) ([]*models.TagModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByRepositoryID", ctx, repositoryID)
+	ret0, _ := ret[0].([]*models.TagModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByRepositoryID indicates an expected call of FindAllByRepositoryID
+func (mr *MockTagAgentMockRecorder) FindAllByRepositoryID(ctx, repositoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByRepositoryID", reflect.TypeOf((*MockTagAgent)(nil).FindAllByRepositoryID), ctx, repositoryID)
+}
+
+// Delete mocks base method
+func (m *MockTagAgent) Delete(ctx context.Context, tag *models.TagModel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockTagAgentMockRecorder) Delete(ctx, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTagAgent)(nil).Delete), ctx, tag)
+}
+
+// MockJobEventAgent is a mock of JobEventAgent interface
+type MockJobEventAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobEventAgentMockRecorder
+}
+
+// MockJobEventAgentMockRecorder is the mock recorder for MockJobEventAgent
+type MockJobEventAgentMockRecorder struct {
+	mock *MockJobEventAgent
+}
+
+// NewMockJobEventAgent creates a new mock instance
+func NewMockJobEventAgent(ctrl *gomock.Controller) *MockJobEventAgent {
+	mock := &MockJobEventAgent{ctrl: ctrl}
+	mock.recorder = &MockJobEventAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockJobEventAgent) EXPECT() *MockJobEventAgentMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method
+func (m *MockJobEventAgent) Notify(ctx context.Context, event *entities.JobEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify
+func (mr *MockJobEventAgentMockRecorder) Notify(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockJobEventAgent)(nil).Notify), ctx, event)
+}
+
+// Listen mocks base method
+func (m *MockJobEventAgent) Listen(ctx context.Context) (<-chan *entities.JobEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Listen", ctx)
+	ret0, _ := ret[0].(<-chan *entities.JobEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Listen indicates an expected call of Listen
+func (mr *MockJobEventAgentMockRecorder) Listen(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByName", reflect.TypeOf((*MockTagAgent)(nil).FindAllByName), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Listen", reflect.TypeOf((*MockJobEventAgent)(nil).Listen), ctx)
 }
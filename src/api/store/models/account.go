@@ -2,6 +2,8 @@ package models
 
 import (
 	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
 )
 
 type Account struct {
@@ -15,9 +17,16 @@ type Account struct {
 	TenantID            string
 	OwnerID             string
 	Attributes          map[string]string
+	ApprovalPolicy      *entities.ApprovalPolicy
 	// TODO add internal labels to store accountID
 	StoreID string
 
+	DisabledAt  *time.Time
+	DisabledBy  string
+	Successor   string
+	Predecessor string
+	DrainTxUUID string
+
 	CreatedAt time.Time `pg:"default:now()"`
 	UpdatedAt time.Time `pg:"default:now()"`
 }
@@ -2,6 +2,8 @@ package models
 
 import (
 	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
 )
 
 type Chain struct {
@@ -20,6 +22,8 @@ type Chain struct {
 	ListenerExternalTxEnabled bool
 	PrivateTxManagers         []*PrivateTxManager
 	Labels                    map[string]string
+	RPCPolicy                 *entities.ChainRPCPolicy
+	GasOracle                 *entities.ChainGasOracle
 	CreatedAt                 time.Time `pg:"default:now()"`
 	UpdatedAt                 time.Time `pg:"default:now()"`
 }
@@ -24,6 +24,7 @@ type CodehashModel struct {
 	ChainID  string `pg:"alias:chain_id"`
 	Address  string
 	Codehash string
+	TenantID string
 }
 
 type EventModel struct {
@@ -47,7 +48,9 @@ type RepositoryModel struct {
 	ID int
 
 	// Repository name
-	Name string
+	Name     string
+	TenantID string
+	OwnerID  string
 }
 
 type TagModel struct {
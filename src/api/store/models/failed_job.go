@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+)
+
+type FailedJob struct {
+	tableName struct{} `pg:"failed_jobs"` // nolint:unused,structcheck // reason
+
+	UUID         string `pg:",pk"`
+	JobUUID      string
+	ChainUUID    string
+	TenantID     string
+	OwnerID      string
+	EnvelopeID   string
+	ErrorCode    string
+	ErrorClass   string
+	ErrorMessage string
+	ReplayCount  int `pg:",use_zero"`
+	ReplayedAt   *time.Time
+	CreatedAt    time.Time `pg:"default:now()"`
+}
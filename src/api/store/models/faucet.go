@@ -7,14 +7,17 @@ import (
 type Faucet struct {
 	tableName struct{} `pg:"faucets"` // nolint:unused,structcheck // reason
 
-	UUID            string `pg:",pk"`
-	Name            string
-	TenantID        string
-	ChainRule       string
-	CreditorAccount string
-	MaxBalance      string
-	Amount          string
-	Cooldown        string
-	CreatedAt       time.Time `pg:"default:now()"`
-	UpdatedAt       time.Time `pg:"default:now()"`
+	UUID              string `pg:",pk"`
+	Name              string
+	TenantID          string
+	ChainRule         string
+	CreditorAccount   string
+	TokenAddress      string
+	MaxBalance        string
+	Amount            string
+	Cooldown          string
+	DailyBudget       string
+	TenantDailyBudget string
+	CreatedAt         time.Time `pg:"default:now()"`
+	UpdatedAt         time.Time `pg:"default:now()"`
 }
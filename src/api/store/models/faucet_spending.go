@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+)
+
+type FaucetSpending struct {
+	tableName struct{} `pg:"faucet_spendings"` // nolint:unused,structcheck // reason
+
+	UUID        string `pg:",pk"`
+	FaucetUUID  string
+	JobUUID     string
+	TenantID    string
+	Beneficiary string
+	Amount      string
+	CreatedAt   time.Time `pg:"default:now()"`
+}
@@ -22,6 +22,10 @@ type Job struct {
 	Labels        map[string]string
 	InternalData  *entities.InternalData
 	IsParent      bool `pg:"alias:is_parent,default:false,use_zero"`
+	DependsOn     []*entities.JobDependency
+	Inputs        []*entities.JobInput
+	DecodedInput  *entities.DecodedCall
+	Revert        *entities.TxRevert
 	Status        entities.JobStatus
 	CreatedAt     time.Time `pg:"default:now()"`
 	UpdatedAt     time.Time `pg:"default:now()"`
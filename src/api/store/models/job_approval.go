@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+)
+
+type JobApproval struct {
+	tableName struct{} `pg:"job_approvals"` // nolint:unused,structcheck // reason
+
+	UUID      string `pg:",pk"`
+	JobUUID   string
+	TenantID  string
+	Username  string
+	Decision  string
+	Reason    string
+	CreatedAt time.Time `pg:"default:now()"`
+}
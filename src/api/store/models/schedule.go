@@ -12,5 +12,10 @@ type Schedule struct {
 	TenantID  string `pg:"alias:tenant_id"`
 	OwnerID   string `pg:"alias:owner_id"`
 	Jobs      []*Job
-	CreatedAt time.Time `pg:"default:now()"`
+	NotBefore *time.Time `pg:"alias:not_before"`
+	NotAfter  *time.Time `pg:"alias:not_after"`
+	Cron      string
+	NextRunAt *time.Time `pg:"alias:next_run_at"`
+	LastRunAt *time.Time `pg:"alias:last_run_at"`
+	CreatedAt time.Time  `pg:"default:now()"`
 }
@@ -154,3 +154,39 @@ func FakePrivateTxManagerModel() *models.PrivateTxManager {
 		CreatedAt: time.Now(),
 	}
 }
+
+func FakeWebhookModel() *models.Webhook {
+	return &models.Webhook{
+		UUID:      uuid.Must(uuid.NewV4()).String(),
+		TenantID:  "tenantID",
+		URL:       "https://example.com/notifications",
+		Secret:    "my-webhook-secret",
+		Statuses:  []string{string(entities.StatusMined)},
+		ChainUUID: uuid.Must(uuid.NewV4()).String(),
+		Labels:    map[string]string{"app": "payments"},
+	}
+}
+
+func FakeWebhookDeliveryModel(webhookUUID string) *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		UUID:        uuid.Must(uuid.NewV4()).String(),
+		WebhookUUID: webhookUUID,
+		JobUUID:     uuid.Must(uuid.NewV4()).String(),
+		JobStatus:   string(entities.StatusMined),
+		State:       string(entities.WebhookDeliveryPending),
+	}
+}
+
+func FakeFailedJobModel(jobUUID, chainUUID, tenantID string) *models.FailedJob {
+	return &models.FailedJob{
+		UUID:         uuid.Must(uuid.NewV4()).String(),
+		JobUUID:      jobUUID,
+		ChainUUID:    chainUUID,
+		TenantID:     tenantID,
+		OwnerID:      "ownerID",
+		EnvelopeID:   uuid.Must(uuid.NewV4()).String(),
+		ErrorCode:    "08000",
+		ErrorClass:   string(entities.FailedJobConnectionError),
+		ErrorMessage: "failed to connect to chain",
+	}
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+)
+
+type Webhook struct {
+	tableName struct{} `pg:"webhooks"` // nolint:unused,structcheck // reason
+
+	UUID      string `pg:",pk"`
+	TenantID  string
+	URL       string
+	Secret    string
+	Statuses  []string `pg:",array"`
+	ChainUUID string
+	Labels    map[string]string
+	CreatedAt time.Time `pg:"default:now()"`
+	UpdatedAt time.Time `pg:"default:now()"`
+}
+
+type WebhookDelivery struct {
+	tableName struct{} `pg:"webhook_deliveries"` // nolint:unused,structcheck // reason
+
+	UUID           string `pg:",pk"`
+	WebhookUUID    string
+	JobUUID        string
+	JobStatus      string
+	State          string
+	Attempts       int
+	ResponseStatus int
+	Error          string
+	Payload        []byte
+	NextAttemptAt  *time.Time
+	CreatedAt      time.Time `pg:"default:now()"`
+	UpdatedAt      time.Time `pg:"default:now()"`
+}
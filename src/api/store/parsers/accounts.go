@@ -1,6 +1,7 @@
 package parsers
 
 import (
+	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/api/store/models"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/quorum/common/hexutil"
@@ -17,6 +18,12 @@ func NewAccountModelFromEntities(account *entities.Account) *models.Account {
 		OwnerID:             account.OwnerID,
 		StoreID:             account.StoreID,
 		Attributes:          account.Attributes,
+		ApprovalPolicy:      account.ApprovalPolicy,
+		DisabledAt:          account.DisabledAt,
+		DisabledBy:          account.DisabledBy,
+		Successor:           utils.StringerToString(account.Successor),
+		Predecessor:         utils.StringerToString(account.Predecessor),
+		DrainTxUUID:         account.DrainTxUUID,
 		CreatedAt:           account.CreatedAt,
 		UpdatedAt:           account.UpdatedAt,
 	}
@@ -32,6 +39,12 @@ func NewAccountEntityFromModels(account *models.Account) *entities.Account {
 		OwnerID:             account.OwnerID,
 		StoreID:             account.StoreID,
 		Attributes:          account.Attributes,
+		ApprovalPolicy:      account.ApprovalPolicy,
+		DisabledAt:          account.DisabledAt,
+		DisabledBy:          account.DisabledBy,
+		Successor:           utils.ToEthAddr(account.Successor),
+		Predecessor:         utils.ToEthAddr(account.Predecessor),
+		DrainTxUUID:         account.DrainTxUUID,
 		CreatedAt:           account.CreatedAt,
 		UpdatedAt:           account.UpdatedAt,
 	}
@@ -22,6 +22,8 @@ func NewChainFromModel(chainModel *models.Chain) *entities.Chain {
 		ListenerBackOffDuration:   chainModel.ListenerBackOffDuration,
 		ListenerExternalTxEnabled: chainModel.ListenerExternalTxEnabled,
 		Labels:                    chainModel.Labels,
+		RPCPolicy:                 chainModel.RPCPolicy,
+		GasOracle:                 chainModel.GasOracle,
 		CreatedAt:                 chainModel.CreatedAt,
 		UpdatedAt:                 chainModel.UpdatedAt,
 	}
@@ -56,6 +58,8 @@ func NewChainModelFromEntity(chain *entities.Chain) *models.Chain {
 		ListenerBackOffDuration:   chain.ListenerBackOffDuration,
 		ListenerExternalTxEnabled: chain.ListenerExternalTxEnabled,
 		Labels:                    chain.Labels,
+		RPCPolicy:                 chain.RPCPolicy,
+		GasOracle:                 chain.GasOracle,
 		CreatedAt:                 chain.CreatedAt,
 		UpdatedAt:                 chain.UpdatedAt,
 	}
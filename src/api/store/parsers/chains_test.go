@@ -3,9 +3,12 @@
 package parsers
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,4 +18,23 @@ func TestChainsParser(t *testing.T) {
 	finalChain := NewChainFromModel(chainModel)
 
 	assert.Equal(t, chain, finalChain)
+
+	chain.RPCPolicy = &entities.ChainRPCPolicy{
+		AllowedMethods: []string{"eth_*"},
+		TenantQuota:    &entities.ChainRPCQuota{Requests: 100, Period: "1m"},
+	}
+	chainModel = NewChainModelFromEntity(chain)
+	finalChain = NewChainFromModel(chainModel)
+
+	assert.Equal(t, chain, finalChain)
+
+	chain.GasOracle = &entities.ChainGasOracle{
+		Type:              entities.FeeHistoryGasOracle,
+		RewardPercentiles: map[string]float64{"high": 80},
+		MaxFee:            (*hexutil.Big)(big.NewInt(100000000000)),
+	}
+	chainModel = NewChainModelFromEntity(chain)
+	finalChain = NewChainFromModel(chainModel)
+
+	assert.Equal(t, chain, finalChain)
 }
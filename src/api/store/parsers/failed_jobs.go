@@ -0,0 +1,40 @@
+package parsers
+
+import (
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+func NewFailedJobModelFromEntity(failedJob *entities.FailedJob) *models.FailedJob {
+	return &models.FailedJob{
+		UUID:         failedJob.UUID,
+		JobUUID:      failedJob.JobUUID,
+		ChainUUID:    failedJob.ChainUUID,
+		TenantID:     failedJob.TenantID,
+		OwnerID:      failedJob.OwnerID,
+		EnvelopeID:   failedJob.EnvelopeID,
+		ErrorCode:    failedJob.ErrorCode,
+		ErrorClass:   string(failedJob.ErrorClass),
+		ErrorMessage: failedJob.ErrorMessage,
+		ReplayCount:  failedJob.ReplayCount,
+		ReplayedAt:   failedJob.ReplayedAt,
+		CreatedAt:    failedJob.CreatedAt,
+	}
+}
+
+func NewFailedJobEntityFromModel(failedJob *models.FailedJob) *entities.FailedJob {
+	return &entities.FailedJob{
+		UUID:         failedJob.UUID,
+		JobUUID:      failedJob.JobUUID,
+		ChainUUID:    failedJob.ChainUUID,
+		TenantID:     failedJob.TenantID,
+		OwnerID:      failedJob.OwnerID,
+		EnvelopeID:   failedJob.EnvelopeID,
+		ErrorCode:    failedJob.ErrorCode,
+		ErrorClass:   entities.FailedJobErrorClass(failedJob.ErrorClass),
+		ErrorMessage: failedJob.ErrorMessage,
+		ReplayCount:  failedJob.ReplayCount,
+		ReplayedAt:   failedJob.ReplayedAt,
+		CreatedAt:    failedJob.CreatedAt,
+	}
+}
@@ -0,0 +1,23 @@
+// +build unit
+
+package parsers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailedJobsParser(t *testing.T) {
+	failedJob := testdata.FakeFailedJob()
+	replayedAt := time.Now().UTC()
+	failedJob.ReplayedAt = &replayedAt
+	failedJob.ReplayCount = 1
+
+	failedJobModel := NewFailedJobModelFromEntity(failedJob)
+	finalFailedJob := NewFailedJobEntityFromModel(failedJobModel)
+
+	assert.Equal(t, failedJob, finalFailedJob)
+}
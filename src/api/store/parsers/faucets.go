@@ -9,31 +9,61 @@ import (
 
 func NewFaucetFromModel(faucet *models.Faucet) *entities.Faucet {
 	return &entities.Faucet{
-		UUID:            faucet.UUID,
-		Name:            faucet.Name,
-		TenantID:        faucet.TenantID,
-		ChainRule:       faucet.ChainRule,
-		CreditorAccount: ethcommon.HexToAddress(faucet.CreditorAccount),
-		MaxBalance:      *utils.BigIntStringToHex(faucet.MaxBalance),
-		Amount:          *utils.BigIntStringToHex(faucet.Amount),
-		Cooldown:        faucet.Cooldown,
-		CreatedAt:       faucet.CreatedAt,
-		UpdatedAt:       faucet.UpdatedAt,
+		UUID:              faucet.UUID,
+		Name:              faucet.Name,
+		TenantID:          faucet.TenantID,
+		ChainRule:         faucet.ChainRule,
+		CreditorAccount:   ethcommon.HexToAddress(faucet.CreditorAccount),
+		TokenAddress:      utils.ToEthAddr(faucet.TokenAddress),
+		MaxBalance:        *utils.BigIntStringToHex(faucet.MaxBalance),
+		Amount:            *utils.BigIntStringToHex(faucet.Amount),
+		Cooldown:          faucet.Cooldown,
+		DailyBudget:       utils.BigIntStringToHex(faucet.DailyBudget),
+		TenantDailyBudget: utils.BigIntStringToHex(faucet.TenantDailyBudget),
+		CreatedAt:         faucet.CreatedAt,
+		UpdatedAt:         faucet.UpdatedAt,
 	}
 }
 
 func NewFaucetModelFromEntity(faucet *entities.Faucet) *models.Faucet {
 	f := &models.Faucet{
-		UUID:            faucet.UUID,
-		Name:            faucet.Name,
-		TenantID:        faucet.TenantID,
-		ChainRule:       faucet.ChainRule,
-		CreditorAccount: faucet.CreditorAccount.Hex(),
-		MaxBalance:      faucet.MaxBalance.ToInt().String(),
-		Amount:          faucet.Amount.ToInt().String(),
-		Cooldown:        faucet.Cooldown,
-		CreatedAt:       faucet.CreatedAt,
+		UUID:              faucet.UUID,
+		Name:              faucet.Name,
+		TenantID:          faucet.TenantID,
+		ChainRule:         faucet.ChainRule,
+		CreditorAccount:   faucet.CreditorAccount.Hex(),
+		TokenAddress:      utils.StringerToString(faucet.TokenAddress),
+		MaxBalance:        faucet.MaxBalance.ToInt().String(),
+		Amount:            faucet.Amount.ToInt().String(),
+		Cooldown:          faucet.Cooldown,
+		DailyBudget:       utils.HexToBigIntString(faucet.DailyBudget),
+		TenantDailyBudget: utils.HexToBigIntString(faucet.TenantDailyBudget),
+		CreatedAt:         faucet.CreatedAt,
 	}
 
 	return f
 }
+
+func NewFaucetSpendingFromModel(spending *models.FaucetSpending) *entities.FaucetSpending {
+	return &entities.FaucetSpending{
+		UUID:        spending.UUID,
+		FaucetUUID:  spending.FaucetUUID,
+		JobUUID:     spending.JobUUID,
+		TenantID:    spending.TenantID,
+		Beneficiary: ethcommon.HexToAddress(spending.Beneficiary),
+		Amount:      *utils.BigIntStringToHex(spending.Amount),
+		CreatedAt:   spending.CreatedAt,
+	}
+}
+
+func NewFaucetSpendingModelFromEntity(spending *entities.FaucetSpending) *models.FaucetSpending {
+	return &models.FaucetSpending{
+		UUID:        spending.UUID,
+		FaucetUUID:  spending.FaucetUUID,
+		JobUUID:     spending.JobUUID,
+		TenantID:    spending.TenantID,
+		Beneficiary: spending.Beneficiary.Hex(),
+		Amount:      spending.Amount.ToInt().String(),
+		CreatedAt:   spending.CreatedAt,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/src/entities/testdata"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,3 +17,14 @@ func TestFaucetsParser(t *testing.T) {
 
 	assert.Equal(t, faucet, finalFaucet)
 }
+
+func TestFaucetsParser_TokenAddress(t *testing.T) {
+	tokenAddress := ethcommon.HexToAddress("0x6230592812dE2E256D1512504c3E8A3C49975f07")
+	faucet := testdata.FakeFaucet()
+	faucet.TokenAddress = &tokenAddress
+	faucetModel := NewFaucetModelFromEntity(faucet)
+	finalFaucet := NewFaucetFromModel(faucetModel)
+
+	assert.Equal(t, tokenAddress.Hex(), faucetModel.TokenAddress)
+	assert.Equal(t, faucet, finalFaucet)
+}
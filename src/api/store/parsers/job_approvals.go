@@ -0,0 +1,30 @@
+package parsers
+
+import (
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+func NewJobApprovalModelFromEntity(approval *entities.JobApproval) *models.JobApproval {
+	return &models.JobApproval{
+		UUID:      approval.UUID,
+		JobUUID:   approval.JobUUID,
+		TenantID:  approval.TenantID,
+		Username:  approval.Username,
+		Decision:  string(approval.Decision),
+		Reason:    approval.Reason,
+		CreatedAt: approval.CreatedAt,
+	}
+}
+
+func NewJobApprovalEntityFromModel(approval *models.JobApproval) *entities.JobApproval {
+	return &entities.JobApproval{
+		UUID:      approval.UUID,
+		JobUUID:   approval.JobUUID,
+		TenantID:  approval.TenantID,
+		Username:  approval.Username,
+		Decision:  entities.ApprovalDecision(approval.Decision),
+		Reason:    approval.Reason,
+		CreatedAt: approval.CreatedAt,
+	}
+}
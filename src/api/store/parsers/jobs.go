@@ -15,6 +15,10 @@ func NewJobModelFromEntities(job *entities.Job, scheduleID *int) *models.Job {
 		InternalData: job.InternalData,
 		ScheduleID:   scheduleID,
 		Status:       job.Status,
+		DependsOn:    job.DependsOn,
+		Inputs:       job.Inputs,
+		DecodedInput: job.DecodedInput,
+		Revert:       job.Revert,
 		Schedule: &models.Schedule{
 			UUID:     job.ScheduleUUID,
 			TenantID: job.TenantID,
@@ -59,6 +63,10 @@ func NewJobEntityFromModels(jobModel *models.Job) *entities.Job {
 		CreatedAt:    jobModel.CreatedAt,
 		UpdatedAt:    jobModel.UpdatedAt,
 		Status:       jobModel.Status,
+		DependsOn:    jobModel.DependsOn,
+		Inputs:       jobModel.Inputs,
+		DecodedInput: jobModel.DecodedInput,
+		Revert:       jobModel.Revert,
 	}
 
 	if jobModel.Schedule != nil {
@@ -77,3 +85,29 @@ func NewJobEntityFromModels(jobModel *models.Job) *entities.Job {
 
 	return job
 }
+
+// NewJobEventEntityFromModels returns the event recorded by a job log, loaded with its job and schedule
+func NewJobEventEntityFromModels(logModel *models.Log) *entities.JobEvent {
+	event := &entities.JobEvent{
+		UUID:      logModel.UUID,
+		Status:    logModel.Status,
+		Message:   logModel.Message,
+		CreatedAt: logModel.CreatedAt,
+	}
+
+	if jobModel := logModel.Job; jobModel != nil {
+		event.JobUUID = jobModel.UUID
+		event.ChainUUID = jobModel.ChainUUID
+		event.Labels = jobModel.Labels
+		if jobModel.InternalData != nil {
+			event.ParentJobUUID = jobModel.InternalData.ParentJobUUID
+		}
+		if jobModel.Schedule != nil {
+			event.ScheduleUUID = jobModel.Schedule.UUID
+			event.TenantID = jobModel.Schedule.TenantID
+			event.OwnerID = jobModel.Schedule.OwnerID
+		}
+	}
+
+	return event
+}
@@ -7,6 +7,7 @@ import (
 
 func NewLogEntityFromModels(logModel *models.Log) *entities.Log {
 	return &entities.Log{
+		UUID:      logModel.UUID,
 		Status:    logModel.Status,
 		Message:   logModel.Message,
 		CreatedAt: logModel.CreatedAt,
@@ -15,6 +16,7 @@ func NewLogEntityFromModels(logModel *models.Log) *entities.Log {
 
 func NewLogModelFromEntity(log *entities.Log) *models.Log {
 	return &models.Log{
+		UUID:      log.UUID,
 		Status:    log.Status,
 		Message:   log.Message,
 		CreatedAt: log.CreatedAt,
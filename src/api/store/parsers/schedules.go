@@ -10,6 +10,11 @@ func NewScheduleEntityFromModels(scheduleModel *models.Schedule) *entities.Sched
 		UUID:      scheduleModel.UUID,
 		TenantID:  scheduleModel.TenantID,
 		OwnerID:   scheduleModel.OwnerID,
+		NotBefore: scheduleModel.NotBefore,
+		NotAfter:  scheduleModel.NotAfter,
+		Cron:      scheduleModel.Cron,
+		NextRunAt: scheduleModel.NextRunAt,
+		LastRunAt: scheduleModel.LastRunAt,
 		CreatedAt: scheduleModel.CreatedAt,
 	}
 
@@ -22,8 +27,13 @@ func NewScheduleEntityFromModels(scheduleModel *models.Schedule) *entities.Sched
 
 func NewScheduleModelFromEntities(schedule *entities.Schedule) *models.Schedule {
 	scheduleModel := &models.Schedule{
-		UUID:     schedule.UUID,
-		TenantID: schedule.TenantID,
+		UUID:      schedule.UUID,
+		TenantID:  schedule.TenantID,
+		NotBefore: schedule.NotBefore,
+		NotAfter:  schedule.NotAfter,
+		Cron:      schedule.Cron,
+		NextRunAt: schedule.NextRunAt,
+		LastRunAt: schedule.LastRunAt,
 	}
 
 	for _, job := range schedule.Jobs {
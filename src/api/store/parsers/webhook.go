@@ -0,0 +1,74 @@
+package parsers
+
+import (
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+func NewWebhookFromModel(webhook *models.Webhook) *entities.Webhook {
+	var statuses []entities.JobStatus
+	for _, status := range webhook.Statuses {
+		statuses = append(statuses, entities.JobStatus(status))
+	}
+
+	return &entities.Webhook{
+		UUID:      webhook.UUID,
+		TenantID:  webhook.TenantID,
+		URL:       webhook.URL,
+		Secret:    webhook.Secret,
+		Statuses:  statuses,
+		ChainUUID: webhook.ChainUUID,
+		Labels:    webhook.Labels,
+		CreatedAt: webhook.CreatedAt,
+		UpdatedAt: webhook.UpdatedAt,
+	}
+}
+
+func NewWebhookModelFromEntity(webhook *entities.Webhook) *models.Webhook {
+	var statuses []string
+	for _, status := range webhook.Statuses {
+		statuses = append(statuses, string(status))
+	}
+
+	return &models.Webhook{
+		UUID:      webhook.UUID,
+		TenantID:  webhook.TenantID,
+		URL:       webhook.URL,
+		Secret:    webhook.Secret,
+		Statuses:  statuses,
+		ChainUUID: webhook.ChainUUID,
+		Labels:    webhook.Labels,
+		CreatedAt: webhook.CreatedAt,
+		UpdatedAt: webhook.UpdatedAt,
+	}
+}
+
+func NewWebhookDeliveryFromModel(delivery *models.WebhookDelivery) *entities.WebhookDelivery {
+	return &entities.WebhookDelivery{
+		UUID:           delivery.UUID,
+		WebhookUUID:    delivery.WebhookUUID,
+		JobUUID:        delivery.JobUUID,
+		JobStatus:      entities.JobStatus(delivery.JobStatus),
+		State:          entities.WebhookDeliveryState(delivery.State),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
+
+func NewWebhookDeliveryModelFromEntity(delivery *entities.WebhookDelivery) *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		UUID:           delivery.UUID,
+		WebhookUUID:    delivery.WebhookUUID,
+		JobUUID:        delivery.JobUUID,
+		JobStatus:      string(delivery.JobStatus),
+		State:          string(delivery.State),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
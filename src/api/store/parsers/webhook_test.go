@@ -0,0 +1,26 @@
+// +build unit
+
+package parsers
+
+import (
+	"testing"
+
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhooksParser(t *testing.T) {
+	webhook := testdata.FakeWebhook()
+	webhookModel := NewWebhookModelFromEntity(webhook)
+	finalWebhook := NewWebhookFromModel(webhookModel)
+
+	assert.Equal(t, webhook, finalWebhook)
+}
+
+func TestWebhookDeliveriesParser(t *testing.T) {
+	delivery := testdata.FakeWebhookDelivery()
+	deliveryModel := NewWebhookDeliveryModelFromEntity(delivery)
+	finalDelivery := NewWebhookDeliveryFromModel(deliveryModel)
+
+	assert.Equal(t, delivery, finalDelivery)
+}
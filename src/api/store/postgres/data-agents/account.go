@@ -17,6 +17,11 @@ import (
 
 const accountDAComponent = "data-agents.account"
 
+var accountSortColumns = map[string]string{
+	entities.SortByCreatedAt: "created_at",
+	entities.SortByUpdatedAt: "updated_at",
+}
+
 // PGAccount is an Account data agent for PostgreSQL
 type PGAccount struct {
 	db     pg.DB
@@ -73,10 +78,15 @@ func (agent *PGAccount) Search(ctx context.Context, filters *entities.AccountFil
 		query = query.Where("tenant_id = ?", filters.TenantID)
 	}
 
-	query = pg.WhereAllowedTenants(query, "tenant_id", tenants).Order("id ASC")
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants)
 	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
 
-	err := pg.Select(ctx, query)
+	query, err := paginate(query, &filters.Pagination, "id ASC", "address", accountSortColumns)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(accountDAComponent)
+	}
+
+	err = pg.Select(ctx, query)
 	if err != nil {
 		errMsg := "failed to search accounts"
 		if !errors.IsNotFoundError(err) {
@@ -107,3 +117,17 @@ func (agent *PGAccount) FindOneByAddress(ctx context.Context, address string, te
 
 	return account, nil
 }
+
+// LockOneByAddress locks an account row until the end of the current transaction
+func (agent *PGAccount) LockOneByAddress(ctx context.Context, address string) error {
+	query := agent.db.ModelContext(ctx, &models.Account{}).Where("address = ?", address).For("UPDATE")
+	err := pg.Select(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to lock account by address")
+		}
+		return errors.FromError(err).ExtendComponent(accountDAComponent)
+	}
+
+	return nil
+}
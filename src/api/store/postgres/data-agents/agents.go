@@ -13,6 +13,7 @@ type PGAgents struct {
 	txRequest        store.TransactionRequestAgent
 	account          store.AccountAgent
 	faucet           store.FaucetAgent
+	faucetSpending   store.FaucetSpendingAgent
 	artifact         store.ArtifactAgent
 	codeHash         store.CodeHashAgent
 	event            store.EventAgent
@@ -21,6 +22,11 @@ type PGAgents struct {
 	contract         store.ContractAgent
 	chain            store.ChainAgent
 	privateTxManager store.PrivateTxManagerAgent
+	webhook          store.WebhookAgent
+	webhookDelivery  store.WebhookDeliveryAgent
+	jobApproval      store.JobApprovalAgent
+	failedJob        store.FailedJobAgent
+	jobEvent         store.JobEventAgent
 }
 
 func New(db pg.DB) *PGAgents {
@@ -32,6 +38,7 @@ func New(db pg.DB) *PGAgents {
 		txRequest:        NewPGTransactionRequest(db),
 		account:          NewPGAccount(db),
 		faucet:           NewPGFaucet(db),
+		faucetSpending:   NewPGFaucetSpending(db),
 		artifact:         NewPGArtifact(db),
 		codeHash:         NewPGCodeHash(db),
 		event:            NewPGEvent(db),
@@ -40,6 +47,11 @@ func New(db pg.DB) *PGAgents {
 		contract:         NewPGContract(db),
 		chain:            NewPGChain(db),
 		privateTxManager: NewPGPrivateTxManager(db),
+		webhook:          NewPGWebhook(db),
+		webhookDelivery:  NewPGWebhookDelivery(db),
+		jobApproval:      NewPGJobApproval(db),
+		failedJob:        NewPGFailedJob(db),
+		jobEvent:         NewPGJobEvent(db),
 	}
 }
 
@@ -71,6 +83,10 @@ func (a *PGAgents) Faucet() store.FaucetAgent {
 	return a.faucet
 }
 
+func (a *PGAgents) FaucetSpending() store.FaucetSpendingAgent {
+	return a.faucetSpending
+}
+
 func (a *PGAgents) Artifact() store.ArtifactAgent {
 	return a.artifact
 }
@@ -102,3 +118,23 @@ func (a *PGAgents) Chain() store.ChainAgent {
 func (a *PGAgents) PrivateTxManager() store.PrivateTxManagerAgent {
 	return a.privateTxManager
 }
+
+func (a *PGAgents) Webhook() store.WebhookAgent {
+	return a.webhook
+}
+
+func (a *PGAgents) WebhookDelivery() store.WebhookDeliveryAgent {
+	return a.webhookDelivery
+}
+
+func (a *PGAgents) JobApproval() store.JobApprovalAgent {
+	return a.jobApproval
+}
+
+func (a *PGAgents) FailedJob() store.FailedJobAgent {
+	return a.failedJob
+}
+
+func (a *PGAgents) JobEvent() store.JobEventAgent {
+	return a.jobEvent
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/api/store/models"
 	pg "github.com/consensys/orchestrate/src/infra/database/postgres"
+	gopg "github.com/go-pg/pg/v9"
 )
 
 const artifactDAComponent = "data-agents.artifact"
@@ -65,7 +66,7 @@ func (agent *PGArtifact) SelectOrInsert(ctx context.Context, artifact *models.Ar
 	return nil
 }
 
-func (agent *PGArtifact) FindOneByNameAndTag(ctx context.Context, name, tag string) (*models.ArtifactModel, error) {
+func (agent *PGArtifact) FindOneByNameAndTag(ctx context.Context, name, tag string, tenants []string, ownerID string) (*models.ArtifactModel, error) {
 	artifact := &models.ArtifactModel{}
 	query := agent.db.ModelContext(ctx, artifact).
 		Column("artifact_model.id", "abi", "bytecode", "deployed_bytecode").
@@ -73,6 +74,9 @@ func (agent *PGArtifact) FindOneByNameAndTag(ctx context.Context, name, tag stri
 		Join("JOIN repositories AS registry ON registry.id = t.repository_id").
 		Where("LOWER(t.name) = LOWER(?)", tag).
 		Where("LOWER(registry.name) = LOWER(?)", name)
+	query = pg.WhereAllowedTenants(query, "registry.tenant_id", tenants)
+	query = pg.WhereAllowedOwner(query, "registry.owner_id", ownerID)
+	query = orderByRepositoryPreference(query, "registry", tenants)
 
 	err := pg.SelectOne(ctx, query)
 	if err != nil {
@@ -82,3 +86,24 @@ func (agent *PGArtifact) FindOneByNameAndTag(ctx context.Context, name, tag stri
 
 	return artifact, nil
 }
+
+// DeleteUnused deletes the artifacts, among the given ones, which are not tagged anymore and returns them
+func (agent *PGArtifact) DeleteUnused(ctx context.Context, ids []int) ([]*models.ArtifactModel, error) {
+	var artifacts []*models.ArtifactModel
+	if len(ids) == 0 {
+		return artifacts, nil
+	}
+
+	query := agent.db.ModelContext(ctx, &artifacts).
+		Where("artifact_model.id IN (?)", gopg.In(ids)).
+		Where("NOT EXISTS (SELECT 1 FROM tags WHERE tags.artifact_id = artifact_model.id)").
+		Returning("id, codehash")
+
+	err := pg.Delete(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete unused artifacts")
+		return nil, errors.FromError(err).ExtendComponent(artifactDAComponent)
+	}
+
+	return artifacts, nil
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/infra/database/postgres"
 	pgTestUtils "github.com/consensys/orchestrate/src/infra/database/postgres/testutils"
 	"github.com/consensys/orchestrate/src/api/store/models"
@@ -146,23 +147,47 @@ func (s *artifactTestSuite) TestPGArtifact_FindOneByNameAndTag() {
 	ctx := context.Background()
 
 	s.T().Run("should return NotFoundError if none is found", func(t *testing.T) {
-		_, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "name", "tag")
+		_, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "name", "tag", []string{multitenancy.DefaultTenant}, "")
 		assert.True(t, errors.IsNotFoundError(err))
 	})
 
 	s.T().Run("should find successfully", func(t *testing.T) {
 		_ = s.insertArtifacts(ctx, "myContract", "tag")
 
-		artifact, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "myContract", "tag")
+		artifact, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "myContract", "tag", []string{multitenancy.DefaultTenant}, "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, 1, artifact.ID)
 	})
 
+	s.T().Run("should not find contracts of other tenants", func(t *testing.T) {
+		_, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "myContract", "tag", []string{"tenantOne"}, "")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	s.T().Run("should prefer the contract of the caller tenant and owner", func(t *testing.T) {
+		tenants := []string{multitenancy.DefaultTenant, "tenantOne"}
+		_ = s.insertTenantArtifact(ctx, "myTenantContract", "tag", multitenancy.DefaultTenant, "", "0x01")
+		_ = s.insertTenantArtifact(ctx, "myTenantContract", "tag", "tenantOne", "", "0x02")
+		_ = s.insertTenantArtifact(ctx, "myTenantContract", "tag", "tenantOne", "username", "0x03")
+
+		artifact, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "myTenantContract", "tag", tenants, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "0x02", artifact.DeployedBytecode)
+
+		artifact, err = s.agents.Artifact().FindOneByNameAndTag(ctx, "myTenantContract", "tag", tenants, "username")
+		assert.NoError(t, err)
+		assert.Equal(t, "0x03", artifact.DeployedBytecode)
+
+		artifact, err = s.agents.Artifact().FindOneByNameAndTag(ctx, "myTenantContract", "tag", []string{multitenancy.DefaultTenant}, "username")
+		assert.NoError(t, err)
+		assert.Equal(t, "0x01", artifact.DeployedBytecode)
+	})
+
 	s.T().Run("should return PostgresConnectionError if select fails", func(t *testing.T) {
 		// We drop the DB to make the test fail
 		s.pg.DropTestDB(t)
-		_, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "name", "tag")
+		_, err := s.agents.Artifact().FindOneByNameAndTag(ctx, "name", "tag", []string{multitenancy.DefaultTenant}, "")
 
 		assert.True(t, errors.IsInternalError(err))
 
@@ -192,3 +217,52 @@ func (s *artifactTestSuite) insertArtifacts(ctx context.Context, name, tag strin
 
 	return s.agents.Tag().Insert(ctx, tagModel)
 }
+
+func (s *artifactTestSuite) TestPGArtifact_DeleteUnused() {
+	ctx := context.Background()
+
+	s.T().Run("should only delete the artifacts which are not tagged anymore", func(t *testing.T) {
+		_ = s.insertTenantArtifact(ctx, "myContract", "tag", multitenancy.DefaultTenant, "", "0x01")
+		unused := &models.ArtifactModel{
+			ABI:              s.abi,
+			Bytecode:         "0x02",
+			DeployedBytecode: "0x02",
+			Codehash:         codeHash,
+		}
+		_ = s.agents.Artifact().Insert(ctx, unused)
+
+		tagged, _ := s.agents.Artifact().FindOneByNameAndTag(ctx, "myContract", "tag", []string{multitenancy.DefaultTenant}, "")
+
+		artifacts, err := s.agents.Artifact().DeleteUnused(ctx, []int{tagged.ID, unused.ID})
+		assert.NoError(t, err)
+		assert.Len(t, artifacts, 1)
+		assert.Equal(t, unused.ID, artifacts[0].ID)
+		assert.Equal(t, codeHash, artifacts[0].Codehash)
+
+		_, err = s.agents.Artifact().FindOneByNameAndTag(ctx, "myContract", "tag", []string{multitenancy.DefaultTenant}, "")
+		assert.NoError(t, err)
+	})
+}
+
+func (s *artifactTestSuite) insertTenantArtifact(ctx context.Context, name, tag, tenantID, ownerID, deployedBytecode string) error {
+	repo := &models.RepositoryModel{
+		Name:     name,
+		TenantID: tenantID,
+		OwnerID:  ownerID,
+	}
+	_ = s.agents.Repository().Insert(ctx, repo)
+
+	artifact := &models.ArtifactModel{
+		ABI:              s.abi,
+		Bytecode:         deployedBytecode,
+		DeployedBytecode: deployedBytecode,
+		Codehash:         codeHash,
+	}
+	_ = s.agents.Artifact().Insert(ctx, artifact)
+
+	return s.agents.Tag().Insert(ctx, &models.TagModel{
+		Name:         tag,
+		ArtifactID:   artifact.ID,
+		RepositoryID: repo.ID,
+	})
+}
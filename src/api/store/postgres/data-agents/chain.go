@@ -16,6 +16,11 @@ import (
 
 const chainDAComponent = "data-agents.chain"
 
+var chainSortColumns = map[string]string{
+	entities.SortByCreatedAt: "created_at",
+	entities.SortByUpdatedAt: "updated_at",
+}
+
 // PGChain is a Chain data agent for PostgreSQL
 type PGChain struct {
 	db     pg.DB
@@ -92,10 +97,15 @@ func (agent *PGChain) Search(ctx context.Context, filters *entities.ChainFilters
 		query = query.Where("tenant_id = ?", filters.TenantID)
 	}
 
-	query = pg.WhereAllowedTenants(query, "tenant_id", tenants).Order("created_at ASC")
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants)
 	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
 
-	if err := pg.Select(ctx, query); err != nil {
+	query, err := paginate(query, &filters.Pagination, "created_at ASC", "uuid", chainSortColumns)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(chainDAComponent)
+	}
+
+	if err = pg.Select(ctx, query); err != nil {
 		if !errors.IsNotFoundError(err) {
 			agent.logger.WithContext(ctx).WithError(err).Error("failed to search chains")
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/api/store/models"
 	pkgpg "github.com/consensys/orchestrate/src/infra/database/postgres"
+	gopg "github.com/go-pg/pg/v9"
 )
 
 const codeHashDAComponent = "data-agents.code_hash"
@@ -24,9 +25,7 @@ func NewPGCodeHash(db pkgpg.DB) store.CodeHashAgent {
 func (agent *PGCodeHash) Insert(ctx context.Context, codehash *models.CodehashModel) error {
 	// If uniqueness constraint is broken then it updates the former value
 	_, err := agent.db.ModelContext(ctx, codehash).
-		OnConflict("ON CONSTRAINT codehashes_chain_id_address_key DO UPDATE").
-		Set("chain_id = ?chain_id").
-		Set("address = ?address").
+		OnConflict("ON CONSTRAINT codehashes_tenant_id_chain_id_address_key DO UPDATE").
 		Set("codehash = ?codehash").
 		Returning("*").
 		Insert()
@@ -39,3 +38,22 @@ func (agent *PGCodeHash) Insert(ctx context.Context, codehash *models.CodehashMo
 
 	return nil
 }
+
+// DeleteUnused deletes the accounts of the given code hashes which do not match any artifact anymore
+func (agent *PGCodeHash) DeleteUnused(ctx context.Context, codeHashes []string) error {
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	query := agent.db.ModelContext(ctx, (*models.CodehashModel)(nil)).
+		Where("codehash_model.codehash IN (?)", gopg.In(codeHashes)).
+		Where("NOT EXISTS (SELECT 1 FROM artifacts WHERE artifacts.codehash = codehash_model.codehash)")
+
+	err := pkgpg.Delete(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete unused codehashes")
+		return errors.FromError(err).ExtendComponent(codeHashDAComponent)
+	}
+
+	return nil
+}
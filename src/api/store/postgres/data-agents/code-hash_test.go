@@ -77,3 +77,27 @@ func (s *codeHashTestSuite) TestPGCodeHash_Insert() {
 		s.pg.InitTestDB(t)
 	})
 }
+
+func (s *codeHashTestSuite) TestPGCodeHash_DeleteUnused() {
+	ctx := context.Background()
+
+	s.T().Run("should only delete the codehashes which do not match any artifact", func(t *testing.T) {
+		_ = s.agents.Artifact().Insert(ctx, &models.ArtifactModel{
+			ABI:              "ABI",
+			Bytecode:         "0x01",
+			DeployedBytecode: "0x01",
+			Codehash:         "usedCodeHash",
+		})
+		_ = s.agents.CodeHash().Insert(ctx, &models.CodehashModel{ChainID: "chainID", Address: "address1", Codehash: "usedCodeHash"})
+		_ = s.agents.CodeHash().Insert(ctx, &models.CodehashModel{ChainID: "chainID", Address: "address2", Codehash: "unusedCodeHash"})
+		_ = s.agents.CodeHash().Insert(ctx, &models.CodehashModel{ChainID: "chainID", Address: "address3", Codehash: "otherCodeHash"})
+
+		err := s.agents.CodeHash().DeleteUnused(ctx, []string{"usedCodeHash", "unusedCodeHash"})
+		assert.NoError(t, err)
+
+		var addresses []string
+		err = s.pg.DB.Model((*models.CodehashModel)(nil)).Column("address").Order("address").Select(&addresses)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"address1", "address3"}, addresses)
+	})
+}
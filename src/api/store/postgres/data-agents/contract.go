@@ -2,15 +2,17 @@ package dataagents
 
 import (
 	"context"
-	"strings"
 
-	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/consensys/orchestrate/pkg/ethereum/abi"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/entities"
 	pg "github.com/consensys/orchestrate/src/infra/database/postgres"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gopg "github.com/go-pg/pg/v9"
 )
 
 const contractDAComponent = "data-agents.contract"
@@ -35,18 +37,19 @@ func NewPGContract(db pg.DB) store.ContractAgent {
 	}
 }
 
-func (agent *PGContract) FindOneByCodeHash(ctx context.Context, codeHash string) (*entities.Contract, error) {
+func (agent *PGContract) FindOneByCodeHash(ctx context.Context, codeHash string, tenants []string, ownerID string) (*entities.Contract, error) {
 	qContract := &contractQuery{}
 	query := `
 SELECT a.abi, a.bytecode, a.deployed_bytecode, r.name as name, t.name as tag 
 FROM artifacts a
 INNER JOIN tags t ON (a.id = t.artifact_id)
 INNER JOIN repositories r ON (r.id = t.repository_id) 
-WHERE a.codehash = ?
-ORDER BY t.id DESC
+WHERE a.codehash = ?0
+AND ` + allowedRepositoryCondition + `
+ORDER BY ` + repositoryPreferenceOrder + `, t.id DESC
 LIMIT 1
 `
-	_, err := agent.db.QueryOneContext(ctx, qContract, query, codeHash)
+	_, err := agent.db.QueryOneContext(ctx, qContract, query, allowedRepositoryParams(codeHash, tenants, ownerID)...)
 	if err != nil {
 		return nil, pg.ParsePGError(err)
 	}
@@ -54,7 +57,7 @@ LIMIT 1
 	return parseContract(qContract)
 }
 
-func (agent *PGContract) FindOneByAddress(ctx context.Context, address string) (*entities.Contract, error) {
+func (agent *PGContract) FindOneByAddress(ctx context.Context, address string, tenants []string, ownerID string) (*entities.Contract, error) {
 	qContract := &contractQuery{}
 	query := `
 SELECT a.abi, a.bytecode, a.deployed_bytecode, r.name as name, t.name as tag 
@@ -62,10 +65,12 @@ FROM artifacts a
 INNER JOIN tags t ON (a.id = t.artifact_id)
 INNER JOIN repositories r ON (r.id = t.repository_id) 
 INNER JOIN codehashes ch ON (ch.codehash = a.codehash) 
-WHERE ch.address = ?
+WHERE ch.address = ?0
+AND ` + allowedRepositoryCondition + `
+ORDER BY ` + repositoryPreferenceOrder + `
 LIMIT 1
 `
-	_, err := agent.db.QueryOneContext(ctx, qContract, query, address)
+	_, err := agent.db.QueryOneContext(ctx, qContract, query, allowedRepositoryParams(address, tenants, ownerID)...)
 	if err != nil {
 		return nil, pg.ParsePGError(err)
 	}
@@ -73,8 +78,23 @@ LIMIT 1
 	return parseContract(qContract)
 }
 
+// Restricts the repositories "r" to the allowed tenants and owner, see allowedRepositoryParams for the parameters
+const allowedRepositoryCondition = `(?1 OR r.tenant_id IN (?2)) AND (?3 OR r.owner_id = ?4 OR r.owner_id IS NULL)`
+
+// Same order as orderByRepositoryPreference
+const repositoryPreferenceOrder = `array_position(?5::text[], r.tenant_id) DESC NULLS LAST, r.owner_id IS NULL`
+
+func allowedRepositoryParams(value string, tenants []string, ownerID string) []interface{} {
+	allTenants := len(tenants) == 0 || utils.ContainsString(tenants, multitenancy.WildcardTenant)
+	if len(tenants) == 0 {
+		tenants = []string{multitenancy.WildcardTenant}
+	}
+
+	return []interface{}{value, allTenants, gopg.In(tenants), ownerID == multitenancy.WildcardOwner, ownerID, gopg.Array(tenants)}
+}
+
 func parseContract(qContract *contractQuery) (*entities.Contract, error) {
-	parsedABI, err := abi.JSON(strings.NewReader(qContract.ABI))
+	parsedABI, err := abi.ParseABI(qContract.ABI)
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/api/store/models"
 	pg "github.com/consensys/orchestrate/src/infra/database/postgres"
+	gopg "github.com/go-pg/pg/v9"
 )
 
 const eventDAComponent = "data-agents.event"
@@ -33,7 +34,9 @@ func (agent *PGEvent) InsertMultiple(ctx context.Context, events []*models.Event
 	return nil
 }
 
-func (agent *PGEvent) FindOneByAccountAndSigHash(ctx context.Context, chainID, address, sighash string, indexedInputCount uint32) (*models.EventModel, error) {
+// FindOneByAccountAndSigHash finds the event of the contract bound to the address, the binding of the most specific
+// tenant of the caller coming first
+func (agent *PGEvent) FindOneByAccountAndSigHash(ctx context.Context, chainID, address, sighash string, indexedInputCount uint32, tenants []string) (*models.EventModel, error) {
 	event := &models.EventModel{}
 	query := agent.db.ModelContext(ctx, event).
 		Column("event_model.abi").
@@ -42,6 +45,8 @@ func (agent *PGEvent) FindOneByAccountAndSigHash(ctx context.Context, chainID, a
 		Where("c.address = ?", address).
 		Where("event_model.sig_hash = ?", sighash).
 		Where("event_model.indexed_input_count = ?", indexedInputCount)
+	query = pg.WhereAllowedTenants(query, "c.tenant_id", tenants).
+		OrderExpr("array_position(?::text[], c.tenant_id) DESC NULLS LAST", gopg.Array(tenants))
 
 	err := pg.SelectOne(ctx, query)
 	if err != nil {
@@ -53,12 +58,23 @@ func (agent *PGEvent) FindOneByAccountAndSigHash(ctx context.Context, chainID, a
 
 	return event, nil
 }
-func (agent *PGEvent) FindDefaultBySigHash(ctx context.Context, sighash string, indexedInputCount uint32) ([]*models.EventModel, error) {
+
+// FindDefaultBySigHash finds the events matching the signature among the contracts the caller is allowed to see
+func (agent *PGEvent) FindDefaultBySigHash(ctx context.Context, sighash string, indexedInputCount uint32, tenants []string, ownerID string) ([]*models.EventModel, error) {
+	artifacts := agent.db.ModelContext(ctx, (*models.ArtifactModel)(nil)).
+		ColumnExpr("1").
+		Join("JOIN tags AS t ON t.artifact_id = artifact_model.id").
+		Join("JOIN repositories AS r ON r.id = t.repository_id").
+		Where("artifact_model.codehash = event_model.codehash")
+	artifacts = pg.WhereAllowedTenants(artifacts, "r.tenant_id", tenants)
+	artifacts = pg.WhereAllowedOwner(artifacts, "r.owner_id", ownerID)
+
 	var defaultEvents []*models.EventModel
 	query := agent.db.ModelContext(ctx, &defaultEvents).
 		ColumnExpr("DISTINCT abi").
 		Where("sig_hash = ?", sighash).
 		Where("indexed_input_count = ?", indexedInputCount).
+		Where("EXISTS (?)", artifacts).
 		Order("abi DESC")
 
 	err := pg.Select(ctx, query)
@@ -71,3 +87,22 @@ func (agent *PGEvent) FindDefaultBySigHash(ctx context.Context, sighash string,
 
 	return defaultEvents, nil
 }
+
+// DeleteUnused deletes the events of the given code hashes which do not belong to any artifact anymore
+func (agent *PGEvent) DeleteUnused(ctx context.Context, codeHashes []string) error {
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	query := agent.db.ModelContext(ctx, (*models.EventModel)(nil)).
+		Where("event_model.codehash IN (?)", gopg.In(codeHashes)).
+		Where("NOT EXISTS (SELECT 1 FROM artifacts WHERE artifacts.codehash = event_model.codehash)")
+
+	err := pg.Delete(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete unused events")
+		return errors.FromError(err).ExtendComponent(eventDAComponent)
+	}
+
+	return nil
+}
@@ -119,18 +119,26 @@ func (s *eventTestSuite) TestPGEvent_InsertMultipleTx() {
 
 func (s *eventTestSuite) TestPGEvent_FindOneByAccountAndSigHash() {
 	ctx := context.Background()
+	tenants := []string{"_", "tenantOne"}
 
 	s.T().Run("should find one event successfully", func(t *testing.T) {
 		s.insertEvents(ctx)
 
-		event, err := s.agents.Event().FindOneByAccountAndSigHash(ctx, chainID, address, sigHash, 0)
+		event, err := s.agents.Event().FindOneByAccountAndSigHash(ctx, chainID, address, sigHash, 0, tenants)
 
 		assert.NoError(t, err)
 		assert.Equal(t, event.ABI, "ABI")
 	})
 
+	s.T().Run("should return NotFoundError if the address is bound by another tenant", func(t *testing.T) {
+		s.insertEvents(ctx)
+
+		_, err := s.agents.Event().FindOneByAccountAndSigHash(ctx, chainID, "address1", "sigHash1", 1, []string{"_", "tenantTwo"})
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
 	s.T().Run("should return NotFoundError if no event is found", func(t *testing.T) {
-		_, err := s.agents.Event().FindOneByAccountAndSigHash(ctx, "unknown", "unknown", "unknown", 0)
+		_, err := s.agents.Event().FindOneByAccountAndSigHash(ctx, "unknown", "unknown", "unknown", 0, tenants)
 		assert.True(t, errors.IsNotFoundError(err))
 	})
 
@@ -138,7 +146,7 @@ func (s *eventTestSuite) TestPGEvent_FindOneByAccountAndSigHash() {
 		// We drop the DB to make the test fail
 		s.pg.DropTestDB(t)
 
-		_, err := s.agents.Event().FindOneByAccountAndSigHash(ctx, chainID, address, sigHash, 0)
+		_, err := s.agents.Event().FindOneByAccountAndSigHash(ctx, chainID, address, sigHash, 0, tenants)
 		assert.True(t, errors.IsInternalError(err))
 
 		// We bring it back up
@@ -150,43 +158,66 @@ func (s *eventTestSuite) TestPGEvent_FindDefaultBySigHash() {
 	ctx := context.Background()
 	s.T().Run("should find default events successfully", func(t *testing.T) {
 		s.insertEvents(ctx)
-	
-		defaultEvents, err := s.agents.Event().FindDefaultBySigHash(ctx, sigHash, 0)
-	
+
+		defaultEvents, err := s.agents.Event().FindDefaultBySigHash(ctx, sigHash, 0, []string{"_"}, "")
+
 		assert.NoError(t, err)
 		assert.Len(t, defaultEvents, 1)
 	})
 
+	s.T().Run("should not find default events of contracts of other tenants", func(t *testing.T) {
+		s.insertEvents(ctx)
+
+		defaultEvents, err := s.agents.Event().FindDefaultBySigHash(ctx, "sigHash1", 1, []string{"_", "tenantTwo"}, "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, defaultEvents)
+	})
+
 	s.T().Run("should return NotFoundError if no default events are found", func(t *testing.T) {
-		defaultEvents, err := s.agents.Event().FindDefaultBySigHash(ctx, "unknown", 0)
+		defaultEvents, err := s.agents.Event().FindDefaultBySigHash(ctx, "unknown", 0, []string{"_"}, "")
 		assert.NoError(t, err)
 		assert.Empty(t, defaultEvents)
 	})
 
 	s.T().Run("should return PostgresConnectionError if find fails", func(t *testing.T) {
 		s.pg.DropTestDB(t)
-	
-		_, err := s.agents.Event().FindDefaultBySigHash(ctx, sigHash, 0)
+
+		_, err := s.agents.Event().FindDefaultBySigHash(ctx, sigHash, 0, []string{"_"}, "")
 		assert.Error(t, err)
 		assert.True(t, errors.IsInternalError(err))
-	
+
 		s.pg.InitTestDB(t)
 	})
 }
 
+// insertEvents registers a shared contract bound to address and a contract of tenantOne bound to address1
 func (s *eventTestSuite) insertEvents(ctx context.Context) {
 	_ = s.agents.CodeHash().Insert(ctx, &models.CodehashModel{
 		ChainID:  chainID,
 		Address:  address,
 		Codehash: codeHash,
+		TenantID: "_",
 	})
 
 	_ = s.agents.CodeHash().Insert(ctx, &models.CodehashModel{
 		ChainID:  chainID,
 		Address:  "address1",
 		Codehash: "codeHash1",
+		TenantID: "tenantOne",
 	})
 
+	for _, contract := range []struct{ name, tenantID, codeHash string }{
+		{"sharedContract", "_", codeHash},
+		{"tenantContract", "tenantOne", "codeHash1"},
+	} {
+		repo := &models.RepositoryModel{Name: contract.name, TenantID: contract.tenantID}
+		_ = s.agents.Repository().SelectOrInsert(ctx, repo)
+		artifact := &models.ArtifactModel{ABI: "ContractABI", Codehash: contract.codeHash}
+		_ = s.agents.Artifact().SelectOrInsert(ctx, artifact)
+		_ = s.agents.Tag().Insert(ctx, &models.TagModel{Name: "latest", RepositoryID: repo.ID, ArtifactID: artifact.ID})
+	}
+
 	events := []*models.EventModel{
 		{
 			Codehash:          codeHash,
@@ -0,0 +1,110 @@
+package dataagents
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	pg "github.com/consensys/orchestrate/src/infra/database/postgres"
+	gopg "github.com/go-pg/pg/v9"
+	"github.com/gofrs/uuid"
+)
+
+const failedJobDAComponent = "data-agents.failed-job"
+
+// maxFailedJobs is the number of failed jobs returned at once when the search is not paginated
+const maxFailedJobs = 100
+
+var failedJobSortColumns = map[string]string{
+	entities.SortByCreatedAt: "created_at",
+}
+
+// PGFailedJob is a FailedJob data agent for PostgreSQL
+type PGFailedJob struct {
+	db     pg.DB
+	logger *log.Logger
+}
+
+// NewPGFailedJob creates a new PGFailedJob
+func NewPGFailedJob(db pg.DB) store.FailedJobAgent {
+	return &PGFailedJob{db: db, logger: log.NewLogger().SetComponent(failedJobDAComponent)}
+}
+
+// Insert Inserts a new failed job in DB
+func (agent *PGFailedJob) Insert(ctx context.Context, failedJob *models.FailedJob) error {
+	if failedJob.UUID == "" {
+		failedJob.UUID = uuid.Must(uuid.NewV4()).String()
+	}
+
+	err := pg.Insert(ctx, agent.db, failedJob)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to insert failed job")
+		return errors.FromError(err).ExtendComponent(failedJobDAComponent)
+	}
+
+	return nil
+}
+
+func (agent *PGFailedJob) Update(ctx context.Context, failedJob *models.FailedJob) error {
+	query := agent.db.ModelContext(ctx, failedJob).Where("uuid = ?", failedJob.UUID)
+
+	err := pg.Update(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to update failed job")
+		return errors.FromError(err).ExtendComponent(failedJobDAComponent)
+	}
+
+	return nil
+}
+
+// Search returns the failed jobs matching the filters, the oldest ones first when the search is not paginated
+func (agent *PGFailedJob) Search(ctx context.Context, filters *entities.FailedJobFilters, tenants []string, ownerID string) ([]*models.FailedJob, error) {
+	var failedJobs []*models.FailedJob
+
+	query := agent.db.ModelContext(ctx, &failedJobs)
+	if len(filters.JobUUIDs) > 0 {
+		query = query.Where("job_uuid in (?)", gopg.In(filters.JobUUIDs))
+	}
+	if filters.ChainUUID != "" {
+		query = query.Where("chain_uuid = ?", filters.ChainUUID)
+	}
+	if filters.ErrorCode != "" {
+		query = query.Where("error_code = ?", filters.ErrorCode)
+	}
+	if filters.ErrorClass != "" {
+		query = query.Where("error_class = ?", filters.ErrorClass)
+	}
+	if !filters.CreatedAfter.IsZero() {
+		query = query.Where("created_at >= ?", filters.CreatedAfter)
+	}
+	if !filters.CreatedBefore.IsZero() {
+		query = query.Where("created_at < ?", filters.CreatedBefore)
+	}
+	if filters.OnlyPending {
+		query = query.Where("replayed_at IS NULL")
+	}
+
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants)
+	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
+	if filters.Pagination == (entities.Pagination{}) {
+		query = query.Limit(maxFailedJobs)
+	}
+
+	query, err := paginate(query, &filters.Pagination, "created_at ASC", "uuid", failedJobSortColumns)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(failedJobDAComponent)
+	}
+
+	err = pg.Select(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to search failed jobs")
+		}
+		return nil, errors.FromError(err).ExtendComponent(failedJobDAComponent)
+	}
+
+	return failedJobs, nil
+}
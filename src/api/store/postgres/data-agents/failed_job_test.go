@@ -0,0 +1,118 @@
+// +build unit
+// +build !race
+// +build !integration
+
+package dataagents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/orchestrate/src/api/store/postgres/migrations"
+	pgTestUtils "github.com/consensys/orchestrate/src/infra/database/postgres/testutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type failedJobTestSuite struct {
+	suite.Suite
+	agents *PGAgents
+	pg     *pgTestUtils.PGTestHelper
+}
+
+func TestPGFailedJob(t *testing.T) {
+	s := new(failedJobTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *failedJobTestSuite) SetupSuite() {
+	s.pg, _ = pgTestUtils.NewPGTestHelper(nil, migrations.Collection)
+	s.pg.InitTestDB(s.T())
+}
+
+func (s *failedJobTestSuite) SetupTest() {
+	s.pg.UpgradeTestDB(s.T())
+	s.agents = New(s.pg.DB)
+}
+
+func (s *failedJobTestSuite) TearDownTest() {
+	s.pg.DowngradeTestDB(s.T())
+}
+
+func (s *failedJobTestSuite) TearDownSuite() {
+	s.pg.DropTestDB(s.T())
+}
+
+func (s *failedJobTestSuite) TestPGFailedJob_InsertAndSearch() {
+	ctx := context.Background()
+	chainUUID := uuid.Must(uuid.NewV4()).String()
+
+	connectionFailure := testdata.FakeFailedJobModel(uuid.Must(uuid.NewV4()).String(), chainUUID, "tenantOne")
+	err := s.agents.FailedJob().Insert(ctx, connectionFailure)
+	require.NoError(s.T(), err)
+
+	nonceFailure := testdata.FakeFailedJobModel(uuid.Must(uuid.NewV4()).String(), chainUUID, "tenantTwo")
+	nonceFailure.ErrorCode = "01300"
+	nonceFailure.ErrorClass = string(entities.FailedJobInvalidNonceError)
+	err = s.agents.FailedJob().Insert(ctx, nonceFailure)
+	require.NoError(s.T(), err)
+
+	s.T().Run("should find all failed jobs in chronological order", func(t *testing.T) {
+		failedJobs, err := s.agents.FailedJob().Search(ctx, &entities.FailedJobFilters{}, []string{multitenancy.WildcardTenant}, "")
+
+		assert.NoError(t, err)
+		require.Len(t, failedJobs, 2)
+		assert.Equal(t, connectionFailure.UUID, failedJobs[0].UUID)
+		assert.Equal(t, nonceFailure.UUID, failedJobs[1].UUID)
+	})
+
+	s.T().Run("should find failed jobs by error code and tenant", func(t *testing.T) {
+		failedJobs, err := s.agents.FailedJob().Search(ctx, &entities.FailedJobFilters{
+			ChainUUID: chainUUID,
+			ErrorCode: "01300",
+		}, []string{"tenantTwo"}, "")
+
+		assert.NoError(t, err)
+		require.Len(t, failedJobs, 1)
+		assert.Equal(t, nonceFailure.JobUUID, failedJobs[0].JobUUID)
+
+		failedJobs, err = s.agents.FailedJob().Search(ctx, &entities.FailedJobFilters{ErrorCode: "01300"}, []string{"tenantOne"}, "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, failedJobs)
+	})
+
+	s.T().Run("should find failed jobs by time range", func(t *testing.T) {
+		failedJobs, err := s.agents.FailedJob().Search(ctx, &entities.FailedJobFilters{
+			CreatedAfter: time.Now().Add(time.Hour),
+		}, []string{multitenancy.WildcardTenant}, "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, failedJobs)
+	})
+
+	s.T().Run("should exclude replayed jobs when searching pending ones", func(t *testing.T) {
+		replayedAt := time.Now().UTC()
+		connectionFailure.ReplayedAt = &replayedAt
+		connectionFailure.ReplayCount = 1
+		err := s.agents.FailedJob().Update(ctx, connectionFailure)
+		require.NoError(t, err)
+
+		failedJobs, err := s.agents.FailedJob().Search(ctx, &entities.FailedJobFilters{
+			JobUUIDs:    []string{connectionFailure.JobUUID, nonceFailure.JobUUID},
+			OnlyPending: true,
+		}, []string{multitenancy.WildcardTenant}, "")
+
+		assert.NoError(t, err)
+		require.Len(t, failedJobs, 1)
+		assert.Equal(t, nonceFailure.UUID, failedJobs[0].UUID)
+	})
+}
+
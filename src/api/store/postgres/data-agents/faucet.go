@@ -12,11 +12,19 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	pg "github.com/consensys/orchestrate/src/infra/database/postgres"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/gofrs/uuid"
 )
 
 const faucetDAComponent = "data-agents.faucet"
 
+var nativeCurrencyTokenAddress = ethcommon.Address{}.Hex()
+
+var faucetSortColumns = map[string]string{
+	entities.SortByCreatedAt: "created_at",
+	entities.SortByUpdatedAt: "updated_at",
+}
+
 // PGFaucet is a Faucet data agent for PostgreSQL
 type PGFaucet struct {
 	db     pg.DB
@@ -75,9 +83,14 @@ func (agent *PGFaucet) Search(ctx context.Context, filters *entities.FaucetFilte
 		query = query.Where("chain_rule = ?", filters.ChainRule)
 	}
 
-	query = pg.WhereAllowedTenants(query, "tenant_id", tenants).Order("created_at ASC")
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants)
 
-	err := pg.Select(ctx, query)
+	query, err := paginate(query, &filters.Pagination, "created_at ASC", "uuid", faucetSortColumns)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(faucetDAComponent)
+	}
+
+	err = pg.Select(ctx, query)
 	if err != nil {
 		if !errors.IsNotFoundError(err) {
 			agent.logger.WithContext(ctx).WithError(err).Error("failed to search faucet")
@@ -92,6 +105,10 @@ func (agent *PGFaucet) Update(ctx context.Context, faucet *models.Faucet, tenant
 	faucet.UpdatedAt = time.Now().UTC()
 	query := agent.db.ModelContext(ctx, faucet).Where("uuid = ?", faucet.UUID)
 	query = pg.WhereAllowedTenantsDefault(query, tenants)
+	// Zero fields are not updated, the zero address sets the faucet back to the native currency
+	if faucet.TokenAddress == nativeCurrencyTokenAddress {
+		query = query.Value("token_address", "NULL")
+	}
 
 	err := pg.Update(ctx, query)
 	if err != nil {
@@ -114,3 +131,17 @@ func (agent *PGFaucet) Delete(ctx context.Context, faucet *models.Faucet, tenant
 
 	return nil
 }
+
+// LockOneByUUID locks a faucet row until the end of the current transaction
+func (agent *PGFaucet) LockOneByUUID(ctx context.Context, faucetUUID string) error {
+	query := agent.db.ModelContext(ctx, &models.Faucet{}).Where("uuid = ?", faucetUUID).For("UPDATE")
+	err := pg.Select(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to lock faucet by uuid")
+		}
+		return errors.FromError(err).ExtendComponent(faucetDAComponent)
+	}
+
+	return nil
+}
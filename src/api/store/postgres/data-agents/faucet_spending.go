@@ -0,0 +1,147 @@
+package dataagents
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	pg "github.com/consensys/orchestrate/src/infra/database/postgres"
+	gopg "github.com/go-pg/pg/v9"
+	"github.com/gofrs/uuid"
+)
+
+const faucetSpendingDAComponent = "data-agents.faucet-spending"
+
+// creditedSpendingCondition excludes the spendings released by their funding job: failed, expired and skipped jobs, and
+// jobs never mined unless one of their retries was mined. Spendings of a funding job not created yet still count
+const creditedSpendingCondition = `NOT EXISTS (
+	SELECT 1 FROM jobs AS job
+	WHERE job.uuid = faucet_spending.job_uuid
+		AND job.status IN (?)
+		AND NOT EXISTS (
+			SELECT 1 FROM jobs AS child
+			WHERE child.internal_data->>'parentJobUUID' = job.uuid::text AND child.status = ?
+		)
+)`
+
+var releasedSpendingJobStatuses = []entities.JobStatus{
+	entities.StatusFailed,
+	entities.StatusExpired,
+	entities.StatusSkipped,
+	entities.StatusNeverMined,
+}
+
+// PGFaucetSpending is a FaucetSpending data agent for PostgreSQL
+type PGFaucetSpending struct {
+	db     pg.DB
+	logger *log.Logger
+}
+
+// NewPGFaucetSpending creates a new PGFaucetSpending
+func NewPGFaucetSpending(db pg.DB) store.FaucetSpendingAgent {
+	return &PGFaucetSpending{db: db, logger: log.NewLogger().SetComponent(faucetSpendingDAComponent)}
+}
+
+// Insert Inserts a new faucet spending in DB
+func (agent *PGFaucetSpending) Insert(ctx context.Context, spending *models.FaucetSpending) error {
+	if spending.UUID == "" {
+		spending.UUID = uuid.Must(uuid.NewV4()).String()
+	}
+
+	err := pg.Insert(ctx, agent.db, spending)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to insert faucet spending")
+		return errors.FromError(err).ExtendComponent(faucetSpendingDAComponent)
+	}
+
+	return nil
+}
+
+// FindLastByBeneficiary finds the last credit of a faucet to a beneficiary, ignoring the released spendings
+func (agent *PGFaucetSpending) FindLastByBeneficiary(ctx context.Context, faucetUUID, beneficiary string) (*models.FaucetSpending, error) {
+	spending := &models.FaucetSpending{}
+
+	query := agent.db.ModelContext(ctx, spending).
+		Where("faucet_uuid = ?", faucetUUID).
+		Where("beneficiary = ?", beneficiary).
+		Where(creditedSpendingCondition, gopg.In(releasedSpendingJobStatuses), entities.StatusMined).
+		Order("created_at DESC").
+		Limit(1)
+
+	err := pg.SelectOne(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to find last faucet spending")
+		}
+		return nil, errors.FromError(err).ExtendComponent(faucetSpendingDAComponent)
+	}
+
+	return spending, nil
+}
+
+// SumSince returns the total amount credited by a faucet since the given time, to the beneficiaries of the given tenant
+// if not empty. Released spendings are ignored
+func (agent *PGFaucetSpending) SumSince(ctx context.Context, faucetUUID, tenantID string, since time.Time) (*big.Int, error) {
+	var total string
+
+	query := agent.db.ModelContext(ctx, (*models.FaucetSpending)(nil)).
+		ColumnExpr("COALESCE(SUM(amount), 0)::text").
+		Where("faucet_uuid = ?", faucetUUID).
+		Where("created_at >= ?", since).
+		Where(creditedSpendingCondition, gopg.In(releasedSpendingJobStatuses), entities.StatusMined)
+
+	if tenantID != "" {
+		query = query.Where("tenant_id = ?", tenantID)
+	}
+
+	err := pg.SelectColumn(ctx, query, &total)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to sum faucet spendings")
+		return nil, errors.FromError(err).ExtendComponent(faucetSpendingDAComponent)
+	}
+
+	sum, ok := new(big.Int).SetString(total, 10)
+	if !ok {
+		return nil, errors.DataCorruptedError("invalid faucet spending total %s", total).ExtendComponent(faucetSpendingDAComponent)
+	}
+
+	return sum, nil
+}
+
+// Search returns the most recent credits of a faucet
+func (agent *PGFaucetSpending) Search(ctx context.Context, faucetUUID string, limit int) ([]*models.FaucetSpending, error) {
+	var spendings []*models.FaucetSpending
+
+	query := agent.db.ModelContext(ctx, &spendings).
+		Where("faucet_uuid = ?", faucetUUID).
+		Order("created_at DESC").
+		Limit(limit)
+
+	err := pg.Select(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to search faucet spendings")
+		}
+		return nil, errors.FromError(err).ExtendComponent(faucetSpendingDAComponent)
+	}
+
+	return spendings, nil
+}
+
+// DeleteByJobUUID deletes the spending of a funding job which could not be created
+func (agent *PGFaucetSpending) DeleteByJobUUID(ctx context.Context, jobUUID string) error {
+	query := agent.db.ModelContext(ctx, (*models.FaucetSpending)(nil)).Where("job_uuid = ?", jobUUID)
+
+	err := pg.Delete(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete faucet spending")
+		return errors.FromError(err).ExtendComponent(faucetSpendingDAComponent)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// +build unit
+// +build !race
+// +build !integration
+
+package dataagents
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/orchestrate/src/api/store/postgres/migrations"
+	pgTestUtils "github.com/consensys/orchestrate/src/infra/database/postgres/testutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type faucetSpendingTestSuite struct {
+	suite.Suite
+	agents *PGAgents
+	pg     *pgTestUtils.PGTestHelper
+}
+
+func TestPGFaucetSpending(t *testing.T) {
+	s := new(faucetSpendingTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *faucetSpendingTestSuite) SetupSuite() {
+	s.pg, _ = pgTestUtils.NewPGTestHelper(nil, migrations.Collection)
+	s.pg.InitTestDB(s.T())
+}
+
+func (s *faucetSpendingTestSuite) SetupTest() {
+	s.pg.UpgradeTestDB(s.T())
+	s.agents = New(s.pg.DB)
+}
+
+func (s *faucetSpendingTestSuite) TearDownTest() {
+	s.pg.DowngradeTestDB(s.T())
+}
+
+func (s *faucetSpendingTestSuite) TearDownSuite() {
+	s.pg.DropTestDB(s.T())
+}
+
+func (s *faucetSpendingTestSuite) TestPGFaucetSpending() {
+	ctx := context.Background()
+	beneficiary := "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+
+	faucet := testdata.FakeFaucetModel()
+	faucet.TenantID = "tenantID"
+	err := s.agents.Faucet().Insert(ctx, faucet)
+	require.NoError(s.T(), err)
+
+	for _, amount := range []string{"100", "200"} {
+		err = s.agents.FaucetSpending().Insert(ctx, &models.FaucetSpending{
+			FaucetUUID:  faucet.UUID,
+			TenantID:    faucet.TenantID,
+			Beneficiary: beneficiary,
+			Amount:      amount,
+		})
+		require.NoError(s.T(), err)
+	}
+
+	s.T().Run("should find the last spending of the beneficiary", func(t *testing.T) {
+		spending, err := s.agents.FaucetSpending().FindLastByBeneficiary(ctx, faucet.UUID, beneficiary)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "200", spending.Amount)
+	})
+
+	s.T().Run("should return NotFoundError if the beneficiary was never credited", func(t *testing.T) {
+		_, err := s.agents.FaucetSpending().FindLastByBeneficiary(ctx, faucet.UUID, "0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	s.T().Run("should sum the spendings since the given time", func(t *testing.T) {
+		total, err := s.agents.FaucetSpending().SumSince(ctx, faucet.UUID, "", time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewInt(300), total)
+
+		total, err = s.agents.FaucetSpending().SumSince(ctx, faucet.UUID, faucet.TenantID, time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewInt(300), total)
+
+		total, err = s.agents.FaucetSpending().SumSince(ctx, faucet.UUID, "otherTenant", time.Now().Add(-time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewInt(0), total)
+
+		total, err = s.agents.FaucetSpending().SumSince(ctx, faucet.UUID, "", time.Now().Add(time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewInt(0), total)
+	})
+
+	s.T().Run("should search the most recent spendings", func(t *testing.T) {
+		spendings, err := s.agents.FaucetSpending().Search(ctx, faucet.UUID, 1)
+
+		assert.NoError(t, err)
+		require.Len(t, spendings, 1)
+		assert.Equal(t, "200", spendings[0].Amount)
+	})
+
+	s.T().Run("should lock the faucet", func(t *testing.T) {
+		err := s.agents.Faucet().LockOneByUUID(ctx, faucet.UUID)
+
+		assert.NoError(t, err)
+	})
+}
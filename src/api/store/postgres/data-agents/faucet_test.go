@@ -10,6 +10,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/api/store/models"
 	"github.com/consensys/orchestrate/src/api/store/models/testdata"
 	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
@@ -108,6 +109,22 @@ func (s *faucetTestSuite) TestPGFaucet_Update() {
 		faucetRetrieved, _ := s.agents.Faucet().FindOneByUUID(ctx, faucet.UUID, s.allowedTenants)
 		assert.Equal(t, newFaucet.ChainRule, faucetRetrieved.ChainRule)
 	})
+
+	s.T().Run("should set the faucet back to the native currency with the zero address", func(t *testing.T) {
+		tokenFaucet := testdata.FakeFaucetModel()
+		tokenFaucet.UUID = faucet.UUID
+		tokenFaucet.TokenAddress = "0x6230592812dE2E256D1512504c3E8A3C49975f07"
+		err = s.agents.Faucet().Update(ctx, tokenFaucet, s.allowedTenants)
+		assert.NoError(t, err)
+
+		nativeFaucet := &models.Faucet{UUID: faucet.UUID, TokenAddress: "0x0000000000000000000000000000000000000000"}
+		err = s.agents.Faucet().Update(ctx, nativeFaucet, s.allowedTenants)
+		assert.NoError(t, err)
+
+		faucetRetrieved, _ := s.agents.Faucet().FindOneByUUID(ctx, faucet.UUID, s.allowedTenants)
+		assert.Empty(t, faucetRetrieved.TokenAddress)
+		assert.Equal(t, tokenFaucet.ChainRule, faucetRetrieved.ChainRule)
+	})
 }
 
 func (s *faucetTestSuite) TestPGFaucet_FindOneByUUID() {
@@ -20,6 +20,11 @@ import (
 
 const jobDAComponent = "data-agents.job"
 
+var jobSortColumns = map[string]string{
+	entities.SortByCreatedAt: "job.created_at",
+	entities.SortByUpdatedAt: "job.updated_at",
+}
+
 // PGJob is a job data agent for PostgreSQL
 type PGJob struct {
 	db     pg.DB
@@ -164,10 +169,15 @@ func (agent *PGJob) Search(ctx context.Context, filters *entities.JobFilters, te
 		query = query.Where("job.updated_at >= ?", filters.UpdatedAfter)
 	}
 
-	query = pg.WhereAllowedTenants(query, "schedule.tenant_id", tenants).Order("id ASC")
+	query = pg.WhereAllowedTenants(query, "schedule.tenant_id", tenants)
 	query = pg.WhereAllowedOwner(query, "schedule.owner_id", ownerID)
 
-	err := pg.Select(ctx, query)
+	query, err := paginate(query, &filters.Pagination, "id ASC", "job.uuid", jobSortColumns)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(jobDAComponent)
+	}
+
+	err = pg.Select(ctx, query)
 	if err != nil {
 		if !errors.IsNotFoundError(err) {
 			agent.logger.WithError(err).Error("failed to search jobs")
@@ -0,0 +1,59 @@
+package dataagents
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	pg "github.com/consensys/orchestrate/src/infra/database/postgres"
+	"github.com/gofrs/uuid"
+)
+
+const jobApprovalDAComponent = "data-agents.job-approval"
+
+// PGJobApproval is a JobApproval data agent for PostgreSQL
+type PGJobApproval struct {
+	db     pg.DB
+	logger *log.Logger
+}
+
+// NewPGJobApproval creates a new PGJobApproval
+func NewPGJobApproval(db pg.DB) store.JobApprovalAgent {
+	return &PGJobApproval{db: db, logger: log.NewLogger().SetComponent(jobApprovalDAComponent)}
+}
+
+// Insert Inserts a new job approval in DB
+func (agent *PGJobApproval) Insert(ctx context.Context, approval *models.JobApproval) error {
+	if approval.UUID == "" {
+		approval.UUID = uuid.Must(uuid.NewV4()).String()
+	}
+
+	err := pg.Insert(ctx, agent.db, approval)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to insert job approval")
+		return errors.FromError(err).ExtendComponent(jobApprovalDAComponent)
+	}
+
+	return nil
+}
+
+// FindAllByJobUUID returns the approvals of a job in chronological order
+func (agent *PGJobApproval) FindAllByJobUUID(ctx context.Context, jobUUID string) ([]*models.JobApproval, error) {
+	var approvals []*models.JobApproval
+
+	query := agent.db.ModelContext(ctx, &approvals).
+		Where("job_uuid = ?", jobUUID).
+		Order("created_at ASC")
+
+	err := pg.Select(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to find job approvals")
+		}
+		return nil, errors.FromError(err).ExtendComponent(jobApprovalDAComponent)
+	}
+
+	return approvals, nil
+}
@@ -0,0 +1,92 @@
+// +build unit
+// +build !race
+// +build !integration
+
+package dataagents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/orchestrate/src/api/store/postgres/migrations"
+	pgTestUtils "github.com/consensys/orchestrate/src/infra/database/postgres/testutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type jobApprovalTestSuite struct {
+	suite.Suite
+	agents *PGAgents
+	pg     *pgTestUtils.PGTestHelper
+}
+
+func TestPGJobApproval(t *testing.T) {
+	s := new(jobApprovalTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *jobApprovalTestSuite) SetupSuite() {
+	s.pg, _ = pgTestUtils.NewPGTestHelper(nil, migrations.Collection)
+	s.pg.InitTestDB(s.T())
+}
+
+func (s *jobApprovalTestSuite) SetupTest() {
+	s.pg.UpgradeTestDB(s.T())
+	s.agents = New(s.pg.DB)
+}
+
+func (s *jobApprovalTestSuite) TearDownTest() {
+	s.pg.DowngradeTestDB(s.T())
+}
+
+func (s *jobApprovalTestSuite) TearDownSuite() {
+	s.pg.DropTestDB(s.T())
+}
+
+func (s *jobApprovalTestSuite) TestPGJobApproval_InsertAndFindAllByJobUUID() {
+	ctx := context.Background()
+	jobUUID := uuid.Must(uuid.NewV4()).String()
+
+	for _, username := range []string{"alice", "bob"} {
+		err := s.agents.JobApproval().Insert(ctx, &models.JobApproval{
+			JobUUID:  jobUUID,
+			TenantID: "tenantID",
+			Username: username,
+			Decision: string(entities.ApprovalDecisionApproved),
+		})
+		require.NoError(s.T(), err)
+	}
+
+	s.T().Run("should find approvals of the job in chronological order", func(t *testing.T) {
+		approvals, err := s.agents.JobApproval().FindAllByJobUUID(ctx, jobUUID)
+
+		assert.NoError(t, err)
+		require.Len(t, approvals, 2)
+		assert.Equal(t, "alice", approvals[0].Username)
+		assert.Equal(t, "bob", approvals[1].Username)
+		assert.NotEmpty(t, approvals[0].UUID)
+	})
+
+	s.T().Run("should fail if the user already decided on the job", func(t *testing.T) {
+		err := s.agents.JobApproval().Insert(ctx, &models.JobApproval{
+			JobUUID:  jobUUID,
+			TenantID: "tenantID",
+			Username: "alice",
+			Decision: string(entities.ApprovalDecisionRejected),
+		})
+
+		assert.Error(t, err)
+	})
+
+	s.T().Run("should return empty list if the job has no approval", func(t *testing.T) {
+		approvals, err := s.agents.JobApproval().FindAllByJobUUID(ctx, uuid.Must(uuid.NewV4()).String())
+
+		assert.NoError(t, err)
+		assert.Empty(t, approvals)
+	})
+}
@@ -0,0 +1,99 @@
+package dataagents
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/parsers"
+	"github.com/consensys/orchestrate/src/entities"
+	pg "github.com/consensys/orchestrate/src/infra/database/postgres"
+	gopg "github.com/go-pg/pg/v9"
+)
+
+const jobEventDAComponent = "data-agents.job-event"
+
+// jobEventsChannel is the Postgres notification channel shared by all the API instances
+const jobEventsChannel = "job_events"
+
+// PGJobEvent is a JobEvent data agent for PostgreSQL, events are sent with NOTIFY to the instances listening to them.
+// Notifications only carry the UUID of the job log recording the event, as their payload is limited to 8000 bytes
+type PGJobEvent struct {
+	db     pg.DB
+	logger *log.Logger
+}
+
+// NewPGJobEvent creates a new PGJobEvent
+func NewPGJobEvent(db pg.DB) store.JobEventAgent {
+	return &PGJobEvent{db: db, logger: log.NewLogger().SetComponent(jobEventDAComponent)}
+}
+
+// Notify sends the event to the listeners, notifications sent in a transaction are delivered when it is committed
+func (agent *PGJobEvent) Notify(ctx context.Context, event *entities.JobEvent) error {
+	if event.UUID == "" {
+		return errors.InvalidParameterError("job event has no log UUID").ExtendComponent(jobEventDAComponent)
+	}
+
+	_, err := agent.db.ExecContext(ctx, "SELECT pg_notify(?, ?)", jobEventsChannel, event.UUID)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to notify job event")
+		return errors.PostgresConnectionError("failed to notify job event").AppendReason(err.Error()).ExtendComponent(jobEventDAComponent)
+	}
+
+	return nil
+}
+
+// Listen returns a channel of the events notified by every API instance, closed when the context is done
+func (agent *PGJobEvent) Listen(ctx context.Context) (<-chan *entities.JobEvent, error) {
+	db, ok := agent.db.(*gopg.DB)
+	if !ok {
+		return nil, errors.InvalidStateError("cannot listen to job events in a transaction").ExtendComponent(jobEventDAComponent)
+	}
+
+	logger := agent.logger.WithContext(ctx)
+	listener := db.Listen(jobEventsChannel)
+	notifications := listener.Channel()
+	events := make(chan *entities.JobEvent)
+
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+	}()
+
+	go func() {
+		defer close(events)
+		// The notifications channel is closed with the listener
+		for notification := range notifications {
+			event, err := agent.findOneByUUID(ctx, notification.Payload)
+			if err != nil {
+				logger.WithError(err).WithField("event", notification.Payload).Warn("failed to load job event, ignoring")
+				continue
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// findOneByUUID loads the event recorded by the job log with the given UUID
+func (agent *PGJobEvent) findOneByUUID(ctx context.Context, eventUUID string) (*entities.JobEvent, error) {
+	logModel := &models.Log{}
+	query := agent.db.ModelContext(ctx, logModel).
+		Relation("Job").
+		Relation("Job.Schedule").
+		Where("log.uuid = ?", eventUUID)
+
+	err := pg.SelectOne(ctx, query)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(jobEventDAComponent)
+	}
+
+	return parsers.NewJobEventEntityFromModels(logModel), nil
+}
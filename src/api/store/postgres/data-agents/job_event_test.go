@@ -0,0 +1,96 @@
+// +build unit
+// +build !race
+// +build !integration
+
+package dataagents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/api/store/postgres/migrations"
+	"github.com/consensys/orchestrate/src/entities"
+	pgTestUtils "github.com/consensys/orchestrate/src/infra/database/postgres/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type jobEventTestSuite struct {
+	suite.Suite
+	agents *PGAgents
+	pg     *pgTestUtils.PGTestHelper
+}
+
+func TestPGJobEvent(t *testing.T) {
+	s := new(jobEventTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *jobEventTestSuite) SetupSuite() {
+	s.pg, _ = pgTestUtils.NewPGTestHelper(nil, migrations.Collection)
+	s.pg.InitTestDB(s.T())
+}
+
+func (s *jobEventTestSuite) SetupTest() {
+	s.pg.UpgradeTestDB(s.T())
+	s.agents = New(s.pg.DB)
+}
+
+func (s *jobEventTestSuite) TearDownTest() {
+	s.pg.DowngradeTestDB(s.T())
+}
+
+func (s *jobEventTestSuite) TearDownSuite() {
+	s.pg.DropTestDB(s.T())
+}
+
+func (s *jobEventTestSuite) TestPGJobEvent_NotifyAndListen() {
+	s.T().Run("should receive notified events loaded from the job logs", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		events, err := s.agents.JobEvent().Listen(ctx)
+		require.NoError(t, err)
+
+		chain := testdata.FakeChainModel()
+		require.NoError(t, s.agents.Chain().Insert(ctx, chain))
+		job := testdata.FakeJobModel(0)
+		job.ChainUUID = chain.UUID
+		job.Labels = map[string]string{"app": "payments"}
+		require.NoError(t, s.agents.Schedule().Insert(ctx, job.Schedule))
+		require.NoError(t, s.agents.Transaction().Insert(ctx, job.Transaction))
+		require.NoError(t, s.agents.Job().Insert(ctx, job))
+		jobLog := job.Logs[0]
+		jobLog.JobID = &job.ID
+		require.NoError(t, s.agents.Log().Insert(ctx, jobLog))
+
+		event := &entities.JobEvent{UUID: jobLog.UUID}
+		// Notifications sent before the listener is subscribed are lost
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for received := false; !received; {
+			select {
+			case receivedEvent := <-events:
+				assert.Equal(t, jobLog.UUID, receivedEvent.UUID)
+				assert.Equal(t, job.UUID, receivedEvent.JobUUID)
+				assert.Equal(t, job.Schedule.TenantID, receivedEvent.TenantID)
+				assert.Equal(t, job.Labels, receivedEvent.Labels)
+				assert.Equal(t, jobLog.Status, receivedEvent.Status)
+				received = true
+			case <-ticker.C:
+				require.NoError(t, s.agents.JobEvent().Notify(ctx, event))
+			}
+		}
+
+		cancel()
+		for range events {
+		}
+	})
+
+	s.T().Run("should fail to notify events without log UUID", func(t *testing.T) {
+		err := s.agents.JobEvent().Notify(context.Background(), &entities.JobEvent{JobUUID: "jobUUID"})
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
 	pgTestUtils "github.com/consensys/orchestrate/src/infra/database/postgres/testutils"
 	"github.com/consensys/orchestrate/src/api/store/models/testdata"
 	"github.com/consensys/orchestrate/src/api/store/postgres/migrations"
@@ -316,6 +317,27 @@ func (s *jobTestSuite) TestPGJob_Search() {
 		assert.NoError(t, err)
 		assert.Equal(t, len(retrievedJobs), 2)
 	})
+
+	s.T().Run("should paginate models successfully", func(t *testing.T) {
+		filters := &entities.JobFilters{Pagination: entities.Pagination{Limit: 1, Sort: "-created_at"}}
+		firstPage, err := s.agents.Job().Search(ctx, filters, s.allowedTenants, s.username)
+		require.NoError(t, err)
+		require.Len(t, firstPage, 1)
+		assert.Equal(t, job1.UUID, firstPage[0].UUID)
+
+		filters.Next = utils.EncodeCursor(firstPage[0].CreatedAt, firstPage[0].UUID)
+		secondPage, err := s.agents.Job().Search(ctx, filters, s.allowedTenants, s.username)
+		require.NoError(t, err)
+		require.Len(t, secondPage, 1)
+		assert.Equal(t, job0.UUID, secondPage[0].UUID)
+	})
+
+	s.T().Run("should fail with InvalidParameterError if cursor is invalid", func(t *testing.T) {
+		filters := &entities.JobFilters{Pagination: entities.Pagination{Next: "invalid"}}
+		_, err := s.agents.Job().Search(ctx, filters, s.allowedTenants, s.username)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
 }
 
 func (s *jobTestSuite) TestPGJob_ConnectionErr() {
@@ -0,0 +1,54 @@
+package dataagents
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/go-pg/pg/v9/orm"
+)
+
+// paginate orders the query by sort column then by key column, so the cursor of the previous page can be resumed after.
+// Queries without pagination keep the default order of the data agent
+func paginate(query *orm.Query, pagination *entities.Pagination, defaultOrder, keyColumn string,
+	sortColumns map[string]string) (*orm.Query, error) {
+	if *pagination == (entities.Pagination{}) {
+		if defaultOrder != "" {
+			query = query.Order(defaultOrder)
+		}
+		return query, nil
+	}
+
+	sortKey := strings.TrimPrefix(pagination.Sort, "-")
+	if sortKey == "" {
+		sortKey = entities.SortByCreatedAt
+	}
+
+	sortColumn, ok := sortColumns[sortKey]
+	if !ok {
+		return nil, errors.InvalidParameterError("cannot sort by %s", sortKey)
+	}
+
+	direction, operator := "ASC", ">"
+	if strings.HasPrefix(pagination.Sort, "-") {
+		direction, operator = "DESC", "<"
+	}
+
+	if pagination.Next != "" {
+		value, key, err := utils.DecodeCursor(pagination.Next)
+		if err != nil {
+			return nil, err
+		}
+
+		query = query.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", sortColumn, keyColumn, operator), value, key)
+	}
+
+	query = query.Order(fmt.Sprintf("%s %s", sortColumn, direction), fmt.Sprintf("%s %s", keyColumn, direction))
+	if pagination.Limit > 0 {
+		query = query.Limit(pagination.Limit)
+	}
+
+	return query, nil
+}
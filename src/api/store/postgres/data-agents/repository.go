@@ -2,12 +2,15 @@ package dataagents
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/api/store/models"
 	pg "github.com/consensys/orchestrate/src/infra/database/postgres"
+	gopg "github.com/go-pg/pg/v9"
+	"github.com/go-pg/pg/v9/orm"
 )
 
 const repositoryDAComponent = "data-agents.repository"
@@ -22,9 +25,13 @@ func NewPGRepository(db pg.DB) store.RepositoryAgent {
 	return &PGRepository{db: db, logger: log.NewLogger().SetComponent(repositoryDAComponent)}
 }
 
-func (agent *PGRepository) FindOne(ctx context.Context, name string) (*models.RepositoryModel, error) {
+func (agent *PGRepository) FindOne(ctx context.Context, name string, tenants []string, ownerID string) (*models.RepositoryModel, error) {
 	model := &models.RepositoryModel{}
 	query := agent.db.ModelContext(ctx, model).Where("LOWER(name) = LOWER(?)", name)
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants)
+	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
+	query = orderByRepositoryPreference(query, "repository_model", tenants)
+
 	err := pg.SelectOne(ctx, query)
 	if err != nil {
 		if !errors.IsNotFoundError(err) {
@@ -37,7 +44,10 @@ func (agent *PGRepository) FindOne(ctx context.Context, name string) (*models.Re
 }
 
 func (agent *PGRepository) SelectOrInsert(ctx context.Context, repository *models.RepositoryModel) error {
-	q := agent.db.ModelContext(ctx, repository).Column("id").Where("name = ?name").
+	q := agent.db.ModelContext(ctx, repository).Column("id").
+		Where("name = ?name").
+		Where("tenant_id = ?tenant_id").
+		Where("owner_id IS NOT DISTINCT FROM ?owner_id").
 		OnConflict("DO NOTHING").Returning("id")
 
 	err := pg.SelectOrInsert(ctx, q)
@@ -59,11 +69,24 @@ func (agent *PGRepository) Insert(ctx context.Context, repository *models.Reposi
 	return nil
 }
 
-func (agent *PGRepository) FindAll(ctx context.Context) ([]string, error) {
+func (agent *PGRepository) Delete(ctx context.Context, repository *models.RepositoryModel) error {
+	err := pg.Delete(ctx, agent.db.ModelContext(ctx, repository).WherePK())
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete repository")
+		return errors.FromError(err).ExtendComponent(repositoryDAComponent)
+	}
+
+	return nil
+}
+
+func (agent *PGRepository) FindAll(ctx context.Context, tenants []string, ownerID string) ([]string, error) {
 	var names []string
 	query := agent.db.ModelContext(ctx, (*models.RepositoryModel)(nil)).
 		Column("name").
+		Group("name").
 		OrderExpr("lower(name)")
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants)
+	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
 
 	err := pg.SelectColumn(ctx, query, &names)
 	if err != nil {
@@ -75,3 +98,11 @@ func (agent *PGRepository) FindAll(ctx context.Context) ([]string, error) {
 
 	return names, nil
 }
+
+// orderByRepositoryPreference sorts the repositories from the most specific tenant of the caller to the default one,
+// the repositories owned by the caller coming first within a tenant
+func orderByRepositoryPreference(query *orm.Query, alias string, tenants []string) *orm.Query {
+	return query.
+		OrderExpr(fmt.Sprintf("array_position(?::text[], %s.tenant_id) DESC NULLS LAST", alias), gopg.Array(tenants)).
+		OrderExpr(fmt.Sprintf("%s.owner_id IS NULL", alias))
+}
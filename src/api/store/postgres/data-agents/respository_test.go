@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/infra/database/postgres"
 	pgTestUtils "github.com/consensys/orchestrate/src/infra/database/postgres/testutils"
 	"github.com/consensys/orchestrate/src/api/store/models"
@@ -104,14 +105,14 @@ func (s *repositoryTestSuite) TestPGRepository_FindOneByName() {
 	ctx := context.Background()
 
 	s.T().Run("should return NotFoundError if none is found", func(t *testing.T) {
-		_, err := s.agents.Repository().FindOne(ctx, "unknown")
+		_, err := s.agents.Repository().FindOne(ctx, "unknown", []string{multitenancy.DefaultTenant}, "")
 		assert.True(t, errors.IsNotFoundError(err))
 	})
 
 	s.T().Run("should find successfully", func(t *testing.T) {
 		s.insertRepo(ctx, 1)
 
-		artifact, err := s.agents.Repository().FindOne(ctx, "myRepository_0")
+		artifact, err := s.agents.Repository().FindOne(ctx, "myRepository_0", []string{multitenancy.DefaultTenant}, "")
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, artifact.ID)
@@ -120,7 +121,7 @@ func (s *repositoryTestSuite) TestPGRepository_FindOneByName() {
 	s.T().Run("should return PostgresConnectionError if select fails", func(t *testing.T) {
 		// We drop the DB to make the test fail
 		s.pg.DropTestDB(t)
-		_, err := s.agents.Repository().FindOne(ctx,  "respository")
+		_, err := s.agents.Repository().FindOne(ctx, "respository", []string{multitenancy.DefaultTenant}, "")
 
 		assert.True(t, errors.IsInternalError(err))
 
@@ -134,16 +135,29 @@ func (s *repositoryTestSuite) TestPGRepository_FindAll() {
 	s.T().Run("should find all successfully", func(t *testing.T) {
 		s.insertRepo(ctx, 5)
 
-		names, err := s.agents.Repository().FindAll(ctx)
+		names, err := s.agents.Repository().FindAll(ctx, []string{multitenancy.DefaultTenant}, "")
 
 		assert.Equal(t, 5, len(names))
 		assert.NoError(t, err)
 	})
 
+	s.T().Run("should only find the repositories of the allowed tenants", func(t *testing.T) {
+		_ = s.agents.Repository().Insert(ctx, &models.RepositoryModel{Name: "myRepository_0", TenantID: "tenantOne"})
+		_ = s.agents.Repository().Insert(ctx, &models.RepositoryModel{Name: "myTenantRepository", TenantID: "tenantOne"})
+
+		names, err := s.agents.Repository().FindAll(ctx, []string{"tenantOne"}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"myRepository_0", "myTenantRepository"}, names)
+
+		names, err = s.agents.Repository().FindAll(ctx, []string{multitenancy.DefaultTenant, "tenantOne"}, "")
+		assert.NoError(t, err)
+		assert.Equal(t, 6, len(names))
+	})
+
 	s.T().Run("should return PostgresConnectionError if select fails", func(t *testing.T) {
 		// We drop the DB to make the test fail
 		s.pg.DropTestDB(t)
-		_, err := s.agents.Repository().FindAll(ctx)
+		_, err := s.agents.Repository().FindAll(ctx, []string{multitenancy.DefaultTenant}, "")
 
 		assert.True(t, errors.IsInternalError(err))
 
@@ -151,6 +165,21 @@ func (s *repositoryTestSuite) TestPGRepository_FindAll() {
 	})
 }
 
+func (s *repositoryTestSuite) TestPGRepository_Delete() {
+	ctx := context.Background()
+
+	s.T().Run("should delete repository successfully", func(t *testing.T) {
+		repository := &models.RepositoryModel{Name: "myRepository"}
+		_ = s.agents.Repository().Insert(ctx, repository)
+
+		err := s.agents.Repository().Delete(ctx, repository)
+		assert.NoError(t, err)
+
+		_, err = s.agents.Repository().FindOne(ctx, "myRepository", []string{multitenancy.DefaultTenant}, "")
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
 func (s *repositoryTestSuite) insertRepo(ctx context.Context, number int) {
 	for i := 0; i < number; i++ {
 		repo := &models.RepositoryModel{
@@ -2,10 +2,12 @@ package dataagents
 
 import (
 	"context"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
 	pg "github.com/consensys/orchestrate/src/infra/database/postgres"
 	"github.com/go-pg/pg/v9/orm"
 	"github.com/gofrs/uuid"
@@ -15,6 +17,10 @@ import (
 
 const scheduleDAComponent = "data-agents.schedule"
 
+var scheduleSortColumns = map[string]string{
+	entities.SortByCreatedAt: "schedule.created_at",
+}
+
 // PGSchedule is a schedule data agent for PostgreSQL
 type PGSchedule struct {
 	db     pg.DB
@@ -71,7 +77,7 @@ func (agent *PGSchedule) FindOneByUUID(ctx context.Context, scheduleUUID string,
 }
 
 // Search Finds schedules in DB
-func (agent *PGSchedule) FindAll(ctx context.Context, tenants []string, ownerID string) ([]*models.Schedule, error) {
+func (agent *PGSchedule) Search(ctx context.Context, filters *entities.ScheduleFilters, tenants []string, ownerID string) ([]*models.Schedule, error) {
 	var schedules []*models.Schedule
 
 	query := agent.db.ModelContext(ctx, &schedules).
@@ -82,13 +88,58 @@ func (agent *PGSchedule) FindAll(ctx context.Context, tenants []string, ownerID
 	query = pg.WhereAllowedTenants(query, "schedule.tenant_id", tenants)
 	query = pg.WhereAllowedOwner(query, "owner_id", ownerID)
 
-	err := pg.Select(ctx, query)
+	query, err := paginate(query, &filters.Pagination, "", "schedule.uuid", scheduleSortColumns)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(scheduleDAComponent)
+	}
+
+	err = pg.Select(ctx, query)
 	if err != nil {
 		if !errors.IsNotFoundError(err) {
-			agent.logger.WithContext(ctx).WithError(err).Error("failed to insert all schedules")
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to search schedules")
 		}
 		return nil, errors.FromError(err).ExtendComponent(jobDAComponent)
 	}
 
 	return schedules, nil
 }
+
+// Update updates the next and last run times of a schedule in DB, a nil next run time stops the schedule
+func (agent *PGSchedule) Update(ctx context.Context, schedule *models.Schedule) error {
+	if schedule.ID == 0 {
+		errMsg := "cannot update schedule with missing ID"
+		agent.logger.WithContext(ctx).Error(errMsg)
+		return errors.InvalidArgError(errMsg)
+	}
+
+	_, err := agent.db.ModelContext(ctx, schedule).Column("next_run_at", "last_run_at").WherePK().Update()
+	if err != nil {
+		err = pg.ParsePGError(err)
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to update schedule")
+		return errors.FromError(err).ExtendComponent(scheduleDAComponent)
+	}
+
+	return nil
+}
+
+// LockDue finds and locks the schedules to run before the given time, schedules locked by another transaction are skipped
+func (agent *PGSchedule) LockDue(ctx context.Context, now time.Time, limit int) ([]*models.Schedule, error) {
+	var schedules []*models.Schedule
+
+	query := agent.db.ModelContext(ctx, &schedules).
+		Relation("Jobs", func(q *orm.Query) (*orm.Query, error) {
+			return q.Order("id ASC"), nil
+		}).
+		Where("schedule.next_run_at <= ?", now).
+		Order("schedule.next_run_at ASC").
+		Limit(limit).
+		For("UPDATE OF schedule SKIP LOCKED")
+
+	err := pg.Select(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to lock due schedules")
+		return nil, errors.FromError(err).ExtendComponent(scheduleDAComponent)
+	}
+
+	return schedules, nil
+}
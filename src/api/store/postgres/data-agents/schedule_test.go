@@ -7,13 +7,17 @@ package dataagents
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/entities"
 	pgTestUtils "github.com/consensys/orchestrate/src/infra/database/postgres/testutils"
 	"github.com/consensys/orchestrate/src/api/store/models"
 	"github.com/consensys/orchestrate/src/api/store/models/testdata"
 	"github.com/consensys/orchestrate/src/api/store/postgres/migrations"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -95,7 +99,7 @@ func (s *scheduleTestSuite) TestPGSchedule_FindOneByUUID() {
 	})
 }
 
-func (s *scheduleTestSuite) TestPGSchedule_FindAll() {
+func (s *scheduleTestSuite) TestPGSchedule_Search() {
 	ctx := context.Background()
 	tenantID2 := "tenantID2"
 	schedules := []*models.Schedule{
@@ -111,7 +115,7 @@ func (s *scheduleTestSuite) TestPGSchedule_FindAll() {
 	}
 
 	s.T().Run("should get models successfully as tenant", func(t *testing.T) {
-		schedulesRetrieved, err := s.agents.Schedule().FindAll(ctx, s.allowedTenants, s.username)
+		schedulesRetrieved, err := s.agents.Schedule().Search(ctx, &entities.ScheduleFilters{}, s.allowedTenants, s.username)
 
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(schedulesRetrieved))
@@ -120,13 +124,65 @@ func (s *scheduleTestSuite) TestPGSchedule_FindAll() {
 		}
 	})
 
+	s.T().Run("should get models successfully by page", func(t *testing.T) {
+		filters := &entities.ScheduleFilters{Pagination: entities.Pagination{Limit: 1}}
+		firstPage, err := s.agents.Schedule().Search(ctx, filters, s.allowedTenants, s.username)
+		require.NoError(t, err)
+		require.Len(t, firstPage, 1)
+
+		filters.Next = utils.EncodeCursor(firstPage[0].CreatedAt, firstPage[0].UUID)
+		secondPage, err := s.agents.Schedule().Search(ctx, filters, s.allowedTenants, s.username)
+		require.NoError(t, err)
+		require.Len(t, secondPage, 1)
+		assert.NotEqual(t, firstPage[0].UUID, secondPage[0].UUID)
+	})
+
 	s.T().Run("should return empty array if nothing is found", func(t *testing.T) {
-		schedules, err := s.agents.Schedule().FindAll(ctx, []string{"randomID"}, s.username)
+		schedules, err := s.agents.Schedule().Search(ctx, &entities.ScheduleFilters{}, []string{"randomID"}, s.username)
 		assert.NoError(t, err)
 		assert.Empty(t, schedules)
 	})
 }
 
+func (s *scheduleTestSuite) TestPGSchedule_LockDueAndUpdate() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	past, future := now.Add(-time.Minute), now.Add(time.Hour)
+	dueSchedule := testdata.FakeSchedule(s.tenantID, s.username)
+	dueSchedule.NextRunAt = &past
+	laterSchedule := testdata.FakeSchedule(s.tenantID, s.username)
+	laterSchedule.NextRunAt = &future
+
+	for _, schedule := range []*models.Schedule{dueSchedule, laterSchedule, testdata.FakeSchedule(s.tenantID, s.username)} {
+		err := s.insertSchedule(ctx, s.agents, schedule)
+		assert.NoError(s.T(), err)
+	}
+
+	s.T().Run("should lock due schedules successfully", func(t *testing.T) {
+		schedules, err := s.agents.Schedule().LockDue(ctx, now, 10)
+
+		assert.NoError(t, err)
+		assert.Len(t, schedules, 1)
+		assertEqualSchedule(t, dueSchedule, schedules[0])
+	})
+
+	s.T().Run("should update run times successfully", func(t *testing.T) {
+		dueSchedule.NextRunAt = nil
+		dueSchedule.LastRunAt = &now
+		err := s.agents.Schedule().Update(ctx, dueSchedule)
+		assert.NoError(t, err)
+
+		schedules, err := s.agents.Schedule().LockDue(ctx, now, 10)
+		assert.NoError(t, err)
+		assert.Empty(t, schedules)
+
+		scheduleRetrieved, err := s.agents.Schedule().FindOneByUUID(ctx, dueSchedule.UUID, s.allowedTenants, s.username)
+		assert.NoError(t, err)
+		assert.Nil(t, scheduleRetrieved.NextRunAt)
+		assert.NotNil(t, scheduleRetrieved.LastRunAt)
+	})
+}
+
 func (s *scheduleTestSuite) TestPGSchedule_ConnectionErr() {
 	ctx := context.Background()
 
@@ -145,7 +201,7 @@ func (s *scheduleTestSuite) TestPGSchedule_ConnectionErr() {
 	})
 
 	s.T().Run("should return PostgresConnectionError if FindAll fails", func(t *testing.T) {
-		_, err := s.agents.Schedule().FindAll(ctx, s.allowedTenants, s.username)
+		_, err := s.agents.Schedule().Search(ctx, &entities.ScheduleFilters{}, s.allowedTenants, s.username)
 		assert.True(t, errors.IsInternalError(err))
 	})
 
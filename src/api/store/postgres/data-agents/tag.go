@@ -37,13 +37,17 @@ func (agent *PGTag) Insert(ctx context.Context, tag *models.TagModel) error {
 
 	return nil
 }
-func (agent *PGTag) FindAllByName(ctx context.Context, name string) ([]string, error) {
+
+func (agent *PGTag) FindAllByName(ctx context.Context, name string, tenants []string, ownerID string) ([]string, error) {
 	var tags []string
 	query := agent.db.ModelContext(ctx, (*models.TagModel)(nil)).
 		Column("tag_model.name").
 		Join("JOIN repositories AS registry ON registry.id = tag_model.repository_id").
 		Where("lower(registry.name) = lower(?)", name).
+		Group("tag_model.name").
 		OrderExpr("lower(tag_model.name)")
+	query = pg.WhereAllowedTenants(query, "registry.tenant_id", tenants)
+	query = pg.WhereAllowedOwner(query, "registry.owner_id", ownerID)
 
 	err := pg.SelectColumn(ctx, query, &tags)
 	if err != nil {
@@ -55,3 +59,47 @@ func (agent *PGTag) FindAllByName(ctx context.Context, name string) ([]string, e
 
 	return tags, nil
 }
+
+func (agent *PGTag) FindOneByName(ctx context.Context, repositoryID int, name string) (*models.TagModel, error) {
+	tag := &models.TagModel{}
+	query := agent.db.ModelContext(ctx, tag).
+		Where("repository_id = ?", repositoryID).
+		Where("lower(name) = lower(?)", name)
+
+	err := pg.SelectOne(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to find tag")
+		}
+		return nil, errors.FromError(err).ExtendComponent(tagDAComponent)
+	}
+
+	return tag, nil
+}
+
+func (agent *PGTag) FindAllByRepositoryID(ctx context.Context, repositoryID int) ([]*models.TagModel, error) {
+	var tags []*models.TagModel
+	query := agent.db.ModelContext(ctx, &tags).
+		Where("repository_id = ?", repositoryID).
+		Order("id ASC")
+
+	err := pg.Select(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to find tags")
+		}
+		return nil, errors.FromError(err).ExtendComponent(tagDAComponent)
+	}
+
+	return tags, nil
+}
+
+func (agent *PGTag) Delete(ctx context.Context, tag *models.TagModel) error {
+	err := pg.Delete(ctx, agent.db.ModelContext(ctx, tag).WherePK())
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete tag")
+		return errors.FromError(err).ExtendComponent(tagDAComponent)
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/infra/database/postgres"
 	pgTestUtils "github.com/consensys/orchestrate/src/infra/database/postgres/testutils"
 	"github.com/consensys/orchestrate/src/api/store/models"
@@ -89,7 +90,7 @@ func (s *tagTestSuite) TestPGTag_FindAllByName() {
 	contractName := "myContract"
 
 	s.T().Run("should return NotFoundError if none is found", func(t *testing.T) {
-		result, err := s.agents.Tag().FindAllByName(ctx, contractName)
+		result, err := s.agents.Tag().FindAllByName(ctx, contractName, []string{multitenancy.DefaultTenant}, "")
 		assert.NoError(t, err)
 		assert.Empty(t, result)
 	})
@@ -97,7 +98,7 @@ func (s *tagTestSuite) TestPGTag_FindAllByName() {
 	s.T().Run("should find all successfully", func(t *testing.T) {
 		_, _ = s.insertTag(ctx, contractName, "tag")
 
-		tags, err := s.agents.Tag().FindAllByName(ctx, contractName)
+		tags, err := s.agents.Tag().FindAllByName(ctx, contractName, []string{multitenancy.DefaultTenant}, "")
 
 		assert.Equal(t, 1, len(tags))
 		assert.Equal(t, "tag", tags[0])
@@ -107,7 +108,51 @@ func (s *tagTestSuite) TestPGTag_FindAllByName() {
 	s.T().Run("should return PostgresConnectionError if select fails", func(t *testing.T) {
 		// We drop the DB to make the test fail
 		s.pg.DropTestDB(t)
-		_, err := s.agents.Tag().FindAllByName(ctx, contractName)
+		_, err := s.agents.Tag().FindAllByName(ctx, contractName, []string{multitenancy.DefaultTenant}, "")
+
+		assert.True(t, errors.IsInternalError(err))
+
+		s.pg.InitTestDB(t)
+	})
+}
+
+func (s *tagTestSuite) TestPGTag_FindOneByName() {
+	ctx := context.Background()
+
+	s.T().Run("should find tag successfully", func(t *testing.T) {
+		tag, _ := s.insertTag(ctx, "myContract", "tag")
+
+		result, err := s.agents.Tag().FindOneByName(ctx, tag.RepositoryID, "TAG")
+
+		assert.NoError(t, err)
+		assert.Equal(t, tag, result)
+	})
+
+	s.T().Run("should return NotFoundError if tag does not exist", func(t *testing.T) {
+		_, err := s.agents.Tag().FindOneByName(ctx, 1, "unknown")
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
+func (s *tagTestSuite) TestPGTag_Delete() {
+	ctx := context.Background()
+
+	s.T().Run("should delete tag successfully", func(t *testing.T) {
+		tag, _ := s.insertTag(ctx, "myContract", "tag")
+
+		err := s.agents.Tag().Delete(ctx, tag)
+		assert.NoError(t, err)
+
+		tags, err := s.agents.Tag().FindAllByRepositoryID(ctx, tag.RepositoryID)
+		assert.NoError(t, err)
+		assert.Empty(t, tags)
+	})
+
+	s.T().Run("should return PostgresConnectionError if delete fails", func(t *testing.T) {
+		// We drop the DB to make the test fail
+		s.pg.DropTestDB(t)
+		err := s.agents.Tag().Delete(ctx, &models.TagModel{ID: 1})
 
 		assert.True(t, errors.IsInternalError(err))
 
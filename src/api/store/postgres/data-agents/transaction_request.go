@@ -15,6 +15,10 @@ import (
 
 const txRequestDAComponent = "data-agents.transaction-request"
 
+var txRequestSortColumns = map[string]string{
+	entities.SortByCreatedAt: "transaction_request.created_at",
+}
+
 // PGTransactionRequest is a transaction request data agent for PostgreSQL
 type PGTransactionRequest struct {
 	db     pg.DB
@@ -94,7 +98,12 @@ func (agent *PGTransactionRequest) Search(ctx context.Context, filters *entities
 	query = pg.WhereAllowedTenants(query, "schedule.tenant_id", tenants)
 	query = pg.WhereAllowedOwner(query, "schedule.owner_id", ownerID)
 
-	err := pg.Select(ctx, query)
+	query, err := paginate(query, &filters.Pagination, "", "schedule.uuid", txRequestSortColumns)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(txRequestDAComponent)
+	}
+
+	err = pg.Select(ctx, query)
 	if err != nil {
 		if !errors.IsNotFoundError(err) {
 			agent.logger.WithContext(ctx).WithError(err).Error("failed to fetch tx-requests")
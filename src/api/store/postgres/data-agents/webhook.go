@@ -0,0 +1,108 @@
+package dataagents
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	pg "github.com/consensys/orchestrate/src/infra/database/postgres"
+	"github.com/gofrs/uuid"
+)
+
+const webhookDAComponent = "data-agents.webhook"
+
+// PGWebhook is a Webhook data agent for PostgreSQL
+type PGWebhook struct {
+	db     pg.DB
+	logger *log.Logger
+}
+
+// NewPGWebhook creates a new PGWebhook
+func NewPGWebhook(db pg.DB) store.WebhookAgent {
+	return &PGWebhook{db: db, logger: log.NewLogger().SetComponent(webhookDAComponent)}
+}
+
+// Insert Inserts a new webhook in DB
+func (agent *PGWebhook) Insert(ctx context.Context, webhook *models.Webhook) error {
+	if webhook.UUID == "" {
+		webhook.UUID = uuid.Must(uuid.NewV4()).String()
+	}
+
+	err := pg.Insert(ctx, agent.db, webhook)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to insert webhook")
+		return errors.FromError(err).ExtendComponent(webhookDAComponent)
+	}
+
+	return nil
+}
+
+// FindOneByUUID Finds a webhook in DB
+func (agent *PGWebhook) FindOneByUUID(ctx context.Context, webhookUUID string, tenants []string) (*models.Webhook, error) {
+	webhook := &models.Webhook{}
+
+	query := agent.db.ModelContext(ctx, webhook).Where("uuid = ?", webhookUUID)
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants)
+
+	err := pg.SelectOne(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to select webhook")
+		}
+		return nil, errors.FromError(err).ExtendComponent(webhookDAComponent)
+	}
+
+	return webhook, nil
+}
+
+func (agent *PGWebhook) Search(ctx context.Context, filters *entities.WebhookFilters, tenants []string) ([]*models.Webhook, error) {
+	var webhooks []*models.Webhook
+
+	query := agent.db.ModelContext(ctx, &webhooks)
+	if filters.TenantID != "" {
+		query = query.Where("tenant_id = ?", filters.TenantID)
+	}
+
+	query = pg.WhereAllowedTenants(query, "tenant_id", tenants).Order("created_at ASC")
+
+	err := pg.Select(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to search webhooks")
+		}
+		return nil, errors.FromError(err).ExtendComponent(webhookDAComponent)
+	}
+
+	return webhooks, nil
+}
+
+func (agent *PGWebhook) Update(ctx context.Context, webhook *models.Webhook, tenants []string) error {
+	webhook.UpdatedAt = time.Now().UTC()
+	query := agent.db.ModelContext(ctx, webhook).Where("uuid = ?", webhook.UUID)
+	query = pg.WhereAllowedTenantsDefault(query, tenants)
+
+	err := pg.Update(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to update webhook")
+		return errors.FromError(err).ExtendComponent(webhookDAComponent)
+	}
+
+	return nil
+}
+
+func (agent *PGWebhook) Delete(ctx context.Context, webhook *models.Webhook, tenants []string) error {
+	query := agent.db.ModelContext(ctx, webhook).Where("uuid = ?", webhook.UUID)
+	query = pg.WhereAllowedTenantsDefault(query, tenants)
+
+	err := pg.Delete(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to delete webhook")
+		return errors.FromError(err).ExtendComponent(webhookDAComponent)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package dataagents
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	pg "github.com/consensys/orchestrate/src/infra/database/postgres"
+	"github.com/gofrs/uuid"
+)
+
+const webhookDeliveryDAComponent = "data-agents.webhook-delivery"
+
+var webhookDeliverySortColumns = map[string]string{
+	entities.SortByCreatedAt: "created_at",
+	entities.SortByUpdatedAt: "updated_at",
+}
+
+// PGWebhookDelivery is a WebhookDelivery data agent for PostgreSQL
+type PGWebhookDelivery struct {
+	db     pg.DB
+	logger *log.Logger
+}
+
+// NewPGWebhookDelivery creates a new PGWebhookDelivery
+func NewPGWebhookDelivery(db pg.DB) store.WebhookDeliveryAgent {
+	return &PGWebhookDelivery{db: db, logger: log.NewLogger().SetComponent(webhookDeliveryDAComponent)}
+}
+
+// Insert Inserts a new webhook delivery in DB
+func (agent *PGWebhookDelivery) Insert(ctx context.Context, delivery *models.WebhookDelivery) error {
+	if delivery.UUID == "" {
+		delivery.UUID = uuid.Must(uuid.NewV4()).String()
+	}
+
+	err := pg.Insert(ctx, agent.db, delivery)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to insert webhook delivery")
+		return errors.FromError(err).ExtendComponent(webhookDeliveryDAComponent)
+	}
+
+	return nil
+}
+
+func (agent *PGWebhookDelivery) Update(ctx context.Context, delivery *models.WebhookDelivery) error {
+	delivery.UpdatedAt = time.Now().UTC()
+	query := agent.db.ModelContext(ctx, delivery).Where("uuid = ?", delivery.UUID)
+
+	err := pg.Update(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to update webhook delivery")
+		return errors.FromError(err).ExtendComponent(webhookDeliveryDAComponent)
+	}
+
+	return nil
+}
+
+// Search returns the deliveries of a webhook, latest first unless sorted otherwise
+func (agent *PGWebhookDelivery) Search(ctx context.Context, webhookUUID string, filters *entities.WebhookDeliveryFilters) ([]*models.WebhookDelivery, error) {
+	var deliveries []*models.WebhookDelivery
+
+	query := agent.db.ModelContext(ctx, &deliveries).Where("webhook_uuid = ?", webhookUUID)
+	if filters.JobUUID != "" {
+		query = query.Where("job_uuid = ?", filters.JobUUID)
+	}
+	if filters.State != "" {
+		query = query.Where("state = ?", filters.State)
+	}
+
+	query, err := paginate(query, &filters.Pagination, "created_at DESC", "uuid", webhookDeliverySortColumns)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(webhookDeliveryDAComponent)
+	}
+
+	err = pg.Select(ctx, query)
+	if err != nil {
+		if !errors.IsNotFoundError(err) {
+			agent.logger.WithContext(ctx).WithError(err).Error("failed to search webhook deliveries")
+		}
+		return nil, errors.FromError(err).ExtendComponent(webhookDeliveryDAComponent)
+	}
+
+	return deliveries, nil
+}
+
+// LockPending finds and locks the pending deliveries to attempt before the given time, deliveries locked by another
+// transaction are skipped
+func (agent *PGWebhookDelivery) LockPending(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	var deliveries []*models.WebhookDelivery
+
+	query := agent.db.ModelContext(ctx, &deliveries).
+		Where("state = ?", entities.WebhookDeliveryPending).
+		Where("next_attempt_at <= ?", now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED")
+
+	err := pg.Select(ctx, query)
+	if err != nil {
+		agent.logger.WithContext(ctx).WithError(err).Error("failed to lock pending webhook deliveries")
+		return nil, errors.FromError(err).ExtendComponent(webhookDeliveryDAComponent)
+	}
+
+	return deliveries, nil
+}
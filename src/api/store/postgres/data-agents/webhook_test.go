@@ -0,0 +1,197 @@
+// +build unit
+// +build !race
+// +build !integration
+
+package dataagents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/api/store/models/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/orchestrate/src/api/store/postgres/migrations"
+	pgTestUtils "github.com/consensys/orchestrate/src/infra/database/postgres/testutils"
+	"github.com/stretchr/testify/suite"
+)
+
+type webhookTestSuite struct {
+	suite.Suite
+	agents         *PGAgents
+	pg             *pgTestUtils.PGTestHelper
+	tenantID       string
+	allowedTenants []string
+}
+
+func TestPGWebhook(t *testing.T) {
+	s := new(webhookTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *webhookTestSuite) SetupSuite() {
+	s.pg, _ = pgTestUtils.NewPGTestHelper(nil, migrations.Collection)
+	s.tenantID = "tenantID"
+	s.allowedTenants = []string{s.tenantID, "_"}
+	s.pg.InitTestDB(s.T())
+}
+
+func (s *webhookTestSuite) SetupTest() {
+	s.pg.UpgradeTestDB(s.T())
+	s.agents = New(s.pg.DB)
+}
+
+func (s *webhookTestSuite) TearDownTest() {
+	s.pg.DowngradeTestDB(s.T())
+}
+
+func (s *webhookTestSuite) TearDownSuite() {
+	s.pg.DropTestDB(s.T())
+}
+
+func (s *webhookTestSuite) TestPGWebhook_Insert() {
+	ctx := context.Background()
+
+	s.T().Run("should insert model without UUID successfully", func(t *testing.T) {
+		webhook := testdata.FakeWebhookModel()
+		webhook.UUID = ""
+		err := s.agents.Webhook().Insert(ctx, webhook)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, webhook.UUID)
+	})
+}
+
+func (s *webhookTestSuite) TestPGWebhook_FindOneByUUID() {
+	ctx := context.Background()
+	webhook := testdata.FakeWebhookModel()
+	err := s.agents.Webhook().Insert(ctx, webhook)
+	require.NoError(s.T(), err)
+
+	s.T().Run("should get model successfully", func(t *testing.T) {
+		webhookRetrieved, err := s.agents.Webhook().FindOneByUUID(ctx, webhook.UUID, s.allowedTenants)
+
+		assert.NoError(t, err)
+		assert.Equal(t, webhook.URL, webhookRetrieved.URL)
+		assert.Equal(t, webhook.Statuses, webhookRetrieved.Statuses)
+		assert.Equal(t, webhook.Labels, webhookRetrieved.Labels)
+	})
+
+	s.T().Run("should return NotFoundError if tenant is not allowed", func(t *testing.T) {
+		_, err := s.agents.Webhook().FindOneByUUID(ctx, webhook.UUID, []string{"notAllowed"})
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
+func (s *webhookTestSuite) TestPGWebhook_Search() {
+	ctx := context.Background()
+	webhook := testdata.FakeWebhookModel()
+	err := s.agents.Webhook().Insert(ctx, webhook)
+	require.NoError(s.T(), err)
+
+	s.T().Run("should find models successfully by tenant", func(t *testing.T) {
+		webhooks, err := s.agents.Webhook().Search(ctx, &entities.WebhookFilters{TenantID: s.tenantID}, s.allowedTenants)
+
+		assert.NoError(t, err)
+		require.Len(t, webhooks, 1)
+		assert.Equal(t, webhook.UUID, webhooks[0].UUID)
+	})
+
+	s.T().Run("should not find any model of other tenants", func(t *testing.T) {
+		webhooks, err := s.agents.Webhook().Search(ctx, &entities.WebhookFilters{}, []string{"notAllowed"})
+
+		assert.NoError(t, err)
+		assert.Empty(t, webhooks)
+	})
+}
+
+func (s *webhookTestSuite) TestPGWebhook_UpdateAndDelete() {
+	ctx := context.Background()
+	webhook := testdata.FakeWebhookModel()
+	err := s.agents.Webhook().Insert(ctx, webhook)
+	require.NoError(s.T(), err)
+
+	s.T().Run("should update model successfully", func(t *testing.T) {
+		err := s.agents.Webhook().Update(ctx, &models.Webhook{UUID: webhook.UUID, URL: "https://example.com/updated"}, s.allowedTenants)
+		assert.NoError(t, err)
+
+		webhookRetrieved, _ := s.agents.Webhook().FindOneByUUID(ctx, webhook.UUID, s.allowedTenants)
+		assert.Equal(t, "https://example.com/updated", webhookRetrieved.URL)
+		assert.Equal(t, webhook.Secret, webhookRetrieved.Secret)
+	})
+
+	s.T().Run("should delete model and its deliveries successfully", func(t *testing.T) {
+		delivery := testdata.FakeWebhookDeliveryModel(webhook.UUID)
+		err := s.agents.WebhookDelivery().Insert(ctx, delivery)
+		require.NoError(t, err)
+
+		err = s.agents.Webhook().Delete(ctx, webhook, s.allowedTenants)
+		assert.NoError(t, err)
+
+		deliveries, err := s.agents.WebhookDelivery().Search(ctx, webhook.UUID, &entities.WebhookDeliveryFilters{})
+		assert.NoError(t, err)
+		assert.Empty(t, deliveries)
+	})
+}
+
+func (s *webhookTestSuite) TestPGWebhookDelivery() {
+	ctx := context.Background()
+	webhook := testdata.FakeWebhookModel()
+	err := s.agents.Webhook().Insert(ctx, webhook)
+	require.NoError(s.T(), err)
+
+	delivery := testdata.FakeWebhookDeliveryModel(webhook.UUID)
+	err = s.agents.WebhookDelivery().Insert(ctx, delivery)
+	require.NoError(s.T(), err)
+
+	s.T().Run("should update and find model successfully", func(t *testing.T) {
+		delivery.State = string(entities.WebhookDeliveryDelivered)
+		delivery.Attempts = 2
+		delivery.ResponseStatus = 200
+		err := s.agents.WebhookDelivery().Update(ctx, delivery)
+		assert.NoError(t, err)
+
+		deliveries, err := s.agents.WebhookDelivery().Search(ctx, webhook.UUID, &entities.WebhookDeliveryFilters{
+			JobUUID: delivery.JobUUID,
+			State:   entities.WebhookDeliveryDelivered,
+		})
+		assert.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, 2, deliveries[0].Attempts)
+		assert.Equal(t, 200, deliveries[0].ResponseStatus)
+	})
+}
+
+func (s *webhookTestSuite) TestPGWebhookDelivery_LockPending() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	past, future := now.Add(-time.Minute), now.Add(time.Hour)
+	webhook := testdata.FakeWebhookModel()
+	err := s.agents.Webhook().Insert(ctx, webhook)
+	require.NoError(s.T(), err)
+
+	dueDelivery := testdata.FakeWebhookDeliveryModel(webhook.UUID)
+	dueDelivery.NextAttemptAt = &past
+	laterDelivery := testdata.FakeWebhookDeliveryModel(webhook.UUID)
+	laterDelivery.NextAttemptAt = &future
+	deliveredDelivery := testdata.FakeWebhookDeliveryModel(webhook.UUID)
+	deliveredDelivery.NextAttemptAt = &past
+	deliveredDelivery.State = string(entities.WebhookDeliveryDelivered)
+	for _, delivery := range []*models.WebhookDelivery{dueDelivery, laterDelivery, deliveredDelivery} {
+		err = s.agents.WebhookDelivery().Insert(ctx, delivery)
+		require.NoError(s.T(), err)
+	}
+
+	s.T().Run("should only lock pending deliveries to attempt", func(t *testing.T) {
+		deliveries, err := s.agents.WebhookDelivery().LockPending(ctx, now, 10)
+		assert.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, dueDelivery.UUID, deliveries[0].UUID)
+	})
+}
@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func upgradeReorgedJobStatus(db migrations.DB) error {
+	log.Debug("Applying adding REORGED job status...")
+	_, err := db.Exec(`
+ALTER TYPE job_status ADD VALUE IF NOT EXISTS 'REORGED';
+`)
+	if err != nil {
+		return err
+	}
+	log.Info("Applied adding REORGED job status")
+
+	return nil
+}
+
+func downgradeReorgedJobStatus(db migrations.DB) error {
+	log.Debug("Downgrading adding REORGED job status...")
+	_, err := db.Exec(`
+UPDATE logs
+	SET status = 'WARNING'
+	WHERE status = 'REORGED';
+
+ALTER TYPE job_status RENAME TO job_status_old;
+
+CREATE TYPE job_status AS ENUM ('CREATED', 'STARTED', 'PENDING', 'MINED', 'NEVER_MINED', 'RESENDING', 'STORED', 'RECOVERING', 'WARNING', 'FAILED');
+
+ALTER TABLE logs
+	ALTER COLUMN status TYPE job_status using status::text::job_status;
+
+ALTER TABLE jobs
+	ALTER COLUMN status TYPE job_status using status::text::job_status;
+
+DROP TYPE job_status_old;
+`)
+	if err != nil {
+		return err
+	}
+	log.Info("Downgraded adding REORGED job status")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(upgradeReorgedJobStatus, downgradeReorgedJobStatus)
+}
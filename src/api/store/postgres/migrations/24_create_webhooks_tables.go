@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createWebhooksTables(db migrations.DB) error {
+	log.Debug("Creating webhooks tables...")
+	_, err := db.Exec(`
+CREATE TABLE webhooks (
+	uuid UUID PRIMARY KEY,
+	tenant_id VARCHAR(66) NOT NULL,
+	url TEXT NOT NULL,
+	secret TEXT NOT NULL,
+	statuses TEXT[],
+	chain_uuid UUID,
+	labels JSONB,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+CREATE INDEX webhooks_tenant_id_idx ON webhooks (tenant_id);
+
+CREATE TABLE webhook_deliveries (
+	uuid UUID PRIMARY KEY,
+	webhook_uuid UUID NOT NULL REFERENCES webhooks(uuid) ON DELETE CASCADE,
+	job_uuid UUID NOT NULL,
+	job_status VARCHAR(66) NOT NULL,
+	state VARCHAR(66) NOT NULL,
+	attempts INTEGER DEFAULT 0 NOT NULL,
+	response_status INTEGER,
+	error TEXT,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+CREATE INDEX webhook_deliveries_webhook_uuid_created_at_idx ON webhook_deliveries (webhook_uuid, created_at);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create webhooks tables")
+		return err
+	}
+	log.Info("Created webhooks tables")
+
+	return nil
+}
+
+func dropWebhooksTables(db migrations.DB) error {
+	log.Debug("Dropping webhooks tables")
+	_, err := db.Exec(`
+DROP TABLE webhook_deliveries;
+DROP TABLE webhooks;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop webhooks tables")
+		return err
+	}
+	log.Info("Dropped webhooks tables")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createWebhooksTables, dropWebhooksTables)
+}
@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addJobDependencies(db migrations.DB) error {
+	log.Debug("Adding job dependencies...")
+	_, err := db.Exec(`
+ALTER TYPE job_status ADD VALUE IF NOT EXISTS 'SKIPPED';
+
+ALTER TABLE jobs
+	ADD COLUMN depends_on JSONB,
+	ADD COLUMN inputs JSONB;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add job dependencies")
+		return err
+	}
+	log.Info("Added job dependencies")
+
+	return nil
+}
+
+func removeJobDependencies(db migrations.DB) error {
+	log.Debug("Removing job dependencies...")
+	_, err := db.Exec(`
+ALTER TABLE jobs
+	DROP COLUMN depends_on,
+	DROP COLUMN inputs;
+
+UPDATE logs
+	SET status = 'FAILED'
+	WHERE status = 'SKIPPED';
+
+UPDATE jobs
+	SET status = 'FAILED'
+	WHERE status = 'SKIPPED';
+
+ALTER TYPE job_status RENAME TO job_status_old;
+
+CREATE TYPE job_status AS ENUM ('CREATED', 'STARTED', 'PENDING', 'MINED', 'NEVER_MINED', 'RESENDING', 'STORED', 'RECOVERING', 'WARNING', 'FAILED', 'REORGED');
+
+ALTER TABLE logs
+	ALTER COLUMN status TYPE job_status using status::text::job_status;
+
+ALTER TABLE jobs
+	ALTER COLUMN status TYPE job_status using status::text::job_status;
+
+DROP TYPE job_status_old;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove job dependencies")
+		return err
+	}
+	log.Info("Removed job dependencies")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addJobDependencies, removeJobDependencies)
+}
@@ -0,0 +1,74 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addScheduleTiming(db migrations.DB) error {
+	log.Debug("Adding schedule timing...")
+	_, err := db.Exec(`
+ALTER TYPE job_status ADD VALUE IF NOT EXISTS 'EXPIRED';
+
+ALTER TABLE schedules
+	ADD COLUMN not_before TIMESTAMPTZ,
+	ADD COLUMN not_after TIMESTAMPTZ,
+	ADD COLUMN cron TEXT,
+	ADD COLUMN next_run_at TIMESTAMPTZ,
+	ADD COLUMN last_run_at TIMESTAMPTZ;
+
+CREATE INDEX schedules_next_run_at_idx ON schedules (next_run_at) WHERE next_run_at IS NOT NULL;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add schedule timing")
+		return err
+	}
+	log.Info("Added schedule timing")
+
+	return nil
+}
+
+func removeScheduleTiming(db migrations.DB) error {
+	log.Debug("Removing schedule timing...")
+	_, err := db.Exec(`
+DROP INDEX schedules_next_run_at_idx;
+
+ALTER TABLE schedules
+	DROP COLUMN not_before,
+	DROP COLUMN not_after,
+	DROP COLUMN cron,
+	DROP COLUMN next_run_at,
+	DROP COLUMN last_run_at;
+
+UPDATE logs
+	SET status = 'FAILED'
+	WHERE status = 'EXPIRED';
+
+UPDATE jobs
+	SET status = 'FAILED'
+	WHERE status = 'EXPIRED';
+
+ALTER TYPE job_status RENAME TO job_status_old;
+
+CREATE TYPE job_status AS ENUM ('CREATED', 'STARTED', 'PENDING', 'MINED', 'NEVER_MINED', 'RESENDING', 'STORED', 'RECOVERING', 'WARNING', 'FAILED', 'REORGED', 'SKIPPED');
+
+ALTER TABLE logs
+	ALTER COLUMN status TYPE job_status using status::text::job_status;
+
+ALTER TABLE jobs
+	ALTER COLUMN status TYPE job_status using status::text::job_status;
+
+DROP TYPE job_status_old;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove schedule timing")
+		return err
+	}
+	log.Info("Removed schedule timing")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addScheduleTiming, removeScheduleTiming)
+}
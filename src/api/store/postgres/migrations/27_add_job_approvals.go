@@ -0,0 +1,75 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addJobApprovals(db migrations.DB) error {
+	log.Debug("Adding job approvals...")
+	_, err := db.Exec(`
+ALTER TYPE job_status ADD VALUE IF NOT EXISTS 'AWAITING_APPROVAL';
+
+ALTER TABLE accounts
+	ADD COLUMN approval_policy JSONB;
+
+CREATE TABLE job_approvals (
+	uuid UUID PRIMARY KEY,
+	job_uuid UUID NOT NULL,
+	tenant_id VARCHAR(66) NOT NULL,
+	username TEXT NOT NULL,
+	decision VARCHAR(66) NOT NULL,
+	reason TEXT,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	UNIQUE (job_uuid, username)
+);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add job approvals")
+		return err
+	}
+	log.Info("Added job approvals")
+
+	return nil
+}
+
+func removeJobApprovals(db migrations.DB) error {
+	log.Debug("Removing job approvals...")
+	_, err := db.Exec(`
+DROP TABLE job_approvals;
+
+ALTER TABLE accounts
+	DROP COLUMN approval_policy;
+
+UPDATE logs
+	SET status = 'CREATED'
+	WHERE status = 'AWAITING_APPROVAL';
+
+UPDATE jobs
+	SET status = 'CREATED'
+	WHERE status = 'AWAITING_APPROVAL';
+
+ALTER TYPE job_status RENAME TO job_status_old;
+
+CREATE TYPE job_status AS ENUM ('CREATED', 'STARTED', 'PENDING', 'MINED', 'NEVER_MINED', 'RESENDING', 'STORED', 'RECOVERING', 'WARNING', 'FAILED', 'REORGED', 'SKIPPED', 'EXPIRED');
+
+ALTER TABLE logs
+	ALTER COLUMN status TYPE job_status using status::text::job_status;
+
+ALTER TABLE jobs
+	ALTER COLUMN status TYPE job_status using status::text::job_status;
+
+DROP TYPE job_status_old;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove job approvals")
+		return err
+	}
+	log.Info("Removed job approvals")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addJobApprovals, removeJobApprovals)
+}
@@ -0,0 +1,54 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addFaucetSpendings(db migrations.DB) error {
+	log.Debug("Adding faucet spendings...")
+	_, err := db.Exec(`
+ALTER TABLE faucets
+	ADD COLUMN daily_budget NUMERIC(78);
+
+CREATE TABLE faucet_spendings (
+	uuid UUID PRIMARY KEY,
+	faucet_uuid UUID NOT NULL REFERENCES faucets(uuid) ON DELETE CASCADE,
+	tenant_id VARCHAR(66) NOT NULL,
+	beneficiary CHAR(42) NOT NULL,
+	amount NUMERIC(78) NOT NULL,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE INDEX faucet_spendings_faucet_uuid_created_at_idx ON faucet_spendings (faucet_uuid, created_at);
+CREATE INDEX faucet_spendings_faucet_uuid_beneficiary_idx ON faucet_spendings (faucet_uuid, beneficiary, created_at);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add faucet spendings")
+		return err
+	}
+	log.Info("Added faucet spendings")
+
+	return nil
+}
+
+func removeFaucetSpendings(db migrations.DB) error {
+	log.Debug("Removing faucet spendings...")
+	_, err := db.Exec(`
+DROP TABLE faucet_spendings;
+
+ALTER TABLE faucets
+	DROP COLUMN daily_budget;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove faucet spendings")
+		return err
+	}
+	log.Info("Removed faucet spendings")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addFaucetSpendings, removeFaucetSpendings)
+}
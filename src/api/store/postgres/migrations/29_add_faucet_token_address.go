@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addFaucetTokenAddress(db migrations.DB) error {
+	log.Debug("Adding faucet token address...")
+	_, err := db.Exec(`
+ALTER TABLE faucets
+	ADD COLUMN token_address CHAR(42);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add faucet token address")
+		return err
+	}
+	log.Info("Added faucet token address")
+
+	return nil
+}
+
+func removeFaucetTokenAddress(db migrations.DB) error {
+	log.Debug("Removing faucet token address...")
+	_, err := db.Exec(`
+ALTER TABLE faucets
+	DROP COLUMN token_address;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove faucet token address")
+		return err
+	}
+	log.Info("Removed faucet token address")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addFaucetTokenAddress, removeFaucetTokenAddress)
+}
@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addRepositoriesTenancy(db migrations.DB) error {
+	log.Debug("Adding tenancy to contract repositories...")
+	_, err := db.Exec(`
+ALTER TABLE repositories
+	ADD COLUMN tenant_id TEXT NOT NULL DEFAULT '_',
+	ADD COLUMN owner_id TEXT,
+	DROP CONSTRAINT repositories_name_key;
+
+CREATE UNIQUE INDEX repositories_unique_name_idx ON repositories (tenant_id, COALESCE(owner_id, ''), name);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add tenancy to contract repositories")
+		return err
+	}
+	log.Info("Added tenancy to contract repositories")
+
+	return nil
+}
+
+func removeRepositoriesTenancy(db migrations.DB) error {
+	log.Debug("Removing tenancy from contract repositories...")
+	_, err := db.Exec(`
+DROP INDEX repositories_unique_name_idx;
+
+ALTER TABLE repositories
+	DROP COLUMN tenant_id,
+	DROP COLUMN owner_id,
+	ADD CONSTRAINT repositories_name_key UNIQUE (name);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove tenancy from contract repositories")
+		return err
+	}
+	log.Info("Removed tenancy from contract repositories")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addRepositoriesTenancy, removeRepositoriesTenancy)
+}
@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addJobDecodedData(db migrations.DB) error {
+	log.Debug("Adding job decoded data...")
+	_, err := db.Exec(`
+ALTER TABLE jobs
+	ADD COLUMN decoded_input JSONB,
+	ADD COLUMN revert JSONB;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add job decoded data")
+		return err
+	}
+	log.Info("Added job decoded data")
+
+	return nil
+}
+
+func removeJobDecodedData(db migrations.DB) error {
+	log.Debug("Removing job decoded data...")
+	_, err := db.Exec(`
+ALTER TABLE jobs
+	DROP COLUMN decoded_input,
+	DROP COLUMN revert;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove job decoded data")
+		return err
+	}
+	log.Info("Removed job decoded data")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addJobDecodedData, removeJobDecodedData)
+}
@@ -0,0 +1,49 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addKeystoreKeys(db migrations.DB) error {
+	log.Debug("Adding keystore keys...")
+	_, err := db.Exec(`
+CREATE TABLE keystore_keys (
+	id SERIAL PRIMARY KEY,
+	address CHAR(42) NOT NULL UNIQUE,
+	key_id TEXT,
+	public_key TEXT NOT NULL,
+	compressed_public_key TEXT NOT NULL,
+	encrypted_private_key BYTEA NOT NULL,
+	encrypted_data_key BYTEA NOT NULL,
+	tags JSONB,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add keystore keys")
+		return err
+	}
+	log.Info("Added keystore keys")
+
+	return nil
+}
+
+func removeKeystoreKeys(db migrations.DB) error {
+	log.Debug("Removing keystore keys...")
+	_, err := db.Exec(`
+DROP TABLE keystore_keys;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove keystore keys")
+		return err
+	}
+	log.Info("Removed keystore keys")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addKeystoreKeys, removeKeystoreKeys)
+}
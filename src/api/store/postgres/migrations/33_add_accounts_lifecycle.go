@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addAccountsLifecycle(db migrations.DB) error {
+	log.Debug("Adding lifecycle columns to accounts...")
+	_, err := db.Exec(`
+ALTER TABLE accounts
+	ADD COLUMN disabled_at TIMESTAMPTZ,
+	ADD COLUMN disabled_by TEXT,
+	ADD COLUMN successor CHAR(42),
+	ADD COLUMN predecessor CHAR(42),
+	ADD COLUMN drain_tx_uuid UUID;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add lifecycle columns to accounts")
+		return err
+	}
+	log.Info("Added lifecycle columns to accounts")
+
+	return nil
+}
+
+func removeAccountsLifecycle(db migrations.DB) error {
+	log.Debug("Removing lifecycle columns from accounts...")
+	_, err := db.Exec(`
+ALTER TABLE accounts
+	DROP COLUMN disabled_at,
+	DROP COLUMN disabled_by,
+	DROP COLUMN successor,
+	DROP COLUMN predecessor,
+	DROP COLUMN drain_tx_uuid;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove lifecycle columns from accounts")
+		return err
+	}
+	log.Info("Removed lifecycle columns from accounts")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addAccountsLifecycle, removeAccountsLifecycle)
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addChainRPCPolicy(db migrations.DB) error {
+	log.Debug("Adding rpc policy to chains...")
+	_, err := db.Exec(`
+ALTER TABLE chains
+	ADD COLUMN rpc_policy JSONB;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add rpc policy to chains")
+		return err
+	}
+	log.Info("Added rpc policy to chains")
+
+	return nil
+}
+
+func removeChainRPCPolicy(db migrations.DB) error {
+	log.Debug("Removing rpc policy from chains...")
+	_, err := db.Exec(`
+ALTER TABLE chains
+	DROP COLUMN rpc_policy;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove rpc policy from chains")
+		return err
+	}
+	log.Info("Removed rpc policy from chains")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addChainRPCPolicy, removeChainRPCPolicy)
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addChainGasOracle(db migrations.DB) error {
+	log.Debug("Adding gas oracle to chains...")
+	_, err := db.Exec(`
+ALTER TABLE chains
+	ADD COLUMN gas_oracle JSONB;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add gas oracle to chains")
+		return err
+	}
+	log.Info("Added gas oracle to chains")
+
+	return nil
+}
+
+func removeChainGasOracle(db migrations.DB) error {
+	log.Debug("Removing gas oracle from chains...")
+	_, err := db.Exec(`
+ALTER TABLE chains
+	DROP COLUMN gas_oracle;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove gas oracle from chains")
+		return err
+	}
+	log.Info("Removed gas oracle from chains")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addChainGasOracle, removeChainGasOracle)
+}
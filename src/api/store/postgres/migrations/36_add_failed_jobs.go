@@ -0,0 +1,54 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addFailedJobs(db migrations.DB) error {
+	log.Debug("Adding failed jobs...")
+	_, err := db.Exec(`
+CREATE TABLE failed_jobs (
+	uuid UUID PRIMARY KEY,
+	job_uuid UUID NOT NULL,
+	chain_uuid UUID NOT NULL,
+	tenant_id VARCHAR(66) NOT NULL,
+	owner_id TEXT,
+	envelope_id TEXT,
+	error_code VARCHAR(16),
+	error_class VARCHAR(66) NOT NULL,
+	error_message TEXT,
+	replay_count INTEGER DEFAULT 0 NOT NULL,
+	replayed_at TIMESTAMPTZ,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE INDEX failed_jobs_job_uuid_idx ON failed_jobs (job_uuid);
+CREATE INDEX failed_jobs_created_at_idx ON failed_jobs (created_at);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add failed jobs")
+		return err
+	}
+	log.Info("Added failed jobs")
+
+	return nil
+}
+
+func removeFailedJobs(db migrations.DB) error {
+	log.Debug("Removing failed jobs...")
+	_, err := db.Exec(`
+DROP TABLE failed_jobs;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove failed jobs")
+		return err
+	}
+	log.Info("Removed failed jobs")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addFailedJobs, removeFailedJobs)
+}
@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addWebhookDeliveryPayload(db migrations.DB) error {
+	log.Debug("Adding payload to webhook deliveries...")
+	_, err := db.Exec(`
+ALTER TABLE webhook_deliveries
+	ADD COLUMN payload BYTEA,
+	ADD COLUMN next_attempt_at TIMESTAMPTZ;
+CREATE INDEX webhook_deliveries_pending_next_attempt_at_idx ON webhook_deliveries (next_attempt_at) WHERE state = 'PENDING';
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add payload to webhook deliveries")
+		return err
+	}
+	log.Info("Added payload to webhook deliveries")
+
+	return nil
+}
+
+func removeWebhookDeliveryPayload(db migrations.DB) error {
+	log.Debug("Removing payload from webhook deliveries...")
+	_, err := db.Exec(`
+DROP INDEX webhook_deliveries_pending_next_attempt_at_idx;
+ALTER TABLE webhook_deliveries
+	DROP COLUMN payload,
+	DROP COLUMN next_attempt_at;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove payload from webhook deliveries")
+		return err
+	}
+	log.Info("Removed payload from webhook deliveries")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addWebhookDeliveryPayload, removeWebhookDeliveryPayload)
+}
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addCodehashesTenancy(db migrations.DB) error {
+	log.Debug("Adding tenancy to contract code hashes...")
+	_, err := db.Exec(`
+ALTER TABLE codehashes
+	ADD COLUMN tenant_id TEXT NOT NULL DEFAULT '_',
+	DROP CONSTRAINT codehashes_chain_id_address_key,
+	ADD CONSTRAINT codehashes_tenant_id_chain_id_address_key UNIQUE (tenant_id, chain_id, address);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add tenancy to contract code hashes")
+		return err
+	}
+	log.Info("Added tenancy to contract code hashes")
+
+	return nil
+}
+
+func removeCodehashesTenancy(db migrations.DB) error {
+	log.Debug("Removing tenancy from contract code hashes...")
+	_, err := db.Exec(`
+DELETE FROM codehashes WHERE tenant_id <> '_';
+
+ALTER TABLE codehashes
+	DROP CONSTRAINT codehashes_tenant_id_chain_id_address_key,
+	DROP COLUMN tenant_id,
+	ADD CONSTRAINT codehashes_chain_id_address_key UNIQUE (chain_id, address);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove tenancy from contract code hashes")
+		return err
+	}
+	log.Info("Removed tenancy from contract code hashes")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addCodehashesTenancy, removeCodehashesTenancy)
+}
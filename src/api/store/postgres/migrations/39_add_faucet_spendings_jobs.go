@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addFaucetSpendingsJobs(db migrations.DB) error {
+	log.Debug("Adding funding jobs and tenant budgets to faucet spendings...")
+	_, err := db.Exec(`
+ALTER TABLE faucets
+	ADD COLUMN tenant_daily_budget NUMERIC(78);
+
+ALTER TABLE faucet_spendings
+	ADD COLUMN job_uuid UUID;
+
+CREATE INDEX faucet_spendings_faucet_uuid_tenant_id_created_at_idx ON faucet_spendings (faucet_uuid, tenant_id, created_at);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add funding jobs and tenant budgets to faucet spendings")
+		return err
+	}
+	log.Info("Added funding jobs and tenant budgets to faucet spendings")
+
+	return nil
+}
+
+func removeFaucetSpendingsJobs(db migrations.DB) error {
+	log.Debug("Removing funding jobs and tenant budgets from faucet spendings...")
+	_, err := db.Exec(`
+DROP INDEX faucet_spendings_faucet_uuid_tenant_id_created_at_idx;
+
+ALTER TABLE faucet_spendings
+	DROP COLUMN job_uuid;
+
+ALTER TABLE faucets
+	DROP COLUMN tenant_daily_budget;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove funding jobs and tenant budgets from faucet spendings")
+		return err
+	}
+	log.Info("Removed funding jobs and tenant budgets from faucet spendings")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addFaucetSpendingsJobs, removeFaucetSpendingsJobs)
+}
@@ -2,6 +2,8 @@ package store
 
 import (
 	"context"
+	"math/big"
+	"time"
 
 	"github.com/consensys/orchestrate/src/entities"
 
@@ -23,6 +25,7 @@ type Agents interface {
 	TransactionRequest() TransactionRequestAgent
 	Account() AccountAgent
 	Faucet() FaucetAgent
+	FaucetSpending() FaucetSpendingAgent
 	Artifact() ArtifactAgent
 	CodeHash() CodeHashAgent
 	Event() EventAgent
@@ -31,6 +34,11 @@ type Agents interface {
 	Contract() ContractAgent
 	Chain() ChainAgent
 	PrivateTxManager() PrivateTxManagerAgent
+	Webhook() WebhookAgent
+	WebhookDelivery() WebhookDeliveryAgent
+	JobApproval() JobApprovalAgent
+	FailedJob() FailedJobAgent
+	JobEvent() JobEventAgent
 }
 
 type DB interface {
@@ -53,7 +61,9 @@ type TransactionRequestAgent interface {
 type ScheduleAgent interface {
 	Insert(ctx context.Context, schedule *models.Schedule) error
 	FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*models.Schedule, error)
-	FindAll(ctx context.Context, tenants []string, ownerID string) ([]*models.Schedule, error)
+	Search(ctx context.Context, filters *entities.ScheduleFilters, tenants []string, ownerID string) ([]*models.Schedule, error)
+	Update(ctx context.Context, schedule *models.Schedule) error
+	LockDue(ctx context.Context, now time.Time, limit int) ([]*models.Schedule, error)
 }
 
 type JobAgent interface {
@@ -64,6 +74,17 @@ type JobAgent interface {
 	Search(ctx context.Context, filters *entities.JobFilters, tenants []string, ownerID string) ([]*models.Job, error)
 }
 
+type JobApprovalAgent interface {
+	Insert(ctx context.Context, approval *models.JobApproval) error
+	FindAllByJobUUID(ctx context.Context, jobUUID string) ([]*models.JobApproval, error)
+}
+
+type FailedJobAgent interface {
+	Insert(ctx context.Context, failedJob *models.FailedJob) error
+	Update(ctx context.Context, failedJob *models.FailedJob) error
+	Search(ctx context.Context, filters *entities.FailedJobFilters, tenants []string, ownerID string) ([]*models.FailedJob, error)
+}
+
 type LogAgent interface {
 	Insert(ctx context.Context, log *models.Log) error
 }
@@ -78,6 +99,7 @@ type AccountAgent interface {
 	Update(ctx context.Context, account *models.Account) error
 	FindOneByAddress(ctx context.Context, address string, tenants []string, ownerID string) (*models.Account, error)
 	Search(ctx context.Context, filters *entities.AccountFilters, tenants []string, ownerID string) ([]*models.Account, error)
+	LockOneByAddress(ctx context.Context, address string) error
 }
 
 type FaucetAgent interface {
@@ -86,6 +108,15 @@ type FaucetAgent interface {
 	FindOneByUUID(ctx context.Context, uuid string, tenants []string) (*models.Faucet, error)
 	Search(ctx context.Context, filters *entities.FaucetFilters, tenants []string) ([]*models.Faucet, error)
 	Delete(ctx context.Context, faucet *models.Faucet, tenants []string) error
+	LockOneByUUID(ctx context.Context, uuid string) error
+}
+
+type FaucetSpendingAgent interface {
+	Insert(ctx context.Context, spending *models.FaucetSpending) error
+	FindLastByBeneficiary(ctx context.Context, faucetUUID, beneficiary string) (*models.FaucetSpending, error)
+	SumSince(ctx context.Context, faucetUUID, tenantID string, since time.Time) (*big.Int, error)
+	Search(ctx context.Context, faucetUUID string, limit int) ([]*models.FaucetSpending, error)
+	DeleteByJobUUID(ctx context.Context, jobUUID string) error
 }
 
 type ChainAgent interface {
@@ -97,6 +128,21 @@ type ChainAgent interface {
 	Delete(ctx context.Context, chain *models.Chain, tenants []string) error
 }
 
+type WebhookAgent interface {
+	Insert(ctx context.Context, webhook *models.Webhook) error
+	Update(ctx context.Context, webhook *models.Webhook, tenants []string) error
+	FindOneByUUID(ctx context.Context, uuid string, tenants []string) (*models.Webhook, error)
+	Search(ctx context.Context, filters *entities.WebhookFilters, tenants []string) ([]*models.Webhook, error)
+	Delete(ctx context.Context, webhook *models.Webhook, tenants []string) error
+}
+
+type WebhookDeliveryAgent interface {
+	Insert(ctx context.Context, delivery *models.WebhookDelivery) error
+	Update(ctx context.Context, delivery *models.WebhookDelivery) error
+	Search(ctx context.Context, webhookUUID string, filters *entities.WebhookDeliveryFilters) ([]*models.WebhookDelivery, error)
+	LockPending(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error)
+}
+
 type PrivateTxManagerAgent interface {
 	Insert(ctx context.Context, privateTxManager *models.PrivateTxManager) error
 	Update(ctx context.Context, privateTxManager *models.PrivateTxManager) error
@@ -108,32 +154,44 @@ type ArtifactAgent interface {
 	FindOneByABIAndCodeHash(ctx context.Context, abi, codeHash string) (*models.ArtifactModel, error)
 	SelectOrInsert(ctx context.Context, artifact *models.ArtifactModel) error
 	Insert(ctx context.Context, artifact *models.ArtifactModel) error
-	FindOneByNameAndTag(ctx context.Context, name, tag string) (*models.ArtifactModel, error)
+	FindOneByNameAndTag(ctx context.Context, name, tag string, tenants []string, ownerID string) (*models.ArtifactModel, error)
+	DeleteUnused(ctx context.Context, ids []int) ([]*models.ArtifactModel, error)
 }
 
 type ContractAgent interface {
-	FindOneByCodeHash(ctx context.Context, codeHash string) (*entities.Contract, error)
-	FindOneByAddress(ctx context.Context, address string) (*entities.Contract, error)
+	FindOneByCodeHash(ctx context.Context, codeHash string, tenants []string, ownerID string) (*entities.Contract, error)
+	FindOneByAddress(ctx context.Context, address string, tenants []string, ownerID string) (*entities.Contract, error)
 }
 
 type CodeHashAgent interface {
 	Insert(ctx context.Context, codehash *models.CodehashModel) error
+	DeleteUnused(ctx context.Context, codeHashes []string) error
 }
 
 type EventAgent interface {
 	InsertMultiple(ctx context.Context, events []*models.EventModel) error
-	FindOneByAccountAndSigHash(ctx context.Context, chainID, address, sighash string, indexedInputCount uint32) (*models.EventModel, error)
-	FindDefaultBySigHash(ctx context.Context, sighash string, indexedInputCount uint32) ([]*models.EventModel, error)
+	FindOneByAccountAndSigHash(ctx context.Context, chainID, address, sighash string, indexedInputCount uint32, tenants []string) (*models.EventModel, error)
+	FindDefaultBySigHash(ctx context.Context, sighash string, indexedInputCount uint32, tenants []string, ownerID string) ([]*models.EventModel, error)
+	DeleteUnused(ctx context.Context, codeHashes []string) error
 }
 
 type RepositoryAgent interface {
 	SelectOrInsert(ctx context.Context, repository *models.RepositoryModel) error
 	Insert(ctx context.Context, repository *models.RepositoryModel) error
-	FindOne(ctx context.Context, name string) (*models.RepositoryModel, error)
-	FindAll(ctx context.Context) ([]string, error)
+	FindOne(ctx context.Context, name string, tenants []string, ownerID string) (*models.RepositoryModel, error)
+	FindAll(ctx context.Context, tenants []string, ownerID string) ([]string, error)
+	Delete(ctx context.Context, repository *models.RepositoryModel) error
 }
 
 type TagAgent interface {
 	Insert(ctx context.Context, tag *models.TagModel) error
-	FindAllByName(ctx context.Context, name string) ([]string, error)
+	FindAllByName(ctx context.Context, name string, tenants []string, ownerID string) ([]string, error)
+	FindOneByName(ctx context.Context, repositoryID int, name string) (*models.TagModel, error)
+	FindAllByRepositoryID(ctx context.Context, repositoryID int) ([]*models.TagModel, error)
+	Delete(ctx context.Context, tag *models.TagModel) error
+}
+
+type JobEventAgent interface {
+	Notify(ctx context.Context, event *entities.JobEvent) error
+	Listen(ctx context.Context) (<-chan *entities.JobEvent, error)
 }
@@ -16,6 +16,12 @@ type Account struct {
 	OwnerID             string
 	StoreID             string
 	Attributes          map[string]string
+	ApprovalPolicy      *ApprovalPolicy
+	DisabledAt          *time.Time
+	DisabledBy          string
+	Successor           *ethcommon.Address
+	Predecessor         *ethcommon.Address
+	DrainTxUUID         string
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 }
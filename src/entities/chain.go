@@ -3,6 +3,8 @@ package entities
 import (
 	"math/big"
 	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type Chain struct {
@@ -19,6 +21,45 @@ type Chain struct {
 	ListenerExternalTxEnabled bool
 	PrivateTxManager          *PrivateTxManager
 	Labels                    map[string]string
+	RPCPolicy                 *ChainRPCPolicy
+	GasOracle                 *ChainGasOracle
 	CreatedAt                 time.Time
 	UpdatedAt                 time.Time
 }
+
+// ChainRPCPolicy restricts the JSON-RPC requests tenants send to a chain through the chain proxy
+type ChainRPCPolicy struct {
+	AllowedMethods []string       `json:"allowedMethods,omitempty" validate:"omitempty,unique,dive,required" example:"eth_*,net_version"`         // Methods allowed, a trailing `*` matches any suffix. All methods are allowed if empty.
+	DeniedMethods  []string       `json:"deniedMethods,omitempty" validate:"omitempty,unique,dive,required" example:"admin_*,debug_*,personal_*"` // Methods denied, a trailing `*` matches any suffix. Denied methods take precedence over allowed methods.
+	MaxBatchSize   int            `json:"maxBatchSize,omitempty" validate:"omitempty,min=1" example:"10"`                                         // Maximum number of requests in a batch. Unlimited if empty.
+	TenantQuota    *ChainRPCQuota `json:"tenantQuota,omitempty" validate:"omitempty"`                                                             // Maximum number of requests each tenant can send to the chain over a period.
+}
+
+type ChainRPCQuota struct {
+	Requests int    `json:"requests" validate:"required,min=1" example:"1000"`  // Number of requests, each request of a batch counting as one.
+	Period   string `json:"period" validate:"required,isDuration" example:"1m"` // Period over which requests are counted (for example `1s` or `1h`).
+}
+
+// DefaultChainRPCPolicy is the policy of the chains registered without policy, it denies the namespaces giving access to
+// the administration and the accounts of the nodes. Its denied methods also apply to the policies without denied methods
+func DefaultChainRPCPolicy() *ChainRPCPolicy {
+	return &ChainRPCPolicy{
+		DeniedMethods: []string{"admin_*", "debug_*", "personal_*"},
+	}
+}
+
+type GasOracleType string
+
+const (
+	DefaultGasOracle    GasOracleType = "default"
+	FeeHistoryGasOracle GasOracleType = "fee-history"
+)
+
+// ChainGasOracle configures how the tx-sender prices the transactions it crafts for a chain
+type ChainGasOracle struct {
+	Type              GasOracleType      `json:"type,omitempty" validate:"omitempty,isGasOracleType" example:"fee-history"`                                     // `default` applies fixed multipliers and priority fees per priority, `fee-history` uses the priority fees paid in the latest blocks.
+	BlockCount        int                `json:"blockCount,omitempty" validate:"omitempty,min=1,max=1024" example:"20"`                                         // Number of blocks of the fee history. Defaults to 20.
+	RewardPercentiles map[string]float64 `json:"rewardPercentiles,omitempty" validate:"omitempty,dive,keys,isPriority,endkeys,min=0,max=100" example:"high:80"` // Percentile of the priority fees paid in the fee history used for each priority. Defaults to 10, 25, 50, 75 and 90.
+	MaxFee            *hexutil.Big       `json:"maxFee,omitempty" example:"0x174876e800" swaggertype:"string"`                                                  // Maximum gas price or max fee per gas crafted. Unlimited if empty.
+	MaxTip            *hexutil.Big       `json:"maxTip,omitempty" example:"0x77359400" swaggertype:"string"`                                                    // Maximum max priority fee per gas crafted. Unlimited if empty.
+}
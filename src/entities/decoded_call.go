@@ -0,0 +1,13 @@
+package entities
+
+// DecodedCall is a method call or a custom error decoded against the ABI of a contract
+type DecodedCall struct {
+	Signature string            `json:"signature" example:"transfer(address,uint256)"`
+	Args      map[string]string `json:"args,omitempty"`
+}
+
+// TxRevert is the reason why a mined transaction failed, obtained by replaying it on the state of its parent block
+type TxRevert struct {
+	Reason string       `json:"reason,omitempty" example:"Not enough Ether provided."`
+	Error  *DecodedCall `json:"error,omitempty"`
+}
@@ -0,0 +1,39 @@
+package entities
+
+import "time"
+
+type FailedJobErrorClass string
+
+const (
+	FailedJobConnectionError     FailedJobErrorClass = "CONNECTION"
+	FailedJobEthereumError       FailedJobErrorClass = "ETHEREUM"
+	FailedJobInvalidNonceError   FailedJobErrorClass = "INVALID_NONCE"
+	FailedJobInvalidDataError    FailedJobErrorClass = "INVALID_DATA"
+	FailedJobAuthenticationError FailedJobErrorClass = "AUTHENTICATION"
+	FailedJobInvalidStateError   FailedJobErrorClass = "INVALID_STATE"
+	FailedJobCryptoError         FailedJobErrorClass = "CRYPTO"
+	FailedJobInternalError       FailedJobErrorClass = "INTERNAL"
+	FailedJobUnknownError        FailedJobErrorClass = "UNKNOWN"
+)
+
+// FailedJob is a job failed by the tx-sender, as received on the tx-recover topic.
+// A failed job is pending until it is replayed
+type FailedJob struct {
+	UUID         string
+	JobUUID      string
+	ChainUUID    string
+	TenantID     string
+	OwnerID      string
+	EnvelopeID   string
+	ErrorCode    string
+	ErrorClass   FailedJobErrorClass
+	ErrorMessage string
+	ReplayCount  int
+	ReplayedAt   *time.Time
+	CreatedAt    time.Time
+}
+
+// IsPending indicates whether the failed job has not been replayed yet
+func (f *FailedJob) IsPending() bool {
+	return f.ReplayedAt == nil
+}
@@ -8,20 +8,41 @@ import (
 )
 
 type Faucet struct {
-	UUID            string
-	Name            string
-	TenantID        string
-	ChainRule       string
-	CreditorAccount ethcommon.Address
-	MaxBalance      hexutil.Big
-	Amount          hexutil.Big
-	Cooldown        string
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	UUID              string
+	Name              string
+	TenantID          string
+	ChainRule         string
+	CreditorAccount   ethcommon.Address
+	TokenAddress      *ethcommon.Address
+	MaxBalance        hexutil.Big
+	Amount            hexutil.Big
+	Cooldown          string
+	DailyBudget       *hexutil.Big
+	TenantDailyBudget *hexutil.Big
+	DailySpent        *hexutil.Big
+	Spendings         []*FaucetSpending
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// FaucetBudgetPeriod is the sliding window over which the credits of a faucet are limited by its daily budgets
+const FaucetBudgetPeriod = 24 * time.Hour
+
+// FaucetSpending is a credit granted by a faucet to a beneficiary of a tenant, it no longer counts once its funding
+// job fails
+type FaucetSpending struct {
+	UUID        string
+	FaucetUUID  string
+	JobUUID     string
+	TenantID    string
+	Beneficiary ethcommon.Address
+	Amount      hexutil.Big
+	CreatedAt   time.Time
 }
 
 type FaucetRequest struct {
 	Chain       *Chain
+	TenantID    string
 	Beneficiary ethcommon.Address
 	Candidates  map[string]*Faucet
 }
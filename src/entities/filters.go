@@ -2,7 +2,32 @@ package entities
 
 import "time"
 
+// Sort keys of search requests, prefixed by "-" for descending order
+const (
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+)
+
+// Page sizes of search requests
+const (
+	DefaultPageLimit = 100
+	MaxPageLimit     = 1000
+)
+
+// Pagination limits the number of search results, Next is the opaque cursor returned with the previous page
+type Pagination struct {
+	Limit int    `validate:"omitempty,min=1,max=1000"`
+	Next  string `validate:"omitempty"`
+	Sort  string `validate:"omitempty,isSortKey"`
+}
+
+// HasNextPage indicates whether a page of the given size may be followed by other results
+func (p *Pagination) HasNextPage(count int) bool {
+	return p.Limit > 0 && count == p.Limit
+}
+
 type JobFilters struct {
+	Pagination
 	TxHashes      []string  `validate:"omitempty,unique,dive,isHash"`
 	ChainUUID     string    `validate:"omitempty,uuid"`
 	Status        JobStatus `validate:"omitempty,isJobStatus"`
@@ -13,21 +38,55 @@ type JobFilters struct {
 }
 
 type TransactionRequestFilters struct {
+	Pagination
 	IdempotencyKeys []string `validate:"omitempty,unique"`
 }
 
 type FaucetFilters struct {
+	Pagination
 	Names     []string `validate:"omitempty,unique"`
 	ChainRule string   `validate:"omitempty"`
 	TenantID  string   `validate:"omitempty"`
 }
 
 type AccountFilters struct {
+	Pagination
 	Aliases  []string `validate:"omitempty,unique"`
 	TenantID string   `validate:"omitempty"`
 }
 
 type ChainFilters struct {
+	Pagination
 	Names    []string `validate:"omitempty,unique"`
 	TenantID string   `validate:"omitempty"`
 }
+
+type WebhookFilters struct {
+	TenantID string `validate:"omitempty"`
+}
+
+type WebhookDeliveryFilters struct {
+	Pagination
+	JobUUID string               `validate:"omitempty,uuid"`
+	State   WebhookDeliveryState `validate:"omitempty,oneof=PENDING DELIVERED FAILED"`
+}
+
+type FailedJobFilters struct {
+	Pagination
+	JobUUIDs      []string            `validate:"omitempty,unique,dive,uuid"`
+	ChainUUID     string              `validate:"omitempty,uuid"`
+	ErrorCode     string              `validate:"omitempty"`
+	ErrorClass    FailedJobErrorClass `validate:"omitempty,isFailedJobErrorClass"`
+	CreatedAfter  time.Time           `validate:"omitempty"`
+	CreatedBefore time.Time           `validate:"omitempty"`
+	OnlyPending   bool                `validate:"omitempty"`
+}
+
+type JobEventFilters struct {
+	ChainUUID string `validate:"omitempty,uuid"`
+	Labels    map[string]string
+}
+
+type ScheduleFilters struct {
+	Pagination
+}
@@ -19,16 +19,20 @@ const (
 )
 
 const (
-	StatusCreated    JobStatus = "CREATED"
-	StatusStarted    JobStatus = "STARTED"
-	StatusPending    JobStatus = "PENDING"
-	StatusResending  JobStatus = "RESENDING"
-	StatusStored     JobStatus = "STORED"
-	StatusRecovering JobStatus = "RECOVERING"
-	StatusWarning    JobStatus = "WARNING"
-	StatusFailed     JobStatus = "FAILED"
-	StatusMined      JobStatus = "MINED"
-	StatusNeverMined JobStatus = "NEVER_MINED"
+	StatusCreated          JobStatus = "CREATED"
+	StatusAwaitingApproval JobStatus = "AWAITING_APPROVAL"
+	StatusStarted          JobStatus = "STARTED"
+	StatusPending          JobStatus = "PENDING"
+	StatusResending        JobStatus = "RESENDING"
+	StatusStored           JobStatus = "STORED"
+	StatusRecovering       JobStatus = "RECOVERING"
+	StatusWarning          JobStatus = "WARNING"
+	StatusFailed           JobStatus = "FAILED"
+	StatusMined            JobStatus = "MINED"
+	StatusNeverMined       JobStatus = "NEVER_MINED"
+	StatusReorged          JobStatus = "REORGED"
+	StatusSkipped          JobStatus = "SKIPPED"
+	StatusExpired          JobStatus = "EXPIRED"
 )
 
 type Job struct {
@@ -44,6 +48,10 @@ type Job struct {
 	InternalData *InternalData
 	Transaction  *ETHTransaction
 	Receipt      *ethereum.Receipt
+	DependsOn    []*JobDependency
+	Inputs       []*JobInput
+	DecodedInput *DecodedCall
+	Revert       *TxRevert
 	Logs         []*Log
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
@@ -53,5 +61,7 @@ func IsFinalJobStatus(status JobStatus) bool {
 	return status == StatusMined ||
 		status == StatusFailed ||
 		status == StatusStored ||
-		status == StatusNeverMined
+		status == StatusNeverMined ||
+		status == StatusSkipped ||
+		status == StatusExpired
 }
@@ -0,0 +1,38 @@
+package entities
+
+import "time"
+
+type ApprovalDecision string
+
+const (
+	ApprovalDecisionApproved ApprovalDecision = "APPROVED"
+	ApprovalDecisionRejected ApprovalDecision = "REJECTED"
+)
+
+// ApprovalPolicy requires the jobs sent from an account to be approved by a number of distinct approvers before they start
+type ApprovalPolicy struct {
+	Threshold int      `json:"threshold" validate:"required,min=1" example:"2"`
+	Approvers []string `json:"approvers" validate:"required,unique,dive,required" example:"alice,bob,carol"`
+}
+
+// JobApproval is the decision of an approver on a job AWAITING_APPROVAL
+type JobApproval struct {
+	UUID      string
+	JobUUID   string
+	TenantID  string
+	Username  string
+	Decision  ApprovalDecision
+	Reason    string
+	CreatedAt time.Time
+}
+
+// HasApprover indicates whether the user is allowed to approve or reject the jobs under the policy
+func (p *ApprovalPolicy) HasApprover(username string) bool {
+	for _, approver := range p.Approvers {
+		if approver == username {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,28 @@
+package entities
+
+type JobOutput string
+type JobInputTarget string
+
+const (
+	JobOutputContractAddress JobOutput = "contractAddress" // Address of the contract deployed by the job
+	JobOutputTxHash          JobOutput = "txHash"          // Hash of the transaction sent by the job
+)
+
+const (
+	JobInputTargetTo   JobInputTarget = "to"   // Recipient of the transaction
+	JobInputTargetData JobInputTarget = "data" // 32 bytes word of the transaction data
+)
+
+// JobDependency is a condition on the final status of another job of the schedule to start a job
+type JobDependency struct {
+	JobUUID string    `json:"jobUUID" validate:"required,uuid4" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	Status  JobStatus `json:"status" validate:"required,oneof=MINED FAILED" example:"MINED"`
+}
+
+// JobInput sets a field of the job transaction from an output of a MINED dependency when the job starts
+type JobInput struct {
+	JobUUID    string         `json:"jobUUID" validate:"required,uuid4" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	Output     JobOutput      `json:"output" validate:"required,oneof=contractAddress txHash" example:"contractAddress"`
+	Target     JobInputTarget `json:"target" validate:"required,oneof=to data" example:"data"`
+	DataOffset int            `json:"dataOffset,omitempty" validate:"omitempty,min=0" example:"4"`
+}
@@ -0,0 +1,18 @@
+package entities
+
+import "time"
+
+// JobEvent is a status transition of a job, as recorded in its logs
+type JobEvent struct {
+	UUID          string
+	JobUUID       string
+	ParentJobUUID string
+	ScheduleUUID  string
+	ChainUUID     string
+	TenantID      string
+	OwnerID       string
+	Labels        map[string]string
+	Status        JobStatus
+	Message       string
+	CreatedAt     time.Time
+}
@@ -5,9 +5,16 @@ import (
 )
 
 type Schedule struct {
-	UUID      string
-	TenantID  string
-	OwnerID   string
-	Jobs      []*Job
+	UUID     string
+	TenantID string
+	OwnerID  string
+	Jobs     []*Job
+	// NotBefore and NotAfter delimit the window in which jobs of the schedule are sent, jobs not sent when it closes are EXPIRED
+	NotBefore *time.Time
+	NotAfter  *time.Time
+	// Cron is the recurrence of the schedule, its first job is sent again at every occurrence
+	Cron      string
+	NextRunAt *time.Time
+	LastRunAt *time.Time
 	CreatedAt time.Time
 }
@@ -0,0 +1,20 @@
+package testdata
+
+import (
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/gofrs/uuid"
+)
+
+func FakeFailedJob() *entities.FailedJob {
+	return &entities.FailedJob{
+		UUID:         uuid.Must(uuid.NewV4()).String(),
+		JobUUID:      uuid.Must(uuid.NewV4()).String(),
+		ChainUUID:    uuid.Must(uuid.NewV4()).String(),
+		TenantID:     "tenantID",
+		OwnerID:      "ownerID",
+		EnvelopeID:   uuid.Must(uuid.NewV4()).String(),
+		ErrorCode:    "08000",
+		ErrorClass:   entities.FailedJobConnectionError,
+		ErrorMessage: "failed to connect to chain",
+	}
+}
@@ -0,0 +1,28 @@
+package testdata
+
+import (
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/gofrs/uuid"
+)
+
+func FakeWebhook() *entities.Webhook {
+	return &entities.Webhook{
+		UUID:      uuid.Must(uuid.NewV4()).String(),
+		TenantID:  "tenantID",
+		URL:       "https://example.com/notifications",
+		Secret:    "my-webhook-secret",
+		Statuses:  []entities.JobStatus{entities.StatusMined, entities.StatusFailed},
+		ChainUUID: uuid.Must(uuid.NewV4()).String(),
+		Labels:    map[string]string{"app": "payments"},
+	}
+}
+
+func FakeWebhookDelivery() *entities.WebhookDelivery {
+	return &entities.WebhookDelivery{
+		UUID:        uuid.Must(uuid.NewV4()).String(),
+		WebhookUUID: uuid.Must(uuid.NewV4()).String(),
+		JobUUID:     uuid.Must(uuid.NewV4()).String(),
+		JobStatus:   entities.StatusMined,
+		State:       entities.WebhookDeliveryPending,
+	}
+}
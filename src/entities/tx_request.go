@@ -7,9 +7,11 @@ import (
 type TxRequest struct {
 	IdempotencyKey string
 	ChainName      string
-	Schedule       *Schedule
-	Params         *ETHTransactionParams
-	Labels         map[string]string
-	InternalData   *InternalData
-	CreatedAt      time.Time
+	// JobUUID is the UUID of the first job of the request, generated if empty
+	JobUUID      string
+	Schedule     *Schedule
+	Params       *ETHTransactionParams
+	Labels       map[string]string
+	InternalData *InternalData
+	CreatedAt    time.Time
 }
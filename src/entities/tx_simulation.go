@@ -0,0 +1,31 @@
+package entities
+
+import (
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TxSimulation is the outcome of a transaction executed against the latest state of a chain without being sent
+type TxSimulation struct {
+	Transaction   *ETHTransaction
+	Fee           *hexutil.Big
+	Output        hexutil.Bytes
+	DecodedOutput map[string]string
+	Revert        *TxRevert
+	Logs          []*SimulatedLog
+	Warning       string
+}
+
+// SimulatedLog is a log emitted during a transaction simulation
+type SimulatedLog struct {
+	Address     ethcommon.Address
+	Topics      []ethcommon.Hash
+	Data        hexutil.Bytes
+	Event       string
+	DecodedData map[string]string
+}
+
+// Reverted indicates whether the simulated transaction failed
+func (s *TxSimulation) Reverted() bool {
+	return s.Revert != nil
+}
@@ -0,0 +1,38 @@
+package entities
+
+import "time"
+
+type WebhookDeliveryState string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryState = "PENDING"
+	WebhookDeliveryDelivered WebhookDeliveryState = "DELIVERED"
+	WebhookDeliveryFailed    WebhookDeliveryState = "FAILED"
+)
+
+// Webhook is a subscription to the status changes of the jobs of a tenant
+// Empty Statuses, ChainUUID and Labels filters match every job
+type Webhook struct {
+	UUID      string
+	TenantID  string
+	URL       string
+	Secret    string
+	Statuses  []JobStatus
+	ChainUUID string
+	Labels    map[string]string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type WebhookDelivery struct {
+	UUID           string
+	WebhookUUID    string
+	JobUUID        string
+	JobStatus      JobStatus
+	State          WebhookDeliveryState
+	Attempts       int
+	ResponseStatus int
+	Error          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -21,6 +21,8 @@ func init() {
 	_ = viper.BindEnv(TxDecodedViperKey, txDecodedTopicEnv)
 	viper.SetDefault(TxRecoverViperKey, txRecoverTopicDefault)
 	_ = viper.BindEnv(TxRecoverViperKey, txRecoverTopicEnv)
+	viper.SetDefault(TxRequestViperKey, txRequestTopicDefault)
+	_ = viper.BindEnv(TxRequestViperKey, txRequestTopicEnv)
 
 	// Kafka consumer group for tx workflow
 	viper.SetDefault(ConsumerGroupNameViperKey, consumerGroupNameDefault)
@@ -121,12 +123,18 @@ const (
 	TxRecoverViperKey     = "topic.tx.recover"
 	txRecoverTopicEnv     = "TOPIC_TX_RECOVER"
 	txRecoverTopicDefault = "topic-tx-recover"
+
+	txRequestFlag         = "topic-tx-request"
+	TxRequestViperKey     = "topic.tx.request"
+	txRequestTopicEnv     = "TOPIC_TX_REQUEST"
+	txRequestTopicDefault = "topic-tx-request"
 )
 
 type KafkaTopicConfig struct {
 	Sender  string
 	Decoded string
 	Recover string
+	Request string
 }
 
 func NewKafkaTopicConfig(vipr *viper.Viper) *KafkaTopicConfig {
@@ -134,6 +142,7 @@ func NewKafkaTopicConfig(vipr *viper.Viper) *KafkaTopicConfig {
 		Sender:  vipr.GetString(TxSenderViperKey),
 		Decoded: vipr.GetString(TxDecodedViperKey),
 		Recover: vipr.GetString(TxRecoverViperKey),
+		Request: vipr.GetString(TxRequestViperKey),
 	}
 }
 
@@ -163,6 +172,14 @@ Environment variable: %q`, txDecodedTopicEnv)
 	_ = viper.BindPFlag(TxDecodedViperKey, f.Lookup(txDecodedFlag))
 }
 
+// KafkaTopicTxRequest register flag for Kafka topic
+func KafkaTopicTxRequest(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Topic for transaction requests sent to the API.
+Environment variable: %q`, txRequestTopicEnv)
+	f.String(txRequestFlag, txRequestTopicDefault, desc)
+	_ = viper.BindPFlag(TxRequestViperKey, f.Lookup(txRequestFlag))
+}
+
 // Kafka Consumer group environment variables
 const (
 	consumerGroupNameFlag     = "consumer-group-name"
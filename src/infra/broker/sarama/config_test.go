@@ -85,6 +85,9 @@ func TestTopics(t *testing.T) {
 
 	KafkaTopicTxDecoded(flgs)
 	assert.Equal(t, "topic-tx-decoded", viper.GetString("topic.tx.decoded"), "From default")
+
+	KafkaTopicTxRequest(flgs)
+	assert.Equal(t, "topic-tx-request", viper.GetString("topic.tx.request"), "From default")
 }
 
 func TestConsumerGroupName(t *testing.T) {
@@ -135,6 +135,9 @@ type ContractCaller interface {
 	// PendingCallContract executes a message call transaction using the EVM.
 	// The state seen by the contract call is the pending state.
 	PendingCallContract(ctx context.Context, url string, msg *eth.CallMsg) ([]byte, error)
+
+	// TraceCall executes a message call transaction against the latest state and returns its call frames
+	TraceCall(ctx context.Context, url string, msg *eth.CallMsg) (*rpc.CallTrace, error)
 }
 
 // GasEstimator is a service that can provide transaction gas price estimation
@@ -148,14 +151,16 @@ type GasPricer interface {
 	// SuggestGasPrice retrieves the currently suggested gas price
 	SuggestGasPrice(ctx context.Context, url string) (*big.Int, error)
 
-	// FeeHistory retrieve historical baseFeeData
-	FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string) (*rpc.FeeHistory, error)
+	// FeeHistory retrieve historical baseFeeData and the given percentiles of the priority fees paid
+	FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string, rewardPercentiles []float64) (*rpc.FeeHistory, error)
 }
 
 // ChainSyncReader is a service to access to the node's current sync status
 type ChainSyncReader interface {
 	Network(ctx context.Context, url string) (*big.Int, error)
 	SyncProgress(ctx context.Context, url string) (*eth.SyncProgress, error)
+	// BlockNumber returns the number of the most recent block known by the node
+	BlockNumber(ctx context.Context, url string) (uint64, error)
 }
 
 type MultiClient interface {
@@ -677,6 +677,21 @@ func (mr *MockContractCallerMockRecorder) PendingCallContract(ctx, url, msg inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingCallContract", reflect.TypeOf((*MockContractCaller)(nil).PendingCallContract), ctx, url, msg)
 }
 
+// TraceCall mocks base method
+func (m *MockContractCaller) TraceCall(ctx context.Context, url string, msg *ethereum0.CallMsg) (*rpc.CallTrace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceCall", ctx, url, msg)
+	ret0, _ := ret[0].(*rpc.CallTrace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceCall indicates an expected call of TraceCall
+func (mr *MockContractCallerMockRecorder) TraceCall(ctx, url, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceCall", reflect.TypeOf((*MockContractCaller)(nil).TraceCall), ctx, url, msg)
+}
+
 // MockGasEstimator is a mock of GasEstimator interface
 type MockGasEstimator struct {
 	ctrl     *gomock.Controller
@@ -754,18 +769,18 @@ func (mr *MockGasPricerMockRecorder) SuggestGasPrice(ctx, url interface{}) *gomo
 }
 
 // FeeHistory mocks base method
-func (m *MockGasPricer) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string) (*rpc.FeeHistory, error) {
+func (m *MockGasPricer) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string, rewardPercentiles []float64) (*rpc.FeeHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock)
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock, rewardPercentiles)
 	ret0, _ := ret[0].(*rpc.FeeHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FeeHistory indicates an expected call of FeeHistory
-func (mr *MockGasPricerMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock interface{}) *gomock.Call {
+func (mr *MockGasPricerMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock, rewardPercentiles interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockGasPricer)(nil).FeeHistory), ctx, url, blockCount, newestBlock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockGasPricer)(nil).FeeHistory), ctx, url, blockCount, newestBlock, rewardPercentiles)
 }
 
 // MockChainSyncReader is a mock of ChainSyncReader interface
@@ -821,6 +836,21 @@ func (mr *MockChainSyncReaderMockRecorder) SyncProgress(ctx, url interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncProgress", reflect.TypeOf((*MockChainSyncReader)(nil).SyncProgress), ctx, url)
 }
 
+// BlockNumber mocks base method
+func (m *MockChainSyncReader) BlockNumber(ctx context.Context, url string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockNumber", ctx, url)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockNumber indicates an expected call of BlockNumber
+func (mr *MockChainSyncReaderMockRecorder) BlockNumber(ctx, url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockNumber", reflect.TypeOf((*MockChainSyncReader)(nil).BlockNumber), ctx, url)
+}
+
 // MockMultiClient is a mock of MultiClient interface
 type MockMultiClient struct {
 	ctrl     *gomock.Controller
@@ -1130,6 +1160,21 @@ func (mr *MockMultiClientMockRecorder) PendingCallContract(ctx, url, msg interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingCallContract", reflect.TypeOf((*MockMultiClient)(nil).PendingCallContract), ctx, url, msg)
 }
 
+// TraceCall mocks base method
+func (m *MockMultiClient) TraceCall(ctx context.Context, url string, msg *ethereum0.CallMsg) (*rpc.CallTrace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceCall", ctx, url, msg)
+	ret0, _ := ret[0].(*rpc.CallTrace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceCall indicates an expected call of TraceCall
+func (mr *MockMultiClientMockRecorder) TraceCall(ctx, url, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceCall", reflect.TypeOf((*MockMultiClient)(nil).TraceCall), ctx, url, msg)
+}
+
 // EstimateGas mocks base method
 func (m *MockMultiClient) EstimateGas(ctx context.Context, url string, msg *ethereum0.CallMsg) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -1161,18 +1206,18 @@ func (mr *MockMultiClientMockRecorder) SuggestGasPrice(ctx, url interface{}) *go
 }
 
 // FeeHistory mocks base method
-func (m *MockMultiClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string) (*rpc.FeeHistory, error) {
+func (m *MockMultiClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string, rewardPercentiles []float64) (*rpc.FeeHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock)
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock, rewardPercentiles)
 	ret0, _ := ret[0].(*rpc.FeeHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FeeHistory indicates an expected call of FeeHistory
-func (mr *MockMultiClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock interface{}) *gomock.Call {
+func (mr *MockMultiClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock, rewardPercentiles interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockMultiClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockMultiClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock, rewardPercentiles)
 }
 
 // Network mocks base method
@@ -1205,6 +1250,21 @@ func (mr *MockMultiClientMockRecorder) SyncProgress(ctx, url interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncProgress", reflect.TypeOf((*MockMultiClient)(nil).SyncProgress), ctx, url)
 }
 
+// BlockNumber mocks base method
+func (m *MockMultiClient) BlockNumber(ctx context.Context, url string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockNumber", ctx, url)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockNumber indicates an expected call of BlockNumber
+func (mr *MockMultiClientMockRecorder) BlockNumber(ctx, url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockNumber", reflect.TypeOf((*MockMultiClient)(nil).BlockNumber), ctx, url)
+}
+
 // PrivDistributeRawTransaction mocks base method
 func (m *MockMultiClient) PrivDistributeRawTransaction(ctx context.Context, endpoint string, raw hexutil.Bytes) (common.Hash, error) {
 	m.ctrl.T.Helper()
@@ -1698,6 +1758,21 @@ func (mr *MockClientMockRecorder) PendingCallContract(ctx, url, msg interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingCallContract", reflect.TypeOf((*MockClient)(nil).PendingCallContract), ctx, url, msg)
 }
 
+// TraceCall mocks base method
+func (m *MockClient) TraceCall(ctx context.Context, url string, msg *ethereum0.CallMsg) (*rpc.CallTrace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceCall", ctx, url, msg)
+	ret0, _ := ret[0].(*rpc.CallTrace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceCall indicates an expected call of TraceCall
+func (mr *MockClientMockRecorder) TraceCall(ctx, url, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceCall", reflect.TypeOf((*MockClient)(nil).TraceCall), ctx, url, msg)
+}
+
 // EstimateGas mocks base method
 func (m *MockClient) EstimateGas(ctx context.Context, url string, msg *ethereum0.CallMsg) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -1729,18 +1804,18 @@ func (mr *MockClientMockRecorder) SuggestGasPrice(ctx, url interface{}) *gomock.
 }
 
 // FeeHistory mocks base method
-func (m *MockClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string) (*rpc.FeeHistory, error) {
+func (m *MockClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string, rewardPercentiles []float64) (*rpc.FeeHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock)
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock, rewardPercentiles)
 	ret0, _ := ret[0].(*rpc.FeeHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FeeHistory indicates an expected call of FeeHistory
-func (mr *MockClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock, rewardPercentiles interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock, rewardPercentiles)
 }
 
 // Network mocks base method
@@ -1773,6 +1848,21 @@ func (mr *MockClientMockRecorder) SyncProgress(ctx, url interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncProgress", reflect.TypeOf((*MockClient)(nil).SyncProgress), ctx, url)
 }
 
+// BlockNumber mocks base method
+func (m *MockClient) BlockNumber(ctx context.Context, url string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockNumber", ctx, url)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockNumber indicates an expected call of BlockNumber
+func (mr *MockClientMockRecorder) BlockNumber(ctx, url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockNumber", reflect.TypeOf((*MockClient)(nil).BlockNumber), ctx, url)
+}
+
 // Call mocks base method
 func (m *MockClient) Call(ctx context.Context, endpoint string, processResult func(json.RawMessage) error, method string, args ...interface{}) error {
 	m.ctrl.T.Helper()
@@ -1845,6 +1935,21 @@ func (mr *MockEEAClientMockRecorder) PendingCallContract(ctx, url, msg interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingCallContract", reflect.TypeOf((*MockEEAClient)(nil).PendingCallContract), ctx, url, msg)
 }
 
+// TraceCall mocks base method
+func (m *MockEEAClient) TraceCall(ctx context.Context, url string, msg *ethereum0.CallMsg) (*rpc.CallTrace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceCall", ctx, url, msg)
+	ret0, _ := ret[0].(*rpc.CallTrace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceCall indicates an expected call of TraceCall
+func (mr *MockEEAClientMockRecorder) TraceCall(ctx, url, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceCall", reflect.TypeOf((*MockEEAClient)(nil).TraceCall), ctx, url, msg)
+}
+
 // EstimateGas mocks base method
 func (m *MockEEAClient) EstimateGas(ctx context.Context, url string, msg *ethereum0.CallMsg) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -1876,18 +1981,18 @@ func (mr *MockEEAClientMockRecorder) SuggestGasPrice(ctx, url interface{}) *gomo
 }
 
 // FeeHistory mocks base method
-func (m *MockEEAClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string) (*rpc.FeeHistory, error) {
+func (m *MockEEAClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string, rewardPercentiles []float64) (*rpc.FeeHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock)
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock, rewardPercentiles)
 	ret0, _ := ret[0].(*rpc.FeeHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FeeHistory indicates an expected call of FeeHistory
-func (mr *MockEEAClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock interface{}) *gomock.Call {
+func (mr *MockEEAClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock, rewardPercentiles interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockEEAClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockEEAClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock, rewardPercentiles)
 }
 
 // Network mocks base method
@@ -1920,6 +2025,21 @@ func (mr *MockEEAClientMockRecorder) SyncProgress(ctx, url interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncProgress", reflect.TypeOf((*MockEEAClient)(nil).SyncProgress), ctx, url)
 }
 
+// BlockNumber mocks base method
+func (m *MockEEAClient) BlockNumber(ctx context.Context, url string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockNumber", ctx, url)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockNumber indicates an expected call of BlockNumber
+func (mr *MockEEAClientMockRecorder) BlockNumber(ctx, url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockNumber", reflect.TypeOf((*MockEEAClient)(nil).BlockNumber), ctx, url)
+}
+
 // PrivDistributeRawTransaction mocks base method
 func (m *MockEEAClient) PrivDistributeRawTransaction(ctx context.Context, endpoint string, raw hexutil.Bytes) (common.Hash, error) {
 	m.ctrl.T.Helper()
@@ -2093,6 +2213,21 @@ func (mr *MockQuorumClientMockRecorder) PendingCallContract(ctx, url, msg interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingCallContract", reflect.TypeOf((*MockQuorumClient)(nil).PendingCallContract), ctx, url, msg)
 }
 
+// TraceCall mocks base method
+func (m *MockQuorumClient) TraceCall(ctx context.Context, url string, msg *ethereum0.CallMsg) (*rpc.CallTrace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceCall", ctx, url, msg)
+	ret0, _ := ret[0].(*rpc.CallTrace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceCall indicates an expected call of TraceCall
+func (mr *MockQuorumClientMockRecorder) TraceCall(ctx, url, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceCall", reflect.TypeOf((*MockQuorumClient)(nil).TraceCall), ctx, url, msg)
+}
+
 // EstimateGas mocks base method
 func (m *MockQuorumClient) EstimateGas(ctx context.Context, url string, msg *ethereum0.CallMsg) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -2124,18 +2259,18 @@ func (mr *MockQuorumClientMockRecorder) SuggestGasPrice(ctx, url interface{}) *g
 }
 
 // FeeHistory mocks base method
-func (m *MockQuorumClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string) (*rpc.FeeHistory, error) {
+func (m *MockQuorumClient) FeeHistory(ctx context.Context, url string, blockCount int, newestBlock string, rewardPercentiles []float64) (*rpc.FeeHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock)
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, url, blockCount, newestBlock, rewardPercentiles)
 	ret0, _ := ret[0].(*rpc.FeeHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FeeHistory indicates an expected call of FeeHistory
-func (mr *MockQuorumClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock interface{}) *gomock.Call {
+func (mr *MockQuorumClientMockRecorder) FeeHistory(ctx, url, blockCount, newestBlock, rewardPercentiles interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockQuorumClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockQuorumClient)(nil).FeeHistory), ctx, url, blockCount, newestBlock, rewardPercentiles)
 }
 
 // Network mocks base method
@@ -2168,6 +2303,21 @@ func (mr *MockQuorumClientMockRecorder) SyncProgress(ctx, url interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncProgress", reflect.TypeOf((*MockQuorumClient)(nil).SyncProgress), ctx, url)
 }
 
+// BlockNumber mocks base method
+func (m *MockQuorumClient) BlockNumber(ctx context.Context, url string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockNumber", ctx, url)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockNumber indicates an expected call of BlockNumber
+func (mr *MockQuorumClientMockRecorder) BlockNumber(ctx, url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockNumber", reflect.TypeOf((*MockQuorumClient)(nil).BlockNumber), ctx, url)
+}
+
 // BlockByHash mocks base method
 func (m *MockQuorumClient) BlockByHash(ctx context.Context, url string, hash common.Hash) (*types0.Block, error) {
 	m.ctrl.T.Helper()
@@ -196,7 +196,13 @@ func (ec *Client) processEthError(err *utils.JSONError) error {
 	if strings.Contains(err.Message, "nonce too low") || strings.Contains(err.Message, "Nonce too low") || strings.Contains(err.Message, "Incorrect nonce") {
 		return errors.NonceTooLowError("code: %d - message: %s", err.Code, err.Message)
 	}
-	return errors.EthereumError("code: %d - message: %s", err.Code, err.Message)
+
+	ierr := errors.EthereumError("code: %d - message: %s", err.Code, err.Message)
+	// Reverted calls return the revert data of the transaction (e.g. an encoded Error(string))
+	if data, ok := err.Data.(string); ok && data != "" {
+		_ = ierr.SetExtra(utils.RevertDataExtraKey, data)
+	}
+	return ierr
 }
 
 type txExtraInfo struct {
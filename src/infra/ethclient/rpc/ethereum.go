@@ -197,6 +197,16 @@ func (ec *Client) SyncProgress(ctx context.Context, endpoint string) (*eth.SyncP
 	}, nil
 }
 
+// BlockNumber returns the number of the most recent block known by the node
+func (ec *Client) BlockNumber(ctx context.Context, endpoint string) (uint64, error) {
+	var blockNumber hexutil.Uint64
+	err := ec.Call(ctx, endpoint, utils.ProcessResult(&blockNumber), "eth_blockNumber")
+	if err != nil {
+		return 0, errors.FromError(err).ExtendComponent(component)
+	}
+	return uint64(blockNumber), nil
+}
+
 // SendRawPrivateTransaction send a raw transaction to an Ethereum node supporting EEA extension
 func (ec *Client) Network(ctx context.Context, endpoint string) (*big.Int, error) {
 	var version string
@@ -329,6 +339,66 @@ func (ec *Client) PendingCallContract(ctx context.Context, endpoint string, msg
 	return hex, nil
 }
 
+// CallTrace is a call frame as returned by the callTracer of debug_traceCall
+type CallTrace struct {
+	From   ethcommon.Address  `json:"from"`
+	To     *ethcommon.Address `json:"to,omitempty"`
+	Output hexutil.Bytes      `json:"output,omitempty"`
+	Error  string             `json:"error,omitempty"`
+	Calls  []*CallTrace       `json:"calls,omitempty"`
+	Logs   []*CallTraceLog    `json:"logs,omitempty"`
+}
+
+// CallTraceLog is a log emitted by a call frame. Position is the number of sub-calls made by the frame before the log
+type CallTraceLog struct {
+	Address  ethcommon.Address `json:"address"`
+	Topics   []ethcommon.Hash  `json:"topics"`
+	Data     hexutil.Bytes     `json:"data"`
+	Position hexutil.Uint      `json:"position"`
+}
+
+// AllLogs returns the logs of the frame and of its sub-calls in emission order.
+// Logs of reverted frames are dropped as they are not part of the resulting state
+func (t *CallTrace) AllLogs() []*CallTraceLog {
+	if t.Error != "" {
+		return nil
+	}
+
+	var logs []*CallTraceLog
+	logIdx := 0
+	for callIdx := 0; callIdx <= len(t.Calls); callIdx++ {
+		for ; logIdx < len(t.Logs) && int(t.Logs[logIdx].Position) <= callIdx; logIdx++ {
+			logs = append(logs, t.Logs[logIdx])
+		}
+
+		if callIdx < len(t.Calls) {
+			logs = append(logs, t.Calls[callIdx].AllLogs()...)
+		}
+	}
+
+	return logs
+}
+
+// TraceCall executes a message call transaction against the latest state and returns its call frames, including
+// emitted logs. It requires the node to expose the debug namespace
+func (ec *Client) TraceCall(ctx context.Context, endpoint string, msg *eth.CallMsg) (*CallTrace, error) {
+	var trace *CallTrace
+	tracerConfig := map[string]interface{}{
+		"tracer":       "callTracer",
+		"tracerConfig": map[string]interface{}{"withLog": true},
+	}
+	err := ec.Call(ctx, endpoint, utils.ProcessResult(&trace), "debug_traceCall", toCallArg(msg), "latest", tracerConfig)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+
+	if trace == nil {
+		return nil, errors.NotFoundError("call trace not found")
+	}
+
+	return trace, nil
+}
+
 // SuggestGasPrice retrieves the currently suggested gas price to allow a timely
 // execution of a transaction.
 func (ec *Client) SuggestGasPrice(ctx context.Context, endpoint string) (*big.Int, error) {
@@ -365,9 +435,15 @@ func parseFeeHistoryResult(feeHistory **FeeHistory) ParseResultFunc {
 	}
 }
 
-func (ec *Client) FeeHistory(ctx context.Context, endpoint string, blockCount int, newestBlock string) (*FeeHistory, error) {
+// FeeHistory returns the base fees of the blockCount blocks up to newestBlock and the next one, with the given
+// percentiles of the priority fees paid in each block
+func (ec *Client) FeeHistory(ctx context.Context, endpoint string, blockCount int, newestBlock string, rewardPercentiles []float64) (*FeeHistory, error) {
+	if rewardPercentiles == nil {
+		rewardPercentiles = []float64{}
+	}
+
 	var feeHistory *FeeHistory
-	if err := ec.Call(ctx, endpoint, parseFeeHistoryResult(&feeHistory), "eth_feeHistory", blockCount, newestBlock, []interface{}{}); err != nil {
+	if err := ec.Call(ctx, endpoint, parseFeeHistoryResult(&feeHistory), "eth_feeHistory", blockCount, newestBlock, rewardPercentiles); err != nil {
 		return nil, errors.FromError(err).ExtendComponent(component)
 	}
 
@@ -43,6 +43,11 @@ func TestProcessEthError(t *testing.T) {
 	// Default
 	err = ec.processEthError(&utils.JSONError{Message: "json-rpc: failed"})
 	assert.Equal(t, "BE000", errors.FromError(err).Hex(), "Error code should be correst")
+
+	// Reverted call
+	err = ec.processEthError(&utils.JSONError{Code: 3, Message: "execution reverted", Data: "0x08c379a0"})
+	assert.Equal(t, "BE000", errors.FromError(err).Hex(), "Error code should be correst")
+	assert.Equal(t, "0x08c379a0", errors.FromError(err).GetExtra()[utils.RevertDataExtraKey], "Revert data should be kept")
 }
 
 
@@ -391,6 +396,21 @@ func TestSyncProgress(t *testing.T) {
 	assert.Equal(t, expectedProgress.StartingBlock, prgrss.StartingBlock, "#3 SyncProgress should nbe correct")
 }
 
+func TestBlockNumber(t *testing.T) {
+	ec := newEthereumClient()
+
+	// Test 1 with Error
+	ctx := testutils.NewContext(fmt.Errorf("test-error"), 0, nil)
+	_, err := ec.BlockNumber(ctx, "test-endpoint")
+	assert.Error(t, err, "#1 BlockNumber should  error")
+
+	// Test 2 without error
+	ctx = testutils.NewContext(nil, 200, testutils.MakeRespBody(hexutil.Uint64(1234), ""))
+	blockNumber, err := ec.BlockNumber(ctx, "test-endpoint")
+	assert.NoError(t, err, "#2 BlockNumber should not error")
+	assert.Equal(t, uint64(1234), blockNumber, "#2 BlockNumber should be correct")
+}
+
 func TestBalanceAt(t *testing.T) {
 	ec := newEthereumClient()
 
@@ -551,6 +571,48 @@ func TestPendingCallContract(t *testing.T) {
 	assert.Equal(t, hexutil.Encode(expectedContract), hexutil.Encode(contract), "#3 PendingCallContract code should be correct")
 }
 
+func TestTraceCall(t *testing.T) {
+	ec := newEthereumClient()
+
+	// Test 1 with Error
+	ctx := testutils.NewContext(fmt.Errorf("test-error"), 0, nil)
+	_, err := ec.TraceCall(ctx, "test-endpoint", &eth.CallMsg{})
+	assert.Error(t, err, "#1 TraceCall should error")
+
+	// Test 2 without error
+	to := ethcommon.HexToAddress("0xdbb881a51CD4023E4400CEF3ef73046743f08da3")
+	expectedTrace := &CallTrace{
+		To:     &to,
+		Output: hexutil.MustDecode("0x01"),
+		Logs:   []*CallTraceLog{{Address: to, Topics: []ethcommon.Hash{{0x1}}, Data: hexutil.MustDecode("0x02")}},
+	}
+	ctx = testutils.NewContext(nil, 200, testutils.MakeRespBody(expectedTrace, ""))
+	trace, err := ec.TraceCall(ctx, "test-endpoint", &eth.CallMsg{})
+	assert.NoError(t, err, "#2 TraceCall should not error")
+	assert.Equal(t, expectedTrace, trace, "#2 TraceCall trace should be correct")
+}
+
+func TestCallTrace_AllLogs(t *testing.T) {
+	log := func(data string, position uint) *CallTraceLog {
+		return &CallTraceLog{Data: hexutil.MustDecode(data), Position: hexutil.Uint(position)}
+	}
+
+	trace := &CallTrace{
+		Logs: []*CallTraceLog{log("0x01", 0), log("0x04", 1), log("0x06", 2)},
+		Calls: []*CallTrace{
+			{Logs: []*CallTraceLog{log("0x02", 0), log("0x03", 0)}},
+			{Logs: []*CallTraceLog{log("0xff", 0)}, Error: "execution reverted"},
+			{Calls: []*CallTrace{{Logs: []*CallTraceLog{log("0x07", 0)}}}},
+		},
+	}
+
+	var data []string
+	for _, l := range trace.AllLogs() {
+		data = append(data, l.Data.String())
+	}
+	assert.Equal(t, []string{"0x01", "0x02", "0x03", "0x04", "0x06", "0x07"}, data)
+}
+
 func TestSuggestGasPrice(t *testing.T) {
 	ec := newEthereumClient()
 
@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 )
 
+// RevertDataExtraKey is the key of the error extra information holding the data returned by a reverted call
+const RevertDataExtraKey = "revertData"
+
 // Similar struct a https://github.com/ethereum/go-ethereum/blob/master/rpc/json.go
 type JSONRpcMessage struct {
 	Version string          `json:"jsonrpc,omitempty"`
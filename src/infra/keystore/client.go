@@ -0,0 +1,88 @@
+package keystore
+
+import (
+	"context"
+
+	qkm "github.com/consensys/quorum-key-manager/pkg/client"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+)
+
+// Client is a Key Manager client routing the Ethereum account operations of the registered stores to their KeyStore.
+// Operations targeting any other store name are forwarded to the Quorum Key Manager.
+type Client struct {
+	qkm.KeyManagerClient
+	stores map[string]KeyStore
+}
+
+var _ qkm.KeyManagerClient = &Client{}
+
+func NewClient(keyManagerClient qkm.KeyManagerClient, stores map[string]KeyStore) *Client {
+	return &Client{
+		KeyManagerClient: keyManagerClient,
+		stores:           stores,
+	}
+}
+
+func (c *Client) CreateEthAccount(ctx context.Context, storeName string, request *qkmtypes.CreateEthAccountRequest) (*qkmtypes.EthAccountResponse, error) {
+	if store, ok := c.stores[storeName]; ok {
+		return store.CreateEthAccount(ctx, request)
+	}
+
+	return c.KeyManagerClient.CreateEthAccount(ctx, storeName, request)
+}
+
+func (c *Client) ImportEthAccount(ctx context.Context, storeName string, request *qkmtypes.ImportEthAccountRequest) (*qkmtypes.EthAccountResponse, error) {
+	if store, ok := c.stores[storeName]; ok {
+		return store.ImportEthAccount(ctx, request)
+	}
+
+	return c.KeyManagerClient.ImportEthAccount(ctx, storeName, request)
+}
+
+func (c *Client) GetEthAccount(ctx context.Context, storeName, address string) (*qkmtypes.EthAccountResponse, error) {
+	if store, ok := c.stores[storeName]; ok {
+		return store.GetEthAccount(ctx, address)
+	}
+
+	return c.KeyManagerClient.GetEthAccount(ctx, storeName, address)
+}
+
+func (c *Client) SignMessage(ctx context.Context, storeName, address string, request *qkmtypes.SignMessageRequest) (string, error) {
+	if store, ok := c.stores[storeName]; ok {
+		return store.SignMessage(ctx, address, request)
+	}
+
+	return c.KeyManagerClient.SignMessage(ctx, storeName, address, request)
+}
+
+func (c *Client) SignTypedData(ctx context.Context, storeName, address string, request *qkmtypes.SignTypedDataRequest) (string, error) {
+	if store, ok := c.stores[storeName]; ok {
+		return store.SignTypedData(ctx, address, request)
+	}
+
+	return c.KeyManagerClient.SignTypedData(ctx, storeName, address, request)
+}
+
+func (c *Client) SignTransaction(ctx context.Context, storeName, address string, request *qkmtypes.SignETHTransactionRequest) (string, error) {
+	if store, ok := c.stores[storeName]; ok {
+		return store.SignTransaction(ctx, address, request)
+	}
+
+	return c.KeyManagerClient.SignTransaction(ctx, storeName, address, request)
+}
+
+func (c *Client) SignQuorumPrivateTransaction(ctx context.Context, storeName, address string, request *qkmtypes.SignQuorumPrivateTransactionRequest) (string, error) {
+	if store, ok := c.stores[storeName]; ok {
+		return store.SignQuorumPrivateTransaction(ctx, address, request)
+	}
+
+	return c.KeyManagerClient.SignQuorumPrivateTransaction(ctx, storeName, address, request)
+}
+
+func (c *Client) SignEEATransaction(ctx context.Context, storeName, address string, request *qkmtypes.SignEEATransactionRequest) (string, error) {
+	if store, ok := c.stores[storeName]; ok {
+		return store.SignEEATransaction(ctx, address, request)
+	}
+
+	return c.KeyManagerClient.SignEEATransaction(ctx, storeName, address, request)
+}
@@ -0,0 +1,75 @@
+// +build unit
+
+package keystore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/src/infra/keystore/mocks"
+	qkmmock "github.com/consensys/quorum-key-manager/pkg/client/mock"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	qkmClient := qkmmock.NewMockKeyManagerClient(ctrl)
+	localStore := mocks.NewMockKeyStore(ctrl)
+	client := NewClient(qkmClient, map[string]KeyStore{"local": localStore})
+	address := "0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"
+
+	t.Run("should route account creation to the local store", func(t *testing.T) {
+		req := &qkmtypes.CreateEthAccountRequest{KeyID: "my-key"}
+		expected := &qkmtypes.EthAccountResponse{KeyID: "my-key"}
+		localStore.EXPECT().CreateEthAccount(gomock.Any(), req).Return(expected, nil)
+
+		resp, err := client.CreateEthAccount(ctx, "local", req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, resp)
+	})
+
+	t.Run("should route account creation of other stores to the Key Manager", func(t *testing.T) {
+		req := &qkmtypes.CreateEthAccountRequest{KeyID: "my-key"}
+		expected := &qkmtypes.EthAccountResponse{KeyID: "my-key"}
+		qkmClient.EXPECT().CreateEthAccount(gomock.Any(), "qkm-store", req).Return(expected, nil)
+
+		resp, err := client.CreateEthAccount(ctx, "qkm-store", req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, resp)
+	})
+
+	t.Run("should route transaction signing to the local store", func(t *testing.T) {
+		req := &qkmtypes.SignETHTransactionRequest{GasLimit: 21000}
+		localStore.EXPECT().SignTransaction(gomock.Any(), address, req).Return("0xsigned", nil)
+
+		signedRaw, err := client.SignTransaction(ctx, "local", address, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "0xsigned", signedRaw)
+	})
+
+	t.Run("should route private transaction signing to the Key Manager", func(t *testing.T) {
+		req := &qkmtypes.SignEEATransactionRequest{PrivateFrom: "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="}
+		qkmClient.EXPECT().SignEEATransaction(gomock.Any(), "qkm-store", address, req).Return("0xsigned", nil)
+
+		signedRaw, err := client.SignEEATransaction(ctx, "qkm-store", address, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "0xsigned", signedRaw)
+	})
+
+	t.Run("should forward operations not supported by key stores to the Key Manager", func(t *testing.T) {
+		qkmClient.EXPECT().DeleteEthAccount(gomock.Any(), "local", address).Return(nil)
+
+		err := client.DeleteEthAccount(ctx, "local", address)
+
+		assert.NoError(t, err)
+	})
+}
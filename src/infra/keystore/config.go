@@ -0,0 +1,60 @@
+package keystore
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(LocalStoreNameViperKey, localStoreNameDefault)
+	_ = viper.BindEnv(LocalStoreNameViperKey, localStoreNameEnv)
+	viper.SetDefault(LocalMasterKeyFileViperKey, localMasterKeyFileDefault)
+	_ = viper.BindEnv(LocalMasterKeyFileViperKey, localMasterKeyFileEnv)
+}
+
+const (
+	localStoreNameFlag     = "key-store-local-name"
+	LocalStoreNameViperKey = "key.store.local.name"
+	localStoreNameDefault  = ""
+	localStoreNameEnv      = "KEY_STORE_LOCAL_NAME"
+)
+
+func localStoreName(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Name of the local key store, accounts created with this store ID are kept encrypted in Postgres instead of the Key Manager (disabled if empty).
+Environment variable: %q`, localStoreNameEnv)
+	f.String(localStoreNameFlag, localStoreNameDefault, desc)
+	_ = viper.BindPFlag(LocalStoreNameViperKey, f.Lookup(localStoreNameFlag))
+}
+
+const (
+	localMasterKeyFileFlag     = "key-store-local-master-key-file"
+	LocalMasterKeyFileViperKey = "key.store.local.master.key.file"
+	localMasterKeyFileDefault  = ""
+	localMasterKeyFileEnv      = "KEY_STORE_LOCAL_MASTER_KEY_FILE"
+)
+
+func localMasterKeyFile(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Path to the file holding the hex encoded 32 bytes master key of the local key store.
+Environment variable: %q`, localMasterKeyFileEnv)
+	f.String(localMasterKeyFileFlag, localMasterKeyFileDefault, desc)
+	_ = viper.BindPFlag(LocalMasterKeyFileViperKey, f.Lookup(localMasterKeyFileFlag))
+}
+
+func Flags(f *pflag.FlagSet) {
+	localStoreName(f)
+	localMasterKeyFile(f)
+}
+
+type Config struct {
+	LocalStoreName     string
+	LocalMasterKeyFile string
+}
+
+func NewConfig(vipr *viper.Viper) *Config {
+	return &Config{
+		LocalStoreName:     vipr.GetString(LocalStoreNameViperKey),
+		LocalMasterKeyFile: vipr.GetString(LocalMasterKeyFileViperKey),
+	}
+}
@@ -0,0 +1,61 @@
+package keystore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/infra/database/postgres"
+	"github.com/consensys/orchestrate/src/infra/keystore/local"
+	qkm "github.com/consensys/orchestrate/src/infra/quorum-key-manager"
+	"github.com/spf13/viper"
+)
+
+const component = "keystore"
+
+var (
+	client   *Client
+	initOnce = &sync.Once{}
+)
+
+var _ KeyStore = &local.Store{}
+
+// Init initializes the Key Manager client of the Quorum Key Manager and, if configured, registers the local key store
+func Init(ctx context.Context) {
+	initOnce.Do(func() {
+		if client != nil {
+			return
+		}
+
+		qkm.Init()
+
+		vipr := viper.GetViper()
+		logger := log.NewLogger().SetComponent(component)
+		cfg := NewConfig(vipr)
+		stores := make(map[string]KeyStore)
+		if cfg.LocalStoreName != "" {
+			masterKey, err := local.LoadMasterKey(cfg.LocalMasterKeyFile)
+			if err != nil {
+				logger.WithError(err).Fatal("failed to load master key of local key store")
+				return
+			}
+
+			opts, err := postgres.NewConfig(vipr).PGOptions()
+			if err != nil {
+				logger.WithError(err).Fatal("failed to load postgres options of local key store")
+				return
+			}
+
+			db := postgres.GetManager().Connect(ctx, opts)
+			stores[cfg.LocalStoreName] = local.New(local.NewPGStorage(db), masterKey)
+			logger.WithField("store_name", cfg.LocalStoreName).Info("local key store ready")
+		}
+
+		client = NewClient(qkm.GlobalClient(), stores)
+	})
+}
+
+// GlobalClient returns the Key Manager client routing to the registered key stores
+func GlobalClient() *Client {
+	return client
+}
@@ -0,0 +1,23 @@
+package keystore
+
+import (
+	"context"
+
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+)
+
+//go:generate mockgen -source=keystore.go -destination=mocks/keystore.go -package=mocks
+
+// KeyStore is a backend holding Ethereum accounts on behalf of Orchestrate.
+// Requests and responses are the ones of the Quorum Key Manager so that stores are interchangeable
+// and signed payloads are returned hex encoded.
+type KeyStore interface {
+	CreateEthAccount(ctx context.Context, request *qkmtypes.CreateEthAccountRequest) (*qkmtypes.EthAccountResponse, error)
+	ImportEthAccount(ctx context.Context, request *qkmtypes.ImportEthAccountRequest) (*qkmtypes.EthAccountResponse, error)
+	GetEthAccount(ctx context.Context, address string) (*qkmtypes.EthAccountResponse, error)
+	SignMessage(ctx context.Context, address string, request *qkmtypes.SignMessageRequest) (string, error)
+	SignTypedData(ctx context.Context, address string, request *qkmtypes.SignTypedDataRequest) (string, error)
+	SignTransaction(ctx context.Context, address string, request *qkmtypes.SignETHTransactionRequest) (string, error)
+	SignQuorumPrivateTransaction(ctx context.Context, address string, request *qkmtypes.SignQuorumPrivateTransactionRequest) (string, error)
+	SignEEATransaction(ctx context.Context, address string, request *qkmtypes.SignEEATransactionRequest) (string, error)
+}
@@ -0,0 +1,110 @@
+package local
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"io/ioutil"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+)
+
+const dataKeySize = 32
+
+// LoadMasterKey reads the hex encoded AES-256 master key of the store from a file
+func LoadMasterKey(path string) ([]byte, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.ConfigError("failed to read master key file").AppendReason(err.Error())
+	}
+
+	masterKey, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(content)), "0x"))
+	if err != nil {
+		return nil, errors.ConfigError("master key must be hex encoded").AppendReason(err.Error())
+	}
+
+	if len(masterKey) != dataKeySize {
+		return nil, errors.ConfigError("master key must be %d bytes long", dataKeySize)
+	}
+
+	return masterKey, nil
+}
+
+// seal encrypts the payload with a new data key and returns the payload and the data key encrypted with the master key
+func seal(masterKey, payload []byte) (encryptedPayload, encryptedDataKey []byte, err error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err = rand.Read(dataKey); err != nil {
+		return nil, nil, errors.CryptoOperationError("failed to generate data key").AppendReason(err.Error())
+	}
+
+	encryptedPayload, err = encrypt(dataKey, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	encryptedDataKey, err = encrypt(masterKey, dataKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encryptedPayload, encryptedDataKey, nil
+}
+
+// open decrypts the data key with the master key, then the payload with the data key
+func open(masterKey, encryptedPayload, encryptedDataKey []byte) ([]byte, error) {
+	dataKey, err := decrypt(masterKey, encryptedDataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(dataKey, encryptedPayload)
+}
+
+// encrypt uses AES-GCM and prepends the random nonce to the cipher text
+func encrypt(key, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, errors.CryptoOperationError("failed to generate nonce").AppendReason(err.Error())
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func decrypt(key, ciphertext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.CryptoOperationError("invalid encrypted payload")
+	}
+
+	plaintext, err := aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.CryptoOperationError("failed to decrypt payload").AppendReason(err.Error())
+	}
+
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.CryptoOperationError("invalid encryption key").AppendReason(err.Error())
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.CryptoOperationError("failed to create cipher").AppendReason(err.Error())
+	}
+
+	return aead, nil
+}
@@ -0,0 +1,72 @@
+// +build unit
+
+package local
+
+import (
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelope(t *testing.T) {
+	masterKey := make([]byte, dataKeySize)
+	_, err := rand.Read(masterKey)
+	require.NoError(t, err)
+	payload := []byte("my-private-key")
+
+	t.Run("should seal and open payload successfully", func(t *testing.T) {
+		encryptedPayload, encryptedDataKey, err := seal(masterKey, payload)
+		require.NoError(t, err)
+
+		assert.NotContains(t, string(encryptedPayload), string(payload))
+		assert.Len(t, encryptedDataKey, 12+dataKeySize+16)
+
+		opened, err := open(masterKey, encryptedPayload, encryptedDataKey)
+		assert.NoError(t, err)
+		assert.Equal(t, payload, opened)
+	})
+
+	t.Run("should fail with CryptoOperationError if master key is wrong", func(t *testing.T) {
+		encryptedPayload, encryptedDataKey, err := seal(masterKey, payload)
+		require.NoError(t, err)
+
+		wrongKey := make([]byte, dataKeySize)
+		_, err = open(wrongKey, encryptedPayload, encryptedDataKey)
+		assert.True(t, errors.IsCryptoOperationError(err))
+	})
+}
+
+func TestLoadMasterKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	t.Run("should load hex encoded master key successfully", func(t *testing.T) {
+		path := filepath.Join(dir, "master.key")
+		require.NoError(t, ioutil.WriteFile(path, []byte("0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20\n"), 0600))
+
+		masterKey, err := LoadMasterKey(path)
+		assert.NoError(t, err)
+		assert.Len(t, masterKey, dataKeySize)
+		assert.Equal(t, byte(0x20), masterKey[31])
+	})
+
+	t.Run("should fail with ConfigError if master key has wrong size", func(t *testing.T) {
+		path := filepath.Join(dir, "short.key")
+		require.NoError(t, ioutil.WriteFile(path, []byte("0102"), 0600))
+
+		_, err := LoadMasterKey(path)
+		assert.Equal(t, errors.Config, errors.FromError(err).GetCode())
+	})
+
+	t.Run("should fail with ConfigError if file does not exist", func(t *testing.T) {
+		_, err := LoadMasterKey(filepath.Join(dir, "missing.key"))
+		assert.Equal(t, errors.Config, errors.FromError(err).GetCode())
+	})
+}
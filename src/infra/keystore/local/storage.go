@@ -0,0 +1,78 @@
+package local
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	pg "github.com/consensys/orchestrate/src/infra/database/postgres"
+)
+
+//go:generate mockgen -source=storage.go -destination=mocks/storage.go -package=mocks
+
+const storageComponent = "keystore.local.storage"
+
+// Key is an Ethereum account of the local key store. The private key is encrypted with a data key, itself
+// encrypted with the master key of the store
+type Key struct {
+	tableName struct{} `pg:"keystore_keys"` // nolint:unused,structcheck // reason
+
+	ID                  int
+	Address             string
+	KeyID               string
+	PublicKey           string
+	CompressedPublicKey string
+	EncryptedPrivateKey []byte
+	EncryptedDataKey    []byte
+	Tags                map[string]string
+
+	CreatedAt time.Time `pg:"default:now()"`
+	UpdatedAt time.Time `pg:"default:now()"`
+}
+
+type Storage interface {
+	Insert(ctx context.Context, key *Key) error
+	FindOneByAddress(ctx context.Context, address string) (*Key, error)
+}
+
+// PGStorage is a Storage of the local key store for PostgreSQL
+type PGStorage struct {
+	db     pg.DB
+	logger *log.Logger
+}
+
+// NewPGStorage creates a new PGStorage
+func NewPGStorage(db pg.DB) *PGStorage {
+	return &PGStorage{
+		db:     db,
+		logger: log.NewLogger().SetComponent(storageComponent),
+	}
+}
+
+func (s *PGStorage) Insert(ctx context.Context, key *Key) error {
+	err := pg.Insert(ctx, s.db, key)
+	if err != nil {
+		errMsg := "failed to insert key"
+		s.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return errors.FromError(err).SetMessage(errMsg).ExtendComponent(storageComponent)
+	}
+
+	return nil
+}
+
+func (s *PGStorage) FindOneByAddress(ctx context.Context, address string) (*Key, error) {
+	key := &Key{}
+
+	query := s.db.ModelContext(ctx, key).Where("address = ?", address)
+	err := pg.SelectOne(ctx, query)
+	if err != nil {
+		errMsg := "failed to find one key by address"
+		if !errors.IsNotFoundError(err) {
+			s.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		}
+		return nil, errors.FromError(err).SetMessage(errMsg).ExtendComponent(storageComponent)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,361 @@
+package local
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+
+	pkgcrypto "github.com/consensys/orchestrate/pkg/crypto/ethereum"
+	"github.com/consensys/orchestrate/pkg/encoding/rlp"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/entities"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	quorumtypes "github.com/consensys/quorum/core/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	signercore "github.com/ethereum/go-ethereum/signer/core"
+)
+
+const (
+	component          = "keystore.local"
+	eip712DomainLabel  = "EIP712Domain"
+	homesteadRecoveryV = 27
+)
+
+// Store is a KeyStore keeping the Ethereum accounts in a Storage, envelope encrypted with a master key
+type Store struct {
+	storage   Storage
+	masterKey []byte
+	logger    *log.Logger
+}
+
+func New(storage Storage, masterKey []byte) *Store {
+	return &Store{
+		storage:   storage,
+		masterKey: masterKey,
+		logger:    log.NewLogger().SetComponent(component),
+	}
+}
+
+func (s *Store) CreateEthAccount(ctx context.Context, request *qkmtypes.CreateEthAccountRequest) (*qkmtypes.EthAccountResponse, error) {
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		errMessage := "failed to generate Ethereum account"
+		s.logger.WithContext(ctx).WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	return s.insert(ctx, request.KeyID, privKey, request.Tags)
+}
+
+func (s *Store) ImportEthAccount(ctx context.Context, request *qkmtypes.ImportEthAccountRequest) (*qkmtypes.EthAccountResponse, error) {
+	privKey, err := crypto.ToECDSA(request.PrivateKey)
+	if err != nil {
+		errMessage := "invalid private key"
+		s.logger.WithContext(ctx).WithError(err).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage).AppendReason(err.Error())
+	}
+
+	return s.insert(ctx, request.KeyID, privKey, request.Tags)
+}
+
+func (s *Store) GetEthAccount(ctx context.Context, address string) (*qkmtypes.EthAccountResponse, error) {
+	key, err := s.storage.FindOneByAddress(ctx, ethcommon.HexToAddress(address).Hex())
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+
+	return formatResponse(key), nil
+}
+
+func (s *Store) SignMessage(ctx context.Context, address string, request *qkmtypes.SignMessageRequest) (string, error) {
+	signature, err := s.signHomestead(ctx, address, accounts.TextHash(request.Message))
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+
+	return hexutil.Encode(signature), nil
+}
+
+func (s *Store) SignTypedData(ctx context.Context, address string, request *qkmtypes.SignTypedDataRequest) (string, error) {
+	encodedData, err := encodeTypedData(request)
+	if err != nil {
+		errMessage := "failed to format typed data"
+		s.logger.WithContext(ctx).WithError(err).Error(errMessage)
+		return "", errors.InvalidParameterError(errMessage).AppendReason(err.Error())
+	}
+
+	signature, err := s.signHomestead(ctx, address, crypto.Keccak256(encodedData))
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+
+	return hexutil.Encode(signature), nil
+}
+
+func (s *Store) SignTransaction(ctx context.Context, address string, request *qkmtypes.SignETHTransactionRequest) (string, error) {
+	tx, err := newTransaction(request)
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+
+	privKey, err := s.privateKey(ctx, address)
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+
+	signedTx, err := types.SignTx(tx, types.NewLondonSigner(request.ChainID.ToInt()), privKey)
+	if err != nil {
+		errMessage := "failed to sign transaction"
+		s.logger.WithContext(ctx).WithError(err).Error(errMessage)
+		return "", errors.CryptoOperationError(errMessage).ExtendComponent(component)
+	}
+
+	signedRaw, err := signedTx.MarshalBinary()
+	if err != nil {
+		errMessage := "failed to RLP encode signed transaction"
+		s.logger.WithContext(ctx).WithError(err).Error(errMessage)
+		return "", errors.EncodingError(errMessage).ExtendComponent(component)
+	}
+
+	return hexutil.Encode(signedRaw), nil
+}
+
+func (s *Store) SignQuorumPrivateTransaction(ctx context.Context, address string, request *qkmtypes.SignQuorumPrivateTransactionRequest) (string, error) {
+	var tx *quorumtypes.Transaction
+	if request.To == nil {
+		tx = quorumtypes.NewContractCreation(uint64(request.Nonce), request.Value.ToInt(), uint64(request.GasLimit), request.GasPrice.ToInt(), request.Data)
+	} else {
+		tx = quorumtypes.NewTransaction(uint64(request.Nonce), *request.To, request.Value.ToInt(), uint64(request.GasLimit), request.GasPrice.ToInt(), request.Data)
+	}
+	tx.SetPrivate()
+
+	privKey, err := s.privateKey(ctx, address)
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+
+	signer := pkgcrypto.GetQuorumPrivateTxSigner()
+	signature, err := pkgcrypto.SignQuorumPrivateTransaction(tx, privKey, signer)
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+
+	signedTx, err := tx.WithSignature(signer, signature)
+	if err != nil {
+		errMessage := "failed to set quorum private transaction signature"
+		s.logger.WithContext(ctx).WithError(err).Error(errMessage)
+		return "", errors.InvalidParameterError(errMessage).ExtendComponent(component)
+	}
+
+	signedRaw, err := rlp.Encode(signedTx)
+	if err != nil {
+		errMessage := "failed to RLP encode signed quorum private transaction"
+		s.logger.WithContext(ctx).WithError(err).Error(errMessage)
+		return "", errors.EncodingError(errMessage).ExtendComponent(component)
+	}
+
+	return hexutil.Encode(signedRaw), nil
+}
+
+func (s *Store) SignEEATransaction(ctx context.Context, address string, request *qkmtypes.SignEEATransactionRequest) (string, error) {
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    uint64(request.Nonce),
+		GasPrice: request.GasPrice.ToInt(),
+		Gas:      uint64(request.GasLimit),
+		To:       request.To,
+		Value:    request.Value.ToInt(),
+		Data:     request.Data,
+	})
+	privateArgs := &entities.PrivateETHTransactionParams{
+		PrivateFrom:    request.PrivateFrom,
+		PrivateFor:     request.PrivateFor,
+		PrivacyGroupID: request.PrivacyGroupID,
+		PrivateTxType:  entities.PrivateTxTypeRestricted,
+	}
+
+	privKey, err := s.privateKey(ctx, address)
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+
+	signature, err := pkgcrypto.SignEEATransaction(tx, privateArgs, request.ChainID.ToInt(), privKey)
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+
+	signedRaw, err := pkgcrypto.EncodeSignedEEATransaction(tx, privateArgs, signature, request.ChainID.ToInt())
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+
+	return hexutil.Encode(signedRaw), nil
+}
+
+func (s *Store) insert(ctx context.Context, keyID string, privKey *ecdsa.PrivateKey, tags map[string]string) (*qkmtypes.EthAccountResponse, error) {
+	encryptedPrivKey, encryptedDataKey, err := seal(s.masterKey, crypto.FromECDSA(privKey))
+	if err != nil {
+		s.logger.WithContext(ctx).WithError(err).Error("failed to encrypt private key")
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+
+	key := &Key{
+		Address:             crypto.PubkeyToAddress(privKey.PublicKey).Hex(),
+		KeyID:               keyID,
+		PublicKey:           hexutil.Encode(crypto.FromECDSAPub(&privKey.PublicKey)),
+		CompressedPublicKey: hexutil.Encode(crypto.CompressPubkey(&privKey.PublicKey)),
+		EncryptedPrivateKey: encryptedPrivKey,
+		EncryptedDataKey:    encryptedDataKey,
+		Tags:                tags,
+	}
+
+	err = s.storage.Insert(ctx, key)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+
+	s.logger.WithContext(ctx).WithField("address", key.Address).Info("ethereum account stored successfully")
+	return formatResponse(key), nil
+}
+
+func (s *Store) privateKey(ctx context.Context, address string) (*ecdsa.PrivateKey, error) {
+	key, err := s.storage.FindOneByAddress(ctx, ethcommon.HexToAddress(address).Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	rawPrivKey, err := open(s.masterKey, key.EncryptedPrivateKey, key.EncryptedDataKey)
+	if err != nil {
+		s.logger.WithContext(ctx).WithError(err).WithField("address", key.Address).Error("failed to decrypt private key")
+		return nil, err
+	}
+
+	privKey, err := crypto.ToECDSA(rawPrivKey)
+	if err != nil {
+		return nil, errors.DataCorruptedError("invalid private key").AppendReason(err.Error())
+	}
+
+	return privKey, nil
+}
+
+// signHomestead signs the hash and sets the recovery ID to 27 or 28 as expected by ecrecover
+func (s *Store) signHomestead(ctx context.Context, address string, hash []byte) ([]byte, error) {
+	privKey, err := s.privateKey(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.Sign(hash, privKey)
+	if err != nil {
+		return nil, errors.CryptoOperationError("failed to sign payload").AppendReason(err.Error())
+	}
+	signature[crypto.RecoveryIDOffset] += homesteadRecoveryV
+
+	return signature, nil
+}
+
+func newTransaction(request *qkmtypes.SignETHTransactionRequest) (*types.Transaction, error) {
+	switch request.TransactionType {
+	case qkmtypes.LegacyTxType:
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    uint64(request.Nonce),
+			GasPrice: request.GasPrice.ToInt(),
+			Gas:      uint64(request.GasLimit),
+			To:       request.To,
+			Value:    request.Value.ToInt(),
+			Data:     request.Data,
+		}), nil
+	case qkmtypes.AccessListTxType:
+		return types.NewTx(&types.AccessListTx{
+			ChainID:    request.ChainID.ToInt(),
+			Nonce:      uint64(request.Nonce),
+			GasPrice:   request.GasPrice.ToInt(),
+			Gas:        uint64(request.GasLimit),
+			To:         request.To,
+			Value:      request.Value.ToInt(),
+			Data:       request.Data,
+			AccessList: request.AccessList,
+		}), nil
+	case "", qkmtypes.DynamicFeeTxType:
+		if request.GasFeeCap == nil || request.GasTipCap == nil {
+			return nil, errors.InvalidParameterError("maxFeePerGas and maxPriorityFeePerGas are required for %s transactions", qkmtypes.DynamicFeeTxType)
+		}
+
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    request.ChainID.ToInt(),
+			Nonce:      uint64(request.Nonce),
+			GasTipCap:  request.GasTipCap.ToInt(),
+			GasFeeCap:  request.GasFeeCap.ToInt(),
+			Gas:        uint64(request.GasLimit),
+			To:         request.To,
+			Value:      request.Value.ToInt(),
+			Data:       request.Data,
+			AccessList: request.AccessList,
+		}), nil
+	default:
+		return nil, errors.InvalidParameterError("invalid transaction type %q", request.TransactionType)
+	}
+}
+
+// encodeTypedData returns the EIP-712 payload to hash and sign
+func encodeTypedData(request *qkmtypes.SignTypedDataRequest) ([]byte, error) {
+	typedData := &signercore.TypedData{
+		Types: signercore.Types{
+			eip712DomainLabel: []signercore.Type{
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+		},
+		PrimaryType: request.MessageType,
+		Domain: signercore.TypedDataDomain{
+			Name:              request.DomainSeparator.Name,
+			Version:           request.DomainSeparator.Version,
+			ChainId:           math.NewHexOrDecimal256(request.DomainSeparator.ChainID),
+			VerifyingContract: request.DomainSeparator.VerifyingContract,
+			Salt:              request.DomainSeparator.Salt,
+		},
+		Message: request.Message,
+	}
+
+	for typeName, fields := range request.Types {
+		for _, field := range fields {
+			typedData.Types[typeName] = append(typedData.Types[typeName], signercore.Type{Name: field.Name, Type: field.Type})
+		}
+	}
+	if request.DomainSeparator.VerifyingContract != "" {
+		typedData.Types[eip712DomainLabel] = append(typedData.Types[eip712DomainLabel], signercore.Type{Name: "verifyingContract", Type: "address"})
+	}
+	if request.DomainSeparator.Salt != "" {
+		typedData.Types[eip712DomainLabel] = append(typedData.Types[eip712DomainLabel], signercore.Type{Name: "salt", Type: "string"})
+	}
+
+	messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	domainHash, err := typedData.HashStruct(eip712DomainLabel, typedData.Domain.Map())
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(fmt.Sprintf("\x19\x01%s%s", string(domainHash), string(messageHash))), nil
+}
+
+func formatResponse(key *Key) *qkmtypes.EthAccountResponse {
+	return &qkmtypes.EthAccountResponse{
+		PublicKey:           hexutil.MustDecode(key.PublicKey),
+		CompressedPublicKey: hexutil.MustDecode(key.CompressedPublicKey),
+		CreatedAt:           key.CreatedAt,
+		UpdatedAt:           key.UpdatedAt,
+		KeyID:               key.KeyID,
+		Tags:                key.Tags,
+		Address:             ethcommon.HexToAddress(key.Address),
+	}
+}
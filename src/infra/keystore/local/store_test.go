@@ -0,0 +1,237 @@
+// +build unit
+
+package local_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/encoding/rlp"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/keystore/local"
+	"github.com/consensys/orchestrate/src/infra/keystore/local/mocks"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	quorumtypes "github.com/consensys/quorum/core/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	signercore "github.com/ethereum/go-ethereum/signer/core"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	privateKey = "0x56202652FDFFD802B7252A456DBD8F3ECC0352BBDE76C23B40AFE8AEBD714E2E"
+	address    = "0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"
+)
+
+func TestStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	storage := mocks.NewMockStorage(ctrl)
+	masterKey := make([]byte, 32)
+	masterKey[0] = 1
+	store := local.New(storage, masterKey)
+
+	var key *local.Key
+	storage.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *local.Key) error {
+		key = k
+		return nil
+	})
+
+	resp, err := store.ImportEthAccount(ctx, &qkmtypes.ImportEthAccountRequest{
+		KeyID:      "my-key",
+		PrivateKey: hexutil.MustDecode(privateKey),
+		Tags:       map[string]string{"tenant": "foo"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, key)
+
+	t.Run("should import account and store its private key encrypted", func(t *testing.T) {
+		assert.Equal(t, address, resp.Address.Hex())
+		assert.Equal(t, "my-key", resp.KeyID)
+		assert.Equal(t, map[string]string{"tenant": "foo"}, resp.Tags)
+		assert.Equal(t, address, key.Address)
+		assert.NotContains(t, string(key.EncryptedPrivateKey), string(hexutil.MustDecode(privateKey)))
+		assert.NotEmpty(t, key.EncryptedDataKey)
+	})
+
+	t.Run("should fail with InvalidParameterError if private key is invalid", func(t *testing.T) {
+		_, err := store.ImportEthAccount(ctx, &qkmtypes.ImportEthAccountRequest{PrivateKey: []byte{1}})
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should create account successfully", func(t *testing.T) {
+		storage.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		created, err := store.CreateEthAccount(ctx, &qkmtypes.CreateEthAccountRequest{KeyID: "new-key"})
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, ethcommon.Address{}, created.Address)
+		assert.Equal(t, created.Address, crypto.PubkeyToAddress(*mustUnmarshalPubkey(t, created.PublicKey)))
+	})
+
+	t.Run("should sign dynamic fee transaction successfully", func(t *testing.T) {
+		storage.EXPECT().FindOneByAddress(gomock.Any(), address).Return(key, nil)
+		to := ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+
+		signedRaw, err := store.SignTransaction(ctx, address, &qkmtypes.SignETHTransactionRequest{
+			Nonce:     1,
+			To:        &to,
+			Value:     hexutil.Big(*big.NewInt(10)),
+			GasLimit:  21000,
+			ChainID:   hexutil.Big(*big.NewInt(1337)),
+			GasFeeCap: (*hexutil.Big)(big.NewInt(1000)),
+			GasTipCap: (*hexutil.Big)(big.NewInt(10)),
+		})
+		require.NoError(t, err)
+
+		tx := &types.Transaction{}
+		require.NoError(t, tx.UnmarshalBinary(hexutil.MustDecode(signedRaw)))
+		sender, err := types.Sender(types.NewLondonSigner(big.NewInt(1337)), tx)
+		assert.NoError(t, err)
+		assert.Equal(t, address, sender.Hex())
+		assert.Equal(t, uint8(types.DynamicFeeTxType), tx.Type())
+	})
+
+	t.Run("should fail with InvalidParameterError if dynamic fee transaction has no fees", func(t *testing.T) {
+		_, err := store.SignTransaction(ctx, address, &qkmtypes.SignETHTransactionRequest{
+			GasLimit: 21000,
+			ChainID:  hexutil.Big(*big.NewInt(1337)),
+		})
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should sign quorum private transaction successfully", func(t *testing.T) {
+		storage.EXPECT().FindOneByAddress(gomock.Any(), address).Return(key, nil)
+
+		signedRaw, err := store.SignQuorumPrivateTransaction(ctx, address, &qkmtypes.SignQuorumPrivateTransactionRequest{
+			Nonce:    1,
+			GasLimit: 21000,
+			Data:     hexutil.MustDecode("0xfeaeee"),
+		})
+		require.NoError(t, err)
+
+		tx := &quorumtypes.Transaction{}
+		require.NoError(t, rlp.Decode(hexutil.MustDecode(signedRaw), tx))
+		sender, err := quorumtypes.QuorumPrivateTxSigner{}.Sender(tx)
+		assert.NoError(t, err)
+		assert.Equal(t, address, sender.Hex())
+		assert.True(t, tx.IsPrivate())
+	})
+
+	t.Run("should sign EEA transaction successfully", func(t *testing.T) {
+		storage.EXPECT().FindOneByAddress(gomock.Any(), address).Return(key, nil)
+
+		signedRaw, err := store.SignEEATransaction(ctx, address, &qkmtypes.SignEEATransactionRequest{
+			Nonce:       1,
+			ChainID:     hexutil.Big(*big.NewInt(2018)),
+			PrivateFrom: "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=",
+			PrivateFor:  []string{"B1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="},
+		})
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, signedRaw)
+	})
+
+	t.Run("should fail with EncodingError if EEA privateFrom is invalid", func(t *testing.T) {
+		storage.EXPECT().FindOneByAddress(gomock.Any(), address).Return(key, nil)
+
+		_, err := store.SignEEATransaction(ctx, address, &qkmtypes.SignEEATransactionRequest{
+			ChainID:     hexutil.Big(*big.NewInt(2018)),
+			PrivateFrom: "not base64",
+		})
+
+		assert.True(t, errors.IsEncodingError(err))
+	})
+
+	t.Run("should sign message successfully", func(t *testing.T) {
+		storage.EXPECT().FindOneByAddress(gomock.Any(), address).Return(key, nil)
+		message := []byte("my message")
+
+		signature, err := store.SignMessage(ctx, address, &qkmtypes.SignMessageRequest{Message: message})
+		require.NoError(t, err)
+
+		assert.Equal(t, address, recoverHomestead(t, accounts.TextHash(message), signature))
+	})
+
+	t.Run("should sign typed data successfully", func(t *testing.T) {
+		storage.EXPECT().FindOneByAddress(gomock.Any(), address).Return(key, nil)
+
+		signature, err := store.SignTypedData(ctx, address, &qkmtypes.SignTypedDataRequest{
+			DomainSeparator: qkmtypes.DomainSeparator{Name: "orchestrate", Version: "v1", ChainID: 1},
+			Types:           map[string][]qkmtypes.Type{"Mail": {{Name: "contents", Type: "string"}}},
+			Message:         map[string]interface{}{"contents": "hello"},
+			MessageType:     "Mail",
+		})
+
+		require.NoError(t, err)
+
+		typedData := signercore.TypedData{
+			Types: signercore.Types{
+				"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "version", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+				"Mail":         {{Name: "contents", Type: "string"}},
+			},
+			PrimaryType: "Mail",
+			Domain:      signercore.TypedDataDomain{Name: "orchestrate", Version: "v1", ChainId: math.NewHexOrDecimal256(1)},
+			Message:     signercore.TypedDataMessage{"contents": "hello"},
+		}
+		domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+		require.NoError(t, err)
+		messageHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+		require.NoError(t, err)
+		digest := crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, messageHash)
+
+		assert.Equal(t, address, recoverHomestead(t, digest, signature))
+	})
+
+	t.Run("should fail with InvalidParameterError if typed data is invalid", func(t *testing.T) {
+		_, err := store.SignTypedData(ctx, address, &qkmtypes.SignTypedDataRequest{
+			DomainSeparator: qkmtypes.DomainSeparator{Name: "orchestrate", Version: "v1", ChainID: 1},
+			Message:         map[string]interface{}{"contents": "hello"},
+			MessageType:     "Mail",
+		})
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with CryptoOperationError if master key does not match", func(t *testing.T) {
+		storage.EXPECT().FindOneByAddress(gomock.Any(), address).Return(key, nil)
+
+		_, err := local.New(storage, make([]byte, 32)).SignMessage(ctx, address, &qkmtypes.SignMessageRequest{Message: []byte("msg")})
+
+		assert.True(t, errors.IsCryptoOperationError(err))
+	})
+
+	t.Run("should fail with NotFoundError if account is not in the store", func(t *testing.T) {
+		storage.EXPECT().FindOneByAddress(gomock.Any(), address).Return(nil, errors.NotFoundError("error"))
+
+		_, err := store.GetEthAccount(ctx, address)
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
+
+func recoverHomestead(t *testing.T, hash []byte, signature string) string {
+	sig := hexutil.MustDecode(signature)
+	sig[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(hash, sig)
+	require.NoError(t, err)
+	return crypto.PubkeyToAddress(*pubKey).Hex()
+}
+
+func mustUnmarshalPubkey(t *testing.T, pubKey []byte) *ecdsa.PublicKey {
+	key, err := crypto.UnmarshalPubkey(pubKey)
+	require.NoError(t, err)
+	return key
+}
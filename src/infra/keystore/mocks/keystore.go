@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: keystore.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	types "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockKeyStore is a mock of KeyStore interface
+type MockKeyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyStoreMockRecorder
+}
+
+// MockKeyStoreMockRecorder is the mock recorder for MockKeyStore
+type MockKeyStoreMockRecorder struct {
+	mock *MockKeyStore
+}
+
+// NewMockKeyStore creates a new mock instance
+func NewMockKeyStore(ctrl *gomock.Controller) *MockKeyStore {
+	mock := &MockKeyStore{ctrl: ctrl}
+	mock.recorder = &MockKeyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockKeyStore) EXPECT() *MockKeyStoreMockRecorder {
+	return m.recorder
+}
+
+// CreateEthAccount mocks base method
+func (m *MockKeyStore) CreateEthAccount(ctx context.Context, request *types.CreateEthAccountRequest) (*types.EthAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEthAccount", ctx, request)
+	ret0, _ := ret[0].(*types.EthAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEthAccount indicates an expected call of CreateEthAccount
+func (mr *MockKeyStoreMockRecorder) CreateEthAccount(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEthAccount", reflect.TypeOf((*MockKeyStore)(nil).CreateEthAccount), ctx, request)
+}
+
+// ImportEthAccount mocks base method
+func (m *MockKeyStore) ImportEthAccount(ctx context.Context, request *types.ImportEthAccountRequest) (*types.EthAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportEthAccount", ctx, request)
+	ret0, _ := ret[0].(*types.EthAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportEthAccount indicates an expected call of ImportEthAccount
+func (mr *MockKeyStoreMockRecorder) ImportEthAccount(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEthAccount", reflect.TypeOf((*MockKeyStore)(nil).ImportEthAccount), ctx, request)
+}
+
+// GetEthAccount mocks base method
+func (m *MockKeyStore) GetEthAccount(ctx context.Context, address string) (*types.EthAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEthAccount", ctx, address)
+	ret0, _ := ret[0].(*types.EthAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEthAccount indicates an expected call of GetEthAccount
+func (mr *MockKeyStoreMockRecorder) GetEthAccount(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEthAccount", reflect.TypeOf((*MockKeyStore)(nil).GetEthAccount), ctx, address)
+}
+
+// SignMessage mocks base method
+func (m *MockKeyStore) SignMessage(ctx context.Context, address string, request *types.SignMessageRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignMessage", ctx, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignMessage indicates an expected call of SignMessage
+func (mr *MockKeyStoreMockRecorder) SignMessage(ctx, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMessage", reflect.TypeOf((*MockKeyStore)(nil).SignMessage), ctx, address, request)
+}
+
+// SignTypedData mocks base method
+func (m *MockKeyStore) SignTypedData(ctx context.Context, address string, request *types.SignTypedDataRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTypedData", ctx, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTypedData indicates an expected call of SignTypedData
+func (mr *MockKeyStoreMockRecorder) SignTypedData(ctx, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTypedData", reflect.TypeOf((*MockKeyStore)(nil).SignTypedData), ctx, address, request)
+}
+
+// SignTransaction mocks base method
+func (m *MockKeyStore) SignTransaction(ctx context.Context, address string, request *types.SignETHTransactionRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTransaction", ctx, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTransaction indicates an expected call of SignTransaction
+func (mr *MockKeyStoreMockRecorder) SignTransaction(ctx, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockKeyStore)(nil).SignTransaction), ctx, address, request)
+}
+
+// SignQuorumPrivateTransaction mocks base method
+func (m *MockKeyStore) SignQuorumPrivateTransaction(ctx context.Context, address string, request *types.SignQuorumPrivateTransactionRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignQuorumPrivateTransaction", ctx, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignQuorumPrivateTransaction indicates an expected call of SignQuorumPrivateTransaction
+func (mr *MockKeyStoreMockRecorder) SignQuorumPrivateTransaction(ctx, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignQuorumPrivateTransaction", reflect.TypeOf((*MockKeyStore)(nil).SignQuorumPrivateTransaction), ctx, address, request)
+}
+
+// SignEEATransaction mocks base method
+func (m *MockKeyStore) SignEEATransaction(ctx context.Context, address string, request *types.SignEEATransactionRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignEEATransaction", ctx, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignEEATransaction indicates an expected call of SignEEATransaction
+func (mr *MockKeyStoreMockRecorder) SignEEATransaction(ctx, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignEEATransaction", reflect.TypeOf((*MockKeyStore)(nil).SignEEATransaction), ctx, address, request)
+}
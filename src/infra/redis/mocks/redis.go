@@ -47,6 +47,21 @@ func (mr *MockClientMockRecorder) LoadUint64(key interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadUint64", reflect.TypeOf((*MockClient)(nil).LoadUint64), key)
 }
 
+// LoadBytes mocks base method
+func (m *MockClient) LoadBytes(key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadBytes", key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadBytes indicates an expected call of LoadBytes
+func (mr *MockClientMockRecorder) LoadBytes(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadBytes", reflect.TypeOf((*MockClient)(nil).LoadBytes), key)
+}
+
 // Set mocks base method
 func (m *MockClient) Set(key string, expiration int, value interface{}) error {
 	m.ctrl.T.Helper()
@@ -89,6 +104,49 @@ func (mr *MockClientMockRecorder) Incr(key interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockClient)(nil).Incr), key)
 }
 
+// Update mocks base method
+func (m *MockClient) Update(key string, expiration int, update func([]byte) ([]byte, error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", key, expiration, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockClientMockRecorder) Update(key, expiration, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockClient)(nil).Update), key, expiration, update)
+}
+
+// AcquireLock mocks base method
+func (m *MockClient) AcquireLock(key, owner string, expiration int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireLock", key, owner, expiration)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireLock indicates an expected call of AcquireLock
+func (mr *MockClientMockRecorder) AcquireLock(key, owner, expiration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireLock", reflect.TypeOf((*MockClient)(nil).AcquireLock), key, owner, expiration)
+}
+
+// ReleaseLock mocks base method
+func (m *MockClient) ReleaseLock(key, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseLock", key, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseLock indicates an expected call of ReleaseLock
+func (mr *MockClientMockRecorder) ReleaseLock(key, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLock", reflect.TypeOf((*MockClient)(nil).ReleaseLock), key, owner)
+}
+
 // Ping mocks base method
 func (m *MockClient) Ping() error {
 	m.ctrl.T.Helper()
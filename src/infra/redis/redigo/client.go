@@ -1,10 +1,33 @@
 package redigo
 
 import (
+	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/gomodule/redigo/redis"
 )
 
+// acquireLockScript sets the lock if it is free or extends it if it is already held by the same owner
+var acquireLockScript = redis.NewScript(1, `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then
+	return 1
+end
+return 0
+`)
+
+// releaseLockScript deletes the lock only if it is held by the given owner
+var releaseLockScript = redis.NewScript(1, `
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// maxUpdateAttempts is the number of times an update is retried when the key is modified concurrently
+const maxUpdateAttempts = 10
+
 type Client struct {
 	pool   *redis.Pool
 	logger *log.Logger
@@ -47,6 +70,23 @@ func (nm *Client) LoadUint64(key string) (uint64, error) {
 	return value, nil
 }
 
+func (nm *Client) LoadBytes(key string) ([]byte, error) {
+	conn := nm.pool.Get()
+	defer closeConn(conn)
+
+	reply, err := conn.Do("GET", key)
+	if err != nil {
+		return nil, parseRedisError(err)
+	}
+
+	value, err := redis.Bytes(reply, nil)
+	if err != nil {
+		return nil, parseRedisError(err)
+	}
+
+	return value, nil
+}
+
 func (nm *Client) Set(key string, expiration int, value interface{}) error {
 	conn := nm.pool.Get()
 	defer closeConn(conn)
@@ -85,6 +125,74 @@ func (nm *Client) Incr(key string) error {
 	return nil
 }
 
+func (nm *Client) Update(key string, expiration int, update func(value []byte) ([]byte, error)) error {
+	conn := nm.pool.Get()
+	defer closeConn(conn)
+
+	for i := 0; i < maxUpdateAttempts; i++ {
+		// WATCH makes the transaction fail if the key is modified before EXEC
+		_, err := conn.Do("WATCH", key)
+		if err != nil {
+			return parseRedisError(err)
+		}
+
+		current, err := redis.Bytes(conn.Do("GET", key))
+		if err != nil && err != redis.ErrNil {
+			_, _ = conn.Do("UNWATCH")
+			return parseRedisError(err)
+		}
+
+		value, err := update(current)
+		if err != nil {
+			_, _ = conn.Do("UNWATCH")
+			return err
+		}
+
+		_ = conn.Send("MULTI")
+		if value == nil {
+			_ = conn.Send("DEL", key)
+		} else {
+			_ = conn.Send("PSETEX", key, expiration, value)
+		}
+
+		reply, err := conn.Do("EXEC")
+		if err != nil {
+			return parseRedisError(err)
+		}
+
+		// A nil reply means the transaction was aborted because the key changed
+		if reply != nil {
+			return nil
+		}
+	}
+
+	return errors.RedisConnectionError("too many concurrent updates of key %s", key)
+}
+
+func (nm *Client) AcquireLock(key, owner string, expiration int) (bool, error) {
+	conn := nm.pool.Get()
+	defer closeConn(conn)
+
+	acquired, err := redis.Bool(acquireLockScript.Do(conn, key, owner, expiration))
+	if err != nil {
+		return false, parseRedisError(err)
+	}
+
+	return acquired, nil
+}
+
+func (nm *Client) ReleaseLock(key, owner string) error {
+	conn := nm.pool.Get()
+	defer closeConn(conn)
+
+	_, err := releaseLockScript.Do(conn, key, owner)
+	if err != nil {
+		return parseRedisError(err)
+	}
+
+	return nil
+}
+
 func (nm *Client) Ping() error {
 	conn := nm.pool.Get()
 	defer closeConn(conn)
@@ -4,8 +4,13 @@ package redis
 
 type Client interface {
 	LoadUint64(key string) (uint64, error)
+	LoadBytes(key string) ([]byte, error)
 	Set(key string, expiration int, value interface{}) error
 	Delete(key string) error
 	Incr(key string) error
+	// Update atomically replaces the value of key by the one returned by update, the key is deleted if it returns nil
+	Update(key string, expiration int, update func(value []byte) ([]byte, error)) error
+	AcquireLock(key, owner string, expiration int) (bool, error)
+	ReleaseLock(key, owner string) error
 	Ping() error
 }
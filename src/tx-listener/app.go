@@ -4,6 +4,7 @@ import (
 	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 	pkgsarama "github.com/consensys/orchestrate/src/infra/broker/sarama"
+	"github.com/consensys/orchestrate/src/infra/redis"
 	listenermetrics "github.com/consensys/orchestrate/src/tx-listener/metrics"
 	provider "github.com/consensys/orchestrate/src/tx-listener/providers"
 	"github.com/consensys/orchestrate/src/tx-listener/session/ethereum"
@@ -20,6 +21,7 @@ func New(
 	offsets offset.Manager,
 	ec ethereum.EthClient,
 	client orchestrateclient.OrchestrateClient,
+	redisCli redis.Client,
 ) (*app.App, error) {
 
 	var listenerMetrics listenermetrics.ListenerMetrics
@@ -30,8 +32,8 @@ func New(
 	}
 
 	listener = NewTxListener(prvdr, hk, offsets, ec, client, listenerMetrics)
-	sentry = txsentry.NewTxSentry(client, txsentry.NewConfig(viper.GetViper()))
-	appli, err := app.New(cfg, ReadinessOpt(client), app.MetricsOpt(listenerMetrics))
+	sentry = txsentry.NewTxSentry(client, txsentry.NewConfig(viper.GetViper()), redisCli)
+	appli, err := app.New(cfg, ReadinessOpt(client, redisCli), app.MetricsOpt(listenerMetrics))
 	if err != nil {
 		return nil, err
 	}
@@ -42,10 +44,13 @@ func New(
 	return appli, nil
 }
 
-func ReadinessOpt(client orchestrateclient.OrchestrateClient) app.Option {
+func ReadinessOpt(client orchestrateclient.OrchestrateClient, redisCli redis.Client) app.Option {
 	return func(ap *app.App) error {
 		ap.AddReadinessCheck("api", client.Checker())
 		ap.AddReadinessCheck("kafka", pkgsarama.GlobalClientChecker())
+		if redisCli != nil {
+			ap.AddReadinessCheck("redis", redisCli.Ping)
+		}
 		return nil
 	}
 }
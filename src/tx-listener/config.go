@@ -1,6 +1,7 @@
 package txlistener
 
 import (
+	"github.com/consensys/orchestrate/cmd/flags"
 	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 	authkey "github.com/consensys/orchestrate/pkg/toolkit/app/auth/key"
@@ -34,6 +35,7 @@ func Flags(f *pflag.FlagSet) {
 	app.MetricFlags(f)
 	metricregistry.Flags(f, tcpmetrics.ModuleName)
 	txsentry.Flags(f)
+	flags.RedisFlags(f)
 	provider.Flags(f)
 	orchestrateclient.Flags(f)
 }
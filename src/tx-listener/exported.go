@@ -5,6 +5,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/consensys/orchestrate/cmd/flags"
+
 	"github.com/consensys/orchestrate/pkg/backoff"
 	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
@@ -13,9 +15,12 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/utils"
 	ethclient "github.com/consensys/orchestrate/src/infra/ethclient/rpc"
+	"github.com/consensys/orchestrate/src/infra/redis"
+	"github.com/consensys/orchestrate/src/infra/redis/redigo"
 	registryprovider "github.com/consensys/orchestrate/src/tx-listener/providers/chain-registry"
 	kafkahook "github.com/consensys/orchestrate/src/tx-listener/session/ethereum/hooks/kafka"
 	registryoffset "github.com/consensys/orchestrate/src/tx-listener/session/ethereum/offset/chain-registry"
+	txsentry "github.com/consensys/orchestrate/src/tx-sentry"
 	"github.com/spf13/viper"
 )
 
@@ -48,6 +53,15 @@ func NewApp(ctx context.Context) (*app.App, error) {
 	registryprovider.Init(client)
 	registryoffset.Init(client)
 
+	var redisCli redis.Client
+	if txsentry.NewConfig(viper.GetViper()).SessionStoreType == txsentry.SessionStoreTypeRedis {
+		var err error
+		redisCli, err = redigo.New(flags.NewRedisConfig(viper.GetViper()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return New(
 		config,
 		registryprovider.GlobalProvider(),
@@ -55,6 +69,7 @@ func NewApp(ctx context.Context) (*app.App, error) {
 		registryoffset.GlobalManager(),
 		ethclient.GlobalClient(),
 		client,
+		redisCli,
 	)
 }
 
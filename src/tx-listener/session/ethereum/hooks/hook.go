@@ -13,4 +13,6 @@ import (
 
 type Hook interface {
 	AfterNewBlock(ctx context.Context, chain *dynamic.Chain, block *ethtypes.Block, jobs []*entities.Job) error
+	// AfterChainReorg is called with the jobs mined in blocks removed from the canonical chain above the fork block
+	AfterChainReorg(ctx context.Context, chain *dynamic.Chain, forkBlockNumber uint64, jobs []*entities.Job) error
 }
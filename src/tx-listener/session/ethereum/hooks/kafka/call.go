@@ -0,0 +1,158 @@
+package kafka
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/abi"
+	types "github.com/consensys/orchestrate/pkg/types/ethereum"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	ethclientutils "github.com/consensys/orchestrate/src/infra/ethclient/utils"
+	"github.com/consensys/orchestrate/src/tx-listener/dynamic"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// decodeCall decodes the method called by the transaction of the job against the ABI of the called contract and,
+// if the transaction failed, the reason of its revert. Decoding failures are logged and never fail the receipt.
+func (hk *Hook) decodeCall(ctx context.Context, c *dynamic.Chain, job *entities.Job, receipt *types.Receipt) {
+	if job.Transaction == nil || job.Transaction.To == nil || len(job.Transaction.Data) < 4 {
+		return
+	}
+
+	logger := hk.logger.WithContext(ctx).WithField("to", job.Transaction.To.Hex())
+	var rawABI string
+	contract, err := hk.client.SearchContract(ctx, &api.SearchContractRequest{Address: job.Transaction.To})
+	switch {
+	case err == nil:
+		rawABI = contract.ABI
+		hk.decodeInput(ctx, rawABI, job.Transaction.Data, receipt)
+	case !errors.IsNotFoundError(err):
+		logger.WithError(err).Warn("failed to search called contract")
+	}
+
+	if receipt.Status == 0 && receipt.RevertReason == "" && isPublicJob(job) {
+		hk.decodeRevert(ctx, c, job, rawABI, receipt)
+	}
+}
+
+func (hk *Hook) decodeInput(ctx context.Context, rawABI string, data []byte, receipt *types.Receipt) {
+	logger := hk.logger.WithContext(ctx)
+
+	contractABI, err := abi.ParseABI(rawABI)
+	if err != nil {
+		logger.WithError(err).Warn("failed to parse ABI of called contract")
+		return
+	}
+
+	method, err := contractABI.MethodById(data[:4])
+	if err != nil {
+		logger.WithField("selector", hexutil.Encode(data[:4])).Debug("called method not found in contract ABI")
+		return
+	}
+
+	mapping, err := abi.DecodeInput(method, data[4:])
+	if err != nil {
+		logger.WithError(err).Warn("failed to decode transaction input")
+		return
+	}
+
+	receipt.Method = method.Sig
+	receipt.DecodedInput = mapping
+	logger.WithField("method", method.Sig).Debug("transaction input decoded")
+}
+
+// decodeRevert replays the transaction on the state its block was built on to retrieve the data of its revert
+func (hk *Hook) decodeRevert(ctx context.Context, c *dynamic.Chain, job *entities.Job, rawABI string, receipt *types.Receipt) {
+	logger := hk.logger.WithContext(ctx)
+
+	msg := &ethereum.CallMsg{
+		To:    job.Transaction.To,
+		Value: job.Transaction.Value.ToInt(),
+		Data:  job.Transaction.Data,
+	}
+	if job.Transaction.From != nil {
+		msg.From = *job.Transaction.From
+	}
+	if job.Transaction.Gas != nil {
+		msg.Gas = *job.Transaction.Gas
+	}
+
+	parentBlockNumber := receipt.BlockNumber
+	if parentBlockNumber > 0 {
+		parentBlockNumber--
+	}
+
+	_, err := hk.ec.CallContract(ctx, c.URL, msg, new(big.Int).SetUint64(parentBlockNumber))
+	if err == nil {
+		logger.Debug("replayed transaction did not revert")
+		return
+	}
+
+	revertData, err := hexutil.Decode(errors.FromError(err).GetExtra()[ethclientutils.RevertDataExtraKey])
+	if err != nil {
+		logger.Debug("replayed transaction reverted without data")
+		return
+	}
+
+	reason, err := abi.DecodeRevertReason(revertData)
+	if err == nil {
+		receipt.RevertReason = reason
+		logger.WithField("revert_reason", reason).Debug("revert reason decoded")
+		return
+	}
+
+	if rawABI == "" {
+		return
+	}
+
+	contractErrors, err := abi.ParseErrors(rawABI)
+	if err != nil {
+		logger.WithError(err).Warn("failed to parse errors of called contract")
+		return
+	}
+
+	contractError, mapping, err := abi.DecodeError(contractErrors, revertData)
+	if err != nil {
+		logger.WithError(err).Debug("failed to decode revert error")
+		return
+	}
+
+	receipt.RevertError = contractError.Sig()
+	receipt.DecodedRevertError = mapping
+	logger.WithField("revert_error", receipt.RevertError).Debug("revert error decoded")
+}
+
+// Private transactions cannot be replayed against the public state
+func isPublicJob(job *entities.Job) bool {
+	return job.Type == entities.EthereumTransaction || job.Type == entities.EthereumRawTransaction
+}
+
+func newDecodedInput(receipt *types.Receipt) *entities.DecodedCall {
+	if receipt.Method == "" {
+		return nil
+	}
+
+	return &entities.DecodedCall{
+		Signature: receipt.Method,
+		Args:      receipt.DecodedInput,
+	}
+}
+
+func newTxRevert(receipt *types.Receipt) *entities.TxRevert {
+	if receipt.RevertReason == "" && receipt.RevertError == "" {
+		return nil
+	}
+
+	revert := &entities.TxRevert{Reason: receipt.RevertReason}
+	if receipt.RevertError != "" {
+		revert.Error = &entities.DecodedCall{
+			Signature: receipt.RevertError,
+			Args:      receipt.DecodedRevertError,
+		}
+	}
+
+	return revert
+}
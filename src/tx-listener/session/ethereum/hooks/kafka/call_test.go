@@ -0,0 +1,124 @@
+// +build unit
+
+package kafka
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Shopify/sarama/mocks"
+	"github.com/consensys/orchestrate/pkg/errors"
+	mock2 "github.com/consensys/orchestrate/pkg/sdk/client/mock"
+	types "github.com/consensys/orchestrate/pkg/types/ethereum"
+	apitypes "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	ethclientutils "github.com/consensys/orchestrate/src/infra/ethclient/utils"
+	"github.com/consensys/orchestrate/src/tx-listener/dynamic"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const tokenABI = `[
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}
+]`
+
+func Test_DecodeCall(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ec := mock.NewMockMultiClient(ctrl)
+	producer := mocks.NewSyncProducer(t, nil)
+	client := mock2.NewMockOrchestrateClient(ctrl)
+	hk := NewHook(&Config{OutTopic: "test-topic-decoded"}, ec, producer, client)
+
+	chain := &dynamic.Chain{UUID: "test-c", URL: "test-url", ChainID: "888"}
+	to := ethcommon.HexToAddress("0xAf84242d70aE9D268E2bE3616ED497BA28A7b62C")
+	data := hexutil.MustDecode("0xa9059cbb000000000000000000000000ff778b716fc07d98839f48ddb88d8be583beb684000000000000000000000000000000000000000000000000002386f26fc10000")
+	expectedInput := map[string]string{
+		"to":     "0xfF778b716FC07D98839f48DdB88D8bE583BEB684",
+		"amount": "10000000000000000",
+	}
+
+	newJob := func() *entities.Job {
+		job := testdata.FakeJob()
+		job.Type = entities.EthereumTransaction
+		job.Transaction.To = &to
+		job.Transaction.Data = data
+		return job
+	}
+
+	t.Run("should decode the input of a successful transaction", func(t *testing.T) {
+		receipt := &types.Receipt{Status: 1, BlockNumber: 10}
+
+		client.EXPECT().SearchContract(gomock.Any(), &apitypes.SearchContractRequest{Address: &to}).
+			Return(&apitypes.ContractResponse{ABI: tokenABI}, nil)
+
+		hk.decodeCall(context.Background(), chain, newJob(), receipt)
+
+		assert.Equal(t, "transfer(address,uint256)", receipt.Method)
+		assert.Equal(t, expectedInput, receipt.DecodedInput)
+		assert.Empty(t, receipt.RevertReason)
+		assert.Equal(t, &entities.DecodedCall{Signature: receipt.Method, Args: expectedInput}, newDecodedInput(receipt))
+		assert.Nil(t, newTxRevert(receipt))
+	})
+
+	t.Run("should decode the revert reason of a failed transaction by replaying it", func(t *testing.T) {
+		receipt := &types.Receipt{Status: 0, BlockNumber: 10}
+		job := newJob()
+
+		client.EXPECT().SearchContract(gomock.Any(), gomock.Any()).Return(nil, errors.NotFoundError("error"))
+		ec.EXPECT().CallContract(gomock.Any(), chain.URL, gomock.Any(), big.NewInt(9)).
+			Return(nil, errors.EthereumError("execution reverted").SetExtra(ethclientutils.RevertDataExtraKey,
+				"0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000001a4e6f7420656e6f7567682045746865722070726f76696465642e000000000000"))
+
+		hk.decodeCall(context.Background(), chain, job, receipt)
+
+		assert.Empty(t, receipt.Method)
+		assert.Equal(t, "Not enough Ether provided.", receipt.RevertReason)
+		assert.Equal(t, &entities.TxRevert{Reason: "Not enough Ether provided."}, newTxRevert(receipt))
+	})
+
+	t.Run("should decode the custom error of a failed transaction", func(t *testing.T) {
+		receipt := &types.Receipt{Status: 0, BlockNumber: 10}
+
+		client.EXPECT().SearchContract(gomock.Any(), gomock.Any()).Return(&apitypes.ContractResponse{ABI: tokenABI}, nil)
+		ec.EXPECT().CallContract(gomock.Any(), chain.URL, gomock.Any(), big.NewInt(9)).
+			Return(nil, errors.EthereumError("execution reverted").SetExtra(ethclientutils.RevertDataExtraKey,
+				"0xcf47918100000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002"))
+
+		hk.decodeCall(context.Background(), chain, newJob(), receipt)
+
+		assert.Equal(t, "transfer(address,uint256)", receipt.Method)
+		assert.Equal(t, "InsufficientBalance(uint256,uint256)", receipt.RevertError)
+		assert.Equal(t, map[string]string{"available": "1", "required": "2"}, receipt.DecodedRevertError)
+		assert.Equal(t, &entities.TxRevert{Error: &entities.DecodedCall{
+			Signature: receipt.RevertError,
+			Args:      receipt.DecodedRevertError,
+		}}, newTxRevert(receipt))
+	})
+
+	t.Run("should not replay failed private transactions", func(t *testing.T) {
+		receipt := &types.Receipt{Status: 0, BlockNumber: 10}
+		job := newJob()
+		job.Type = entities.EEAPrivateTransaction
+
+		client.EXPECT().SearchContract(gomock.Any(), gomock.Any()).Return(nil, errors.NotFoundError("error"))
+
+		hk.decodeCall(context.Background(), chain, job, receipt)
+
+		assert.Empty(t, receipt.RevertReason)
+	})
+
+	t.Run("should not decode contract deployments", func(t *testing.T) {
+		job := newJob()
+		job.Transaction.To = nil
+
+		hk.decodeCall(context.Background(), chain, job, &types.Receipt{Status: 1})
+	})
+}
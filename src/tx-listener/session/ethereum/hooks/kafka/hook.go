@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/toolkit/workerpool"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/pkg/utils/envelope"
@@ -24,6 +26,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/types/tx"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
 	"github.com/consensys/orchestrate/src/tx-listener/dynamic"
+	"github.com/dgraph-io/ristretto"
 	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -39,6 +42,8 @@ type Hook struct {
 	producer sarama.SyncProducer
 	client   sdk.OrchestrateClient
 	logger   *log.Logger
+	// Addresses of external contracts whose code hash has been resolved, per tenant and chain
+	resolvedAddresses *ristretto.Cache
 }
 
 func NewHook(
@@ -47,12 +52,19 @@ func NewHook(
 	producer sarama.SyncProducer,
 	client sdk.OrchestrateClient,
 ) *Hook {
+	resolvedAddresses, _ := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 10 * maxResolvedAddresses,
+		MaxCost:     maxResolvedAddresses,
+		BufferItems: 64,
+	})
+
 	return &Hook{
-		conf:     conf,
-		ec:       ec,
-		producer: producer,
-		client:   client,
-		logger:   log.NewLogger().SetComponent(component),
+		conf:              conf,
+		ec:                ec,
+		producer:          producer,
+		client:            client,
+		logger:            log.NewLogger().SetComponent(component),
+		resolvedAddresses: resolvedAddresses,
 	}
 }
 
@@ -63,39 +75,20 @@ func (hk *Hook) AfterNewBlock(ctx context.Context, c *dynamic.Chain, block *etht
 	var txResponses []*tx.TxResponse
 	for _, job := range jobs {
 		receiptLogCtx := log.WithFields(blockLogCtx, log.Field("receipt_tx_hash", job.Receipt.TxHash))
+		// Contracts are registered and resolved on behalf of the owner of the job
+		receiptLogCtx = multitenancy.WithUserInfo(receiptLogCtx, multitenancy.NewUserInfo(job.TenantID, job.OwnerID))
 		// Register deployed contract
 		err := hk.registerDeployedContract(receiptLogCtx, c, job.Receipt, block)
 		if err != nil {
 			hk.logger.WithContext(receiptLogCtx).WithError(err).Error("could not register deployed contract on registry")
 		}
 
-		txResponse := &tx.TxResponse{
-			Id:            job.ScheduleUUID,
-			JobUUID:       job.UUID,
-			ContextLabels: job.Labels,
-			Transaction: &types.Transaction{
-				From:       utils.StringerToString(job.Transaction.From),
-				Nonce:      utils.ValueToString(job.Transaction.Nonce),
-				To:         utils.StringerToString(job.Transaction.To),
-				Value:      utils.StringerToString(job.Transaction.Value),
-				Gas:        utils.ValueToString(job.Transaction.Gas),
-				GasPrice:   utils.StringerToString(job.Transaction.GasPrice),
-				GasFeeCap:  utils.StringerToString(job.Transaction.GasFeeCap),
-				GasTipCap:  utils.StringerToString(job.Transaction.GasTipCap),
-				Data:       utils.StringerToString(job.Transaction.Data),
-				Raw:        utils.StringerToString(job.Transaction.Raw),
-				TxHash:     utils.StringerToString(job.Transaction.Hash),
-				AccessList: envelope.ConvertFromAccessList(job.Transaction.AccessList),
-				TxType:     string(job.Transaction.TransactionType),
-			},
-			Receipt: job.Receipt,
-			Chain:   c.Name,
-		}
-
+		txResponse := newTxResponse(job, c)
 		err = hk.decodeReceipt(receiptLogCtx, c, txResponse.Receipt)
 		if err != nil {
 			txResponse.Errors = []*ierror.Error{errors.FromError(err)}
 		}
+		hk.decodeCall(receiptLogCtx, c, job, txResponse.Receipt)
 
 		txResponses = append(txResponses, txResponse)
 	}
@@ -110,8 +103,10 @@ func (hk *Hook) AfterNewBlock(ctx context.Context, c *dynamic.Chain, block *etht
 		txResponse := txResponse
 
 		updateReq := &api.UpdateJobRequest{
-			Status:  entities.StatusMined,
-			Message: fmt.Sprintf("transaction mined in block %v", block.NumberU64()),
+			Status:       entities.StatusMined,
+			Message:      fmt.Sprintf("transaction mined in block %v", block.NumberU64()),
+			DecodedInput: newDecodedInput(txResponse.Receipt),
+			Revert:       newTxRevert(txResponse.Receipt),
 		}
 
 		if txResponse.Receipt.EffectiveGasPrice != "" {
@@ -152,6 +147,96 @@ func (hk *Hook) AfterNewBlock(ctx context.Context, c *dynamic.Chain, block *etht
 	return nil
 }
 
+// AfterChainReorg moves the jobs mined in orphaned blocks out of MINED and publishes compensating responses
+func (hk *Hook) AfterChainReorg(ctx context.Context, c *dynamic.Chain, forkBlockNumber uint64, jobs []*entities.Job) error {
+	reorgLogCtx := log.WithFields(ctx, log.Field("chain", c.UUID), log.Field("fork_block_number", forkBlockNumber))
+	logger := hk.logger.WithContext(reorgLogCtx)
+
+	var txResponses []*tx.TxResponse
+	var updateErr error
+	mux := &sync.Mutex{}
+	wp := workerpool.New(20)
+	for _, job := range jobs {
+		txResponse := newTxResponse(job, c)
+		txResponse.Errors = []*ierror.Error{
+			errors.ChainReorgError("transaction %s removed from the canonical chain by a reorganisation after block %d",
+				txResponse.Transaction.TxHash, forkBlockNumber),
+		}
+		txResponses = append(txResponses, txResponse)
+
+		if txResponse.GetJobUUID() == "" {
+			continue
+		}
+
+		updateReq := &api.UpdateJobRequest{
+			Status:  entities.StatusReorged,
+			Message: fmt.Sprintf("transaction removed from the canonical chain by a reorganisation after block %v", forkBlockNumber),
+		}
+
+		wp.Submit(func() {
+			_, err := hk.client.UpdateJob(
+				ctx,
+				txResponse.GetJobUUID(),
+				updateReq,
+			)
+			// Jobs which are no longer MINED, rejected with an invalid state, were rolled back when the reorganisation was
+			// first processed
+			if err != nil && !errors.IsInvalidStateError(err) {
+				logger.WithError(err).Errorf("failed to update status of %s to REORGED", txResponse.Id)
+				mux.Lock()
+				updateErr = err
+				mux.Unlock()
+			}
+		})
+	}
+	wp.StopWait()
+
+	// The reorganisation is processed again, compensating messages are only produced once every job is rolled back
+	if updateErr != nil {
+		return updateErr
+	}
+
+	msgs, err := hk.prepareEnvelopeMsgs(txResponses, hk.conf.OutTopic, c.UUID)
+	if err != nil {
+		logger.WithError(err).Errorf("failed to prepare messages")
+		return err
+	}
+
+	err = hk.produce(msgs)
+	if err != nil {
+		logger.WithError(err).Errorf("failed to produce message")
+		return err
+	}
+
+	logger.WithField("jobs", len(jobs)).Info("chain reorganisation processed")
+	return nil
+}
+
+func newTxResponse(job *entities.Job, c *dynamic.Chain) *tx.TxResponse {
+	return &tx.TxResponse{
+		Id:            envelope.EnvelopeID(job),
+		JobUUID:       job.UUID,
+		ContextLabels: job.Labels,
+		Transaction: &types.Transaction{
+			From:       utils.StringerToString(job.Transaction.From),
+			Nonce:      utils.ValueToString(job.Transaction.Nonce),
+			To:         utils.StringerToString(job.Transaction.To),
+			Value:      utils.StringerToString(job.Transaction.Value),
+			Gas:        utils.ValueToString(job.Transaction.Gas),
+			GasPrice:   utils.StringerToString(job.Transaction.GasPrice),
+			GasFeeCap:  utils.StringerToString(job.Transaction.GasFeeCap),
+			GasTipCap:  utils.StringerToString(job.Transaction.GasTipCap),
+			Data:       utils.StringerToString(job.Transaction.Data),
+			Raw:        utils.StringerToString(job.Transaction.Raw),
+			TxHash:     utils.StringerToString(job.Transaction.Hash),
+			AccessList: envelope.ConvertFromAccessList(job.Transaction.AccessList),
+			TxType:     string(job.Transaction.TransactionType),
+		},
+		Receipt: job.Receipt,
+		Chain:   c.Name,
+	}
+}
+
 func (hk *Hook) decodeReceipt(ctx context.Context, c *dynamic.Chain, receipt *types.Receipt) error {
 	hk.logger.WithContext(ctx).Debug("decoding receipt...")
 	var contractAddress *ethcommon.Address
@@ -165,16 +250,19 @@ func (hk *Hook) decodeReceipt(ctx context.Context, c *dynamic.Chain, receipt *ty
 			WithField("address", l.GetAddress()).WithField("indexed", uint32(len(l.Topics)-1))
 
 		logger.Debug("decoding receipt logs")
-		sigHash := hexutil.MustDecode(l.Topics[0])
-		eventResp, err := hk.client.GetContractEvents(
-			ctx,
-			l.GetAddress(),
-			c.ChainID,
-			&api.GetContractEventsRequest{
-				SigHash:           sigHash,
-				IndexedInputCount: uint32(len(l.Topics) - 1),
-			},
-		)
+		resolveExternal := c.Listener.ExternalTxEnabled && receipt.PrivacyGroupId == ""
+		if resolveExternal && strings.EqualFold(l.Topics[0], upgradedEventSigHash) {
+			// The proxy changed its implementation so the code hash bound to it is stale
+			hk.resolveContractCodeHash(ctx, c, l.GetAddress(), l.GetBlockNumber(), true)
+		}
+
+		eventResp, err := hk.getContractEvents(ctx, c, l)
+		if resolveExternal && (errors.IsNotFoundError(err) || (err == nil && eventResp.Event == "")) {
+			// No ABI is bound to the address, which may be a proxy or a contract deployed outside Orchestrate
+			if hk.resolveContractCodeHash(ctx, c, l.GetAddress(), l.GetBlockNumber(), false) {
+				eventResp, err = hk.getContractEvents(ctx, c, l)
+			}
+		}
 
 		if err != nil {
 			if errors.IsNotFoundError(err) {
@@ -264,6 +352,18 @@ func (hk *Hook) decodeReceipt(ctx context.Context, c *dynamic.Chain, receipt *ty
 	return nil
 }
 
+func (hk *Hook) getContractEvents(ctx context.Context, c *dynamic.Chain, l *types.Log) (*api.GetContractEventsBySignHashResponse, error) {
+	return hk.client.GetContractEvents(
+		ctx,
+		l.GetAddress(),
+		c.ChainID,
+		&api.GetContractEventsRequest{
+			SigHash:           hexutil.MustDecode(l.Topics[0]),
+			IndexedInputCount: uint32(len(l.Topics) - 1),
+		},
+	)
+}
+
 // GetAbi creates a string ABI (format EventName(argType1, argType2)) from an event
 func GetAbi(e *ethAbi.Event) string {
 	inputs := make([]string, len(e.Inputs))
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/src/entities"
 
 	"github.com/Shopify/sarama/mocks"
@@ -201,6 +202,83 @@ func Test_AfterNewBlock(t *testing.T) {
 	})
 }
  
+func Test_AfterChainReorg(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mock2.NewMockOrchestrateClient(ctrl)
+	producer := mocks.NewSyncProducer(t, nil)
+	conf := &Config{
+		OutTopic: "test-topic-decoded",
+	}
+	forkBlockNumber := uint64(10)
+
+	t.Run("should move jobs to REORGED and publish compensating responses", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Receipt = &types.Receipt{TxHash: "0xf2beaddb2dc4e4c9055148a808365edbadd5f418c31631dcba9ad99af34ae66b"}
+
+		client.EXPECT().UpdateJob(gomock.Any(), job.UUID, &apitypes.UpdateJobRequest{
+			Status:  entities.StatusReorged,
+			Message: fmt.Sprintf("transaction removed from the canonical chain by a reorganisation after block %v", forkBlockNumber),
+		}).Return(&apitypes.JobResponse{}, nil)
+		producer.ExpectSendMessageAndSucceed()
+
+		hk := NewHook(conf, nil, producer, client)
+		err := hk.AfterChainReorg(context.Background(), c, forkBlockNumber, []*entities.Job{job})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should publish compensating responses of external transactions", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.UUID = ""
+
+		producer.ExpectSendMessageAndSucceed()
+
+		hk := NewHook(conf, nil, producer, client)
+		err := hk.AfterChainReorg(context.Background(), c, forkBlockNumber, []*entities.Job{job})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail without publishing compensating responses if a job could not be moved to REORGED", func(t *testing.T) {
+		job := testdata.FakeJob()
+		expectedErr := errors.ServiceConnectionError("error")
+
+		client.EXPECT().UpdateJob(gomock.Any(), job.UUID, gomock.Any()).Return(nil, expectedErr)
+
+		hk := NewHook(conf, nil, producer, client)
+		err := hk.AfterChainReorg(context.Background(), c, forkBlockNumber, []*entities.Job{job})
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should publish compensating responses of jobs already rolled back", func(t *testing.T) {
+		job := testdata.FakeJob()
+
+		client.EXPECT().UpdateJob(gomock.Any(), job.UUID, gomock.Any()).Return(nil, errors.InvalidStateError("invalid status update for the current job state"))
+		producer.ExpectSendMessageAndSucceed()
+
+		hk := NewHook(conf, nil, producer, client)
+		err := hk.AfterChainReorg(context.Background(), c, forkBlockNumber, []*entities.Job{job})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail if it could not to produce message into kafka", func(t *testing.T) {
+		job := testdata.FakeJob()
+		expectedErr := fmt.Errorf("error")
+
+		client.EXPECT().UpdateJob(gomock.Any(), job.UUID, gomock.Any()).Return(&apitypes.JobResponse{}, nil)
+		producer.ExpectSendMessageAndFail(expectedErr)
+
+		hk := NewHook(conf, nil, producer, client)
+		err := hk.AfterChainReorg(context.Background(), c, forkBlockNumber, []*entities.Job{job})
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
 func Test_AfterNewBlock_Priv(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
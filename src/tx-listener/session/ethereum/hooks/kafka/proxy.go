@@ -0,0 +1,128 @@
+package kafka
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/tx-listener/dynamic"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// Addresses are resolved again after resolvedAddressTTL, in case they were bound or deployed since then
+	resolvedAddressTTL = time.Hour
+	// Maximum number of resolved addresses kept in memory, the least used ones being evicted first
+	maxResolvedAddresses = 100000
+)
+
+var (
+	// Storage slots holding the implementation address of standard proxies
+	proxyImplementationSlots = []ethcommon.Hash{
+		// EIP-1967: bytes32(uint256(keccak256('eip1967.proxy.implementation')) - 1)
+		ethcommon.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc"),
+		// EIP-1822: keccak256('PROXIABLE')
+		ethcommon.HexToHash("0xc5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7"),
+	}
+
+	// Signature hash of the EIP-1967 event Upgraded(address) emitted when a proxy changes its implementation
+	upgradedEventSigHash = "0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b"
+)
+
+// resolvedAddressKey identifies an address of a chain for the tenant of the context, as code hashes are bound per tenant
+func resolvedAddressKey(ctx context.Context, c *dynamic.Chain, address string) string {
+	key := c.UUID + "@" + ethcommon.HexToAddress(address).Hex()
+	if userInfo := multitenancy.UserInfoValue(ctx); userInfo != nil {
+		key = userInfo.TenantID + "/" + key
+	}
+
+	return key
+}
+
+// resolveContractCodeHash binds the address of a contract which was not deployed by Orchestrate to the code hash of
+// its code or, for standard proxies, of the code of its implementation, as long as a registered contract matches it.
+// It returns true if a code hash was bound. Every address is resolved once per TTL, unless upgraded is set because
+// the proxy emitted an Upgraded event, in which case the former binding is replaced even if no contract matches.
+func (hk *Hook) resolveContractCodeHash(ctx context.Context, c *dynamic.Chain, address string, blockNumber uint64, upgraded bool) bool {
+	key := resolvedAddressKey(ctx, c, address)
+	if _, ok := hk.resolvedAddresses.Get(key); ok && !upgraded {
+		return false
+	}
+
+	logger := hk.logger.WithContext(ctx).WithField("address", address)
+	block := new(big.Int).SetUint64(blockNumber)
+	contractAddress := ethcommon.HexToAddress(address)
+
+	target, err := hk.proxyImplementation(ctx, c, contractAddress, block)
+	if err != nil {
+		logger.WithError(err).Warn("failed to fetch proxy implementation")
+		return false
+	}
+	if target == nil {
+		target = &contractAddress
+	} else {
+		logger = logger.WithField("implementation", target.Hex())
+	}
+
+	code, err := hk.ec.CodeAt(ctx, c.URL, *target, block)
+	if err != nil {
+		logger.WithError(err).Warn("failed to fetch contract code")
+		return false
+	}
+
+	hk.setResolved(key)
+	if len(code) == 0 {
+		return false
+	}
+
+	codeHash := crypto.Keccak256Hash(code).Bytes()
+	_, err = hk.client.SearchContract(ctx, &api.SearchContractRequest{CodeHash: codeHash})
+	switch {
+	case errors.IsNotFoundError(err) && !upgraded:
+		logger.Debug("no registered contract matches the contract code")
+		return false
+	case err != nil && !errors.IsNotFoundError(err):
+		hk.resolvedAddresses.Del(key)
+		logger.WithError(err).Warn("failed to search contract by code hash")
+		return false
+	}
+
+	err = hk.client.SetContractAddressCodeHash(ctx, contractAddress.Hex(), c.ChainID, &api.SetContractCodeHashRequest{
+		CodeHash: codeHash,
+	})
+	if err != nil {
+		hk.resolvedAddresses.Del(key)
+		logger.WithError(err).Warn("failed to register contract code hash")
+		return false
+	}
+
+	logger.Debug("contract code hash resolved")
+	return true
+}
+
+func (hk *Hook) setResolved(key string) {
+	hk.resolvedAddresses.SetWithTTL(key, struct{}{}, 1, resolvedAddressTTL)
+	// Ristretto sets values asynchronously, we wait for the address to be marked for the next receipts
+	hk.resolvedAddresses.Wait()
+}
+
+// proxyImplementation returns the implementation address stored in the EIP-1967 or EIP-1822 slot, if any
+func (hk *Hook) proxyImplementation(ctx context.Context, c *dynamic.Chain, address ethcommon.Address, block *big.Int) (*ethcommon.Address, error) {
+	for _, slot := range proxyImplementationSlots {
+		value, err := hk.ec.StorageAt(ctx, c.URL, address, slot, block)
+		if err != nil {
+			return nil, err
+		}
+
+		implementation := ethcommon.BytesToAddress(value)
+		if implementation != (ethcommon.Address{}) {
+			return &implementation, nil
+		}
+	}
+
+	return nil, nil
+}
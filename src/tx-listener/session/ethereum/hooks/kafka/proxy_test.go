@@ -0,0 +1,171 @@
+// +build unit
+
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Shopify/sarama/mocks"
+	"github.com/consensys/orchestrate/pkg/errors"
+	mock2 "github.com/consensys/orchestrate/pkg/sdk/client/mock"
+	types "github.com/consensys/orchestrate/pkg/types/ethereum"
+	apitypes "github.com/consensys/orchestrate/src/api/service/types"
+	apitestdata "github.com/consensys/orchestrate/src/api/service/types/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	"github.com/consensys/orchestrate/src/tx-listener/dynamic"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const transferEventABI = "{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"tokens\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"}"
+
+func Test_DecodeReceipt_ExternalContracts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	conf := &Config{
+		OutTopic: "test-topic-decoded",
+	}
+
+	ec := mock.NewMockMultiClient(ctrl)
+	producer := mocks.NewSyncProducer(t, nil)
+	client := mock2.NewMockOrchestrateClient(ctrl)
+
+	contract := apitestdata.FakeContractResponse()
+	chain := &dynamic.Chain{
+		UUID:     "test-c",
+		URL:      "test-url",
+		ChainID:  "888",
+		Listener: dynamic.Listener{ExternalTxEnabled: true},
+	}
+	proxyAddress := ethcommon.HexToAddress("0xAf84242d70aE9D268E2bE3616ED497BA28A7b62C")
+	implementation := ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	code := ethcommon.FromHex("0xabcd")
+	expectedDecodedData := map[string]string{
+		"tokens": "30000000000000000000",
+		"from":   "0xBA826fEc90CEFdf6706858E5FbaFcb27A290Fbe0",
+		"to":     "0x4aEE792A88eDDA29932254099b9d1e06D537883f",
+	}
+
+	t.Run("should resolve the implementation of an EIP-1967 proxy and decode its logs", func(t *testing.T) {
+		hk := NewHook(conf, ec, producer, client)
+		r := newTransferReceipt(proxyAddress)
+
+		gomock.InOrder(
+			client.EXPECT().GetContractEvents(gomock.Any(), proxyAddress.Hex(), chain.ChainID, gomock.Any()).
+				Return(&apitypes.GetContractEventsBySignHashResponse{}, nil),
+			ec.EXPECT().StorageAt(gomock.Any(), chain.URL, proxyAddress, proxyImplementationSlots[0], gomock.Any()).
+				Return(ethcommon.LeftPadBytes(implementation.Bytes(), 32), nil),
+			ec.EXPECT().CodeAt(gomock.Any(), chain.URL, implementation, gomock.Any()).Return(code, nil),
+			client.EXPECT().SearchContract(gomock.Any(), &apitypes.SearchContractRequest{CodeHash: crypto.Keccak256Hash(code).Bytes()}).
+				Return(contract, nil),
+			client.EXPECT().SetContractAddressCodeHash(gomock.Any(), proxyAddress.Hex(), chain.ChainID, &apitypes.SetContractCodeHashRequest{
+				CodeHash: crypto.Keccak256Hash(code).Bytes(),
+			}).Return(nil),
+			client.EXPECT().GetContractEvents(gomock.Any(), proxyAddress.Hex(), chain.ChainID, gomock.Any()).
+				Return(&apitypes.GetContractEventsBySignHashResponse{Event: transferEventABI}, nil),
+			client.EXPECT().SearchContract(gomock.Any(), &apitypes.SearchContractRequest{Address: &proxyAddress}).Return(contract, nil),
+		)
+
+		err := hk.decodeReceipt(context.Background(), chain, r)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Transfer(address,address,uint256)", r.Logs[0].Event)
+		assert.Equal(t, expectedDecodedData, r.Logs[0].DecodedData)
+		assert.Equal(t, contract.Name, r.ContractName)
+	})
+
+	t.Run("should not bind an external contract matching no registered contract and resolve it once", func(t *testing.T) {
+		hk := NewHook(conf, ec, producer, client)
+
+		gomock.InOrder(
+			client.EXPECT().GetContractEvents(gomock.Any(), proxyAddress.Hex(), chain.ChainID, gomock.Any()).
+				Return(&apitypes.GetContractEventsBySignHashResponse{DefaultEvents: []string{transferEventABI}}, nil),
+			ec.EXPECT().StorageAt(gomock.Any(), chain.URL, proxyAddress, proxyImplementationSlots[0], gomock.Any()).
+				Return(make([]byte, 32), nil),
+			ec.EXPECT().StorageAt(gomock.Any(), chain.URL, proxyAddress, proxyImplementationSlots[1], gomock.Any()).
+				Return(make([]byte, 32), nil),
+			ec.EXPECT().CodeAt(gomock.Any(), chain.URL, proxyAddress, gomock.Any()).Return(code, nil),
+			client.EXPECT().SearchContract(gomock.Any(), &apitypes.SearchContractRequest{CodeHash: crypto.Keccak256Hash(code).Bytes()}).
+				Return(nil, errors.NotFoundError("error")),
+			client.EXPECT().SearchContract(gomock.Any(), gomock.Any()).Return(nil, errors.NotFoundError("error")),
+		)
+
+		r := newTransferReceipt(proxyAddress)
+		err := hk.decodeReceipt(context.Background(), chain, r)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedDecodedData, r.Logs[0].DecodedData)
+
+		// Address is already resolved
+		client.EXPECT().GetContractEvents(gomock.Any(), proxyAddress.Hex(), chain.ChainID, gomock.Any()).
+			Return(&apitypes.GetContractEventsBySignHashResponse{DefaultEvents: []string{transferEventABI}}, nil)
+		client.EXPECT().SearchContract(gomock.Any(), gomock.Any()).Return(nil, errors.NotFoundError("error"))
+
+		r = newTransferReceipt(proxyAddress)
+		err = hk.decodeReceipt(context.Background(), chain, r)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedDecodedData, r.Logs[0].DecodedData)
+	})
+
+	t.Run("should replace the code hash bound to a proxy emitting an Upgraded event", func(t *testing.T) {
+		hk := NewHook(conf, ec, producer, client)
+		newCode := ethcommon.FromHex("0xef01")
+		r := newTransferReceipt(proxyAddress)
+		r.Logs[0].Topics = []string{upgradedEventSigHash, ethcommon.BytesToHash(implementation.Bytes()).Hex()}
+		r.Logs[0].Data = ""
+
+		gomock.InOrder(
+			ec.EXPECT().StorageAt(gomock.Any(), chain.URL, proxyAddress, proxyImplementationSlots[0], gomock.Any()).
+				Return(ethcommon.LeftPadBytes(implementation.Bytes(), 32), nil),
+			ec.EXPECT().CodeAt(gomock.Any(), chain.URL, implementation, gomock.Any()).Return(newCode, nil),
+			client.EXPECT().SearchContract(gomock.Any(), &apitypes.SearchContractRequest{CodeHash: crypto.Keccak256Hash(newCode).Bytes()}).
+				Return(nil, errors.NotFoundError("error")),
+			client.EXPECT().SetContractAddressCodeHash(gomock.Any(), proxyAddress.Hex(), chain.ChainID, &apitypes.SetContractCodeHashRequest{
+				CodeHash: crypto.Keccak256Hash(newCode).Bytes(),
+			}).Return(nil),
+			client.EXPECT().GetContractEvents(gomock.Any(), proxyAddress.Hex(), chain.ChainID, gomock.Any()).
+				Return(&apitypes.GetContractEventsBySignHashResponse{}, nil),
+		)
+
+		err := hk.decodeReceipt(context.Background(), chain, r)
+
+		assert.NoError(t, err)
+		assert.Empty(t, r.Logs[0].DecodedData)
+	})
+
+	t.Run("should not resolve contracts of private transactions", func(t *testing.T) {
+		hk := NewHook(conf, ec, producer, client)
+
+		client.EXPECT().GetContractEvents(gomock.Any(), proxyAddress.Hex(), chain.ChainID, gomock.Any()).
+			Return(&apitypes.GetContractEventsBySignHashResponse{}, nil)
+
+		r := newTransferReceipt(proxyAddress)
+		r.PrivacyGroupId = "kAbelwaVW7okoEn1+okO+AbA4Hhz/7DaCOWVQz9nx5M="
+		err := hk.decodeReceipt(context.Background(), chain, r)
+
+		assert.NoError(t, err)
+		assert.Empty(t, r.Logs[0].DecodedData)
+	})
+}
+
+func newTransferReceipt(address ethcommon.Address) *types.Receipt {
+	return &types.Receipt{
+		TxHash: "0xf2beaddb2dc4e4c9055148a808365edbadd5f418c31631dcba9ad99af34ae66b",
+		Logs: []*types.Log{
+			{
+				TxHash:  "0xf2beaddb2dc4e4c9055148a808365edbadd5f418c31631dcba9ad99af34ae66b",
+				Address: address.Hex(),
+				Topics: []string{
+					"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+					"0x000000000000000000000000ba826fec90cefdf6706858e5fbafcb27a290fbe0",
+					"0x0000000000000000000000004aee792a88edda29932254099b9d1e06d537883f",
+				},
+				Data:        "0x000000000000000000000000000000000000000000000001a055690d9db80000",
+				BlockNumber: 1,
+			},
+		},
+	}
+}
@@ -49,3 +49,17 @@ func (mr *MockHookMockRecorder) AfterNewBlock(ctx, chain, block, jobs interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterNewBlock", reflect.TypeOf((*MockHook)(nil).AfterNewBlock), ctx, chain, block, jobs)
 }
+
+// AfterChainReorg mocks base method
+func (m *MockHook) AfterChainReorg(ctx context.Context, chain *dynamic.Chain, forkBlockNumber uint64, jobs []*entities.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AfterChainReorg", ctx, chain, forkBlockNumber, jobs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AfterChainReorg indicates an expected call of AfterChainReorg
+func (mr *MockHookMockRecorder) AfterChainReorg(ctx, chain, forkBlockNumber, jobs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AfterChainReorg", reflect.TypeOf((*MockHook)(nil).AfterChainReorg), ctx, chain, forkBlockNumber, jobs)
+}
@@ -0,0 +1,141 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// reorgWindowSize is the number of processed blocks kept to detect chain reorganisations
+const reorgWindowSize = 128
+
+type processedBlock struct {
+	hash ethcommon.Hash
+	jobs []*entities.Job
+}
+
+// blockWindow keeps the hashes and jobs of the latest processed blocks
+type blockWindow struct {
+	size   uint64
+	blocks map[uint64]*processedBlock
+}
+
+func newBlockWindow(size uint64) *blockWindow {
+	return &blockWindow{
+		size:   size,
+		blocks: make(map[uint64]*processedBlock),
+	}
+}
+
+func (w *blockWindow) add(number uint64, hash ethcommon.Hash, jobs []*entities.Job) {
+	w.blocks[number] = &processedBlock{hash: hash, jobs: jobs}
+	if number >= w.size {
+		delete(w.blocks, number-w.size)
+	}
+}
+
+func (w *blockWindow) get(number uint64) (*processedBlock, bool) {
+	blck, ok := w.blocks[number]
+	return blck, ok
+}
+
+// jobsFrom returns the jobs contained in every block from the given number
+func (w *blockWindow) jobsFrom(from uint64) []*entities.Job {
+	var jobs []*entities.Job
+	for number := from; ; number++ {
+		blck, ok := w.blocks[number]
+		if !ok {
+			return jobs
+		}
+
+		jobs = append(jobs, blck.jobs...)
+	}
+}
+
+// truncate removes every block from the given number
+func (w *blockWindow) truncate(from uint64) {
+	for number := from; ; number++ {
+		if _, ok := w.blocks[number]; !ok {
+			return
+		}
+
+		delete(w.blocks, number)
+	}
+}
+
+// checkReorg verifies the block extends the last processed block
+//
+// On parent hash mismatch, the jobs of orphaned blocks are handed to the hook and the offset is moved back to the fork
+// block, so the returned error restarts the session from the first block of the new canonical chain. When the
+// reorganisation is deeper than the window, the session restarts from the oldest block kept and the jobs mined in older
+// orphaned blocks cannot be rolled back
+func (s *Session) checkReorg(ctx context.Context, block *ethtypes.Block) error {
+	number := block.NumberU64()
+	if number == 0 {
+		return nil
+	}
+
+	parent, ok := s.window.get(number - 1)
+	if !ok || parent.hash == block.ParentHash() {
+		return nil
+	}
+
+	forkNumber, deepReorgErr := s.findForkBlock(ctx, number-1)
+	if deepReorgErr != nil && !errors.IsChainReorgError(deepReorgErr) {
+		return deepReorgErr
+	}
+
+	// Orphaned blocks are kept until the hook processed them, so that a failed rollback is retried
+	jobs := s.window.jobsFrom(forkNumber + 1)
+	logger := s.logger.WithField("block_number", number).WithField("fork_block_number", forkNumber)
+	logger.WithField("orphaned_jobs", len(jobs)).Warn("chain reorganisation detected")
+
+	err := s.hook.AfterChainReorg(ctx, s.Chain, forkNumber, jobs)
+	if err != nil {
+		return err
+	}
+
+	err = s.offsets.SetLastBlockNumber(ctx, s.Chain, forkNumber)
+	if err != nil {
+		return err
+	}
+	s.window.truncate(forkNumber + 1)
+
+	if deepReorgErr != nil {
+		logger.WithError(deepReorgErr).Error("jobs mined in orphaned blocks older than the window are not rolled back")
+		return deepReorgErr
+	}
+
+	return errors.ChainReorgError("chain reorganisation detected after block %d", forkNumber)
+}
+
+// findForkBlock returns the highest processed block still part of the canonical chain
+//
+// When every block of the window was orphaned, it fails with a ChainReorgError along with the highest block which is not
+// kept, and could not be checked
+func (s *Session) findForkBlock(ctx context.Context, number uint64) (uint64, error) {
+	for {
+		blck, ok := s.window.get(number)
+		if !ok {
+			return number, errors.ChainReorgError("chain reorganisation deeper than the window of %d blocks, blocks up to %d were not checked",
+				s.window.size, number)
+		}
+
+		header, err := s.ec.HeaderByNumber(ctx, s.Chain.URL, new(big.Int).SetUint64(number))
+		if err != nil {
+			errMessage := "failed to fetch block header"
+			s.logger.WithError(err).WithField("block_number", number).Error(errMessage)
+			return 0, errors.ConnectionError(errMessage)
+		}
+
+		if header.Hash() == blck.hash || number == 0 {
+			return number, nil
+		}
+
+		number--
+	}
+}
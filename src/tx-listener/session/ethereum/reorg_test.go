@@ -0,0 +1,161 @@
+// +build unit
+
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/tx-listener/dynamic"
+	"github.com/consensys/orchestrate/src/tx-listener/session/ethereum/hooks/mock"
+	mock3 "github.com/consensys/orchestrate/src/tx-listener/session/ethereum/mocks"
+	mock2 "github.com/consensys/orchestrate/src/tx-listener/session/ethereum/offset/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockWindow(t *testing.T) {
+	t.Run("should evict blocks older than the window size", func(t *testing.T) {
+		window := newBlockWindow(2)
+		window.add(1, common.HexToHash("0x1"), nil)
+		window.add(2, common.HexToHash("0x2"), nil)
+		window.add(3, common.HexToHash("0x3"), nil)
+
+		_, ok := window.get(1)
+		assert.False(t, ok)
+		blck, ok := window.get(3)
+		require.True(t, ok)
+		assert.Equal(t, common.HexToHash("0x3"), blck.hash)
+	})
+
+	t.Run("should return the jobs of blocks and truncate them", func(t *testing.T) {
+		window := newBlockWindow(10)
+		job1, job2 := testdata.FakeJob(), testdata.FakeJob()
+		window.add(1, common.HexToHash("0x1"), nil)
+		window.add(2, common.HexToHash("0x2"), []*entities.Job{job1})
+		window.add(3, common.HexToHash("0x3"), []*entities.Job{job2})
+
+		jobs := window.jobsFrom(2)
+		assert.Equal(t, []*entities.Job{job1, job2}, jobs)
+
+		window.truncate(2)
+		_, ok := window.get(2)
+		assert.False(t, ok)
+		_, ok = window.get(1)
+		assert.True(t, ok)
+	})
+}
+
+func TestSession_CheckReorg(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockHook := mock.NewMockHook(ctrl)
+	mockOffsetManager := mock2.NewMockManager(ctrl)
+	mockEthClient := mock3.NewMockEthClient(ctrl)
+
+	newSession := func() *Session {
+		ses := NewSession(&dynamic.Chain{UUID: "test-chain", URL: "test-url"}, mockEthClient, nil, mockHook, mockOffsetManager, nil)
+		ses.window.add(10, common.HexToHash("0xa"), nil)
+		ses.window.add(11, common.HexToHash("0xb"), []*entities.Job{testdata.FakeJob()})
+		return ses
+	}
+
+	t.Run("should accept a block extending the last processed block", func(t *testing.T) {
+		ses := newSession()
+		block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(12), ParentHash: common.HexToHash("0xb")})
+
+		err := ses.checkReorg(ctx, block)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should accept a block if parent was not processed", func(t *testing.T) {
+		ses := newSession()
+		block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(20), ParentHash: common.HexToHash("0xf")})
+
+		err := ses.checkReorg(ctx, block)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should rollback orphaned jobs and restart from fork block", func(t *testing.T) {
+		ses := newSession()
+		orphanedBlock, _ := ses.window.get(11)
+		forkHeader := &types.Header{Number: big.NewInt(10)}
+		ses.window.add(10, forkHeader.Hash(), nil)
+		block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(12), ParentHash: common.HexToHash("0xc")})
+
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), ses.Chain.URL, big.NewInt(11)).
+			Return(&types.Header{Number: big.NewInt(11)}, nil)
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), ses.Chain.URL, big.NewInt(10)).Return(forkHeader, nil)
+		mockHook.EXPECT().AfterChainReorg(gomock.Any(), ses.Chain, uint64(10), orphanedBlock.jobs).Return(nil)
+		mockOffsetManager.EXPECT().SetLastBlockNumber(gomock.Any(), ses.Chain, uint64(10)).Return(nil)
+
+		err := ses.checkReorg(ctx, block)
+
+		assert.True(t, errors.IsChainReorgError(err))
+		_, ok := ses.window.get(11)
+		assert.False(t, ok)
+	})
+
+	t.Run("should fail with same error if AfterChainReorg fails", func(t *testing.T) {
+		ses := newSession()
+		block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(12), ParentHash: common.HexToHash("0xc")})
+		expectedErr := fmt.Errorf("error")
+
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), ses.Chain.URL, big.NewInt(11)).
+			Return(&types.Header{Number: big.NewInt(11)}, nil)
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), ses.Chain.URL, big.NewInt(10)).
+			Return(&types.Header{Number: big.NewInt(10)}, nil)
+		mockHook.EXPECT().AfterChainReorg(gomock.Any(), ses.Chain, uint64(9), gomock.Any()).Return(expectedErr)
+
+		err := ses.checkReorg(ctx, block)
+
+		assert.Equal(t, expectedErr, err)
+		// Orphaned blocks are kept so that the reorganisation is processed again
+		_, ok := ses.window.get(11)
+		assert.True(t, ok)
+	})
+
+	t.Run("should fail explicitly if the reorganisation is deeper than the window", func(t *testing.T) {
+		ses := newSession()
+		orphanedBlock, _ := ses.window.get(11)
+		block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(12), ParentHash: common.HexToHash("0xc")})
+
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), ses.Chain.URL, big.NewInt(11)).
+			Return(&types.Header{Number: big.NewInt(11)}, nil)
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), ses.Chain.URL, big.NewInt(10)).
+			Return(&types.Header{Number: big.NewInt(10)}, nil)
+		mockHook.EXPECT().AfterChainReorg(gomock.Any(), ses.Chain, uint64(9), orphanedBlock.jobs).Return(nil)
+		mockOffsetManager.EXPECT().SetLastBlockNumber(gomock.Any(), ses.Chain, uint64(9)).Return(nil)
+
+		err := ses.checkReorg(ctx, block)
+
+		assert.True(t, errors.IsChainReorgError(err))
+		assert.Contains(t, err.Error(), "deeper than the window")
+		_, ok := ses.window.get(10)
+		assert.False(t, ok)
+	})
+
+	t.Run("should fail with ConnectionError if HeaderByNumber fails", func(t *testing.T) {
+		ses := newSession()
+		block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(12), ParentHash: common.HexToHash("0xc")})
+
+		mockEthClient.EXPECT().HeaderByNumber(gomock.Any(), ses.Chain.URL, big.NewInt(11)).
+			Return(nil, fmt.Errorf("error"))
+
+		err := ses.checkReorg(ctx, block)
+
+		assert.True(t, errors.IsConnectionError(err))
+	})
+}
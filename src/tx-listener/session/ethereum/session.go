@@ -40,6 +40,8 @@ type Session struct {
 	blockPosition                  uint64
 	eeaPrivPrecompiledContractAddr string
 	currentChainTip                uint64
+	// Latest processed blocks, kept across session restarts to detect chain reorganisations
+	window *blockWindow
 	// Channel stacking blocks waiting for receipts to be fetched
 	fetchedBlocks chan *Future
 	errors        chan error
@@ -62,6 +64,7 @@ func NewSession(
 		offsets: offsets,
 		bckOff:  backoff.NewConstantBackOff(2 * time.Second),
 		metrics: m,
+		window:  newBlockWindow(reorgWindowSize),
 		metricsLabels: []string{
 			"chain_uuid", chain.UUID,
 		},
@@ -292,12 +295,23 @@ func (s *Session) callHooks(ctx context.Context) {
 }
 
 func (s *Session) callHook(ctx context.Context, block *fetchedBlock) error {
-	err := s.hook.AfterNewBlock(ctx, s.Chain, block.block, block.jobs)
+	err := s.checkReorg(ctx, block.block)
 	if err != nil {
 		return err
 	}
 
-	return s.offsets.SetLastBlockNumber(ctx, s.Chain, block.block.NumberU64())
+	err = s.hook.AfterNewBlock(ctx, s.Chain, block.block, block.jobs)
+	if err != nil {
+		return err
+	}
+
+	err = s.offsets.SetLastBlockNumber(ctx, s.Chain, block.block.NumberU64())
+	if err != nil {
+		return err
+	}
+
+	s.window.add(block.block.NumberU64(), block.block.Hash(), block.jobs)
+	return nil
 }
 
 func (s *Session) fetchBlock(ctx context.Context, blockPosition uint64) *Future {
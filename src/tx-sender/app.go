@@ -2,9 +2,12 @@ package txsender
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 	"time"
 
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/gas"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/gas/oracle"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/nonce"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/nonce/manager"
 
@@ -18,6 +21,7 @@ import (
 	"github.com/consensys/orchestrate/src/infra/ethclient"
 	"github.com/consensys/orchestrate/src/infra/redis"
 	"github.com/consensys/orchestrate/src/tx-sender/service"
+	"github.com/consensys/orchestrate/src/tx-sender/store"
 	"github.com/consensys/orchestrate/src/tx-sender/store/memory"
 	redisnoncemngr "github.com/consensys/orchestrate/src/tx-sender/store/redis"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/builder"
@@ -32,6 +36,8 @@ type txSenderDaemon struct {
 	jobClient        api.JobClient
 	ec               ethclient.MultiClient
 	nonceManager     nonce.Manager
+	gasOracle        gas.Oracle
+	oneTimeKeys      store.OneTimeKeys
 	consumerGroup    []sarama.ConsumerGroup
 	producer         sarama.SyncProducer
 	config           *Config
@@ -54,12 +60,18 @@ func NewTxSender(
 	}
 
 	var nm nonce.Manager
+	var oneTimeKeys store.OneTimeKeys
 	if config.NonceManagerType == NonceManagerTypeInMemory {
 		nm = manager.NewNonceManager(ec, memory.NewNonceSender(config.NonceManagerExpiration), memory.NewNonceRecoveryTracker(),
-			config.ProxyURL, config.NonceMaxRecovery)
+			apiClient, config.ProxyURL, config.NonceMaxRecovery, config.NonceManagerGapTimeout)
+		oneTimeKeys = memory.NewOneTimeKeys(config.OneTimeKeyExpiration)
 	} else if config.NonceManagerType == NonceManagerTypeRedis {
 		nm = manager.NewNonceManager(ec, redisnoncemngr.NewNonceSender(redisCli, config.NonceManagerExpiration), redisnoncemngr.NewNonceRecoveryTracker(redisCli),
-			config.ProxyURL, config.NonceMaxRecovery)
+			apiClient, config.ProxyURL, config.NonceMaxRecovery, config.NonceManagerGapTimeout)
+		oneTimeKeys, err = newRedisOneTimeKeys(redisCli, config)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	txSenderDaemon := &txSenderDaemon{
@@ -70,6 +82,8 @@ func NewTxSender(
 		config:           config,
 		ec:               ec,
 		nonceManager:     nm,
+		gasOracle:        oracle.NewChainOracle(apiClient, ec, config.ProxyURL),
+		oneTimeKeys:      oneTimeKeys,
 		logger:           log.NewLogger().SetComponent(component),
 	}
 
@@ -82,14 +96,18 @@ func (d *txSenderDaemon) Run(ctx context.Context) error {
 	d.logger.Debug("starting transaction sender")
 
 	// Create business layer use cases
-	useCases := builder.NewUseCases(d.jobClient, d.keyManagerClient, d.ec, d.nonceManager, d.config.ProxyURL)
+	useCases := builder.NewUseCases(d.jobClient, d.keyManagerClient, d.ec, d.nonceManager, d.gasOracle, d.oneTimeKeys, d.config.ProxyURL)
 
 	// Create service layer listener
 	listener := service.NewMessageListener(useCases, d.jobClient, d.producer, d.config.RecoverTopic, d.config.SenderTopic,
 		d.config.BckOff)
+	keysCleaner := service.NewOneTimeKeysCleaner(d.jobClient, d.oneTimeKeys, subscriptionBackOff())
 
 	ctx, d.cancel = context.WithCancel(ctx)
 	gr := &multierror.Group{}
+	gr.Go(func() error {
+		return keysCleaner.Run(ctx)
+	})
 	for idx, consumerGroup := range d.consumerGroup {
 		cGroup := consumerGroup
 		cGroupID := fmt.Sprintf("c-%d", idx)
@@ -130,6 +148,28 @@ func (d *txSenderDaemon) Close() error {
 	return gerr
 }
 
+func newRedisOneTimeKeys(redisCli redis.Client, config *Config) (store.OneTimeKeys, error) {
+	secret := config.OneTimeKeySecret
+	if secret == "" {
+		log.NewLogger().SetComponent(component).
+			Warn("no one-time key encryption secret set, a random secret is used and one-time keys are lost on restart")
+		secretB := make([]byte, 32)
+		if _, err := rand.Read(secretB); err != nil {
+			return nil, errors.CryptoOperationError("failed to generate one-time key encryption secret").AppendReason(err.Error())
+		}
+		secret = string(secretB)
+	}
+
+	return redisnoncemngr.NewOneTimeKeys(redisCli, config.OneTimeKeyExpiration, secret)
+}
+
+func subscriptionBackOff() backoff.BackOff {
+	bck := backoff.NewExponentialBackOff()
+	bck.MaxInterval = time.Minute
+	bck.MaxElapsedTime = 0
+	return bck
+}
+
 func readinessOpt(apiClient api.MetricClient, redisCli redis.Client) app.Option {
 	return func(ap *app.App) error {
 		ap.AddReadinessCheck("kafka", pkgsarama.GlobalClientChecker())
@@ -17,6 +17,8 @@ import (
 	metricregistry "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/registry"
 	tcpmetrics "github.com/consensys/orchestrate/pkg/toolkit/tcp/metrics"
 	broker "github.com/consensys/orchestrate/src/infra/broker/sarama"
+	"github.com/consensys/orchestrate/src/infra/database/postgres"
+	"github.com/consensys/orchestrate/src/infra/keystore"
 	qkm "github.com/consensys/orchestrate/src/infra/quorum-key-manager"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -35,6 +37,15 @@ func init() {
 	viper.SetDefault(NonceManagerExpirationViperKey, nonceManagerExpirationDefault)
 	_ = viper.BindEnv(NonceManagerExpirationViperKey, nonceManagerExpirationEnv)
 
+	viper.SetDefault(NonceManagerGapTimeoutViperKey, nonceManagerGapTimeoutDefault)
+	_ = viper.BindEnv(NonceManagerGapTimeoutViperKey, nonceManagerGapTimeoutEnv)
+
+	viper.SetDefault(OneTimeKeyExpirationViperKey, oneTimeKeyExpirationDefault)
+	_ = viper.BindEnv(OneTimeKeyExpirationViperKey, oneTimeKeyExpirationEnv)
+
+	viper.SetDefault(OneTimeKeyEncryptionSecretViperKey, oneTimeKeyEncryptionSecretDefault)
+	_ = viper.BindEnv(OneTimeKeyEncryptionSecretViperKey, oneTimeKeyEncryptionSecretEnv)
+
 	viper.SetDefault(KafkaConsumerViperKey, kafkaConsumerDefault)
 	_ = viper.BindEnv(KafkaConsumerViperKey, KafkaConsumerEnv)
 }
@@ -69,6 +80,27 @@ const (
 	nonceManagerExpirationEnv      = "NONCE_MANAGER_EXPIRATION"
 )
 
+const (
+	nonceManagerGapTimeoutFlag     = "nonce-manager-gap-timeout"
+	NonceManagerGapTimeoutViperKey = "nonce.manager.gap.timeout"
+	nonceManagerGapTimeoutDefault  = time.Minute
+	nonceManagerGapTimeoutEnv      = "NONCE_MANAGER_GAP_TIMEOUT"
+)
+
+const (
+	oneTimeKeyExpirationFlag     = "one-time-key-expiration"
+	OneTimeKeyExpirationViperKey = "one-time-key.expiration"
+	oneTimeKeyExpirationDefault  = 24 * time.Hour
+	oneTimeKeyExpirationEnv      = "ONE_TIME_KEY_EXPIRATION"
+)
+
+const (
+	oneTimeKeyEncryptionSecretFlag     = "one-time-key-encryption-secret"
+	OneTimeKeyEncryptionSecretViperKey = "one-time-key.encryption.secret"
+	oneTimeKeyEncryptionSecretDefault  = ""
+	oneTimeKeyEncryptionSecretEnv      = "ONE_TIME_KEY_ENCRYPTION_SECRET"
+)
+
 const (
 	kafkaConsumersFlag    = "kafka-consumers"
 	KafkaConsumerViperKey = "kafka.consumers"
@@ -86,6 +118,8 @@ func Flags(f *pflag.FlagSet) {
 	broker.KafkaTopicTxSender(f)
 	broker.KafkaTopicTxRecover(f)
 	qkm.Flags(f)
+	keystore.Flags(f)
+	postgres.PGFlags(f)
 	orchestrateclient.Flags(f)
 	app.MetricFlags(f)
 	metricregistry.Flags(f, tcpmetrics.ModuleName)
@@ -93,6 +127,9 @@ func Flags(f *pflag.FlagSet) {
 	maxRecovery(f)
 	nonceManagerType(f)
 	nonceManagerExpiration(f)
+	nonceManagerGapTimeout(f)
+	oneTimeKeyExpiration(f)
+	oneTimeKeyEncryptionSecret(f)
 	kafkaConsumers(f)
 }
 
@@ -117,6 +154,27 @@ Environment variable: %q`, nonceManagerExpirationEnv)
 	_ = viper.BindPFlag(NonceManagerExpirationViperKey, f.Lookup(nonceManagerExpirationFlag))
 }
 
+func nonceManagerGapTimeout(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Duration after which a sent nonce not confirmed by the chain reveals a nonce gap to fill (0 disables gap filling and the nonce ledger).
+Environment variable: %q`, nonceManagerGapTimeoutEnv)
+	f.Duration(nonceManagerGapTimeoutFlag, nonceManagerGapTimeoutDefault, desc)
+	_ = viper.BindPFlag(NonceManagerGapTimeoutViperKey, f.Lookup(nonceManagerGapTimeoutFlag))
+}
+
+func oneTimeKeyExpiration(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Duration one-time keys are kept to sign replacing transactions (speed up, call off). Keys are kept in the cache of the nonce manager type.
+Environment variable: %q`, oneTimeKeyExpirationEnv)
+	f.Duration(oneTimeKeyExpirationFlag, oneTimeKeyExpirationDefault, desc)
+	_ = viper.BindPFlag(OneTimeKeyExpirationViperKey, f.Lookup(oneTimeKeyExpirationFlag))
+}
+
+func oneTimeKeyEncryptionSecret(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Secret encrypting the one-time keys kept in Redis, shared by all the tx-sender instances (random secret if empty, keys are then lost on restart).
+Environment variable: %q`, oneTimeKeyEncryptionSecretEnv)
+	f.String(oneTimeKeyEncryptionSecretFlag, oneTimeKeyEncryptionSecretDefault, desc)
+	_ = viper.BindPFlag(OneTimeKeyEncryptionSecretViperKey, f.Lookup(oneTimeKeyEncryptionSecretFlag))
+}
+
 func kafkaConsumers(f *pflag.FlagSet) {
 	desc := fmt.Sprintf(`Number of parallel kafka consumers to initialize.
 Environment variable: %q`, KafkaConsumerEnv)
@@ -136,6 +194,9 @@ type Config struct {
 	NonceManagerType       string
 	RedisCfg               *redigo.Config
 	NonceManagerExpiration time.Duration
+	NonceManagerGapTimeout time.Duration
+	OneTimeKeyExpiration   time.Duration
+	OneTimeKeySecret       string
 }
 
 func NewConfig(vipr *viper.Viper) *Config {
@@ -149,6 +210,9 @@ func NewConfig(vipr *viper.Viper) *Config {
 		BckOff:                 retryMessageBackOff(),
 		NonceManagerType:       vipr.GetString(nonceManagerTypeViperKey),
 		NonceManagerExpiration: vipr.GetDuration(NonceManagerExpirationViperKey),
+		NonceManagerGapTimeout: vipr.GetDuration(NonceManagerGapTimeoutViperKey),
+		OneTimeKeyExpiration:   vipr.GetDuration(OneTimeKeyExpirationViperKey),
+		OneTimeKeySecret:       vipr.GetString(OneTimeKeyEncryptionSecretViperKey),
 		RedisCfg:               flags.NewRedisConfig(vipr),
 		NConsumer:              int(vipr.GetUint64(KafkaConsumerViperKey)),
 	}
@@ -13,7 +13,7 @@ import (
 	ethclient "github.com/consensys/orchestrate/src/infra/ethclient/rpc"
 
 	"github.com/consensys/orchestrate/src/infra/broker/sarama"
-	qkm "github.com/consensys/orchestrate/src/infra/quorum-key-manager"
+	"github.com/consensys/orchestrate/src/infra/keystore"
 	"github.com/spf13/viper"
 )
 
@@ -25,7 +25,7 @@ func New(ctx context.Context) (*app.App, error) {
 	var err error
 
 	sarama.InitSyncProducer(ctx)
-	qkm.Init()
+	keystore.Init(ctx)
 	orchestrateClient.Init()
 	ethclient.Init(ctx)
 
@@ -51,7 +51,7 @@ func New(ctx context.Context) (*app.App, error) {
 		config,
 		consumerGroups,
 		sarama.GlobalSyncProducer(),
-		qkm.GlobalClient(),
+		keystore.GlobalClient(),
 		orchestrateClient.GlobalClient(),
 		ethclient.GlobalClient(),
 		redisClient,
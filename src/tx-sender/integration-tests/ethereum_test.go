@@ -114,6 +114,10 @@ func (s *txSenderEthereumTestSuite) TestPublic() {
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-transaction", qkmStoreName, envelope.GetFromString())).
 			Reply(http2.StatusOK).BodyString(signedRawTx)
 
+		gock.New(apiURL).
+			Get(fmt.Sprintf("/chains/%s", envelope.GetChainUUID())).
+			Reply(http2.StatusOK).JSON(&api.ChainResponse{UUID: envelope.GetChainUUID()})
+
 		feeHistory := testdata.FakeFeeHistory(new(big.Int).SetUint64(100000))
 		feeHistoryResult, _ := json.Marshal(feeHistory)
 		gock.New(apiURL).
@@ -175,6 +179,10 @@ func (s *txSenderEthereumTestSuite) TestPublic() {
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-transaction", qkmStoreName, envelope.GetFromString())).
 			Reply(http2.StatusOK).BodyString(signedRawTx)
 
+		gock.New(apiURL).
+			Get(fmt.Sprintf("/chains/%s", envelope.GetChainUUID())).
+			Reply(http2.StatusOK).JSON(&api.ChainResponse{UUID: envelope.GetChainUUID()})
+
 		gock.New(apiURL).
 			Post(fmt.Sprintf("/proxy/chains/%s", envelope.GetChainUUID())).
 			AddMatcher(ethCallMatcher(wg, "eth_gasPrice")).
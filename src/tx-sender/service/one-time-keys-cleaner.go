@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk/client"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/tx-sender/store"
+)
+
+const oneTimeKeysCleanerComponent = "service.one-time-keys-cleaner"
+
+// OneTimeKeysCleaner deletes the one-time keys of the jobs reaching a final status. Job events are best effort, keys
+// missed by the cleaner are removed when they expire
+type OneTimeKeysCleaner struct {
+	jobClient    client.JobClient
+	keys         store.OneTimeKeys
+	retryBackOff backoff.BackOff
+	logger       *log.Logger
+}
+
+func NewOneTimeKeysCleaner(jobClient client.JobClient, keys store.OneTimeKeys, bck backoff.BackOff) *OneTimeKeysCleaner {
+	return &OneTimeKeysCleaner{
+		jobClient:    jobClient,
+		keys:         keys,
+		retryBackOff: bck,
+		logger:       log.NewLogger().SetComponent(oneTimeKeysCleanerComponent),
+	}
+}
+
+// Run listens to the job events until the context is cancelled, subscribing again whenever the stream is closed
+func (c *OneTimeKeysCleaner) Run(ctx context.Context) error {
+	logger := c.logger.WithContext(ctx)
+	bck := backoff.WithContext(c.retryBackOff, ctx)
+
+	_ = backoff.RetryNotify(
+		func() error {
+			events, err := c.jobClient.SubscribeJobEvents(ctx, &entities.JobEventFilters{})
+			if err != nil {
+				return err
+			}
+
+			logger.Debug("listening to job events")
+			bck.Reset()
+			for event := range events {
+				c.clean(ctx, event)
+			}
+
+			return errors.ServiceConnectionError("job events stream closed")
+		},
+		bck,
+		func(err error, duration time.Duration) {
+			logger.WithError(err).Warnf("job events subscription lost, retrying in %s", duration.String())
+		},
+	)
+
+	return nil
+}
+
+func (c *OneTimeKeysCleaner) clean(ctx context.Context, event *api.JobEventResponse) {
+	if !entities.IsFinalJobStatus(event.Status) {
+		return
+	}
+
+	// Failures of a resending job are only logged, the job keeps being sent
+	if event.Status == entities.StatusFailed {
+		job, err := c.jobClient.GetJob(ctx, event.JobUUID)
+		if err != nil {
+			c.logger.WithContext(ctx).WithError(err).WithField("job", event.JobUUID).Warn("failed to get failed job")
+			return
+		}
+		if job.Status != entities.StatusFailed {
+			return
+		}
+	}
+
+	jobUUIDs := []string{event.JobUUID}
+	// Replaced jobs are signed with the key of their parent, which is not needed anymore once one of them is mined
+	if event.Status == entities.StatusMined && event.ParentJobUUID != "" && event.ParentJobUUID != event.JobUUID {
+		jobUUIDs = append(jobUUIDs, event.ParentJobUUID)
+	}
+
+	for _, jobUUID := range jobUUIDs {
+		if err := c.keys.Delete(jobUUID); err != nil {
+			c.logger.WithContext(ctx).WithError(err).WithField("job", jobUUID).Warn("failed to delete one-time key")
+		}
+	}
+}
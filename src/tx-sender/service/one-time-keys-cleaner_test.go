@@ -0,0 +1,85 @@
+// +build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/consensys/orchestrate/pkg/errors"
+	mock3 "github.com/consensys/orchestrate/pkg/sdk/client/mock"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	storemock "github.com/consensys/orchestrate/src/tx-sender/store/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOneTimeKeysCleaner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiClient := mock3.NewMockOrchestrateClient(ctrl)
+	keys := storemock.NewMockOneTimeKeys(ctrl)
+	cleaner := NewOneTimeKeysCleaner(apiClient, keys, backoff.NewConstantBackOff(time.Millisecond))
+
+	// runCleaner streams the events to the cleaner and stops it once they are all processed
+	runCleaner := func(t *testing.T, events ...*api.JobEventResponse) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		eventsCh := make(chan *api.JobEventResponse, len(events))
+		for _, event := range events {
+			eventsCh <- event
+		}
+		close(eventsCh)
+
+		apiClient.EXPECT().SubscribeJobEvents(gomock.Any(), &entities.JobEventFilters{}).Return(eventsCh, nil)
+		apiClient.EXPECT().SubscribeJobEvents(gomock.Any(), &entities.JobEventFilters{}).
+			DoAndReturn(func(_ context.Context, _ *entities.JobEventFilters) (<-chan *api.JobEventResponse, error) {
+				cancel()
+				return nil, errors.ServiceConnectionError("error")
+			})
+
+		assert.NoError(t, cleaner.Run(ctx))
+	}
+
+	t.Run("should delete the one-time key of final jobs", func(t *testing.T) {
+		keys.EXPECT().Delete("jobUUID").Return(nil)
+
+		runCleaner(t,
+			&api.JobEventResponse{JobUUID: "pendingJobUUID", Status: entities.StatusPending},
+			&api.JobEventResponse{JobUUID: "jobUUID", Status: entities.StatusNeverMined},
+		)
+	})
+
+	t.Run("should delete the one-time key of the parent of mined jobs", func(t *testing.T) {
+		keys.EXPECT().Delete("jobUUID").Return(nil)
+		keys.EXPECT().Delete("parentJobUUID").Return(nil)
+
+		runCleaner(t, &api.JobEventResponse{JobUUID: "jobUUID", ParentJobUUID: "parentJobUUID", Status: entities.StatusMined})
+	})
+
+	t.Run("should keep the one-time key of failed jobs still resending", func(t *testing.T) {
+		apiClient.EXPECT().GetJob(gomock.Any(), "resendingJobUUID").Return(&api.JobResponse{Status: entities.StatusResending}, nil)
+		apiClient.EXPECT().GetJob(gomock.Any(), "jobUUID").Return(&api.JobResponse{Status: entities.StatusFailed}, nil)
+		keys.EXPECT().Delete("jobUUID").Return(nil)
+
+		runCleaner(t,
+			&api.JobEventResponse{JobUUID: "resendingJobUUID", Status: entities.StatusFailed},
+			&api.JobEventResponse{JobUUID: "jobUUID", Status: entities.StatusFailed},
+		)
+	})
+
+	t.Run("should keep listening if a key cannot be deleted", func(t *testing.T) {
+		keys.EXPECT().Delete("jobUUID").Return(errors.RedisConnectionError("error"))
+		keys.EXPECT().Delete("jobUUID2").Return(nil)
+
+		runCleaner(t,
+			&api.JobEventResponse{JobUUID: "jobUUID", Status: entities.StatusMined},
+			&api.JobEventResponse{JobUUID: "jobUUID2", Status: entities.StatusMined},
+		)
+	})
+}
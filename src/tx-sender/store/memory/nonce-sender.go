@@ -2,6 +2,8 @@ package memory
 
 import (
 	"reflect"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
@@ -20,6 +22,9 @@ import (
 type nonceSender struct {
 	cache *ristretto.Cache
 	ttl   time.Duration
+	// Nonce ledgers are not cached as records must not be evicted before being confirmed
+	mutex   *sync.RWMutex
+	ledgers map[string]map[uint64]*store.NonceRecord
 }
 
 // NewNonceSender creates a new mock NonceManager
@@ -31,8 +36,10 @@ func NewNonceSender(ttl time.Duration) store.NonceSender {
 	})
 
 	return &nonceSender{
-		cache: cache,
-		ttl:   ttl,
+		cache:   cache,
+		ttl:     ttl,
+		mutex:   &sync.RWMutex{},
+		ledgers: make(map[string]map[uint64]*store.NonceRecord),
 	}
 }
 
@@ -57,6 +64,50 @@ func (nm *nonceSender) DeleteLastSent(key string) (err error) {
 	return nil
 }
 
+func (nm *nonceSender) GetNonceRecords(key string) ([]*store.NonceRecord, error) {
+	nm.mutex.RLock()
+	defer nm.mutex.RUnlock()
+
+	records := []*store.NonceRecord{}
+	for _, record := range nm.ledgers[key] {
+		r := *record
+		records = append(records, &r)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Nonce < records[j].Nonce })
+
+	return records, nil
+}
+
+func (nm *nonceSender) SetNonceRecord(key string, record *store.NonceRecord) error {
+	nm.mutex.Lock()
+	defer nm.mutex.Unlock()
+
+	if _, ok := nm.ledgers[key]; !ok {
+		nm.ledgers[key] = make(map[uint64]*store.NonceRecord)
+	}
+
+	r := *record
+	nm.ledgers[key][record.Nonce] = &r
+	return nil
+}
+
+func (nm *nonceSender) DeleteNonceRecords(key string, lowerThan uint64) error {
+	nm.mutex.Lock()
+	defer nm.mutex.Unlock()
+
+	for nonce := range nm.ledgers[key] {
+		if nonce < lowerThan {
+			delete(nm.ledgers[key], nonce)
+		}
+	}
+
+	if len(nm.ledgers[key]) == 0 {
+		delete(nm.ledgers, key)
+	}
+
+	return nil
+}
+
 func (nm *nonceSender) loadUint64(key string) (uint64, error) {
 	v, ok := nm.cache.Get(key)
 	if !ok {
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/consensys/orchestrate/src/tx-sender/store"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -50,3 +51,35 @@ func TestNonceSenderMemory(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(0), n)
 }
+
+func TestNonceSenderMemory_NonceLedger(t *testing.T) {
+	ns := NewNonceSender(time.Second)
+
+	testKey := "nonce-ledger-memory"
+
+	records, err := ns.GetNonceRecords(testKey)
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	err = ns.SetNonceRecord(testKey, &store.NonceRecord{Nonce: 2, JobUUID: "job-2", Status: store.NonceReserved})
+	assert.NoError(t, err)
+	err = ns.SetNonceRecord(testKey, &store.NonceRecord{Nonce: 1, JobUUID: "job-1", Status: store.NonceReserved})
+	assert.NoError(t, err)
+	err = ns.SetNonceRecord(testKey, &store.NonceRecord{Nonce: 1, JobUUID: "job-1", Status: store.NonceSent})
+	assert.NoError(t, err)
+
+	records, err = ns.GetNonceRecords(testKey)
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, uint64(1), records[0].Nonce)
+	assert.Equal(t, store.NonceSent, records[0].Status)
+	assert.Equal(t, uint64(2), records[1].Nonce)
+
+	err = ns.DeleteNonceRecords(testKey, 2)
+	assert.NoError(t, err)
+
+	records, err = ns.GetNonceRecords(testKey)
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "job-2", records[0].JobUUID)
+}
@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/tx-sender/store"
+	"github.com/dgraph-io/ristretto"
+)
+
+const oneTimeKeySuf = "one-time-key"
+
+type oneTimeKeys struct {
+	cache *ristretto.Cache
+	ttl   time.Duration
+}
+
+// NewOneTimeKeys creates a one-time key store keeping keys in memory for the given TTL
+func NewOneTimeKeys(ttl time.Duration) store.OneTimeKeys {
+	cache, _ := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1e7,
+		MaxCost:     1 << 30,
+		BufferItems: 64,
+	})
+
+	return &oneTimeKeys{
+		cache: cache,
+		ttl:   ttl,
+	}
+}
+
+func (k *oneTimeKeys) Get(jobUUID string) ([]byte, error) {
+	v, ok := k.cache.Get(computeKey(jobUUID, oneTimeKeySuf))
+	if !ok {
+		return nil, errors.NotFoundError("one-time key not found")
+	}
+
+	privKey, ok := v.([]byte)
+	if !ok {
+		return nil, errors.DataCorruptedError("loaded value is not a one-time key")
+	}
+
+	return privKey, nil
+}
+
+func (k *oneTimeKeys) Set(jobUUID string, privKey []byte) error {
+	k.cache.SetWithTTL(computeKey(jobUUID, oneTimeKeySuf), privKey, int64(len(privKey)), k.ttl)
+	// Ristretto sets values asynchronously, we wait for the key to be available to the replacing jobs
+	k.cache.Wait()
+	return nil
+}
+
+func (k *oneTimeKeys) Delete(jobUUID string) error {
+	k.cache.Del(computeKey(jobUUID, oneTimeKeySuf))
+	return nil
+}
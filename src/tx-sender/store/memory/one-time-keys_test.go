@@ -0,0 +1,35 @@
+// +build unit
+
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOneTimeKeysMemory(t *testing.T) {
+	keys := NewOneTimeKeys(time.Minute)
+
+	jobUUID := "one-time-keys-memory"
+	privKey := []byte{0x1, 0x2, 0x3}
+
+	_, err := keys.Get(jobUUID)
+	assert.True(t, errors.IsNotFoundError(err))
+
+	err = keys.Set(jobUUID, privKey)
+	require.NoError(t, err)
+
+	v, err := keys.Get(jobUUID)
+	require.NoError(t, err)
+	assert.Equal(t, privKey, v)
+
+	err = keys.Delete(jobUUID)
+	require.NoError(t, err)
+
+	_, err = keys.Get(jobUUID)
+	assert.True(t, errors.IsNotFoundError(err))
+}
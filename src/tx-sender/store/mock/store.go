@@ -7,6 +7,7 @@ package mock
 import (
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	store "github.com/consensys/orchestrate/src/tx-sender/store"
 )
 
 // MockNonceSender is a mock of NonceSender interface
@@ -89,6 +90,49 @@ func (mr *MockNonceSenderMockRecorder) SetLastSent(key, value interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastSent", reflect.TypeOf((*MockNonceSender)(nil).SetLastSent), key, value)
 }
 
+// GetNonceRecords mocks base method
+func (m *MockNonceSender) GetNonceRecords(key string) ([]*store.NonceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNonceRecords", key)
+	ret0, _ := ret[0].([]*store.NonceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNonceRecords indicates an expected call of GetNonceRecords
+func (mr *MockNonceSenderMockRecorder) GetNonceRecords(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNonceRecords", reflect.TypeOf((*MockNonceSender)(nil).GetNonceRecords), key)
+}
+
+// SetNonceRecord mocks base method
+func (m *MockNonceSender) SetNonceRecord(key string, record *store.NonceRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNonceRecord", key, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNonceRecord indicates an expected call of SetNonceRecord
+func (mr *MockNonceSenderMockRecorder) SetNonceRecord(key, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNonceRecord", reflect.TypeOf((*MockNonceSender)(nil).SetNonceRecord), key, record)
+}
+
+// DeleteNonceRecords mocks base method
+func (m *MockNonceSender) DeleteNonceRecords(key string, lowerThan uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNonceRecords", key, lowerThan)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNonceRecords indicates an expected call of DeleteNonceRecords
+func (mr *MockNonceSenderMockRecorder) DeleteNonceRecords(key, lowerThan interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNonceRecords", reflect.TypeOf((*MockNonceSender)(nil).DeleteNonceRecords), key, lowerThan)
+}
+
 // MockRecoveryTracker is a mock of RecoveryTracker interface
 type MockRecoveryTracker struct {
 	ctrl     *gomock.Controller
@@ -149,3 +193,69 @@ func (mr *MockRecoveryTrackerMockRecorder) Recovered(key interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recovered", reflect.TypeOf((*MockRecoveryTracker)(nil).Recovered), key)
 }
+
+// MockOneTimeKeys is a mock of OneTimeKeys interface
+type MockOneTimeKeys struct {
+	ctrl     *gomock.Controller
+	recorder *MockOneTimeKeysMockRecorder
+}
+
+// MockOneTimeKeysMockRecorder is the mock recorder for MockOneTimeKeys
+type MockOneTimeKeysMockRecorder struct {
+	mock *MockOneTimeKeys
+}
+
+// NewMockOneTimeKeys creates a new mock instance
+func NewMockOneTimeKeys(ctrl *gomock.Controller) *MockOneTimeKeys {
+	mock := &MockOneTimeKeys{ctrl: ctrl}
+	mock.recorder = &MockOneTimeKeysMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockOneTimeKeys) EXPECT() *MockOneTimeKeysMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockOneTimeKeys) Get(jobUUID string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", jobUUID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockOneTimeKeysMockRecorder) Get(jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOneTimeKeys)(nil).Get), jobUUID)
+}
+
+// Set mocks base method
+func (m *MockOneTimeKeys) Set(jobUUID string, privKey []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", jobUUID, privKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set
+func (mr *MockOneTimeKeysMockRecorder) Set(jobUUID, privKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockOneTimeKeys)(nil).Set), jobUUID, privKey)
+}
+
+// Delete mocks base method
+func (m *MockOneTimeKeys) Delete(jobUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", jobUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockOneTimeKeysMockRecorder) Delete(jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOneTimeKeys)(nil).Delete), jobUUID)
+}
@@ -1,12 +1,19 @@
 package redis
 
 import (
+	"encoding/json"
+	"sort"
 	"time"
 
+	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/src/infra/redis"
+	"github.com/consensys/orchestrate/src/tx-sender/store"
 )
 
-const lastSentSuf = "last-sent"
+const (
+	lastSentSuf    = "last-sent"
+	nonceLedgerSuf = "nonce-ledger"
+)
 
 type NonceSender struct {
 	redis      redis.Client
@@ -36,3 +43,79 @@ func (ns *NonceSender) IncrLastSent(key string) error {
 func (ns *NonceSender) DeleteLastSent(key string) error {
 	return ns.redis.Delete(computeKey(key, lastSentSuf))
 }
+
+func (ns *NonceSender) GetNonceRecords(key string) ([]*store.NonceRecord, error) {
+	bytes, err := ns.redis.LoadBytes(computeKey(key, nonceLedgerSuf))
+	if errors.IsNotFoundError(err) {
+		return []*store.NonceRecord{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalNonceRecords(bytes)
+}
+
+func (ns *NonceSender) SetNonceRecord(key string, record *store.NonceRecord) error {
+	return ns.updateNonceRecords(key, func(records []*store.NonceRecord) []*store.NonceRecord {
+		for idx, r := range records {
+			if r.Nonce == record.Nonce {
+				records = append(records[:idx], records[idx+1:]...)
+				break
+			}
+		}
+
+		records = append(records, record)
+		sort.Slice(records, func(i, j int) bool { return records[i].Nonce < records[j].Nonce })
+		return records
+	})
+}
+
+func (ns *NonceSender) DeleteNonceRecords(key string, lowerThan uint64) error {
+	return ns.updateNonceRecords(key, func(records []*store.NonceRecord) []*store.NonceRecord {
+		var kept []*store.NonceRecord
+		for _, r := range records {
+			if r.Nonce >= lowerThan {
+				kept = append(kept, r)
+			}
+		}
+
+		return kept
+	})
+}
+
+// updateNonceRecords applies the update to the ledger atomically so that concurrent workers do not lose records
+func (ns *NonceSender) updateNonceRecords(key string, update func([]*store.NonceRecord) []*store.NonceRecord) error {
+	return ns.redis.Update(computeKey(key, nonceLedgerSuf), ns.expiration, func(bytes []byte) ([]byte, error) {
+		records := []*store.NonceRecord{}
+		if bytes != nil {
+			var err error
+			records, err = unmarshalNonceRecords(bytes)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		records = update(records)
+		if len(records) == 0 {
+			return nil, nil
+		}
+
+		bytes, err := json.Marshal(records)
+		if err != nil {
+			return nil, errors.EncodingError("failed to marshal nonce ledger")
+		}
+
+		return bytes, nil
+	})
+}
+
+func unmarshalNonceRecords(bytes []byte) ([]*store.NonceRecord, error) {
+	var records []*store.NonceRecord
+	err := json.Unmarshal(bytes, &records)
+	if err != nil {
+		return nil, errors.DataCorruptedError("loaded nonce ledger is not valid")
+	}
+
+	return records, nil
+}
@@ -3,7 +3,11 @@
 package redis
 
 import (
+	"encoding/json"
+
+	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/src/infra/redis/mocks"
+	"github.com/consensys/orchestrate/src/tx-sender/store"
 	"github.com/golang/mock/gomock"
 	"testing"
 	"time"
@@ -52,4 +56,66 @@ func TestNonceSender(t *testing.T) {
 		err := ns.DeleteLastSent(testKey)
 		assert.NoError(t, err)
 	})
+
+	t.Run("should get empty nonce ledger if not found", func(t *testing.T) {
+		mockRedisClient.EXPECT().LoadBytes(computeKey(testKey, nonceLedgerSuf)).Return(nil, errors.NotFoundError("error"))
+
+		records, err := ns.GetNonceRecords(testKey)
+		assert.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("should set nonce record in ordered ledger successfully", func(t *testing.T) {
+		ledgerKey := computeKey(testKey, nonceLedgerSuf)
+		bytes, _ := json.Marshal([]*store.NonceRecord{{Nonce: 3, Status: store.NonceSent}, {Nonce: 4, Status: store.NonceReserved}})
+		expectedBytes, _ := json.Marshal([]*store.NonceRecord{{Nonce: 2, Status: store.NonceReserved}, {Nonce: 3, Status: store.NonceSent}, {Nonce: 4, Status: store.NonceReserved}})
+		mockRedisClient.EXPECT().Update(ledgerKey, 100, gomock.Any()).
+			DoAndReturn(func(key string, expiration int, update func([]byte) ([]byte, error)) error {
+				updated, err := update(bytes)
+				assert.NoError(t, err)
+				assert.Equal(t, expectedBytes, updated)
+				return nil
+			})
+
+		err := ns.SetNonceRecord(testKey, &store.NonceRecord{Nonce: 2, Status: store.NonceReserved})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should delete confirmed nonce records successfully", func(t *testing.T) {
+		ledgerKey := computeKey(testKey, nonceLedgerSuf)
+		bytes, _ := json.Marshal([]*store.NonceRecord{{Nonce: 3}, {Nonce: 4}})
+		expectedBytes, _ := json.Marshal([]*store.NonceRecord{{Nonce: 4}})
+		mockRedisClient.EXPECT().Update(ledgerKey, 100, gomock.Any()).
+			DoAndReturn(func(key string, expiration int, update func([]byte) ([]byte, error)) error {
+				updated, err := update(bytes)
+				assert.NoError(t, err)
+				assert.Equal(t, expectedBytes, updated)
+				return nil
+			})
+
+		err := ns.DeleteNonceRecords(testKey, 4)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should delete nonce ledger when all records are confirmed", func(t *testing.T) {
+		ledgerKey := computeKey(testKey, nonceLedgerSuf)
+		bytes, _ := json.Marshal([]*store.NonceRecord{{Nonce: 3}})
+		mockRedisClient.EXPECT().Update(ledgerKey, 100, gomock.Any()).
+			DoAndReturn(func(key string, expiration int, update func([]byte) ([]byte, error)) error {
+				updated, err := update(bytes)
+				assert.NoError(t, err)
+				assert.Nil(t, updated)
+				return nil
+			})
+
+		err := ns.DeleteNonceRecords(testKey, 4)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with DataCorruptedError if nonce ledger is not valid", func(t *testing.T) {
+		mockRedisClient.EXPECT().LoadBytes(computeKey(testKey, nonceLedgerSuf)).Return([]byte("invalid"), nil)
+
+		_, err := ns.GetNonceRecords(testKey)
+		assert.True(t, errors.IsDataCorruptedError(err))
+	})
 }
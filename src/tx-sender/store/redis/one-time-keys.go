@@ -0,0 +1,74 @@
+package redis
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/redis"
+)
+
+const oneTimeKeySuf = "one-time-key"
+
+type OneTimeKeys struct {
+	redis      redis.Client
+	expiration int
+	aead       cipher.AEAD
+}
+
+// NewOneTimeKeys creates a one-time key store keeping keys in Redis until they expire, keys are encrypted with
+// AES-256-GCM using a key derived from the secret
+func NewOneTimeKeys(client redis.Client, expiration time.Duration, secret string) (*OneTimeKeys, error) {
+	encryptionKey := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(encryptionKey[:])
+	if err != nil {
+		return nil, errors.CryptoOperationError("invalid one-time key encryption key").AppendReason(err.Error())
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.CryptoOperationError("failed to create one-time key cipher").AppendReason(err.Error())
+	}
+
+	return &OneTimeKeys{
+		redis:      client,
+		expiration: int(expiration.Milliseconds()),
+		aead:       aead,
+	}, nil
+}
+
+func (k *OneTimeKeys) Get(jobUUID string) ([]byte, error) {
+	ciphertext, err := k.redis.LoadBytes(computeKey(jobUUID, oneTimeKeySuf))
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := k.aead.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, errors.DataCorruptedError("loaded value is not an encrypted one-time key")
+	}
+
+	// The job UUID is authenticated so that a key cannot be moved to another job
+	privKey, err := k.aead.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], []byte(jobUUID))
+	if err != nil {
+		return nil, errors.DataCorruptedError("failed to decrypt one-time key").AppendReason(err.Error())
+	}
+
+	return privKey, nil
+}
+
+func (k *OneTimeKeys) Set(jobUUID string, privKey []byte) error {
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return errors.CryptoOperationError("failed to generate nonce").AppendReason(err.Error())
+	}
+
+	return k.redis.Set(computeKey(jobUUID, oneTimeKeySuf), k.expiration, k.aead.Seal(nonce, nonce, privKey, []byte(jobUUID)))
+}
+
+func (k *OneTimeKeys) Delete(jobUUID string) error {
+	return k.redis.Delete(computeKey(jobUUID, oneTimeKeySuf))
+}
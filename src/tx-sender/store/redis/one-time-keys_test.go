@@ -0,0 +1,80 @@
+// +build unit
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/redis/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOneTimeKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	jobUUID := "one-time-keys-redis"
+	expectedKey := computeKey(jobUUID, oneTimeKeySuf)
+	privKey := []byte{0x1, 0x2, 0x3}
+
+	mockRedisClient := mocks.NewMockClient(ctrl)
+
+	keys, err := NewOneTimeKeys(mockRedisClient, time.Minute, "secret")
+	require.NoError(t, err)
+
+	var stored []byte
+
+	t.Run("should set encrypted one-time key successfully", func(t *testing.T) {
+		mockRedisClient.EXPECT().Set(expectedKey, 60000, gomock.Any()).DoAndReturn(func(_ string, _ int, value interface{}) error {
+			stored = value.([]byte)
+			return nil
+		})
+
+		err := keys.Set(jobUUID, privKey)
+		require.NoError(t, err)
+		assert.NotContains(t, string(stored), string(privKey))
+	})
+
+	t.Run("should get one-time key successfully", func(t *testing.T) {
+		mockRedisClient.EXPECT().LoadBytes(expectedKey).Return(stored, nil)
+
+		v, err := keys.Get(jobUUID)
+		assert.NoError(t, err)
+		assert.Equal(t, privKey, v)
+	})
+
+	t.Run("should fail with DataCorruptedError if the key was encrypted for another job", func(t *testing.T) {
+		mockRedisClient.EXPECT().LoadBytes(computeKey("otherJobUUID", oneTimeKeySuf)).Return(stored, nil)
+
+		_, err := keys.Get("otherJobUUID")
+		assert.True(t, errors.IsDataCorruptedError(err))
+	})
+
+	t.Run("should fail with DataCorruptedError if the key was encrypted with another secret", func(t *testing.T) {
+		otherKeys, err := NewOneTimeKeys(mockRedisClient, time.Minute, "otherSecret")
+		require.NoError(t, err)
+		mockRedisClient.EXPECT().LoadBytes(expectedKey).Return(stored, nil)
+
+		_, err = otherKeys.Get(jobUUID)
+		assert.True(t, errors.IsDataCorruptedError(err))
+	})
+
+	t.Run("should fail with same error if the key cannot be loaded", func(t *testing.T) {
+		expectedErr := errors.NotFoundError("error")
+		mockRedisClient.EXPECT().LoadBytes(expectedKey).Return(nil, expectedErr)
+
+		_, err := keys.Get(jobUUID)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should delete one-time key successfully", func(t *testing.T) {
+		mockRedisClient.EXPECT().Delete(expectedKey).Return(nil)
+
+		err := keys.Delete(jobUUID)
+		assert.NoError(t, err)
+	})
+}
@@ -1,7 +1,26 @@
 package store
 
+import (
+	"time"
+)
+
 //go:generate mockgen -source=store.go -destination=mock/store.go -package=mock
 
+type NonceStatus string
+
+const (
+	NonceReserved NonceStatus = "reserved"
+	NonceSent     NonceStatus = "sent"
+)
+
+// NonceRecord is the state of a nonce in the ledger of an account
+type NonceRecord struct {
+	Nonce     uint64      `json:"nonce"`
+	JobUUID   string      `json:"jobUUID"`
+	Status    NonceStatus `json:"status"`
+	UpdatedAt time.Time   `json:"updatedAt"`
+}
+
 type NonceSender interface {
 	// GetLastSent retrieves last sent nonce
 	GetLastSent(key string) (uint64, error)
@@ -14,6 +33,15 @@ type NonceSender interface {
 
 	// SetLastSent sets last sent nonce
 	SetLastSent(key string, value uint64) error
+
+	// GetNonceRecords retrieves the nonce ledger ordered by nonce
+	GetNonceRecords(key string) ([]*NonceRecord, error)
+
+	// SetNonceRecord records the state of a nonce in the ledger
+	SetNonceRecord(key string, record *NonceRecord) error
+
+	// DeleteNonceRecords removes the nonces lower than the given value from the ledger
+	DeleteNonceRecords(key string, lowerThan uint64) error
 }
 
 type RecoveryTracker interface {
@@ -21,3 +49,15 @@ type RecoveryTracker interface {
 	Recover(key string)
 	Recovered(key string)
 }
+
+// OneTimeKeys keeps the one-time keys used to sign jobs so that their replacing jobs are signed by the same account
+type OneTimeKeys interface {
+	// Get retrieves the one-time key of a job
+	Get(jobUUID string) ([]byte, error)
+
+	// Set stores the one-time key of a job
+	Set(jobUUID string, privKey []byte) error
+
+	// Delete removes the one-time key of a job
+	Delete(jobUUID string) error
+}
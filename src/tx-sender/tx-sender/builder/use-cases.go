@@ -3,6 +3,8 @@ package builder
 import (
 	"github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
+	"github.com/consensys/orchestrate/src/tx-sender/store"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/gas"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/nonce"
 	usecases "github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases/crafter"
@@ -23,13 +25,15 @@ func NewUseCases(jobClient client.JobClient,
 	keyManagerClient keymanager.KeyManagerClient,
 	ec ethclient.MultiClient,
 	nonceManager nonce.Manager,
+	gasOracle gas.Oracle,
+	oneTimeKeys store.OneTimeKeys,
 	chainRegistryURL string,
 ) usecases.UseCases {
-	signETHTransactionUC := signer.NewSignETHTransactionUseCase(keyManagerClient)
-	signEEATransactionUC := signer.NewSignEEATransactionUseCase(keyManagerClient)
-	signQuorumTransactionUC := signer.NewSignQuorumPrivateTransactionUseCase(keyManagerClient)
+	signETHTransactionUC := signer.NewSignETHTransactionUseCase(keyManagerClient, oneTimeKeys)
+	signEEATransactionUC := signer.NewSignEEATransactionUseCase(keyManagerClient, oneTimeKeys)
+	signQuorumTransactionUC := signer.NewSignQuorumPrivateTransactionUseCase(keyManagerClient, oneTimeKeys)
 
-	crafterUC := crafter.NewCraftTransactionUseCase(ec, chainRegistryURL, nonceManager)
+	crafterUC := crafter.NewCraftTransactionUseCase(ec, chainRegistryURL, nonceManager, gasOracle)
 
 	return &useCases{
 		sendETHTx:            sender.NewSendEthTxUseCase(signETHTransactionUC, crafterUC, ec, jobClient, chainRegistryURL, nonceManager),
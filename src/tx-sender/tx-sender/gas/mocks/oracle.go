@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oracle.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	entities "github.com/consensys/orchestrate/src/entities"
+	gomock "github.com/golang/mock/gomock"
+	big "math/big"
+	reflect "reflect"
+)
+
+// MockOracle is a mock of Oracle interface
+type MockOracle struct {
+	ctrl     *gomock.Controller
+	recorder *MockOracleMockRecorder
+}
+
+// MockOracleMockRecorder is the mock recorder for MockOracle
+type MockOracleMockRecorder struct {
+	mock *MockOracle
+}
+
+// NewMockOracle creates a new mock instance
+func NewMockOracle(ctrl *gomock.Controller) *MockOracle {
+	mock := &MockOracle{ctrl: ctrl}
+	mock.recorder = &MockOracleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockOracle) EXPECT() *MockOracleMockRecorder {
+	return m.recorder
+}
+
+// GasPrice mocks base method
+func (m *MockOracle) GasPrice(ctx context.Context, job *entities.Job) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GasPrice", ctx, job)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GasPrice indicates an expected call of GasPrice
+func (mr *MockOracleMockRecorder) GasPrice(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GasPrice", reflect.TypeOf((*MockOracle)(nil).GasPrice), ctx, job)
+}
+
+// DynamicFees mocks base method
+func (m *MockOracle) DynamicFees(ctx context.Context, job *entities.Job) (*big.Int, *big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DynamicFees", ctx, job)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(*big.Int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DynamicFees indicates an expected call of DynamicFees
+func (mr *MockOracleMockRecorder) DynamicFees(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DynamicFees", reflect.TypeOf((*MockOracle)(nil).DynamicFees), ctx, job)
+}
+
+// CapFees mocks base method
+func (m *MockOracle) CapFees(ctx context.Context, job *entities.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CapFees", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CapFees indicates an expected call of CapFees
+func (mr *MockOracleMockRecorder) CapFees(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CapFees", reflect.TypeOf((*MockOracle)(nil).CapFees), ctx, job)
+}
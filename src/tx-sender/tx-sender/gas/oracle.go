@@ -0,0 +1,22 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+//go:generate mockgen -source=oracle.go -destination=mocks/oracle.go -package=mocks
+
+// Oracle suggests the fees of the transactions crafted for a job
+type Oracle interface {
+	// GasPrice suggests the gas price of a legacy transaction
+	GasPrice(ctx context.Context, job *entities.Job) (*big.Int, error)
+	// DynamicFees suggests the max priority fee per gas and the max fee per gas of a dynamic fee transaction.
+	// The priority fee of the job transaction is used if set
+	DynamicFees(ctx context.Context, job *entities.Job) (gasTipCap, gasFeeCap *big.Int, err error)
+	// CapFees lowers the fees of the job transaction exceeding the maximum fees of the chain, whether they were
+	// suggested, set by the client or increased by a speed up
+	CapFees(ctx context.Context, job *entities.Job) error
+}
@@ -0,0 +1,93 @@
+package oracle
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk/client"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/gas"
+	"github.com/dgraph-io/ristretto"
+)
+
+const chainOracleComponent = "gas-oracle.chain"
+
+// Chain configurations are cached so that updates are taken into account within a minute
+const chainConfigTTL = time.Minute
+
+// chainOracle delegates to the gas oracle configured for the chain of each job
+type chainOracle struct {
+	chainClient      client.ChainClient
+	ec               ethclient.MultiClient
+	chainRegistryURL string
+	cache            *ristretto.Cache
+	logger           *log.Logger
+}
+
+// NewChainOracle creates a gas oracle applying the gas oracle configuration of the chains registered in the API
+func NewChainOracle(chainClient client.ChainClient, ec ethclient.MultiClient, chainRegistryURL string) gas.Oracle {
+	cache, _ := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1e4,
+		MaxCost:     1 << 20,
+		BufferItems: 64,
+	})
+
+	return &chainOracle{
+		chainClient:      chainClient,
+		ec:               ec,
+		chainRegistryURL: chainRegistryURL,
+		cache:            cache,
+		logger:           log.NewLogger().SetComponent(chainOracleComponent),
+	}
+}
+
+func (o *chainOracle) GasPrice(ctx context.Context, job *entities.Job) (*big.Int, error) {
+	chainOracle, err := o.oracle(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	return chainOracle.GasPrice(ctx, job)
+}
+
+func (o *chainOracle) DynamicFees(ctx context.Context, job *entities.Job) (gasTipCap, gasFeeCap *big.Int, err error) {
+	chainOracle, err := o.oracle(ctx, job)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return chainOracle.DynamicFees(ctx, job)
+}
+
+func (o *chainOracle) CapFees(ctx context.Context, job *entities.Job) error {
+	chainOracle, err := o.oracle(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	return chainOracle.CapFees(ctx, job)
+}
+
+func (o *chainOracle) oracle(ctx context.Context, job *entities.Job) (gas.Oracle, error) {
+	if v, ok := o.cache.Get(job.ChainUUID); ok {
+		if cfg, ok := v.(*entities.ChainGasOracle); ok {
+			return New(cfg, o.ec, o.chainRegistryURL), nil
+		}
+	}
+
+	ctx = multitenancy.WithUserInfo(ctx, multitenancy.NewUserInfo(job.TenantID, job.OwnerID))
+	chain, err := o.chainClient.GetChain(ctx, job.ChainUUID)
+	if err != nil {
+		o.logger.WithContext(ctx).WithError(err).WithField("chain", job.ChainUUID).Error("failed to get chain gas oracle configuration")
+		return nil, errors.FromError(err).ExtendComponent(chainOracleComponent)
+	}
+
+	o.cache.SetWithTTL(job.ChainUUID, chain.GasOracle, 1, chainConfigTTL)
+	o.cache.Wait()
+	return New(chain.GasOracle, o.ec, o.chainRegistryURL), nil
+}
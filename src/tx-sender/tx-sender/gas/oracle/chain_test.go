@@ -0,0 +1,71 @@
+// +build unit
+
+package oracle
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk/client/mock"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	ethclientmock "github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainOracle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	chainClient := mock.NewMockChainClient(ctrl)
+	ec := ethclientmock.NewMockMultiClient(ctrl)
+	oracle := NewChainOracle(chainClient, ec, "http://chain-registry:8081")
+
+	t.Run("should apply the gas oracle configuration of the chain", func(t *testing.T) {
+		job := testdata.FakeJob()
+		chain := &types.ChainResponse{UUID: job.ChainUUID, GasOracle: &entities.ChainGasOracle{MaxFee: (*hexutil.Big)(big.NewInt(1000))}}
+
+		chainClient.EXPECT().GetChain(gomock.Any(), job.ChainUUID).Return(chain, nil).Times(1)
+		ec.EXPECT().SuggestGasPrice(gomock.Any(), gomock.Any()).Return(big.NewInt(2000), nil).Times(2)
+
+		gasPrice, err := oracle.GasPrice(ctx, job)
+		require.NoError(t, err)
+		assert.Equal(t, "1000", gasPrice.String())
+
+		// The configuration is cached
+		gasPrice, err = oracle.GasPrice(ctx, job)
+		require.NoError(t, err)
+		assert.Equal(t, "1000", gasPrice.String())
+	})
+
+	t.Run("should cap the fees of the transaction to the configuration of the chain", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Transaction.GasPrice = (*hexutil.Big)(big.NewInt(2000))
+		chain := &types.ChainResponse{UUID: job.ChainUUID, GasOracle: &entities.ChainGasOracle{MaxFee: (*hexutil.Big)(big.NewInt(1000))}}
+
+		chainClient.EXPECT().GetChain(gomock.Any(), job.ChainUUID).Return(chain, nil)
+
+		err := oracle.CapFees(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "1000", job.Transaction.GasPrice.ToInt().String())
+	})
+
+	t.Run("should fail with same error if chain cannot be fetched", func(t *testing.T) {
+		job := testdata.FakeJob()
+		expectedErr := errors.NotFoundError("error")
+
+		chainClient.EXPECT().GetChain(gomock.Any(), job.ChainUUID).Return(nil, expectedErr)
+
+		_, _, err := oracle.DynamicFees(ctx, job)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(chainOracleComponent), err)
+	})
+}
@@ -0,0 +1,95 @@
+package oracle
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/gas"
+)
+
+const defaultOracleComponent = "gas-oracle.default"
+
+const mediumPriorityString = "1500000000" // 1.5 gwei
+const thresholdString = "500000000"       // 0.5 gwei
+
+// defaultOracle applies fixed multipliers to the gas price suggested by the node and fixed priority fees per priority
+type defaultOracle struct {
+	ec               ethclient.MultiClient
+	chainRegistryURL string
+}
+
+// NewDefaultOracle creates a new default gas oracle
+func NewDefaultOracle(ec ethclient.MultiClient, chainRegistryURL string) gas.Oracle {
+	return &defaultOracle{
+		ec:               ec,
+		chainRegistryURL: chainRegistryURL,
+	}
+}
+
+func (o *defaultOracle) GasPrice(ctx context.Context, job *entities.Job) (*big.Int, error) {
+	proxyURL := utils.GetProxyURL(o.chainRegistryURL, job.ChainUUID)
+	gasPrice, err := o.ec.SuggestGasPrice(ctx, proxyURL)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(defaultOracleComponent)
+	}
+
+	switch job.InternalData.Priority {
+	case utils.PriorityVeryLow:
+		return gasPrice.Mul(gasPrice, big.NewInt(6)).Div(gasPrice, big.NewInt(10)), nil
+	case utils.PriorityLow:
+		return gasPrice.Mul(gasPrice, big.NewInt(8)).Div(gasPrice, big.NewInt(10)), nil
+	case utils.PriorityHigh:
+		return gasPrice.Mul(gasPrice, big.NewInt(12)).Div(gasPrice, big.NewInt(10)), nil
+	case utils.PriorityVeryHigh:
+		return gasPrice.Mul(gasPrice, big.NewInt(14)).Div(gasPrice, big.NewInt(10)), nil
+	default:
+		return gasPrice, nil
+	}
+}
+
+func (o *defaultOracle) DynamicFees(ctx context.Context, job *entities.Job) (gasTipCap, gasFeeCap *big.Int, err error) {
+	proxyURL := utils.GetProxyURL(o.chainRegistryURL, job.ChainUUID)
+	feeHistory, err := o.ec.FeeHistory(ctx, proxyURL, 1, "latest", nil)
+	if err != nil {
+		return nil, nil, errors.FromError(err).ExtendComponent(defaultOracleComponent)
+	}
+
+	baseFee, err := nextBaseFee(feeHistory)
+	if err != nil {
+		return nil, nil, errors.FromError(err).ExtendComponent(defaultOracleComponent)
+	}
+
+	if job.Transaction.GasTipCap != nil {
+		gasTipCap = job.Transaction.GasTipCap.ToInt()
+	} else {
+		gasTipCap = defaultPriorityFee(job.InternalData.Priority)
+	}
+
+	return gasTipCap, new(big.Int).Add(baseFee, gasTipCap), nil
+}
+
+func (o *defaultOracle) CapFees(context.Context, *entities.Job) error {
+	return nil
+}
+
+func defaultPriorityFee(priority string) *big.Int {
+	mediumPriority, _ := new(big.Int).SetString(mediumPriorityString, 10) // 1.5 gwei
+	threshold, _ := new(big.Int).SetString(thresholdString, 10)           // 0.5 gwei
+
+	switch priority {
+	case utils.PriorityVeryLow:
+		return new(big.Int).Sub(mediumPriority, new(big.Int).Mul(threshold, big.NewInt(2))) // 0.5 gwei
+	case utils.PriorityLow:
+		return new(big.Int).Sub(mediumPriority, threshold) // 1 gwei
+	case utils.PriorityHigh:
+		return new(big.Int).Add(mediumPriority, threshold) // 2 gwei
+	case utils.PriorityVeryHigh:
+		return new(big.Int).Add(mediumPriority, new(big.Int).Mul(threshold, big.NewInt(2))) // 2.5 gwei
+	default:
+		return mediumPriority // 1.5 gwei
+	}
+}
@@ -0,0 +1,110 @@
+// +build unit
+
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultOracle_GasPrice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	ec := mock.NewMockMultiClient(ctrl)
+	chainRegistryURL := "http://chain-registry:8081"
+	oracle := NewDefaultOracle(ec, chainRegistryURL)
+
+	t.Run("should apply the multiplier of the job priority", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.InternalData.Priority = utils.PriorityHigh
+
+		ec.EXPECT().SuggestGasPrice(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID)).Return(big.NewInt(1000), nil)
+
+		gasPrice, err := oracle.GasPrice(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "1200", gasPrice.String())
+	})
+
+	t.Run("should fail with same error if gas price cannot be suggested", func(t *testing.T) {
+		job := testdata.FakeJob()
+		expectedErr := errors.ServiceConnectionError("error")
+
+		ec.EXPECT().SuggestGasPrice(gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		_, err := oracle.GasPrice(ctx, job)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(defaultOracleComponent), err)
+	})
+}
+
+func TestDefaultOracle_DynamicFees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	ec := mock.NewMockMultiClient(ctrl)
+	chainRegistryURL := "http://chain-registry:8081"
+	oracle := NewDefaultOracle(ec, chainRegistryURL)
+	nextBaseFee := big.NewInt(1000000000)
+
+	t.Run("should add the fixed priority fee of the job priority to the base fee", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.InternalData.Priority = utils.PriorityLow
+
+		ec.EXPECT().FeeHistory(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID), 1, "latest", nil).
+			Return(testdata.FakeFeeHistory(nextBaseFee), nil)
+
+		gasTipCap, gasFeeCap, err := oracle.DynamicFees(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "1000000000", gasTipCap.String())
+		assert.Equal(t, "2000000000", gasFeeCap.String())
+	})
+
+	t.Run("should keep the priority fee of the transaction", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Transaction.GasTipCap = (*hexutil.Big)(big.NewInt(200000))
+
+		ec.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), 1, "latest", nil).Return(testdata.FakeFeeHistory(nextBaseFee), nil)
+
+		gasTipCap, gasFeeCap, err := oracle.DynamicFees(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "200000", gasTipCap.String())
+		assert.Equal(t, "1000200000", gasFeeCap.String())
+	})
+
+	t.Run("should fail with FeatureNotSupportedError if base fee is zero", func(t *testing.T) {
+		job := testdata.FakeJob()
+
+		ec.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), 1, "latest", nil).Return(testdata.FakeFeeHistory(big.NewInt(0)), nil)
+
+		_, _, err := oracle.DynamicFees(ctx, job)
+
+		assert.True(t, errors.IsFeatureNotSupportedError(err))
+	})
+
+	t.Run("should fail with same error if fee history cannot be fetched", func(t *testing.T) {
+		job := testdata.FakeJob()
+
+		ec.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), 1, "latest", nil).Return(nil, fmt.Errorf("error"))
+
+		_, _, err := oracle.DynamicFees(ctx, job)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,150 @@
+package oracle
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	"github.com/consensys/orchestrate/src/infra/ethclient/rpc"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/gas"
+)
+
+const feeHistoryOracleComponent = "gas-oracle.fee-history"
+
+const defaultBlockCount = 20
+
+var defaultRewardPercentiles = map[string]float64{
+	utils.PriorityVeryLow:  10,
+	utils.PriorityLow:      25,
+	utils.PriorityMedium:   50,
+	utils.PriorityHigh:     75,
+	utils.PriorityVeryHigh: 90,
+}
+
+// feeHistoryOracle suggests the median of the priority fees paid in the latest blocks at the percentile of the job priority
+type feeHistoryOracle struct {
+	fallback          gas.Oracle
+	ec                ethclient.MultiClient
+	chainRegistryURL  string
+	blockCount        int
+	rewardPercentiles map[string]float64
+}
+
+// NewFeeHistoryOracle creates a new gas oracle based on the fee history of the chain
+func NewFeeHistoryOracle(ec ethclient.MultiClient, chainRegistryURL string, cfg *entities.ChainGasOracle) gas.Oracle {
+	o := &feeHistoryOracle{
+		fallback:          NewDefaultOracle(ec, chainRegistryURL),
+		ec:                ec,
+		chainRegistryURL:  chainRegistryURL,
+		blockCount:        defaultBlockCount,
+		rewardPercentiles: defaultRewardPercentiles,
+	}
+
+	if cfg != nil && cfg.BlockCount > 0 {
+		o.blockCount = cfg.BlockCount
+	}
+	if cfg != nil && len(cfg.RewardPercentiles) > 0 {
+		o.rewardPercentiles = make(map[string]float64)
+		for priority, percentile := range defaultRewardPercentiles {
+			o.rewardPercentiles[priority] = percentile
+		}
+		for priority, percentile := range cfg.RewardPercentiles {
+			o.rewardPercentiles[priority] = percentile
+		}
+	}
+
+	return o
+}
+
+// GasPrice suggests the base fee of the next block increased by the suggested priority fee. Chains without base fee
+// fall back to the gas price suggested by the node
+func (o *feeHistoryOracle) GasPrice(ctx context.Context, job *entities.Job) (*big.Int, error) {
+	gasTipCap, baseFee, err := o.suggest(ctx, job)
+	if errors.IsFeatureNotSupportedError(err) {
+		return o.fallback.GasPrice(ctx, job)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).Add(baseFee, gasTipCap), nil
+}
+
+// DynamicFees suggests a max fee per gas covering twice the base fee of the next block so that the transaction
+// remains executable for several blocks with full base fee increases
+func (o *feeHistoryOracle) DynamicFees(ctx context.Context, job *entities.Job) (gasTipCap, gasFeeCap *big.Int, err error) {
+	gasTipCap, baseFee, err := o.suggest(ctx, job)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if job.Transaction.GasTipCap != nil {
+		gasTipCap = job.Transaction.GasTipCap.ToInt()
+	}
+
+	gasFeeCap = new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), gasTipCap)
+	return gasTipCap, gasFeeCap, nil
+}
+
+func (o *feeHistoryOracle) CapFees(context.Context, *entities.Job) error {
+	return nil
+}
+
+func (o *feeHistoryOracle) suggest(ctx context.Context, job *entities.Job) (gasTipCap, baseFee *big.Int, err error) {
+	percentile, ok := o.rewardPercentiles[job.InternalData.Priority]
+	if !ok {
+		percentile = o.rewardPercentiles[utils.PriorityMedium]
+	}
+
+	proxyURL := utils.GetProxyURL(o.chainRegistryURL, job.ChainUUID)
+	feeHistory, err := o.ec.FeeHistory(ctx, proxyURL, o.blockCount, "latest", []float64{percentile})
+	if err != nil {
+		return nil, nil, errors.FromError(err).ExtendComponent(feeHistoryOracleComponent)
+	}
+
+	baseFee, err = nextBaseFee(feeHistory)
+	if err != nil {
+		return nil, nil, errors.FromError(err).ExtendComponent(feeHistoryOracleComponent)
+	}
+
+	return medianReward(feeHistory), baseFee, nil
+}
+
+// medianReward returns the median of the rewards of the fee history, ignoring empty blocks
+func medianReward(feeHistory *rpc.FeeHistory) *big.Int {
+	var rewards []*big.Int
+	for idx, blockRewards := range feeHistory.Reward {
+		if len(blockRewards) == 0 || (idx < len(feeHistory.GasUsedRatio) && feeHistory.GasUsedRatio[idx] == 0) {
+			continue
+		}
+		rewards = append(rewards, blockRewards[0].ToInt())
+	}
+
+	if len(rewards) == 0 {
+		return big.NewInt(0)
+	}
+
+	sort.Slice(rewards, func(i, j int) bool {
+		return rewards[i].Cmp(rewards[j]) < 0
+	})
+
+	return new(big.Int).Set(rewards[len(rewards)/2])
+}
+
+// nextBaseFee returns the base fee of the block following the fee history
+func nextBaseFee(feeHistory *rpc.FeeHistory) (*big.Int, error) {
+	if feeHistory == nil || len(feeHistory.BaseFeePerGas) == 0 {
+		return nil, errors.FeatureNotSupportedError("cannot extract base fee")
+	}
+
+	baseFee := feeHistory.BaseFeePerGas[len(feeHistory.BaseFeePerGas)-1].ToInt()
+	if baseFee.Sign() == 0 {
+		return nil, errors.FeatureNotSupportedError("zero base fee is not allowed")
+	}
+
+	return baseFee, nil
+}
@@ -0,0 +1,162 @@
+// +build unit
+
+package oracle
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	"github.com/consensys/orchestrate/src/infra/ethclient/rpc"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fakeFeeHistory(nextBaseFee int64, rewards ...int64) *rpc.FeeHistory {
+	feeHistory := testdata.FakeFeeHistory(big.NewInt(nextBaseFee))
+	for _, reward := range rewards {
+		feeHistory.Reward = append(feeHistory.Reward, []hexutil.Big{hexutil.Big(*big.NewInt(reward))})
+		feeHistory.GasUsedRatio = append(feeHistory.GasUsedRatio, 0.5)
+	}
+
+	return feeHistory
+}
+
+func TestFeeHistoryOracle_DynamicFees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	ec := mock.NewMockMultiClient(ctrl)
+	chainRegistryURL := "http://chain-registry:8081"
+
+	t.Run("should suggest the median reward at the default percentile of the job priority", func(t *testing.T) {
+		oracle := NewFeeHistoryOracle(ec, chainRegistryURL, nil)
+		job := testdata.FakeJob()
+		job.InternalData.Priority = utils.PriorityVeryHigh
+
+		ec.EXPECT().FeeHistory(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID), defaultBlockCount, "latest", []float64{90}).
+			Return(fakeFeeHistory(1000, 30, 10, 20), nil)
+
+		gasTipCap, gasFeeCap, err := oracle.DynamicFees(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "20", gasTipCap.String())
+		assert.Equal(t, "2020", gasFeeCap.String())
+	})
+
+	t.Run("should use the block count and percentiles of the configuration", func(t *testing.T) {
+		oracle := NewFeeHistoryOracle(ec, chainRegistryURL, &entities.ChainGasOracle{
+			BlockCount:        5,
+			RewardPercentiles: map[string]float64{utils.PriorityHigh: 80},
+		})
+		job := testdata.FakeJob()
+		job.InternalData.Priority = utils.PriorityHigh
+
+		ec.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), 5, "latest", []float64{80}).Return(fakeFeeHistory(1000, 50), nil)
+
+		gasTipCap, _, err := oracle.DynamicFees(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "50", gasTipCap.String())
+	})
+
+	t.Run("should keep the priority fee of the transaction", func(t *testing.T) {
+		oracle := NewFeeHistoryOracle(ec, chainRegistryURL, nil)
+		job := testdata.FakeJob()
+		job.Transaction.GasTipCap = (*hexutil.Big)(big.NewInt(100))
+
+		ec.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), defaultBlockCount, "latest", []float64{50}).Return(fakeFeeHistory(1000, 50), nil)
+
+		gasTipCap, gasFeeCap, err := oracle.DynamicFees(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "100", gasTipCap.String())
+		assert.Equal(t, "2100", gasFeeCap.String())
+	})
+
+	t.Run("should fail with FeatureNotSupportedError if base fee cannot be extracted", func(t *testing.T) {
+		oracle := NewFeeHistoryOracle(ec, chainRegistryURL, nil)
+		job := testdata.FakeJob()
+
+		ec.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), defaultBlockCount, "latest", gomock.Any()).Return(&rpc.FeeHistory{}, nil)
+
+		_, _, err := oracle.DynamicFees(ctx, job)
+
+		assert.True(t, errors.IsFeatureNotSupportedError(err))
+	})
+}
+
+func TestFeeHistoryOracle_GasPrice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ec := mock.NewMockMultiClient(ctrl)
+	oracle := NewFeeHistoryOracle(ec, "http://chain-registry:8081", nil)
+
+	t.Run("should add the suggested priority fee to the base fee", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.InternalData.Priority = utils.PriorityLow
+
+		ec.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), defaultBlockCount, "latest", []float64{25}).Return(fakeFeeHistory(1000, 10, 40), nil)
+
+		gasPrice, err := oracle.GasPrice(context.Background(), job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "1040", gasPrice.String())
+	})
+
+	t.Run("should fall back to the gas price of the node if base fee cannot be extracted", func(t *testing.T) {
+		job := testdata.FakeJob()
+
+		ec.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), defaultBlockCount, "latest", gomock.Any()).Return(&rpc.FeeHistory{}, nil)
+		ec.EXPECT().SuggestGasPrice(gomock.Any(), utils.GetProxyURL("http://chain-registry:8081", job.ChainUUID)).Return(big.NewInt(1000), nil)
+
+		gasPrice, err := oracle.GasPrice(context.Background(), job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "1000", gasPrice.String())
+	})
+
+	t.Run("should fall back to the gas price of the node if base fee is zero", func(t *testing.T) {
+		job := testdata.FakeJob()
+
+		ec.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), defaultBlockCount, "latest", gomock.Any()).Return(fakeFeeHistory(0, 10), nil)
+		ec.EXPECT().SuggestGasPrice(gomock.Any(), gomock.Any()).Return(big.NewInt(1000), nil)
+
+		gasPrice, err := oracle.GasPrice(context.Background(), job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "1000", gasPrice.String())
+	})
+
+	t.Run("should fail with same error if fee history cannot be fetched", func(t *testing.T) {
+		job := testdata.FakeJob()
+
+		ec.EXPECT().FeeHistory(gomock.Any(), gomock.Any(), defaultBlockCount, "latest", gomock.Any()).Return(nil, errors.ServiceConnectionError("error"))
+
+		_, err := oracle.GasPrice(context.Background(), job)
+
+		assert.True(t, errors.IsServiceConnectionError(err))
+	})
+}
+
+func TestMedianReward(t *testing.T) {
+	t.Run("should ignore empty blocks", func(t *testing.T) {
+		feeHistory := fakeFeeHistory(1000, 10, 0, 30)
+		feeHistory.GasUsedRatio[1] = 0
+
+		assert.Equal(t, "30", medianReward(feeHistory).String())
+	})
+
+	t.Run("should return zero if no reward is available", func(t *testing.T) {
+		assert.Equal(t, "0", medianReward(fakeFeeHistory(1000)).String())
+	})
+}
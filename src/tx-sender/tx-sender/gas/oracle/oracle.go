@@ -0,0 +1,90 @@
+package oracle
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/gas"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// New creates the gas oracle configured for a chain, the default one if no configuration is provided
+func New(cfg *entities.ChainGasOracle, ec ethclient.MultiClient, chainRegistryURL string) gas.Oracle {
+	if cfg == nil {
+		return NewDefaultOracle(ec, chainRegistryURL)
+	}
+
+	var o gas.Oracle
+	switch cfg.Type {
+	case entities.FeeHistoryGasOracle:
+		o = NewFeeHistoryOracle(ec, chainRegistryURL, cfg)
+	default:
+		o = NewDefaultOracle(ec, chainRegistryURL)
+	}
+
+	if cfg.MaxFee == nil && cfg.MaxTip == nil {
+		return o
+	}
+
+	return &cappedOracle{oracle: o, maxFee: cfg.MaxFee.ToInt(), maxTip: cfg.MaxTip.ToInt()}
+}
+
+// cappedOracle bounds the fees suggested by another oracle
+type cappedOracle struct {
+	oracle gas.Oracle
+	maxFee *big.Int
+	maxTip *big.Int
+}
+
+func (o *cappedOracle) GasPrice(ctx context.Context, job *entities.Job) (*big.Int, error) {
+	gasPrice, err := o.oracle.GasPrice(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	return capValue(gasPrice, o.maxFee), nil
+}
+
+func (o *cappedOracle) DynamicFees(ctx context.Context, job *entities.Job) (gasTipCap, gasFeeCap *big.Int, err error) {
+	gasTipCap, gasFeeCap, err = o.oracle.DynamicFees(ctx, job)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gasFeeCap = capValue(gasFeeCap, o.maxFee)
+	// The priority fee can never exceed the max fee per gas
+	gasTipCap = capValue(capValue(gasTipCap, o.maxTip), gasFeeCap)
+
+	return gasTipCap, gasFeeCap, nil
+}
+
+func (o *cappedOracle) CapFees(_ context.Context, job *entities.Job) error {
+	if job.Transaction.GasPrice != nil {
+		job.Transaction.GasPrice = capBig(job.Transaction.GasPrice, o.maxFee)
+	}
+	if job.Transaction.GasFeeCap != nil {
+		job.Transaction.GasFeeCap = capBig(job.Transaction.GasFeeCap, o.maxFee)
+	}
+	if job.Transaction.GasTipCap != nil {
+		job.Transaction.GasTipCap = capBig(job.Transaction.GasTipCap, o.maxTip)
+		if job.Transaction.GasFeeCap != nil {
+			job.Transaction.GasTipCap = capBig(job.Transaction.GasTipCap, job.Transaction.GasFeeCap.ToInt())
+		}
+	}
+
+	return nil
+}
+
+func capBig(value *hexutil.Big, max *big.Int) *hexutil.Big {
+	return (*hexutil.Big)(capValue(value.ToInt(), max))
+}
+
+func capValue(value, max *big.Int) *big.Int {
+	if max != nil && value.Cmp(max) > 0 {
+		return new(big.Int).Set(max)
+	}
+
+	return value
+}
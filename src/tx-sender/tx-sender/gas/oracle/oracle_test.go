@@ -0,0 +1,125 @@
+// +build unit
+
+package oracle
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/gas/mocks"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ec := mock.NewMockMultiClient(ctrl)
+
+	t.Run("should create the default oracle if no configuration is provided", func(t *testing.T) {
+		assert.IsType(t, &defaultOracle{}, New(nil, ec, ""))
+	})
+
+	t.Run("should create the configured oracle", func(t *testing.T) {
+		assert.IsType(t, &feeHistoryOracle{}, New(&entities.ChainGasOracle{Type: entities.FeeHistoryGasOracle}, ec, ""))
+	})
+
+	t.Run("should cap the configured oracle", func(t *testing.T) {
+		o := New(&entities.ChainGasOracle{MaxTip: (*hexutil.Big)(big.NewInt(1))}, ec, "")
+
+		require.IsType(t, &cappedOracle{}, o)
+		assert.IsType(t, &defaultOracle{}, o.(*cappedOracle).oracle)
+		assert.Nil(t, o.(*cappedOracle).maxFee)
+	})
+}
+
+func TestCappedOracle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	gasOracle := mocks.NewMockOracle(ctrl)
+	oracle := &cappedOracle{oracle: gasOracle, maxFee: big.NewInt(1000), maxTip: big.NewInt(100)}
+
+	t.Run("should cap the gas price to the max fee", func(t *testing.T) {
+		job := testdata.FakeJob()
+
+		gasOracle.EXPECT().GasPrice(ctx, job).Return(big.NewInt(2000), nil)
+
+		gasPrice, err := oracle.GasPrice(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "1000", gasPrice.String())
+	})
+
+	t.Run("should cap the dynamic fees", func(t *testing.T) {
+		job := testdata.FakeJob()
+
+		gasOracle.EXPECT().DynamicFees(ctx, job).Return(big.NewInt(200), big.NewInt(2000), nil)
+
+		gasTipCap, gasFeeCap, err := oracle.DynamicFees(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "100", gasTipCap.String())
+		assert.Equal(t, "1000", gasFeeCap.String())
+	})
+
+	t.Run("should not suggest a priority fee above the max fee per gas", func(t *testing.T) {
+		job := testdata.FakeJob()
+		capped := &cappedOracle{oracle: gasOracle, maxFee: big.NewInt(50)}
+
+		gasOracle.EXPECT().DynamicFees(ctx, job).Return(big.NewInt(80), big.NewInt(90), nil)
+
+		gasTipCap, gasFeeCap, err := capped.DynamicFees(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "50", gasTipCap.String())
+		assert.Equal(t, "50", gasFeeCap.String())
+	})
+
+	t.Run("should keep fees below the caps", func(t *testing.T) {
+		job := testdata.FakeJob()
+
+		gasOracle.EXPECT().DynamicFees(ctx, job).Return(big.NewInt(20), big.NewInt(500), nil)
+
+		gasTipCap, gasFeeCap, err := oracle.DynamicFees(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "20", gasTipCap.String())
+		assert.Equal(t, "500", gasFeeCap.String())
+	})
+
+	t.Run("should cap the fees of the transaction", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Transaction.GasPrice = (*hexutil.Big)(big.NewInt(2000))
+		job.Transaction.GasFeeCap = (*hexutil.Big)(big.NewInt(3000))
+		job.Transaction.GasTipCap = (*hexutil.Big)(big.NewInt(200))
+
+		err := oracle.CapFees(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "1000", job.Transaction.GasPrice.ToInt().String())
+		assert.Equal(t, "1000", job.Transaction.GasFeeCap.ToInt().String())
+		assert.Equal(t, "100", job.Transaction.GasTipCap.ToInt().String())
+	})
+
+	t.Run("should not cap the priority fee of the transaction above its max fee per gas", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Transaction.GasPrice = nil
+		job.Transaction.GasFeeCap = (*hexutil.Big)(big.NewInt(50))
+		job.Transaction.GasTipCap = (*hexutil.Big)(big.NewInt(80))
+
+		err := oracle.CapFees(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, "50", job.Transaction.GasFeeCap.ToInt().String())
+		assert.Equal(t, "50", job.Transaction.GasTipCap.ToInt().String())
+	})
+}
@@ -0,0 +1,179 @@
+package manager
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/types/tx"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/tx-sender/store"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func (nc *Manager) recordNonce(ctx context.Context, job *entities.Job, nonceKey string, nonce uint64, status store.NonceStatus) {
+	// The ledger is only used to fill nonce gaps, which is disabled for private nonces or when the timeout is 0
+	if nonceKey == "" || nc.gapTimeout == 0 || string(job.Type) == tx.JobType_ETH_EEA_PRIVATE_TX.String() {
+		return
+	}
+
+	err := nc.nonce.SetNonceRecord(nonceKey, &store.NonceRecord{
+		Nonce:     nonce,
+		JobUUID:   job.UUID,
+		Status:    status,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		nc.logger.WithContext(ctx).WithError(err).WithField("nonce", nonce).Warn("cannot record nonce in account ledger")
+	}
+}
+
+// checkNonceGap compares the account ledger with the chain and fills the nonce the chain is waiting for when
+// transactions with higher nonces were sent for longer than the gap timeout
+func (nc *Manager) checkNonceGap(ctx context.Context, job *entities.Job, nonceKey string, lastSent uint64) error {
+	// Private nonces cannot be filled by public transactions
+	if nc.gapTimeout == 0 || string(job.Type) == tx.JobType_ETH_EEA_PRIVATE_TX.String() {
+		return nil
+	}
+
+	records, err := nc.nonce.GetNonceRecords(nonceKey)
+	if err != nil {
+		return err
+	}
+
+	// We only query the chain when some nonces are not confirmed after the gap timeout
+	if !hasStaleRecord(records, 0, nc.gapTimeout) {
+		return nil
+	}
+
+	url := utils.GetProxyURL(nc.chainRegistryURL, job.ChainUUID)
+	confirmedNonce, err := nc.ethClient.NonceAt(ctx, url, *job.Transaction.From, nil)
+	if err != nil {
+		return err
+	}
+
+	// Nonces lower than the nonce of the latest block are mined and no longer need to be tracked
+	err = nc.nonce.DeleteNonceRecords(nonceKey, confirmedNonce)
+	if err != nil {
+		return err
+	}
+
+	pendingNonce, err := nc.fetchNonceFromChain(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	if pendingNonce > lastSent {
+		return nil
+	}
+
+	var record *store.NonceRecord
+	for _, r := range records {
+		if r.Nonce == pendingNonce {
+			record = r
+		}
+	}
+
+	// The missing nonce is in flight
+	if record != nil && time.Since(record.UpdatedAt) < nc.gapTimeout {
+		return nil
+	}
+
+	// The missing nonce was neither dropped after being sent nor is blocking transactions sent after it
+	if !hasStaleRecord(records, pendingNonce, nc.gapTimeout) {
+		return nil
+	}
+
+	logger := nc.logger.WithContext(ctx).WithField("job", job.UUID).WithField("nonce", pendingNonce)
+	logger.WithField("last_sent", lastSent).Warn("nonce gap detected")
+
+	fillJobUUID, err := nc.fillNonceGap(ctx, job, pendingNonce, record)
+	if errors.IsInvalidStateError(err) {
+		logger.WithError(err).Error("nonce gap must be filled by sending a transaction with the missing nonce")
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.WithField("fill_job", fillJobUUID).Info("nonce gap filled")
+	return nc.nonce.SetNonceRecord(nonceKey, &store.NonceRecord{
+		Nonce:     pendingNonce,
+		JobUUID:   fillJobUUID,
+		Status:    store.NonceSent,
+		UpdatedAt: time.Now(),
+	})
+}
+
+// fillNonceGap resends the job which reserved the nonce if still pending, otherwise it sends a 0-value self-transfer.
+// Gaps of accounts with an approval policy are only reported, as the self-transfer would wait for approvals
+func (nc *Manager) fillNonceGap(ctx context.Context, job *entities.Job, nonce uint64, record *store.NonceRecord) (string, error) {
+	ctx = multitenancy.WithUserInfo(ctx, multitenancy.NewUserInfo(job.TenantID, job.OwnerID))
+
+	if record != nil && record.JobUUID != "" {
+		stuckJob, err := nc.client.GetJob(ctx, record.JobUUID)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return "", err
+		}
+
+		if err == nil && stuckJob.Status == entities.StatusPending &&
+			stuckJob.Transaction.Nonce != nil && *stuckJob.Transaction.Nonce == nonce {
+			err = nc.client.ResendJobTx(ctx, stuckJob.UUID)
+			if err != nil {
+				return "", err
+			}
+
+			return stuckJob.UUID, nil
+		}
+	}
+
+	account, err := nc.client.GetAccount(ctx, *job.Transaction.From)
+	if err != nil && !errors.IsNotFoundError(err) {
+		return "", err
+	}
+	if err == nil && account.ApprovalPolicy != nil {
+		return "", errors.InvalidStateError("nonce gap at %d cannot be filled with a self-transfer from an account with an approval policy", nonce)
+	}
+
+	schedule, err := nc.client.CreateSchedule(ctx, &types.CreateScheduleRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	fillJob, err := nc.client.CreateJob(ctx, &types.CreateJobRequest{
+		ScheduleUUID: schedule.UUID,
+		ChainUUID:    job.ChainUUID,
+		Type:         entities.EthereumTransaction,
+		Transaction: entities.ETHTransaction{
+			From:  job.Transaction.From,
+			To:    job.Transaction.From,
+			Value: (*hexutil.Big)(big.NewInt(0)),
+			Nonce: &nonce,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	err = nc.client.StartJob(ctx, fillJob.UUID)
+	if err != nil {
+		return "", err
+	}
+
+	return fillJob.UUID, nil
+}
+
+// hasStaleRecord indicates whether a nonce from fromNonce was sent for longer than the timeout
+func hasStaleRecord(records []*store.NonceRecord, fromNonce uint64, timeout time.Duration) bool {
+	for _, r := range records {
+		if r.Nonce >= fromNonce && r.Status == store.NonceSent && time.Since(r.UpdatedAt) >= timeout {
+			return true
+		}
+	}
+
+	return false
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	sdk "github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/types/tx"
 	"github.com/consensys/orchestrate/pkg/utils"
@@ -23,18 +25,23 @@ type Manager struct {
 	nonce            store.NonceSender
 	ethClient        ethclient.MultiClient
 	recovery         store.RecoveryTracker
+	client           sdk.OrchestrateClient
 	maxRecovery      uint64
+	gapTimeout       time.Duration
 	chainRegistryURL string
 	logger           *log.Logger
 }
 
-func NewNonceManager(ec ethclient.MultiClient, nm store.NonceSender, tracker store.RecoveryTracker, chainRegistryURL string,
-	maxRecovery uint64) *Manager {
+// NewNonceManager creates a new nonce manager, nonce gaps are not filled if gapTimeout is 0
+func NewNonceManager(ec ethclient.MultiClient, nm store.NonceSender, tracker store.RecoveryTracker, client sdk.OrchestrateClient,
+	chainRegistryURL string, maxRecovery uint64, gapTimeout time.Duration) *Manager {
 	return &Manager{
 		nonce:            nm,
 		ethClient:        ec,
 		recovery:         tracker,
+		client:           client,
 		maxRecovery:      maxRecovery,
+		gapTimeout:       gapTimeout,
 		chainRegistryURL: chainRegistryURL,
 		logger:           log.NewLogger().SetComponent(component),
 	}
@@ -59,11 +66,36 @@ func (nc *Manager) GetNonce(ctx context.Context, job *entities.Job) (uint64, err
 		}
 
 		logger.WithField("pending_nonce", pendingNonce).WithField("account", job.Transaction.From.Hex()).Debug("fetched pending nonce from node")
+		nc.recordNonce(ctx, job, nonceKey, pendingNonce, store.NonceReserved)
 		return pendingNonce, nil
 	case err != nil:
 		errMsg := "cannot retrieve last sent nonce"
 		logger.WithError(err).Error(errMsg)
 		return 0, err
+	default:
+		if der := nc.checkNonceGap(ctx, job, nonceKey, lastSent); der != nil {
+			logger.WithError(der).Warn("cannot check account nonce gap")
+		}
+
+		nc.recordNonce(ctx, job, nonceKey, lastSent+1, store.NonceReserved)
+		return lastSent + 1, nil
+	}
+}
+
+// PeekNonce returns the nonce the next job of the account would be sent with, without reserving it
+func (nc *Manager) PeekNonce(ctx context.Context, job *entities.Job) (uint64, error) {
+	nonceKey := partitionKey(job)
+	if nonceKey == "" {
+		return 0, nil
+	}
+
+	lastSent, err := nc.nonce.GetLastSent(nonceKey)
+	switch {
+	case err != nil && errors.IsNotFoundError(err):
+		return nc.fetchNonceFromChain(ctx, job)
+	case err != nil:
+		nc.logger.WithContext(ctx).WithError(err).Error("cannot retrieve last sent nonce")
+		return 0, err
 	default:
 		return lastSent + 1, nil
 	}
@@ -145,6 +177,7 @@ func (nc *Manager) IncrementNonce(ctx context.Context, job *entities.Job) error
 	}
 
 	logger.WithField("last_sent", *job.Transaction.Nonce).Debug("increment account nonce value")
+	nc.recordNonce(ctx, job, nonceKey, txNonce, store.NonceSent)
 	nc.recovery.Recovered(job.UUID)
 	return nil
 }
@@ -4,12 +4,18 @@ package manager
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	mock3 "github.com/consensys/orchestrate/pkg/sdk/client/mock"
 	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	mock2 "github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	"github.com/consensys/orchestrate/src/tx-sender/store"
 	"github.com/consensys/orchestrate/src/tx-sender/store/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -23,9 +29,11 @@ func TestNonceManager(t *testing.T) {
 	ns := mock.NewMockNonceSender(ctrl)
 	chainRegistryURL := "http://chain-registry:8081"
 	rt := mock.NewMockRecoveryTracker(ctrl)
+	client := mock3.NewMockOrchestrateClient(ctrl)
 	maxRecovery := uint64(2)
+	gapTimeout := time.Minute
 
-	manager := NewNonceManager(ec, ns, rt, chainRegistryURL, maxRecovery)
+	manager := NewNonceManager(ec, ns, rt, client, chainRegistryURL, maxRecovery, gapTimeout)
 
 	t.Run("should fetch nonce from chain successfully if no nonce is set", func(t *testing.T) {
 		ctx := context.Background()
@@ -36,6 +44,7 @@ func TestNonceManager(t *testing.T) {
 
 		url := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
 		ec.EXPECT().PendingNonceAt(ctx, url, *job.Transaction.From).Return(expectedNonce, nil)
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).Return(nil)
 
 		nonce, err := manager.GetNonce(ctx, job)
 		assert.NoError(t, err)
@@ -48,12 +57,225 @@ func TestNonceManager(t *testing.T) {
 		expectedNonce := uint64(2)
 
 		ns.EXPECT().GetLastSent(partitionKey(job)).Return(expectedNonce-1, nil)
+		ns.EXPECT().GetNonceRecords(partitionKey(job)).Return([]*store.NonceRecord{}, nil)
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).
+			DoAndReturn(func(key string, record *store.NonceRecord) error {
+				assert.Equal(t, expectedNonce, record.Nonce)
+				assert.Equal(t, job.UUID, record.JobUUID)
+				assert.Equal(t, store.NonceReserved, record.Status)
+				return nil
+			})
 
 		nonce, err := manager.GetNonce(ctx, job)
 		assert.NoError(t, err)
 		assert.Equal(t, expectedNonce, nonce)
 	})
 
+	t.Run("should not query chain if no nonce is stale in ledger", func(t *testing.T) {
+		ctx := context.Background()
+		job := testdata.FakeJob()
+		lastSent := uint64(5)
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(lastSent, nil)
+		ns.EXPECT().GetNonceRecords(partitionKey(job)).Return([]*store.NonceRecord{
+			{Nonce: lastSent, Status: store.NonceSent, UpdatedAt: time.Now()},
+		}, nil)
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).Return(nil)
+
+		nonce, err := manager.GetNonce(ctx, job)
+		assert.NoError(t, err)
+		assert.Equal(t, lastSent+1, nonce)
+	})
+
+	t.Run("should only prune confirmed nonces if there is no gap", func(t *testing.T) {
+		ctx := context.Background()
+		job := testdata.FakeJob()
+		lastSent := uint64(5)
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(lastSent, nil)
+		ns.EXPECT().GetNonceRecords(partitionKey(job)).Return([]*store.NonceRecord{
+			{Nonce: lastSent, Status: store.NonceSent, UpdatedAt: time.Now().Add(-gapTimeout)},
+		}, nil)
+		ec.EXPECT().NonceAt(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID), *job.Transaction.From, nil).
+			Return(lastSent+1, nil)
+		ns.EXPECT().DeleteNonceRecords(partitionKey(job), lastSent+1).Return(nil)
+		ec.EXPECT().PendingNonceAt(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID), *job.Transaction.From).
+			Return(lastSent+1, nil)
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).Return(nil)
+
+		nonce, err := manager.GetNonce(ctx, job)
+		assert.NoError(t, err)
+		assert.Equal(t, lastSent+1, nonce)
+	})
+
+	t.Run("should fill nonce gap by resending the pending job", func(t *testing.T) {
+		ctx := context.Background()
+		job := testdata.FakeJob()
+		lastSent := uint64(5)
+		gapNonce := uint64(4)
+		stuckJob := testdata.FakeJob()
+		stuckJob.Status = entities.StatusPending
+		stuckJob.Transaction.Nonce = &gapNonce
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(lastSent, nil)
+		ns.EXPECT().GetNonceRecords(partitionKey(job)).Return([]*store.NonceRecord{
+			{Nonce: gapNonce, JobUUID: stuckJob.UUID, Status: store.NonceSent, UpdatedAt: time.Now().Add(-gapTimeout)},
+			{Nonce: lastSent, Status: store.NonceSent, UpdatedAt: time.Now()},
+		}, nil)
+		ec.EXPECT().NonceAt(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID), *job.Transaction.From, nil).
+			Return(gapNonce, nil)
+		ns.EXPECT().DeleteNonceRecords(partitionKey(job), gapNonce).Return(nil)
+		ec.EXPECT().PendingNonceAt(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID), *job.Transaction.From).
+			Return(gapNonce, nil)
+		client.EXPECT().GetJob(gomock.Any(), stuckJob.UUID).Return(&types.JobResponse{
+			UUID:        stuckJob.UUID,
+			Status:      entities.StatusPending,
+			Transaction: *stuckJob.Transaction,
+		}, nil)
+		client.EXPECT().ResendJobTx(gomock.Any(), stuckJob.UUID).Return(nil)
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).
+			DoAndReturn(func(key string, record *store.NonceRecord) error {
+				assert.Equal(t, gapNonce, record.Nonce)
+				assert.Equal(t, stuckJob.UUID, record.JobUUID)
+				return nil
+			})
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).Return(nil)
+
+		nonce, err := manager.GetNonce(ctx, job)
+		assert.NoError(t, err)
+		assert.Equal(t, lastSent+1, nonce)
+	})
+
+	t.Run("should fill nonce gap with a self-transfer if no job can be resent", func(t *testing.T) {
+		ctx := context.Background()
+		job := testdata.FakeJob()
+		lastSent := uint64(5)
+		gapNonce := uint64(4)
+		schedule := &types.ScheduleResponse{UUID: "schedule-uuid"}
+		fillJob := &types.JobResponse{UUID: "fill-job-uuid"}
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(lastSent, nil)
+		ns.EXPECT().GetNonceRecords(partitionKey(job)).Return([]*store.NonceRecord{
+			{Nonce: lastSent, Status: store.NonceSent, UpdatedAt: time.Now().Add(-gapTimeout)},
+		}, nil)
+		ec.EXPECT().NonceAt(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID), *job.Transaction.From, nil).
+			Return(gapNonce, nil)
+		ns.EXPECT().DeleteNonceRecords(partitionKey(job), gapNonce).Return(nil)
+		ec.EXPECT().PendingNonceAt(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID), *job.Transaction.From).
+			Return(gapNonce, nil)
+		client.EXPECT().GetAccount(gomock.Any(), *job.Transaction.From).Return(&types.AccountResponse{}, nil)
+		client.EXPECT().CreateSchedule(gomock.Any(), &types.CreateScheduleRequest{}).Return(schedule, nil)
+		client.EXPECT().CreateJob(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *types.CreateJobRequest) (*types.JobResponse, error) {
+				assert.Equal(t, schedule.UUID, req.ScheduleUUID)
+				assert.Equal(t, job.Transaction.From, req.Transaction.To)
+				assert.Equal(t, "0x0", req.Transaction.Value.String())
+				assert.Equal(t, gapNonce, *req.Transaction.Nonce)
+				return fillJob, nil
+			})
+		client.EXPECT().StartJob(gomock.Any(), fillJob.UUID).Return(nil)
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).Return(nil).Times(2)
+
+		nonce, err := manager.GetNonce(ctx, job)
+		assert.NoError(t, err)
+		assert.Equal(t, lastSent+1, nonce)
+	})
+
+	t.Run("should only report nonce gap of an account with an approval policy", func(t *testing.T) {
+		ctx := context.Background()
+		job := testdata.FakeJob()
+		lastSent := uint64(5)
+		gapNonce := uint64(4)
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(lastSent, nil)
+		ns.EXPECT().GetNonceRecords(partitionKey(job)).Return([]*store.NonceRecord{
+			{Nonce: lastSent, Status: store.NonceSent, UpdatedAt: time.Now().Add(-gapTimeout)},
+		}, nil)
+		ec.EXPECT().NonceAt(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID), *job.Transaction.From, nil).
+			Return(gapNonce, nil)
+		ns.EXPECT().DeleteNonceRecords(partitionKey(job), gapNonce).Return(nil)
+		ec.EXPECT().PendingNonceAt(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID), *job.Transaction.From).
+			Return(gapNonce, nil)
+		client.EXPECT().GetAccount(gomock.Any(), *job.Transaction.From).Return(&types.AccountResponse{
+			ApprovalPolicy: &entities.ApprovalPolicy{Threshold: 1, Approvers: []string{"tenantOne:alice"}},
+		}, nil)
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).Return(nil)
+
+		nonce, err := manager.GetNonce(ctx, job)
+		assert.NoError(t, err)
+		assert.Equal(t, lastSent+1, nonce)
+	})
+
+	t.Run("should not fail if nonce gap cannot be checked", func(t *testing.T) {
+		ctx := context.Background()
+		job := testdata.FakeJob()
+		lastSent := uint64(5)
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(lastSent, nil)
+		ns.EXPECT().GetNonceRecords(partitionKey(job)).Return([]*store.NonceRecord{
+			{Nonce: lastSent, Status: store.NonceSent, UpdatedAt: time.Now().Add(-gapTimeout)},
+		}, nil)
+		ec.EXPECT().NonceAt(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID), *job.Transaction.From, nil).
+			Return(uint64(0), fmt.Errorf("error"))
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).Return(nil)
+
+		nonce, err := manager.GetNonce(ctx, job)
+		assert.NoError(t, err)
+		assert.Equal(t, lastSent+1, nonce)
+	})
+
+	t.Run("should not fill nonce gap if the missing nonce is in flight", func(t *testing.T) {
+		ctx := context.Background()
+		job := testdata.FakeJob()
+		lastSent := uint64(5)
+		gapNonce := uint64(4)
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(lastSent, nil)
+		ns.EXPECT().GetNonceRecords(partitionKey(job)).Return([]*store.NonceRecord{
+			{Nonce: gapNonce, Status: store.NonceReserved, UpdatedAt: time.Now()},
+			{Nonce: lastSent, Status: store.NonceSent, UpdatedAt: time.Now().Add(-gapTimeout)},
+		}, nil)
+		ec.EXPECT().NonceAt(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID), *job.Transaction.From, nil).
+			Return(gapNonce, nil)
+		ns.EXPECT().DeleteNonceRecords(partitionKey(job), gapNonce).Return(nil)
+		ec.EXPECT().PendingNonceAt(gomock.Any(), utils.GetProxyURL(chainRegistryURL, job.ChainUUID), *job.Transaction.From).
+			Return(gapNonce, nil)
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).Return(nil)
+
+		nonce, err := manager.GetNonce(ctx, job)
+		assert.NoError(t, err)
+		assert.Equal(t, lastSent+1, nonce)
+	})
+
+	t.Run("should not query chain if only reserved nonces are stale in ledger", func(t *testing.T) {
+		ctx := context.Background()
+		job := testdata.FakeJob()
+		lastSent := uint64(5)
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(lastSent, nil)
+		ns.EXPECT().GetNonceRecords(partitionKey(job)).Return([]*store.NonceRecord{
+			{Nonce: lastSent + 1, Status: store.NonceReserved, UpdatedAt: time.Now().Add(-gapTimeout)},
+		}, nil)
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).Return(nil)
+
+		nonce, err := manager.GetNonce(ctx, job)
+		assert.NoError(t, err)
+		assert.Equal(t, lastSent+1, nonce)
+	})
+
+	t.Run("should not use the nonce ledger if nonce gap filling is disabled", func(t *testing.T) {
+		ctx := context.Background()
+		job := testdata.FakeJob()
+		lastSent := uint64(5)
+		noGapManager := NewNonceManager(ec, ns, rt, client, chainRegistryURL, maxRecovery, 0)
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(lastSent, nil)
+
+		nonce, err := noGapManager.GetNonce(ctx, job)
+		assert.NoError(t, err)
+		assert.Equal(t, lastSent+1, nonce)
+	})
+
 	t.Run("should return error if NonceSender fails", func(t *testing.T) {
 		ctx := context.Background()
 		job := testdata.FakeJob()
@@ -65,6 +287,29 @@ func TestNonceManager(t *testing.T) {
 		assert.Equal(t, err, expectedErr)
 	})
 
+	t.Run("should peek the next nonce of the account without reserving it", func(t *testing.T) {
+		ctx := context.Background()
+		job := testdata.FakeJob()
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(uint64(4), nil)
+
+		nonce, err := manager.PeekNonce(ctx, job)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(5), nonce)
+	})
+
+	t.Run("should peek the pending nonce of the chain if no nonce is set", func(t *testing.T) {
+		ctx := context.Background()
+		job := testdata.FakeJob()
+
+		ns.EXPECT().GetLastSent(partitionKey(job)).Return(uint64(0), errors.NotFoundError("error"))
+		ec.EXPECT().PendingNonceAt(ctx, utils.GetProxyURL(chainRegistryURL, job.ChainUUID), *job.Transaction.From).Return(uint64(3), nil)
+
+		nonce, err := manager.PeekNonce(ctx, job)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), nonce)
+	})
+
 	t.Run("should increment nonce successfully", func(t *testing.T) {
 		ctx := context.Background()
 		job := testdata.FakeJob()
@@ -73,6 +318,7 @@ func TestNonceManager(t *testing.T) {
 
 		ns.EXPECT().GetLastSent(partitionKey(job)).Return(uint64(0), nil)
 		ns.EXPECT().SetLastSent(partitionKey(job), expectedNonce).Return(nil)
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).Return(nil)
 		rt.EXPECT().Recovered(job.UUID)
 
 		err := manager.IncrementNonce(ctx, job)
@@ -87,6 +333,7 @@ func TestNonceManager(t *testing.T) {
 
 		ns.EXPECT().GetLastSent(partitionKey(job)).Return(uint64(0), nil)
 		ns.EXPECT().SetLastSent(partitionKey(job), expectedNonce).Return(nil)
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).Return(nil)
 		rt.EXPECT().Recovered(job.UUID)
 
 		err := manager.IncrementNonce(ctx, job)
@@ -100,6 +347,7 @@ func TestNonceManager(t *testing.T) {
 		job.Transaction.Nonce = utils.ToPtr(expectedNonce).(*uint64)
 
 		ns.EXPECT().GetLastSent(partitionKey(job)).Return(uint64(0), nil)
+		ns.EXPECT().SetNonceRecord(partitionKey(job), gomock.Any()).Return(nil)
 		rt.EXPECT().Recovered(job.UUID)
 
 		err := manager.IncrementNonce(ctx, job)
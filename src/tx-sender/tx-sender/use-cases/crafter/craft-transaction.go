@@ -2,10 +2,11 @@ package crafter
 
 import (
 	"context"
-	"math/big"
 
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/gas"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/nonce"
 
+	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/types/tx"
 	"github.com/consensys/orchestrate/pkg/utils"
@@ -20,21 +21,21 @@ import (
 const estimationGasError = "cannot estimate gas usage"
 const craftTransactionComponent = "use-cases.craft-transaction"
 
-const mediumPriorityString = "1500000000" // 1.5 gwei
-const thresholdString = "500000000"       // 0.5 gwei
-
 type craftTxUseCase struct {
 	nonceManager     nonce.Manager
+	gasOracle        gas.Oracle
 	ec               ethclient.MultiClient
 	chainRegistryURL string
 	logger           *log.Logger
 }
 
-func NewCraftTransactionUseCase(ec ethclient.MultiClient, chainRegistryURL string, nonceManager nonce.Manager) usecases.CraftTransactionUseCase {
+func NewCraftTransactionUseCase(ec ethclient.MultiClient, chainRegistryURL string, nonceManager nonce.Manager,
+	gasOracle gas.Oracle) usecases.CraftTransactionUseCase {
 	return &craftTxUseCase{
 		ec:               ec,
 		chainRegistryURL: chainRegistryURL,
 		nonceManager:     nonceManager,
+		gasOracle:        gasOracle,
 		logger:           log.NewLogger().SetComponent(craftTransactionComponent),
 	}
 }
@@ -68,6 +69,21 @@ func (uc *craftTxUseCase) Execute(ctx context.Context, job *entities.Job) error
 		}
 	}
 
+	// Fees set by the client or increased by a speed up are bound by the maximum fees of the chain as well
+	gasPrice, gasFeeCap, gasTipCap := job.Transaction.GasPrice, job.Transaction.GasFeeCap, job.Transaction.GasTipCap
+	if err := uc.gasOracle.CapFees(ctx, job); err != nil {
+		uc.logger.WithContext(ctx).WithError(err).Error("cannot cap transaction fees")
+		return err
+	}
+
+	// Nodes reject replacements not paying more than the transaction they replace, which capped fees cannot guarantee
+	if isReplacement(job) && (isLowered(gasPrice, job.Transaction.GasPrice) || isLowered(gasFeeCap, job.Transaction.GasFeeCap) ||
+		isLowered(gasTipCap, job.Transaction.GasTipCap)) {
+		errMessage := "replacement transaction fees exceed the maximum fees of the chain, the capped replacement would be underpriced"
+		uc.logger.WithContext(ctx).WithField("parent_job", job.InternalData.ParentJobUUID).Error(errMessage)
+		return errors.InvalidStateError(errMessage).ExtendComponent(craftTransactionComponent)
+	}
+
 	if job.Transaction.Gas == nil {
 		if err := uc.craftGasEstimation(ctx, job); err != nil {
 			return err
@@ -168,30 +184,13 @@ func (uc *craftTxUseCase) craftGasPrice(ctx context.Context, job *entities.Job)
 		return nil
 	}
 
-	proxyURL := utils.GetProxyURL(uc.chainRegistryURL, job.ChainUUID)
-	gasPrice, err := uc.ec.SuggestGasPrice(ctx, proxyURL)
+	gasPrice, err := uc.gasOracle.GasPrice(ctx, job)
 	if err != nil {
 		logger.WithError(err).Error("cannot suggest gas price")
 		return err
 	}
 
-	var txGasPrice *big.Int
-	switch job.InternalData.Priority {
-	case utils.PriorityVeryLow:
-		txGasPrice = gasPrice.Mul(gasPrice, big.NewInt(6)).Div(gasPrice, big.NewInt(10))
-	case utils.PriorityLow:
-		txGasPrice = gasPrice.Mul(gasPrice, big.NewInt(8)).Div(gasPrice, big.NewInt(10))
-	case utils.PriorityMedium:
-		txGasPrice = gasPrice
-	case utils.PriorityHigh:
-		txGasPrice = gasPrice.Mul(gasPrice, big.NewInt(12)).Div(gasPrice, big.NewInt(10))
-	case utils.PriorityVeryHigh:
-		txGasPrice = gasPrice.Mul(gasPrice, big.NewInt(14)).Div(gasPrice, big.NewInt(10))
-	default:
-		txGasPrice = gasPrice
-	}
-
-	job.Transaction.GasPrice = utils.ToPtr(hexutil.Big(*txGasPrice)).(*hexutil.Big)
+	job.Transaction.GasPrice = utils.ToPtr(hexutil.Big(*gasPrice)).(*hexutil.Big)
 
 	job.Transaction.TransactionType = entities.LegacyTxType
 	logger.WithField("value", job.Transaction.GasPrice).Debug("crafted gas price")
@@ -217,54 +216,26 @@ func (uc *craftTxUseCase) craftDynamicFeePrice(ctx context.Context, job *entitie
 		return nil
 	}
 
-	proxyURL := utils.GetProxyURL(uc.chainRegistryURL, job.ChainUUID)
-	feeHistory, err := uc.ec.FeeHistory(ctx, proxyURL, 1, "latest")
+	gasTipCap, gasFeeCap, err := uc.gasOracle.DynamicFees(ctx, job)
 	if err != nil {
-		logger.WithError(err).Debug("failed to fetch feeHistory. Fallback to craft GasPrice")
-		return uc.craftGasPrice(ctx, job)
-	}
-
-	if feeHistory == nil || len(feeHistory.BaseFeePerGas) == 0 {
-		logger.Debug("cannot extract base fee. Fallback to craft GasPrice")
-		return uc.craftGasPrice(ctx, job)
-	}
-
-	nextBlockBaseFeePerGas := feeHistory.BaseFeePerGas[len(feeHistory.BaseFeePerGas)-1].ToInt()
-	if nextBlockBaseFeePerGas.String() == "0" {
-		logger.Debug("skip gas dynamic fee. Zero base fee is not allowed")
+		logger.WithError(err).Debug("failed to suggest dynamic fees. Fallback to craft GasPrice")
 		return uc.craftGasPrice(ctx, job)
 	}
 
-	var priorityFee *big.Int
-	if job.Transaction.GasTipCap == nil {
-		mediumPriority, _ := new(big.Int).SetString(mediumPriorityString, 10) // 1.5 gwei
-		threshold, _ := new(big.Int).SetString(thresholdString, 10)           // 0.5 gwei
-
-		switch job.InternalData.Priority {
-		case utils.PriorityVeryLow:
-			priorityFee = new(big.Int).Sub(mediumPriority, new(big.Int).Mul(threshold, big.NewInt(2))) // 0.5 gwei
-		case utils.PriorityLow:
-			priorityFee = new(big.Int).Sub(mediumPriority, threshold) // 1 gwei
-		case utils.PriorityMedium:
-			priorityFee = mediumPriority // 1.5 gwei
-		case utils.PriorityHigh:
-			priorityFee = new(big.Int).Add(mediumPriority, threshold) // 2 gwei
-		case utils.PriorityVeryHigh:
-			priorityFee = new(big.Int).Add(mediumPriority, new(big.Int).Mul(threshold, big.NewInt(2))) // 2.5 gwei
-		default:
-			priorityFee = mediumPriority
-		}
-
-		job.Transaction.GasTipCap = utils.ToPtr(hexutil.Big(*priorityFee)).(*hexutil.Big)
-	} else {
-		priorityFee = job.Transaction.GasTipCap.ToInt()
-	}
-
-	gasFeeCap := new(big.Int).Add(nextBlockBaseFeePerGas, priorityFee)
+	job.Transaction.GasTipCap = utils.ToPtr(hexutil.Big(*gasTipCap)).(*hexutil.Big)
 	job.Transaction.GasFeeCap = utils.ToPtr(hexutil.Big(*gasFeeCap)).(*hexutil.Big)
 	job.Transaction.TransactionType = entities.DynamicFeeTxType
 
-	logger.WithField("base", nextBlockBaseFeePerGas).WithField("tip", priorityFee).
+	logger.WithField("fee_cap", gasFeeCap).WithField("tip", gasTipCap).
 		Debug("crafted dynamic fees")
 	return nil
 }
+
+// isReplacement indicates whether the job is a speed up or a retry of a job already sent with the same nonce
+func isReplacement(job *entities.Job) bool {
+	return job.InternalData.ParentJobUUID != "" && job.InternalData.ParentJobUUID != job.UUID
+}
+
+func isLowered(prev, next *hexutil.Big) bool {
+	return prev != nil && next != nil && next.ToInt().Cmp(prev.ToInt()) < 0
+}
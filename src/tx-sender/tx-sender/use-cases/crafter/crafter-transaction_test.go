@@ -12,6 +12,8 @@ import (
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/consensys/orchestrate/pkg/types/tx"
 	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/pkg/errors"
+	gasmocks "github.com/consensys/orchestrate/src/tx-sender/tx-sender/gas/mocks"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/nonce/mocks"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -26,12 +28,13 @@ func TestCrafterTransaction_Execute(t *testing.T) {
 	ctx := context.Background()
 	ec := mock2.NewMockMultiClient(ctrl)
 	nm := mocks.NewMockManager(ctrl)
+	gasOracle := gasmocks.NewMockOracle(ctrl)
 	chainRegistryURL := "http://chain-registry:8081"
 
-	nextBaseFee, _ := new(big.Int).SetString("1000000000", 10)
-	mediumPriority, _ := new(big.Int).SetString(mediumPriorityString, 10)
+	gasTipCap, _ := new(big.Int).SetString("1500000000", 10)
+	gasFeeCap, _ := new(big.Int).SetString("2500000000", 10)
 
-	usecase := NewCraftTransactionUseCase(ec, chainRegistryURL, nm)
+	usecase := NewCraftTransactionUseCase(ec, chainRegistryURL, nm, gasOracle)
 
 	t.Run("should execute use case for LegacyTx successfully", func(t *testing.T) {
 		job := testdata.FakeJob()
@@ -42,9 +45,10 @@ func TestCrafterTransaction_Execute(t *testing.T) {
 
 		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
 		expectedGasPrice, _ := new(big.Int).SetString("1000", 10)
-		ec.EXPECT().SuggestGasPrice(gomock.Any(), proxyURL).Return(expectedGasPrice, nil)
+		gasOracle.EXPECT().GasPrice(gomock.Any(), job).Return(expectedGasPrice, nil)
 		ec.EXPECT().EstimateGas(gomock.Any(), proxyURL, gomock.Any()).Return(uint64(1000), nil)
 		nm.EXPECT().GetNonce(gomock.Any(), gomock.Any()).Return(uint64(1), nil)
+		gasOracle.EXPECT().CapFees(gomock.Any(), job).Return(nil)
 		err := usecase.Execute(ctx, job)
 
 		assert.NoError(t, err)
@@ -60,22 +64,41 @@ func TestCrafterTransaction_Execute(t *testing.T) {
 		job.Transaction.GasPrice = nil
 
 		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
-		expectedFeeHistory := testdata.FakeFeeHistory(nextBaseFee)
-		ec.EXPECT().FeeHistory(gomock.Any(), proxyURL, 1, "latest").Return(expectedFeeHistory, nil)
+		gasOracle.EXPECT().DynamicFees(gomock.Any(), job).Return(gasTipCap, gasFeeCap, nil)
 		ec.EXPECT().EstimateGas(gomock.Any(), proxyURL, gomock.Any()).Return(uint64(1000), nil)
 		nm.EXPECT().GetNonce(gomock.Any(), gomock.Any()).Return(uint64(1), nil)
+		gasOracle.EXPECT().CapFees(gomock.Any(), job).Return(nil)
 		err := usecase.Execute(ctx, job)
 
-		expectedFeeCap := new(big.Int).Add(mediumPriority, nextBaseFee)
-
 		assert.NoError(t, err)
 		assert.Equal(t, entities.DynamicFeeTxType, job.Transaction.TransactionType)
-		assert.Equal(t, expectedFeeCap.String(), job.Transaction.GasFeeCap.ToInt().String())
-		assert.Equal(t, mediumPriority.String(), job.Transaction.GasTipCap.ToInt().String())
+		assert.Equal(t, gasFeeCap.String(), job.Transaction.GasFeeCap.ToInt().String())
+		assert.Equal(t, gasTipCap.String(), job.Transaction.GasTipCap.ToInt().String())
 		assert.Equal(t, uint64(1000), *job.Transaction.Gas)
 		assert.Equal(t, uint64(1), *job.Transaction.Nonce)
 	})
 
+	t.Run("should fallback to LegacyTx if dynamic fees cannot be suggested", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Transaction.Nonce = nil
+		job.Transaction.Gas = nil
+		job.Transaction.GasPrice = nil
+
+		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
+		expectedGasPrice, _ := new(big.Int).SetString("1000", 10)
+		gasOracle.EXPECT().DynamicFees(gomock.Any(), job).Return(nil, nil, errors.FeatureNotSupportedError("cannot extract base fee"))
+		gasOracle.EXPECT().GasPrice(gomock.Any(), job).Return(expectedGasPrice, nil)
+		ec.EXPECT().EstimateGas(gomock.Any(), proxyURL, gomock.Any()).Return(uint64(1000), nil)
+		nm.EXPECT().GetNonce(gomock.Any(), gomock.Any()).Return(uint64(1), nil)
+		gasOracle.EXPECT().CapFees(gomock.Any(), job).Return(nil)
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.LegacyTxType, job.Transaction.TransactionType)
+		assert.Equal(t, expectedGasPrice.String(), job.Transaction.GasPrice.ToInt().String())
+		assert.Nil(t, job.Transaction.GasFeeCap)
+	})
+
 	t.Run("should execute use case for OneTimeKey successfully", func(t *testing.T) {
 		job := testdata.FakeJob()
 		job.Transaction.Nonce = nil
@@ -85,8 +108,9 @@ func TestCrafterTransaction_Execute(t *testing.T) {
 
 		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
 		expectedGasPrice, _ := new(big.Int).SetString("1000", 10)
-		ec.EXPECT().SuggestGasPrice(gomock.Any(), proxyURL).Return(expectedGasPrice, nil)
+		gasOracle.EXPECT().GasPrice(gomock.Any(), job).Return(expectedGasPrice, nil)
 		ec.EXPECT().EstimateGas(gomock.Any(), proxyURL, gomock.Any()).Return(uint64(1000), nil)
+		gasOracle.EXPECT().CapFees(gomock.Any(), job).Return(nil)
 		err := usecase.Execute(ctx, job)
 
 		assert.NoError(t, err)
@@ -104,11 +128,11 @@ func TestCrafterTransaction_Execute(t *testing.T) {
 
 		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
 		expectedContractAddr := ethcommon.HexToAddress("0x1")
-		expectedFeeHistory := testdata.FakeFeeHistory(nextBaseFee)
-		ec.EXPECT().FeeHistory(gomock.Any(), proxyURL, 1, "latest").Return(expectedFeeHistory, nil)
+		gasOracle.EXPECT().DynamicFees(gomock.Any(), job).Return(gasTipCap, gasFeeCap, nil)
 		ec.EXPECT().EstimateGas(gomock.Any(), proxyURL, gomock.Any()).Return(uint64(1000), nil)
 		ec.EXPECT().EEAPrivPrecompiledContractAddr(gomock.Any(), proxyURL).Return(expectedContractAddr, nil)
 		nm.EXPECT().GetNonce(gomock.Any(), gomock.Any()).Return(uint64(1), nil)
+		gasOracle.EXPECT().CapFees(gomock.Any(), job).Return(nil)
 		err := usecase.Execute(ctx, job)
 
 		assert.NoError(t, err)
@@ -125,6 +149,7 @@ func TestCrafterTransaction_Execute(t *testing.T) {
 		job.Transaction.Gas = nil
 
 		nm.EXPECT().GetNonce(gomock.Any(), gomock.Any()).Return(uint64(1), nil)
+		gasOracle.EXPECT().CapFees(gomock.Any(), job).Return(nil)
 		err := usecase.Execute(ctx, job)
 
 		assert.NoError(t, err)
@@ -139,14 +164,66 @@ func TestCrafterTransaction_Execute(t *testing.T) {
 		job.Transaction.TransactionType = entities.DynamicFeeTxType
 		job.InternalData.ParentJobUUID = job.UUID
 
-		proxyURL := utils.GetProxyURL(chainRegistryURL, job.ChainUUID)
-		expectedFeeHistory := testdata.FakeFeeHistory(nextBaseFee)
-		ec.EXPECT().FeeHistory(gomock.Any(), proxyURL, 1, "latest").Return(expectedFeeHistory, nil)
+		gasOracle.EXPECT().DynamicFees(gomock.Any(), job).Return(job.Transaction.GasTipCap.ToInt(), gasFeeCap, nil)
+		gasOracle.EXPECT().CapFees(gomock.Any(), job).Return(nil)
 
 		err := usecase.Execute(ctx, job)
-		expectedFeeCap := new(big.Int).Add(job.Transaction.GasTipCap.ToInt(), nextBaseFee)
 
 		assert.NoError(t, err)
-		assert.Equal(t, expectedFeeCap.String(), job.Transaction.GasFeeCap.ToInt().String())
+		assert.Equal(t, gasFeeCap.String(), job.Transaction.GasFeeCap.ToInt().String())
+	})
+
+	t.Run("should cap the fees set by the client", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Transaction.TransactionType = entities.LegacyTxType
+		job.Transaction.GasPrice = (*hexutil.Big)(hexutil.MustDecodeBig("0x3B9ACA00"))
+		maxGasPrice := hexutil.MustDecodeBig("0x3E8")
+
+		gasOracle.EXPECT().CapFees(gomock.Any(), job).DoAndReturn(func(_ context.Context, j *entities.Job) error {
+			j.Transaction.GasPrice = (*hexutil.Big)(maxGasPrice)
+			return nil
+		})
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+		assert.Equal(t, maxGasPrice.String(), job.Transaction.GasPrice.ToInt().String())
+	})
+
+	t.Run("should fail with InvalidStateError if the fees of a replacement are capped", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.InternalData.ParentJobUUID = "parentJobUUID"
+		job.Transaction.TransactionType = entities.LegacyTxType
+		job.Transaction.GasPrice = (*hexutil.Big)(hexutil.MustDecodeBig("0x3B9ACA00"))
+
+		gasOracle.EXPECT().CapFees(gomock.Any(), job).DoAndReturn(func(_ context.Context, j *entities.Job) error {
+			j.Transaction.GasPrice = (*hexutil.Big)(hexutil.MustDecodeBig("0x3E8"))
+			return nil
+		})
+		err := usecase.Execute(ctx, job)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should not fail if the fees of a replacement are below the maximum fees", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.InternalData.ParentJobUUID = "parentJobUUID"
+		job.Transaction.TransactionType = entities.LegacyTxType
+		job.Transaction.GasPrice = (*hexutil.Big)(hexutil.MustDecodeBig("0x3B9ACA00"))
+
+		gasOracle.EXPECT().CapFees(gomock.Any(), job).Return(nil)
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if fees cannot be capped", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Transaction.TransactionType = entities.LegacyTxType
+		expectedErr := errors.DependencyFailureError("error")
+
+		gasOracle.EXPECT().CapFees(gomock.Any(), job).Return(expectedErr)
+		err := usecase.Execute(ctx, job)
+
+		assert.Equal(t, expectedErr, err)
 	})
 }
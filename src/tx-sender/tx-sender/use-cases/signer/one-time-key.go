@@ -0,0 +1,68 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/tx-sender/store"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// oneTimeKey returns the private key signing a one-time key job. Jobs replacing another job (speed up, call off) are
+// signed with the key of the replaced job so that they are sent from the same account at the same nonce
+func oneTimeKey(keys store.OneTimeKeys, job *entities.Job, logger *log.Logger) (*ecdsa.PrivateKey, error) {
+	parentJobUUID := job.InternalData.ParentJobUUID
+	if parentJobUUID != "" && parentJobUUID != job.UUID {
+		privKey, err := loadOneTimeKey(keys, parentJobUUID, logger)
+		if errors.IsNotFoundError(err) {
+			errMessage := "one-time key of the replaced job is not available anymore"
+			logger.WithField("parent_job", parentJobUUID).Error(errMessage)
+			return nil, errors.InvalidStateError(errMessage)
+		}
+
+		return privKey, err
+	}
+
+	// A job delivered again (crash, Kafka rebalance) must be signed with the key it was first signed with
+	privKey, err := loadOneTimeKey(keys, job.UUID, logger)
+	if err == nil || !errors.IsNotFoundError(err) {
+		return privKey, err
+	}
+
+	privKey, err = crypto.GenerateKey()
+	if err != nil {
+		errMessage := "failed to generate Ethereum private one time key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	// The key is kept so the job can be replaced later on
+	if err = keys.Set(job.UUID, crypto.FromECDSA(privKey)); err != nil {
+		logger.WithError(err).Error("failed to store one-time key")
+		return nil, err
+	}
+
+	return privKey, nil
+}
+
+func loadOneTimeKey(keys store.OneTimeKeys, jobUUID string, logger *log.Logger) (*ecdsa.PrivateKey, error) {
+	privKeyB, err := keys.Get(jobUUID)
+	if errors.IsNotFoundError(err) {
+		return nil, err
+	}
+	if err != nil {
+		logger.WithError(err).WithField("key_job", jobUUID).Error("failed to load one-time key")
+		return nil, err
+	}
+
+	privKey, err := crypto.ToECDSA(privKeyB)
+	if err != nil {
+		errMessage := "loaded one-time key is not valid"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.DataCorruptedError(errMessage)
+	}
+
+	return privKey, nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"math/big"
 
-	"github.com/consensys/orchestrate/pkg/encoding/rlp"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/src/api/service/formatters"
 	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
@@ -13,8 +12,8 @@ import (
 	pkgcryto "github.com/consensys/orchestrate/pkg/crypto/ethereum"
 
 	"github.com/consensys/orchestrate/src/entities"
-	"github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/consensys/orchestrate/src/tx-sender/store"
 	usecases "github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases"
 
 	"github.com/consensys/orchestrate/pkg/errors"
@@ -28,13 +27,15 @@ const signEEATransactionComponent = "use-cases.sign-eea-transaction"
 // signEEATransactionUseCase is a use case to sign a public Ethereum transaction
 type signEEATransactionUseCase struct {
 	keyManagerClient client.KeyManagerClient
+	oneTimeKeys      store.OneTimeKeys
 	logger           *log.Logger
 }
 
 // NewSignEEATransactionUseCase creates a new SignEEATransactionUseCase
-func NewSignEEATransactionUseCase(keyManagerClient client.KeyManagerClient) usecases.SignEEATransactionUseCase {
+func NewSignEEATransactionUseCase(keyManagerClient client.KeyManagerClient, oneTimeKeys store.OneTimeKeys) usecases.SignEEATransactionUseCase {
 	return &signEEATransactionUseCase{
 		keyManagerClient: keyManagerClient,
+		oneTimeKeys:      oneTimeKeys,
 		logger:           log.NewLogger().SetComponent(signEEATransactionComponent),
 	}
 }
@@ -51,7 +52,7 @@ func (uc *signEEATransactionUseCase) Execute(ctx context.Context, job *entities.
 	}
 
 	if job.InternalData.OneTimeKey {
-		signedRaw, err = uc.signWithOneTimeKey(ctx, transaction, privateArgs, job.InternalData.ChainID)
+		signedRaw, err = uc.signWithOneTimeKey(ctx, job, transaction, privateArgs, job.InternalData.ChainID)
 	} else {
 		signedRaw, err = uc.signWithAccount(ctx, job, privateArgs, transaction, job.InternalData.ChainID)
 	}
@@ -65,14 +66,12 @@ func (uc *signEEATransactionUseCase) Execute(ctx context.Context, job *entities.
 	return signedRaw, nil, nil
 }
 
-func (uc *signEEATransactionUseCase) signWithOneTimeKey(ctx context.Context, transaction *types.Transaction,
+func (uc *signEEATransactionUseCase) signWithOneTimeKey(ctx context.Context, job *entities.Job, transaction *types.Transaction,
 	privateArgs *entities.PrivateETHTransactionParams, chainID *big.Int) (hexutil.Bytes, error) {
 	logger := uc.logger.WithContext(ctx)
-	privKey, err := crypto.GenerateKey()
+	privKey, err := oneTimeKey(uc.oneTimeKeys, job, logger)
 	if err != nil {
-		errMessage := "failed to generate ethereum private key"
-		logger.WithError(err).Error(errMessage)
-		return nil, errors.CryptoOperationError(errMessage)
+		return nil, err
 	}
 
 	decodedSignature, err := pkgcryto.SignEEATransaction(transaction, privateArgs, chainID, privKey)
@@ -81,9 +80,10 @@ func (uc *signEEATransactionUseCase) signWithOneTimeKey(ctx context.Context, tra
 		return nil, err
 	}
 
-	signedRaw, err := uc.getSignedRawEEATransaction(ctx, transaction, privateArgs, decodedSignature, chainID)
+	signedRaw, err := pkgcryto.EncodeSignedEEATransaction(transaction, privateArgs, decodedSignature, chainID)
 	if err != nil {
-		return nil, errors.FromError(err).ExtendComponent(signEEATransactionComponent)
+		logger.WithError(err).Error("failed to encode signed EEA transaction")
+		return nil, err
 	}
 
 	logger.Debug("eea transaction signed successfully")
@@ -125,48 +125,3 @@ func (uc *signEEATransactionUseCase) signWithAccount(ctx context.Context, job *e
 
 	return signedRaw, nil
 }
-
-func (uc *signEEATransactionUseCase) getSignedRawEEATransaction(ctx context.Context, transaction *types.Transaction,
-	privateArgs *entities.PrivateETHTransactionParams, signature []byte, chainID *big.Int) (hexutil.Bytes, error) {
-	logger := uc.logger.WithContext(ctx)
-
-	signedTx, err := transaction.WithSignature(types.NewEIP155Signer(chainID), signature)
-	if err != nil {
-		errMessage := "failed to set eea transaction signature"
-		logger.WithError(err).Error(errMessage)
-		return nil, errors.InvalidParameterError(errMessage)
-	}
-	v, r, s := signedTx.RawSignatureValues()
-
-	privateFromEncoded, err := pkgcryto.GetEncodedPrivateFrom(privateArgs.PrivateFrom)
-	if err != nil {
-		return nil, err
-	}
-
-	privateRecipientEncoded, err := pkgcryto.GetEncodedPrivateRecipient(privateArgs.PrivacyGroupID, privateArgs.PrivateFor)
-	if err != nil {
-		return nil, err
-	}
-
-	signedRaw, err := rlp.Encode([]interface{}{
-		transaction.Nonce(),
-		transaction.GasPrice(),
-		transaction.Gas(),
-		transaction.To(),
-		transaction.Value(),
-		transaction.Data(),
-		v,
-		r,
-		s,
-		privateFromEncoded,
-		privateRecipientEncoded,
-		privateArgs.PrivateTxType,
-	})
-	if err != nil {
-		errMessage := "failed to RLP encode signed eea transaction"
-		logger.WithError(err).Error(errMessage)
-		return nil, errors.CryptoOperationError(errMessage)
-	}
-
-	return signedRaw, nil
-}
@@ -9,6 +9,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/tx-sender/store/mock"
 	qkmmock "github.com/consensys/quorum-key-manager/pkg/client/mock"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 	"github.com/stretchr/testify/require"
@@ -22,9 +23,10 @@ func TestSignEEATransaction_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockKeyManagerClient := qkmmock.NewMockKeyManagerClient(ctrl)
+	mockOneTimeKeys := mock.NewMockOneTimeKeys(ctrl)
 	ctx := context.Background()
 
-	usecase := NewSignEEATransactionUseCase(mockKeyManagerClient)
+	usecase := NewSignEEATransactionUseCase(mockKeyManagerClient, mockOneTimeKeys)
 
 	signedRaw := utils.StringToHexBytes("0xf8d501822710825208944fed1fc4144c223ae3c1553be203cdfcbd38c58182c35080820713a09a0a890215ea6e79d06f9665297996ab967db117f36c2090d6d6ead5a2d32d52a065bc4bc766b5a833cb58b3319e44e952487559b9b939cb5268c0409398214c8ba0035695b4cc4b0941e60551d7a19cf30603db5bfc23e5ac43a56f57f25f75486af842a0035695b4cc4b0941e60551d7a19cf30603db5bfc23e5ac43a56f57f25f75486aa0075695b4cc4b0941e60551d7a19cf30603db5bfc23e5ac43a56f57f25f75486a8a72657374726963746564")
 	
@@ -59,6 +61,8 @@ func TestSignEEATransaction_Execute(t *testing.T) {
 		job.Transaction.PrivateFrom = "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="
 		job.Transaction.PrivateFor = []string{"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="}
 		job.Transaction.TransactionType = types.LegacyTxType
+		mockOneTimeKeys.EXPECT().Get(job.UUID).Return(nil, errors.NotFoundError("error"))
+		mockOneTimeKeys.EXPECT().Set(job.UUID, gomock.Any()).Return(nil)
 
 		raw, txHash, err := usecase.Execute(ctx, job)
 
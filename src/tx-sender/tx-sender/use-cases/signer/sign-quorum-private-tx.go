@@ -12,8 +12,8 @@ import (
 	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
 	quorumtypes "github.com/consensys/quorum/core/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/consensys/orchestrate/src/tx-sender/store"
 	usecases "github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases"
 
 	"github.com/consensys/orchestrate/pkg/errors"
@@ -26,13 +26,15 @@ const signQuorumPrivateTransactionComponent = "use-cases.sign-quorum-private-tra
 // signQuorumPrivateTransactionUseCase is a use case to sign a quorum private transaction
 type signQuorumPrivateTransactionUseCase struct {
 	keyManagerClient client.KeyManagerClient
+	oneTimeKeys      store.OneTimeKeys
 	logger           *log.Logger
 }
 
 // NewSignQuorumPrivateTransactionUseCase creates a new signQuorumPrivateTransactionUseCase
-func NewSignQuorumPrivateTransactionUseCase(keyManagerClient client.KeyManagerClient) usecases.SignQuorumPrivateTransactionUseCase {
+func NewSignQuorumPrivateTransactionUseCase(keyManagerClient client.KeyManagerClient, oneTimeKeys store.OneTimeKeys) usecases.SignQuorumPrivateTransactionUseCase {
 	return &signQuorumPrivateTransactionUseCase{
 		keyManagerClient: keyManagerClient,
+		oneTimeKeys:      oneTimeKeys,
 		logger:           log.NewLogger().SetComponent(signQuorumPrivateTransactionComponent),
 	}
 }
@@ -44,7 +46,7 @@ func (uc *signQuorumPrivateTransactionUseCase) Execute(ctx context.Context, job
 	transaction := formatters.ETHTransactionToQuorumTransaction(job.Transaction)
 	transaction.SetPrivate()
 	if job.InternalData.OneTimeKey {
-		signedRaw, txHash, err = uc.signWithOneTimeKey(ctx, transaction)
+		signedRaw, txHash, err = uc.signWithOneTimeKey(ctx, job, transaction)
 	} else {
 		signedRaw, txHash, err = uc.signWithAccount(ctx, job, transaction)
 	}
@@ -57,14 +59,12 @@ func (uc *signQuorumPrivateTransactionUseCase) Execute(ctx context.Context, job
 	return signedRaw, txHash, nil
 }
 
-func (uc *signQuorumPrivateTransactionUseCase) signWithOneTimeKey(ctx context.Context, transaction *quorumtypes.Transaction) (
+func (uc *signQuorumPrivateTransactionUseCase) signWithOneTimeKey(ctx context.Context, job *entities.Job, transaction *quorumtypes.Transaction) (
 	signedRaw hexutil.Bytes, txHash *ethcommon.Hash, err error) {
 	logger := uc.logger.WithContext(ctx)
-	privKey, err := crypto.GenerateKey()
+	privKey, err := oneTimeKey(uc.oneTimeKeys, job, logger)
 	if err != nil {
-		errMessage := "failed to generate Ethereum account"
-		logger.WithError(err).Error(errMessage)
-		return nil, nil, errors.CryptoOperationError(errMessage)
+		return nil, nil, err
 	}
 
 	signer := pkgcryto.GetQuorumPrivateTxSigner()
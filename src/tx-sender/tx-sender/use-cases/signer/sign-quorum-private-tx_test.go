@@ -9,6 +9,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/tx-sender/store/mock"
 	qkmmock "github.com/consensys/quorum-key-manager/pkg/client/mock"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 	"github.com/golang/mock/gomock"
@@ -21,9 +22,10 @@ func TestSignQuorumPrivateTransaction_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockKeyManagerClient := qkmmock.NewMockKeyManagerClient(ctrl)
+	mockOneTimeKeys := mock.NewMockOneTimeKeys(ctrl)
 	ctx := context.Background()
 
-	usecase := NewSignQuorumPrivateTransactionUseCase(mockKeyManagerClient)
+	usecase := NewSignQuorumPrivateTransactionUseCase(mockKeyManagerClient, mockOneTimeKeys)
 
 	signedRaw := utils.StringToHexBytes("0xf851018227108252088082c35080820713a09a0a890215ea6e79d06f9665297996ab967db117f36c2090d6d6ead5a2d32d52a065bc4bc766b5a833cb58b3319e44e952487559b9b939cb5268c0409398214c8b")
 
@@ -55,6 +57,8 @@ func TestSignQuorumPrivateTransaction_Execute(t *testing.T) {
 	t.Run("should execute use case successfully for one time key transactions", func(t *testing.T) {
 		job := testdata.FakeJob()
 		job.InternalData.OneTimeKey = true
+		mockOneTimeKeys.EXPECT().Get(job.UUID).Return(nil, errors.NotFoundError("error"))
+		mockOneTimeKeys.EXPECT().Set(job.UUID, gomock.Any()).Return(nil)
 
 		raw, txHash, err := usecase.Execute(ctx, job)
 
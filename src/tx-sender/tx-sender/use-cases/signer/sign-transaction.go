@@ -12,8 +12,8 @@ import (
 	"github.com/consensys/orchestrate/src/entities"
 	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/consensys/orchestrate/src/tx-sender/store"
 	usecases "github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases"
 
 	"github.com/consensys/orchestrate/pkg/errors"
@@ -27,13 +27,15 @@ const signTransactionComponent = "use-cases.sign-eth-transaction"
 // signETHTransactionUseCase is a use case to sign a public Ethereum transaction
 type signETHTransactionUseCase struct {
 	keyManagerClient client.KeyManagerClient
+	oneTimeKeys      store.OneTimeKeys
 	logger           *log.Logger
 }
 
 // NewSignETHTransactionUseCase creates a new SignTransactionUseCase
-func NewSignETHTransactionUseCase(keyManagerClient client.KeyManagerClient) usecases.SignETHTransactionUseCase {
+func NewSignETHTransactionUseCase(keyManagerClient client.KeyManagerClient, oneTimeKeys store.OneTimeKeys) usecases.SignETHTransactionUseCase {
 	return &signETHTransactionUseCase{
 		keyManagerClient: keyManagerClient,
+		oneTimeKeys:      oneTimeKeys,
 		logger:           log.NewLogger().SetComponent(signTransactionComponent),
 	}
 }
@@ -43,7 +45,7 @@ func (uc *signETHTransactionUseCase) Execute(ctx context.Context, job *entities.
 
 	transaction := formatters.ETHTransactionToTransaction(job.Transaction, job.InternalData.ChainID)
 	if job.InternalData.OneTimeKey {
-		signedRaw, txHash, err = uc.signWithOneTimeKey(ctx, transaction, job.InternalData.ChainID)
+		signedRaw, txHash, err = uc.signWithOneTimeKey(ctx, job, transaction, job.InternalData.ChainID)
 	} else {
 		signedRaw, txHash, err = uc.signWithAccount(ctx, job, transaction, job.InternalData.ChainID)
 	}
@@ -55,13 +57,11 @@ func (uc *signETHTransactionUseCase) Execute(ctx context.Context, job *entities.
 	return signedRaw, txHash, nil
 }
 
-func (uc *signETHTransactionUseCase) signWithOneTimeKey(ctx context.Context, transaction *types.Transaction, chainID *big.Int) (signedRaw hexutil.Bytes, txHash *ethcommon.Hash, err error) {
+func (uc *signETHTransactionUseCase) signWithOneTimeKey(ctx context.Context, job *entities.Job, transaction *types.Transaction, chainID *big.Int) (signedRaw hexutil.Bytes, txHash *ethcommon.Hash, err error) {
 	logger := uc.logger.WithContext(ctx)
-	privKey, err := crypto.GenerateKey()
+	privKey, err := oneTimeKey(uc.oneTimeKeys, job, logger)
 	if err != nil {
-		errMessage := "failed to generate Ethereum private one time key"
-		logger.WithError(err).Error(errMessage)
-		return nil, nil, errors.CryptoOperationError(errMessage)
+		return nil, nil, err
 	}
 
 	signer := types.NewEIP155Signer(chainID)
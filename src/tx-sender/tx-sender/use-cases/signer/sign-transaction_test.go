@@ -10,8 +10,11 @@ import (
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/tx-sender/store/mock"
 	qkmmock "github.com/consensys/quorum-key-manager/pkg/client/mock"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	types2 "github.com/ethereum/go-ethereum/core/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -22,9 +25,10 @@ func TestSignTransaction_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockKeyManagerClient := qkmmock.NewMockKeyManagerClient(ctrl)
+	mockOneTimeKeys := mock.NewMockOneTimeKeys(ctrl)
 	ctx := context.Background()
 
-	usecase := NewSignETHTransactionUseCase(mockKeyManagerClient)
+	usecase := NewSignETHTransactionUseCase(mockKeyManagerClient, mockOneTimeKeys)
 
 	signedRaw := utils.StringToHexBytes("0xf86501822710825208944fed1fc4144c223ae3c1553be203cdfcbd38c58182c35080820713a09a0a890215ea6e79d06f9665297996ab967db117f36c2090d6d6ead5a2d32d52a065bc4bc766b5a833cb58b3319e44e952487559b9b939cb5268c0409398214c8b")
 	
@@ -57,6 +61,8 @@ func TestSignTransaction_Execute(t *testing.T) {
 		job := testdata.FakeJob()
 		job.Transaction.TransactionType = entities.LegacyTxType
 		job.InternalData.OneTimeKey = true
+		mockOneTimeKeys.EXPECT().Get(job.UUID).Return(nil, errors.NotFoundError("error"))
+		mockOneTimeKeys.EXPECT().Set(job.UUID, gomock.Any()).Return(nil)
 
 		raw, txHash, err := usecase.Execute(ctx, job)
 
@@ -65,6 +71,81 @@ func TestSignTransaction_Execute(t *testing.T) {
 		assert.NotEmpty(t, txHash)
 	})
 
+	t.Run("should sign replacing one time key transactions with the key of the replaced job", func(t *testing.T) {
+		privKey, _ := crypto.GenerateKey()
+		job := testdata.FakeJob()
+		job.Transaction.TransactionType = entities.LegacyTxType
+		job.InternalData.OneTimeKey = true
+		job.InternalData.ParentJobUUID = "parentJobUUID"
+		mockOneTimeKeys.EXPECT().Get("parentJobUUID").Return(crypto.FromECDSA(privKey), nil)
+
+		raw, _, err := usecase.Execute(ctx, job)
+		require.NoError(t, err)
+
+		signedTx := &types2.Transaction{}
+		require.NoError(t, signedTx.UnmarshalBinary(raw))
+		sender, err := types2.Sender(types2.NewEIP155Signer(job.InternalData.ChainID), signedTx)
+		require.NoError(t, err)
+		assert.Equal(t, crypto.PubkeyToAddress(privKey.PublicKey), sender)
+	})
+
+	t.Run("should sign redelivered one time key transactions with the key already stored for the job", func(t *testing.T) {
+		privKey, _ := crypto.GenerateKey()
+		job := testdata.FakeJob()
+		job.Transaction.TransactionType = entities.LegacyTxType
+		job.InternalData.OneTimeKey = true
+		mockOneTimeKeys.EXPECT().Get(job.UUID).Return(crypto.FromECDSA(privKey), nil)
+
+		raw, _, err := usecase.Execute(ctx, job)
+		require.NoError(t, err)
+
+		signedTx := &types2.Transaction{}
+		require.NoError(t, signedTx.UnmarshalBinary(raw))
+		sender, err := types2.Sender(types2.NewEIP155Signer(job.InternalData.ChainID), signedTx)
+		require.NoError(t, err)
+		assert.Equal(t, crypto.PubkeyToAddress(privKey.PublicKey), sender)
+	})
+
+	t.Run("should fail with same error if the stored one time key cannot be loaded", func(t *testing.T) {
+		expectedErr := errors.RedisConnectionError("error")
+		job := testdata.FakeJob()
+		job.InternalData.OneTimeKey = true
+		mockOneTimeKeys.EXPECT().Get(job.UUID).Return(nil, expectedErr)
+
+		raw, txHash, err := usecase.Execute(ctx, job)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(signTransactionComponent), err)
+		assert.Empty(t, raw)
+		assert.Empty(t, txHash)
+	})
+
+	t.Run("should fail with InvalidStateError if the key of the replaced job has expired", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.InternalData.OneTimeKey = true
+		job.InternalData.ParentJobUUID = "parentJobUUID"
+		mockOneTimeKeys.EXPECT().Get("parentJobUUID").Return(nil, errors.NotFoundError("error"))
+
+		raw, txHash, err := usecase.Execute(ctx, job)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+		assert.Empty(t, raw)
+		assert.Empty(t, txHash)
+	})
+
+	t.Run("should fail with same error if one time key cannot be stored", func(t *testing.T) {
+		expectedErr := errors.RedisConnectionError("error")
+		job := testdata.FakeJob()
+		job.InternalData.OneTimeKey = true
+		mockOneTimeKeys.EXPECT().Get(job.UUID).Return(nil, errors.NotFoundError("error"))
+		mockOneTimeKeys.EXPECT().Set(job.UUID, gomock.Any()).Return(expectedErr)
+
+		raw, txHash, err := usecase.Execute(ctx, job)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(signTransactionComponent), err)
+		assert.Empty(t, raw)
+		assert.Empty(t, txHash)
+	})
+
 	t.Run("should fail with same error if ETHSignTransaction fails", func(t *testing.T) {
 		expectedErr := errors.InvalidFormatError("error")
 		mockKeyManagerClient.EXPECT().SignTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
@@ -11,7 +11,11 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/api/service/formatters"
 	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/redis"
 	"github.com/consensys/orchestrate/src/tx-sentry/service/listeners"
+	"github.com/consensys/orchestrate/src/tx-sentry/store"
+	"github.com/consensys/orchestrate/src/tx-sentry/store/memory"
+	redisstore "github.com/consensys/orchestrate/src/tx-sentry/store/redis"
 	usecases "github.com/consensys/orchestrate/src/tx-sentry/tx-sentry/use-cases"
 	backoffjob "github.com/traefik/traefik/v2/pkg/job"
 )
@@ -25,11 +29,18 @@ type TxSentry struct {
 	logger         *log.Logger
 }
 
-func NewTxSentry(client orchestrateclient.OrchestrateClient, config *Config) *TxSentry {
+func NewTxSentry(client orchestrateclient.OrchestrateClient, config *Config, redisCli redis.Client) *TxSentry {
+	var sessionStore store.SessionStore
+	if config.SessionStoreType == SessionStoreTypeRedis {
+		sessionStore = redisstore.NewSessionStore(redisCli, config.SessionExpiration)
+	} else {
+		sessionStore = memory.NewSessionStore()
+	}
+
 	createChildJobUC := usecases.NewRetrySessionJobUseCase(client)
 	return &TxSentry{
 		client:         client,
-		sessionManager: listeners.NewSessionManager(client, createChildJobUC),
+		sessionManager: listeners.NewSessionManager(client, createChildJobUC, sessionStore),
 		config:         config,
 		logger:         log.NewLogger().SetComponent(txSentryComponent),
 	}
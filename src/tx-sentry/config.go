@@ -15,9 +15,32 @@ const (
 	sentryRefreshIntervalEnv      = "TX_SENTRY_REFRESH_INTERVAL"
 )
 
+const (
+	sessionStoreTypeFlag     = "tx-sentry-session-store-type"
+	sessionStoreTypeViperKey = "tx-sentry.session-store.type"
+	sessionStoreTypeDefault  = SessionStoreTypeInMemory
+	sessionStoreTypeEnv      = "TX_SENTRY_SESSION_STORE_TYPE"
+
+	SessionStoreTypeInMemory = "in-memory"
+	SessionStoreTypeRedis    = "redis"
+)
+
+const (
+	sessionExpirationFlag     = "tx-sentry-session-expiration"
+	sessionExpirationViperKey = "tx-sentry.session.expiration"
+	sessionExpirationDefault  = 24 * time.Hour
+	sessionExpirationEnv      = "TX_SENTRY_SESSION_EXPIRATION"
+)
+
 func init() {
 	viper.SetDefault(sentryRefreshIntervalViperKey, sentryRefreshIntervalDefault)
 	_ = viper.BindEnv(sentryRefreshIntervalViperKey, sentryRefreshIntervalEnv)
+
+	viper.SetDefault(sessionStoreTypeViperKey, sessionStoreTypeDefault)
+	_ = viper.BindEnv(sessionStoreTypeViperKey, sessionStoreTypeEnv)
+
+	viper.SetDefault(sessionExpirationViperKey, sessionExpirationDefault)
+	_ = viper.BindEnv(sessionExpirationViperKey, sessionExpirationEnv)
 }
 
 // Flags register flags for tx sentry
@@ -25,14 +48,35 @@ func Flags(f *pflag.FlagSet) {
 	pendingDurationDesc := fmt.Sprintf(`Interval of time between checks for pending transactions. Environment variable: %q`, sentryRefreshIntervalEnv)
 	f.Duration(sentryRefreshIntervalFlag, sentryRefreshIntervalDefault, pendingDurationDesc)
 	_ = viper.BindPFlag(sentryRefreshIntervalViperKey, f.Lookup(sentryRefreshIntervalFlag))
+
+	sessionStoreType(f)
+	sessionExpiration(f)
+}
+
+func sessionStoreType(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Type of store used to persist job retry sessions (one of %q). Use %q to share sessions across several instances.
+Environment variable: %q`, []string{SessionStoreTypeInMemory, SessionStoreTypeRedis}, SessionStoreTypeRedis, sessionStoreTypeEnv)
+	f.String(sessionStoreTypeFlag, sessionStoreTypeDefault, desc)
+	_ = viper.BindPFlag(sessionStoreTypeViperKey, f.Lookup(sessionStoreTypeFlag))
+}
+
+func sessionExpiration(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Expiration time (TTL) of persisted job retry sessions.
+Environment variable: %q`, sessionExpirationEnv)
+	f.Duration(sessionExpirationFlag, sessionExpirationDefault, desc)
+	_ = viper.BindPFlag(sessionExpirationViperKey, f.Lookup(sessionExpirationFlag))
 }
 
 type Config struct {
-	RefreshInterval time.Duration
+	RefreshInterval   time.Duration
+	SessionStoreType  string
+	SessionExpiration time.Duration
 }
 
 func NewConfig(vipr *viper.Viper) *Config {
 	return &Config{
-		RefreshInterval: vipr.GetDuration(sentryRefreshIntervalViperKey),
+		RefreshInterval:   vipr.GetDuration(sentryRefreshIntervalViperKey),
+		SessionStoreType:  vipr.GetString(sessionStoreTypeViperKey),
+		SessionExpiration: vipr.GetDuration(sessionExpirationViperKey),
 	}
 }
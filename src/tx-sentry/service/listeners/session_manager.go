@@ -10,7 +10,9 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/src/api/service/formatters"
 	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/tx-sentry/store"
 	usecases "github.com/consensys/orchestrate/src/tx-sentry/tx-sentry/use-cases"
+	"github.com/gofrs/uuid"
 
 	"github.com/consensys/orchestrate/src/entities"
 
@@ -22,33 +24,37 @@ import (
 
 const sessionManagerComponent = "tx-sentry.service.session-manager"
 
+// leaseIntervals is the number of retry intervals a session lease is held for without being renewed
+const leaseIntervals = 2
+
 type SessionManager interface {
 	Start(ctx context.Context, job *entities.Job)
 }
 
 // sessionManager is a manager of job sessions
+//
+// Session states are persisted in the session store, so a session restarted after a crash resumes where it stopped.
+// Every retry requires the lease of the job session, so several instances can share sessions without double-sending.
 type sessionManager struct {
 	mutex                  *sync.RWMutex
 	sessions               map[string]bool
 	retrySessionJobUseCase usecases.RetrySessionJobUseCase
 	client                 orchestrateclient.OrchestrateClient
+	sessionStore           store.SessionStore
+	instanceID             string
 	logger                 *log.Logger
 }
 
-type sessionData struct {
-	parentJob        *entities.Job
-	nChildren        int
-	retries          int
-	lastChildJobUUID string
-}
-
 // NewSessionManager creates a new SessionManager
-func NewSessionManager(client orchestrateclient.OrchestrateClient, retrySessionJobUseCase usecases.RetrySessionJobUseCase) SessionManager {
+func NewSessionManager(client orchestrateclient.OrchestrateClient, retrySessionJobUseCase usecases.RetrySessionJobUseCase,
+	sessionStore store.SessionStore) SessionManager {
 	return &sessionManager{
 		mutex:                  &sync.RWMutex{},
 		sessions:               make(map[string]bool),
 		retrySessionJobUseCase: retrySessionJobUseCase,
 		client:                 client,
+		sessionStore:           sessionStore,
+		instanceID:             uuid.Must(uuid.NewV4()).String(),
 		logger:                 log.NewLogger().SetComponent(sessionManagerComponent),
 	}
 }
@@ -72,13 +78,13 @@ func (manager *sessionManager) Start(ctx context.Context, job *entities.Job) {
 		return
 	}
 
-	ses, err := manager.retrieveJobSessionData(ctx, job)
+	ses, err := manager.loadJobSession(ctx, job)
 	if err != nil {
 		logger.WithError(err).Error("job listening session failed to start")
 		return
 	}
 
-	if ses.retries >= types.SentryMaxRetries {
+	if ses.Retries >= types.SentryMaxRetries {
 		logger.Warn("job already reached max retries")
 		return
 	}
@@ -86,9 +92,11 @@ func (manager *sessionManager) Start(ctx context.Context, job *entities.Job) {
 	manager.addSession(job.UUID)
 
 	go func() {
+		completed := false
 		err := backoff.RetryNotify(
 			func() error {
-				err := manager.runSession(ctx, ses)
+				var err error
+				completed, err = manager.runSession(ctx, job)
 				return err
 			},
 			pkgbackoff.IncrementalBackOff(time.Second, 5*time.Second, time.Minute),
@@ -96,22 +104,23 @@ func (manager *sessionManager) Start(ctx context.Context, job *entities.Job) {
 				logger.WithError(err).Warnf("error in job retry session, restarting in %v...", d)
 			},
 		)
-
 		if err != nil {
 			logger.WithError(err).Error("job listening session unexpectedly stopped")
+			completed = true
 		}
 
-		annotations := formatters.FormatInternalDataToAnnotations(job.InternalData)
-		annotations.HasBeenRetried = true
-		_, err = manager.client.UpdateJob(ctx, job.UUID, &types.UpdateJobRequest{
-			Annotations: &annotations,
-		})
+		// Only the instance which completed the session updates the job, sessions completed by another
+		// instance or interrupted by a shutdown are left untouched
+		if completed {
+			manager.completeSession(ctx, job)
+			logger.Debug("job session was completed")
+		}
 
+		err = manager.sessionStore.ReleaseLease(job.UUID, manager.instanceID)
 		if err != nil {
-			logger.WithError(err).Error("failed to update job labels")
+			logger.WithError(err).Warn("failed to release job session lease")
 		}
 
-		logger.Debug("job session was completed")
 		manager.removeSession(job.UUID)
 	}()
 }
@@ -129,48 +138,155 @@ func (manager *sessionManager) hasSession(jobUUID string) bool {
 	return ok
 }
 
-func (manager *sessionManager) runSession(ctx context.Context, ses *sessionData) error {
+// runSession returns true if the session was completed by this instance
+func (manager *sessionManager) runSession(ctx context.Context, job *entities.Job) (bool, error) {
 	logger := log.FromContext(ctx)
 	logger.Info("job session started")
 
-	ticker := time.NewTicker(ses.parentJob.InternalData.RetryInterval)
-	defer ticker.Stop()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
 	for {
 		select {
-		case <-ticker.C:
-			childJobUUID, err := manager.retrySessionJobUseCase.Execute(ctx, ses.parentJob.UUID, ses.lastChildJobUUID, ses.nChildren)
+		case <-timer.C:
+			ses, err := manager.sessionStore.Get(job.UUID)
+			if errors.IsNotFoundError(err) {
+				ses, err = manager.resumeExpiredSession(ctx, job)
+				if err == nil && ses == nil {
+					logger.Info("job session was completed by another instance")
+					return false, nil
+				}
+			}
 			if err != nil {
-				return errors.FromError(err).ExtendComponent(sessionManagerComponent)
+				return false, errors.FromError(err).ExtendComponent(sessionManagerComponent)
+			}
+			if ses.Retries >= types.SentryMaxRetries {
+				return true, nil
 			}
 
-			ses.retries++
-			if ses.retries >= types.SentryMaxRetries {
-				return nil
+			if wait := time.Until(ses.NextRetryAt); wait > 0 {
+				timer.Reset(wait)
+				continue
 			}
 
-			// If no child created but no error, we exit the session gracefully
-			if childJobUUID == "" {
-				return nil
+			owned, err := manager.sessionStore.AcquireLease(job.UUID, manager.instanceID,
+				leaseIntervals*job.InternalData.RetryInterval)
+			if err != nil {
+				return false, errors.FromError(err).ExtendComponent(sessionManagerComponent)
+			}
+			if !owned {
+				logger.Debug("job session is owned by another instance")
+				timer.Reset(job.InternalData.RetryInterval)
+				continue
 			}
 
-			if childJobUUID != ses.lastChildJobUUID {
-				ses.nChildren++
-				ses.lastChildJobUUID = childJobUUID
+			completed, err := manager.retry(ctx, job, ses)
+			if err != nil || completed {
+				return completed, err
 			}
+
+			timer.Reset(time.Until(ses.NextRetryAt))
 		case <-ctx.Done():
 			logger.WithField("reason", ctx.Err().Error()).Info("session gracefully stopped")
-			return nil
+			return false, nil
 		}
 	}
 }
 
+// retry executes one retry of the session and persists the resulting state, returns true if the session is completed
+func (manager *sessionManager) retry(ctx context.Context, job *entities.Job, ses *store.Session) (bool, error) {
+	childJobUUID, err := manager.retrySessionJobUseCase.Execute(ctx, job.UUID, ses.LastChildJobUUID, ses.NChildren)
+	if err != nil {
+		return false, errors.FromError(err).ExtendComponent(sessionManagerComponent)
+	}
+
+	ses.Retries++
+	if ses.Retries >= types.SentryMaxRetries {
+		return true, nil
+	}
+
+	// If no child created but no error, we exit the session gracefully
+	if childJobUUID == "" {
+		return true, nil
+	}
+
+	if childJobUUID != ses.LastChildJobUUID {
+		ses.NChildren++
+		ses.LastChildJobUUID = childJobUUID
+	}
+
+	ses.NextRetryAt = time.Now().Add(job.InternalData.RetryInterval)
+	err = manager.sessionStore.Set(ses)
+	if err != nil {
+		return false, errors.FromError(err).ExtendComponent(sessionManagerComponent)
+	}
+
+	return false, nil
+}
+
+func (manager *sessionManager) completeSession(ctx context.Context, job *entities.Job) {
+	logger := log.FromContext(ctx)
+
+	annotations := formatters.FormatInternalDataToAnnotations(job.InternalData)
+	annotations.HasBeenRetried = true
+	_, err := manager.client.UpdateJob(ctx, job.UUID, &types.UpdateJobRequest{
+		Annotations: &annotations,
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to update job labels")
+	}
+
+	err = manager.sessionStore.Delete(job.UUID)
+	if err != nil {
+		logger.WithError(err).Warn("failed to delete job session")
+	}
+}
+
 func (manager *sessionManager) removeSession(jobUUID string) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 	delete(manager.sessions, jobUUID)
 }
 
-func (manager *sessionManager) retrieveJobSessionData(ctx context.Context, job *entities.Job) (*sessionData, error) {
+// loadJobSession retrieves the persisted session of the job or creates it from the job children
+func (manager *sessionManager) loadJobSession(ctx context.Context, job *entities.Job) (*store.Session, error) {
+	ses, err := manager.sessionStore.Get(job.UUID)
+	if err == nil {
+		log.FromContext(ctx).WithField("retries", ses.Retries).Debug("job session restored")
+		return ses, nil
+	}
+	if !errors.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	ses, err = manager.retrieveJobSessionData(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	err = manager.sessionStore.Set(ses)
+	if err != nil {
+		return nil, err
+	}
+
+	return ses, nil
+}
+
+// resumeExpiredSession recreates from the job state a session which expired in the session store. Sessions are only
+// deleted once their job is flagged as retried, so nil is returned for the sessions completed by another instance
+func (manager *sessionManager) resumeExpiredSession(ctx context.Context, job *entities.Job) (*store.Session, error) {
+	jobRes, err := manager.client.GetJob(ctx, job.UUID)
+	if err != nil {
+		return nil, err
+	}
+	if jobRes.Annotations.HasBeenRetried {
+		return nil, nil
+	}
+
+	log.FromContext(ctx).Warn("job session expired, resuming it from the job state")
+	return manager.loadJobSession(ctx, job)
+}
+
+func (manager *sessionManager) retrieveJobSessionData(ctx context.Context, job *entities.Job) (*store.Session, error) {
 	jobs, err := manager.client.SearchJob(ctx, &entities.JobFilters{
 		ChainUUID:     job.ChainUUID,
 		ParentJobUUID: job.UUID,
@@ -192,10 +308,11 @@ func (manager *sessionManager) retrieveJobSessionData(ctx context.Context, job *
 		}
 	}
 
-	return &sessionData{
-		parentJob:        job,
-		nChildren:        nChildren,
-		retries:          nRetries,
-		lastChildJobUUID: jobs[nChildren].UUID,
+	return &store.Session{
+		JobUUID:          job.UUID,
+		NChildren:        nChildren,
+		Retries:          nRetries,
+		LastChildJobUUID: jobs[nChildren].UUID,
+		NextRetryAt:      time.Now().Add(job.InternalData.RetryInterval),
 	}, nil
 }
@@ -14,7 +14,11 @@ import (
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	apitestdata "github.com/consensys/orchestrate/src/api/service/types/testdata"
+	"github.com/consensys/orchestrate/src/tx-sentry/store"
+	"github.com/consensys/orchestrate/src/tx-sentry/store/memory"
 	"github.com/consensys/orchestrate/src/tx-sentry/tx-sentry/use-cases/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSessionManager(t *testing.T) {
@@ -26,7 +30,8 @@ func TestSessionManager(t *testing.T) {
 	client := mock.NewMockOrchestrateClient(ctrl)
 	retrySessionJobUC := mocks.NewMockRetrySessionJobUseCase(ctrl)
 
-	sessionManager := NewSessionManager(client, retrySessionJobUC)
+	sessionStore := memory.NewSessionStore()
+	sessionManager := NewSessionManager(client, retrySessionJobUC, sessionStore)
 
 	t.Run("should retry session job successfully at every retry interval with latest child job", func(t *testing.T) {
 		timeout := retryInterval*4 + 500*time.Millisecond
@@ -165,10 +170,14 @@ func TestSessionManager(t *testing.T) {
 
 		retrySessionJobUC.EXPECT().Execute(gomock.Any(), parentJobResponse.UUID, parentJobResponse.UUID, 0).Return("", fmt.Errorf("error"))
 		retrySessionJobUC.EXPECT().Execute(gomock.Any(), parentJobResponse.UUID, parentJobResponse.UUID, gomock.Any()).Return(parentJobResponse.UUID, nil).AnyTimes()
-		client.EXPECT().UpdateJob(gomock.Any(), job.UUID, gomock.Any()).Return(nil, nil)
 		sessionManager.Start(ctx, job)
 
 		<-ctx.Done()
+		// Session interrupted by shutdown is kept so it can be resumed
+		time.Sleep(100 * time.Millisecond)
+		ses, err := sessionStore.Get(job.UUID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, ses.Retries)
 	})
 
 	t.Run("should do nothing if there is an active session for same job", func(t *testing.T) {
@@ -224,4 +233,113 @@ func TestSessionManager(t *testing.T) {
 
 		<-ctx.Done()
 	})
+
+	t.Run("should resume a persisted session without fetching job children", func(t *testing.T) {
+		timeout := retryInterval + 500*time.Millisecond
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		job := testdata.FakeJob()
+		job.InternalData.RetryInterval = retryInterval
+		childJobUUID := "childJobUUID"
+
+		err := sessionStore.Set(&store.Session{
+			JobUUID:          job.UUID,
+			NChildren:        1,
+			Retries:          types.SentryMaxRetries - 1,
+			LastChildJobUUID: childJobUUID,
+			NextRetryAt:      time.Now().Add(-time.Minute),
+		})
+		require.NoError(t, err)
+
+		retrySessionJobUC.EXPECT().Execute(gomock.Any(), job.UUID, childJobUUID, 1).Return(childJobUUID, nil)
+		client.EXPECT().UpdateJob(gomock.Any(), job.UUID, gomock.Any()).Return(nil, nil)
+
+		sessionManager.Start(ctx, job)
+
+		<-ctx.Done()
+		_, err = sessionStore.Get(job.UUID)
+		assert.Error(t, err)
+	})
+
+	t.Run("should resume an expired session from the job state", func(t *testing.T) {
+		timeout := retryInterval*2 + 500*time.Millisecond
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		job := testdata.FakeJob()
+		job.InternalData.RetryInterval = retryInterval
+		parentJobResponse := apitestdata.FakeJobResponse()
+		parentJobResponse.UUID = job.UUID
+
+		client.EXPECT().SearchJob(gomock.Any(), &entities.JobFilters{
+			ChainUUID:     job.ChainUUID,
+			ParentJobUUID: job.UUID,
+			WithLogs:      true,
+		}).Return([]*types.JobResponse{parentJobResponse}, nil).Times(2)
+		client.EXPECT().GetJob(gomock.Any(), job.UUID).Return(parentJobResponse, nil)
+		retrySessionJobUC.EXPECT().Execute(gomock.Any(), job.UUID, job.UUID, 0).Return("", nil)
+		client.EXPECT().UpdateJob(gomock.Any(), job.UUID, gomock.Any()).Return(nil, nil)
+
+		sessionManager.Start(ctx, job)
+		err := sessionStore.Delete(job.UUID)
+		require.NoError(t, err)
+
+		<-ctx.Done()
+	})
+
+	t.Run("should stop a session completed by another instance", func(t *testing.T) {
+		timeout := retryInterval + 500*time.Millisecond
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		job := testdata.FakeJob()
+		job.InternalData.RetryInterval = retryInterval
+		parentJobResponse := apitestdata.FakeJobResponse()
+		parentJobResponse.UUID = job.UUID
+		retriedJobResponse := apitestdata.FakeJobResponse()
+		retriedJobResponse.UUID = job.UUID
+		retriedJobResponse.Annotations.HasBeenRetried = true
+
+		client.EXPECT().SearchJob(gomock.Any(), &entities.JobFilters{
+			ChainUUID:     job.ChainUUID,
+			ParentJobUUID: job.UUID,
+			WithLogs:      true,
+		}).Return([]*types.JobResponse{parentJobResponse}, nil)
+		client.EXPECT().GetJob(gomock.Any(), job.UUID).Return(retriedJobResponse, nil)
+
+		sessionManager.Start(ctx, job)
+		err := sessionStore.Delete(job.UUID)
+		require.NoError(t, err)
+
+		<-ctx.Done()
+	})
+
+	t.Run("should not retry session job if lease is owned by another instance", func(t *testing.T) {
+		timeout := retryInterval*2 + 500*time.Millisecond
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		job := testdata.FakeJob()
+		job.InternalData.RetryInterval = retryInterval
+		parentJobResponse := apitestdata.FakeJobResponse()
+		parentJobResponse.UUID = job.UUID
+
+		acquired, err := sessionStore.AcquireLease(job.UUID, "anotherInstance", time.Minute)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		client.EXPECT().SearchJob(gomock.Any(), &entities.JobFilters{
+			ChainUUID:     job.ChainUUID,
+			ParentJobUUID: job.UUID,
+			WithLogs:      true,
+		}).Return([]*types.JobResponse{parentJobResponse}, nil)
+
+		sessionManager.Start(ctx, job)
+
+		<-ctx.Done()
+		ses, err := sessionStore.Get(job.UUID)
+		require.NoError(t, err)
+		assert.Equal(t, 0, ses.Retries)
+	})
 }
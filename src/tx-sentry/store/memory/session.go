@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/tx-sentry/store"
+)
+
+const (
+	sessionSuf = "session"
+	leaseSuf   = "lease"
+)
+
+type lease struct {
+	owner     string
+	expiresAt time.Time
+}
+
+// sessionStore is a SessionStore kept in memory
+//
+// Important note:
+// sessions are lost on restart and cannot be shared across several instances of the tx-sentry
+type sessionStore struct {
+	mux      *sync.Mutex
+	sessions map[string]store.Session
+	leases   map[string]lease
+}
+
+func NewSessionStore() store.SessionStore {
+	return &sessionStore{
+		mux:      &sync.Mutex{},
+		sessions: make(map[string]store.Session),
+		leases:   make(map[string]lease),
+	}
+}
+
+func (s *sessionStore) Get(jobUUID string) (*store.Session, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	ses, ok := s.sessions[computeKey(jobUUID, sessionSuf)]
+	if !ok {
+		return nil, errors.NotFoundError("session not found")
+	}
+
+	return &ses, nil
+}
+
+func (s *sessionStore) Set(session *store.Session) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.sessions[computeKey(session.JobUUID, sessionSuf)] = *session
+	return nil
+}
+
+func (s *sessionStore) Delete(jobUUID string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	delete(s.sessions, computeKey(jobUUID, sessionSuf))
+	return nil
+}
+
+func (s *sessionStore) AcquireLease(jobUUID, owner string, ttl time.Duration) (bool, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	key := computeKey(jobUUID, leaseSuf)
+	now := time.Now()
+	if l, ok := s.leases[key]; ok && l.owner != owner && l.expiresAt.After(now) {
+		return false, nil
+	}
+
+	s.leases[key] = lease{owner: owner, expiresAt: now.Add(ttl)}
+	return true, nil
+}
+
+func (s *sessionStore) ReleaseLease(jobUUID, owner string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	key := computeKey(jobUUID, leaseSuf)
+	if l, ok := s.leases[key]; ok && l.owner == owner {
+		delete(s.leases, key)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// +build unit
+
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/tx-sentry/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionStoreMemory(t *testing.T) {
+	s := NewSessionStore()
+	jobUUID := "session-store-memory"
+
+	_, err := s.Get(jobUUID)
+	assert.True(t, errors.IsNotFoundError(err))
+
+	session := &store.Session{
+		JobUUID:          jobUUID,
+		NChildren:        1,
+		Retries:          2,
+		LastChildJobUUID: "childJobUUID",
+		NextRetryAt:      time.Now(),
+	}
+	err = s.Set(session)
+	require.NoError(t, err)
+
+	ses, err := s.Get(jobUUID)
+	require.NoError(t, err)
+	assert.Equal(t, session, ses)
+
+	err = s.Delete(jobUUID)
+	require.NoError(t, err)
+	_, err = s.Get(jobUUID)
+	assert.True(t, errors.IsNotFoundError(err))
+}
+
+func TestSessionLeaseMemory(t *testing.T) {
+	s := NewSessionStore()
+	jobUUID := "session-lease-memory"
+
+	acquired, err := s.AcquireLease(jobUUID, "ownerOne", 100*time.Millisecond)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	acquired, _ = s.AcquireLease(jobUUID, "ownerOne", 100*time.Millisecond)
+	assert.True(t, acquired, "owner should extend its own lease")
+
+	acquired, _ = s.AcquireLease(jobUUID, "ownerTwo", 100*time.Millisecond)
+	assert.False(t, acquired, "lease should not be acquired while owned by another owner")
+
+	time.Sleep(200 * time.Millisecond)
+	acquired, _ = s.AcquireLease(jobUUID, "ownerTwo", 100*time.Millisecond)
+	assert.True(t, acquired, "expired lease should be acquired")
+
+	_ = s.ReleaseLease(jobUUID, "ownerOne")
+	acquired, _ = s.AcquireLease(jobUUID, "ownerOne", 100*time.Millisecond)
+	assert.False(t, acquired, "lease should not be released by another owner")
+
+	_ = s.ReleaseLease(jobUUID, "ownerTwo")
+	acquired, _ = s.AcquireLease(jobUUID, "ownerOne", 100*time.Millisecond)
+	assert.True(t, acquired)
+}
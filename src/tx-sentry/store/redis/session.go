@@ -0,0 +1,64 @@
+package redis
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/redis"
+	"github.com/consensys/orchestrate/src/tx-sentry/store"
+)
+
+const (
+	sessionSuf = "sentry-session"
+	leaseSuf   = "sentry-lease"
+)
+
+type SessionStore struct {
+	redis      redis.Client
+	expiration int
+}
+
+// NewSessionStore creates a new SessionStore shared by all the instances of the tx-sentry connected to the same Redis
+func NewSessionStore(client redis.Client, expiration time.Duration) *SessionStore {
+	return &SessionStore{
+		redis:      client,
+		expiration: int(expiration.Milliseconds()),
+	}
+}
+
+func (s *SessionStore) Get(jobUUID string) (*store.Session, error) {
+	bytes, err := s.redis.LoadBytes(computeKey(jobUUID, sessionSuf))
+	if err != nil {
+		return nil, err
+	}
+
+	session := &store.Session{}
+	err = json.Unmarshal(bytes, session)
+	if err != nil {
+		return nil, errors.DataCorruptedError("loaded session is not valid")
+	}
+
+	return session, nil
+}
+
+func (s *SessionStore) Set(session *store.Session) error {
+	bytes, err := json.Marshal(session)
+	if err != nil {
+		return errors.EncodingError("failed to marshal session")
+	}
+
+	return s.redis.Set(computeKey(session.JobUUID, sessionSuf), s.expiration, bytes)
+}
+
+func (s *SessionStore) Delete(jobUUID string) error {
+	return s.redis.Delete(computeKey(jobUUID, sessionSuf))
+}
+
+func (s *SessionStore) AcquireLease(jobUUID, owner string, ttl time.Duration) (bool, error) {
+	return s.redis.AcquireLock(computeKey(jobUUID, leaseSuf), owner, int(ttl.Milliseconds()))
+}
+
+func (s *SessionStore) ReleaseLease(jobUUID, owner string) error {
+	return s.redis.ReleaseLock(computeKey(jobUUID, leaseSuf), owner)
+}
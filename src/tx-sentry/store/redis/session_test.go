@@ -0,0 +1,84 @@
+// +build unit
+
+package redis
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/redis/mocks"
+	"github.com/consensys/orchestrate/src/tx-sentry/store"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	jobUUID := "session-store-redis"
+	expectedSessionKey := computeKey(jobUUID, sessionSuf)
+	expectedLeaseKey := computeKey(jobUUID, leaseSuf)
+	expiration := 100 * time.Millisecond
+
+	mockRedisClient := mocks.NewMockClient(ctrl)
+	s := NewSessionStore(mockRedisClient, expiration)
+
+	session := &store.Session{
+		JobUUID:          jobUUID,
+		NChildren:        1,
+		Retries:          2,
+		LastChildJobUUID: "childJobUUID",
+		NextRetryAt:      time.Now().UTC(),
+	}
+	bytes, _ := json.Marshal(session)
+
+	t.Run("should set session successfully", func(t *testing.T) {
+		mockRedisClient.EXPECT().Set(expectedSessionKey, 100, bytes).Return(nil)
+
+		err := s.Set(session)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should get session successfully", func(t *testing.T) {
+		mockRedisClient.EXPECT().LoadBytes(expectedSessionKey).Return(bytes, nil)
+
+		ses, err := s.Get(jobUUID)
+		require.NoError(t, err)
+		assert.Equal(t, session.Retries, ses.Retries)
+		assert.Equal(t, session.LastChildJobUUID, ses.LastChildJobUUID)
+		assert.True(t, session.NextRetryAt.Equal(ses.NextRetryAt))
+	})
+
+	t.Run("should fail with DataCorruptedError if session is not valid", func(t *testing.T) {
+		mockRedisClient.EXPECT().LoadBytes(expectedSessionKey).Return([]byte("invalid"), nil)
+
+		_, err := s.Get(jobUUID)
+		assert.True(t, errors.IsDataCorruptedError(err))
+	})
+
+	t.Run("should delete session successfully", func(t *testing.T) {
+		mockRedisClient.EXPECT().Delete(expectedSessionKey).Return(nil)
+
+		err := s.Delete(jobUUID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should acquire lease successfully", func(t *testing.T) {
+		mockRedisClient.EXPECT().AcquireLock(expectedLeaseKey, "owner", 1000).Return(true, nil)
+
+		acquired, err := s.AcquireLease(jobUUID, "owner", time.Second)
+		assert.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("should release lease successfully", func(t *testing.T) {
+		mockRedisClient.EXPECT().ReleaseLock(expectedLeaseKey, "owner").Return(nil)
+
+		err := s.ReleaseLease(jobUUID, "owner")
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,29 @@
+package store
+
+import (
+	"time"
+)
+
+//go:generate mockgen -source=store.go -destination=mocks/store.go -package=mocks
+
+// Session is the persisted state of a job retry session
+type Session struct {
+	JobUUID          string    `json:"jobUUID"`
+	NChildren        int       `json:"nChildren"`
+	Retries          int       `json:"retries"`
+	LastChildJobUUID string    `json:"lastChildJobUUID"`
+	NextRetryAt      time.Time `json:"nextRetryAt"`
+}
+
+type SessionStore interface {
+	// Get retrieves the session of a job
+	Get(jobUUID string) (*Session, error)
+	// Set persists the session of a job
+	Set(session *Session) error
+	// Delete removes the session of a job
+	Delete(jobUUID string) error
+	// AcquireLease acquires the lease of a job session for the given owner, or extends it if already owned
+	AcquireLease(jobUUID, owner string, ttl time.Duration) (bool, error)
+	// ReleaseLease releases the lease of a job session if owned by the given owner
+	ReleaseLease(jobUUID, owner string) error
+}